@@ -4,9 +4,18 @@ import (
 	"log"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
 	"github.com/jacoobjake/einvoice-api/config"
+	"github.com/jacoobjake/einvoice-api/internal/repositories"
 	"github.com/jacoobjake/einvoice-api/internal/routes"
+	"github.com/jacoobjake/einvoice-api/pkg/denylist"
+	"github.com/jacoobjake/einvoice-api/pkg/keyring"
+	"github.com/jacoobjake/einvoice-api/pkg/lhdn"
+	"github.com/jacoobjake/einvoice-api/pkg/mailer"
+	"github.com/jacoobjake/einvoice-api/pkg/password"
 	"github.com/jacoobjake/einvoice-api/pkg/redisclient"
+	"github.com/jacoobjake/einvoice-api/pkg/secretbox"
 	_ "github.com/lib/pq"
 	"github.com/stephenafamo/bob"
 )
@@ -17,6 +26,12 @@ func main() {
 
 	// Initialize DB
 	cfg := config.Load()
+
+	// Only take the client ip from X-Forwarded-For when the request comes through a known proxy
+	if err := r.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		log.Fatalf("failed to set trusted proxies: %v", err)
+	}
+
 	db := initDB(cfg)
 
 	defer db.Close()
@@ -24,11 +39,51 @@ func main() {
 	// Initialize redis
 	rdb := redisclient.NewRedisClient(cfg.RedisConfig)
 
+	// Initialize token denylist
+	tokenDenylist := initTokenDenylist(cfg, db, rdb)
+
+	// Initialize JWT keyring
+	kr := initKeyring(cfg)
+
+	// Initialize mailer
+	mail, err := mailer.NewMailer(cfg.MailConfig)
+
+	if err != nil {
+		log.Fatalf("failed to initialize mailer: %v", err)
+	}
+
+	// Initialize MFA secret encryption
+	box, err := secretbox.NewSecretBox(cfg.AuthConfig.MFAEncryptionKey)
+
+	if err != nil {
+		log.Fatalf("failed to initialize mfa secret box: %v", err)
+	}
+
+	// Initialize password hashing
+	hasher, err := password.NewHasher(cfg.PasswordConfig)
+
+	if err != nil {
+		log.Fatalf("failed to initialize password hasher: %v", err)
+	}
+
+	// Initialize breached password check
+	breached, err := password.NewBreachedChecker(cfg.PasswordConfig.BreachedDir)
+
+	if err != nil {
+		log.Fatalf("failed to initialize breached password check: %v", err)
+	}
+
+	// Register LHDN field format validations for request bindings
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		if err := lhdn.RegisterValidations(v); err != nil {
+			log.Fatalf("failed to register lhdn validations: %v", err)
+		}
+	}
+
 	// Pass db to routes if needed (example: api.RegisterRoutes(apiGroup, db))
-	routes.RegisterRoutes(r, db, cfg, rdb)
+	routes.RegisterRoutes(r, db, cfg, rdb, tokenDenylist, kr, mail, box, hasher, breached)
 
 	// Example: Register routes from other modules
-	// invoice.RegisterRoutes(apiGroup, db)
 	// user.RegisterRoutes(apiGroup, db)
 
 	// Start server
@@ -52,3 +107,36 @@ func initDB(cfg *config.Config) bob.DB {
 
 	return db
 }
+
+func initKeyring(cfg *config.Config) *keyring.Keyring {
+	authCfg := cfg.AuthConfig
+
+	if authCfg.JWTKeysDir == "" {
+		log.Println("JWT_KEYS_DIR not set, falling back to HS256 shared secret signing")
+		return keyring.NewHMACKeyring("default", authCfg.JWTSecret)
+	}
+
+	kr, err := keyring.LoadKeyring(authCfg.JWTKeysDir, authCfg.JWTSigningKeyID)
+
+	if err != nil {
+		log.Fatalf("failed to load jwt keyring: %v", err)
+	}
+
+	return kr
+}
+
+// initTokenDenylist selects where revoked access tokens are kept, see TOKEN_DENYLIST_DRIVER.
+func initTokenDenylist(cfg *config.Config, db bob.DB, rdb *redisclient.RedisClient) denylist.TokenDenylist {
+	switch driver := cfg.AuthConfig.TokenDenylistDriver; driver {
+	case "redis":
+		return denylist.NewRedisDenylist(rdb)
+	case "postgres":
+		return repositories.NewDeniedTokenRepository(db)
+	case "memory":
+		log.Println("TOKEN_DENYLIST_DRIVER=memory, revocations are lost on restart and not shared between instances")
+		return denylist.NewMemoryDenylist()
+	default:
+		log.Fatalf("unsupported token denylist driver: %s", driver)
+		return nil
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Manages the JWT keyring directory (JWT_KEYS_DIR).
+// Rotation: generate a new key, point JWT_SIGNING_KEY_ID at it, then retire the
+// old key once it is no longer used for signing. Retired keys keep verifying
+// (and stay in the JWKS) until their public file is deleted.
+func main() {
+	dir := flag.String("dir", "keys", "Keyring directory")
+	alg := flag.String("alg", "RS256", "Key algorithm: RS256 or EdDSA")
+	kid := flag.String("kid", time.Now().Format("20060102150405"), "Key id")
+	retire := flag.Bool("retire", false, "Replace the private key <kid>.pem with its public key <kid>.pub.pem")
+	flag.Parse()
+
+	if err := os.MkdirAll(*dir, 0o700); err != nil {
+		log.Fatalf("failed to create keyring directory: %v", err)
+	}
+
+	privatePath := filepath.Join(*dir, *kid+".pem")
+
+	if *retire {
+		retireKey(privatePath, filepath.Join(*dir, *kid+".pub.pem"))
+		log.Printf("Retired key %s", *kid)
+		return
+	}
+
+	var private crypto.Signer
+	var err error
+
+	switch *alg {
+	case "RS256":
+		private, err = rsa.GenerateKey(rand.Reader, 2048)
+	case "EdDSA":
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		log.Fatalf("Unknown algorithm: %s", *alg)
+	}
+
+	if err != nil {
+		log.Fatalf("failed to generate key: %v", err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		log.Fatalf("failed to encode private key: %v", err)
+	}
+
+	if err := os.WriteFile(privatePath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		log.Fatalf("failed to write private key: %v", err)
+	}
+
+	log.Printf("Generated %s key %s", *alg, privatePath)
+}
+
+func retireKey(privatePath, publicPath string) {
+	data, err := os.ReadFile(privatePath)
+	if err != nil {
+		log.Fatalf("failed to read private key: %v", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		log.Fatalf("invalid private key file: %s", privatePath)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		log.Fatalf("failed to parse private key: %v", err)
+	}
+
+	signer, ok := parsed.(crypto.Signer)
+	if !ok {
+		log.Fatalf("unsupported private key type: %T", parsed)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		log.Fatalf("failed to encode public key: %v", err)
+	}
+
+	if err := os.WriteFile(publicPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o644); err != nil {
+		log.Fatalf("failed to write public key: %v", err)
+	}
+
+	if err := os.Remove(privatePath); err != nil {
+		log.Fatalf("failed to remove private key: %v", err)
+	}
+}
@@ -0,0 +1,123 @@
+// Command pwnedrange builds the breached password range files read by PASSWORD_BREACHED_DIR
+// from a list of SHA-1 hashes (HASH or HASH:COUNT per line, as in the Pwned Passwords dump)
+// or, with -plain, a list of plaintext passwords such as a common password wordlist.
+//
+//	go run ./cmd/pwnedrange -in rockyou-top10k.txt -plain -out storage/breached
+//
+// Entries are grouped in memory, for the full corpus use the official downloader's
+// per-prefix output instead, it already has this layout.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jacoobjake/einvoice-api/pkg/password"
+)
+
+var sha1Hex = regexp.MustCompile(`^[0-9A-F]{40}$`)
+
+func main() {
+	in := flag.String("in", "", "input file, defaults to stdin")
+	out := flag.String("out", "storage/breached", "output directory")
+	plain := flag.Bool("plain", false, "input holds plaintext passwords rather than SHA-1 hashes")
+	flag.Parse()
+
+	src := os.Stdin
+
+	if *in != "" {
+		f, err := os.Open(*in)
+		if err != nil {
+			log.Fatalf("failed to open input: %v", err)
+		}
+		defer f.Close()
+		src = f
+	}
+
+	// prefix -> suffix -> count
+	ranges := map[string]map[string]string{}
+	scanner := bufio.NewScanner(src)
+	skipped := 0
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if line == "" {
+			continue
+		}
+
+		hash, count := "", "1"
+
+		if *plain {
+			hash = password.SHA1Hex(line)
+		} else {
+			h, c, ok := strings.Cut(line, ":")
+			hash = strings.ToUpper(strings.TrimSpace(h))
+			if ok {
+				count = strings.TrimSpace(c)
+			}
+		}
+
+		if !sha1Hex.MatchString(hash) {
+			skipped++
+			continue
+		}
+
+		prefix, suffix := hash[:password.RangePrefixLength], hash[password.RangePrefixLength:]
+
+		if ranges[prefix] == nil {
+			ranges[prefix] = map[string]string{}
+		}
+
+		ranges[prefix][suffix] = count
+	}
+
+	if err := scanner.Err(); err != nil {
+		log.Fatalf("failed to read input: %v", err)
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		log.Fatalf("failed to create output directory: %v", err)
+	}
+
+	for prefix, suffixes := range ranges {
+		if err := writeRange(filepath.Join(*out, prefix+".txt"), suffixes); err != nil {
+			log.Fatalf("failed to write range %s: %v", prefix, err)
+		}
+	}
+
+	log.Printf("wrote %d range files to %s, skipped %d invalid lines", len(ranges), *out, skipped)
+}
+
+// writeRange merges with an existing range file so several lists can be combined.
+func writeRange(path string, suffixes map[string]string) error {
+	if existing, err := os.ReadFile(path); err == nil {
+		for _, line := range strings.Split(string(existing), "\n") {
+			if suffix, count, ok := strings.Cut(strings.TrimSpace(line), ":"); ok {
+				if _, seen := suffixes[suffix]; !seen {
+					suffixes[suffix] = count
+				}
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(suffixes))
+	for suffix := range suffixes {
+		keys = append(keys, suffix)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, suffix := range keys {
+		fmt.Fprintf(&b, "%s:%s\n", suffix, suffixes[suffix])
+	}
+
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/jacoobjake/einvoice-api/config"
 	"github.com/jacoobjake/einvoice-api/internal/database/seeders"
+	"github.com/jacoobjake/einvoice-api/pkg/password"
 	"github.com/stephenafamo/bob"
 )
 
@@ -19,9 +20,20 @@ func main() {
 
 	defer db.Close()
 
+	hasher, err := password.NewHasher(cfg.PasswordConfig)
+
+	if err != nil {
+		log.Fatalf("Failed to initialize password hasher: %v", err)
+	}
+
 	log.Println("Seeding users...")
-	if err := seeders.SeedUsers(&db); err != nil {
+	if err := seeders.SeedUsers(&db, hasher); err != nil {
 		log.Fatalf("Failed to seed users: %v", err)
 	}
+
+	log.Println("Seeding organisations...")
+	if err := seeders.SeedOrganisations(&db); err != nil {
+		log.Fatalf("Failed to seed organisations: %v", err)
+	}
 	log.Println("Seeding completed.")
 }
@@ -4,20 +4,68 @@ import (
 	"github.com/jacoobjake/einvoice-api/pkg/env"
 )
 
+// Email verification policies, see EMAIL_VERIFICATION_POLICY.
+const (
+	// Unverified users have full access
+	EmailVerificationOptional = "optional"
+	// Unverified users can log in but cannot issue invoices
+	EmailVerificationRestrict = "restrict"
+	// Unverified users cannot log in
+	EmailVerificationBlock = "block"
+)
+
 type AuthConfig struct {
 	JWTSecret              string
+	JWTKeysDir             string
+	JWTSigningKeyID        string
 	RefreshTokenSecret     string
 	TokenExpirationMin     int
 	RefreshExpirationMin   int
 	MaxFailedLoginAttempts int
+	MaxFailedLoginsPerIP   int
+	LoginWindowMin         int
+	LoginLockoutBaseSec    int
+	LoginLockoutMaxMin     int
+	PasswordResetExpMin    int
+	PasswordResetURL       string
+	EmailVerifyPolicy      string
+	EmailVerifyExpMin      int
+	EmailVerifyURL         string
+	EmailVerifyResendSec   int
+	EmailChangeURL         string
+	MFAEncryptionKey       string
+	MFAChallengeExpMin     int
+	ClientTokenExpMin      int
+	TokenDenylistDriver    string
+	InvitationExpHours     int
+	InvitationURL          string
 }
 
 func LoadAuthConfig() *AuthConfig {
 	return &AuthConfig{
 		JWTSecret:              env.GetEnv("JWT_SECRET", "default_jwt_secret"),
+		JWTKeysDir:             env.GetEnv("JWT_KEYS_DIR", ""),
+		JWTSigningKeyID:        env.GetEnv("JWT_SIGNING_KEY_ID", ""),
 		RefreshTokenSecret:     env.GetEnv("REFRESH_TOKEN_SECRET", "default_refresh_token_secret"),
 		TokenExpirationMin:     env.GetEnvAsInt("TOKEN_EXPIRATION_MIN", 15),
 		RefreshExpirationMin:   env.GetEnvAsInt("REFRESH_EXPIRATION_MIN", 24*60),
 		MaxFailedLoginAttempts: env.GetEnvAsInt("MAX_FAILED_LOGIN_ATTEMPTS", 5),
+		MaxFailedLoginsPerIP:   env.GetEnvAsInt("MAX_FAILED_LOGINS_PER_IP", 20),
+		LoginWindowMin:         env.GetEnvAsInt("LOGIN_WINDOW_MIN", 60),
+		LoginLockoutBaseSec:    env.GetEnvAsInt("LOGIN_LOCKOUT_BASE_SEC", 60),
+		LoginLockoutMaxMin:     env.GetEnvAsInt("LOGIN_LOCKOUT_MAX_MIN", 30),
+		PasswordResetExpMin:    env.GetEnvAsInt("PASSWORD_RESET_EXPIRATION_MIN", 30),
+		PasswordResetURL:       env.GetEnv("PASSWORD_RESET_URL", "http://localhost:3000/reset-password"),
+		EmailVerifyPolicy:      env.GetEnv("EMAIL_VERIFICATION_POLICY", EmailVerificationRestrict),
+		EmailVerifyExpMin:      env.GetEnvAsInt("EMAIL_VERIFICATION_EXPIRATION_MIN", 24*60),
+		EmailVerifyURL:         env.GetEnv("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email"),
+		EmailVerifyResendSec:   env.GetEnvAsInt("EMAIL_VERIFICATION_RESEND_COOLDOWN_SEC", 60),
+		EmailChangeURL:         env.GetEnv("EMAIL_CHANGE_URL", "http://localhost:3000/confirm-email"),
+		MFAEncryptionKey:       env.GetEnv("MFA_ENCRYPTION_KEY", "default_mfa_encryption_key"),
+		MFAChallengeExpMin:     env.GetEnvAsInt("MFA_CHALLENGE_EXPIRATION_MIN", 5),
+		ClientTokenExpMin:      env.GetEnvAsInt("OAUTH_CLIENT_TOKEN_EXPIRATION_MIN", 60),
+		TokenDenylistDriver:    env.GetEnv("TOKEN_DENYLIST_DRIVER", "redis"),
+		InvitationExpHours:     env.GetEnvAsInt("INVITATION_EXPIRATION_HOURS", 72),
+		InvitationURL:          env.GetEnv("INVITATION_URL", "http://localhost:3000/accept-invitation"),
 	}
 }
@@ -3,17 +3,27 @@ package config
 import (
 	"github.com/jacoobjake/einvoice-api/config/auth"
 	"github.com/jacoobjake/einvoice-api/config/database"
+	"github.com/jacoobjake/einvoice-api/config/mail"
+	"github.com/jacoobjake/einvoice-api/config/oidc"
+	"github.com/jacoobjake/einvoice-api/config/password"
+	"github.com/jacoobjake/einvoice-api/config/ratelimit"
 	"github.com/jacoobjake/einvoice-api/config/redis"
 	pkgEnv "github.com/jacoobjake/einvoice-api/pkg/env"
 )
 
 type Config struct {
-	AppName     string
-	Port        string
-	Env         string
-	DBConfig    *database.DBConfig
-	AuthConfig  *auth.AuthConfig
-	RedisConfig *redis.RedisConfig
+	AppName         string
+	Port            string
+	Env             string
+	DBConfig        *database.DBConfig
+	AuthConfig      *auth.AuthConfig
+	RedisConfig     *redis.RedisConfig
+	MailConfig      *mail.MailConfig
+	RateLimitConfig *ratelimit.RateLimitConfig
+	OIDCConfig      *oidc.OIDCConfig
+	PasswordConfig  *password.PasswordConfig
+	// Proxies allowed to set X-Forwarded-For, none by default so the client ip is the peer address
+	TrustedProxies []string
 }
 
 func Load() *Config {
@@ -22,14 +32,23 @@ func Load() *Config {
 	DBConfig := database.LoadDBConfig()
 	AuthConfig := auth.LoadAuthConfig()
 	RedisConfig := redis.LoadRedisConfig()
+	MailConfig := mail.LoadMailConfig()
+	RateLimitConfig := ratelimit.LoadRateLimitConfig()
+	OIDCConfig := oidc.LoadOIDCConfig()
+	PasswordConfig := password.LoadPasswordConfig()
 
 	cfg := &Config{
-		AppName:     pkgEnv.GetEnv("APP_NAME", "MyApp"),
-		Port:        pkgEnv.GetEnv("PORT", "8080"),
-		DBConfig:    DBConfig,
-		AuthConfig:  AuthConfig,
-		RedisConfig: RedisConfig,
-		Env:         env,
+		AppName:         pkgEnv.GetEnv("APP_NAME", "MyApp"),
+		Port:            pkgEnv.GetEnv("PORT", "8080"),
+		DBConfig:        DBConfig,
+		AuthConfig:      AuthConfig,
+		RedisConfig:     RedisConfig,
+		MailConfig:      MailConfig,
+		RateLimitConfig: RateLimitConfig,
+		OIDCConfig:      OIDCConfig,
+		PasswordConfig:  PasswordConfig,
+		Env:             env,
+		TrustedProxies:  pkgEnv.GetEnvAsSlice("TRUSTED_PROXIES", nil),
 	}
 
 	return cfg
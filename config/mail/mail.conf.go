@@ -0,0 +1,17 @@
+package mail
+
+import "github.com/jacoobjake/einvoice-api/pkg/env"
+
+type MailConfig struct {
+	Driver  string
+	From    string
+	FileDir string
+}
+
+func LoadMailConfig() *MailConfig {
+	return &MailConfig{
+		Driver:  env.GetEnv("MAIL_DRIVER", "log"),
+		From:    env.GetEnv("MAIL_FROM", "no-reply@localhost"),
+		FileDir: env.GetEnv("MAIL_FILE_DIR", "storage/mail"),
+	}
+}
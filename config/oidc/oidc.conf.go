@@ -0,0 +1,56 @@
+package oidc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jacoobjake/einvoice-api/pkg/env"
+)
+
+// OIDCProvider is a single identity provider, configured through OIDC_<NAME>_* variables.
+type OIDCProvider struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// Where the provider sends the user back to, usually the frontend which posts the code to the API
+	RedirectURL string
+	Scopes      []string
+	// Only accounts with an email on one of these domains may sign in, empty allows any domain
+	AllowedDomains []string
+	// Create a user on first sign in instead of requiring an existing account
+	AutoProvision bool
+	// Some providers, such as Azure AD, omit the email_verified claim. Turning this off only lets them
+	// create new accounts, existing accounts are never linked to an email the provider did not verify
+	RequireVerifiedEmail bool
+}
+
+type OIDCConfig struct {
+	Providers   map[string]*OIDCProvider
+	StateExpMin int
+}
+
+func LoadOIDCConfig() *OIDCConfig {
+	providers := map[string]*OIDCProvider{}
+
+	for _, name := range env.GetEnvAsSlice("OIDC_PROVIDERS", []string{}) {
+		prefix := fmt.Sprintf("OIDC_%s_", strings.ToUpper(name))
+
+		providers[name] = &OIDCProvider{
+			Name:                 name,
+			Issuer:               env.GetEnv(prefix+"ISSUER", ""),
+			ClientID:             env.GetEnv(prefix+"CLIENT_ID", ""),
+			ClientSecret:         env.GetEnv(prefix+"CLIENT_SECRET", ""),
+			RedirectURL:          env.GetEnv(prefix+"REDIRECT_URL", ""),
+			Scopes:               env.GetEnvAsSlice(prefix+"SCOPES", []string{"openid", "email", "profile"}),
+			AllowedDomains:       env.GetEnvAsSlice(prefix+"ALLOWED_DOMAINS", []string{}),
+			AutoProvision:        env.GetEnvAsBool(prefix+"AUTO_PROVISION", false),
+			RequireVerifiedEmail: env.GetEnvAsBool(prefix+"REQUIRE_VERIFIED_EMAIL", true),
+		}
+	}
+
+	return &OIDCConfig{
+		Providers:   providers,
+		StateExpMin: env.GetEnvAsInt("OIDC_STATE_EXPIRATION_MIN", 10),
+	}
+}
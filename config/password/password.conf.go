@@ -0,0 +1,43 @@
+package password
+
+import "github.com/jacoobjake/einvoice-api/pkg/env"
+
+type PasswordConfig struct {
+	MinLength     int
+	MaxLength     int
+	RequireLower  bool
+	RequireUpper  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// Reject passwords containing the user's email or name
+	DisallowPersonalInfo bool
+	// Number of previous passwords that cannot be reused, 0 disables the check
+	HistorySize int
+	// Directory of SHA-1 hash-prefix range files, empty disables the breached password check
+	BreachedDir string
+	// argon2id or bcrypt, hashes of the other algorithm are upgraded on login
+	HashAlgorithm     string
+	BcryptCost        int
+	Argon2MemoryKiB   int
+	Argon2Iterations  int
+	Argon2Parallelism int
+}
+
+func LoadPasswordConfig() *PasswordConfig {
+	return &PasswordConfig{
+		MinLength:            env.GetEnvAsInt("PASSWORD_MIN_LENGTH", 8),
+		MaxLength:            env.GetEnvAsInt("PASSWORD_MAX_LENGTH", 64),
+		RequireLower:         env.GetEnvAsBool("PASSWORD_REQUIRE_LOWERCASE", true),
+		RequireUpper:         env.GetEnvAsBool("PASSWORD_REQUIRE_UPPERCASE", true),
+		RequireDigit:         env.GetEnvAsBool("PASSWORD_REQUIRE_DIGIT", true),
+		RequireSymbol:        env.GetEnvAsBool("PASSWORD_REQUIRE_SYMBOL", true),
+		DisallowPersonalInfo: env.GetEnvAsBool("PASSWORD_DISALLOW_PERSONAL_INFO", true),
+		HistorySize:          env.GetEnvAsInt("PASSWORD_HISTORY_SIZE", 5),
+		BreachedDir:          env.GetEnv("PASSWORD_BREACHED_DIR", ""),
+		HashAlgorithm:        env.GetEnv("PASSWORD_HASH_ALGORITHM", "argon2id"),
+		BcryptCost:           env.GetEnvAsInt("PASSWORD_BCRYPT_COST", 12),
+		Argon2MemoryKiB:      env.GetEnvAsInt("PASSWORD_ARGON2_MEMORY_KIB", 64*1024),
+		Argon2Iterations:     env.GetEnvAsInt("PASSWORD_ARGON2_ITERATIONS", 3),
+		Argon2Parallelism:    env.GetEnvAsInt("PASSWORD_ARGON2_PARALLELISM", 2),
+	}
+}
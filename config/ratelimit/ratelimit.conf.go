@@ -0,0 +1,21 @@
+package ratelimit
+
+import "github.com/jacoobjake/einvoice-api/pkg/env"
+
+type RateLimitConfig struct {
+	// Public auth endpoints, keyed by client ip
+	AuthRequests  int
+	AuthPeriodSec int
+	// Authenticated endpoints, keyed by user
+	APIRequests  int
+	APIPeriodSec int
+}
+
+func LoadRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		AuthRequests:  env.GetEnvAsInt("RATE_LIMIT_AUTH_REQUESTS", 10),
+		AuthPeriodSec: env.GetEnvAsInt("RATE_LIMIT_AUTH_PERIOD_SEC", 60),
+		APIRequests:   env.GetEnvAsInt("RATE_LIMIT_API_REQUESTS", 300),
+		APIPeriodSec:  env.GetEnvAsInt("RATE_LIMIT_API_PERIOD_SEC", 60),
+	}
+}
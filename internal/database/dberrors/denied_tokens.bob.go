@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var DeniedTokenErrors = &deniedTokenErrors{
+	ErrUniqueDeniedTokensPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "denied_tokens",
+		columns: []string{"jti"},
+		s:       "denied_tokens_pkey",
+	},
+}
+
+type deniedTokenErrors struct {
+	ErrUniqueDeniedTokensPkey *UniqueConstraintError
+}
@@ -0,0 +1,26 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var InvitationErrors = &invitationErrors{
+	ErrUniqueInvitationsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "invitations",
+		columns: []string{"id"},
+		s:       "invitations_pkey",
+	},
+
+	ErrUniqueInvitationsTokenKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "invitations",
+		columns: []string{"token"},
+		s:       "invitations_token_key",
+	},
+}
+
+type invitationErrors struct {
+	ErrUniqueInvitationsPkey *UniqueConstraintError
+
+	ErrUniqueInvitationsTokenKey *UniqueConstraintError
+}
@@ -0,0 +1,108 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	factory "github.com/jacoobjake/einvoice-api/internal/database/factory"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/stephenafamo/bob"
+)
+
+func TestInvitationUniqueConstraintErrors(t *testing.T) {
+	if testDB == nil {
+		t.Skip("No database connection provided")
+	}
+
+	f := factory.New()
+	tests := []struct {
+		name         string
+		expectedErr  *UniqueConstraintError
+		conflictMods func(context.Context, *testing.T, bob.Executor, *models.Invitation) factory.InvitationModSlice
+	}{
+		{
+			name:        "ErrUniqueInvitationsPkey",
+			expectedErr: InvitationErrors.ErrUniqueInvitationsPkey,
+			conflictMods: func(ctx context.Context, t *testing.T, exec bob.Executor, obj *models.Invitation) factory.InvitationModSlice {
+				shouldUpdate := false
+				updateMods := make(factory.InvitationModSlice, 0, 1)
+
+				if shouldUpdate {
+					if err := obj.Update(ctx, exec, f.NewInvitationWithContext(ctx, updateMods...).BuildSetter()); err != nil {
+						t.Fatalf("Error updating object: %v", err)
+					}
+				}
+
+				return factory.InvitationModSlice{
+					factory.InvitationMods.ID(obj.ID),
+				}
+			},
+		},
+		{
+			name:        "ErrUniqueInvitationsTokenKey",
+			expectedErr: InvitationErrors.ErrUniqueInvitationsTokenKey,
+			conflictMods: func(ctx context.Context, t *testing.T, exec bob.Executor, obj *models.Invitation) factory.InvitationModSlice {
+				shouldUpdate := false
+				updateMods := make(factory.InvitationModSlice, 0, 1)
+
+				if shouldUpdate {
+					if err := obj.Update(ctx, exec, f.NewInvitationWithContext(ctx, updateMods...).BuildSetter()); err != nil {
+						t.Fatalf("Error updating object: %v", err)
+					}
+				}
+
+				return factory.InvitationModSlice{
+					factory.InvitationMods.Token(obj.Token),
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(t.Context())
+			t.Cleanup(cancel)
+
+			tx, err := testDB.Begin(ctx)
+			if err != nil {
+				t.Fatalf("Couldn't start database transaction: %v", err)
+			}
+
+			defer func() {
+				if err := tx.Rollback(ctx); err != nil {
+					t.Fatalf("Error rolling back transaction: %v", err)
+				}
+			}()
+
+			var exec bob.Executor = tx
+
+			obj, err := f.NewInvitationWithContext(ctx, factory.InvitationMods.WithParentsCascading()).Create(ctx, exec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			obj2, err := f.NewInvitationWithContext(ctx).Create(ctx, exec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = obj2.Update(ctx, exec, f.NewInvitationWithContext(ctx, tt.conflictMods(ctx, t, exec, obj)...).BuildSetter())
+			if !errors.Is(ErrUniqueConstraint, err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.name, err)
+			}
+			if !errors.Is(tt.expectedErr, err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.expectedErr.Error(), err)
+			}
+			if !ErrUniqueConstraint.Is(err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.name, err)
+			}
+			if !tt.expectedErr.Is(err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.expectedErr.Error(), err)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var InvoiceAllowanceChargeErrors = &invoiceAllowanceChargeErrors{
+	ErrUniqueInvoiceAllowanceChargesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "invoice_allowance_charges",
+		columns: []string{"id"},
+		s:       "invoice_allowance_charges_pkey",
+	},
+}
+
+type invoiceAllowanceChargeErrors struct {
+	ErrUniqueInvoiceAllowanceChargesPkey *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var InvoiceLineTaxBreakdownErrors = &invoiceLineTaxBreakdownErrors{
+	ErrUniqueInvoiceLineTaxBreakdownsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "invoice_line_tax_breakdowns",
+		columns: []string{"id"},
+		s:       "invoice_line_tax_breakdowns_pkey",
+	},
+}
+
+type invoiceLineTaxBreakdownErrors struct {
+	ErrUniqueInvoiceLineTaxBreakdownsPkey *UniqueConstraintError
+}
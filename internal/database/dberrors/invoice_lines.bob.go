@@ -0,0 +1,26 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var InvoiceLineErrors = &invoiceLineErrors{
+	ErrUniqueInvoiceLinesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "invoice_lines",
+		columns: []string{"id"},
+		s:       "invoice_lines_pkey",
+	},
+
+	ErrUniqueInvoiceLinesInvoiceIdLineNumberKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "invoice_lines",
+		columns: []string{"invoice_id", "line_number"},
+		s:       "invoice_lines_invoice_id_line_number_key",
+	},
+}
+
+type invoiceLineErrors struct {
+	ErrUniqueInvoiceLinesPkey *UniqueConstraintError
+
+	ErrUniqueInvoiceLinesInvoiceIdLineNumberKey *UniqueConstraintError
+}
@@ -0,0 +1,26 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var InvoicePartyErrors = &invoicePartyErrors{
+	ErrUniqueInvoicePartiesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "invoice_parties",
+		columns: []string{"id"},
+		s:       "invoice_parties_pkey",
+	},
+
+	ErrUniqueInvoicePartiesInvoiceIdRoleKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "invoice_parties",
+		columns: []string{"invoice_id", "role"},
+		s:       "invoice_parties_invoice_id_role_key",
+	},
+}
+
+type invoicePartyErrors struct {
+	ErrUniqueInvoicePartiesPkey *UniqueConstraintError
+
+	ErrUniqueInvoicePartiesInvoiceIdRoleKey *UniqueConstraintError
+}
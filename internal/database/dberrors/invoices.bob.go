@@ -0,0 +1,35 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var InvoiceErrors = &invoiceErrors{
+	ErrUniqueInvoicesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "invoices",
+		columns: []string{"id"},
+		s:       "invoices_pkey",
+	},
+
+	ErrUniqueInvoicesOrganisationIdInvoiceNumberKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "invoices",
+		columns: []string{"organisation_id", "invoice_number"},
+		s:       "invoices_organisation_id_invoice_number_key",
+	},
+
+	ErrUniqueInvoicesUuidKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "invoices",
+		columns: []string{"uuid"},
+		s:       "invoices_uuid_key",
+	},
+}
+
+type invoiceErrors struct {
+	ErrUniqueInvoicesPkey *UniqueConstraintError
+
+	ErrUniqueInvoicesOrganisationIdInvoiceNumberKey *UniqueConstraintError
+
+	ErrUniqueInvoicesUuidKey *UniqueConstraintError
+}
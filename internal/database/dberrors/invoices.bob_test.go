@@ -0,0 +1,132 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	factory "github.com/jacoobjake/einvoice-api/internal/database/factory"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/stephenafamo/bob"
+)
+
+func TestInvoiceUniqueConstraintErrors(t *testing.T) {
+	if testDB == nil {
+		t.Skip("No database connection provided")
+	}
+
+	f := factory.New()
+	tests := []struct {
+		name         string
+		expectedErr  *UniqueConstraintError
+		conflictMods func(context.Context, *testing.T, bob.Executor, *models.Invoice) factory.InvoiceModSlice
+	}{
+		{
+			name:        "ErrUniqueInvoicesPkey",
+			expectedErr: InvoiceErrors.ErrUniqueInvoicesPkey,
+			conflictMods: func(ctx context.Context, t *testing.T, exec bob.Executor, obj *models.Invoice) factory.InvoiceModSlice {
+				shouldUpdate := false
+				updateMods := make(factory.InvoiceModSlice, 0, 1)
+
+				if shouldUpdate {
+					if err := obj.Update(ctx, exec, f.NewInvoiceWithContext(ctx, updateMods...).BuildSetter()); err != nil {
+						t.Fatalf("Error updating object: %v", err)
+					}
+				}
+
+				return factory.InvoiceModSlice{
+					factory.InvoiceMods.ID(obj.ID),
+				}
+			},
+		},
+		{
+			name:        "ErrUniqueInvoicesOrganisationIdInvoiceNumberKey",
+			expectedErr: InvoiceErrors.ErrUniqueInvoicesOrganisationIdInvoiceNumberKey,
+			conflictMods: func(ctx context.Context, t *testing.T, exec bob.Executor, obj *models.Invoice) factory.InvoiceModSlice {
+				shouldUpdate := false
+				updateMods := make(factory.InvoiceModSlice, 0, 2)
+
+				if shouldUpdate {
+					if err := obj.Update(ctx, exec, f.NewInvoiceWithContext(ctx, updateMods...).BuildSetter()); err != nil {
+						t.Fatalf("Error updating object: %v", err)
+					}
+				}
+
+				return factory.InvoiceModSlice{
+					factory.InvoiceMods.OrganisationID(obj.OrganisationID),
+					factory.InvoiceMods.InvoiceNumber(obj.InvoiceNumber),
+				}
+			},
+		},
+		{
+			name:        "ErrUniqueInvoicesUuidKey",
+			expectedErr: InvoiceErrors.ErrUniqueInvoicesUuidKey,
+			conflictMods: func(ctx context.Context, t *testing.T, exec bob.Executor, obj *models.Invoice) factory.InvoiceModSlice {
+				shouldUpdate := false
+				updateMods := make(factory.InvoiceModSlice, 0, 1)
+
+				if !obj.UUID.IsValue() {
+					shouldUpdate = true
+					updateMods = append(updateMods, factory.InvoiceMods.RandomUUIDNotNull(nil))
+				}
+
+				if shouldUpdate {
+					if err := obj.Update(ctx, exec, f.NewInvoiceWithContext(ctx, updateMods...).BuildSetter()); err != nil {
+						t.Fatalf("Error updating object: %v", err)
+					}
+				}
+
+				return factory.InvoiceModSlice{
+					factory.InvoiceMods.UUID(obj.UUID),
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(t.Context())
+			t.Cleanup(cancel)
+
+			tx, err := testDB.Begin(ctx)
+			if err != nil {
+				t.Fatalf("Couldn't start database transaction: %v", err)
+			}
+
+			defer func() {
+				if err := tx.Rollback(ctx); err != nil {
+					t.Fatalf("Error rolling back transaction: %v", err)
+				}
+			}()
+
+			var exec bob.Executor = tx
+
+			obj, err := f.NewInvoiceWithContext(ctx, factory.InvoiceMods.WithParentsCascading()).Create(ctx, exec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			obj2, err := f.NewInvoiceWithContext(ctx).Create(ctx, exec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = obj2.Update(ctx, exec, f.NewInvoiceWithContext(ctx, tt.conflictMods(ctx, t, exec, obj)...).BuildSetter())
+			if !errors.Is(ErrUniqueConstraint, err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.name, err)
+			}
+			if !errors.Is(tt.expectedErr, err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.expectedErr.Error(), err)
+			}
+			if !ErrUniqueConstraint.Is(err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.name, err)
+			}
+			if !tt.expectedErr.Is(err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.expectedErr.Error(), err)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var MfaRecoveryCodeErrors = &mfaRecoveryCodeErrors{
+	ErrUniqueMfaRecoveryCodesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "mfa_recovery_codes",
+		columns: []string{"id"},
+		s:       "mfa_recovery_codes_pkey",
+	},
+}
+
+type mfaRecoveryCodeErrors struct {
+	ErrUniqueMfaRecoveryCodesPkey *UniqueConstraintError
+}
@@ -0,0 +1,26 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var OauthClientErrors = &oauthClientErrors{
+	ErrUniqueOauthClientsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "oauth_clients",
+		columns: []string{"id"},
+		s:       "oauth_clients_pkey",
+	},
+
+	ErrUniqueOauthClientsClientIdKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "oauth_clients",
+		columns: []string{"client_id"},
+		s:       "oauth_clients_client_id_key",
+	},
+}
+
+type oauthClientErrors struct {
+	ErrUniqueOauthClientsPkey *UniqueConstraintError
+
+	ErrUniqueOauthClientsClientIdKey *UniqueConstraintError
+}
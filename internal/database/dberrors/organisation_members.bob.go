@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var OrganisationMemberErrors = &organisationMemberErrors{
+	ErrUniqueOrganisationMembersPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "organisation_members",
+		columns: []string{"organisation_id", "user_id"},
+		s:       "organisation_members_pkey",
+	},
+}
+
+type organisationMemberErrors struct {
+	ErrUniqueOrganisationMembersPkey *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var OrganisationErrors = &organisationErrors{
+	ErrUniqueOrganisationsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "organisations",
+		columns: []string{"id"},
+		s:       "organisations_pkey",
+	},
+}
+
+type organisationErrors struct {
+	ErrUniqueOrganisationsPkey *UniqueConstraintError
+}
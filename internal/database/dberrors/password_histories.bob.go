@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var PasswordHistoryErrors = &passwordHistoryErrors{
+	ErrUniquePasswordHistoriesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "password_histories",
+		columns: []string{"id"},
+		s:       "password_histories_pkey",
+	},
+}
+
+type passwordHistoryErrors struct {
+	ErrUniquePasswordHistoriesPkey *UniqueConstraintError
+}
@@ -0,0 +1,26 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var PermissionErrors = &permissionErrors{
+	ErrUniquePermissionsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "permissions",
+		columns: []string{"id"},
+		s:       "permissions_pkey",
+	},
+
+	ErrUniquePermissionsNameKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "permissions",
+		columns: []string{"name"},
+		s:       "permissions_name_key",
+	},
+}
+
+type permissionErrors struct {
+	ErrUniquePermissionsPkey *UniqueConstraintError
+
+	ErrUniquePermissionsNameKey *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var RolePermissionErrors = &rolePermissionErrors{
+	ErrUniqueRolePermissionsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "role_permissions",
+		columns: []string{"role_id", "permission_id"},
+		s:       "role_permissions_pkey",
+	},
+}
+
+type rolePermissionErrors struct {
+	ErrUniqueRolePermissionsPkey *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var SecurityEventErrors = &securityEventErrors{
+	ErrUniqueSecurityEventsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "security_events",
+		columns: []string{"id"},
+		s:       "security_events_pkey",
+	},
+}
+
+type securityEventErrors struct {
+	ErrUniqueSecurityEventsPkey *UniqueConstraintError
+}
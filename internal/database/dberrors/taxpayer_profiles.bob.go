@@ -0,0 +1,26 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var TaxpayerProfileErrors = &taxpayerProfileErrors{
+	ErrUniqueTaxpayerProfilesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "taxpayer_profiles",
+		columns: []string{"id"},
+		s:       "taxpayer_profiles_pkey",
+	},
+
+	ErrUniqueTaxpayerProfilesOrganisationIdKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "taxpayer_profiles",
+		columns: []string{"organisation_id"},
+		s:       "taxpayer_profiles_organisation_id_key",
+	},
+}
+
+type taxpayerProfileErrors struct {
+	ErrUniqueTaxpayerProfilesPkey *UniqueConstraintError
+
+	ErrUniqueTaxpayerProfilesOrganisationIdKey *UniqueConstraintError
+}
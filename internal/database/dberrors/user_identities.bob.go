@@ -0,0 +1,26 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var UserIdentityErrors = &userIdentityErrors{
+	ErrUniqueUserIdentitiesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "user_identities",
+		columns: []string{"id"},
+		s:       "user_identities_pkey",
+	},
+
+	ErrUniqueUserIdentitiesProviderSubjectKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "user_identities",
+		columns: []string{"provider", "subject"},
+		s:       "user_identities_provider_subject_key",
+	},
+}
+
+type userIdentityErrors struct {
+	ErrUniqueUserIdentitiesPkey *UniqueConstraintError
+
+	ErrUniqueUserIdentitiesProviderSubjectKey *UniqueConstraintError
+}
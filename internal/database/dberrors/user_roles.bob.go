@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var UserRoleErrors = &userRoleErrors{
+	ErrUniqueUserRolesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "user_roles",
+		columns: []string{"user_id", "role_id"},
+		s:       "user_roles_pkey",
+	},
+}
+
+type userRoleErrors struct {
+	ErrUniqueUserRolesPkey *UniqueConstraintError
+}
@@ -0,0 +1,274 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var APIKeys = Table[
+	apiKeyColumns,
+	apiKeyIndexes,
+	apiKeyForeignKeys,
+	apiKeyUniques,
+	apiKeyChecks,
+]{
+	Schema: "",
+	Name:   "api_keys",
+	Columns: apiKeyColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('api_keys_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		OrganisationID: column{
+			Name:      "organisation_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Name: column{
+			Name:      "name",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Prefix: column{
+			Name:      "prefix",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		KeyHash: column{
+			Name:      "key_hash",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Scopes: column{
+			Name:      "scopes",
+			DBType:    "text[]",
+			Default:   "'{}'::text[]",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ExpireAt: column{
+			Name:      "expire_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		LastUsedAt: column{
+			Name:      "last_used_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RevokedAt: column{
+			Name:      "revoked_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UpdatedAt: column{
+			Name:      "updated_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: apiKeyIndexes{
+		APIKeysPkey: index{
+			Type: "btree",
+			Name: "api_keys_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		APIKeysPrefixKey: index{
+			Type: "btree",
+			Name: "api_keys_prefix_key",
+			Columns: []indexColumn{
+				{
+					Name:         "prefix",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxAPIKeysOrganisationID: index{
+			Type: "btree",
+			Name: "idx_api_keys_organisation_id",
+			Columns: []indexColumn{
+				{
+					Name:         "organisation_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "api_keys_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: apiKeyForeignKeys{
+		APIKeysAPIKeysOrganisationIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "api_keys.api_keys_organisation_id_fkey",
+				Columns: []string{"organisation_id"},
+				Comment: "",
+			},
+			ForeignTable:   "organisations",
+			ForeignColumns: []string{"id"},
+		},
+		APIKeysAPIKeysUserIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "api_keys.api_keys_user_id_fkey",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: apiKeyUniques{
+		APIKeysPrefixKey: constraint{
+			Name:    "api_keys_prefix_key",
+			Columns: []string{"prefix"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type apiKeyColumns struct {
+	ID             column
+	OrganisationID column
+	UserID         column
+	Name           column
+	Prefix         column
+	KeyHash        column
+	Scopes         column
+	ExpireAt       column
+	LastUsedAt     column
+	RevokedAt      column
+	CreatedAt      column
+	UpdatedAt      column
+}
+
+func (c apiKeyColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.OrganisationID, c.UserID, c.Name, c.Prefix, c.KeyHash, c.Scopes, c.ExpireAt, c.LastUsedAt, c.RevokedAt, c.CreatedAt, c.UpdatedAt,
+	}
+}
+
+type apiKeyIndexes struct {
+	APIKeysPkey              index
+	APIKeysPrefixKey         index
+	IdxAPIKeysOrganisationID index
+}
+
+func (i apiKeyIndexes) AsSlice() []index {
+	return []index{
+		i.APIKeysPkey, i.APIKeysPrefixKey, i.IdxAPIKeysOrganisationID,
+	}
+}
+
+type apiKeyForeignKeys struct {
+	APIKeysAPIKeysOrganisationIDFkey foreignKey
+	APIKeysAPIKeysUserIDFkey         foreignKey
+}
+
+func (f apiKeyForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.APIKeysAPIKeysOrganisationIDFkey, f.APIKeysAPIKeysUserIDFkey,
+	}
+}
+
+type apiKeyUniques struct {
+	APIKeysPrefixKey constraint
+}
+
+func (u apiKeyUniques) AsSlice() []constraint {
+	return []constraint{
+		u.APIKeysPrefixKey,
+	}
+}
+
+type apiKeyChecks struct{}
+
+func (c apiKeyChecks) AsSlice() []check {
+	return []check{}
+}
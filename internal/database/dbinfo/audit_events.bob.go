@@ -0,0 +1,304 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var AuditEvents = Table[
+	auditEventColumns,
+	auditEventIndexes,
+	auditEventForeignKeys,
+	auditEventUniques,
+	auditEventChecks,
+]{
+	Schema: "",
+	Name:   "audit_events",
+	Columns: auditEventColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('audit_events_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ActorType: column{
+			Name:      "actor_type",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ActorID: column{
+			Name:      "actor_id",
+			DBType:    "bigint",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ActorLabel: column{
+			Name:      "actor_label",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		OrganisationID: column{
+			Name:      "organisation_id",
+			DBType:    "bigint",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Action: column{
+			Name:      "action",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		EntityType: column{
+			Name:      "entity_type",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		EntityID: column{
+			Name:      "entity_id",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		IPAddress: column{
+			Name:      "ip_address",
+			DBType:    "inet",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserAgent: column{
+			Name:      "user_agent",
+			DBType:    "text",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RequestID: column{
+			Name:      "request_id",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Changes: column{
+			Name:      "changes",
+			DBType:    "jsonb",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Metadata: column{
+			Name:      "metadata",
+			DBType:    "jsonb",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: auditEventIndexes{
+		AuditEventsPkey: index{
+			Type: "btree",
+			Name: "audit_events_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxAuditEventsAction: index{
+			Type: "btree",
+			Name: "idx_audit_events_action",
+			Columns: []indexColumn{
+				{
+					Name:         "action",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxAuditEventsActor: index{
+			Type: "btree",
+			Name: "idx_audit_events_actor",
+			Columns: []indexColumn{
+				{
+					Name:         "actor_type",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "actor_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxAuditEventsCreatedAt: index{
+			Type: "btree",
+			Name: "idx_audit_events_created_at",
+			Columns: []indexColumn{
+				{
+					Name:         "created_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxAuditEventsEntity: index{
+			Type: "btree",
+			Name: "idx_audit_events_entity",
+			Columns: []indexColumn{
+				{
+					Name:         "entity_type",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "entity_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "audit_events_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+
+	Comment: "",
+}
+
+type auditEventColumns struct {
+	ID             column
+	ActorType      column
+	ActorID        column
+	ActorLabel     column
+	OrganisationID column
+	Action         column
+	EntityType     column
+	EntityID       column
+	IPAddress      column
+	UserAgent      column
+	RequestID      column
+	Changes        column
+	Metadata       column
+	CreatedAt      column
+}
+
+func (c auditEventColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.ActorType, c.ActorID, c.ActorLabel, c.OrganisationID, c.Action, c.EntityType, c.EntityID, c.IPAddress, c.UserAgent, c.RequestID, c.Changes, c.Metadata, c.CreatedAt,
+	}
+}
+
+type auditEventIndexes struct {
+	AuditEventsPkey         index
+	IdxAuditEventsAction    index
+	IdxAuditEventsActor     index
+	IdxAuditEventsCreatedAt index
+	IdxAuditEventsEntity    index
+}
+
+func (i auditEventIndexes) AsSlice() []index {
+	return []index{
+		i.AuditEventsPkey, i.IdxAuditEventsAction, i.IdxAuditEventsActor, i.IdxAuditEventsCreatedAt, i.IdxAuditEventsEntity,
+	}
+}
+
+type auditEventForeignKeys struct{}
+
+func (f auditEventForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{}
+}
+
+type auditEventUniques struct{}
+
+func (u auditEventUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type auditEventChecks struct{}
+
+func (c auditEventChecks) AsSlice() []check {
+	return []check{}
+}
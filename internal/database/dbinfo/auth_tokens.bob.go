@@ -87,6 +87,42 @@ var AuthTokens = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		RotatedAt: column{
+			Name:      "rotated_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RevokedAt: column{
+			Name:      "revoked_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		IPAddress: column{
+			Name:      "ip_address",
+			DBType:    "inet",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserAgent: column{
+			Name:      "user_agent",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: authTokenIndexes{
 		AuthTokensPkey: index{
@@ -106,6 +142,23 @@ var AuthTokens = Table[
 			Where:         "",
 			Include:       []string{},
 		},
+		IdxAuthTokensSessionID: index{
+			Type: "btree",
+			Name: "idx_auth_tokens_session_id",
+			Columns: []indexColumn{
+				{
+					Name:         "session_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
 	},
 	PrimaryKey: &constraint{
 		Name:    "auth_tokens_pkey",
@@ -136,21 +189,26 @@ type authTokenColumns struct {
 	CreatedAt column
 	UpdatedAt column
 	SessionID column
+	RotatedAt column
+	RevokedAt column
+	IPAddress column
+	UserAgent column
 }
 
 func (c authTokenColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.UserID, c.Type, c.Token, c.ExpireAt, c.CreatedAt, c.UpdatedAt, c.SessionID,
+		c.ID, c.UserID, c.Type, c.Token, c.ExpireAt, c.CreatedAt, c.UpdatedAt, c.SessionID, c.RotatedAt, c.RevokedAt, c.IPAddress, c.UserAgent,
 	}
 }
 
 type authTokenIndexes struct {
-	AuthTokensPkey index
+	AuthTokensPkey         index
+	IdxAuthTokensSessionID index
 }
 
 func (i authTokenIndexes) AsSlice() []index {
 	return []index{
-		i.AuthTokensPkey,
+		i.AuthTokensPkey, i.IdxAuthTokensSessionID,
 	}
 }
 
@@ -0,0 +1,130 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var DeniedTokens = Table[
+	deniedTokenColumns,
+	deniedTokenIndexes,
+	deniedTokenForeignKeys,
+	deniedTokenUniques,
+	deniedTokenChecks,
+]{
+	Schema: "",
+	Name:   "denied_tokens",
+	Columns: deniedTokenColumns{
+		Jti: column{
+			Name:      "jti",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ExpiresAt: column{
+			Name:      "expires_at",
+			DBType:    "timestamp with time zone",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: deniedTokenIndexes{
+		DeniedTokensPkey: index{
+			Type: "btree",
+			Name: "denied_tokens_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "jti",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxDeniedTokensExpiresAt: index{
+			Type: "btree",
+			Name: "idx_denied_tokens_expires_at",
+			Columns: []indexColumn{
+				{
+					Name:         "expires_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "denied_tokens_pkey",
+		Columns: []string{"jti"},
+		Comment: "",
+	},
+
+	Comment: "",
+}
+
+type deniedTokenColumns struct {
+	Jti       column
+	ExpiresAt column
+	CreatedAt column
+}
+
+func (c deniedTokenColumns) AsSlice() []column {
+	return []column{
+		c.Jti, c.ExpiresAt, c.CreatedAt,
+	}
+}
+
+type deniedTokenIndexes struct {
+	DeniedTokensPkey         index
+	IdxDeniedTokensExpiresAt index
+}
+
+func (i deniedTokenIndexes) AsSlice() []index {
+	return []index{
+		i.DeniedTokensPkey, i.IdxDeniedTokensExpiresAt,
+	}
+}
+
+type deniedTokenForeignKeys struct{}
+
+func (f deniedTokenForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{}
+}
+
+type deniedTokenUniques struct{}
+
+func (u deniedTokenUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type deniedTokenChecks struct{}
+
+func (c deniedTokenChecks) AsSlice() []check {
+	return []check{}
+}
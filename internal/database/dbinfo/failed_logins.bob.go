@@ -27,9 +27,9 @@ var FailedLogins = Table[
 		UserID: column{
 			Name:      "user_id",
 			DBType:    "bigint",
-			Default:   "",
+			Default:   "NULL",
 			Comment:   "",
-			Nullable:  false,
+			Nullable:  true,
 			Generated: false,
 			AutoIncr:  false,
 		},
@@ -70,6 +70,50 @@ var FailedLogins = Table[
 			Where:         "",
 			Include:       []string{},
 		},
+		IdxFailedLoginsIPAddressAttemptedAt: index{
+			Type: "btree",
+			Name: "idx_failed_logins_ip_address_attempted_at",
+			Columns: []indexColumn{
+				{
+					Name:         "ip_address",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "attempted_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxFailedLoginsUserIDAttemptedAt: index{
+			Type: "btree",
+			Name: "idx_failed_logins_user_id_attempted_at",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "attempted_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
 	},
 	PrimaryKey: &constraint{
 		Name:    "failed_logins_pkey",
@@ -105,12 +149,14 @@ func (c failedLoginColumns) AsSlice() []column {
 }
 
 type failedLoginIndexes struct {
-	FailedLoginsPkey index
+	FailedLoginsPkey                    index
+	IdxFailedLoginsIPAddressAttemptedAt index
+	IdxFailedLoginsUserIDAttemptedAt    index
 }
 
 func (i failedLoginIndexes) AsSlice() []index {
 	return []index{
-		i.FailedLoginsPkey,
+		i.FailedLoginsPkey, i.IdxFailedLoginsIPAddressAttemptedAt, i.IdxFailedLoginsUserIDAttemptedAt,
 	}
 }
 
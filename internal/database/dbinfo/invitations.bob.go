@@ -0,0 +1,274 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Invitations = Table[
+	invitationColumns,
+	invitationIndexes,
+	invitationForeignKeys,
+	invitationUniques,
+	invitationChecks,
+]{
+	Schema: "",
+	Name:   "invitations",
+	Columns: invitationColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('invitations_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Email: column{
+			Name:      "email",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RoleID: column{
+			Name:      "role_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		InvitedBy: column{
+			Name:      "invited_by",
+			DBType:    "bigint",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Token: column{
+			Name:      "token",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ExpireAt: column{
+			Name:      "expire_at",
+			DBType:    "timestamp with time zone",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		AcceptedAt: column{
+			Name:      "accepted_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		AcceptedUserID: column{
+			Name:      "accepted_user_id",
+			DBType:    "bigint",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RevokedAt: column{
+			Name:      "revoked_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UpdatedAt: column{
+			Name:      "updated_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: invitationIndexes{
+		InvitationsPkey: index{
+			Type: "btree",
+			Name: "invitations_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxInvitationsOpenEmail: index{
+			Type: "btree",
+			Name: "idx_invitations_open_email",
+			Columns: []indexColumn{
+				{
+					Name:         "email",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "(accepted_at IS NULL AND revoked_at IS NULL)",
+			Include:       []string{},
+		},
+		InvitationsTokenKey: index{
+			Type: "btree",
+			Name: "invitations_token_key",
+			Columns: []indexColumn{
+				{
+					Name:         "token",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "invitations_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: invitationForeignKeys{
+		InvitationsInvitationsAcceptedUserIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "invitations.invitations_accepted_user_id_fkey",
+				Columns: []string{"accepted_user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+		InvitationsInvitationsInvitedByFkey: foreignKey{
+			constraint: constraint{
+				Name:    "invitations.invitations_invited_by_fkey",
+				Columns: []string{"invited_by"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+		InvitationsInvitationsRoleIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "invitations.invitations_role_id_fkey",
+				Columns: []string{"role_id"},
+				Comment: "",
+			},
+			ForeignTable:   "roles",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: invitationUniques{
+		InvitationsTokenKey: constraint{
+			Name:    "invitations_token_key",
+			Columns: []string{"token"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type invitationColumns struct {
+	ID             column
+	Email          column
+	RoleID         column
+	InvitedBy      column
+	Token          column
+	ExpireAt       column
+	AcceptedAt     column
+	AcceptedUserID column
+	RevokedAt      column
+	CreatedAt      column
+	UpdatedAt      column
+}
+
+func (c invitationColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.Email, c.RoleID, c.InvitedBy, c.Token, c.ExpireAt, c.AcceptedAt, c.AcceptedUserID, c.RevokedAt, c.CreatedAt, c.UpdatedAt,
+	}
+}
+
+type invitationIndexes struct {
+	InvitationsPkey         index
+	IdxInvitationsOpenEmail index
+	InvitationsTokenKey     index
+}
+
+func (i invitationIndexes) AsSlice() []index {
+	return []index{
+		i.InvitationsPkey, i.IdxInvitationsOpenEmail, i.InvitationsTokenKey,
+	}
+}
+
+type invitationForeignKeys struct {
+	InvitationsInvitationsAcceptedUserIDFkey foreignKey
+	InvitationsInvitationsInvitedByFkey      foreignKey
+	InvitationsInvitationsRoleIDFkey         foreignKey
+}
+
+func (f invitationForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.InvitationsInvitationsAcceptedUserIDFkey, f.InvitationsInvitationsInvitedByFkey, f.InvitationsInvitationsRoleIDFkey,
+	}
+}
+
+type invitationUniques struct {
+	InvitationsTokenKey constraint
+}
+
+func (u invitationUniques) AsSlice() []constraint {
+	return []constraint{
+		u.InvitationsTokenKey,
+	}
+}
+
+type invitationChecks struct{}
+
+func (c invitationChecks) AsSlice() []check {
+	return []check{}
+}
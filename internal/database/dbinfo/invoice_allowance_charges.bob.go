@@ -0,0 +1,205 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var InvoiceAllowanceCharges = Table[
+	invoiceAllowanceChargeColumns,
+	invoiceAllowanceChargeIndexes,
+	invoiceAllowanceChargeForeignKeys,
+	invoiceAllowanceChargeUniques,
+	invoiceAllowanceChargeChecks,
+]{
+	Schema: "",
+	Name:   "invoice_allowance_charges",
+	Columns: invoiceAllowanceChargeColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('invoice_allowance_charges_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		InvoiceID: column{
+			Name:      "invoice_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		InvoiceLineID: column{
+			Name:      "invoice_line_id",
+			DBType:    "bigint",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		IsCharge: column{
+			Name:      "is_charge",
+			DBType:    "boolean",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Reason: column{
+			Name:      "reason",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Rate: column{
+			Name:      "rate",
+			DBType:    "numeric",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Amount: column{
+			Name:      "amount",
+			DBType:    "numeric",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: invoiceAllowanceChargeIndexes{
+		InvoiceAllowanceChargesPkey: index{
+			Type: "btree",
+			Name: "invoice_allowance_charges_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		InvoiceAllowanceChargesInvoiceIDIdx: index{
+			Type: "btree",
+			Name: "invoice_allowance_charges_invoice_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "invoice_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "invoice_allowance_charges_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: invoiceAllowanceChargeForeignKeys{
+		InvoiceAllowanceChargesInvoiceAllowanceChargesInvoiceIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "invoice_allowance_charges.invoice_allowance_charges_invoice_id_fkey",
+				Columns: []string{"invoice_id"},
+				Comment: "",
+			},
+			ForeignTable:   "invoices",
+			ForeignColumns: []string{"id"},
+		},
+		InvoiceAllowanceChargesInvoiceAllowanceChargesInvoiceLineIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "invoice_allowance_charges.invoice_allowance_charges_invoice_line_id_fkey",
+				Columns: []string{"invoice_line_id"},
+				Comment: "",
+			},
+			ForeignTable:   "invoice_lines",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type invoiceAllowanceChargeColumns struct {
+	ID            column
+	InvoiceID     column
+	InvoiceLineID column
+	IsCharge      column
+	Reason        column
+	Rate          column
+	Amount        column
+	CreatedAt     column
+}
+
+func (c invoiceAllowanceChargeColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.InvoiceID, c.InvoiceLineID, c.IsCharge, c.Reason, c.Rate, c.Amount, c.CreatedAt,
+	}
+}
+
+type invoiceAllowanceChargeIndexes struct {
+	InvoiceAllowanceChargesPkey         index
+	InvoiceAllowanceChargesInvoiceIDIdx index
+}
+
+func (i invoiceAllowanceChargeIndexes) AsSlice() []index {
+	return []index{
+		i.InvoiceAllowanceChargesPkey, i.InvoiceAllowanceChargesInvoiceIDIdx,
+	}
+}
+
+type invoiceAllowanceChargeForeignKeys struct {
+	InvoiceAllowanceChargesInvoiceAllowanceChargesInvoiceIDFkey     foreignKey
+	InvoiceAllowanceChargesInvoiceAllowanceChargesInvoiceLineIDFkey foreignKey
+}
+
+func (f invoiceAllowanceChargeForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.InvoiceAllowanceChargesInvoiceAllowanceChargesInvoiceIDFkey, f.InvoiceAllowanceChargesInvoiceAllowanceChargesInvoiceLineIDFkey,
+	}
+}
+
+type invoiceAllowanceChargeUniques struct{}
+
+func (u invoiceAllowanceChargeUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type invoiceAllowanceChargeChecks struct{}
+
+func (c invoiceAllowanceChargeChecks) AsSlice() []check {
+	return []check{}
+}
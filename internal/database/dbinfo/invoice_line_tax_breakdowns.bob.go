@@ -0,0 +1,205 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var InvoiceLineTaxBreakdowns = Table[
+	invoiceLineTaxBreakdownColumns,
+	invoiceLineTaxBreakdownIndexes,
+	invoiceLineTaxBreakdownForeignKeys,
+	invoiceLineTaxBreakdownUniques,
+	invoiceLineTaxBreakdownChecks,
+]{
+	Schema: "",
+	Name:   "invoice_line_tax_breakdowns",
+	Columns: invoiceLineTaxBreakdownColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('invoice_line_tax_breakdowns_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		InvoiceLineID: column{
+			Name:      "invoice_line_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TaxType: column{
+			Name:      "tax_type",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Rate: column{
+			Name:      "rate",
+			DBType:    "numeric",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		PerUnitAmount: column{
+			Name:      "per_unit_amount",
+			DBType:    "numeric",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ExemptionReason: column{
+			Name:      "exemption_reason",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TaxableAmount: column{
+			Name:      "taxable_amount",
+			DBType:    "numeric",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TaxAmount: column{
+			Name:      "tax_amount",
+			DBType:    "numeric",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: invoiceLineTaxBreakdownIndexes{
+		InvoiceLineTaxBreakdownsPkey: index{
+			Type: "btree",
+			Name: "invoice_line_tax_breakdowns_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		InvoiceLineTaxBreakdownsInvoiceLineIDIdx: index{
+			Type: "btree",
+			Name: "invoice_line_tax_breakdowns_invoice_line_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "invoice_line_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "invoice_line_tax_breakdowns_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: invoiceLineTaxBreakdownForeignKeys{
+		InvoiceLineTaxBreakdownsInvoiceLineTaxBreakdownsInvoiceLineIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "invoice_line_tax_breakdowns.invoice_line_tax_breakdowns_invoice_line_id_fkey",
+				Columns: []string{"invoice_line_id"},
+				Comment: "",
+			},
+			ForeignTable:   "invoice_lines",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type invoiceLineTaxBreakdownColumns struct {
+	ID              column
+	InvoiceLineID   column
+	TaxType         column
+	Rate            column
+	PerUnitAmount   column
+	ExemptionReason column
+	TaxableAmount   column
+	TaxAmount       column
+	CreatedAt       column
+}
+
+func (c invoiceLineTaxBreakdownColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.InvoiceLineID, c.TaxType, c.Rate, c.PerUnitAmount, c.ExemptionReason, c.TaxableAmount, c.TaxAmount, c.CreatedAt,
+	}
+}
+
+type invoiceLineTaxBreakdownIndexes struct {
+	InvoiceLineTaxBreakdownsPkey             index
+	InvoiceLineTaxBreakdownsInvoiceLineIDIdx index
+}
+
+func (i invoiceLineTaxBreakdownIndexes) AsSlice() []index {
+	return []index{
+		i.InvoiceLineTaxBreakdownsPkey, i.InvoiceLineTaxBreakdownsInvoiceLineIDIdx,
+	}
+}
+
+type invoiceLineTaxBreakdownForeignKeys struct {
+	InvoiceLineTaxBreakdownsInvoiceLineTaxBreakdownsInvoiceLineIDFkey foreignKey
+}
+
+func (f invoiceLineTaxBreakdownForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.InvoiceLineTaxBreakdownsInvoiceLineTaxBreakdownsInvoiceLineIDFkey,
+	}
+}
+
+type invoiceLineTaxBreakdownUniques struct{}
+
+func (u invoiceLineTaxBreakdownUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type invoiceLineTaxBreakdownChecks struct{}
+
+func (c invoiceLineTaxBreakdownChecks) AsSlice() []check {
+	return []check{}
+}
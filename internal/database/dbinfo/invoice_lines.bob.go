@@ -0,0 +1,271 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var InvoiceLines = Table[
+	invoiceLineColumns,
+	invoiceLineIndexes,
+	invoiceLineForeignKeys,
+	invoiceLineUniques,
+	invoiceLineChecks,
+]{
+	Schema: "",
+	Name:   "invoice_lines",
+	Columns: invoiceLineColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('invoice_lines_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		InvoiceID: column{
+			Name:      "invoice_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		LineNumber: column{
+			Name:      "line_number",
+			DBType:    "integer",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ClassificationCode: column{
+			Name:      "classification_code",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Description: column{
+			Name:      "description",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Quantity: column{
+			Name:      "quantity",
+			DBType:    "numeric",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UnitCode: column{
+			Name:      "unit_code",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UnitPrice: column{
+			Name:      "unit_price",
+			DBType:    "numeric",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ProductTariffCode: column{
+			Name:      "product_tariff_code",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CountryOfOrigin: column{
+			Name:      "country_of_origin",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Subtotal: column{
+			Name:      "subtotal",
+			DBType:    "numeric",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TotalExcludingTax: column{
+			Name:      "total_excluding_tax",
+			DBType:    "numeric",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TaxAmount: column{
+			Name:      "tax_amount",
+			DBType:    "numeric",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: invoiceLineIndexes{
+		InvoiceLinesPkey: index{
+			Type: "btree",
+			Name: "invoice_lines_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		InvoiceLinesInvoiceIDLineNumberKey: index{
+			Type: "btree",
+			Name: "invoice_lines_invoice_id_line_number_key",
+			Columns: []indexColumn{
+				{
+					Name:         "invoice_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "line_number",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "invoice_lines_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: invoiceLineForeignKeys{
+		InvoiceLinesInvoiceLinesInvoiceIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "invoice_lines.invoice_lines_invoice_id_fkey",
+				Columns: []string{"invoice_id"},
+				Comment: "",
+			},
+			ForeignTable:   "invoices",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: invoiceLineUniques{
+		InvoiceLinesInvoiceIDLineNumberKey: constraint{
+			Name:    "invoice_lines_invoice_id_line_number_key",
+			Columns: []string{"invoice_id", "line_number"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type invoiceLineColumns struct {
+	ID                 column
+	InvoiceID          column
+	LineNumber         column
+	ClassificationCode column
+	Description        column
+	Quantity           column
+	UnitCode           column
+	UnitPrice          column
+	ProductTariffCode  column
+	CountryOfOrigin    column
+	Subtotal           column
+	TotalExcludingTax  column
+	TaxAmount          column
+	CreatedAt          column
+}
+
+func (c invoiceLineColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.InvoiceID, c.LineNumber, c.ClassificationCode, c.Description, c.Quantity, c.UnitCode, c.UnitPrice, c.ProductTariffCode, c.CountryOfOrigin, c.Subtotal, c.TotalExcludingTax, c.TaxAmount, c.CreatedAt,
+	}
+}
+
+type invoiceLineIndexes struct {
+	InvoiceLinesPkey                   index
+	InvoiceLinesInvoiceIDLineNumberKey index
+}
+
+func (i invoiceLineIndexes) AsSlice() []index {
+	return []index{
+		i.InvoiceLinesPkey, i.InvoiceLinesInvoiceIDLineNumberKey,
+	}
+}
+
+type invoiceLineForeignKeys struct {
+	InvoiceLinesInvoiceLinesInvoiceIDFkey foreignKey
+}
+
+func (f invoiceLineForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.InvoiceLinesInvoiceLinesInvoiceIDFkey,
+	}
+}
+
+type invoiceLineUniques struct {
+	InvoiceLinesInvoiceIDLineNumberKey constraint
+}
+
+func (u invoiceLineUniques) AsSlice() []constraint {
+	return []constraint{
+		u.InvoiceLinesInvoiceIDLineNumberKey,
+	}
+}
+
+type invoiceLineChecks struct{}
+
+func (c invoiceLineChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,341 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var InvoiceParties = Table[
+	invoicePartyColumns,
+	invoicePartyIndexes,
+	invoicePartyForeignKeys,
+	invoicePartyUniques,
+	invoicePartyChecks,
+]{
+	Schema: "",
+	Name:   "invoice_parties",
+	Columns: invoicePartyColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('invoice_parties_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		InvoiceID: column{
+			Name:      "invoice_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Role: column{
+			Name:      "role",
+			DBType:    "public.invoice_party_roles",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Name: column{
+			Name:      "name",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Tin: column{
+			Name:      "tin",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		IDType: column{
+			Name:      "id_type",
+			DBType:    "public.taxpayer_id_types",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		IDValue: column{
+			Name:      "id_value",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		SSTRegistrationNumber: column{
+			Name:      "sst_registration_number",
+			DBType:    "character varying",
+			Default:   "'NA'::character varying",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TourismTaxRegistrationNumber: column{
+			Name:      "tourism_tax_registration_number",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		MsicCode: column{
+			Name:      "msic_code",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		BusinessActivityDescription: column{
+			Name:      "business_activity_description",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		AddressLine1: column{
+			Name:      "address_line1",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		AddressLine2: column{
+			Name:      "address_line2",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		AddressLine3: column{
+			Name:      "address_line3",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		PostalZone: column{
+			Name:      "postal_zone",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CityName: column{
+			Name:      "city_name",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		StateCode: column{
+			Name:      "state_code",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CountryCode: column{
+			Name:      "country_code",
+			DBType:    "character varying",
+			Default:   "'MYS'::character varying",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Phone: column{
+			Name:      "phone",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Email: column{
+			Name:      "email",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: invoicePartyIndexes{
+		InvoicePartiesPkey: index{
+			Type: "btree",
+			Name: "invoice_parties_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		InvoicePartiesInvoiceIDRoleKey: index{
+			Type: "btree",
+			Name: "invoice_parties_invoice_id_role_key",
+			Columns: []indexColumn{
+				{
+					Name:         "invoice_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "role",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "invoice_parties_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: invoicePartyForeignKeys{
+		InvoicePartiesInvoicePartiesInvoiceIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "invoice_parties.invoice_parties_invoice_id_fkey",
+				Columns: []string{"invoice_id"},
+				Comment: "",
+			},
+			ForeignTable:   "invoices",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: invoicePartyUniques{
+		InvoicePartiesInvoiceIDRoleKey: constraint{
+			Name:    "invoice_parties_invoice_id_role_key",
+			Columns: []string{"invoice_id", "role"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type invoicePartyColumns struct {
+	ID                           column
+	InvoiceID                    column
+	Role                         column
+	Name                         column
+	Tin                          column
+	IDType                       column
+	IDValue                      column
+	SSTRegistrationNumber        column
+	TourismTaxRegistrationNumber column
+	MsicCode                     column
+	BusinessActivityDescription  column
+	AddressLine1                 column
+	AddressLine2                 column
+	AddressLine3                 column
+	PostalZone                   column
+	CityName                     column
+	StateCode                    column
+	CountryCode                  column
+	Phone                        column
+	Email                        column
+	CreatedAt                    column
+}
+
+func (c invoicePartyColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.InvoiceID, c.Role, c.Name, c.Tin, c.IDType, c.IDValue, c.SSTRegistrationNumber, c.TourismTaxRegistrationNumber, c.MsicCode, c.BusinessActivityDescription, c.AddressLine1, c.AddressLine2, c.AddressLine3, c.PostalZone, c.CityName, c.StateCode, c.CountryCode, c.Phone, c.Email, c.CreatedAt,
+	}
+}
+
+type invoicePartyIndexes struct {
+	InvoicePartiesPkey             index
+	InvoicePartiesInvoiceIDRoleKey index
+}
+
+func (i invoicePartyIndexes) AsSlice() []index {
+	return []index{
+		i.InvoicePartiesPkey, i.InvoicePartiesInvoiceIDRoleKey,
+	}
+}
+
+type invoicePartyForeignKeys struct {
+	InvoicePartiesInvoicePartiesInvoiceIDFkey foreignKey
+}
+
+func (f invoicePartyForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.InvoicePartiesInvoicePartiesInvoiceIDFkey,
+	}
+}
+
+type invoicePartyUniques struct {
+	InvoicePartiesInvoiceIDRoleKey constraint
+}
+
+func (u invoicePartyUniques) AsSlice() []constraint {
+	return []constraint{
+		u.InvoicePartiesInvoiceIDRoleKey,
+	}
+}
+
+type invoicePartyChecks struct{}
+
+func (c invoicePartyChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,519 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Invoices = Table[
+	invoiceColumns,
+	invoiceIndexes,
+	invoiceForeignKeys,
+	invoiceUniques,
+	invoiceChecks,
+]{
+	Schema: "",
+	Name:   "invoices",
+	Columns: invoiceColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('invoices_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		OrganisationID: column{
+			Name:      "organisation_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		InvoiceNumber: column{
+			Name:      "invoice_number",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TypeCode: column{
+			Name:      "type_code",
+			DBType:    "character varying",
+			Default:   "'01'::character varying",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Status: column{
+			Name:      "status",
+			DBType:    "public.invoice_statuses",
+			Default:   "'draft'::invoice_statuses",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		IssueDate: column{
+			Name:      "issue_date",
+			DBType:    "date",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CurrencyCode: column{
+			Name:      "currency_code",
+			DBType:    "character varying",
+			Default:   "'MYR'::character varying",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ExchangeRate: column{
+			Name:      "exchange_rate",
+			DBType:    "numeric",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		BillingPeriodStart: column{
+			Name:      "billing_period_start",
+			DBType:    "date",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		BillingPeriodEnd: column{
+			Name:      "billing_period_end",
+			DBType:    "date",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		BillingFrequency: column{
+			Name:      "billing_frequency",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		PaymentMode: column{
+			Name:      "payment_mode",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		PaymentTerms: column{
+			Name:      "payment_terms",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		SupplierBankAccount: column{
+			Name:      "supplier_bank_account",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TotalLineAmount: column{
+			Name:      "total_line_amount",
+			DBType:    "numeric",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TotalAllowanceAmount: column{
+			Name:      "total_allowance_amount",
+			DBType:    "numeric",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TotalChargeAmount: column{
+			Name:      "total_charge_amount",
+			DBType:    "numeric",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TotalExcludingTax: column{
+			Name:      "total_excluding_tax",
+			DBType:    "numeric",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TotalTaxAmount: column{
+			Name:      "total_tax_amount",
+			DBType:    "numeric",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TotalIncludingTax: column{
+			Name:      "total_including_tax",
+			DBType:    "numeric",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RoundingAmount: column{
+			Name:      "rounding_amount",
+			DBType:    "numeric",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		PayableAmount: column{
+			Name:      "payable_amount",
+			DBType:    "numeric",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedBy: column{
+			Name:      "created_by",
+			DBType:    "bigint",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UpdatedAt: column{
+			Name:      "updated_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UUID: column{
+			Name:      "uuid",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		OriginalInvoiceID: column{
+			Name:      "original_invoice_id",
+			DBType:    "bigint",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		OriginalUUID: column{
+			Name:      "original_uuid",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		OriginalInvoiceNumber: column{
+			Name:      "original_invoice_number",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: invoiceIndexes{
+		InvoicesPkey: index{
+			Type: "btree",
+			Name: "invoices_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxInvoicesOriginalInvoiceID: index{
+			Type: "btree",
+			Name: "idx_invoices_original_invoice_id",
+			Columns: []indexColumn{
+				{
+					Name:         "original_invoice_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		InvoicesOrganisationIDInvoiceNumberKey: index{
+			Type: "btree",
+			Name: "invoices_organisation_id_invoice_number_key",
+			Columns: []indexColumn{
+				{
+					Name:         "organisation_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "invoice_number",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		InvoicesOrganisationIDStatusIdx: index{
+			Type: "btree",
+			Name: "invoices_organisation_id_status_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "organisation_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "status",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		InvoicesUUIDKey: index{
+			Type: "btree",
+			Name: "invoices_uuid_key",
+			Columns: []indexColumn{
+				{
+					Name:         "uuid",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "invoices_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: invoiceForeignKeys{
+		InvoicesInvoicesCreatedByFkey: foreignKey{
+			constraint: constraint{
+				Name:    "invoices.invoices_created_by_fkey",
+				Columns: []string{"created_by"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+		InvoicesInvoicesOrganisationIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "invoices.invoices_organisation_id_fkey",
+				Columns: []string{"organisation_id"},
+				Comment: "",
+			},
+			ForeignTable:   "organisations",
+			ForeignColumns: []string{"id"},
+		},
+		InvoicesInvoicesOriginalInvoiceIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "invoices.invoices_original_invoice_id_fkey",
+				Columns: []string{"original_invoice_id"},
+				Comment: "",
+			},
+			ForeignTable:   "invoices",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: invoiceUniques{
+		InvoicesOrganisationIDInvoiceNumberKey: constraint{
+			Name:    "invoices_organisation_id_invoice_number_key",
+			Columns: []string{"organisation_id", "invoice_number"},
+			Comment: "",
+		},
+		InvoicesUUIDKey: constraint{
+			Name:    "invoices_uuid_key",
+			Columns: []string{"uuid"},
+			Comment: "",
+		},
+	},
+	Checks: invoiceChecks{
+		InvoicesTypeCodeCheck: check{
+			constraint: constraint{
+				Name:    "invoices_type_code_check",
+				Columns: []string{"type_code"},
+				Comment: "",
+			},
+			Expression: "(type_code IN ('01', '02', '03', '04', '11', '12', '13', '14'))",
+		},
+	},
+	Comment: "",
+}
+
+type invoiceColumns struct {
+	ID                    column
+	OrganisationID        column
+	InvoiceNumber         column
+	TypeCode              column
+	Status                column
+	IssueDate             column
+	CurrencyCode          column
+	ExchangeRate          column
+	BillingPeriodStart    column
+	BillingPeriodEnd      column
+	BillingFrequency      column
+	PaymentMode           column
+	PaymentTerms          column
+	SupplierBankAccount   column
+	TotalLineAmount       column
+	TotalAllowanceAmount  column
+	TotalChargeAmount     column
+	TotalExcludingTax     column
+	TotalTaxAmount        column
+	TotalIncludingTax     column
+	RoundingAmount        column
+	PayableAmount         column
+	CreatedBy             column
+	CreatedAt             column
+	UpdatedAt             column
+	UUID                  column
+	OriginalInvoiceID     column
+	OriginalUUID          column
+	OriginalInvoiceNumber column
+}
+
+func (c invoiceColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.OrganisationID, c.InvoiceNumber, c.TypeCode, c.Status, c.IssueDate, c.CurrencyCode, c.ExchangeRate, c.BillingPeriodStart, c.BillingPeriodEnd, c.BillingFrequency, c.PaymentMode, c.PaymentTerms, c.SupplierBankAccount, c.TotalLineAmount, c.TotalAllowanceAmount, c.TotalChargeAmount, c.TotalExcludingTax, c.TotalTaxAmount, c.TotalIncludingTax, c.RoundingAmount, c.PayableAmount, c.CreatedBy, c.CreatedAt, c.UpdatedAt, c.UUID, c.OriginalInvoiceID, c.OriginalUUID, c.OriginalInvoiceNumber,
+	}
+}
+
+type invoiceIndexes struct {
+	InvoicesPkey                           index
+	IdxInvoicesOriginalInvoiceID           index
+	InvoicesOrganisationIDInvoiceNumberKey index
+	InvoicesOrganisationIDStatusIdx        index
+	InvoicesUUIDKey                        index
+}
+
+func (i invoiceIndexes) AsSlice() []index {
+	return []index{
+		i.InvoicesPkey, i.IdxInvoicesOriginalInvoiceID, i.InvoicesOrganisationIDInvoiceNumberKey, i.InvoicesOrganisationIDStatusIdx, i.InvoicesUUIDKey,
+	}
+}
+
+type invoiceForeignKeys struct {
+	InvoicesInvoicesCreatedByFkey         foreignKey
+	InvoicesInvoicesOrganisationIDFkey    foreignKey
+	InvoicesInvoicesOriginalInvoiceIDFkey foreignKey
+}
+
+func (f invoiceForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.InvoicesInvoicesCreatedByFkey, f.InvoicesInvoicesOrganisationIDFkey, f.InvoicesInvoicesOriginalInvoiceIDFkey,
+	}
+}
+
+type invoiceUniques struct {
+	InvoicesOrganisationIDInvoiceNumberKey constraint
+	InvoicesUUIDKey                        constraint
+}
+
+func (u invoiceUniques) AsSlice() []constraint {
+	return []constraint{
+		u.InvoicesOrganisationIDInvoiceNumberKey, u.InvoicesUUIDKey,
+	}
+}
+
+type invoiceChecks struct {
+	InvoicesTypeCodeCheck check
+}
+
+func (c invoiceChecks) AsSlice() []check {
+	return []check{
+		c.InvoicesTypeCodeCheck,
+	}
+}
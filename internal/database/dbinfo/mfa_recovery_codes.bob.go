@@ -0,0 +1,165 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var MfaRecoveryCodes = Table[
+	mfaRecoveryCodeColumns,
+	mfaRecoveryCodeIndexes,
+	mfaRecoveryCodeForeignKeys,
+	mfaRecoveryCodeUniques,
+	mfaRecoveryCodeChecks,
+]{
+	Schema: "",
+	Name:   "mfa_recovery_codes",
+	Columns: mfaRecoveryCodeColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('mfa_recovery_codes_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CodeHash: column{
+			Name:      "code_hash",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UsedAt: column{
+			Name:      "used_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: mfaRecoveryCodeIndexes{
+		MfaRecoveryCodesPkey: index{
+			Type: "btree",
+			Name: "mfa_recovery_codes_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxMfaRecoveryCodesUserID: index{
+			Type: "btree",
+			Name: "idx_mfa_recovery_codes_user_id",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "mfa_recovery_codes_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: mfaRecoveryCodeForeignKeys{
+		MfaRecoveryCodesMfaRecoveryCodesUserIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "mfa_recovery_codes.mfa_recovery_codes_user_id_fkey",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type mfaRecoveryCodeColumns struct {
+	ID        column
+	UserID    column
+	CodeHash  column
+	UsedAt    column
+	CreatedAt column
+}
+
+func (c mfaRecoveryCodeColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.UserID, c.CodeHash, c.UsedAt, c.CreatedAt,
+	}
+}
+
+type mfaRecoveryCodeIndexes struct {
+	MfaRecoveryCodesPkey      index
+	IdxMfaRecoveryCodesUserID index
+}
+
+func (i mfaRecoveryCodeIndexes) AsSlice() []index {
+	return []index{
+		i.MfaRecoveryCodesPkey, i.IdxMfaRecoveryCodesUserID,
+	}
+}
+
+type mfaRecoveryCodeForeignKeys struct {
+	MfaRecoveryCodesMfaRecoveryCodesUserIDFkey foreignKey
+}
+
+func (f mfaRecoveryCodeForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.MfaRecoveryCodesMfaRecoveryCodesUserIDFkey,
+	}
+}
+
+type mfaRecoveryCodeUniques struct{}
+
+func (u mfaRecoveryCodeUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type mfaRecoveryCodeChecks struct{}
+
+func (c mfaRecoveryCodeChecks) AsSlice() []check {
+	return []check{}
+}
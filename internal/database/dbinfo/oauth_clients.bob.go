@@ -0,0 +1,254 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var OauthClients = Table[
+	oauthClientColumns,
+	oauthClientIndexes,
+	oauthClientForeignKeys,
+	oauthClientUniques,
+	oauthClientChecks,
+]{
+	Schema: "",
+	Name:   "oauth_clients",
+	Columns: oauthClientColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('oauth_clients_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		OrganisationID: column{
+			Name:      "organisation_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Name: column{
+			Name:      "name",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ClientID: column{
+			Name:      "client_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		SecretHash: column{
+			Name:      "secret_hash",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Scopes: column{
+			Name:      "scopes",
+			DBType:    "text[]",
+			Default:   "'{}'::text[]",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RevokedAt: column{
+			Name:      "revoked_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UpdatedAt: column{
+			Name:      "updated_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: oauthClientIndexes{
+		OauthClientsPkey: index{
+			Type: "btree",
+			Name: "oauth_clients_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxOauthClientsOrganisationID: index{
+			Type: "btree",
+			Name: "idx_oauth_clients_organisation_id",
+			Columns: []indexColumn{
+				{
+					Name:         "organisation_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		OauthClientsClientIDKey: index{
+			Type: "btree",
+			Name: "oauth_clients_client_id_key",
+			Columns: []indexColumn{
+				{
+					Name:         "client_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "oauth_clients_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: oauthClientForeignKeys{
+		OauthClientsOauthClientsOrganisationIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "oauth_clients.oauth_clients_organisation_id_fkey",
+				Columns: []string{"organisation_id"},
+				Comment: "",
+			},
+			ForeignTable:   "organisations",
+			ForeignColumns: []string{"id"},
+		},
+		OauthClientsOauthClientsUserIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "oauth_clients.oauth_clients_user_id_fkey",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: oauthClientUniques{
+		OauthClientsClientIDKey: constraint{
+			Name:    "oauth_clients_client_id_key",
+			Columns: []string{"client_id"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type oauthClientColumns struct {
+	ID             column
+	OrganisationID column
+	UserID         column
+	Name           column
+	ClientID       column
+	SecretHash     column
+	Scopes         column
+	RevokedAt      column
+	CreatedAt      column
+	UpdatedAt      column
+}
+
+func (c oauthClientColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.OrganisationID, c.UserID, c.Name, c.ClientID, c.SecretHash, c.Scopes, c.RevokedAt, c.CreatedAt, c.UpdatedAt,
+	}
+}
+
+type oauthClientIndexes struct {
+	OauthClientsPkey              index
+	IdxOauthClientsOrganisationID index
+	OauthClientsClientIDKey       index
+}
+
+func (i oauthClientIndexes) AsSlice() []index {
+	return []index{
+		i.OauthClientsPkey, i.IdxOauthClientsOrganisationID, i.OauthClientsClientIDKey,
+	}
+}
+
+type oauthClientForeignKeys struct {
+	OauthClientsOauthClientsOrganisationIDFkey foreignKey
+	OauthClientsOauthClientsUserIDFkey         foreignKey
+}
+
+func (f oauthClientForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.OauthClientsOauthClientsOrganisationIDFkey, f.OauthClientsOauthClientsUserIDFkey,
+	}
+}
+
+type oauthClientUniques struct {
+	OauthClientsClientIDKey constraint
+}
+
+func (u oauthClientUniques) AsSlice() []constraint {
+	return []constraint{
+		u.OauthClientsClientIDKey,
+	}
+}
+
+type oauthClientChecks struct{}
+
+func (c oauthClientChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,160 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var OrganisationMembers = Table[
+	organisationMemberColumns,
+	organisationMemberIndexes,
+	organisationMemberForeignKeys,
+	organisationMemberUniques,
+	organisationMemberChecks,
+]{
+	Schema: "",
+	Name:   "organisation_members",
+	Columns: organisationMemberColumns{
+		OrganisationID: column{
+			Name:      "organisation_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: organisationMemberIndexes{
+		OrganisationMembersPkey: index{
+			Type: "btree",
+			Name: "organisation_members_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "organisation_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxOrganisationMembersUserID: index{
+			Type: "btree",
+			Name: "idx_organisation_members_user_id",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "organisation_members_pkey",
+		Columns: []string{"organisation_id", "user_id"},
+		Comment: "",
+	},
+	ForeignKeys: organisationMemberForeignKeys{
+		OrganisationMembersOrganisationMembersOrganisationIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "organisation_members.organisation_members_organisation_id_fkey",
+				Columns: []string{"organisation_id"},
+				Comment: "",
+			},
+			ForeignTable:   "organisations",
+			ForeignColumns: []string{"id"},
+		},
+		OrganisationMembersOrganisationMembersUserIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "organisation_members.organisation_members_user_id_fkey",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type organisationMemberColumns struct {
+	OrganisationID column
+	UserID         column
+	CreatedAt      column
+}
+
+func (c organisationMemberColumns) AsSlice() []column {
+	return []column{
+		c.OrganisationID, c.UserID, c.CreatedAt,
+	}
+}
+
+type organisationMemberIndexes struct {
+	OrganisationMembersPkey      index
+	IdxOrganisationMembersUserID index
+}
+
+func (i organisationMemberIndexes) AsSlice() []index {
+	return []index{
+		i.OrganisationMembersPkey, i.IdxOrganisationMembersUserID,
+	}
+}
+
+type organisationMemberForeignKeys struct {
+	OrganisationMembersOrganisationMembersOrganisationIDFkey foreignKey
+	OrganisationMembersOrganisationMembersUserIDFkey         foreignKey
+}
+
+func (f organisationMemberForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.OrganisationMembersOrganisationMembersOrganisationIDFkey, f.OrganisationMembersOrganisationMembersUserIDFkey,
+	}
+}
+
+type organisationMemberUniques struct{}
+
+func (u organisationMemberUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type organisationMemberChecks struct{}
+
+func (c organisationMemberChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,122 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Organisations = Table[
+	organisationColumns,
+	organisationIndexes,
+	organisationForeignKeys,
+	organisationUniques,
+	organisationChecks,
+]{
+	Schema: "",
+	Name:   "organisations",
+	Columns: organisationColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('organisations_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Name: column{
+			Name:      "name",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UpdatedAt: column{
+			Name:      "updated_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: organisationIndexes{
+		OrganisationsPkey: index{
+			Type: "btree",
+			Name: "organisations_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "organisations_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+
+	Comment: "",
+}
+
+type organisationColumns struct {
+	ID        column
+	Name      column
+	CreatedAt column
+	UpdatedAt column
+}
+
+func (c organisationColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.Name, c.CreatedAt, c.UpdatedAt,
+	}
+}
+
+type organisationIndexes struct {
+	OrganisationsPkey index
+}
+
+func (i organisationIndexes) AsSlice() []index {
+	return []index{
+		i.OrganisationsPkey,
+	}
+}
+
+type organisationForeignKeys struct{}
+
+func (f organisationForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{}
+}
+
+type organisationUniques struct{}
+
+func (u organisationUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type organisationChecks struct{}
+
+func (c organisationChecks) AsSlice() []check {
+	return []check{}
+}
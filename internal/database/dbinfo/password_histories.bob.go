@@ -0,0 +1,160 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var PasswordHistories = Table[
+	passwordHistoryColumns,
+	passwordHistoryIndexes,
+	passwordHistoryForeignKeys,
+	passwordHistoryUniques,
+	passwordHistoryChecks,
+]{
+	Schema: "",
+	Name:   "password_histories",
+	Columns: passwordHistoryColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('password_histories_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Password: column{
+			Name:      "password",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: passwordHistoryIndexes{
+		PasswordHistoriesPkey: index{
+			Type: "btree",
+			Name: "password_histories_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxPasswordHistoriesUserID: index{
+			Type: "btree",
+			Name: "idx_password_histories_user_id",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "id",
+					Desc:         null.FromCond(true, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false, true},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "password_histories_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: passwordHistoryForeignKeys{
+		PasswordHistoriesPasswordHistoriesUserIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "password_histories.password_histories_user_id_fkey",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type passwordHistoryColumns struct {
+	ID        column
+	UserID    column
+	Password  column
+	CreatedAt column
+}
+
+func (c passwordHistoryColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.UserID, c.Password, c.CreatedAt,
+	}
+}
+
+type passwordHistoryIndexes struct {
+	PasswordHistoriesPkey      index
+	IdxPasswordHistoriesUserID index
+}
+
+func (i passwordHistoryIndexes) AsSlice() []index {
+	return []index{
+		i.PasswordHistoriesPkey, i.IdxPasswordHistoriesUserID,
+	}
+}
+
+type passwordHistoryForeignKeys struct {
+	PasswordHistoriesPasswordHistoriesUserIDFkey foreignKey
+}
+
+func (f passwordHistoryForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.PasswordHistoriesPasswordHistoriesUserIDFkey,
+	}
+}
+
+type passwordHistoryUniques struct{}
+
+func (u passwordHistoryUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type passwordHistoryChecks struct{}
+
+func (c passwordHistoryChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,152 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Permissions = Table[
+	permissionColumns,
+	permissionIndexes,
+	permissionForeignKeys,
+	permissionUniques,
+	permissionChecks,
+]{
+	Schema: "",
+	Name:   "permissions",
+	Columns: permissionColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('permissions_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Name: column{
+			Name:      "name",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Description: column{
+			Name:      "description",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: permissionIndexes{
+		PermissionsPkey: index{
+			Type: "btree",
+			Name: "permissions_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		PermissionsNameKey: index{
+			Type: "btree",
+			Name: "permissions_name_key",
+			Columns: []indexColumn{
+				{
+					Name:         "name",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "permissions_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+
+	Uniques: permissionUniques{
+		PermissionsNameKey: constraint{
+			Name:    "permissions_name_key",
+			Columns: []string{"name"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type permissionColumns struct {
+	ID          column
+	Name        column
+	Description column
+	CreatedAt   column
+}
+
+func (c permissionColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.Name, c.Description, c.CreatedAt,
+	}
+}
+
+type permissionIndexes struct {
+	PermissionsPkey    index
+	PermissionsNameKey index
+}
+
+func (i permissionIndexes) AsSlice() []index {
+	return []index{
+		i.PermissionsPkey, i.PermissionsNameKey,
+	}
+}
+
+type permissionForeignKeys struct{}
+
+func (f permissionForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{}
+}
+
+type permissionUniques struct {
+	PermissionsNameKey constraint
+}
+
+func (u permissionUniques) AsSlice() []constraint {
+	return []constraint{
+		u.PermissionsNameKey,
+	}
+}
+
+type permissionChecks struct{}
+
+func (c permissionChecks) AsSlice() []check {
+	return []check{}
+}
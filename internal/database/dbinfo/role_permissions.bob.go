@@ -0,0 +1,150 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var RolePermissions = Table[
+	rolePermissionColumns,
+	rolePermissionIndexes,
+	rolePermissionForeignKeys,
+	rolePermissionUniques,
+	rolePermissionChecks,
+]{
+	Schema: "",
+	Name:   "role_permissions",
+	Columns: rolePermissionColumns{
+		RoleID: column{
+			Name:      "role_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		PermissionID: column{
+			Name:      "permission_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: rolePermissionIndexes{
+		RolePermissionsPkey: index{
+			Type: "btree",
+			Name: "role_permissions_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "role_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "permission_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxRolePermissionsPermissionID: index{
+			Type: "btree",
+			Name: "idx_role_permissions_permission_id",
+			Columns: []indexColumn{
+				{
+					Name:         "permission_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "role_permissions_pkey",
+		Columns: []string{"role_id", "permission_id"},
+		Comment: "",
+	},
+	ForeignKeys: rolePermissionForeignKeys{
+		RolePermissionsRolePermissionsPermissionIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "role_permissions.role_permissions_permission_id_fkey",
+				Columns: []string{"permission_id"},
+				Comment: "",
+			},
+			ForeignTable:   "permissions",
+			ForeignColumns: []string{"id"},
+		},
+		RolePermissionsRolePermissionsRoleIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "role_permissions.role_permissions_role_id_fkey",
+				Columns: []string{"role_id"},
+				Comment: "",
+			},
+			ForeignTable:   "roles",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type rolePermissionColumns struct {
+	RoleID       column
+	PermissionID column
+}
+
+func (c rolePermissionColumns) AsSlice() []column {
+	return []column{
+		c.RoleID, c.PermissionID,
+	}
+}
+
+type rolePermissionIndexes struct {
+	RolePermissionsPkey            index
+	IdxRolePermissionsPermissionID index
+}
+
+func (i rolePermissionIndexes) AsSlice() []index {
+	return []index{
+		i.RolePermissionsPkey, i.IdxRolePermissionsPermissionID,
+	}
+}
+
+type rolePermissionForeignKeys struct {
+	RolePermissionsRolePermissionsPermissionIDFkey foreignKey
+	RolePermissionsRolePermissionsRoleIDFkey       foreignKey
+}
+
+func (f rolePermissionForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.RolePermissionsRolePermissionsPermissionIDFkey, f.RolePermissionsRolePermissionsRoleIDFkey,
+	}
+}
+
+type rolePermissionUniques struct{}
+
+func (u rolePermissionUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type rolePermissionChecks struct{}
+
+func (c rolePermissionChecks) AsSlice() []check {
+	return []check{}
+}
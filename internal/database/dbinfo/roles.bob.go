@@ -0,0 +1,162 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Roles = Table[
+	roleColumns,
+	roleIndexes,
+	roleForeignKeys,
+	roleUniques,
+	roleChecks,
+]{
+	Schema: "",
+	Name:   "roles",
+	Columns: roleColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('roles_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Name: column{
+			Name:      "name",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Description: column{
+			Name:      "description",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UpdatedAt: column{
+			Name:      "updated_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: roleIndexes{
+		RolesPkey: index{
+			Type: "btree",
+			Name: "roles_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		RolesNameKey: index{
+			Type: "btree",
+			Name: "roles_name_key",
+			Columns: []indexColumn{
+				{
+					Name:         "name",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "roles_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+
+	Uniques: roleUniques{
+		RolesNameKey: constraint{
+			Name:    "roles_name_key",
+			Columns: []string{"name"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type roleColumns struct {
+	ID          column
+	Name        column
+	Description column
+	CreatedAt   column
+	UpdatedAt   column
+}
+
+func (c roleColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.Name, c.Description, c.CreatedAt, c.UpdatedAt,
+	}
+}
+
+type roleIndexes struct {
+	RolesPkey    index
+	RolesNameKey index
+}
+
+func (i roleIndexes) AsSlice() []index {
+	return []index{
+		i.RolesPkey, i.RolesNameKey,
+	}
+}
+
+type roleForeignKeys struct{}
+
+func (f roleForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{}
+}
+
+type roleUniques struct {
+	RolesNameKey constraint
+}
+
+func (u roleUniques) AsSlice() []constraint {
+	return []constraint{
+		u.RolesNameKey,
+	}
+}
+
+type roleChecks struct{}
+
+func (c roleChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,203 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var SecurityEvents = Table[
+	securityEventColumns,
+	securityEventIndexes,
+	securityEventForeignKeys,
+	securityEventUniques,
+	securityEventChecks,
+]{
+	Schema: "",
+	Name:   "security_events",
+	Columns: securityEventColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('security_events_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "bigint",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		SessionID: column{
+			Name:      "session_id",
+			DBType:    "uuid",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Type: column{
+			Name:      "type",
+			DBType:    "public.security_event_types",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		IPAddress: column{
+			Name:      "ip_address",
+			DBType:    "inet",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Details: column{
+			Name:      "details",
+			DBType:    "jsonb",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: securityEventIndexes{
+		SecurityEventsPkey: index{
+			Type: "btree",
+			Name: "security_events_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxSecurityEventsCreatedAt: index{
+			Type: "btree",
+			Name: "idx_security_events_created_at",
+			Columns: []indexColumn{
+				{
+					Name:         "created_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxSecurityEventsUserID: index{
+			Type: "btree",
+			Name: "idx_security_events_user_id",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "security_events_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: securityEventForeignKeys{
+		SecurityEventsSecurityEventsUserIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "security_events.security_events_user_id_fkey",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type securityEventColumns struct {
+	ID        column
+	UserID    column
+	SessionID column
+	Type      column
+	IPAddress column
+	Details   column
+	CreatedAt column
+}
+
+func (c securityEventColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.UserID, c.SessionID, c.Type, c.IPAddress, c.Details, c.CreatedAt,
+	}
+}
+
+type securityEventIndexes struct {
+	SecurityEventsPkey         index
+	IdxSecurityEventsCreatedAt index
+	IdxSecurityEventsUserID    index
+}
+
+func (i securityEventIndexes) AsSlice() []index {
+	return []index{
+		i.SecurityEventsPkey, i.IdxSecurityEventsCreatedAt, i.IdxSecurityEventsUserID,
+	}
+}
+
+type securityEventForeignKeys struct {
+	SecurityEventsSecurityEventsUserIDFkey foreignKey
+}
+
+func (f securityEventForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.SecurityEventsSecurityEventsUserIDFkey,
+	}
+}
+
+type securityEventUniques struct{}
+
+func (u securityEventUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type securityEventChecks struct{}
+
+func (c securityEventChecks) AsSlice() []check {
+	return []check{}
+}
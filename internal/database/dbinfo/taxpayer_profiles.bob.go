@@ -0,0 +1,336 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var TaxpayerProfiles = Table[
+	taxpayerProfileColumns,
+	taxpayerProfileIndexes,
+	taxpayerProfileForeignKeys,
+	taxpayerProfileUniques,
+	taxpayerProfileChecks,
+]{
+	Schema: "",
+	Name:   "taxpayer_profiles",
+	Columns: taxpayerProfileColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('taxpayer_profiles_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		OrganisationID: column{
+			Name:      "organisation_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Name: column{
+			Name:      "name",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Tin: column{
+			Name:      "tin",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		IDType: column{
+			Name:      "id_type",
+			DBType:    "public.taxpayer_id_types",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		IDValue: column{
+			Name:      "id_value",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		SSTRegistrationNumber: column{
+			Name:      "sst_registration_number",
+			DBType:    "character varying",
+			Default:   "'NA'::character varying",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TourismTaxRegistrationNumber: column{
+			Name:      "tourism_tax_registration_number",
+			DBType:    "character varying",
+			Default:   "'NA'::character varying",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		MsicCode: column{
+			Name:      "msic_code",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		BusinessActivityDescription: column{
+			Name:      "business_activity_description",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		AddressLine1: column{
+			Name:      "address_line1",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		AddressLine2: column{
+			Name:      "address_line2",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		AddressLine3: column{
+			Name:      "address_line3",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		PostalZone: column{
+			Name:      "postal_zone",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CityName: column{
+			Name:      "city_name",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		StateCode: column{
+			Name:      "state_code",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CountryCode: column{
+			Name:      "country_code",
+			DBType:    "character varying",
+			Default:   "'MYS'::character varying",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Phone: column{
+			Name:      "phone",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Email: column{
+			Name:      "email",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UpdatedAt: column{
+			Name:      "updated_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: taxpayerProfileIndexes{
+		TaxpayerProfilesPkey: index{
+			Type: "btree",
+			Name: "taxpayer_profiles_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		TaxpayerProfilesOrganisationIDKey: index{
+			Type: "btree",
+			Name: "taxpayer_profiles_organisation_id_key",
+			Columns: []indexColumn{
+				{
+					Name:         "organisation_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "taxpayer_profiles_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: taxpayerProfileForeignKeys{
+		TaxpayerProfilesTaxpayerProfilesOrganisationIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "taxpayer_profiles.taxpayer_profiles_organisation_id_fkey",
+				Columns: []string{"organisation_id"},
+				Comment: "",
+			},
+			ForeignTable:   "organisations",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: taxpayerProfileUniques{
+		TaxpayerProfilesOrganisationIDKey: constraint{
+			Name:    "taxpayer_profiles_organisation_id_key",
+			Columns: []string{"organisation_id"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type taxpayerProfileColumns struct {
+	ID                           column
+	OrganisationID               column
+	Name                         column
+	Tin                          column
+	IDType                       column
+	IDValue                      column
+	SSTRegistrationNumber        column
+	TourismTaxRegistrationNumber column
+	MsicCode                     column
+	BusinessActivityDescription  column
+	AddressLine1                 column
+	AddressLine2                 column
+	AddressLine3                 column
+	PostalZone                   column
+	CityName                     column
+	StateCode                    column
+	CountryCode                  column
+	Phone                        column
+	Email                        column
+	CreatedAt                    column
+	UpdatedAt                    column
+}
+
+func (c taxpayerProfileColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.OrganisationID, c.Name, c.Tin, c.IDType, c.IDValue, c.SSTRegistrationNumber, c.TourismTaxRegistrationNumber, c.MsicCode, c.BusinessActivityDescription, c.AddressLine1, c.AddressLine2, c.AddressLine3, c.PostalZone, c.CityName, c.StateCode, c.CountryCode, c.Phone, c.Email, c.CreatedAt, c.UpdatedAt,
+	}
+}
+
+type taxpayerProfileIndexes struct {
+	TaxpayerProfilesPkey              index
+	TaxpayerProfilesOrganisationIDKey index
+}
+
+func (i taxpayerProfileIndexes) AsSlice() []index {
+	return []index{
+		i.TaxpayerProfilesPkey, i.TaxpayerProfilesOrganisationIDKey,
+	}
+}
+
+type taxpayerProfileForeignKeys struct {
+	TaxpayerProfilesTaxpayerProfilesOrganisationIDFkey foreignKey
+}
+
+func (f taxpayerProfileForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.TaxpayerProfilesTaxpayerProfilesOrganisationIDFkey,
+	}
+}
+
+type taxpayerProfileUniques struct {
+	TaxpayerProfilesOrganisationIDKey constraint
+}
+
+func (u taxpayerProfileUniques) AsSlice() []constraint {
+	return []constraint{
+		u.TaxpayerProfilesOrganisationIDKey,
+	}
+}
+
+type taxpayerProfileChecks struct{}
+
+func (c taxpayerProfileChecks) AsSlice() []check {
+	return []check{}
+}
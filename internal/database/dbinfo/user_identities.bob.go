@@ -0,0 +1,229 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var UserIdentities = Table[
+	userIdentityColumns,
+	userIdentityIndexes,
+	userIdentityForeignKeys,
+	userIdentityUniques,
+	userIdentityChecks,
+]{
+	Schema: "",
+	Name:   "user_identities",
+	Columns: userIdentityColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "bigint",
+			Default:   "nextval('user_identities_id_seq'::regclass)",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Provider: column{
+			Name:      "provider",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Subject: column{
+			Name:      "subject",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Email: column{
+			Name:      "email",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		LastLoginAt: column{
+			Name:      "last_login_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UpdatedAt: column{
+			Name:      "updated_at",
+			DBType:    "timestamp with time zone",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: userIdentityIndexes{
+		UserIdentitiesPkey: index{
+			Type: "btree",
+			Name: "user_identities_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxUserIdentitiesUserID: index{
+			Type: "btree",
+			Name: "idx_user_identities_user_id",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		UserIdentitiesProviderSubjectKey: index{
+			Type: "btree",
+			Name: "user_identities_provider_subject_key",
+			Columns: []indexColumn{
+				{
+					Name:         "provider",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "subject",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "user_identities_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: userIdentityForeignKeys{
+		UserIdentitiesUserIdentitiesUserIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "user_identities.user_identities_user_id_fkey",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: userIdentityUniques{
+		UserIdentitiesProviderSubjectKey: constraint{
+			Name:    "user_identities_provider_subject_key",
+			Columns: []string{"provider", "subject"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type userIdentityColumns struct {
+	ID          column
+	UserID      column
+	Provider    column
+	Subject     column
+	Email       column
+	LastLoginAt column
+	CreatedAt   column
+	UpdatedAt   column
+}
+
+func (c userIdentityColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.UserID, c.Provider, c.Subject, c.Email, c.LastLoginAt, c.CreatedAt, c.UpdatedAt,
+	}
+}
+
+type userIdentityIndexes struct {
+	UserIdentitiesPkey               index
+	IdxUserIdentitiesUserID          index
+	UserIdentitiesProviderSubjectKey index
+}
+
+func (i userIdentityIndexes) AsSlice() []index {
+	return []index{
+		i.UserIdentitiesPkey, i.IdxUserIdentitiesUserID, i.UserIdentitiesProviderSubjectKey,
+	}
+}
+
+type userIdentityForeignKeys struct {
+	UserIdentitiesUserIdentitiesUserIDFkey foreignKey
+}
+
+func (f userIdentityForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.UserIdentitiesUserIdentitiesUserIDFkey,
+	}
+}
+
+type userIdentityUniques struct {
+	UserIdentitiesProviderSubjectKey constraint
+}
+
+func (u userIdentityUniques) AsSlice() []constraint {
+	return []constraint{
+		u.UserIdentitiesProviderSubjectKey,
+	}
+}
+
+type userIdentityChecks struct{}
+
+func (c userIdentityChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,150 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var UserRoles = Table[
+	userRoleColumns,
+	userRoleIndexes,
+	userRoleForeignKeys,
+	userRoleUniques,
+	userRoleChecks,
+]{
+	Schema: "",
+	Name:   "user_roles",
+	Columns: userRoleColumns{
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RoleID: column{
+			Name:      "role_id",
+			DBType:    "bigint",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: userRoleIndexes{
+		UserRolesPkey: index{
+			Type: "btree",
+			Name: "user_roles_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "role_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxUserRolesRoleID: index{
+			Type: "btree",
+			Name: "idx_user_roles_role_id",
+			Columns: []indexColumn{
+				{
+					Name:         "role_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "user_roles_pkey",
+		Columns: []string{"user_id", "role_id"},
+		Comment: "",
+	},
+	ForeignKeys: userRoleForeignKeys{
+		UserRolesUserRolesRoleIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "user_roles.user_roles_role_id_fkey",
+				Columns: []string{"role_id"},
+				Comment: "",
+			},
+			ForeignTable:   "roles",
+			ForeignColumns: []string{"id"},
+		},
+		UserRolesUserRolesUserIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "user_roles.user_roles_user_id_fkey",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type userRoleColumns struct {
+	UserID column
+	RoleID column
+}
+
+func (c userRoleColumns) AsSlice() []column {
+	return []column{
+		c.UserID, c.RoleID,
+	}
+}
+
+type userRoleIndexes struct {
+	UserRolesPkey      index
+	IdxUserRolesRoleID index
+}
+
+func (i userRoleIndexes) AsSlice() []index {
+	return []index{
+		i.UserRolesPkey, i.IdxUserRolesRoleID,
+	}
+}
+
+type userRoleForeignKeys struct {
+	UserRolesUserRolesRoleIDFkey foreignKey
+	UserRolesUserRolesUserIDFkey foreignKey
+}
+
+func (f userRoleForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.UserRolesUserRolesRoleIDFkey, f.UserRolesUserRolesUserIDFkey,
+	}
+}
+
+type userRoleUniques struct{}
+
+func (u userRoleUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type userRoleChecks struct{}
+
+func (c userRoleChecks) AsSlice() []check {
+	return []check{}
+}
@@ -105,6 +105,33 @@ var Users = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		TotpSecret: column{
+			Name:      "totp_secret",
+			DBType:    "text",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TotpEnabledAt: column{
+			Name:      "totp_enabled_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		PendingEmail: column{
+			Name:      "pending_email",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: userIndexes{
 		UsersPkey: index{
@@ -221,11 +248,14 @@ type userColumns struct {
 	CreatedAt       column
 	UpdatedAt       column
 	DeletedAt       column
+	TotpSecret      column
+	TotpEnabledAt   column
+	PendingEmail    column
 }
 
 func (c userColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.FirstName, c.LastName, c.Password, c.Email, c.EmailVerifiedAt, c.Status, c.CreatedAt, c.UpdatedAt, c.DeletedAt,
+		c.ID, c.FirstName, c.LastName, c.Password, c.Email, c.EmailVerifiedAt, c.Status, c.CreatedAt, c.UpdatedAt, c.DeletedAt, c.TotpSecret, c.TotpEnabledAt, c.PendingEmail,
 	}
 }
 
@@ -14,6 +14,7 @@ const (
 	AuthTokenTypesRefresh           AuthTokenTypes = "refresh"
 	AuthTokenTypesResetPassword     AuthTokenTypes = "reset_password"
 	AuthTokenTypesEmailVerification AuthTokenTypes = "email_verification"
+	AuthTokenTypesEmailChange       AuthTokenTypes = "email_change"
 )
 
 func AllAuthTokenTypes() []AuthTokenTypes {
@@ -22,6 +23,7 @@ func AllAuthTokenTypes() []AuthTokenTypes {
 		AuthTokenTypesRefresh,
 		AuthTokenTypesResetPassword,
 		AuthTokenTypesEmailVerification,
+		AuthTokenTypesEmailChange,
 	}
 }
 
@@ -36,7 +38,8 @@ func (e AuthTokenTypes) Valid() bool {
 	case AuthTokenTypesAccess,
 		AuthTokenTypesRefresh,
 		AuthTokenTypesResetPassword,
-		AuthTokenTypesEmailVerification:
+		AuthTokenTypesEmailVerification,
+		AuthTokenTypesEmailChange:
 		return true
 	default:
 		return false
@@ -87,6 +90,319 @@ func (e *AuthTokenTypes) Scan(value any) error {
 	return nil
 }
 
+// Enum values for InvoicePartyRoles
+const (
+	InvoicePartyRolesSupplier          InvoicePartyRoles = "supplier"
+	InvoicePartyRolesBuyer             InvoicePartyRoles = "buyer"
+	InvoicePartyRolesShippingRecipient InvoicePartyRoles = "shipping_recipient"
+)
+
+func AllInvoicePartyRoles() []InvoicePartyRoles {
+	return []InvoicePartyRoles{
+		InvoicePartyRolesSupplier,
+		InvoicePartyRolesBuyer,
+		InvoicePartyRolesShippingRecipient,
+	}
+}
+
+type InvoicePartyRoles string
+
+func (e InvoicePartyRoles) String() string {
+	return string(e)
+}
+
+func (e InvoicePartyRoles) Valid() bool {
+	switch e {
+	case InvoicePartyRolesSupplier,
+		InvoicePartyRolesBuyer,
+		InvoicePartyRolesShippingRecipient:
+		return true
+	default:
+		return false
+	}
+}
+
+// useful when testing in other packages
+func (e InvoicePartyRoles) All() []InvoicePartyRoles {
+	return AllInvoicePartyRoles()
+}
+
+func (e InvoicePartyRoles) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *InvoicePartyRoles) UnmarshalText(text []byte) error {
+	return e.Scan(text)
+}
+
+func (e InvoicePartyRoles) MarshalBinary() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *InvoicePartyRoles) UnmarshalBinary(data []byte) error {
+	return e.Scan(data)
+}
+
+func (e InvoicePartyRoles) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func (e *InvoicePartyRoles) Scan(value any) error {
+	switch x := value.(type) {
+	case string:
+		*e = InvoicePartyRoles(x)
+	case []byte:
+		*e = InvoicePartyRoles(x)
+	case nil:
+		return fmt.Errorf("cannot nil into InvoicePartyRoles")
+	default:
+		return fmt.Errorf("cannot scan type %T: %v", value, value)
+	}
+
+	if !e.Valid() {
+		return fmt.Errorf("invalid InvoicePartyRoles value: %s", *e)
+	}
+
+	return nil
+}
+
+// Enum values for InvoiceStatuses
+const (
+	InvoiceStatusesDraft     InvoiceStatuses = "draft"
+	InvoiceStatusesValidated InvoiceStatuses = "validated"
+	InvoiceStatusesSubmitted InvoiceStatuses = "submitted"
+	InvoiceStatusesValid     InvoiceStatuses = "valid"
+	InvoiceStatusesInvalid   InvoiceStatuses = "invalid"
+	InvoiceStatusesCancelled InvoiceStatuses = "cancelled"
+	InvoiceStatusesRejected  InvoiceStatuses = "rejected"
+)
+
+func AllInvoiceStatuses() []InvoiceStatuses {
+	return []InvoiceStatuses{
+		InvoiceStatusesDraft,
+		InvoiceStatusesValidated,
+		InvoiceStatusesSubmitted,
+		InvoiceStatusesValid,
+		InvoiceStatusesInvalid,
+		InvoiceStatusesCancelled,
+		InvoiceStatusesRejected,
+	}
+}
+
+type InvoiceStatuses string
+
+func (e InvoiceStatuses) String() string {
+	return string(e)
+}
+
+func (e InvoiceStatuses) Valid() bool {
+	switch e {
+	case InvoiceStatusesDraft,
+		InvoiceStatusesValidated,
+		InvoiceStatusesSubmitted,
+		InvoiceStatusesValid,
+		InvoiceStatusesInvalid,
+		InvoiceStatusesCancelled,
+		InvoiceStatusesRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// useful when testing in other packages
+func (e InvoiceStatuses) All() []InvoiceStatuses {
+	return AllInvoiceStatuses()
+}
+
+func (e InvoiceStatuses) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *InvoiceStatuses) UnmarshalText(text []byte) error {
+	return e.Scan(text)
+}
+
+func (e InvoiceStatuses) MarshalBinary() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *InvoiceStatuses) UnmarshalBinary(data []byte) error {
+	return e.Scan(data)
+}
+
+func (e InvoiceStatuses) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func (e *InvoiceStatuses) Scan(value any) error {
+	switch x := value.(type) {
+	case string:
+		*e = InvoiceStatuses(x)
+	case []byte:
+		*e = InvoiceStatuses(x)
+	case nil:
+		return fmt.Errorf("cannot nil into InvoiceStatuses")
+	default:
+		return fmt.Errorf("cannot scan type %T: %v", value, value)
+	}
+
+	if !e.Valid() {
+		return fmt.Errorf("invalid InvoiceStatuses value: %s", *e)
+	}
+
+	return nil
+}
+
+// Enum values for SecurityEventTypes
+const (
+	SecurityEventTypesRefreshTokenReuse SecurityEventTypes = "refresh_token_reuse"
+)
+
+func AllSecurityEventTypes() []SecurityEventTypes {
+	return []SecurityEventTypes{
+		SecurityEventTypesRefreshTokenReuse,
+	}
+}
+
+type SecurityEventTypes string
+
+func (e SecurityEventTypes) String() string {
+	return string(e)
+}
+
+func (e SecurityEventTypes) Valid() bool {
+	switch e {
+	case SecurityEventTypesRefreshTokenReuse:
+		return true
+	default:
+		return false
+	}
+}
+
+// useful when testing in other packages
+func (e SecurityEventTypes) All() []SecurityEventTypes {
+	return AllSecurityEventTypes()
+}
+
+func (e SecurityEventTypes) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SecurityEventTypes) UnmarshalText(text []byte) error {
+	return e.Scan(text)
+}
+
+func (e SecurityEventTypes) MarshalBinary() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SecurityEventTypes) UnmarshalBinary(data []byte) error {
+	return e.Scan(data)
+}
+
+func (e SecurityEventTypes) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func (e *SecurityEventTypes) Scan(value any) error {
+	switch x := value.(type) {
+	case string:
+		*e = SecurityEventTypes(x)
+	case []byte:
+		*e = SecurityEventTypes(x)
+	case nil:
+		return fmt.Errorf("cannot nil into SecurityEventTypes")
+	default:
+		return fmt.Errorf("cannot scan type %T: %v", value, value)
+	}
+
+	if !e.Valid() {
+		return fmt.Errorf("invalid SecurityEventTypes value: %s", *e)
+	}
+
+	return nil
+}
+
+// Enum values for TaxpayerIDTypes
+const (
+	TaxpayerIDTypesNric     TaxpayerIDTypes = "NRIC"
+	TaxpayerIDTypesBRN      TaxpayerIDTypes = "BRN"
+	TaxpayerIDTypesPassport TaxpayerIDTypes = "PASSPORT"
+	TaxpayerIDTypesArmy     TaxpayerIDTypes = "ARMY"
+)
+
+func AllTaxpayerIDTypes() []TaxpayerIDTypes {
+	return []TaxpayerIDTypes{
+		TaxpayerIDTypesNric,
+		TaxpayerIDTypesBRN,
+		TaxpayerIDTypesPassport,
+		TaxpayerIDTypesArmy,
+	}
+}
+
+type TaxpayerIDTypes string
+
+func (e TaxpayerIDTypes) String() string {
+	return string(e)
+}
+
+func (e TaxpayerIDTypes) Valid() bool {
+	switch e {
+	case TaxpayerIDTypesNric,
+		TaxpayerIDTypesBRN,
+		TaxpayerIDTypesPassport,
+		TaxpayerIDTypesArmy:
+		return true
+	default:
+		return false
+	}
+}
+
+// useful when testing in other packages
+func (e TaxpayerIDTypes) All() []TaxpayerIDTypes {
+	return AllTaxpayerIDTypes()
+}
+
+func (e TaxpayerIDTypes) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *TaxpayerIDTypes) UnmarshalText(text []byte) error {
+	return e.Scan(text)
+}
+
+func (e TaxpayerIDTypes) MarshalBinary() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *TaxpayerIDTypes) UnmarshalBinary(data []byte) error {
+	return e.Scan(data)
+}
+
+func (e TaxpayerIDTypes) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func (e *TaxpayerIDTypes) Scan(value any) error {
+	switch x := value.(type) {
+	case string:
+		*e = TaxpayerIDTypes(x)
+	case []byte:
+		*e = TaxpayerIDTypes(x)
+	case nil:
+		return fmt.Errorf("cannot nil into TaxpayerIDTypes")
+	default:
+		return fmt.Errorf("cannot scan type %T: %v", value, value)
+	}
+
+	if !e.Valid() {
+		return fmt.Errorf("invalid TaxpayerIDTypes value: %s", *e)
+	}
+
+	return nil
+}
+
 // Enum values for UserStatus
 const (
 	UserStatusActive    UserStatus = "active"
@@ -0,0 +1,959 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/lib/pq"
+	"github.com/stephenafamo/bob"
+)
+
+type APIKeyMod interface {
+	Apply(context.Context, *APIKeyTemplate)
+}
+
+type APIKeyModFunc func(context.Context, *APIKeyTemplate)
+
+func (f APIKeyModFunc) Apply(ctx context.Context, n *APIKeyTemplate) {
+	f(ctx, n)
+}
+
+type APIKeyModSlice []APIKeyMod
+
+func (mods APIKeyModSlice) Apply(ctx context.Context, n *APIKeyTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// APIKeyTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type APIKeyTemplate struct {
+	ID             func() int64
+	OrganisationID func() int64
+	UserID         func() int64
+	Name           func() string
+	Prefix         func() string
+	KeyHash        func() string
+	Scopes         func() pq.StringArray
+	ExpireAt       func() null.Val[time.Time]
+	LastUsedAt     func() null.Val[time.Time]
+	RevokedAt      func() null.Val[time.Time]
+	CreatedAt      func() null.Val[time.Time]
+	UpdatedAt      func() null.Val[time.Time]
+
+	r apiKeyR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type apiKeyR struct {
+	Organisation *apiKeyROrganisationR
+	User         *apiKeyRUserR
+}
+
+type apiKeyROrganisationR struct {
+	o *OrganisationTemplate
+}
+type apiKeyRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the APIKeyTemplate
+func (o *APIKeyTemplate) Apply(ctx context.Context, mods ...APIKeyMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.APIKey
+// according to the relationships in the template. Nothing is inserted into the db
+func (t APIKeyTemplate) setModelRels(o *models.APIKey) {
+	if t.r.Organisation != nil {
+		rel := t.r.Organisation.o.Build()
+		rel.R.APIKeys = append(rel.R.APIKeys, o)
+		o.OrganisationID = rel.ID // h2
+		o.R.Organisation = rel
+	}
+
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.APIKeys = append(rel.R.APIKeys, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.APIKeySetter
+// this does nothing with the relationship templates
+func (o APIKeyTemplate) BuildSetter() *models.APIKeySetter {
+	m := &models.APIKeySetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.OrganisationID != nil {
+		val := o.OrganisationID()
+		m.OrganisationID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.Name != nil {
+		val := o.Name()
+		m.Name = omit.From(val)
+	}
+	if o.Prefix != nil {
+		val := o.Prefix()
+		m.Prefix = omit.From(val)
+	}
+	if o.KeyHash != nil {
+		val := o.KeyHash()
+		m.KeyHash = omit.From(val)
+	}
+	if o.Scopes != nil {
+		val := o.Scopes()
+		m.Scopes = omit.From(val)
+	}
+	if o.ExpireAt != nil {
+		val := o.ExpireAt()
+		m.ExpireAt = omitnull.FromNull(val)
+	}
+	if o.LastUsedAt != nil {
+		val := o.LastUsedAt()
+		m.LastUsedAt = omitnull.FromNull(val)
+	}
+	if o.RevokedAt != nil {
+		val := o.RevokedAt()
+		m.RevokedAt = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+	if o.UpdatedAt != nil {
+		val := o.UpdatedAt()
+		m.UpdatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.APIKeySetter
+// this does nothing with the relationship templates
+func (o APIKeyTemplate) BuildManySetter(number int) []*models.APIKeySetter {
+	m := make([]*models.APIKeySetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.APIKey
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use APIKeyTemplate.Create
+func (o APIKeyTemplate) Build() *models.APIKey {
+	m := &models.APIKey{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.OrganisationID != nil {
+		m.OrganisationID = o.OrganisationID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.Prefix != nil {
+		m.Prefix = o.Prefix()
+	}
+	if o.KeyHash != nil {
+		m.KeyHash = o.KeyHash()
+	}
+	if o.Scopes != nil {
+		m.Scopes = o.Scopes()
+	}
+	if o.ExpireAt != nil {
+		m.ExpireAt = o.ExpireAt()
+	}
+	if o.LastUsedAt != nil {
+		m.LastUsedAt = o.LastUsedAt()
+	}
+	if o.RevokedAt != nil {
+		m.RevokedAt = o.RevokedAt()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.UpdatedAt != nil {
+		m.UpdatedAt = o.UpdatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.APIKeySlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use APIKeyTemplate.CreateMany
+func (o APIKeyTemplate) BuildMany(number int) models.APIKeySlice {
+	m := make(models.APIKeySlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableAPIKey(m *models.APIKeySetter) {
+	if !(m.OrganisationID.IsValue()) {
+		val := random_int64(nil)
+		m.OrganisationID = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
+	if !(m.Name.IsValue()) {
+		val := random_string(nil, "100")
+		m.Name = omit.From(val)
+	}
+	if !(m.Prefix.IsValue()) {
+		val := random_string(nil, "16")
+		m.Prefix = omit.From(val)
+	}
+	if !(m.KeyHash.IsValue()) {
+		val := random_string(nil, "255")
+		m.KeyHash = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.APIKey
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *APIKeyTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.APIKey) error {
+	var err error
+
+	return err
+}
+
+// Create builds a apiKey and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *APIKeyTemplate) Create(ctx context.Context, exec bob.Executor) (*models.APIKey, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableAPIKey(opt)
+
+	if o.r.Organisation == nil {
+		APIKeyMods.WithNewOrganisation().Apply(ctx, o)
+	}
+
+	var rel0 *models.Organisation
+
+	if o.r.Organisation.o.alreadyPersisted {
+		rel0 = o.r.Organisation.o.Build()
+	} else {
+		rel0, err = o.r.Organisation.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.OrganisationID = omit.From(rel0.ID)
+
+	if o.r.User == nil {
+		APIKeyMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel1 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel1 = o.r.User.o.Build()
+	} else {
+		rel1, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel1.ID)
+
+	m, err := models.APIKeys.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Organisation = rel0
+	m.R.User = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a apiKey and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *APIKeyTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.APIKey {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a apiKey and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *APIKeyTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.APIKey {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple apiKeys and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o APIKeyTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.APIKeySlice, error) {
+	var err error
+	m := make(models.APIKeySlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple apiKeys and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o APIKeyTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.APIKeySlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple apiKeys and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o APIKeyTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.APIKeySlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// APIKey has methods that act as mods for the APIKeyTemplate
+var APIKeyMods apiKeyMods
+
+type apiKeyMods struct{}
+
+func (m apiKeyMods) RandomizeAllColumns(f *faker.Faker) APIKeyMod {
+	return APIKeyModSlice{
+		APIKeyMods.RandomID(f),
+		APIKeyMods.RandomOrganisationID(f),
+		APIKeyMods.RandomUserID(f),
+		APIKeyMods.RandomName(f),
+		APIKeyMods.RandomPrefix(f),
+		APIKeyMods.RandomKeyHash(f),
+		APIKeyMods.RandomScopes(f),
+		APIKeyMods.RandomExpireAt(f),
+		APIKeyMods.RandomLastUsedAt(f),
+		APIKeyMods.RandomRevokedAt(f),
+		APIKeyMods.RandomCreatedAt(f),
+		APIKeyMods.RandomUpdatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m apiKeyMods) ID(val int64) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m apiKeyMods) IDFunc(f func() int64) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m apiKeyMods) UnsetID() APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m apiKeyMods) RandomID(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m apiKeyMods) OrganisationID(val int64) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.OrganisationID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m apiKeyMods) OrganisationIDFunc(f func() int64) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.OrganisationID = f
+	})
+}
+
+// Clear any values for the column
+func (m apiKeyMods) UnsetOrganisationID() APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.OrganisationID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m apiKeyMods) RandomOrganisationID(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.OrganisationID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m apiKeyMods) UserID(val int64) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m apiKeyMods) UserIDFunc(f func() int64) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m apiKeyMods) UnsetUserID() APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m apiKeyMods) RandomUserID(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m apiKeyMods) Name(val string) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.Name = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m apiKeyMods) NameFunc(f func() string) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m apiKeyMods) UnsetName() APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m apiKeyMods) RandomName(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.Name = func() string {
+			return random_string(f, "100")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m apiKeyMods) Prefix(val string) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.Prefix = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m apiKeyMods) PrefixFunc(f func() string) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.Prefix = f
+	})
+}
+
+// Clear any values for the column
+func (m apiKeyMods) UnsetPrefix() APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.Prefix = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m apiKeyMods) RandomPrefix(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.Prefix = func() string {
+			return random_string(f, "16")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m apiKeyMods) KeyHash(val string) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.KeyHash = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m apiKeyMods) KeyHashFunc(f func() string) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.KeyHash = f
+	})
+}
+
+// Clear any values for the column
+func (m apiKeyMods) UnsetKeyHash() APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.KeyHash = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m apiKeyMods) RandomKeyHash(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.KeyHash = func() string {
+			return random_string(f, "255")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m apiKeyMods) Scopes(val pq.StringArray) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.Scopes = func() pq.StringArray { return val }
+	})
+}
+
+// Set the Column from the function
+func (m apiKeyMods) ScopesFunc(f func() pq.StringArray) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.Scopes = f
+	})
+}
+
+// Clear any values for the column
+func (m apiKeyMods) UnsetScopes() APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.Scopes = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m apiKeyMods) RandomScopes(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.Scopes = func() pq.StringArray {
+			return random_pq_StringArray(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m apiKeyMods) ExpireAt(val null.Val[time.Time]) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.ExpireAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m apiKeyMods) ExpireAtFunc(f func() null.Val[time.Time]) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.ExpireAt = f
+	})
+}
+
+// Clear any values for the column
+func (m apiKeyMods) UnsetExpireAt() APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.ExpireAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m apiKeyMods) RandomExpireAt(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.ExpireAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m apiKeyMods) RandomExpireAtNotNull(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.ExpireAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m apiKeyMods) LastUsedAt(val null.Val[time.Time]) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.LastUsedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m apiKeyMods) LastUsedAtFunc(f func() null.Val[time.Time]) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.LastUsedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m apiKeyMods) UnsetLastUsedAt() APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.LastUsedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m apiKeyMods) RandomLastUsedAt(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.LastUsedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m apiKeyMods) RandomLastUsedAtNotNull(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.LastUsedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m apiKeyMods) RevokedAt(val null.Val[time.Time]) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.RevokedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m apiKeyMods) RevokedAtFunc(f func() null.Val[time.Time]) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.RevokedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m apiKeyMods) UnsetRevokedAt() APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.RevokedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m apiKeyMods) RandomRevokedAt(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.RevokedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m apiKeyMods) RandomRevokedAtNotNull(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.RevokedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m apiKeyMods) CreatedAt(val null.Val[time.Time]) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m apiKeyMods) CreatedAtFunc(f func() null.Val[time.Time]) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m apiKeyMods) UnsetCreatedAt() APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m apiKeyMods) RandomCreatedAt(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m apiKeyMods) RandomCreatedAtNotNull(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m apiKeyMods) UpdatedAt(val null.Val[time.Time]) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m apiKeyMods) UpdatedAtFunc(f func() null.Val[time.Time]) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.UpdatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m apiKeyMods) UnsetUpdatedAt() APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.UpdatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m apiKeyMods) RandomUpdatedAt(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m apiKeyMods) RandomUpdatedAtNotNull(f *faker.Faker) APIKeyMod {
+	return APIKeyModFunc(func(_ context.Context, o *APIKeyTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m apiKeyMods) WithParentsCascading() APIKeyMod {
+	return APIKeyModFunc(func(ctx context.Context, o *APIKeyTemplate) {
+		if isDone, _ := apiKeyWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = apiKeyWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewOrganisationWithContext(ctx, OrganisationMods.WithParentsCascading())
+			m.WithOrganisation(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m apiKeyMods) WithOrganisation(rel *OrganisationTemplate) APIKeyMod {
+	return APIKeyModFunc(func(ctx context.Context, o *APIKeyTemplate) {
+		o.r.Organisation = &apiKeyROrganisationR{
+			o: rel,
+		}
+	})
+}
+
+func (m apiKeyMods) WithNewOrganisation(mods ...OrganisationMod) APIKeyMod {
+	return APIKeyModFunc(func(ctx context.Context, o *APIKeyTemplate) {
+		related := o.f.NewOrganisationWithContext(ctx, mods...)
+
+		m.WithOrganisation(related).Apply(ctx, o)
+	})
+}
+
+func (m apiKeyMods) WithExistingOrganisation(em *models.Organisation) APIKeyMod {
+	return APIKeyModFunc(func(ctx context.Context, o *APIKeyTemplate) {
+		o.r.Organisation = &apiKeyROrganisationR{
+			o: o.f.FromExistingOrganisation(em),
+		}
+	})
+}
+
+func (m apiKeyMods) WithoutOrganisation() APIKeyMod {
+	return APIKeyModFunc(func(ctx context.Context, o *APIKeyTemplate) {
+		o.r.Organisation = nil
+	})
+}
+
+func (m apiKeyMods) WithUser(rel *UserTemplate) APIKeyMod {
+	return APIKeyModFunc(func(ctx context.Context, o *APIKeyTemplate) {
+		o.r.User = &apiKeyRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m apiKeyMods) WithNewUser(mods ...UserMod) APIKeyMod {
+	return APIKeyModFunc(func(ctx context.Context, o *APIKeyTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m apiKeyMods) WithExistingUser(em *models.User) APIKeyMod {
+	return APIKeyModFunc(func(ctx context.Context, o *APIKeyTemplate) {
+		o.r.User = &apiKeyRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m apiKeyMods) WithoutUser() APIKeyMod {
+	return APIKeyModFunc(func(ctx context.Context, o *APIKeyTemplate) {
+		o.r.User = nil
+	})
+}
@@ -0,0 +1,1005 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/types"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+type AuditEventMod interface {
+	Apply(context.Context, *AuditEventTemplate)
+}
+
+type AuditEventModFunc func(context.Context, *AuditEventTemplate)
+
+func (f AuditEventModFunc) Apply(ctx context.Context, n *AuditEventTemplate) {
+	f(ctx, n)
+}
+
+type AuditEventModSlice []AuditEventMod
+
+func (mods AuditEventModSlice) Apply(ctx context.Context, n *AuditEventTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// AuditEventTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type AuditEventTemplate struct {
+	ID             func() int64
+	ActorType      func() string
+	ActorID        func() null.Val[int64]
+	ActorLabel     func() null.Val[string]
+	OrganisationID func() null.Val[int64]
+	Action         func() string
+	EntityType     func() null.Val[string]
+	EntityID       func() null.Val[string]
+	IPAddress      func() null.Val[pgtypes.Inet]
+	UserAgent      func() null.Val[string]
+	RequestID      func() null.Val[string]
+	Changes        func() null.Val[types.JSON[json.RawMessage]]
+	Metadata       func() null.Val[types.JSON[json.RawMessage]]
+	CreatedAt      func() time.Time
+
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+// Apply mods to the AuditEventTemplate
+func (o *AuditEventTemplate) Apply(ctx context.Context, mods ...AuditEventMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.AuditEvent
+// according to the relationships in the template. Nothing is inserted into the db
+func (t AuditEventTemplate) setModelRels(o *models.AuditEvent) {}
+
+// BuildSetter returns an *models.AuditEventSetter
+// this does nothing with the relationship templates
+func (o AuditEventTemplate) BuildSetter() *models.AuditEventSetter {
+	m := &models.AuditEventSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.ActorType != nil {
+		val := o.ActorType()
+		m.ActorType = omit.From(val)
+	}
+	if o.ActorID != nil {
+		val := o.ActorID()
+		m.ActorID = omitnull.FromNull(val)
+	}
+	if o.ActorLabel != nil {
+		val := o.ActorLabel()
+		m.ActorLabel = omitnull.FromNull(val)
+	}
+	if o.OrganisationID != nil {
+		val := o.OrganisationID()
+		m.OrganisationID = omitnull.FromNull(val)
+	}
+	if o.Action != nil {
+		val := o.Action()
+		m.Action = omit.From(val)
+	}
+	if o.EntityType != nil {
+		val := o.EntityType()
+		m.EntityType = omitnull.FromNull(val)
+	}
+	if o.EntityID != nil {
+		val := o.EntityID()
+		m.EntityID = omitnull.FromNull(val)
+	}
+	if o.IPAddress != nil {
+		val := o.IPAddress()
+		m.IPAddress = omitnull.FromNull(val)
+	}
+	if o.UserAgent != nil {
+		val := o.UserAgent()
+		m.UserAgent = omitnull.FromNull(val)
+	}
+	if o.RequestID != nil {
+		val := o.RequestID()
+		m.RequestID = omitnull.FromNull(val)
+	}
+	if o.Changes != nil {
+		val := o.Changes()
+		m.Changes = omitnull.FromNull(val)
+	}
+	if o.Metadata != nil {
+		val := o.Metadata()
+		m.Metadata = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.AuditEventSetter
+// this does nothing with the relationship templates
+func (o AuditEventTemplate) BuildManySetter(number int) []*models.AuditEventSetter {
+	m := make([]*models.AuditEventSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.AuditEvent
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use AuditEventTemplate.Create
+func (o AuditEventTemplate) Build() *models.AuditEvent {
+	m := &models.AuditEvent{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.ActorType != nil {
+		m.ActorType = o.ActorType()
+	}
+	if o.ActorID != nil {
+		m.ActorID = o.ActorID()
+	}
+	if o.ActorLabel != nil {
+		m.ActorLabel = o.ActorLabel()
+	}
+	if o.OrganisationID != nil {
+		m.OrganisationID = o.OrganisationID()
+	}
+	if o.Action != nil {
+		m.Action = o.Action()
+	}
+	if o.EntityType != nil {
+		m.EntityType = o.EntityType()
+	}
+	if o.EntityID != nil {
+		m.EntityID = o.EntityID()
+	}
+	if o.IPAddress != nil {
+		m.IPAddress = o.IPAddress()
+	}
+	if o.UserAgent != nil {
+		m.UserAgent = o.UserAgent()
+	}
+	if o.RequestID != nil {
+		m.RequestID = o.RequestID()
+	}
+	if o.Changes != nil {
+		m.Changes = o.Changes()
+	}
+	if o.Metadata != nil {
+		m.Metadata = o.Metadata()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.AuditEventSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use AuditEventTemplate.CreateMany
+func (o AuditEventTemplate) BuildMany(number int) models.AuditEventSlice {
+	m := make(models.AuditEventSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableAuditEvent(m *models.AuditEventSetter) {
+	if !(m.ActorType.IsValue()) {
+		val := random_string(nil, "20")
+		m.ActorType = omit.From(val)
+	}
+	if !(m.Action.IsValue()) {
+		val := random_string(nil, "100")
+		m.Action = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.AuditEvent
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *AuditEventTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.AuditEvent) error {
+	var err error
+
+	return err
+}
+
+// Create builds a auditEvent and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *AuditEventTemplate) Create(ctx context.Context, exec bob.Executor) (*models.AuditEvent, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableAuditEvent(opt)
+
+	m, err := models.AuditEvents.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a auditEvent and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *AuditEventTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.AuditEvent {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a auditEvent and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *AuditEventTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.AuditEvent {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple auditEvents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o AuditEventTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.AuditEventSlice, error) {
+	var err error
+	m := make(models.AuditEventSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple auditEvents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o AuditEventTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.AuditEventSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple auditEvents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o AuditEventTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.AuditEventSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// AuditEvent has methods that act as mods for the AuditEventTemplate
+var AuditEventMods auditEventMods
+
+type auditEventMods struct{}
+
+func (m auditEventMods) RandomizeAllColumns(f *faker.Faker) AuditEventMod {
+	return AuditEventModSlice{
+		AuditEventMods.RandomID(f),
+		AuditEventMods.RandomActorType(f),
+		AuditEventMods.RandomActorID(f),
+		AuditEventMods.RandomActorLabel(f),
+		AuditEventMods.RandomOrganisationID(f),
+		AuditEventMods.RandomAction(f),
+		AuditEventMods.RandomEntityType(f),
+		AuditEventMods.RandomEntityID(f),
+		AuditEventMods.RandomIPAddress(f),
+		AuditEventMods.RandomUserAgent(f),
+		AuditEventMods.RandomRequestID(f),
+		AuditEventMods.RandomChanges(f),
+		AuditEventMods.RandomMetadata(f),
+		AuditEventMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m auditEventMods) ID(val int64) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) IDFunc(f func() int64) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetID() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m auditEventMods) RandomID(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) ActorType(val string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorType = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) ActorTypeFunc(f func() string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorType = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetActorType() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorType = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m auditEventMods) RandomActorType(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorType = func() string {
+			return random_string(f, "20")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) ActorID(val null.Val[int64]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorID = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) ActorIDFunc(f func() null.Val[int64]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorID = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetActorID() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m auditEventMods) RandomActorID(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m auditEventMods) RandomActorIDNotNull(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) ActorLabel(val null.Val[string]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorLabel = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) ActorLabelFunc(f func() null.Val[string]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorLabel = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetActorLabel() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorLabel = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m auditEventMods) RandomActorLabel(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorLabel = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "255")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m auditEventMods) RandomActorLabelNotNull(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorLabel = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "255")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) OrganisationID(val null.Val[int64]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.OrganisationID = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) OrganisationIDFunc(f func() null.Val[int64]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.OrganisationID = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetOrganisationID() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.OrganisationID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m auditEventMods) RandomOrganisationID(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.OrganisationID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m auditEventMods) RandomOrganisationIDNotNull(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.OrganisationID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) Action(val string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Action = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) ActionFunc(f func() string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Action = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetAction() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Action = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m auditEventMods) RandomAction(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Action = func() string {
+			return random_string(f, "100")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) EntityType(val null.Val[string]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.EntityType = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) EntityTypeFunc(f func() null.Val[string]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.EntityType = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetEntityType() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.EntityType = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m auditEventMods) RandomEntityType(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.EntityType = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "50")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m auditEventMods) RandomEntityTypeNotNull(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.EntityType = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "50")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) EntityID(val null.Val[string]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.EntityID = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) EntityIDFunc(f func() null.Val[string]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.EntityID = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetEntityID() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.EntityID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m auditEventMods) RandomEntityID(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.EntityID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "64")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m auditEventMods) RandomEntityIDNotNull(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.EntityID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "64")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) IPAddress(val null.Val[pgtypes.Inet]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.IPAddress = func() null.Val[pgtypes.Inet] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) IPAddressFunc(f func() null.Val[pgtypes.Inet]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.IPAddress = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetIPAddress() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.IPAddress = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m auditEventMods) RandomIPAddress(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.IPAddress = func() null.Val[pgtypes.Inet] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_pgtypes_Inet(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m auditEventMods) RandomIPAddressNotNull(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.IPAddress = func() null.Val[pgtypes.Inet] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_pgtypes_Inet(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) UserAgent(val null.Val[string]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.UserAgent = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) UserAgentFunc(f func() null.Val[string]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.UserAgent = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetUserAgent() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.UserAgent = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m auditEventMods) RandomUserAgent(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.UserAgent = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m auditEventMods) RandomUserAgentNotNull(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.UserAgent = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) RequestID(val null.Val[string]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.RequestID = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) RequestIDFunc(f func() null.Val[string]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.RequestID = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetRequestID() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.RequestID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m auditEventMods) RandomRequestID(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.RequestID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "64")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m auditEventMods) RandomRequestIDNotNull(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.RequestID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "64")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) Changes(val null.Val[types.JSON[json.RawMessage]]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Changes = func() null.Val[types.JSON[json.RawMessage]] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) ChangesFunc(f func() null.Val[types.JSON[json.RawMessage]]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Changes = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetChanges() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Changes = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m auditEventMods) RandomChanges(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Changes = func() null.Val[types.JSON[json.RawMessage]] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_types_JSON_json_RawMessage_(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m auditEventMods) RandomChangesNotNull(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Changes = func() null.Val[types.JSON[json.RawMessage]] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_types_JSON_json_RawMessage_(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) Metadata(val null.Val[types.JSON[json.RawMessage]]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Metadata = func() null.Val[types.JSON[json.RawMessage]] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) MetadataFunc(f func() null.Val[types.JSON[json.RawMessage]]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Metadata = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetMetadata() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Metadata = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m auditEventMods) RandomMetadata(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Metadata = func() null.Val[types.JSON[json.RawMessage]] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_types_JSON_json_RawMessage_(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m auditEventMods) RandomMetadataNotNull(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Metadata = func() null.Val[types.JSON[json.RawMessage]] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_types_JSON_json_RawMessage_(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) CreatedAt(val time.Time) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) CreatedAtFunc(f func() time.Time) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetCreatedAt() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m auditEventMods) RandomCreatedAt(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m auditEventMods) WithParentsCascading() AuditEventMod {
+	return AuditEventModFunc(func(ctx context.Context, o *AuditEventTemplate) {
+		if isDone, _ := auditEventWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = auditEventWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
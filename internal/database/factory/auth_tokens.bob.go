@@ -16,6 +16,7 @@ import (
 	models "github.com/jacoobjake/einvoice-api/internal/database/models"
 	"github.com/jaswdr/faker/v2"
 	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/types/pgtypes"
 )
 
 type AuthTokenMod interface {
@@ -47,6 +48,10 @@ type AuthTokenTemplate struct {
 	CreatedAt func() null.Val[time.Time]
 	UpdatedAt func() null.Val[time.Time]
 	SessionID func() null.Val[uuid.UUID]
+	RotatedAt func() null.Val[time.Time]
+	RevokedAt func() null.Val[time.Time]
+	IPAddress func() null.Val[pgtypes.Inet]
+	UserAgent func() null.Val[string]
 
 	r authTokenR
 	f *Factory
@@ -117,6 +122,22 @@ func (o AuthTokenTemplate) BuildSetter() *models.AuthTokenSetter {
 		val := o.SessionID()
 		m.SessionID = omitnull.FromNull(val)
 	}
+	if o.RotatedAt != nil {
+		val := o.RotatedAt()
+		m.RotatedAt = omitnull.FromNull(val)
+	}
+	if o.RevokedAt != nil {
+		val := o.RevokedAt()
+		m.RevokedAt = omitnull.FromNull(val)
+	}
+	if o.IPAddress != nil {
+		val := o.IPAddress()
+		m.IPAddress = omitnull.FromNull(val)
+	}
+	if o.UserAgent != nil {
+		val := o.UserAgent()
+		m.UserAgent = omitnull.FromNull(val)
+	}
 
 	return m
 }
@@ -163,6 +184,18 @@ func (o AuthTokenTemplate) Build() *models.AuthToken {
 	if o.SessionID != nil {
 		m.SessionID = o.SessionID()
 	}
+	if o.RotatedAt != nil {
+		m.RotatedAt = o.RotatedAt()
+	}
+	if o.RevokedAt != nil {
+		m.RevokedAt = o.RevokedAt()
+	}
+	if o.IPAddress != nil {
+		m.IPAddress = o.IPAddress()
+	}
+	if o.UserAgent != nil {
+		m.UserAgent = o.UserAgent()
+	}
 
 	o.setModelRels(m)
 
@@ -322,6 +355,10 @@ func (m authTokenMods) RandomizeAllColumns(f *faker.Faker) AuthTokenMod {
 		AuthTokenMods.RandomCreatedAt(f),
 		AuthTokenMods.RandomUpdatedAt(f),
 		AuthTokenMods.RandomSessionID(f),
+		AuthTokenMods.RandomRotatedAt(f),
+		AuthTokenMods.RandomRevokedAt(f),
+		AuthTokenMods.RandomIPAddress(f),
+		AuthTokenMods.RandomUserAgent(f),
 	}
 }
 
@@ -661,6 +698,218 @@ func (m authTokenMods) RandomSessionIDNotNull(f *faker.Faker) AuthTokenMod {
 	})
 }
 
+// Set the model columns to this value
+func (m authTokenMods) RotatedAt(val null.Val[time.Time]) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.RotatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m authTokenMods) RotatedAtFunc(f func() null.Val[time.Time]) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.RotatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m authTokenMods) UnsetRotatedAt() AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.RotatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m authTokenMods) RandomRotatedAt(f *faker.Faker) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.RotatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m authTokenMods) RandomRotatedAtNotNull(f *faker.Faker) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.RotatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m authTokenMods) RevokedAt(val null.Val[time.Time]) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.RevokedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m authTokenMods) RevokedAtFunc(f func() null.Val[time.Time]) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.RevokedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m authTokenMods) UnsetRevokedAt() AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.RevokedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m authTokenMods) RandomRevokedAt(f *faker.Faker) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.RevokedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m authTokenMods) RandomRevokedAtNotNull(f *faker.Faker) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.RevokedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m authTokenMods) IPAddress(val null.Val[pgtypes.Inet]) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.IPAddress = func() null.Val[pgtypes.Inet] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m authTokenMods) IPAddressFunc(f func() null.Val[pgtypes.Inet]) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.IPAddress = f
+	})
+}
+
+// Clear any values for the column
+func (m authTokenMods) UnsetIPAddress() AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.IPAddress = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m authTokenMods) RandomIPAddress(f *faker.Faker) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.IPAddress = func() null.Val[pgtypes.Inet] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_pgtypes_Inet(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m authTokenMods) RandomIPAddressNotNull(f *faker.Faker) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.IPAddress = func() null.Val[pgtypes.Inet] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_pgtypes_Inet(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m authTokenMods) UserAgent(val null.Val[string]) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.UserAgent = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m authTokenMods) UserAgentFunc(f func() null.Val[string]) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.UserAgent = f
+	})
+}
+
+// Clear any values for the column
+func (m authTokenMods) UnsetUserAgent() AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.UserAgent = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m authTokenMods) RandomUserAgent(f *faker.Faker) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.UserAgent = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "512")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m authTokenMods) RandomUserAgentNotNull(f *faker.Faker) AuthTokenMod {
+	return AuthTokenModFunc(func(_ context.Context, o *AuthTokenTemplate) {
+		o.UserAgent = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "512")
+			return null.From(val)
+		}
+	})
+}
+
 func (m authTokenMods) WithParentsCascading() AuthTokenMod {
 	return AuthTokenModFunc(func(ctx context.Context, o *AuthTokenTemplate) {
 		if isDone, _ := authTokenWithParentsCascadingCtx.Value(ctx); isDone {
@@ -8,18 +8,133 @@ import "context"
 type contextKey string
 
 var (
+	// Relationship Contexts for api_keys
+	apiKeyWithParentsCascadingCtx = newContextual[bool]("apiKeyWithParentsCascading")
+	apiKeyRelOrganisationCtx      = newContextual[bool]("api_keys.organisations.api_keys.api_keys_organisation_id_fkey")
+	apiKeyRelUserCtx              = newContextual[bool]("api_keys.users.api_keys.api_keys_user_id_fkey")
+
+	// Relationship Contexts for audit_events
+	auditEventWithParentsCascadingCtx = newContextual[bool]("auditEventWithParentsCascading")
+
 	// Relationship Contexts for auth_tokens
 	authTokenWithParentsCascadingCtx = newContextual[bool]("authTokenWithParentsCascading")
 	authTokenRelUserCtx              = newContextual[bool]("auth_tokens.users.auth_tokens.auth_tokens_user_id_fkey")
 
+	// Relationship Contexts for denied_tokens
+	deniedTokenWithParentsCascadingCtx = newContextual[bool]("deniedTokenWithParentsCascading")
+
 	// Relationship Contexts for failed_logins
 	failedLoginWithParentsCascadingCtx = newContextual[bool]("failedLoginWithParentsCascading")
 	failedLoginRelUserCtx              = newContextual[bool]("failed_logins.users.failed_logins.failed_logins_user_id_fkey")
 
+	// Relationship Contexts for invitations
+	invitationWithParentsCascadingCtx = newContextual[bool]("invitationWithParentsCascading")
+	invitationRelAcceptedUserUserCtx  = newContextual[bool]("invitations.users.invitations.invitations_accepted_user_id_fkey")
+	invitationRelInvitedByUserCtx     = newContextual[bool]("invitations.users.invitations.invitations_invited_by_fkey")
+	invitationRelRoleCtx              = newContextual[bool]("invitations.roles.invitations.invitations_role_id_fkey")
+
+	// Relationship Contexts for invoice_allowance_charges
+	invoiceAllowanceChargeWithParentsCascadingCtx = newContextual[bool]("invoiceAllowanceChargeWithParentsCascading")
+	invoiceAllowanceChargeRelInvoiceCtx           = newContextual[bool]("invoice_allowance_charges.invoices.invoice_allowance_charges.invoice_allowance_charges_invoice_id_fkey")
+	invoiceAllowanceChargeRelInvoiceLineCtx       = newContextual[bool]("invoice_allowance_charges.invoice_lines.invoice_allowance_charges.invoice_allowance_charges_invoice_line_id_fkey")
+
+	// Relationship Contexts for invoice_line_tax_breakdowns
+	invoiceLineTaxBreakdownWithParentsCascadingCtx = newContextual[bool]("invoiceLineTaxBreakdownWithParentsCascading")
+	invoiceLineTaxBreakdownRelInvoiceLineCtx       = newContextual[bool]("invoice_line_tax_breakdowns.invoice_lines.invoice_line_tax_breakdowns.invoice_line_tax_breakdowns_invoice_line_id_fkey")
+
+	// Relationship Contexts for invoice_lines
+	invoiceLineWithParentsCascadingCtx        = newContextual[bool]("invoiceLineWithParentsCascading")
+	invoiceLineRelInvoiceAllowanceChargesCtx  = newContextual[bool]("invoice_allowance_charges.invoice_lines.invoice_allowance_charges.invoice_allowance_charges_invoice_line_id_fkey")
+	invoiceLineRelInvoiceLineTaxBreakdownsCtx = newContextual[bool]("invoice_line_tax_breakdowns.invoice_lines.invoice_line_tax_breakdowns.invoice_line_tax_breakdowns_invoice_line_id_fkey")
+	invoiceLineRelInvoiceCtx                  = newContextual[bool]("invoice_lines.invoices.invoice_lines.invoice_lines_invoice_id_fkey")
+
+	// Relationship Contexts for invoice_parties
+	invoicePartyWithParentsCascadingCtx = newContextual[bool]("invoicePartyWithParentsCascading")
+	invoicePartyRelInvoiceCtx           = newContextual[bool]("invoice_parties.invoices.invoice_parties.invoice_parties_invoice_id_fkey")
+
+	// Relationship Contexts for invoices
+	invoiceWithParentsCascadingCtx       = newContextual[bool]("invoiceWithParentsCascading")
+	invoiceRelInvoiceAllowanceChargesCtx = newContextual[bool]("invoice_allowance_charges.invoices.invoice_allowance_charges.invoice_allowance_charges_invoice_id_fkey")
+	invoiceRelInvoiceLinesCtx            = newContextual[bool]("invoice_lines.invoices.invoice_lines.invoice_lines_invoice_id_fkey")
+	invoiceRelInvoicePartiesCtx          = newContextual[bool]("invoice_parties.invoices.invoice_parties.invoice_parties_invoice_id_fkey")
+	invoiceRelCreatedByUserCtx           = newContextual[bool]("invoices.users.invoices.invoices_created_by_fkey")
+	invoiceRelOrganisationCtx            = newContextual[bool]("invoices.organisations.invoices.invoices_organisation_id_fkey")
+	invoiceRelOriginalInvoiceCtx         = newContextual[bool]("invoices.invoices.invoices.invoices_original_invoice_id_fkey")
+	invoiceRelReverseOriginalInvoicesCtx = newContextual[bool]("invoices.invoices.invoices.invoices_original_invoice_id_fkey")
+
+	// Relationship Contexts for mfa_recovery_codes
+	mfaRecoveryCodeWithParentsCascadingCtx = newContextual[bool]("mfaRecoveryCodeWithParentsCascading")
+	mfaRecoveryCodeRelUserCtx              = newContextual[bool]("mfa_recovery_codes.users.mfa_recovery_codes.mfa_recovery_codes_user_id_fkey")
+
+	// Relationship Contexts for oauth_clients
+	oauthClientWithParentsCascadingCtx = newContextual[bool]("oauthClientWithParentsCascading")
+	oauthClientRelOrganisationCtx      = newContextual[bool]("oauth_clients.organisations.oauth_clients.oauth_clients_organisation_id_fkey")
+	oauthClientRelUserCtx              = newContextual[bool]("oauth_clients.users.oauth_clients.oauth_clients_user_id_fkey")
+
+	// Relationship Contexts for organisation_members
+	organisationMemberWithParentsCascadingCtx = newContextual[bool]("organisationMemberWithParentsCascading")
+	organisationMemberRelOrganisationCtx      = newContextual[bool]("organisation_members.organisations.organisation_members.organisation_members_organisation_id_fkey")
+	organisationMemberRelUserCtx              = newContextual[bool]("organisation_members.users.organisation_members.organisation_members_user_id_fkey")
+
+	// Relationship Contexts for organisations
+	organisationWithParentsCascadingCtx   = newContextual[bool]("organisationWithParentsCascading")
+	organisationRelAPIKeysCtx             = newContextual[bool]("api_keys.organisations.api_keys.api_keys_organisation_id_fkey")
+	organisationRelInvoicesCtx            = newContextual[bool]("invoices.organisations.invoices.invoices_organisation_id_fkey")
+	organisationRelOauthClientsCtx        = newContextual[bool]("oauth_clients.organisations.oauth_clients.oauth_clients_organisation_id_fkey")
+	organisationRelOrganisationMembersCtx = newContextual[bool]("organisation_members.organisations.organisation_members.organisation_members_organisation_id_fkey")
+	organisationRelTaxpayerProfileCtx     = newContextual[bool]("organisations.taxpayer_profiles.taxpayer_profiles.taxpayer_profiles_organisation_id_fkey")
+
+	// Relationship Contexts for password_histories
+	passwordHistoryWithParentsCascadingCtx = newContextual[bool]("passwordHistoryWithParentsCascading")
+	passwordHistoryRelUserCtx              = newContextual[bool]("password_histories.users.password_histories.password_histories_user_id_fkey")
+
+	// Relationship Contexts for permissions
+	permissionWithParentsCascadingCtx = newContextual[bool]("permissionWithParentsCascading")
+	permissionRelRolesCtx             = newContextual[bool]("permissions.roles.role_permissions.role_permissions_permission_id_fkeyrole_permissions.role_permissions_role_id_fkey")
+
+	// Relationship Contexts for role_permissions
+	rolePermissionWithParentsCascadingCtx = newContextual[bool]("rolePermissionWithParentsCascading")
+	rolePermissionRelPermissionCtx        = newContextual[bool]("permissions.role_permissions.role_permissions.role_permissions_permission_id_fkey")
+	rolePermissionRelRoleCtx              = newContextual[bool]("role_permissions.roles.role_permissions.role_permissions_role_id_fkey")
+
+	// Relationship Contexts for roles
+	roleWithParentsCascadingCtx = newContextual[bool]("roleWithParentsCascading")
+	roleRelInvitationsCtx       = newContextual[bool]("invitations.roles.invitations.invitations_role_id_fkey")
+	roleRelPermissionsCtx       = newContextual[bool]("permissions.roles.role_permissions.role_permissions_permission_id_fkeyrole_permissions.role_permissions_role_id_fkey")
+	roleRelUsersCtx             = newContextual[bool]("roles.users.user_roles.user_roles_role_id_fkeyuser_roles.user_roles_user_id_fkey")
+
+	// Relationship Contexts for security_events
+	securityEventWithParentsCascadingCtx = newContextual[bool]("securityEventWithParentsCascading")
+	securityEventRelUserCtx              = newContextual[bool]("security_events.users.security_events.security_events_user_id_fkey")
+
+	// Relationship Contexts for taxpayer_profiles
+	taxpayerProfileWithParentsCascadingCtx = newContextual[bool]("taxpayerProfileWithParentsCascading")
+	taxpayerProfileRelOrganisationCtx      = newContextual[bool]("organisations.taxpayer_profiles.taxpayer_profiles.taxpayer_profiles_organisation_id_fkey")
+
+	// Relationship Contexts for user_identities
+	userIdentityWithParentsCascadingCtx = newContextual[bool]("userIdentityWithParentsCascading")
+	userIdentityRelUserCtx              = newContextual[bool]("user_identities.users.user_identities.user_identities_user_id_fkey")
+
+	// Relationship Contexts for user_roles
+	userRoleWithParentsCascadingCtx = newContextual[bool]("userRoleWithParentsCascading")
+	userRoleRelRoleCtx              = newContextual[bool]("roles.user_roles.user_roles.user_roles_role_id_fkey")
+	userRoleRelUserCtx              = newContextual[bool]("user_roles.users.user_roles.user_roles_user_id_fkey")
+
 	// Relationship Contexts for users
-	userWithParentsCascadingCtx = newContextual[bool]("userWithParentsCascading")
-	userRelAuthTokensCtx        = newContextual[bool]("auth_tokens.users.auth_tokens.auth_tokens_user_id_fkey")
-	userRelFailedLoginsCtx      = newContextual[bool]("failed_logins.users.failed_logins.failed_logins_user_id_fkey")
+	userWithParentsCascadingCtx       = newContextual[bool]("userWithParentsCascading")
+	userRelAPIKeysCtx                 = newContextual[bool]("api_keys.users.api_keys.api_keys_user_id_fkey")
+	userRelAuthTokensCtx              = newContextual[bool]("auth_tokens.users.auth_tokens.auth_tokens_user_id_fkey")
+	userRelFailedLoginsCtx            = newContextual[bool]("failed_logins.users.failed_logins.failed_logins_user_id_fkey")
+	userRelAcceptedUserInvitationsCtx = newContextual[bool]("invitations.users.invitations.invitations_accepted_user_id_fkey")
+	userRelInvitedByInvitationsCtx    = newContextual[bool]("invitations.users.invitations.invitations_invited_by_fkey")
+	userRelCreatedByInvoicesCtx       = newContextual[bool]("invoices.users.invoices.invoices_created_by_fkey")
+	userRelMfaRecoveryCodesCtx        = newContextual[bool]("mfa_recovery_codes.users.mfa_recovery_codes.mfa_recovery_codes_user_id_fkey")
+	userRelOauthClientsCtx            = newContextual[bool]("oauth_clients.users.oauth_clients.oauth_clients_user_id_fkey")
+	userRelOrganisationMembersCtx     = newContextual[bool]("organisation_members.users.organisation_members.organisation_members_user_id_fkey")
+	userRelPasswordHistoriesCtx       = newContextual[bool]("password_histories.users.password_histories.password_histories_user_id_fkey")
+	userRelSecurityEventsCtx          = newContextual[bool]("security_events.users.security_events.security_events_user_id_fkey")
+	userRelUserIdentitiesCtx          = newContextual[bool]("user_identities.users.user_identities.user_identities_user_id_fkey")
+	userRelRolesCtx                   = newContextual[bool]("roles.users.user_roles.user_roles_role_id_fkeyuser_roles.user_roles_user_id_fkey")
 )
 
 // Contextual is a convienience wrapper around context.WithValue and context.Value
@@ -5,88 +5,957 @@ package factory
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/aarondl/opt/null"
 	"github.com/gofrs/uuid/v5"
 	enums "github.com/jacoobjake/einvoice-api/internal/database/enums"
 	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"github.com/stephenafamo/bob/types"
 	"github.com/stephenafamo/bob/types/pgtypes"
 )
 
 type Factory struct {
-	baseAuthTokenMods   AuthTokenModSlice
-	baseFailedLoginMods FailedLoginModSlice
-	baseUserMods        UserModSlice
+	baseAPIKeyMods                  APIKeyModSlice
+	baseAuditEventMods              AuditEventModSlice
+	baseAuthTokenMods               AuthTokenModSlice
+	baseDeniedTokenMods             DeniedTokenModSlice
+	baseFailedLoginMods             FailedLoginModSlice
+	baseInvitationMods              InvitationModSlice
+	baseInvoiceAllowanceChargeMods  InvoiceAllowanceChargeModSlice
+	baseInvoiceLineTaxBreakdownMods InvoiceLineTaxBreakdownModSlice
+	baseInvoiceLineMods             InvoiceLineModSlice
+	baseInvoicePartyMods            InvoicePartyModSlice
+	baseInvoiceMods                 InvoiceModSlice
+	baseMfaRecoveryCodeMods         MfaRecoveryCodeModSlice
+	baseOauthClientMods             OauthClientModSlice
+	baseOrganisationMemberMods      OrganisationMemberModSlice
+	baseOrganisationMods            OrganisationModSlice
+	basePasswordHistoryMods         PasswordHistoryModSlice
+	basePermissionMods              PermissionModSlice
+	baseRolePermissionMods          RolePermissionModSlice
+	baseRoleMods                    RoleModSlice
+	baseSecurityEventMods           SecurityEventModSlice
+	baseTaxpayerProfileMods         TaxpayerProfileModSlice
+	baseUserIdentityMods            UserIdentityModSlice
+	baseUserRoleMods                UserRoleModSlice
+	baseUserMods                    UserModSlice
 }
 
 func New() *Factory {
 	return &Factory{}
 }
 
+func (f *Factory) NewAPIKey(mods ...APIKeyMod) *APIKeyTemplate {
+	return f.NewAPIKeyWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewAPIKeyWithContext(ctx context.Context, mods ...APIKeyMod) *APIKeyTemplate {
+	o := &APIKeyTemplate{f: f}
+
+	if f != nil {
+		f.baseAPIKeyMods.Apply(ctx, o)
+	}
+
+	APIKeyModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingAPIKey(m *models.APIKey) *APIKeyTemplate {
+	o := &APIKeyTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.OrganisationID = func() int64 { return m.OrganisationID }
+	o.UserID = func() int64 { return m.UserID }
+	o.Name = func() string { return m.Name }
+	o.Prefix = func() string { return m.Prefix }
+	o.KeyHash = func() string { return m.KeyHash }
+	o.Scopes = func() pq.StringArray { return m.Scopes }
+	o.ExpireAt = func() null.Val[time.Time] { return m.ExpireAt }
+	o.LastUsedAt = func() null.Val[time.Time] { return m.LastUsedAt }
+	o.RevokedAt = func() null.Val[time.Time] { return m.RevokedAt }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+	o.UpdatedAt = func() null.Val[time.Time] { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if m.R.Organisation != nil {
+		APIKeyMods.WithExistingOrganisation(m.R.Organisation).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		APIKeyMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewAuditEvent(mods ...AuditEventMod) *AuditEventTemplate {
+	return f.NewAuditEventWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewAuditEventWithContext(ctx context.Context, mods ...AuditEventMod) *AuditEventTemplate {
+	o := &AuditEventTemplate{f: f}
+
+	if f != nil {
+		f.baseAuditEventMods.Apply(ctx, o)
+	}
+
+	AuditEventModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingAuditEvent(m *models.AuditEvent) *AuditEventTemplate {
+	o := &AuditEventTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.ActorType = func() string { return m.ActorType }
+	o.ActorID = func() null.Val[int64] { return m.ActorID }
+	o.ActorLabel = func() null.Val[string] { return m.ActorLabel }
+	o.OrganisationID = func() null.Val[int64] { return m.OrganisationID }
+	o.Action = func() string { return m.Action }
+	o.EntityType = func() null.Val[string] { return m.EntityType }
+	o.EntityID = func() null.Val[string] { return m.EntityID }
+	o.IPAddress = func() null.Val[pgtypes.Inet] { return m.IPAddress }
+	o.UserAgent = func() null.Val[string] { return m.UserAgent }
+	o.RequestID = func() null.Val[string] { return m.RequestID }
+	o.Changes = func() null.Val[types.JSON[json.RawMessage]] { return m.Changes }
+	o.Metadata = func() null.Val[types.JSON[json.RawMessage]] { return m.Metadata }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	return o
+}
+
 func (f *Factory) NewAuthToken(mods ...AuthTokenMod) *AuthTokenTemplate {
 	return f.NewAuthTokenWithContext(context.Background(), mods...)
 }
 
-func (f *Factory) NewAuthTokenWithContext(ctx context.Context, mods ...AuthTokenMod) *AuthTokenTemplate {
-	o := &AuthTokenTemplate{f: f}
+func (f *Factory) NewAuthTokenWithContext(ctx context.Context, mods ...AuthTokenMod) *AuthTokenTemplate {
+	o := &AuthTokenTemplate{f: f}
+
+	if f != nil {
+		f.baseAuthTokenMods.Apply(ctx, o)
+	}
+
+	AuthTokenModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingAuthToken(m *models.AuthToken) *AuthTokenTemplate {
+	o := &AuthTokenTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.UserID = func() int64 { return m.UserID }
+	o.Type = func() enums.AuthTokenTypes { return m.Type }
+	o.Token = func() string { return m.Token }
+	o.ExpireAt = func() null.Val[time.Time] { return m.ExpireAt }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+	o.UpdatedAt = func() null.Val[time.Time] { return m.UpdatedAt }
+	o.SessionID = func() null.Val[uuid.UUID] { return m.SessionID }
+	o.RotatedAt = func() null.Val[time.Time] { return m.RotatedAt }
+	o.RevokedAt = func() null.Val[time.Time] { return m.RevokedAt }
+	o.IPAddress = func() null.Val[pgtypes.Inet] { return m.IPAddress }
+	o.UserAgent = func() null.Val[string] { return m.UserAgent }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		AuthTokenMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewDeniedToken(mods ...DeniedTokenMod) *DeniedTokenTemplate {
+	return f.NewDeniedTokenWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewDeniedTokenWithContext(ctx context.Context, mods ...DeniedTokenMod) *DeniedTokenTemplate {
+	o := &DeniedTokenTemplate{f: f}
+
+	if f != nil {
+		f.baseDeniedTokenMods.Apply(ctx, o)
+	}
+
+	DeniedTokenModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingDeniedToken(m *models.DeniedToken) *DeniedTokenTemplate {
+	o := &DeniedTokenTemplate{f: f, alreadyPersisted: true}
+
+	o.Jti = func() string { return m.Jti }
+	o.ExpiresAt = func() time.Time { return m.ExpiresAt }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	return o
+}
+
+func (f *Factory) NewFailedLogin(mods ...FailedLoginMod) *FailedLoginTemplate {
+	return f.NewFailedLoginWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewFailedLoginWithContext(ctx context.Context, mods ...FailedLoginMod) *FailedLoginTemplate {
+	o := &FailedLoginTemplate{f: f}
+
+	if f != nil {
+		f.baseFailedLoginMods.Apply(ctx, o)
+	}
+
+	FailedLoginModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingFailedLogin(m *models.FailedLogin) *FailedLoginTemplate {
+	o := &FailedLoginTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.UserID = func() null.Val[int64] { return m.UserID }
+	o.IPAddress = func() pgtypes.Inet { return m.IPAddress }
+	o.AttemptedAt = func() null.Val[time.Time] { return m.AttemptedAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		FailedLoginMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewInvitation(mods ...InvitationMod) *InvitationTemplate {
+	return f.NewInvitationWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewInvitationWithContext(ctx context.Context, mods ...InvitationMod) *InvitationTemplate {
+	o := &InvitationTemplate{f: f}
+
+	if f != nil {
+		f.baseInvitationMods.Apply(ctx, o)
+	}
+
+	InvitationModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingInvitation(m *models.Invitation) *InvitationTemplate {
+	o := &InvitationTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.Email = func() string { return m.Email }
+	o.RoleID = func() int64 { return m.RoleID }
+	o.InvitedBy = func() null.Val[int64] { return m.InvitedBy }
+	o.Token = func() string { return m.Token }
+	o.ExpireAt = func() time.Time { return m.ExpireAt }
+	o.AcceptedAt = func() null.Val[time.Time] { return m.AcceptedAt }
+	o.AcceptedUserID = func() null.Val[int64] { return m.AcceptedUserID }
+	o.RevokedAt = func() null.Val[time.Time] { return m.RevokedAt }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+	o.UpdatedAt = func() null.Val[time.Time] { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if m.R.AcceptedUserUser != nil {
+		InvitationMods.WithExistingAcceptedUserUser(m.R.AcceptedUserUser).Apply(ctx, o)
+	}
+	if m.R.InvitedByUser != nil {
+		InvitationMods.WithExistingInvitedByUser(m.R.InvitedByUser).Apply(ctx, o)
+	}
+	if m.R.Role != nil {
+		InvitationMods.WithExistingRole(m.R.Role).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewInvoiceAllowanceCharge(mods ...InvoiceAllowanceChargeMod) *InvoiceAllowanceChargeTemplate {
+	return f.NewInvoiceAllowanceChargeWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewInvoiceAllowanceChargeWithContext(ctx context.Context, mods ...InvoiceAllowanceChargeMod) *InvoiceAllowanceChargeTemplate {
+	o := &InvoiceAllowanceChargeTemplate{f: f}
+
+	if f != nil {
+		f.baseInvoiceAllowanceChargeMods.Apply(ctx, o)
+	}
+
+	InvoiceAllowanceChargeModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingInvoiceAllowanceCharge(m *models.InvoiceAllowanceCharge) *InvoiceAllowanceChargeTemplate {
+	o := &InvoiceAllowanceChargeTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.InvoiceID = func() int64 { return m.InvoiceID }
+	o.InvoiceLineID = func() null.Val[int64] { return m.InvoiceLineID }
+	o.IsCharge = func() bool { return m.IsCharge }
+	o.Reason = func() null.Val[string] { return m.Reason }
+	o.Rate = func() null.Val[decimal.Decimal] { return m.Rate }
+	o.Amount = func() decimal.Decimal { return m.Amount }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.Invoice != nil {
+		InvoiceAllowanceChargeMods.WithExistingInvoice(m.R.Invoice).Apply(ctx, o)
+	}
+	if m.R.InvoiceLine != nil {
+		InvoiceAllowanceChargeMods.WithExistingInvoiceLine(m.R.InvoiceLine).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewInvoiceLineTaxBreakdown(mods ...InvoiceLineTaxBreakdownMod) *InvoiceLineTaxBreakdownTemplate {
+	return f.NewInvoiceLineTaxBreakdownWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewInvoiceLineTaxBreakdownWithContext(ctx context.Context, mods ...InvoiceLineTaxBreakdownMod) *InvoiceLineTaxBreakdownTemplate {
+	o := &InvoiceLineTaxBreakdownTemplate{f: f}
+
+	if f != nil {
+		f.baseInvoiceLineTaxBreakdownMods.Apply(ctx, o)
+	}
+
+	InvoiceLineTaxBreakdownModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingInvoiceLineTaxBreakdown(m *models.InvoiceLineTaxBreakdown) *InvoiceLineTaxBreakdownTemplate {
+	o := &InvoiceLineTaxBreakdownTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.InvoiceLineID = func() int64 { return m.InvoiceLineID }
+	o.TaxType = func() string { return m.TaxType }
+	o.Rate = func() null.Val[decimal.Decimal] { return m.Rate }
+	o.PerUnitAmount = func() null.Val[decimal.Decimal] { return m.PerUnitAmount }
+	o.ExemptionReason = func() null.Val[string] { return m.ExemptionReason }
+	o.TaxableAmount = func() decimal.Decimal { return m.TaxableAmount }
+	o.TaxAmount = func() decimal.Decimal { return m.TaxAmount }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.InvoiceLine != nil {
+		InvoiceLineTaxBreakdownMods.WithExistingInvoiceLine(m.R.InvoiceLine).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewInvoiceLine(mods ...InvoiceLineMod) *InvoiceLineTemplate {
+	return f.NewInvoiceLineWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewInvoiceLineWithContext(ctx context.Context, mods ...InvoiceLineMod) *InvoiceLineTemplate {
+	o := &InvoiceLineTemplate{f: f}
+
+	if f != nil {
+		f.baseInvoiceLineMods.Apply(ctx, o)
+	}
+
+	InvoiceLineModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingInvoiceLine(m *models.InvoiceLine) *InvoiceLineTemplate {
+	o := &InvoiceLineTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.InvoiceID = func() int64 { return m.InvoiceID }
+	o.LineNumber = func() int32 { return m.LineNumber }
+	o.ClassificationCode = func() string { return m.ClassificationCode }
+	o.Description = func() string { return m.Description }
+	o.Quantity = func() decimal.Decimal { return m.Quantity }
+	o.UnitCode = func() null.Val[string] { return m.UnitCode }
+	o.UnitPrice = func() decimal.Decimal { return m.UnitPrice }
+	o.ProductTariffCode = func() null.Val[string] { return m.ProductTariffCode }
+	o.CountryOfOrigin = func() null.Val[string] { return m.CountryOfOrigin }
+	o.Subtotal = func() decimal.Decimal { return m.Subtotal }
+	o.TotalExcludingTax = func() decimal.Decimal { return m.TotalExcludingTax }
+	o.TaxAmount = func() decimal.Decimal { return m.TaxAmount }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if len(m.R.InvoiceAllowanceCharges) > 0 {
+		InvoiceLineMods.AddExistingInvoiceAllowanceCharges(m.R.InvoiceAllowanceCharges...).Apply(ctx, o)
+	}
+	if len(m.R.InvoiceLineTaxBreakdowns) > 0 {
+		InvoiceLineMods.AddExistingInvoiceLineTaxBreakdowns(m.R.InvoiceLineTaxBreakdowns...).Apply(ctx, o)
+	}
+	if m.R.Invoice != nil {
+		InvoiceLineMods.WithExistingInvoice(m.R.Invoice).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewInvoiceParty(mods ...InvoicePartyMod) *InvoicePartyTemplate {
+	return f.NewInvoicePartyWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewInvoicePartyWithContext(ctx context.Context, mods ...InvoicePartyMod) *InvoicePartyTemplate {
+	o := &InvoicePartyTemplate{f: f}
+
+	if f != nil {
+		f.baseInvoicePartyMods.Apply(ctx, o)
+	}
+
+	InvoicePartyModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingInvoiceParty(m *models.InvoiceParty) *InvoicePartyTemplate {
+	o := &InvoicePartyTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.InvoiceID = func() int64 { return m.InvoiceID }
+	o.Role = func() enums.InvoicePartyRoles { return m.Role }
+	o.Name = func() string { return m.Name }
+	o.Tin = func() string { return m.Tin }
+	o.IDType = func() enums.TaxpayerIDTypes { return m.IDType }
+	o.IDValue = func() string { return m.IDValue }
+	o.SSTRegistrationNumber = func() string { return m.SSTRegistrationNumber }
+	o.TourismTaxRegistrationNumber = func() null.Val[string] { return m.TourismTaxRegistrationNumber }
+	o.MsicCode = func() null.Val[string] { return m.MsicCode }
+	o.BusinessActivityDescription = func() null.Val[string] { return m.BusinessActivityDescription }
+	o.AddressLine1 = func() string { return m.AddressLine1 }
+	o.AddressLine2 = func() null.Val[string] { return m.AddressLine2 }
+	o.AddressLine3 = func() null.Val[string] { return m.AddressLine3 }
+	o.PostalZone = func() null.Val[string] { return m.PostalZone }
+	o.CityName = func() string { return m.CityName }
+	o.StateCode = func() string { return m.StateCode }
+	o.CountryCode = func() string { return m.CountryCode }
+	o.Phone = func() null.Val[string] { return m.Phone }
+	o.Email = func() null.Val[string] { return m.Email }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.Invoice != nil {
+		InvoicePartyMods.WithExistingInvoice(m.R.Invoice).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewInvoice(mods ...InvoiceMod) *InvoiceTemplate {
+	return f.NewInvoiceWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewInvoiceWithContext(ctx context.Context, mods ...InvoiceMod) *InvoiceTemplate {
+	o := &InvoiceTemplate{f: f}
+
+	if f != nil {
+		f.baseInvoiceMods.Apply(ctx, o)
+	}
+
+	InvoiceModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingInvoice(m *models.Invoice) *InvoiceTemplate {
+	o := &InvoiceTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.OrganisationID = func() int64 { return m.OrganisationID }
+	o.InvoiceNumber = func() string { return m.InvoiceNumber }
+	o.TypeCode = func() string { return m.TypeCode }
+	o.Status = func() enums.InvoiceStatuses { return m.Status }
+	o.IssueDate = func() time.Time { return m.IssueDate }
+	o.CurrencyCode = func() string { return m.CurrencyCode }
+	o.ExchangeRate = func() null.Val[decimal.Decimal] { return m.ExchangeRate }
+	o.BillingPeriodStart = func() null.Val[time.Time] { return m.BillingPeriodStart }
+	o.BillingPeriodEnd = func() null.Val[time.Time] { return m.BillingPeriodEnd }
+	o.BillingFrequency = func() null.Val[string] { return m.BillingFrequency }
+	o.PaymentMode = func() null.Val[string] { return m.PaymentMode }
+	o.PaymentTerms = func() null.Val[string] { return m.PaymentTerms }
+	o.SupplierBankAccount = func() null.Val[string] { return m.SupplierBankAccount }
+	o.TotalLineAmount = func() decimal.Decimal { return m.TotalLineAmount }
+	o.TotalAllowanceAmount = func() decimal.Decimal { return m.TotalAllowanceAmount }
+	o.TotalChargeAmount = func() decimal.Decimal { return m.TotalChargeAmount }
+	o.TotalExcludingTax = func() decimal.Decimal { return m.TotalExcludingTax }
+	o.TotalTaxAmount = func() decimal.Decimal { return m.TotalTaxAmount }
+	o.TotalIncludingTax = func() decimal.Decimal { return m.TotalIncludingTax }
+	o.RoundingAmount = func() decimal.Decimal { return m.RoundingAmount }
+	o.PayableAmount = func() decimal.Decimal { return m.PayableAmount }
+	o.CreatedBy = func() null.Val[int64] { return m.CreatedBy }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+	o.UpdatedAt = func() null.Val[time.Time] { return m.UpdatedAt }
+	o.UUID = func() null.Val[string] { return m.UUID }
+	o.OriginalInvoiceID = func() null.Val[int64] { return m.OriginalInvoiceID }
+	o.OriginalUUID = func() null.Val[string] { return m.OriginalUUID }
+	o.OriginalInvoiceNumber = func() null.Val[string] { return m.OriginalInvoiceNumber }
+
+	ctx := context.Background()
+	if len(m.R.InvoiceAllowanceCharges) > 0 {
+		InvoiceMods.AddExistingInvoiceAllowanceCharges(m.R.InvoiceAllowanceCharges...).Apply(ctx, o)
+	}
+	if len(m.R.InvoiceLines) > 0 {
+		InvoiceMods.AddExistingInvoiceLines(m.R.InvoiceLines...).Apply(ctx, o)
+	}
+	if len(m.R.InvoiceParties) > 0 {
+		InvoiceMods.AddExistingInvoiceParties(m.R.InvoiceParties...).Apply(ctx, o)
+	}
+	if m.R.CreatedByUser != nil {
+		InvoiceMods.WithExistingCreatedByUser(m.R.CreatedByUser).Apply(ctx, o)
+	}
+	if m.R.Organisation != nil {
+		InvoiceMods.WithExistingOrganisation(m.R.Organisation).Apply(ctx, o)
+	}
+	if m.R.OriginalInvoice != nil {
+		InvoiceMods.WithExistingOriginalInvoice(m.R.OriginalInvoice).Apply(ctx, o)
+	}
+	if len(m.R.ReverseOriginalInvoices) > 0 {
+		InvoiceMods.AddExistingReverseOriginalInvoices(m.R.ReverseOriginalInvoices...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewMfaRecoveryCode(mods ...MfaRecoveryCodeMod) *MfaRecoveryCodeTemplate {
+	return f.NewMfaRecoveryCodeWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewMfaRecoveryCodeWithContext(ctx context.Context, mods ...MfaRecoveryCodeMod) *MfaRecoveryCodeTemplate {
+	o := &MfaRecoveryCodeTemplate{f: f}
+
+	if f != nil {
+		f.baseMfaRecoveryCodeMods.Apply(ctx, o)
+	}
+
+	MfaRecoveryCodeModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingMfaRecoveryCode(m *models.MfaRecoveryCode) *MfaRecoveryCodeTemplate {
+	o := &MfaRecoveryCodeTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.UserID = func() int64 { return m.UserID }
+	o.CodeHash = func() string { return m.CodeHash }
+	o.UsedAt = func() null.Val[time.Time] { return m.UsedAt }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		MfaRecoveryCodeMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewOauthClient(mods ...OauthClientMod) *OauthClientTemplate {
+	return f.NewOauthClientWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewOauthClientWithContext(ctx context.Context, mods ...OauthClientMod) *OauthClientTemplate {
+	o := &OauthClientTemplate{f: f}
+
+	if f != nil {
+		f.baseOauthClientMods.Apply(ctx, o)
+	}
+
+	OauthClientModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingOauthClient(m *models.OauthClient) *OauthClientTemplate {
+	o := &OauthClientTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.OrganisationID = func() int64 { return m.OrganisationID }
+	o.UserID = func() int64 { return m.UserID }
+	o.Name = func() string { return m.Name }
+	o.ClientID = func() string { return m.ClientID }
+	o.SecretHash = func() string { return m.SecretHash }
+	o.Scopes = func() pq.StringArray { return m.Scopes }
+	o.RevokedAt = func() null.Val[time.Time] { return m.RevokedAt }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+	o.UpdatedAt = func() null.Val[time.Time] { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if m.R.Organisation != nil {
+		OauthClientMods.WithExistingOrganisation(m.R.Organisation).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		OauthClientMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewOrganisationMember(mods ...OrganisationMemberMod) *OrganisationMemberTemplate {
+	return f.NewOrganisationMemberWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewOrganisationMemberWithContext(ctx context.Context, mods ...OrganisationMemberMod) *OrganisationMemberTemplate {
+	o := &OrganisationMemberTemplate{f: f}
+
+	if f != nil {
+		f.baseOrganisationMemberMods.Apply(ctx, o)
+	}
+
+	OrganisationMemberModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingOrganisationMember(m *models.OrganisationMember) *OrganisationMemberTemplate {
+	o := &OrganisationMemberTemplate{f: f, alreadyPersisted: true}
+
+	o.OrganisationID = func() int64 { return m.OrganisationID }
+	o.UserID = func() int64 { return m.UserID }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.Organisation != nil {
+		OrganisationMemberMods.WithExistingOrganisation(m.R.Organisation).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		OrganisationMemberMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewOrganisation(mods ...OrganisationMod) *OrganisationTemplate {
+	return f.NewOrganisationWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewOrganisationWithContext(ctx context.Context, mods ...OrganisationMod) *OrganisationTemplate {
+	o := &OrganisationTemplate{f: f}
+
+	if f != nil {
+		f.baseOrganisationMods.Apply(ctx, o)
+	}
+
+	OrganisationModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingOrganisation(m *models.Organisation) *OrganisationTemplate {
+	o := &OrganisationTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.Name = func() string { return m.Name }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+	o.UpdatedAt = func() null.Val[time.Time] { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if len(m.R.APIKeys) > 0 {
+		OrganisationMods.AddExistingAPIKeys(m.R.APIKeys...).Apply(ctx, o)
+	}
+	if len(m.R.Invoices) > 0 {
+		OrganisationMods.AddExistingInvoices(m.R.Invoices...).Apply(ctx, o)
+	}
+	if len(m.R.OauthClients) > 0 {
+		OrganisationMods.AddExistingOauthClients(m.R.OauthClients...).Apply(ctx, o)
+	}
+	if len(m.R.OrganisationMembers) > 0 {
+		OrganisationMods.AddExistingOrganisationMembers(m.R.OrganisationMembers...).Apply(ctx, o)
+	}
+	if m.R.TaxpayerProfile != nil {
+		OrganisationMods.WithExistingTaxpayerProfile(m.R.TaxpayerProfile).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewPasswordHistory(mods ...PasswordHistoryMod) *PasswordHistoryTemplate {
+	return f.NewPasswordHistoryWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewPasswordHistoryWithContext(ctx context.Context, mods ...PasswordHistoryMod) *PasswordHistoryTemplate {
+	o := &PasswordHistoryTemplate{f: f}
 
 	if f != nil {
-		f.baseAuthTokenMods.Apply(ctx, o)
+		f.basePasswordHistoryMods.Apply(ctx, o)
 	}
 
-	AuthTokenModSlice(mods).Apply(ctx, o)
+	PasswordHistoryModSlice(mods).Apply(ctx, o)
 
 	return o
 }
 
-func (f *Factory) FromExistingAuthToken(m *models.AuthToken) *AuthTokenTemplate {
-	o := &AuthTokenTemplate{f: f, alreadyPersisted: true}
+func (f *Factory) FromExistingPasswordHistory(m *models.PasswordHistory) *PasswordHistoryTemplate {
+	o := &PasswordHistoryTemplate{f: f, alreadyPersisted: true}
 
 	o.ID = func() int64 { return m.ID }
 	o.UserID = func() int64 { return m.UserID }
-	o.Type = func() enums.AuthTokenTypes { return m.Type }
-	o.Token = func() string { return m.Token }
-	o.ExpireAt = func() null.Val[time.Time] { return m.ExpireAt }
+	o.Password = func() string { return m.Password }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		PasswordHistoryMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewPermission(mods ...PermissionMod) *PermissionTemplate {
+	return f.NewPermissionWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewPermissionWithContext(ctx context.Context, mods ...PermissionMod) *PermissionTemplate {
+	o := &PermissionTemplate{f: f}
+
+	if f != nil {
+		f.basePermissionMods.Apply(ctx, o)
+	}
+
+	PermissionModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingPermission(m *models.Permission) *PermissionTemplate {
+	o := &PermissionTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.Name = func() string { return m.Name }
+	o.Description = func() null.Val[string] { return m.Description }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if len(m.R.Roles) > 0 {
+		PermissionMods.AddExistingRoles(m.R.Roles...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewRolePermission(mods ...RolePermissionMod) *RolePermissionTemplate {
+	return f.NewRolePermissionWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewRolePermissionWithContext(ctx context.Context, mods ...RolePermissionMod) *RolePermissionTemplate {
+	o := &RolePermissionTemplate{f: f}
+
+	if f != nil {
+		f.baseRolePermissionMods.Apply(ctx, o)
+	}
+
+	RolePermissionModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingRolePermission(m *models.RolePermission) *RolePermissionTemplate {
+	o := &RolePermissionTemplate{f: f, alreadyPersisted: true}
+
+	o.RoleID = func() int64 { return m.RoleID }
+	o.PermissionID = func() int64 { return m.PermissionID }
+
+	ctx := context.Background()
+	if m.R.Permission != nil {
+		RolePermissionMods.WithExistingPermission(m.R.Permission).Apply(ctx, o)
+	}
+	if m.R.Role != nil {
+		RolePermissionMods.WithExistingRole(m.R.Role).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewRole(mods ...RoleMod) *RoleTemplate {
+	return f.NewRoleWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewRoleWithContext(ctx context.Context, mods ...RoleMod) *RoleTemplate {
+	o := &RoleTemplate{f: f}
+
+	if f != nil {
+		f.baseRoleMods.Apply(ctx, o)
+	}
+
+	RoleModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingRole(m *models.Role) *RoleTemplate {
+	o := &RoleTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.Name = func() string { return m.Name }
+	o.Description = func() null.Val[string] { return m.Description }
 	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
 	o.UpdatedAt = func() null.Val[time.Time] { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if len(m.R.Invitations) > 0 {
+		RoleMods.AddExistingInvitations(m.R.Invitations...).Apply(ctx, o)
+	}
+	if len(m.R.Permissions) > 0 {
+		RoleMods.AddExistingPermissions(m.R.Permissions...).Apply(ctx, o)
+	}
+	if len(m.R.Users) > 0 {
+		RoleMods.AddExistingUsers(m.R.Users...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewSecurityEvent(mods ...SecurityEventMod) *SecurityEventTemplate {
+	return f.NewSecurityEventWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewSecurityEventWithContext(ctx context.Context, mods ...SecurityEventMod) *SecurityEventTemplate {
+	o := &SecurityEventTemplate{f: f}
+
+	if f != nil {
+		f.baseSecurityEventMods.Apply(ctx, o)
+	}
+
+	SecurityEventModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingSecurityEvent(m *models.SecurityEvent) *SecurityEventTemplate {
+	o := &SecurityEventTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.UserID = func() null.Val[int64] { return m.UserID }
 	o.SessionID = func() null.Val[uuid.UUID] { return m.SessionID }
+	o.Type = func() enums.SecurityEventTypes { return m.Type }
+	o.IPAddress = func() null.Val[pgtypes.Inet] { return m.IPAddress }
+	o.Details = func() null.Val[types.JSON[json.RawMessage]] { return m.Details }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
 
 	ctx := context.Background()
 	if m.R.User != nil {
-		AuthTokenMods.WithExistingUser(m.R.User).Apply(ctx, o)
+		SecurityEventMods.WithExistingUser(m.R.User).Apply(ctx, o)
 	}
 
 	return o
 }
 
-func (f *Factory) NewFailedLogin(mods ...FailedLoginMod) *FailedLoginTemplate {
-	return f.NewFailedLoginWithContext(context.Background(), mods...)
+func (f *Factory) NewTaxpayerProfile(mods ...TaxpayerProfileMod) *TaxpayerProfileTemplate {
+	return f.NewTaxpayerProfileWithContext(context.Background(), mods...)
 }
 
-func (f *Factory) NewFailedLoginWithContext(ctx context.Context, mods ...FailedLoginMod) *FailedLoginTemplate {
-	o := &FailedLoginTemplate{f: f}
+func (f *Factory) NewTaxpayerProfileWithContext(ctx context.Context, mods ...TaxpayerProfileMod) *TaxpayerProfileTemplate {
+	o := &TaxpayerProfileTemplate{f: f}
 
 	if f != nil {
-		f.baseFailedLoginMods.Apply(ctx, o)
+		f.baseTaxpayerProfileMods.Apply(ctx, o)
 	}
 
-	FailedLoginModSlice(mods).Apply(ctx, o)
+	TaxpayerProfileModSlice(mods).Apply(ctx, o)
 
 	return o
 }
 
-func (f *Factory) FromExistingFailedLogin(m *models.FailedLogin) *FailedLoginTemplate {
-	o := &FailedLoginTemplate{f: f, alreadyPersisted: true}
+func (f *Factory) FromExistingTaxpayerProfile(m *models.TaxpayerProfile) *TaxpayerProfileTemplate {
+	o := &TaxpayerProfileTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.OrganisationID = func() int64 { return m.OrganisationID }
+	o.Name = func() string { return m.Name }
+	o.Tin = func() string { return m.Tin }
+	o.IDType = func() enums.TaxpayerIDTypes { return m.IDType }
+	o.IDValue = func() string { return m.IDValue }
+	o.SSTRegistrationNumber = func() string { return m.SSTRegistrationNumber }
+	o.TourismTaxRegistrationNumber = func() string { return m.TourismTaxRegistrationNumber }
+	o.MsicCode = func() string { return m.MsicCode }
+	o.BusinessActivityDescription = func() string { return m.BusinessActivityDescription }
+	o.AddressLine1 = func() string { return m.AddressLine1 }
+	o.AddressLine2 = func() null.Val[string] { return m.AddressLine2 }
+	o.AddressLine3 = func() null.Val[string] { return m.AddressLine3 }
+	o.PostalZone = func() null.Val[string] { return m.PostalZone }
+	o.CityName = func() string { return m.CityName }
+	o.StateCode = func() string { return m.StateCode }
+	o.CountryCode = func() string { return m.CountryCode }
+	o.Phone = func() string { return m.Phone }
+	o.Email = func() null.Val[string] { return m.Email }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+	o.UpdatedAt = func() null.Val[time.Time] { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if m.R.Organisation != nil {
+		TaxpayerProfileMods.WithExistingOrganisation(m.R.Organisation).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewUserIdentity(mods ...UserIdentityMod) *UserIdentityTemplate {
+	return f.NewUserIdentityWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewUserIdentityWithContext(ctx context.Context, mods ...UserIdentityMod) *UserIdentityTemplate {
+	o := &UserIdentityTemplate{f: f}
+
+	if f != nil {
+		f.baseUserIdentityMods.Apply(ctx, o)
+	}
+
+	UserIdentityModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingUserIdentity(m *models.UserIdentity) *UserIdentityTemplate {
+	o := &UserIdentityTemplate{f: f, alreadyPersisted: true}
 
 	o.ID = func() int64 { return m.ID }
 	o.UserID = func() int64 { return m.UserID }
-	o.IPAddress = func() pgtypes.Inet { return m.IPAddress }
-	o.AttemptedAt = func() null.Val[time.Time] { return m.AttemptedAt }
+	o.Provider = func() string { return m.Provider }
+	o.Subject = func() string { return m.Subject }
+	o.Email = func() string { return m.Email }
+	o.LastLoginAt = func() null.Val[time.Time] { return m.LastLoginAt }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+	o.UpdatedAt = func() null.Val[time.Time] { return m.UpdatedAt }
 
 	ctx := context.Background()
 	if m.R.User != nil {
-		FailedLoginMods.WithExistingUser(m.R.User).Apply(ctx, o)
+		UserIdentityMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewUserRole(mods ...UserRoleMod) *UserRoleTemplate {
+	return f.NewUserRoleWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewUserRoleWithContext(ctx context.Context, mods ...UserRoleMod) *UserRoleTemplate {
+	o := &UserRoleTemplate{f: f}
+
+	if f != nil {
+		f.baseUserRoleMods.Apply(ctx, o)
+	}
+
+	UserRoleModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingUserRole(m *models.UserRole) *UserRoleTemplate {
+	o := &UserRoleTemplate{f: f, alreadyPersisted: true}
+
+	o.UserID = func() int64 { return m.UserID }
+	o.RoleID = func() int64 { return m.RoleID }
+
+	ctx := context.Background()
+	if m.R.Role != nil {
+		UserRoleMods.WithExistingRole(m.R.Role).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		UserRoleMods.WithExistingUser(m.R.User).Apply(ctx, o)
 	}
 
 	return o
@@ -121,18 +990,70 @@ func (f *Factory) FromExistingUser(m *models.User) *UserTemplate {
 	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
 	o.UpdatedAt = func() null.Val[time.Time] { return m.UpdatedAt }
 	o.DeletedAt = func() null.Val[time.Time] { return m.DeletedAt }
+	o.TotpSecret = func() null.Val[string] { return m.TotpSecret }
+	o.TotpEnabledAt = func() null.Val[time.Time] { return m.TotpEnabledAt }
+	o.PendingEmail = func() null.Val[string] { return m.PendingEmail }
 
 	ctx := context.Background()
+	if len(m.R.APIKeys) > 0 {
+		UserMods.AddExistingAPIKeys(m.R.APIKeys...).Apply(ctx, o)
+	}
 	if len(m.R.AuthTokens) > 0 {
 		UserMods.AddExistingAuthTokens(m.R.AuthTokens...).Apply(ctx, o)
 	}
 	if len(m.R.FailedLogins) > 0 {
 		UserMods.AddExistingFailedLogins(m.R.FailedLogins...).Apply(ctx, o)
 	}
+	if len(m.R.AcceptedUserInvitations) > 0 {
+		UserMods.AddExistingAcceptedUserInvitations(m.R.AcceptedUserInvitations...).Apply(ctx, o)
+	}
+	if len(m.R.InvitedByInvitations) > 0 {
+		UserMods.AddExistingInvitedByInvitations(m.R.InvitedByInvitations...).Apply(ctx, o)
+	}
+	if len(m.R.CreatedByInvoices) > 0 {
+		UserMods.AddExistingCreatedByInvoices(m.R.CreatedByInvoices...).Apply(ctx, o)
+	}
+	if len(m.R.MfaRecoveryCodes) > 0 {
+		UserMods.AddExistingMfaRecoveryCodes(m.R.MfaRecoveryCodes...).Apply(ctx, o)
+	}
+	if len(m.R.OauthClients) > 0 {
+		UserMods.AddExistingOauthClients(m.R.OauthClients...).Apply(ctx, o)
+	}
+	if len(m.R.OrganisationMembers) > 0 {
+		UserMods.AddExistingOrganisationMembers(m.R.OrganisationMembers...).Apply(ctx, o)
+	}
+	if len(m.R.PasswordHistories) > 0 {
+		UserMods.AddExistingPasswordHistories(m.R.PasswordHistories...).Apply(ctx, o)
+	}
+	if len(m.R.SecurityEvents) > 0 {
+		UserMods.AddExistingSecurityEvents(m.R.SecurityEvents...).Apply(ctx, o)
+	}
+	if len(m.R.UserIdentities) > 0 {
+		UserMods.AddExistingUserIdentities(m.R.UserIdentities...).Apply(ctx, o)
+	}
+	if len(m.R.Roles) > 0 {
+		UserMods.AddExistingRoles(m.R.Roles...).Apply(ctx, o)
+	}
 
 	return o
 }
 
+func (f *Factory) ClearBaseAPIKeyMods() {
+	f.baseAPIKeyMods = nil
+}
+
+func (f *Factory) AddBaseAPIKeyMod(mods ...APIKeyMod) {
+	f.baseAPIKeyMods = append(f.baseAPIKeyMods, mods...)
+}
+
+func (f *Factory) ClearBaseAuditEventMods() {
+	f.baseAuditEventMods = nil
+}
+
+func (f *Factory) AddBaseAuditEventMod(mods ...AuditEventMod) {
+	f.baseAuditEventMods = append(f.baseAuditEventMods, mods...)
+}
+
 func (f *Factory) ClearBaseAuthTokenMods() {
 	f.baseAuthTokenMods = nil
 }
@@ -141,6 +1062,14 @@ func (f *Factory) AddBaseAuthTokenMod(mods ...AuthTokenMod) {
 	f.baseAuthTokenMods = append(f.baseAuthTokenMods, mods...)
 }
 
+func (f *Factory) ClearBaseDeniedTokenMods() {
+	f.baseDeniedTokenMods = nil
+}
+
+func (f *Factory) AddBaseDeniedTokenMod(mods ...DeniedTokenMod) {
+	f.baseDeniedTokenMods = append(f.baseDeniedTokenMods, mods...)
+}
+
 func (f *Factory) ClearBaseFailedLoginMods() {
 	f.baseFailedLoginMods = nil
 }
@@ -149,6 +1078,150 @@ func (f *Factory) AddBaseFailedLoginMod(mods ...FailedLoginMod) {
 	f.baseFailedLoginMods = append(f.baseFailedLoginMods, mods...)
 }
 
+func (f *Factory) ClearBaseInvitationMods() {
+	f.baseInvitationMods = nil
+}
+
+func (f *Factory) AddBaseInvitationMod(mods ...InvitationMod) {
+	f.baseInvitationMods = append(f.baseInvitationMods, mods...)
+}
+
+func (f *Factory) ClearBaseInvoiceAllowanceChargeMods() {
+	f.baseInvoiceAllowanceChargeMods = nil
+}
+
+func (f *Factory) AddBaseInvoiceAllowanceChargeMod(mods ...InvoiceAllowanceChargeMod) {
+	f.baseInvoiceAllowanceChargeMods = append(f.baseInvoiceAllowanceChargeMods, mods...)
+}
+
+func (f *Factory) ClearBaseInvoiceLineTaxBreakdownMods() {
+	f.baseInvoiceLineTaxBreakdownMods = nil
+}
+
+func (f *Factory) AddBaseInvoiceLineTaxBreakdownMod(mods ...InvoiceLineTaxBreakdownMod) {
+	f.baseInvoiceLineTaxBreakdownMods = append(f.baseInvoiceLineTaxBreakdownMods, mods...)
+}
+
+func (f *Factory) ClearBaseInvoiceLineMods() {
+	f.baseInvoiceLineMods = nil
+}
+
+func (f *Factory) AddBaseInvoiceLineMod(mods ...InvoiceLineMod) {
+	f.baseInvoiceLineMods = append(f.baseInvoiceLineMods, mods...)
+}
+
+func (f *Factory) ClearBaseInvoicePartyMods() {
+	f.baseInvoicePartyMods = nil
+}
+
+func (f *Factory) AddBaseInvoicePartyMod(mods ...InvoicePartyMod) {
+	f.baseInvoicePartyMods = append(f.baseInvoicePartyMods, mods...)
+}
+
+func (f *Factory) ClearBaseInvoiceMods() {
+	f.baseInvoiceMods = nil
+}
+
+func (f *Factory) AddBaseInvoiceMod(mods ...InvoiceMod) {
+	f.baseInvoiceMods = append(f.baseInvoiceMods, mods...)
+}
+
+func (f *Factory) ClearBaseMfaRecoveryCodeMods() {
+	f.baseMfaRecoveryCodeMods = nil
+}
+
+func (f *Factory) AddBaseMfaRecoveryCodeMod(mods ...MfaRecoveryCodeMod) {
+	f.baseMfaRecoveryCodeMods = append(f.baseMfaRecoveryCodeMods, mods...)
+}
+
+func (f *Factory) ClearBaseOauthClientMods() {
+	f.baseOauthClientMods = nil
+}
+
+func (f *Factory) AddBaseOauthClientMod(mods ...OauthClientMod) {
+	f.baseOauthClientMods = append(f.baseOauthClientMods, mods...)
+}
+
+func (f *Factory) ClearBaseOrganisationMemberMods() {
+	f.baseOrganisationMemberMods = nil
+}
+
+func (f *Factory) AddBaseOrganisationMemberMod(mods ...OrganisationMemberMod) {
+	f.baseOrganisationMemberMods = append(f.baseOrganisationMemberMods, mods...)
+}
+
+func (f *Factory) ClearBaseOrganisationMods() {
+	f.baseOrganisationMods = nil
+}
+
+func (f *Factory) AddBaseOrganisationMod(mods ...OrganisationMod) {
+	f.baseOrganisationMods = append(f.baseOrganisationMods, mods...)
+}
+
+func (f *Factory) ClearBasePasswordHistoryMods() {
+	f.basePasswordHistoryMods = nil
+}
+
+func (f *Factory) AddBasePasswordHistoryMod(mods ...PasswordHistoryMod) {
+	f.basePasswordHistoryMods = append(f.basePasswordHistoryMods, mods...)
+}
+
+func (f *Factory) ClearBasePermissionMods() {
+	f.basePermissionMods = nil
+}
+
+func (f *Factory) AddBasePermissionMod(mods ...PermissionMod) {
+	f.basePermissionMods = append(f.basePermissionMods, mods...)
+}
+
+func (f *Factory) ClearBaseRolePermissionMods() {
+	f.baseRolePermissionMods = nil
+}
+
+func (f *Factory) AddBaseRolePermissionMod(mods ...RolePermissionMod) {
+	f.baseRolePermissionMods = append(f.baseRolePermissionMods, mods...)
+}
+
+func (f *Factory) ClearBaseRoleMods() {
+	f.baseRoleMods = nil
+}
+
+func (f *Factory) AddBaseRoleMod(mods ...RoleMod) {
+	f.baseRoleMods = append(f.baseRoleMods, mods...)
+}
+
+func (f *Factory) ClearBaseSecurityEventMods() {
+	f.baseSecurityEventMods = nil
+}
+
+func (f *Factory) AddBaseSecurityEventMod(mods ...SecurityEventMod) {
+	f.baseSecurityEventMods = append(f.baseSecurityEventMods, mods...)
+}
+
+func (f *Factory) ClearBaseTaxpayerProfileMods() {
+	f.baseTaxpayerProfileMods = nil
+}
+
+func (f *Factory) AddBaseTaxpayerProfileMod(mods ...TaxpayerProfileMod) {
+	f.baseTaxpayerProfileMods = append(f.baseTaxpayerProfileMods, mods...)
+}
+
+func (f *Factory) ClearBaseUserIdentityMods() {
+	f.baseUserIdentityMods = nil
+}
+
+func (f *Factory) AddBaseUserIdentityMod(mods ...UserIdentityMod) {
+	f.baseUserIdentityMods = append(f.baseUserIdentityMods, mods...)
+}
+
+func (f *Factory) ClearBaseUserRoleMods() {
+	f.baseUserRoleMods = nil
+}
+
+func (f *Factory) AddBaseUserRoleMod(mods ...UserRoleMod) {
+	f.baseUserRoleMods = append(f.baseUserRoleMods, mods...)
+}
+
 func (f *Factory) ClearBaseUserMods() {
 	f.baseUserMods = nil
 }
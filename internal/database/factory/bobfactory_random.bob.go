@@ -4,7 +4,10 @@
 package factory
 
 import (
+	"bytes"
 	"crypto/rand"
+	"encoding/json"
+	"fmt"
 	"net/netip"
 	"strconv"
 	"strings"
@@ -13,11 +16,53 @@ import (
 	"github.com/gofrs/uuid/v5"
 	enums "github.com/jacoobjake/einvoice-api/internal/database/enums"
 	"github.com/jaswdr/faker/v2"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"github.com/stephenafamo/bob/types"
 	"github.com/stephenafamo/bob/types/pgtypes"
 )
 
 var defaultFaker = faker.New()
 
+func random_bool(f *faker.Faker, limits ...string) bool {
+	if f == nil {
+		f = &defaultFaker
+	}
+
+	return f.Bool()
+}
+
+func random_decimal_Decimal(f *faker.Faker, limits ...string) decimal.Decimal {
+	if f == nil {
+		f = &defaultFaker
+	}
+
+	var precision int64 = 7
+	var scale int64 = 3
+
+	if len(limits) > 0 {
+		precision, _ = strconv.ParseInt(limits[0], 10, 32)
+	}
+
+	if len(limits) > 1 {
+		scale, _ = strconv.ParseInt(limits[1], 10, 32)
+	}
+
+	baseVal := f.Float32(10, -1, 1)
+	for baseVal == -1 || baseVal == 0 || baseVal == 1 {
+		baseVal = f.Float32(10, -1, 1)
+	}
+
+	precisionDecimal, _ := decimal.NewFromInt(10).PowInt32(int32(precision))
+	val := decimal.
+		NewFromFloat32(baseVal).
+		Mul(precisionDecimal).
+		Shift(int32(-1 * scale)).
+		RoundDown(int32(scale))
+
+	return val
+}
+
 func random_enums_AuthTokenTypes(f *faker.Faker, limits ...string) enums.AuthTokenTypes {
 	if f == nil {
 		f = &defaultFaker
@@ -28,6 +73,46 @@ func random_enums_AuthTokenTypes(f *faker.Faker, limits ...string) enums.AuthTok
 	return all[f.IntBetween(0, len(all)-1)]
 }
 
+func random_enums_InvoicePartyRoles(f *faker.Faker, limits ...string) enums.InvoicePartyRoles {
+	if f == nil {
+		f = &defaultFaker
+	}
+
+	var e enums.InvoicePartyRoles
+	all := e.All()
+	return all[f.IntBetween(0, len(all)-1)]
+}
+
+func random_enums_InvoiceStatuses(f *faker.Faker, limits ...string) enums.InvoiceStatuses {
+	if f == nil {
+		f = &defaultFaker
+	}
+
+	var e enums.InvoiceStatuses
+	all := e.All()
+	return all[f.IntBetween(0, len(all)-1)]
+}
+
+func random_enums_SecurityEventTypes(f *faker.Faker, limits ...string) enums.SecurityEventTypes {
+	if f == nil {
+		f = &defaultFaker
+	}
+
+	var e enums.SecurityEventTypes
+	all := e.All()
+	return all[f.IntBetween(0, len(all)-1)]
+}
+
+func random_enums_TaxpayerIDTypes(f *faker.Faker, limits ...string) enums.TaxpayerIDTypes {
+	if f == nil {
+		f = &defaultFaker
+	}
+
+	var e enums.TaxpayerIDTypes
+	all := e.All()
+	return all[f.IntBetween(0, len(all)-1)]
+}
+
 func random_enums_UserStatuses(f *faker.Faker, limits ...string) enums.UserStatuses {
 	if f == nil {
 		f = &defaultFaker
@@ -38,6 +123,14 @@ func random_enums_UserStatuses(f *faker.Faker, limits ...string) enums.UserStatu
 	return all[f.IntBetween(0, len(all)-1)]
 }
 
+func random_int32(f *faker.Faker, limits ...string) int32 {
+	if f == nil {
+		f = &defaultFaker
+	}
+
+	return f.Int32()
+}
+
 func random_int64(f *faker.Faker, limits ...string) int64 {
 	if f == nil {
 		f = &defaultFaker
@@ -58,6 +151,18 @@ func random_pgtypes_Inet(f *faker.Faker, limits ...string) pgtypes.Inet {
 	return pgtypes.Inet{Prefix: ipPrefix}
 }
 
+func random_pq_StringArray(f *faker.Faker, limits ...string) pq.StringArray {
+	if f == nil {
+		f = &defaultFaker
+	}
+
+	arr := make(pq.StringArray, f.IntBetween(1, 5))
+	for i := range arr {
+		arr[i] = random_string(f, limits...)
+	}
+	return arr
+}
+
 func random_string(f *faker.Faker, limits ...string) string {
 	if f == nil {
 		f = &defaultFaker
@@ -85,6 +190,23 @@ func random_time_Time(f *faker.Faker, limits ...string) time.Time {
 	return f.Time().TimeBetween(min, max)
 }
 
+func random_types_JSON_json_RawMessage_(f *faker.Faker, limits ...string) types.JSON[json.RawMessage] {
+	if f == nil {
+		f = &defaultFaker
+	}
+
+	s := &bytes.Buffer{}
+	s.WriteRune('{')
+	for i := range f.IntBetween(1, 5) {
+		if i > 0 {
+			fmt.Fprint(s, ", ")
+		}
+		fmt.Fprintf(s, "%q:%q", f.Lorem().Word(), f.Lorem().Word())
+	}
+	s.WriteRune('}')
+	return types.NewJSON[json.RawMessage](s.Bytes())
+}
+
 func random_uuid_UUID(f *faker.Faker, limits ...string) uuid.UUID {
 	if f == nil {
 		f = &defaultFaker
@@ -4,6 +4,8 @@
 package factory
 
 import (
+	"bytes"
+	"slices"
 	"testing"
 
 	"github.com/stephenafamo/bob"
@@ -12,6 +14,28 @@ import (
 // Set the testDB to enable tests that use the database
 var testDB bob.Transactor[bob.Tx]
 
+func TestRandom_decimal_Decimal(t *testing.T) {
+	t.Parallel()
+
+	val1 := random_decimal_Decimal(nil)
+	val2 := random_decimal_Decimal(nil)
+
+	if val1.Equal(val2) {
+		t.Fatalf("random_decimal_Decimal() returned the same value twice: %v", val1)
+	}
+}
+
+func TestRandom_int32(t *testing.T) {
+	t.Parallel()
+
+	val1 := random_int32(nil)
+	val2 := random_int32(nil)
+
+	if val1 == val2 {
+		t.Fatalf("random_int32() returned the same value twice: %v", val1)
+	}
+}
+
 func TestRandom_int64(t *testing.T) {
 	t.Parallel()
 
@@ -34,6 +58,17 @@ func TestRandom_pgtypes_Inet(t *testing.T) {
 	}
 }
 
+func TestRandom_pq_StringArray(t *testing.T) {
+	t.Parallel()
+
+	val1 := random_pq_StringArray(nil)
+	val2 := random_pq_StringArray(nil)
+
+	if slices.Equal(val1, val2) {
+		t.Fatalf("random_pq_StringArray() returned the same value twice: %v", val1)
+	}
+}
+
 func TestRandom_string(t *testing.T) {
 	t.Parallel()
 
@@ -56,6 +91,17 @@ func TestRandom_time_Time(t *testing.T) {
 	}
 }
 
+func TestRandom_types_JSON_json_RawMessage_(t *testing.T) {
+	t.Parallel()
+
+	val1 := random_types_JSON_json_RawMessage_(nil)
+	val2 := random_types_JSON_json_RawMessage_(nil)
+
+	if bytes.Equal(val1.Val, val2.Val) {
+		t.Fatalf("random_types_JSON_json_RawMessage_() returned the same value twice: %v", val1)
+	}
+}
+
 func TestRandom_uuid_UUID(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,364 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type DeniedTokenMod interface {
+	Apply(context.Context, *DeniedTokenTemplate)
+}
+
+type DeniedTokenModFunc func(context.Context, *DeniedTokenTemplate)
+
+func (f DeniedTokenModFunc) Apply(ctx context.Context, n *DeniedTokenTemplate) {
+	f(ctx, n)
+}
+
+type DeniedTokenModSlice []DeniedTokenMod
+
+func (mods DeniedTokenModSlice) Apply(ctx context.Context, n *DeniedTokenTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// DeniedTokenTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type DeniedTokenTemplate struct {
+	Jti       func() string
+	ExpiresAt func() time.Time
+	CreatedAt func() null.Val[time.Time]
+
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+// Apply mods to the DeniedTokenTemplate
+func (o *DeniedTokenTemplate) Apply(ctx context.Context, mods ...DeniedTokenMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.DeniedToken
+// according to the relationships in the template. Nothing is inserted into the db
+func (t DeniedTokenTemplate) setModelRels(o *models.DeniedToken) {}
+
+// BuildSetter returns an *models.DeniedTokenSetter
+// this does nothing with the relationship templates
+func (o DeniedTokenTemplate) BuildSetter() *models.DeniedTokenSetter {
+	m := &models.DeniedTokenSetter{}
+
+	if o.Jti != nil {
+		val := o.Jti()
+		m.Jti = omit.From(val)
+	}
+	if o.ExpiresAt != nil {
+		val := o.ExpiresAt()
+		m.ExpiresAt = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.DeniedTokenSetter
+// this does nothing with the relationship templates
+func (o DeniedTokenTemplate) BuildManySetter(number int) []*models.DeniedTokenSetter {
+	m := make([]*models.DeniedTokenSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.DeniedToken
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use DeniedTokenTemplate.Create
+func (o DeniedTokenTemplate) Build() *models.DeniedToken {
+	m := &models.DeniedToken{}
+
+	if o.Jti != nil {
+		m.Jti = o.Jti()
+	}
+	if o.ExpiresAt != nil {
+		m.ExpiresAt = o.ExpiresAt()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.DeniedTokenSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use DeniedTokenTemplate.CreateMany
+func (o DeniedTokenTemplate) BuildMany(number int) models.DeniedTokenSlice {
+	m := make(models.DeniedTokenSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableDeniedToken(m *models.DeniedTokenSetter) {
+	if !(m.Jti.IsValue()) {
+		val := random_string(nil, "255")
+		m.Jti = omit.From(val)
+	}
+	if !(m.ExpiresAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.ExpiresAt = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.DeniedToken
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *DeniedTokenTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.DeniedToken) error {
+	var err error
+
+	return err
+}
+
+// Create builds a deniedToken and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *DeniedTokenTemplate) Create(ctx context.Context, exec bob.Executor) (*models.DeniedToken, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableDeniedToken(opt)
+
+	m, err := models.DeniedTokens.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a deniedToken and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *DeniedTokenTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.DeniedToken {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a deniedToken and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *DeniedTokenTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.DeniedToken {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple deniedTokens and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o DeniedTokenTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.DeniedTokenSlice, error) {
+	var err error
+	m := make(models.DeniedTokenSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple deniedTokens and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o DeniedTokenTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.DeniedTokenSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple deniedTokens and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o DeniedTokenTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.DeniedTokenSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// DeniedToken has methods that act as mods for the DeniedTokenTemplate
+var DeniedTokenMods deniedTokenMods
+
+type deniedTokenMods struct{}
+
+func (m deniedTokenMods) RandomizeAllColumns(f *faker.Faker) DeniedTokenMod {
+	return DeniedTokenModSlice{
+		DeniedTokenMods.RandomJti(f),
+		DeniedTokenMods.RandomExpiresAt(f),
+		DeniedTokenMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m deniedTokenMods) Jti(val string) DeniedTokenMod {
+	return DeniedTokenModFunc(func(_ context.Context, o *DeniedTokenTemplate) {
+		o.Jti = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m deniedTokenMods) JtiFunc(f func() string) DeniedTokenMod {
+	return DeniedTokenModFunc(func(_ context.Context, o *DeniedTokenTemplate) {
+		o.Jti = f
+	})
+}
+
+// Clear any values for the column
+func (m deniedTokenMods) UnsetJti() DeniedTokenMod {
+	return DeniedTokenModFunc(func(_ context.Context, o *DeniedTokenTemplate) {
+		o.Jti = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m deniedTokenMods) RandomJti(f *faker.Faker) DeniedTokenMod {
+	return DeniedTokenModFunc(func(_ context.Context, o *DeniedTokenTemplate) {
+		o.Jti = func() string {
+			return random_string(f, "255")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m deniedTokenMods) ExpiresAt(val time.Time) DeniedTokenMod {
+	return DeniedTokenModFunc(func(_ context.Context, o *DeniedTokenTemplate) {
+		o.ExpiresAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m deniedTokenMods) ExpiresAtFunc(f func() time.Time) DeniedTokenMod {
+	return DeniedTokenModFunc(func(_ context.Context, o *DeniedTokenTemplate) {
+		o.ExpiresAt = f
+	})
+}
+
+// Clear any values for the column
+func (m deniedTokenMods) UnsetExpiresAt() DeniedTokenMod {
+	return DeniedTokenModFunc(func(_ context.Context, o *DeniedTokenTemplate) {
+		o.ExpiresAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m deniedTokenMods) RandomExpiresAt(f *faker.Faker) DeniedTokenMod {
+	return DeniedTokenModFunc(func(_ context.Context, o *DeniedTokenTemplate) {
+		o.ExpiresAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m deniedTokenMods) CreatedAt(val null.Val[time.Time]) DeniedTokenMod {
+	return DeniedTokenModFunc(func(_ context.Context, o *DeniedTokenTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m deniedTokenMods) CreatedAtFunc(f func() null.Val[time.Time]) DeniedTokenMod {
+	return DeniedTokenModFunc(func(_ context.Context, o *DeniedTokenTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m deniedTokenMods) UnsetCreatedAt() DeniedTokenMod {
+	return DeniedTokenModFunc(func(_ context.Context, o *DeniedTokenTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m deniedTokenMods) RandomCreatedAt(f *faker.Faker) DeniedTokenMod {
+	return DeniedTokenModFunc(func(_ context.Context, o *DeniedTokenTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m deniedTokenMods) RandomCreatedAtNotNull(f *faker.Faker) DeniedTokenMod {
+	return DeniedTokenModFunc(func(_ context.Context, o *DeniedTokenTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m deniedTokenMods) WithParentsCascading() DeniedTokenMod {
+	return DeniedTokenModFunc(func(ctx context.Context, o *DeniedTokenTemplate) {
+		if isDone, _ := deniedTokenWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = deniedTokenWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
@@ -0,0 +1,1002 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type InvitationMod interface {
+	Apply(context.Context, *InvitationTemplate)
+}
+
+type InvitationModFunc func(context.Context, *InvitationTemplate)
+
+func (f InvitationModFunc) Apply(ctx context.Context, n *InvitationTemplate) {
+	f(ctx, n)
+}
+
+type InvitationModSlice []InvitationMod
+
+func (mods InvitationModSlice) Apply(ctx context.Context, n *InvitationTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// InvitationTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type InvitationTemplate struct {
+	ID             func() int64
+	Email          func() string
+	RoleID         func() int64
+	InvitedBy      func() null.Val[int64]
+	Token          func() string
+	ExpireAt       func() time.Time
+	AcceptedAt     func() null.Val[time.Time]
+	AcceptedUserID func() null.Val[int64]
+	RevokedAt      func() null.Val[time.Time]
+	CreatedAt      func() null.Val[time.Time]
+	UpdatedAt      func() null.Val[time.Time]
+
+	r invitationR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type invitationR struct {
+	AcceptedUserUser *invitationRAcceptedUserUserR
+	InvitedByUser    *invitationRInvitedByUserR
+	Role             *invitationRRoleR
+}
+
+type invitationRAcceptedUserUserR struct {
+	o *UserTemplate
+}
+type invitationRInvitedByUserR struct {
+	o *UserTemplate
+}
+type invitationRRoleR struct {
+	o *RoleTemplate
+}
+
+// Apply mods to the InvitationTemplate
+func (o *InvitationTemplate) Apply(ctx context.Context, mods ...InvitationMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Invitation
+// according to the relationships in the template. Nothing is inserted into the db
+func (t InvitationTemplate) setModelRels(o *models.Invitation) {
+	if t.r.AcceptedUserUser != nil {
+		rel := t.r.AcceptedUserUser.o.Build()
+		rel.R.AcceptedUserInvitations = append(rel.R.AcceptedUserInvitations, o)
+		o.AcceptedUserID = null.From(rel.ID) // h2
+		o.R.AcceptedUserUser = rel
+	}
+
+	if t.r.InvitedByUser != nil {
+		rel := t.r.InvitedByUser.o.Build()
+		rel.R.InvitedByInvitations = append(rel.R.InvitedByInvitations, o)
+		o.InvitedBy = null.From(rel.ID) // h2
+		o.R.InvitedByUser = rel
+	}
+
+	if t.r.Role != nil {
+		rel := t.r.Role.o.Build()
+		rel.R.Invitations = append(rel.R.Invitations, o)
+		o.RoleID = rel.ID // h2
+		o.R.Role = rel
+	}
+}
+
+// BuildSetter returns an *models.InvitationSetter
+// this does nothing with the relationship templates
+func (o InvitationTemplate) BuildSetter() *models.InvitationSetter {
+	m := &models.InvitationSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.Email != nil {
+		val := o.Email()
+		m.Email = omit.From(val)
+	}
+	if o.RoleID != nil {
+		val := o.RoleID()
+		m.RoleID = omit.From(val)
+	}
+	if o.InvitedBy != nil {
+		val := o.InvitedBy()
+		m.InvitedBy = omitnull.FromNull(val)
+	}
+	if o.Token != nil {
+		val := o.Token()
+		m.Token = omit.From(val)
+	}
+	if o.ExpireAt != nil {
+		val := o.ExpireAt()
+		m.ExpireAt = omit.From(val)
+	}
+	if o.AcceptedAt != nil {
+		val := o.AcceptedAt()
+		m.AcceptedAt = omitnull.FromNull(val)
+	}
+	if o.AcceptedUserID != nil {
+		val := o.AcceptedUserID()
+		m.AcceptedUserID = omitnull.FromNull(val)
+	}
+	if o.RevokedAt != nil {
+		val := o.RevokedAt()
+		m.RevokedAt = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+	if o.UpdatedAt != nil {
+		val := o.UpdatedAt()
+		m.UpdatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.InvitationSetter
+// this does nothing with the relationship templates
+func (o InvitationTemplate) BuildManySetter(number int) []*models.InvitationSetter {
+	m := make([]*models.InvitationSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Invitation
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use InvitationTemplate.Create
+func (o InvitationTemplate) Build() *models.Invitation {
+	m := &models.Invitation{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Email != nil {
+		m.Email = o.Email()
+	}
+	if o.RoleID != nil {
+		m.RoleID = o.RoleID()
+	}
+	if o.InvitedBy != nil {
+		m.InvitedBy = o.InvitedBy()
+	}
+	if o.Token != nil {
+		m.Token = o.Token()
+	}
+	if o.ExpireAt != nil {
+		m.ExpireAt = o.ExpireAt()
+	}
+	if o.AcceptedAt != nil {
+		m.AcceptedAt = o.AcceptedAt()
+	}
+	if o.AcceptedUserID != nil {
+		m.AcceptedUserID = o.AcceptedUserID()
+	}
+	if o.RevokedAt != nil {
+		m.RevokedAt = o.RevokedAt()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.UpdatedAt != nil {
+		m.UpdatedAt = o.UpdatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.InvitationSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use InvitationTemplate.CreateMany
+func (o InvitationTemplate) BuildMany(number int) models.InvitationSlice {
+	m := make(models.InvitationSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableInvitation(m *models.InvitationSetter) {
+	if !(m.Email.IsValue()) {
+		val := random_string(nil, "300")
+		m.Email = omit.From(val)
+	}
+	if !(m.RoleID.IsValue()) {
+		val := random_int64(nil)
+		m.RoleID = omit.From(val)
+	}
+	if !(m.Token.IsValue()) {
+		val := random_string(nil, "255")
+		m.Token = omit.From(val)
+	}
+	if !(m.ExpireAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.ExpireAt = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Invitation
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *InvitationTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Invitation) error {
+	var err error
+
+	isAcceptedUserUserDone, _ := invitationRelAcceptedUserUserCtx.Value(ctx)
+	if !isAcceptedUserUserDone && o.r.AcceptedUserUser != nil {
+		ctx = invitationRelAcceptedUserUserCtx.WithValue(ctx, true)
+		if o.r.AcceptedUserUser.o.alreadyPersisted {
+			m.R.AcceptedUserUser = o.r.AcceptedUserUser.o.Build()
+		} else {
+			var rel0 *models.User
+			rel0, err = o.r.AcceptedUserUser.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachAcceptedUserUser(ctx, exec, rel0)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	isInvitedByUserDone, _ := invitationRelInvitedByUserCtx.Value(ctx)
+	if !isInvitedByUserDone && o.r.InvitedByUser != nil {
+		ctx = invitationRelInvitedByUserCtx.WithValue(ctx, true)
+		if o.r.InvitedByUser.o.alreadyPersisted {
+			m.R.InvitedByUser = o.r.InvitedByUser.o.Build()
+		} else {
+			var rel1 *models.User
+			rel1, err = o.r.InvitedByUser.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachInvitedByUser(ctx, exec, rel1)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return err
+}
+
+// Create builds a invitation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *InvitationTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Invitation, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableInvitation(opt)
+
+	if o.r.Role == nil {
+		InvitationMods.WithNewRole().Apply(ctx, o)
+	}
+
+	var rel2 *models.Role
+
+	if o.r.Role.o.alreadyPersisted {
+		rel2 = o.r.Role.o.Build()
+	} else {
+		rel2, err = o.r.Role.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.RoleID = omit.From(rel2.ID)
+
+	m, err := models.Invitations.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Role = rel2
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a invitation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *InvitationTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Invitation {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a invitation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *InvitationTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Invitation {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple invitations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o InvitationTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.InvitationSlice, error) {
+	var err error
+	m := make(models.InvitationSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple invitations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o InvitationTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.InvitationSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple invitations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o InvitationTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.InvitationSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Invitation has methods that act as mods for the InvitationTemplate
+var InvitationMods invitationMods
+
+type invitationMods struct{}
+
+func (m invitationMods) RandomizeAllColumns(f *faker.Faker) InvitationMod {
+	return InvitationModSlice{
+		InvitationMods.RandomID(f),
+		InvitationMods.RandomEmail(f),
+		InvitationMods.RandomRoleID(f),
+		InvitationMods.RandomInvitedBy(f),
+		InvitationMods.RandomToken(f),
+		InvitationMods.RandomExpireAt(f),
+		InvitationMods.RandomAcceptedAt(f),
+		InvitationMods.RandomAcceptedUserID(f),
+		InvitationMods.RandomRevokedAt(f),
+		InvitationMods.RandomCreatedAt(f),
+		InvitationMods.RandomUpdatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m invitationMods) ID(val int64) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invitationMods) IDFunc(f func() int64) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m invitationMods) UnsetID() InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invitationMods) RandomID(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invitationMods) Email(val string) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.Email = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invitationMods) EmailFunc(f func() string) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.Email = f
+	})
+}
+
+// Clear any values for the column
+func (m invitationMods) UnsetEmail() InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.Email = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invitationMods) RandomEmail(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.Email = func() string {
+			return random_string(f, "300")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invitationMods) RoleID(val int64) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.RoleID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invitationMods) RoleIDFunc(f func() int64) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.RoleID = f
+	})
+}
+
+// Clear any values for the column
+func (m invitationMods) UnsetRoleID() InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.RoleID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invitationMods) RandomRoleID(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.RoleID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invitationMods) InvitedBy(val null.Val[int64]) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.InvitedBy = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invitationMods) InvitedByFunc(f func() null.Val[int64]) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.InvitedBy = f
+	})
+}
+
+// Clear any values for the column
+func (m invitationMods) UnsetInvitedBy() InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.InvitedBy = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invitationMods) RandomInvitedBy(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.InvitedBy = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invitationMods) RandomInvitedByNotNull(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.InvitedBy = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invitationMods) Token(val string) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.Token = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invitationMods) TokenFunc(f func() string) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.Token = f
+	})
+}
+
+// Clear any values for the column
+func (m invitationMods) UnsetToken() InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.Token = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invitationMods) RandomToken(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.Token = func() string {
+			return random_string(f, "255")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invitationMods) ExpireAt(val time.Time) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.ExpireAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invitationMods) ExpireAtFunc(f func() time.Time) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.ExpireAt = f
+	})
+}
+
+// Clear any values for the column
+func (m invitationMods) UnsetExpireAt() InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.ExpireAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invitationMods) RandomExpireAt(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.ExpireAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invitationMods) AcceptedAt(val null.Val[time.Time]) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.AcceptedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invitationMods) AcceptedAtFunc(f func() null.Val[time.Time]) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.AcceptedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m invitationMods) UnsetAcceptedAt() InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.AcceptedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invitationMods) RandomAcceptedAt(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.AcceptedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invitationMods) RandomAcceptedAtNotNull(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.AcceptedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invitationMods) AcceptedUserID(val null.Val[int64]) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.AcceptedUserID = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invitationMods) AcceptedUserIDFunc(f func() null.Val[int64]) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.AcceptedUserID = f
+	})
+}
+
+// Clear any values for the column
+func (m invitationMods) UnsetAcceptedUserID() InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.AcceptedUserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invitationMods) RandomAcceptedUserID(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.AcceptedUserID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invitationMods) RandomAcceptedUserIDNotNull(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.AcceptedUserID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invitationMods) RevokedAt(val null.Val[time.Time]) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.RevokedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invitationMods) RevokedAtFunc(f func() null.Val[time.Time]) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.RevokedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m invitationMods) UnsetRevokedAt() InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.RevokedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invitationMods) RandomRevokedAt(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.RevokedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invitationMods) RandomRevokedAtNotNull(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.RevokedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invitationMods) CreatedAt(val null.Val[time.Time]) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invitationMods) CreatedAtFunc(f func() null.Val[time.Time]) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m invitationMods) UnsetCreatedAt() InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invitationMods) RandomCreatedAt(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invitationMods) RandomCreatedAtNotNull(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invitationMods) UpdatedAt(val null.Val[time.Time]) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invitationMods) UpdatedAtFunc(f func() null.Val[time.Time]) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.UpdatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m invitationMods) UnsetUpdatedAt() InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.UpdatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invitationMods) RandomUpdatedAt(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invitationMods) RandomUpdatedAtNotNull(f *faker.Faker) InvitationMod {
+	return InvitationModFunc(func(_ context.Context, o *InvitationTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m invitationMods) WithParentsCascading() InvitationMod {
+	return InvitationModFunc(func(ctx context.Context, o *InvitationTemplate) {
+		if isDone, _ := invitationWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = invitationWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithAcceptedUserUser(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithInvitedByUser(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewRoleWithContext(ctx, RoleMods.WithParentsCascading())
+			m.WithRole(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m invitationMods) WithAcceptedUserUser(rel *UserTemplate) InvitationMod {
+	return InvitationModFunc(func(ctx context.Context, o *InvitationTemplate) {
+		o.r.AcceptedUserUser = &invitationRAcceptedUserUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m invitationMods) WithNewAcceptedUserUser(mods ...UserMod) InvitationMod {
+	return InvitationModFunc(func(ctx context.Context, o *InvitationTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithAcceptedUserUser(related).Apply(ctx, o)
+	})
+}
+
+func (m invitationMods) WithExistingAcceptedUserUser(em *models.User) InvitationMod {
+	return InvitationModFunc(func(ctx context.Context, o *InvitationTemplate) {
+		o.r.AcceptedUserUser = &invitationRAcceptedUserUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m invitationMods) WithoutAcceptedUserUser() InvitationMod {
+	return InvitationModFunc(func(ctx context.Context, o *InvitationTemplate) {
+		o.r.AcceptedUserUser = nil
+	})
+}
+
+func (m invitationMods) WithInvitedByUser(rel *UserTemplate) InvitationMod {
+	return InvitationModFunc(func(ctx context.Context, o *InvitationTemplate) {
+		o.r.InvitedByUser = &invitationRInvitedByUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m invitationMods) WithNewInvitedByUser(mods ...UserMod) InvitationMod {
+	return InvitationModFunc(func(ctx context.Context, o *InvitationTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithInvitedByUser(related).Apply(ctx, o)
+	})
+}
+
+func (m invitationMods) WithExistingInvitedByUser(em *models.User) InvitationMod {
+	return InvitationModFunc(func(ctx context.Context, o *InvitationTemplate) {
+		o.r.InvitedByUser = &invitationRInvitedByUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m invitationMods) WithoutInvitedByUser() InvitationMod {
+	return InvitationModFunc(func(ctx context.Context, o *InvitationTemplate) {
+		o.r.InvitedByUser = nil
+	})
+}
+
+func (m invitationMods) WithRole(rel *RoleTemplate) InvitationMod {
+	return InvitationModFunc(func(ctx context.Context, o *InvitationTemplate) {
+		o.r.Role = &invitationRRoleR{
+			o: rel,
+		}
+	})
+}
+
+func (m invitationMods) WithNewRole(mods ...RoleMod) InvitationMod {
+	return InvitationModFunc(func(ctx context.Context, o *InvitationTemplate) {
+		related := o.f.NewRoleWithContext(ctx, mods...)
+
+		m.WithRole(related).Apply(ctx, o)
+	})
+}
+
+func (m invitationMods) WithExistingRole(em *models.Role) InvitationMod {
+	return InvitationModFunc(func(ctx context.Context, o *InvitationTemplate) {
+		o.r.Role = &invitationRRoleR{
+			o: o.f.FromExistingRole(em),
+		}
+	})
+}
+
+func (m invitationMods) WithoutRole() InvitationMod {
+	return InvitationModFunc(func(ctx context.Context, o *InvitationTemplate) {
+		o.r.Role = nil
+	})
+}
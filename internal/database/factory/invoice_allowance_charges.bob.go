@@ -0,0 +1,766 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/shopspring/decimal"
+	"github.com/stephenafamo/bob"
+)
+
+type InvoiceAllowanceChargeMod interface {
+	Apply(context.Context, *InvoiceAllowanceChargeTemplate)
+}
+
+type InvoiceAllowanceChargeModFunc func(context.Context, *InvoiceAllowanceChargeTemplate)
+
+func (f InvoiceAllowanceChargeModFunc) Apply(ctx context.Context, n *InvoiceAllowanceChargeTemplate) {
+	f(ctx, n)
+}
+
+type InvoiceAllowanceChargeModSlice []InvoiceAllowanceChargeMod
+
+func (mods InvoiceAllowanceChargeModSlice) Apply(ctx context.Context, n *InvoiceAllowanceChargeTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// InvoiceAllowanceChargeTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type InvoiceAllowanceChargeTemplate struct {
+	ID            func() int64
+	InvoiceID     func() int64
+	InvoiceLineID func() null.Val[int64]
+	IsCharge      func() bool
+	Reason        func() null.Val[string]
+	Rate          func() null.Val[decimal.Decimal]
+	Amount        func() decimal.Decimal
+	CreatedAt     func() null.Val[time.Time]
+
+	r invoiceAllowanceChargeR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type invoiceAllowanceChargeR struct {
+	Invoice     *invoiceAllowanceChargeRInvoiceR
+	InvoiceLine *invoiceAllowanceChargeRInvoiceLineR
+}
+
+type invoiceAllowanceChargeRInvoiceR struct {
+	o *InvoiceTemplate
+}
+type invoiceAllowanceChargeRInvoiceLineR struct {
+	o *InvoiceLineTemplate
+}
+
+// Apply mods to the InvoiceAllowanceChargeTemplate
+func (o *InvoiceAllowanceChargeTemplate) Apply(ctx context.Context, mods ...InvoiceAllowanceChargeMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.InvoiceAllowanceCharge
+// according to the relationships in the template. Nothing is inserted into the db
+func (t InvoiceAllowanceChargeTemplate) setModelRels(o *models.InvoiceAllowanceCharge) {
+	if t.r.Invoice != nil {
+		rel := t.r.Invoice.o.Build()
+		rel.R.InvoiceAllowanceCharges = append(rel.R.InvoiceAllowanceCharges, o)
+		o.InvoiceID = rel.ID // h2
+		o.R.Invoice = rel
+	}
+
+	if t.r.InvoiceLine != nil {
+		rel := t.r.InvoiceLine.o.Build()
+		rel.R.InvoiceAllowanceCharges = append(rel.R.InvoiceAllowanceCharges, o)
+		o.InvoiceLineID = null.From(rel.ID) // h2
+		o.R.InvoiceLine = rel
+	}
+}
+
+// BuildSetter returns an *models.InvoiceAllowanceChargeSetter
+// this does nothing with the relationship templates
+func (o InvoiceAllowanceChargeTemplate) BuildSetter() *models.InvoiceAllowanceChargeSetter {
+	m := &models.InvoiceAllowanceChargeSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.InvoiceID != nil {
+		val := o.InvoiceID()
+		m.InvoiceID = omit.From(val)
+	}
+	if o.InvoiceLineID != nil {
+		val := o.InvoiceLineID()
+		m.InvoiceLineID = omitnull.FromNull(val)
+	}
+	if o.IsCharge != nil {
+		val := o.IsCharge()
+		m.IsCharge = omit.From(val)
+	}
+	if o.Reason != nil {
+		val := o.Reason()
+		m.Reason = omitnull.FromNull(val)
+	}
+	if o.Rate != nil {
+		val := o.Rate()
+		m.Rate = omitnull.FromNull(val)
+	}
+	if o.Amount != nil {
+		val := o.Amount()
+		m.Amount = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.InvoiceAllowanceChargeSetter
+// this does nothing with the relationship templates
+func (o InvoiceAllowanceChargeTemplate) BuildManySetter(number int) []*models.InvoiceAllowanceChargeSetter {
+	m := make([]*models.InvoiceAllowanceChargeSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.InvoiceAllowanceCharge
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use InvoiceAllowanceChargeTemplate.Create
+func (o InvoiceAllowanceChargeTemplate) Build() *models.InvoiceAllowanceCharge {
+	m := &models.InvoiceAllowanceCharge{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.InvoiceID != nil {
+		m.InvoiceID = o.InvoiceID()
+	}
+	if o.InvoiceLineID != nil {
+		m.InvoiceLineID = o.InvoiceLineID()
+	}
+	if o.IsCharge != nil {
+		m.IsCharge = o.IsCharge()
+	}
+	if o.Reason != nil {
+		m.Reason = o.Reason()
+	}
+	if o.Rate != nil {
+		m.Rate = o.Rate()
+	}
+	if o.Amount != nil {
+		m.Amount = o.Amount()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.InvoiceAllowanceChargeSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use InvoiceAllowanceChargeTemplate.CreateMany
+func (o InvoiceAllowanceChargeTemplate) BuildMany(number int) models.InvoiceAllowanceChargeSlice {
+	m := make(models.InvoiceAllowanceChargeSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableInvoiceAllowanceCharge(m *models.InvoiceAllowanceChargeSetter) {
+	if !(m.InvoiceID.IsValue()) {
+		val := random_int64(nil)
+		m.InvoiceID = omit.From(val)
+	}
+	if !(m.IsCharge.IsValue()) {
+		val := random_bool(nil)
+		m.IsCharge = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.InvoiceAllowanceCharge
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *InvoiceAllowanceChargeTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.InvoiceAllowanceCharge) error {
+	var err error
+
+	isInvoiceLineDone, _ := invoiceAllowanceChargeRelInvoiceLineCtx.Value(ctx)
+	if !isInvoiceLineDone && o.r.InvoiceLine != nil {
+		ctx = invoiceAllowanceChargeRelInvoiceLineCtx.WithValue(ctx, true)
+		if o.r.InvoiceLine.o.alreadyPersisted {
+			m.R.InvoiceLine = o.r.InvoiceLine.o.Build()
+		} else {
+			var rel1 *models.InvoiceLine
+			rel1, err = o.r.InvoiceLine.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachInvoiceLine(ctx, exec, rel1)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return err
+}
+
+// Create builds a invoiceAllowanceCharge and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *InvoiceAllowanceChargeTemplate) Create(ctx context.Context, exec bob.Executor) (*models.InvoiceAllowanceCharge, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableInvoiceAllowanceCharge(opt)
+
+	if o.r.Invoice == nil {
+		InvoiceAllowanceChargeMods.WithNewInvoice().Apply(ctx, o)
+	}
+
+	var rel0 *models.Invoice
+
+	if o.r.Invoice.o.alreadyPersisted {
+		rel0 = o.r.Invoice.o.Build()
+	} else {
+		rel0, err = o.r.Invoice.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.InvoiceID = omit.From(rel0.ID)
+
+	m, err := models.InvoiceAllowanceCharges.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Invoice = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a invoiceAllowanceCharge and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *InvoiceAllowanceChargeTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.InvoiceAllowanceCharge {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a invoiceAllowanceCharge and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *InvoiceAllowanceChargeTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.InvoiceAllowanceCharge {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple invoiceAllowanceCharges and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o InvoiceAllowanceChargeTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.InvoiceAllowanceChargeSlice, error) {
+	var err error
+	m := make(models.InvoiceAllowanceChargeSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple invoiceAllowanceCharges and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o InvoiceAllowanceChargeTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.InvoiceAllowanceChargeSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple invoiceAllowanceCharges and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o InvoiceAllowanceChargeTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.InvoiceAllowanceChargeSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// InvoiceAllowanceCharge has methods that act as mods for the InvoiceAllowanceChargeTemplate
+var InvoiceAllowanceChargeMods invoiceAllowanceChargeMods
+
+type invoiceAllowanceChargeMods struct{}
+
+func (m invoiceAllowanceChargeMods) RandomizeAllColumns(f *faker.Faker) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModSlice{
+		InvoiceAllowanceChargeMods.RandomID(f),
+		InvoiceAllowanceChargeMods.RandomInvoiceID(f),
+		InvoiceAllowanceChargeMods.RandomInvoiceLineID(f),
+		InvoiceAllowanceChargeMods.RandomIsCharge(f),
+		InvoiceAllowanceChargeMods.RandomReason(f),
+		InvoiceAllowanceChargeMods.RandomRate(f),
+		InvoiceAllowanceChargeMods.RandomAmount(f),
+		InvoiceAllowanceChargeMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m invoiceAllowanceChargeMods) ID(val int64) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceAllowanceChargeMods) IDFunc(f func() int64) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceAllowanceChargeMods) UnsetID() InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceAllowanceChargeMods) RandomID(f *faker.Faker) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceAllowanceChargeMods) InvoiceID(val int64) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.InvoiceID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceAllowanceChargeMods) InvoiceIDFunc(f func() int64) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.InvoiceID = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceAllowanceChargeMods) UnsetInvoiceID() InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.InvoiceID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceAllowanceChargeMods) RandomInvoiceID(f *faker.Faker) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.InvoiceID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceAllowanceChargeMods) InvoiceLineID(val null.Val[int64]) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.InvoiceLineID = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceAllowanceChargeMods) InvoiceLineIDFunc(f func() null.Val[int64]) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.InvoiceLineID = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceAllowanceChargeMods) UnsetInvoiceLineID() InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.InvoiceLineID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceAllowanceChargeMods) RandomInvoiceLineID(f *faker.Faker) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.InvoiceLineID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceAllowanceChargeMods) RandomInvoiceLineIDNotNull(f *faker.Faker) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.InvoiceLineID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceAllowanceChargeMods) IsCharge(val bool) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.IsCharge = func() bool { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceAllowanceChargeMods) IsChargeFunc(f func() bool) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.IsCharge = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceAllowanceChargeMods) UnsetIsCharge() InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.IsCharge = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceAllowanceChargeMods) RandomIsCharge(f *faker.Faker) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.IsCharge = func() bool {
+			return random_bool(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceAllowanceChargeMods) Reason(val null.Val[string]) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.Reason = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceAllowanceChargeMods) ReasonFunc(f func() null.Val[string]) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.Reason = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceAllowanceChargeMods) UnsetReason() InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.Reason = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceAllowanceChargeMods) RandomReason(f *faker.Faker) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.Reason = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "300")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceAllowanceChargeMods) RandomReasonNotNull(f *faker.Faker) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.Reason = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "300")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceAllowanceChargeMods) Rate(val null.Val[decimal.Decimal]) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.Rate = func() null.Val[decimal.Decimal] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceAllowanceChargeMods) RateFunc(f func() null.Val[decimal.Decimal]) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.Rate = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceAllowanceChargeMods) UnsetRate() InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.Rate = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceAllowanceChargeMods) RandomRate(f *faker.Faker) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.Rate = func() null.Val[decimal.Decimal] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_decimal_Decimal(f, "7", "4")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceAllowanceChargeMods) RandomRateNotNull(f *faker.Faker) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.Rate = func() null.Val[decimal.Decimal] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_decimal_Decimal(f, "7", "4")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceAllowanceChargeMods) Amount(val decimal.Decimal) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.Amount = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceAllowanceChargeMods) AmountFunc(f func() decimal.Decimal) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.Amount = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceAllowanceChargeMods) UnsetAmount() InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.Amount = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceAllowanceChargeMods) RandomAmount(f *faker.Faker) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.Amount = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceAllowanceChargeMods) CreatedAt(val null.Val[time.Time]) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceAllowanceChargeMods) CreatedAtFunc(f func() null.Val[time.Time]) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceAllowanceChargeMods) UnsetCreatedAt() InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceAllowanceChargeMods) RandomCreatedAt(f *faker.Faker) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceAllowanceChargeMods) RandomCreatedAtNotNull(f *faker.Faker) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(_ context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m invoiceAllowanceChargeMods) WithParentsCascading() InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(ctx context.Context, o *InvoiceAllowanceChargeTemplate) {
+		if isDone, _ := invoiceAllowanceChargeWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = invoiceAllowanceChargeWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewInvoiceWithContext(ctx, InvoiceMods.WithParentsCascading())
+			m.WithInvoice(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewInvoiceLineWithContext(ctx, InvoiceLineMods.WithParentsCascading())
+			m.WithInvoiceLine(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m invoiceAllowanceChargeMods) WithInvoice(rel *InvoiceTemplate) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(ctx context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.r.Invoice = &invoiceAllowanceChargeRInvoiceR{
+			o: rel,
+		}
+	})
+}
+
+func (m invoiceAllowanceChargeMods) WithNewInvoice(mods ...InvoiceMod) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(ctx context.Context, o *InvoiceAllowanceChargeTemplate) {
+		related := o.f.NewInvoiceWithContext(ctx, mods...)
+
+		m.WithInvoice(related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceAllowanceChargeMods) WithExistingInvoice(em *models.Invoice) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(ctx context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.r.Invoice = &invoiceAllowanceChargeRInvoiceR{
+			o: o.f.FromExistingInvoice(em),
+		}
+	})
+}
+
+func (m invoiceAllowanceChargeMods) WithoutInvoice() InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(ctx context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.r.Invoice = nil
+	})
+}
+
+func (m invoiceAllowanceChargeMods) WithInvoiceLine(rel *InvoiceLineTemplate) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(ctx context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.r.InvoiceLine = &invoiceAllowanceChargeRInvoiceLineR{
+			o: rel,
+		}
+	})
+}
+
+func (m invoiceAllowanceChargeMods) WithNewInvoiceLine(mods ...InvoiceLineMod) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(ctx context.Context, o *InvoiceAllowanceChargeTemplate) {
+		related := o.f.NewInvoiceLineWithContext(ctx, mods...)
+
+		m.WithInvoiceLine(related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceAllowanceChargeMods) WithExistingInvoiceLine(em *models.InvoiceLine) InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(ctx context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.r.InvoiceLine = &invoiceAllowanceChargeRInvoiceLineR{
+			o: o.f.FromExistingInvoiceLine(em),
+		}
+	})
+}
+
+func (m invoiceAllowanceChargeMods) WithoutInvoiceLine() InvoiceAllowanceChargeMod {
+	return InvoiceAllowanceChargeModFunc(func(ctx context.Context, o *InvoiceAllowanceChargeTemplate) {
+		o.r.InvoiceLine = nil
+	})
+}
@@ -0,0 +1,741 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/shopspring/decimal"
+	"github.com/stephenafamo/bob"
+)
+
+type InvoiceLineTaxBreakdownMod interface {
+	Apply(context.Context, *InvoiceLineTaxBreakdownTemplate)
+}
+
+type InvoiceLineTaxBreakdownModFunc func(context.Context, *InvoiceLineTaxBreakdownTemplate)
+
+func (f InvoiceLineTaxBreakdownModFunc) Apply(ctx context.Context, n *InvoiceLineTaxBreakdownTemplate) {
+	f(ctx, n)
+}
+
+type InvoiceLineTaxBreakdownModSlice []InvoiceLineTaxBreakdownMod
+
+func (mods InvoiceLineTaxBreakdownModSlice) Apply(ctx context.Context, n *InvoiceLineTaxBreakdownTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// InvoiceLineTaxBreakdownTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type InvoiceLineTaxBreakdownTemplate struct {
+	ID              func() int64
+	InvoiceLineID   func() int64
+	TaxType         func() string
+	Rate            func() null.Val[decimal.Decimal]
+	PerUnitAmount   func() null.Val[decimal.Decimal]
+	ExemptionReason func() null.Val[string]
+	TaxableAmount   func() decimal.Decimal
+	TaxAmount       func() decimal.Decimal
+	CreatedAt       func() null.Val[time.Time]
+
+	r invoiceLineTaxBreakdownR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type invoiceLineTaxBreakdownR struct {
+	InvoiceLine *invoiceLineTaxBreakdownRInvoiceLineR
+}
+
+type invoiceLineTaxBreakdownRInvoiceLineR struct {
+	o *InvoiceLineTemplate
+}
+
+// Apply mods to the InvoiceLineTaxBreakdownTemplate
+func (o *InvoiceLineTaxBreakdownTemplate) Apply(ctx context.Context, mods ...InvoiceLineTaxBreakdownMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.InvoiceLineTaxBreakdown
+// according to the relationships in the template. Nothing is inserted into the db
+func (t InvoiceLineTaxBreakdownTemplate) setModelRels(o *models.InvoiceLineTaxBreakdown) {
+	if t.r.InvoiceLine != nil {
+		rel := t.r.InvoiceLine.o.Build()
+		rel.R.InvoiceLineTaxBreakdowns = append(rel.R.InvoiceLineTaxBreakdowns, o)
+		o.InvoiceLineID = rel.ID // h2
+		o.R.InvoiceLine = rel
+	}
+}
+
+// BuildSetter returns an *models.InvoiceLineTaxBreakdownSetter
+// this does nothing with the relationship templates
+func (o InvoiceLineTaxBreakdownTemplate) BuildSetter() *models.InvoiceLineTaxBreakdownSetter {
+	m := &models.InvoiceLineTaxBreakdownSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.InvoiceLineID != nil {
+		val := o.InvoiceLineID()
+		m.InvoiceLineID = omit.From(val)
+	}
+	if o.TaxType != nil {
+		val := o.TaxType()
+		m.TaxType = omit.From(val)
+	}
+	if o.Rate != nil {
+		val := o.Rate()
+		m.Rate = omitnull.FromNull(val)
+	}
+	if o.PerUnitAmount != nil {
+		val := o.PerUnitAmount()
+		m.PerUnitAmount = omitnull.FromNull(val)
+	}
+	if o.ExemptionReason != nil {
+		val := o.ExemptionReason()
+		m.ExemptionReason = omitnull.FromNull(val)
+	}
+	if o.TaxableAmount != nil {
+		val := o.TaxableAmount()
+		m.TaxableAmount = omit.From(val)
+	}
+	if o.TaxAmount != nil {
+		val := o.TaxAmount()
+		m.TaxAmount = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.InvoiceLineTaxBreakdownSetter
+// this does nothing with the relationship templates
+func (o InvoiceLineTaxBreakdownTemplate) BuildManySetter(number int) []*models.InvoiceLineTaxBreakdownSetter {
+	m := make([]*models.InvoiceLineTaxBreakdownSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.InvoiceLineTaxBreakdown
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use InvoiceLineTaxBreakdownTemplate.Create
+func (o InvoiceLineTaxBreakdownTemplate) Build() *models.InvoiceLineTaxBreakdown {
+	m := &models.InvoiceLineTaxBreakdown{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.InvoiceLineID != nil {
+		m.InvoiceLineID = o.InvoiceLineID()
+	}
+	if o.TaxType != nil {
+		m.TaxType = o.TaxType()
+	}
+	if o.Rate != nil {
+		m.Rate = o.Rate()
+	}
+	if o.PerUnitAmount != nil {
+		m.PerUnitAmount = o.PerUnitAmount()
+	}
+	if o.ExemptionReason != nil {
+		m.ExemptionReason = o.ExemptionReason()
+	}
+	if o.TaxableAmount != nil {
+		m.TaxableAmount = o.TaxableAmount()
+	}
+	if o.TaxAmount != nil {
+		m.TaxAmount = o.TaxAmount()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.InvoiceLineTaxBreakdownSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use InvoiceLineTaxBreakdownTemplate.CreateMany
+func (o InvoiceLineTaxBreakdownTemplate) BuildMany(number int) models.InvoiceLineTaxBreakdownSlice {
+	m := make(models.InvoiceLineTaxBreakdownSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableInvoiceLineTaxBreakdown(m *models.InvoiceLineTaxBreakdownSetter) {
+	if !(m.InvoiceLineID.IsValue()) {
+		val := random_int64(nil)
+		m.InvoiceLineID = omit.From(val)
+	}
+	if !(m.TaxType.IsValue()) {
+		val := random_string(nil, "2")
+		m.TaxType = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.InvoiceLineTaxBreakdown
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *InvoiceLineTaxBreakdownTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.InvoiceLineTaxBreakdown) error {
+	var err error
+
+	return err
+}
+
+// Create builds a invoiceLineTaxBreakdown and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *InvoiceLineTaxBreakdownTemplate) Create(ctx context.Context, exec bob.Executor) (*models.InvoiceLineTaxBreakdown, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableInvoiceLineTaxBreakdown(opt)
+
+	if o.r.InvoiceLine == nil {
+		InvoiceLineTaxBreakdownMods.WithNewInvoiceLine().Apply(ctx, o)
+	}
+
+	var rel0 *models.InvoiceLine
+
+	if o.r.InvoiceLine.o.alreadyPersisted {
+		rel0 = o.r.InvoiceLine.o.Build()
+	} else {
+		rel0, err = o.r.InvoiceLine.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.InvoiceLineID = omit.From(rel0.ID)
+
+	m, err := models.InvoiceLineTaxBreakdowns.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.InvoiceLine = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a invoiceLineTaxBreakdown and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *InvoiceLineTaxBreakdownTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.InvoiceLineTaxBreakdown {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a invoiceLineTaxBreakdown and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *InvoiceLineTaxBreakdownTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.InvoiceLineTaxBreakdown {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple invoiceLineTaxBreakdowns and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o InvoiceLineTaxBreakdownTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.InvoiceLineTaxBreakdownSlice, error) {
+	var err error
+	m := make(models.InvoiceLineTaxBreakdownSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple invoiceLineTaxBreakdowns and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o InvoiceLineTaxBreakdownTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.InvoiceLineTaxBreakdownSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple invoiceLineTaxBreakdowns and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o InvoiceLineTaxBreakdownTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.InvoiceLineTaxBreakdownSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// InvoiceLineTaxBreakdown has methods that act as mods for the InvoiceLineTaxBreakdownTemplate
+var InvoiceLineTaxBreakdownMods invoiceLineTaxBreakdownMods
+
+type invoiceLineTaxBreakdownMods struct{}
+
+func (m invoiceLineTaxBreakdownMods) RandomizeAllColumns(f *faker.Faker) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModSlice{
+		InvoiceLineTaxBreakdownMods.RandomID(f),
+		InvoiceLineTaxBreakdownMods.RandomInvoiceLineID(f),
+		InvoiceLineTaxBreakdownMods.RandomTaxType(f),
+		InvoiceLineTaxBreakdownMods.RandomRate(f),
+		InvoiceLineTaxBreakdownMods.RandomPerUnitAmount(f),
+		InvoiceLineTaxBreakdownMods.RandomExemptionReason(f),
+		InvoiceLineTaxBreakdownMods.RandomTaxableAmount(f),
+		InvoiceLineTaxBreakdownMods.RandomTaxAmount(f),
+		InvoiceLineTaxBreakdownMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m invoiceLineTaxBreakdownMods) ID(val int64) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineTaxBreakdownMods) IDFunc(f func() int64) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineTaxBreakdownMods) UnsetID() InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceLineTaxBreakdownMods) RandomID(f *faker.Faker) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineTaxBreakdownMods) InvoiceLineID(val int64) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.InvoiceLineID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineTaxBreakdownMods) InvoiceLineIDFunc(f func() int64) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.InvoiceLineID = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineTaxBreakdownMods) UnsetInvoiceLineID() InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.InvoiceLineID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceLineTaxBreakdownMods) RandomInvoiceLineID(f *faker.Faker) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.InvoiceLineID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineTaxBreakdownMods) TaxType(val string) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.TaxType = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineTaxBreakdownMods) TaxTypeFunc(f func() string) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.TaxType = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineTaxBreakdownMods) UnsetTaxType() InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.TaxType = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceLineTaxBreakdownMods) RandomTaxType(f *faker.Faker) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.TaxType = func() string {
+			return random_string(f, "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineTaxBreakdownMods) Rate(val null.Val[decimal.Decimal]) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.Rate = func() null.Val[decimal.Decimal] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineTaxBreakdownMods) RateFunc(f func() null.Val[decimal.Decimal]) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.Rate = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineTaxBreakdownMods) UnsetRate() InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.Rate = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceLineTaxBreakdownMods) RandomRate(f *faker.Faker) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.Rate = func() null.Val[decimal.Decimal] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_decimal_Decimal(f, "7", "4")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceLineTaxBreakdownMods) RandomRateNotNull(f *faker.Faker) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.Rate = func() null.Val[decimal.Decimal] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_decimal_Decimal(f, "7", "4")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineTaxBreakdownMods) PerUnitAmount(val null.Val[decimal.Decimal]) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.PerUnitAmount = func() null.Val[decimal.Decimal] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineTaxBreakdownMods) PerUnitAmountFunc(f func() null.Val[decimal.Decimal]) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.PerUnitAmount = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineTaxBreakdownMods) UnsetPerUnitAmount() InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.PerUnitAmount = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceLineTaxBreakdownMods) RandomPerUnitAmount(f *faker.Faker) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.PerUnitAmount = func() null.Val[decimal.Decimal] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_decimal_Decimal(f, "18", "2")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceLineTaxBreakdownMods) RandomPerUnitAmountNotNull(f *faker.Faker) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.PerUnitAmount = func() null.Val[decimal.Decimal] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_decimal_Decimal(f, "18", "2")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineTaxBreakdownMods) ExemptionReason(val null.Val[string]) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.ExemptionReason = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineTaxBreakdownMods) ExemptionReasonFunc(f func() null.Val[string]) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.ExemptionReason = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineTaxBreakdownMods) UnsetExemptionReason() InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.ExemptionReason = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceLineTaxBreakdownMods) RandomExemptionReason(f *faker.Faker) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.ExemptionReason = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "300")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceLineTaxBreakdownMods) RandomExemptionReasonNotNull(f *faker.Faker) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.ExemptionReason = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "300")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineTaxBreakdownMods) TaxableAmount(val decimal.Decimal) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.TaxableAmount = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineTaxBreakdownMods) TaxableAmountFunc(f func() decimal.Decimal) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.TaxableAmount = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineTaxBreakdownMods) UnsetTaxableAmount() InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.TaxableAmount = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceLineTaxBreakdownMods) RandomTaxableAmount(f *faker.Faker) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.TaxableAmount = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineTaxBreakdownMods) TaxAmount(val decimal.Decimal) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.TaxAmount = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineTaxBreakdownMods) TaxAmountFunc(f func() decimal.Decimal) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.TaxAmount = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineTaxBreakdownMods) UnsetTaxAmount() InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.TaxAmount = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceLineTaxBreakdownMods) RandomTaxAmount(f *faker.Faker) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.TaxAmount = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineTaxBreakdownMods) CreatedAt(val null.Val[time.Time]) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineTaxBreakdownMods) CreatedAtFunc(f func() null.Val[time.Time]) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineTaxBreakdownMods) UnsetCreatedAt() InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceLineTaxBreakdownMods) RandomCreatedAt(f *faker.Faker) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceLineTaxBreakdownMods) RandomCreatedAtNotNull(f *faker.Faker) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(_ context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m invoiceLineTaxBreakdownMods) WithParentsCascading() InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(ctx context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		if isDone, _ := invoiceLineTaxBreakdownWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = invoiceLineTaxBreakdownWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewInvoiceLineWithContext(ctx, InvoiceLineMods.WithParentsCascading())
+			m.WithInvoiceLine(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m invoiceLineTaxBreakdownMods) WithInvoiceLine(rel *InvoiceLineTemplate) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(ctx context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.r.InvoiceLine = &invoiceLineTaxBreakdownRInvoiceLineR{
+			o: rel,
+		}
+	})
+}
+
+func (m invoiceLineTaxBreakdownMods) WithNewInvoiceLine(mods ...InvoiceLineMod) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(ctx context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		related := o.f.NewInvoiceLineWithContext(ctx, mods...)
+
+		m.WithInvoiceLine(related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceLineTaxBreakdownMods) WithExistingInvoiceLine(em *models.InvoiceLine) InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(ctx context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.r.InvoiceLine = &invoiceLineTaxBreakdownRInvoiceLineR{
+			o: o.f.FromExistingInvoiceLine(em),
+		}
+	})
+}
+
+func (m invoiceLineTaxBreakdownMods) WithoutInvoiceLine() InvoiceLineTaxBreakdownMod {
+	return InvoiceLineTaxBreakdownModFunc(func(ctx context.Context, o *InvoiceLineTaxBreakdownTemplate) {
+		o.r.InvoiceLine = nil
+	})
+}
@@ -0,0 +1,1129 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/shopspring/decimal"
+	"github.com/stephenafamo/bob"
+)
+
+type InvoiceLineMod interface {
+	Apply(context.Context, *InvoiceLineTemplate)
+}
+
+type InvoiceLineModFunc func(context.Context, *InvoiceLineTemplate)
+
+func (f InvoiceLineModFunc) Apply(ctx context.Context, n *InvoiceLineTemplate) {
+	f(ctx, n)
+}
+
+type InvoiceLineModSlice []InvoiceLineMod
+
+func (mods InvoiceLineModSlice) Apply(ctx context.Context, n *InvoiceLineTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// InvoiceLineTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type InvoiceLineTemplate struct {
+	ID                 func() int64
+	InvoiceID          func() int64
+	LineNumber         func() int32
+	ClassificationCode func() string
+	Description        func() string
+	Quantity           func() decimal.Decimal
+	UnitCode           func() null.Val[string]
+	UnitPrice          func() decimal.Decimal
+	ProductTariffCode  func() null.Val[string]
+	CountryOfOrigin    func() null.Val[string]
+	Subtotal           func() decimal.Decimal
+	TotalExcludingTax  func() decimal.Decimal
+	TaxAmount          func() decimal.Decimal
+	CreatedAt          func() null.Val[time.Time]
+
+	r invoiceLineR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type invoiceLineR struct {
+	InvoiceAllowanceCharges  []*invoiceLineRInvoiceAllowanceChargesR
+	InvoiceLineTaxBreakdowns []*invoiceLineRInvoiceLineTaxBreakdownsR
+	Invoice                  *invoiceLineRInvoiceR
+}
+
+type invoiceLineRInvoiceAllowanceChargesR struct {
+	number int
+	o      *InvoiceAllowanceChargeTemplate
+}
+type invoiceLineRInvoiceLineTaxBreakdownsR struct {
+	number int
+	o      *InvoiceLineTaxBreakdownTemplate
+}
+type invoiceLineRInvoiceR struct {
+	o *InvoiceTemplate
+}
+
+// Apply mods to the InvoiceLineTemplate
+func (o *InvoiceLineTemplate) Apply(ctx context.Context, mods ...InvoiceLineMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.InvoiceLine
+// according to the relationships in the template. Nothing is inserted into the db
+func (t InvoiceLineTemplate) setModelRels(o *models.InvoiceLine) {
+	if t.r.InvoiceAllowanceCharges != nil {
+		rel := models.InvoiceAllowanceChargeSlice{}
+		for _, r := range t.r.InvoiceAllowanceCharges {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.InvoiceLineID = null.From(o.ID) // h2
+				rel.R.InvoiceLine = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.InvoiceAllowanceCharges = rel
+	}
+
+	if t.r.InvoiceLineTaxBreakdowns != nil {
+		rel := models.InvoiceLineTaxBreakdownSlice{}
+		for _, r := range t.r.InvoiceLineTaxBreakdowns {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.InvoiceLineID = o.ID // h2
+				rel.R.InvoiceLine = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.InvoiceLineTaxBreakdowns = rel
+	}
+
+	if t.r.Invoice != nil {
+		rel := t.r.Invoice.o.Build()
+		rel.R.InvoiceLines = append(rel.R.InvoiceLines, o)
+		o.InvoiceID = rel.ID // h2
+		o.R.Invoice = rel
+	}
+}
+
+// BuildSetter returns an *models.InvoiceLineSetter
+// this does nothing with the relationship templates
+func (o InvoiceLineTemplate) BuildSetter() *models.InvoiceLineSetter {
+	m := &models.InvoiceLineSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.InvoiceID != nil {
+		val := o.InvoiceID()
+		m.InvoiceID = omit.From(val)
+	}
+	if o.LineNumber != nil {
+		val := o.LineNumber()
+		m.LineNumber = omit.From(val)
+	}
+	if o.ClassificationCode != nil {
+		val := o.ClassificationCode()
+		m.ClassificationCode = omit.From(val)
+	}
+	if o.Description != nil {
+		val := o.Description()
+		m.Description = omit.From(val)
+	}
+	if o.Quantity != nil {
+		val := o.Quantity()
+		m.Quantity = omit.From(val)
+	}
+	if o.UnitCode != nil {
+		val := o.UnitCode()
+		m.UnitCode = omitnull.FromNull(val)
+	}
+	if o.UnitPrice != nil {
+		val := o.UnitPrice()
+		m.UnitPrice = omit.From(val)
+	}
+	if o.ProductTariffCode != nil {
+		val := o.ProductTariffCode()
+		m.ProductTariffCode = omitnull.FromNull(val)
+	}
+	if o.CountryOfOrigin != nil {
+		val := o.CountryOfOrigin()
+		m.CountryOfOrigin = omitnull.FromNull(val)
+	}
+	if o.Subtotal != nil {
+		val := o.Subtotal()
+		m.Subtotal = omit.From(val)
+	}
+	if o.TotalExcludingTax != nil {
+		val := o.TotalExcludingTax()
+		m.TotalExcludingTax = omit.From(val)
+	}
+	if o.TaxAmount != nil {
+		val := o.TaxAmount()
+		m.TaxAmount = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.InvoiceLineSetter
+// this does nothing with the relationship templates
+func (o InvoiceLineTemplate) BuildManySetter(number int) []*models.InvoiceLineSetter {
+	m := make([]*models.InvoiceLineSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.InvoiceLine
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use InvoiceLineTemplate.Create
+func (o InvoiceLineTemplate) Build() *models.InvoiceLine {
+	m := &models.InvoiceLine{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.InvoiceID != nil {
+		m.InvoiceID = o.InvoiceID()
+	}
+	if o.LineNumber != nil {
+		m.LineNumber = o.LineNumber()
+	}
+	if o.ClassificationCode != nil {
+		m.ClassificationCode = o.ClassificationCode()
+	}
+	if o.Description != nil {
+		m.Description = o.Description()
+	}
+	if o.Quantity != nil {
+		m.Quantity = o.Quantity()
+	}
+	if o.UnitCode != nil {
+		m.UnitCode = o.UnitCode()
+	}
+	if o.UnitPrice != nil {
+		m.UnitPrice = o.UnitPrice()
+	}
+	if o.ProductTariffCode != nil {
+		m.ProductTariffCode = o.ProductTariffCode()
+	}
+	if o.CountryOfOrigin != nil {
+		m.CountryOfOrigin = o.CountryOfOrigin()
+	}
+	if o.Subtotal != nil {
+		m.Subtotal = o.Subtotal()
+	}
+	if o.TotalExcludingTax != nil {
+		m.TotalExcludingTax = o.TotalExcludingTax()
+	}
+	if o.TaxAmount != nil {
+		m.TaxAmount = o.TaxAmount()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.InvoiceLineSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use InvoiceLineTemplate.CreateMany
+func (o InvoiceLineTemplate) BuildMany(number int) models.InvoiceLineSlice {
+	m := make(models.InvoiceLineSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableInvoiceLine(m *models.InvoiceLineSetter) {
+	if !(m.InvoiceID.IsValue()) {
+		val := random_int64(nil)
+		m.InvoiceID = omit.From(val)
+	}
+	if !(m.LineNumber.IsValue()) {
+		val := random_int32(nil)
+		m.LineNumber = omit.From(val)
+	}
+	if !(m.ClassificationCode.IsValue()) {
+		val := random_string(nil, "3")
+		m.ClassificationCode = omit.From(val)
+	}
+	if !(m.Description.IsValue()) {
+		val := random_string(nil, "300")
+		m.Description = omit.From(val)
+	}
+	if !(m.Quantity.IsValue()) {
+		val := random_decimal_Decimal(nil, "18", "6")
+		m.Quantity = omit.From(val)
+	}
+	if !(m.UnitPrice.IsValue()) {
+		val := random_decimal_Decimal(nil, "18", "6")
+		m.UnitPrice = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.InvoiceLine
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *InvoiceLineTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.InvoiceLine) error {
+	var err error
+
+	isInvoiceAllowanceChargesDone, _ := invoiceLineRelInvoiceAllowanceChargesCtx.Value(ctx)
+	if !isInvoiceAllowanceChargesDone && o.r.InvoiceAllowanceCharges != nil {
+		ctx = invoiceLineRelInvoiceAllowanceChargesCtx.WithValue(ctx, true)
+		for _, r := range o.r.InvoiceAllowanceCharges {
+			if r.o.alreadyPersisted {
+				m.R.InvoiceAllowanceCharges = append(m.R.InvoiceAllowanceCharges, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachInvoiceAllowanceCharges(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isInvoiceLineTaxBreakdownsDone, _ := invoiceLineRelInvoiceLineTaxBreakdownsCtx.Value(ctx)
+	if !isInvoiceLineTaxBreakdownsDone && o.r.InvoiceLineTaxBreakdowns != nil {
+		ctx = invoiceLineRelInvoiceLineTaxBreakdownsCtx.WithValue(ctx, true)
+		for _, r := range o.r.InvoiceLineTaxBreakdowns {
+			if r.o.alreadyPersisted {
+				m.R.InvoiceLineTaxBreakdowns = append(m.R.InvoiceLineTaxBreakdowns, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachInvoiceLineTaxBreakdowns(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a invoiceLine and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *InvoiceLineTemplate) Create(ctx context.Context, exec bob.Executor) (*models.InvoiceLine, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableInvoiceLine(opt)
+
+	if o.r.Invoice == nil {
+		InvoiceLineMods.WithNewInvoice().Apply(ctx, o)
+	}
+
+	var rel2 *models.Invoice
+
+	if o.r.Invoice.o.alreadyPersisted {
+		rel2 = o.r.Invoice.o.Build()
+	} else {
+		rel2, err = o.r.Invoice.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.InvoiceID = omit.From(rel2.ID)
+
+	m, err := models.InvoiceLines.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Invoice = rel2
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a invoiceLine and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *InvoiceLineTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.InvoiceLine {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a invoiceLine and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *InvoiceLineTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.InvoiceLine {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple invoiceLines and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o InvoiceLineTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.InvoiceLineSlice, error) {
+	var err error
+	m := make(models.InvoiceLineSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple invoiceLines and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o InvoiceLineTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.InvoiceLineSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple invoiceLines and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o InvoiceLineTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.InvoiceLineSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// InvoiceLine has methods that act as mods for the InvoiceLineTemplate
+var InvoiceLineMods invoiceLineMods
+
+type invoiceLineMods struct{}
+
+func (m invoiceLineMods) RandomizeAllColumns(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModSlice{
+		InvoiceLineMods.RandomID(f),
+		InvoiceLineMods.RandomInvoiceID(f),
+		InvoiceLineMods.RandomLineNumber(f),
+		InvoiceLineMods.RandomClassificationCode(f),
+		InvoiceLineMods.RandomDescription(f),
+		InvoiceLineMods.RandomQuantity(f),
+		InvoiceLineMods.RandomUnitCode(f),
+		InvoiceLineMods.RandomUnitPrice(f),
+		InvoiceLineMods.RandomProductTariffCode(f),
+		InvoiceLineMods.RandomCountryOfOrigin(f),
+		InvoiceLineMods.RandomSubtotal(f),
+		InvoiceLineMods.RandomTotalExcludingTax(f),
+		InvoiceLineMods.RandomTaxAmount(f),
+		InvoiceLineMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m invoiceLineMods) ID(val int64) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineMods) IDFunc(f func() int64) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineMods) UnsetID() InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceLineMods) RandomID(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineMods) InvoiceID(val int64) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.InvoiceID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineMods) InvoiceIDFunc(f func() int64) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.InvoiceID = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineMods) UnsetInvoiceID() InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.InvoiceID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceLineMods) RandomInvoiceID(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.InvoiceID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineMods) LineNumber(val int32) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.LineNumber = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineMods) LineNumberFunc(f func() int32) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.LineNumber = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineMods) UnsetLineNumber() InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.LineNumber = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceLineMods) RandomLineNumber(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.LineNumber = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineMods) ClassificationCode(val string) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.ClassificationCode = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineMods) ClassificationCodeFunc(f func() string) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.ClassificationCode = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineMods) UnsetClassificationCode() InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.ClassificationCode = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceLineMods) RandomClassificationCode(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.ClassificationCode = func() string {
+			return random_string(f, "3")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineMods) Description(val string) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.Description = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineMods) DescriptionFunc(f func() string) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.Description = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineMods) UnsetDescription() InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.Description = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceLineMods) RandomDescription(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.Description = func() string {
+			return random_string(f, "300")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineMods) Quantity(val decimal.Decimal) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.Quantity = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineMods) QuantityFunc(f func() decimal.Decimal) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.Quantity = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineMods) UnsetQuantity() InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.Quantity = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceLineMods) RandomQuantity(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.Quantity = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "6")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineMods) UnitCode(val null.Val[string]) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.UnitCode = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineMods) UnitCodeFunc(f func() null.Val[string]) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.UnitCode = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineMods) UnsetUnitCode() InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.UnitCode = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceLineMods) RandomUnitCode(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.UnitCode = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "3")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceLineMods) RandomUnitCodeNotNull(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.UnitCode = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "3")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineMods) UnitPrice(val decimal.Decimal) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.UnitPrice = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineMods) UnitPriceFunc(f func() decimal.Decimal) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.UnitPrice = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineMods) UnsetUnitPrice() InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.UnitPrice = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceLineMods) RandomUnitPrice(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.UnitPrice = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "6")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineMods) ProductTariffCode(val null.Val[string]) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.ProductTariffCode = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineMods) ProductTariffCodeFunc(f func() null.Val[string]) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.ProductTariffCode = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineMods) UnsetProductTariffCode() InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.ProductTariffCode = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceLineMods) RandomProductTariffCode(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.ProductTariffCode = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "12")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceLineMods) RandomProductTariffCodeNotNull(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.ProductTariffCode = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "12")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineMods) CountryOfOrigin(val null.Val[string]) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.CountryOfOrigin = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineMods) CountryOfOriginFunc(f func() null.Val[string]) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.CountryOfOrigin = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineMods) UnsetCountryOfOrigin() InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.CountryOfOrigin = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceLineMods) RandomCountryOfOrigin(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.CountryOfOrigin = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "3")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceLineMods) RandomCountryOfOriginNotNull(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.CountryOfOrigin = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "3")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineMods) Subtotal(val decimal.Decimal) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.Subtotal = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineMods) SubtotalFunc(f func() decimal.Decimal) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.Subtotal = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineMods) UnsetSubtotal() InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.Subtotal = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceLineMods) RandomSubtotal(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.Subtotal = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineMods) TotalExcludingTax(val decimal.Decimal) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.TotalExcludingTax = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineMods) TotalExcludingTaxFunc(f func() decimal.Decimal) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.TotalExcludingTax = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineMods) UnsetTotalExcludingTax() InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.TotalExcludingTax = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceLineMods) RandomTotalExcludingTax(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.TotalExcludingTax = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineMods) TaxAmount(val decimal.Decimal) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.TaxAmount = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineMods) TaxAmountFunc(f func() decimal.Decimal) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.TaxAmount = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineMods) UnsetTaxAmount() InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.TaxAmount = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceLineMods) RandomTaxAmount(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.TaxAmount = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceLineMods) CreatedAt(val null.Val[time.Time]) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceLineMods) CreatedAtFunc(f func() null.Val[time.Time]) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceLineMods) UnsetCreatedAt() InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceLineMods) RandomCreatedAt(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceLineMods) RandomCreatedAtNotNull(f *faker.Faker) InvoiceLineMod {
+	return InvoiceLineModFunc(func(_ context.Context, o *InvoiceLineTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m invoiceLineMods) WithParentsCascading() InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		if isDone, _ := invoiceLineWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = invoiceLineWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewInvoiceWithContext(ctx, InvoiceMods.WithParentsCascading())
+			m.WithInvoice(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m invoiceLineMods) WithInvoice(rel *InvoiceTemplate) InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		o.r.Invoice = &invoiceLineRInvoiceR{
+			o: rel,
+		}
+	})
+}
+
+func (m invoiceLineMods) WithNewInvoice(mods ...InvoiceMod) InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		related := o.f.NewInvoiceWithContext(ctx, mods...)
+
+		m.WithInvoice(related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceLineMods) WithExistingInvoice(em *models.Invoice) InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		o.r.Invoice = &invoiceLineRInvoiceR{
+			o: o.f.FromExistingInvoice(em),
+		}
+	})
+}
+
+func (m invoiceLineMods) WithoutInvoice() InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		o.r.Invoice = nil
+	})
+}
+
+func (m invoiceLineMods) WithInvoiceAllowanceCharges(number int, related *InvoiceAllowanceChargeTemplate) InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		o.r.InvoiceAllowanceCharges = []*invoiceLineRInvoiceAllowanceChargesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m invoiceLineMods) WithNewInvoiceAllowanceCharges(number int, mods ...InvoiceAllowanceChargeMod) InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		related := o.f.NewInvoiceAllowanceChargeWithContext(ctx, mods...)
+		m.WithInvoiceAllowanceCharges(number, related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceLineMods) AddInvoiceAllowanceCharges(number int, related *InvoiceAllowanceChargeTemplate) InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		o.r.InvoiceAllowanceCharges = append(o.r.InvoiceAllowanceCharges, &invoiceLineRInvoiceAllowanceChargesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m invoiceLineMods) AddNewInvoiceAllowanceCharges(number int, mods ...InvoiceAllowanceChargeMod) InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		related := o.f.NewInvoiceAllowanceChargeWithContext(ctx, mods...)
+		m.AddInvoiceAllowanceCharges(number, related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceLineMods) AddExistingInvoiceAllowanceCharges(existingModels ...*models.InvoiceAllowanceCharge) InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		for _, em := range existingModels {
+			o.r.InvoiceAllowanceCharges = append(o.r.InvoiceAllowanceCharges, &invoiceLineRInvoiceAllowanceChargesR{
+				o: o.f.FromExistingInvoiceAllowanceCharge(em),
+			})
+		}
+	})
+}
+
+func (m invoiceLineMods) WithoutInvoiceAllowanceCharges() InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		o.r.InvoiceAllowanceCharges = nil
+	})
+}
+
+func (m invoiceLineMods) WithInvoiceLineTaxBreakdowns(number int, related *InvoiceLineTaxBreakdownTemplate) InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		o.r.InvoiceLineTaxBreakdowns = []*invoiceLineRInvoiceLineTaxBreakdownsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m invoiceLineMods) WithNewInvoiceLineTaxBreakdowns(number int, mods ...InvoiceLineTaxBreakdownMod) InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		related := o.f.NewInvoiceLineTaxBreakdownWithContext(ctx, mods...)
+		m.WithInvoiceLineTaxBreakdowns(number, related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceLineMods) AddInvoiceLineTaxBreakdowns(number int, related *InvoiceLineTaxBreakdownTemplate) InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		o.r.InvoiceLineTaxBreakdowns = append(o.r.InvoiceLineTaxBreakdowns, &invoiceLineRInvoiceLineTaxBreakdownsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m invoiceLineMods) AddNewInvoiceLineTaxBreakdowns(number int, mods ...InvoiceLineTaxBreakdownMod) InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		related := o.f.NewInvoiceLineTaxBreakdownWithContext(ctx, mods...)
+		m.AddInvoiceLineTaxBreakdowns(number, related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceLineMods) AddExistingInvoiceLineTaxBreakdowns(existingModels ...*models.InvoiceLineTaxBreakdown) InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		for _, em := range existingModels {
+			o.r.InvoiceLineTaxBreakdowns = append(o.r.InvoiceLineTaxBreakdowns, &invoiceLineRInvoiceLineTaxBreakdownsR{
+				o: o.f.FromExistingInvoiceLineTaxBreakdown(em),
+			})
+		}
+	})
+}
+
+func (m invoiceLineMods) WithoutInvoiceLineTaxBreakdowns() InvoiceLineMod {
+	return InvoiceLineModFunc(func(ctx context.Context, o *InvoiceLineTemplate) {
+		o.r.InvoiceLineTaxBreakdowns = nil
+	})
+}
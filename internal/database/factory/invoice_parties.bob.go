@@ -0,0 +1,1359 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	enums "github.com/jacoobjake/einvoice-api/internal/database/enums"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type InvoicePartyMod interface {
+	Apply(context.Context, *InvoicePartyTemplate)
+}
+
+type InvoicePartyModFunc func(context.Context, *InvoicePartyTemplate)
+
+func (f InvoicePartyModFunc) Apply(ctx context.Context, n *InvoicePartyTemplate) {
+	f(ctx, n)
+}
+
+type InvoicePartyModSlice []InvoicePartyMod
+
+func (mods InvoicePartyModSlice) Apply(ctx context.Context, n *InvoicePartyTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// InvoicePartyTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type InvoicePartyTemplate struct {
+	ID                           func() int64
+	InvoiceID                    func() int64
+	Role                         func() enums.InvoicePartyRoles
+	Name                         func() string
+	Tin                          func() string
+	IDType                       func() enums.TaxpayerIDTypes
+	IDValue                      func() string
+	SSTRegistrationNumber        func() string
+	TourismTaxRegistrationNumber func() null.Val[string]
+	MsicCode                     func() null.Val[string]
+	BusinessActivityDescription  func() null.Val[string]
+	AddressLine1                 func() string
+	AddressLine2                 func() null.Val[string]
+	AddressLine3                 func() null.Val[string]
+	PostalZone                   func() null.Val[string]
+	CityName                     func() string
+	StateCode                    func() string
+	CountryCode                  func() string
+	Phone                        func() null.Val[string]
+	Email                        func() null.Val[string]
+	CreatedAt                    func() null.Val[time.Time]
+
+	r invoicePartyR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type invoicePartyR struct {
+	Invoice *invoicePartyRInvoiceR
+}
+
+type invoicePartyRInvoiceR struct {
+	o *InvoiceTemplate
+}
+
+// Apply mods to the InvoicePartyTemplate
+func (o *InvoicePartyTemplate) Apply(ctx context.Context, mods ...InvoicePartyMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.InvoiceParty
+// according to the relationships in the template. Nothing is inserted into the db
+func (t InvoicePartyTemplate) setModelRels(o *models.InvoiceParty) {
+	if t.r.Invoice != nil {
+		rel := t.r.Invoice.o.Build()
+		rel.R.InvoiceParties = append(rel.R.InvoiceParties, o)
+		o.InvoiceID = rel.ID // h2
+		o.R.Invoice = rel
+	}
+}
+
+// BuildSetter returns an *models.InvoicePartySetter
+// this does nothing with the relationship templates
+func (o InvoicePartyTemplate) BuildSetter() *models.InvoicePartySetter {
+	m := &models.InvoicePartySetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.InvoiceID != nil {
+		val := o.InvoiceID()
+		m.InvoiceID = omit.From(val)
+	}
+	if o.Role != nil {
+		val := o.Role()
+		m.Role = omit.From(val)
+	}
+	if o.Name != nil {
+		val := o.Name()
+		m.Name = omit.From(val)
+	}
+	if o.Tin != nil {
+		val := o.Tin()
+		m.Tin = omit.From(val)
+	}
+	if o.IDType != nil {
+		val := o.IDType()
+		m.IDType = omit.From(val)
+	}
+	if o.IDValue != nil {
+		val := o.IDValue()
+		m.IDValue = omit.From(val)
+	}
+	if o.SSTRegistrationNumber != nil {
+		val := o.SSTRegistrationNumber()
+		m.SSTRegistrationNumber = omit.From(val)
+	}
+	if o.TourismTaxRegistrationNumber != nil {
+		val := o.TourismTaxRegistrationNumber()
+		m.TourismTaxRegistrationNumber = omitnull.FromNull(val)
+	}
+	if o.MsicCode != nil {
+		val := o.MsicCode()
+		m.MsicCode = omitnull.FromNull(val)
+	}
+	if o.BusinessActivityDescription != nil {
+		val := o.BusinessActivityDescription()
+		m.BusinessActivityDescription = omitnull.FromNull(val)
+	}
+	if o.AddressLine1 != nil {
+		val := o.AddressLine1()
+		m.AddressLine1 = omit.From(val)
+	}
+	if o.AddressLine2 != nil {
+		val := o.AddressLine2()
+		m.AddressLine2 = omitnull.FromNull(val)
+	}
+	if o.AddressLine3 != nil {
+		val := o.AddressLine3()
+		m.AddressLine3 = omitnull.FromNull(val)
+	}
+	if o.PostalZone != nil {
+		val := o.PostalZone()
+		m.PostalZone = omitnull.FromNull(val)
+	}
+	if o.CityName != nil {
+		val := o.CityName()
+		m.CityName = omit.From(val)
+	}
+	if o.StateCode != nil {
+		val := o.StateCode()
+		m.StateCode = omit.From(val)
+	}
+	if o.CountryCode != nil {
+		val := o.CountryCode()
+		m.CountryCode = omit.From(val)
+	}
+	if o.Phone != nil {
+		val := o.Phone()
+		m.Phone = omitnull.FromNull(val)
+	}
+	if o.Email != nil {
+		val := o.Email()
+		m.Email = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.InvoicePartySetter
+// this does nothing with the relationship templates
+func (o InvoicePartyTemplate) BuildManySetter(number int) []*models.InvoicePartySetter {
+	m := make([]*models.InvoicePartySetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.InvoiceParty
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use InvoicePartyTemplate.Create
+func (o InvoicePartyTemplate) Build() *models.InvoiceParty {
+	m := &models.InvoiceParty{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.InvoiceID != nil {
+		m.InvoiceID = o.InvoiceID()
+	}
+	if o.Role != nil {
+		m.Role = o.Role()
+	}
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.Tin != nil {
+		m.Tin = o.Tin()
+	}
+	if o.IDType != nil {
+		m.IDType = o.IDType()
+	}
+	if o.IDValue != nil {
+		m.IDValue = o.IDValue()
+	}
+	if o.SSTRegistrationNumber != nil {
+		m.SSTRegistrationNumber = o.SSTRegistrationNumber()
+	}
+	if o.TourismTaxRegistrationNumber != nil {
+		m.TourismTaxRegistrationNumber = o.TourismTaxRegistrationNumber()
+	}
+	if o.MsicCode != nil {
+		m.MsicCode = o.MsicCode()
+	}
+	if o.BusinessActivityDescription != nil {
+		m.BusinessActivityDescription = o.BusinessActivityDescription()
+	}
+	if o.AddressLine1 != nil {
+		m.AddressLine1 = o.AddressLine1()
+	}
+	if o.AddressLine2 != nil {
+		m.AddressLine2 = o.AddressLine2()
+	}
+	if o.AddressLine3 != nil {
+		m.AddressLine3 = o.AddressLine3()
+	}
+	if o.PostalZone != nil {
+		m.PostalZone = o.PostalZone()
+	}
+	if o.CityName != nil {
+		m.CityName = o.CityName()
+	}
+	if o.StateCode != nil {
+		m.StateCode = o.StateCode()
+	}
+	if o.CountryCode != nil {
+		m.CountryCode = o.CountryCode()
+	}
+	if o.Phone != nil {
+		m.Phone = o.Phone()
+	}
+	if o.Email != nil {
+		m.Email = o.Email()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.InvoicePartySlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use InvoicePartyTemplate.CreateMany
+func (o InvoicePartyTemplate) BuildMany(number int) models.InvoicePartySlice {
+	m := make(models.InvoicePartySlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableInvoiceParty(m *models.InvoicePartySetter) {
+	if !(m.InvoiceID.IsValue()) {
+		val := random_int64(nil)
+		m.InvoiceID = omit.From(val)
+	}
+	if !(m.Role.IsValue()) {
+		val := random_enums_InvoicePartyRoles(nil)
+		m.Role = omit.From(val)
+	}
+	if !(m.Name.IsValue()) {
+		val := random_string(nil, "300")
+		m.Name = omit.From(val)
+	}
+	if !(m.Tin.IsValue()) {
+		val := random_string(nil, "14")
+		m.Tin = omit.From(val)
+	}
+	if !(m.IDType.IsValue()) {
+		val := random_enums_TaxpayerIDTypes(nil)
+		m.IDType = omit.From(val)
+	}
+	if !(m.IDValue.IsValue()) {
+		val := random_string(nil, "20")
+		m.IDValue = omit.From(val)
+	}
+	if !(m.AddressLine1.IsValue()) {
+		val := random_string(nil, "150")
+		m.AddressLine1 = omit.From(val)
+	}
+	if !(m.CityName.IsValue()) {
+		val := random_string(nil, "50")
+		m.CityName = omit.From(val)
+	}
+	if !(m.StateCode.IsValue()) {
+		val := random_string(nil, "2")
+		m.StateCode = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.InvoiceParty
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *InvoicePartyTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.InvoiceParty) error {
+	var err error
+
+	return err
+}
+
+// Create builds a invoiceParty and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *InvoicePartyTemplate) Create(ctx context.Context, exec bob.Executor) (*models.InvoiceParty, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableInvoiceParty(opt)
+
+	if o.r.Invoice == nil {
+		InvoicePartyMods.WithNewInvoice().Apply(ctx, o)
+	}
+
+	var rel0 *models.Invoice
+
+	if o.r.Invoice.o.alreadyPersisted {
+		rel0 = o.r.Invoice.o.Build()
+	} else {
+		rel0, err = o.r.Invoice.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.InvoiceID = omit.From(rel0.ID)
+
+	m, err := models.InvoiceParties.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Invoice = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a invoiceParty and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *InvoicePartyTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.InvoiceParty {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a invoiceParty and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *InvoicePartyTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.InvoiceParty {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple invoiceParties and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o InvoicePartyTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.InvoicePartySlice, error) {
+	var err error
+	m := make(models.InvoicePartySlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple invoiceParties and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o InvoicePartyTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.InvoicePartySlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple invoiceParties and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o InvoicePartyTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.InvoicePartySlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// InvoiceParty has methods that act as mods for the InvoicePartyTemplate
+var InvoicePartyMods invoicePartyMods
+
+type invoicePartyMods struct{}
+
+func (m invoicePartyMods) RandomizeAllColumns(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModSlice{
+		InvoicePartyMods.RandomID(f),
+		InvoicePartyMods.RandomInvoiceID(f),
+		InvoicePartyMods.RandomRole(f),
+		InvoicePartyMods.RandomName(f),
+		InvoicePartyMods.RandomTin(f),
+		InvoicePartyMods.RandomIDType(f),
+		InvoicePartyMods.RandomIDValue(f),
+		InvoicePartyMods.RandomSSTRegistrationNumber(f),
+		InvoicePartyMods.RandomTourismTaxRegistrationNumber(f),
+		InvoicePartyMods.RandomMsicCode(f),
+		InvoicePartyMods.RandomBusinessActivityDescription(f),
+		InvoicePartyMods.RandomAddressLine1(f),
+		InvoicePartyMods.RandomAddressLine2(f),
+		InvoicePartyMods.RandomAddressLine3(f),
+		InvoicePartyMods.RandomPostalZone(f),
+		InvoicePartyMods.RandomCityName(f),
+		InvoicePartyMods.RandomStateCode(f),
+		InvoicePartyMods.RandomCountryCode(f),
+		InvoicePartyMods.RandomPhone(f),
+		InvoicePartyMods.RandomEmail(f),
+		InvoicePartyMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) ID(val int64) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) IDFunc(f func() int64) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetID() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoicePartyMods) RandomID(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) InvoiceID(val int64) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.InvoiceID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) InvoiceIDFunc(f func() int64) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.InvoiceID = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetInvoiceID() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.InvoiceID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoicePartyMods) RandomInvoiceID(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.InvoiceID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) Role(val enums.InvoicePartyRoles) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Role = func() enums.InvoicePartyRoles { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) RoleFunc(f func() enums.InvoicePartyRoles) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Role = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetRole() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Role = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoicePartyMods) RandomRole(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Role = func() enums.InvoicePartyRoles {
+			return random_enums_InvoicePartyRoles(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) Name(val string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Name = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) NameFunc(f func() string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetName() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoicePartyMods) RandomName(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Name = func() string {
+			return random_string(f, "300")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) Tin(val string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Tin = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) TinFunc(f func() string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Tin = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetTin() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Tin = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoicePartyMods) RandomTin(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Tin = func() string {
+			return random_string(f, "14")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) IDType(val enums.TaxpayerIDTypes) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.IDType = func() enums.TaxpayerIDTypes { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) IDTypeFunc(f func() enums.TaxpayerIDTypes) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.IDType = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetIDType() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.IDType = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoicePartyMods) RandomIDType(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.IDType = func() enums.TaxpayerIDTypes {
+			return random_enums_TaxpayerIDTypes(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) IDValue(val string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.IDValue = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) IDValueFunc(f func() string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.IDValue = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetIDValue() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.IDValue = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoicePartyMods) RandomIDValue(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.IDValue = func() string {
+			return random_string(f, "20")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) SSTRegistrationNumber(val string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.SSTRegistrationNumber = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) SSTRegistrationNumberFunc(f func() string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.SSTRegistrationNumber = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetSSTRegistrationNumber() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.SSTRegistrationNumber = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoicePartyMods) RandomSSTRegistrationNumber(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.SSTRegistrationNumber = func() string {
+			return random_string(f, "35")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) TourismTaxRegistrationNumber(val null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.TourismTaxRegistrationNumber = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) TourismTaxRegistrationNumberFunc(f func() null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.TourismTaxRegistrationNumber = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetTourismTaxRegistrationNumber() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.TourismTaxRegistrationNumber = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoicePartyMods) RandomTourismTaxRegistrationNumber(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.TourismTaxRegistrationNumber = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "17")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoicePartyMods) RandomTourismTaxRegistrationNumberNotNull(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.TourismTaxRegistrationNumber = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "17")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) MsicCode(val null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.MsicCode = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) MsicCodeFunc(f func() null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.MsicCode = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetMsicCode() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.MsicCode = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoicePartyMods) RandomMsicCode(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.MsicCode = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "5")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoicePartyMods) RandomMsicCodeNotNull(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.MsicCode = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "5")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) BusinessActivityDescription(val null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.BusinessActivityDescription = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) BusinessActivityDescriptionFunc(f func() null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.BusinessActivityDescription = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetBusinessActivityDescription() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.BusinessActivityDescription = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoicePartyMods) RandomBusinessActivityDescription(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.BusinessActivityDescription = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "300")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoicePartyMods) RandomBusinessActivityDescriptionNotNull(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.BusinessActivityDescription = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "300")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) AddressLine1(val string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.AddressLine1 = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) AddressLine1Func(f func() string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.AddressLine1 = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetAddressLine1() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.AddressLine1 = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoicePartyMods) RandomAddressLine1(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.AddressLine1 = func() string {
+			return random_string(f, "150")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) AddressLine2(val null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.AddressLine2 = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) AddressLine2Func(f func() null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.AddressLine2 = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetAddressLine2() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.AddressLine2 = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoicePartyMods) RandomAddressLine2(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.AddressLine2 = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "150")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoicePartyMods) RandomAddressLine2NotNull(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.AddressLine2 = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "150")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) AddressLine3(val null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.AddressLine3 = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) AddressLine3Func(f func() null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.AddressLine3 = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetAddressLine3() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.AddressLine3 = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoicePartyMods) RandomAddressLine3(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.AddressLine3 = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "150")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoicePartyMods) RandomAddressLine3NotNull(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.AddressLine3 = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "150")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) PostalZone(val null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.PostalZone = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) PostalZoneFunc(f func() null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.PostalZone = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetPostalZone() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.PostalZone = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoicePartyMods) RandomPostalZone(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.PostalZone = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "50")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoicePartyMods) RandomPostalZoneNotNull(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.PostalZone = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "50")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) CityName(val string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.CityName = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) CityNameFunc(f func() string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.CityName = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetCityName() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.CityName = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoicePartyMods) RandomCityName(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.CityName = func() string {
+			return random_string(f, "50")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) StateCode(val string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.StateCode = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) StateCodeFunc(f func() string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.StateCode = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetStateCode() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.StateCode = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoicePartyMods) RandomStateCode(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.StateCode = func() string {
+			return random_string(f, "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) CountryCode(val string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.CountryCode = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) CountryCodeFunc(f func() string) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.CountryCode = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetCountryCode() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.CountryCode = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoicePartyMods) RandomCountryCode(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.CountryCode = func() string {
+			return random_string(f, "3")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) Phone(val null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Phone = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) PhoneFunc(f func() null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Phone = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetPhone() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Phone = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoicePartyMods) RandomPhone(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Phone = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoicePartyMods) RandomPhoneNotNull(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Phone = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) Email(val null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Email = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) EmailFunc(f func() null.Val[string]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Email = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetEmail() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Email = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoicePartyMods) RandomEmail(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Email = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "320")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoicePartyMods) RandomEmailNotNull(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.Email = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "320")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoicePartyMods) CreatedAt(val null.Val[time.Time]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoicePartyMods) CreatedAtFunc(f func() null.Val[time.Time]) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m invoicePartyMods) UnsetCreatedAt() InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoicePartyMods) RandomCreatedAt(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoicePartyMods) RandomCreatedAtNotNull(f *faker.Faker) InvoicePartyMod {
+	return InvoicePartyModFunc(func(_ context.Context, o *InvoicePartyTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m invoicePartyMods) WithParentsCascading() InvoicePartyMod {
+	return InvoicePartyModFunc(func(ctx context.Context, o *InvoicePartyTemplate) {
+		if isDone, _ := invoicePartyWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = invoicePartyWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewInvoiceWithContext(ctx, InvoiceMods.WithParentsCascading())
+			m.WithInvoice(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m invoicePartyMods) WithInvoice(rel *InvoiceTemplate) InvoicePartyMod {
+	return InvoicePartyModFunc(func(ctx context.Context, o *InvoicePartyTemplate) {
+		o.r.Invoice = &invoicePartyRInvoiceR{
+			o: rel,
+		}
+	})
+}
+
+func (m invoicePartyMods) WithNewInvoice(mods ...InvoiceMod) InvoicePartyMod {
+	return InvoicePartyModFunc(func(ctx context.Context, o *InvoicePartyTemplate) {
+		related := o.f.NewInvoiceWithContext(ctx, mods...)
+
+		m.WithInvoice(related).Apply(ctx, o)
+	})
+}
+
+func (m invoicePartyMods) WithExistingInvoice(em *models.Invoice) InvoicePartyMod {
+	return InvoicePartyModFunc(func(ctx context.Context, o *InvoicePartyTemplate) {
+		o.r.Invoice = &invoicePartyRInvoiceR{
+			o: o.f.FromExistingInvoice(em),
+		}
+	})
+}
+
+func (m invoicePartyMods) WithoutInvoice() InvoicePartyMod {
+	return InvoicePartyModFunc(func(ctx context.Context, o *InvoicePartyTemplate) {
+		o.r.Invoice = nil
+	})
+}
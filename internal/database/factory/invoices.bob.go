@@ -0,0 +1,2240 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	enums "github.com/jacoobjake/einvoice-api/internal/database/enums"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/shopspring/decimal"
+	"github.com/stephenafamo/bob"
+)
+
+type InvoiceMod interface {
+	Apply(context.Context, *InvoiceTemplate)
+}
+
+type InvoiceModFunc func(context.Context, *InvoiceTemplate)
+
+func (f InvoiceModFunc) Apply(ctx context.Context, n *InvoiceTemplate) {
+	f(ctx, n)
+}
+
+type InvoiceModSlice []InvoiceMod
+
+func (mods InvoiceModSlice) Apply(ctx context.Context, n *InvoiceTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// InvoiceTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type InvoiceTemplate struct {
+	ID                    func() int64
+	OrganisationID        func() int64
+	InvoiceNumber         func() string
+	TypeCode              func() string
+	Status                func() enums.InvoiceStatuses
+	IssueDate             func() time.Time
+	CurrencyCode          func() string
+	ExchangeRate          func() null.Val[decimal.Decimal]
+	BillingPeriodStart    func() null.Val[time.Time]
+	BillingPeriodEnd      func() null.Val[time.Time]
+	BillingFrequency      func() null.Val[string]
+	PaymentMode           func() null.Val[string]
+	PaymentTerms          func() null.Val[string]
+	SupplierBankAccount   func() null.Val[string]
+	TotalLineAmount       func() decimal.Decimal
+	TotalAllowanceAmount  func() decimal.Decimal
+	TotalChargeAmount     func() decimal.Decimal
+	TotalExcludingTax     func() decimal.Decimal
+	TotalTaxAmount        func() decimal.Decimal
+	TotalIncludingTax     func() decimal.Decimal
+	RoundingAmount        func() decimal.Decimal
+	PayableAmount         func() decimal.Decimal
+	CreatedBy             func() null.Val[int64]
+	CreatedAt             func() null.Val[time.Time]
+	UpdatedAt             func() null.Val[time.Time]
+	UUID                  func() null.Val[string]
+	OriginalInvoiceID     func() null.Val[int64]
+	OriginalUUID          func() null.Val[string]
+	OriginalInvoiceNumber func() null.Val[string]
+
+	r invoiceR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type invoiceR struct {
+	InvoiceAllowanceCharges []*invoiceRInvoiceAllowanceChargesR
+	InvoiceLines            []*invoiceRInvoiceLinesR
+	InvoiceParties          []*invoiceRInvoicePartiesR
+	CreatedByUser           *invoiceRCreatedByUserR
+	Organisation            *invoiceROrganisationR
+	OriginalInvoice         *invoiceROriginalInvoiceR
+	ReverseOriginalInvoices []*invoiceRReverseOriginalInvoicesR
+}
+
+type invoiceRInvoiceAllowanceChargesR struct {
+	number int
+	o      *InvoiceAllowanceChargeTemplate
+}
+type invoiceRInvoiceLinesR struct {
+	number int
+	o      *InvoiceLineTemplate
+}
+type invoiceRInvoicePartiesR struct {
+	number int
+	o      *InvoicePartyTemplate
+}
+type invoiceRCreatedByUserR struct {
+	o *UserTemplate
+}
+type invoiceROrganisationR struct {
+	o *OrganisationTemplate
+}
+type invoiceROriginalInvoiceR struct {
+	o *InvoiceTemplate
+}
+type invoiceRReverseOriginalInvoicesR struct {
+	number int
+	o      *InvoiceTemplate
+}
+
+// Apply mods to the InvoiceTemplate
+func (o *InvoiceTemplate) Apply(ctx context.Context, mods ...InvoiceMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Invoice
+// according to the relationships in the template. Nothing is inserted into the db
+func (t InvoiceTemplate) setModelRels(o *models.Invoice) {
+	if t.r.InvoiceAllowanceCharges != nil {
+		rel := models.InvoiceAllowanceChargeSlice{}
+		for _, r := range t.r.InvoiceAllowanceCharges {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.InvoiceID = o.ID // h2
+				rel.R.Invoice = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.InvoiceAllowanceCharges = rel
+	}
+
+	if t.r.InvoiceLines != nil {
+		rel := models.InvoiceLineSlice{}
+		for _, r := range t.r.InvoiceLines {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.InvoiceID = o.ID // h2
+				rel.R.Invoice = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.InvoiceLines = rel
+	}
+
+	if t.r.InvoiceParties != nil {
+		rel := models.InvoicePartySlice{}
+		for _, r := range t.r.InvoiceParties {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.InvoiceID = o.ID // h2
+				rel.R.Invoice = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.InvoiceParties = rel
+	}
+
+	if t.r.CreatedByUser != nil {
+		rel := t.r.CreatedByUser.o.Build()
+		rel.R.CreatedByInvoices = append(rel.R.CreatedByInvoices, o)
+		o.CreatedBy = null.From(rel.ID) // h2
+		o.R.CreatedByUser = rel
+	}
+
+	if t.r.Organisation != nil {
+		rel := t.r.Organisation.o.Build()
+		rel.R.Invoices = append(rel.R.Invoices, o)
+		o.OrganisationID = rel.ID // h2
+		o.R.Organisation = rel
+	}
+
+	if t.r.OriginalInvoice != nil {
+		rel := t.r.OriginalInvoice.o.Build()
+		rel.R.OriginalInvoice = o
+		o.OriginalInvoiceID = null.From(rel.ID) // h2
+		o.R.OriginalInvoice = rel
+	}
+
+	if t.r.ReverseOriginalInvoices != nil {
+		rel := models.InvoiceSlice{}
+		for _, r := range t.r.ReverseOriginalInvoices {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.OriginalInvoiceID = null.From(o.ID) // h2
+				rel.R.ReverseOriginalInvoices = append(rel.R.ReverseOriginalInvoices, o)
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ReverseOriginalInvoices = rel
+	}
+}
+
+// BuildSetter returns an *models.InvoiceSetter
+// this does nothing with the relationship templates
+func (o InvoiceTemplate) BuildSetter() *models.InvoiceSetter {
+	m := &models.InvoiceSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.OrganisationID != nil {
+		val := o.OrganisationID()
+		m.OrganisationID = omit.From(val)
+	}
+	if o.InvoiceNumber != nil {
+		val := o.InvoiceNumber()
+		m.InvoiceNumber = omit.From(val)
+	}
+	if o.TypeCode != nil {
+		val := o.TypeCode()
+		m.TypeCode = omit.From(val)
+	}
+	if o.Status != nil {
+		val := o.Status()
+		m.Status = omit.From(val)
+	}
+	if o.IssueDate != nil {
+		val := o.IssueDate()
+		m.IssueDate = omit.From(val)
+	}
+	if o.CurrencyCode != nil {
+		val := o.CurrencyCode()
+		m.CurrencyCode = omit.From(val)
+	}
+	if o.ExchangeRate != nil {
+		val := o.ExchangeRate()
+		m.ExchangeRate = omitnull.FromNull(val)
+	}
+	if o.BillingPeriodStart != nil {
+		val := o.BillingPeriodStart()
+		m.BillingPeriodStart = omitnull.FromNull(val)
+	}
+	if o.BillingPeriodEnd != nil {
+		val := o.BillingPeriodEnd()
+		m.BillingPeriodEnd = omitnull.FromNull(val)
+	}
+	if o.BillingFrequency != nil {
+		val := o.BillingFrequency()
+		m.BillingFrequency = omitnull.FromNull(val)
+	}
+	if o.PaymentMode != nil {
+		val := o.PaymentMode()
+		m.PaymentMode = omitnull.FromNull(val)
+	}
+	if o.PaymentTerms != nil {
+		val := o.PaymentTerms()
+		m.PaymentTerms = omitnull.FromNull(val)
+	}
+	if o.SupplierBankAccount != nil {
+		val := o.SupplierBankAccount()
+		m.SupplierBankAccount = omitnull.FromNull(val)
+	}
+	if o.TotalLineAmount != nil {
+		val := o.TotalLineAmount()
+		m.TotalLineAmount = omit.From(val)
+	}
+	if o.TotalAllowanceAmount != nil {
+		val := o.TotalAllowanceAmount()
+		m.TotalAllowanceAmount = omit.From(val)
+	}
+	if o.TotalChargeAmount != nil {
+		val := o.TotalChargeAmount()
+		m.TotalChargeAmount = omit.From(val)
+	}
+	if o.TotalExcludingTax != nil {
+		val := o.TotalExcludingTax()
+		m.TotalExcludingTax = omit.From(val)
+	}
+	if o.TotalTaxAmount != nil {
+		val := o.TotalTaxAmount()
+		m.TotalTaxAmount = omit.From(val)
+	}
+	if o.TotalIncludingTax != nil {
+		val := o.TotalIncludingTax()
+		m.TotalIncludingTax = omit.From(val)
+	}
+	if o.RoundingAmount != nil {
+		val := o.RoundingAmount()
+		m.RoundingAmount = omit.From(val)
+	}
+	if o.PayableAmount != nil {
+		val := o.PayableAmount()
+		m.PayableAmount = omit.From(val)
+	}
+	if o.CreatedBy != nil {
+		val := o.CreatedBy()
+		m.CreatedBy = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+	if o.UpdatedAt != nil {
+		val := o.UpdatedAt()
+		m.UpdatedAt = omitnull.FromNull(val)
+	}
+	if o.UUID != nil {
+		val := o.UUID()
+		m.UUID = omitnull.FromNull(val)
+	}
+	if o.OriginalInvoiceID != nil {
+		val := o.OriginalInvoiceID()
+		m.OriginalInvoiceID = omitnull.FromNull(val)
+	}
+	if o.OriginalUUID != nil {
+		val := o.OriginalUUID()
+		m.OriginalUUID = omitnull.FromNull(val)
+	}
+	if o.OriginalInvoiceNumber != nil {
+		val := o.OriginalInvoiceNumber()
+		m.OriginalInvoiceNumber = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.InvoiceSetter
+// this does nothing with the relationship templates
+func (o InvoiceTemplate) BuildManySetter(number int) []*models.InvoiceSetter {
+	m := make([]*models.InvoiceSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Invoice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use InvoiceTemplate.Create
+func (o InvoiceTemplate) Build() *models.Invoice {
+	m := &models.Invoice{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.OrganisationID != nil {
+		m.OrganisationID = o.OrganisationID()
+	}
+	if o.InvoiceNumber != nil {
+		m.InvoiceNumber = o.InvoiceNumber()
+	}
+	if o.TypeCode != nil {
+		m.TypeCode = o.TypeCode()
+	}
+	if o.Status != nil {
+		m.Status = o.Status()
+	}
+	if o.IssueDate != nil {
+		m.IssueDate = o.IssueDate()
+	}
+	if o.CurrencyCode != nil {
+		m.CurrencyCode = o.CurrencyCode()
+	}
+	if o.ExchangeRate != nil {
+		m.ExchangeRate = o.ExchangeRate()
+	}
+	if o.BillingPeriodStart != nil {
+		m.BillingPeriodStart = o.BillingPeriodStart()
+	}
+	if o.BillingPeriodEnd != nil {
+		m.BillingPeriodEnd = o.BillingPeriodEnd()
+	}
+	if o.BillingFrequency != nil {
+		m.BillingFrequency = o.BillingFrequency()
+	}
+	if o.PaymentMode != nil {
+		m.PaymentMode = o.PaymentMode()
+	}
+	if o.PaymentTerms != nil {
+		m.PaymentTerms = o.PaymentTerms()
+	}
+	if o.SupplierBankAccount != nil {
+		m.SupplierBankAccount = o.SupplierBankAccount()
+	}
+	if o.TotalLineAmount != nil {
+		m.TotalLineAmount = o.TotalLineAmount()
+	}
+	if o.TotalAllowanceAmount != nil {
+		m.TotalAllowanceAmount = o.TotalAllowanceAmount()
+	}
+	if o.TotalChargeAmount != nil {
+		m.TotalChargeAmount = o.TotalChargeAmount()
+	}
+	if o.TotalExcludingTax != nil {
+		m.TotalExcludingTax = o.TotalExcludingTax()
+	}
+	if o.TotalTaxAmount != nil {
+		m.TotalTaxAmount = o.TotalTaxAmount()
+	}
+	if o.TotalIncludingTax != nil {
+		m.TotalIncludingTax = o.TotalIncludingTax()
+	}
+	if o.RoundingAmount != nil {
+		m.RoundingAmount = o.RoundingAmount()
+	}
+	if o.PayableAmount != nil {
+		m.PayableAmount = o.PayableAmount()
+	}
+	if o.CreatedBy != nil {
+		m.CreatedBy = o.CreatedBy()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.UpdatedAt != nil {
+		m.UpdatedAt = o.UpdatedAt()
+	}
+	if o.UUID != nil {
+		m.UUID = o.UUID()
+	}
+	if o.OriginalInvoiceID != nil {
+		m.OriginalInvoiceID = o.OriginalInvoiceID()
+	}
+	if o.OriginalUUID != nil {
+		m.OriginalUUID = o.OriginalUUID()
+	}
+	if o.OriginalInvoiceNumber != nil {
+		m.OriginalInvoiceNumber = o.OriginalInvoiceNumber()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.InvoiceSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use InvoiceTemplate.CreateMany
+func (o InvoiceTemplate) BuildMany(number int) models.InvoiceSlice {
+	m := make(models.InvoiceSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableInvoice(m *models.InvoiceSetter) {
+	if !(m.OrganisationID.IsValue()) {
+		val := random_int64(nil)
+		m.OrganisationID = omit.From(val)
+	}
+	if !(m.InvoiceNumber.IsValue()) {
+		val := random_string(nil, "50")
+		m.InvoiceNumber = omit.From(val)
+	}
+	if !(m.IssueDate.IsValue()) {
+		val := random_time_Time(nil)
+		m.IssueDate = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Invoice
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *InvoiceTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Invoice) error {
+	var err error
+
+	isInvoiceAllowanceChargesDone, _ := invoiceRelInvoiceAllowanceChargesCtx.Value(ctx)
+	if !isInvoiceAllowanceChargesDone && o.r.InvoiceAllowanceCharges != nil {
+		ctx = invoiceRelInvoiceAllowanceChargesCtx.WithValue(ctx, true)
+		for _, r := range o.r.InvoiceAllowanceCharges {
+			if r.o.alreadyPersisted {
+				m.R.InvoiceAllowanceCharges = append(m.R.InvoiceAllowanceCharges, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachInvoiceAllowanceCharges(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isInvoiceLinesDone, _ := invoiceRelInvoiceLinesCtx.Value(ctx)
+	if !isInvoiceLinesDone && o.r.InvoiceLines != nil {
+		ctx = invoiceRelInvoiceLinesCtx.WithValue(ctx, true)
+		for _, r := range o.r.InvoiceLines {
+			if r.o.alreadyPersisted {
+				m.R.InvoiceLines = append(m.R.InvoiceLines, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachInvoiceLines(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isInvoicePartiesDone, _ := invoiceRelInvoicePartiesCtx.Value(ctx)
+	if !isInvoicePartiesDone && o.r.InvoiceParties != nil {
+		ctx = invoiceRelInvoicePartiesCtx.WithValue(ctx, true)
+		for _, r := range o.r.InvoiceParties {
+			if r.o.alreadyPersisted {
+				m.R.InvoiceParties = append(m.R.InvoiceParties, r.o.Build())
+			} else {
+				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachInvoiceParties(ctx, exec, rel2...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isCreatedByUserDone, _ := invoiceRelCreatedByUserCtx.Value(ctx)
+	if !isCreatedByUserDone && o.r.CreatedByUser != nil {
+		ctx = invoiceRelCreatedByUserCtx.WithValue(ctx, true)
+		if o.r.CreatedByUser.o.alreadyPersisted {
+			m.R.CreatedByUser = o.r.CreatedByUser.o.Build()
+		} else {
+			var rel3 *models.User
+			rel3, err = o.r.CreatedByUser.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachCreatedByUser(ctx, exec, rel3)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	isOriginalInvoiceDone, _ := invoiceRelOriginalInvoiceCtx.Value(ctx)
+	if !isOriginalInvoiceDone && o.r.OriginalInvoice != nil {
+		ctx = invoiceRelOriginalInvoiceCtx.WithValue(ctx, true)
+		if o.r.OriginalInvoice.o.alreadyPersisted {
+			m.R.OriginalInvoice = o.r.OriginalInvoice.o.Build()
+		} else {
+			var rel5 *models.Invoice
+			rel5, err = o.r.OriginalInvoice.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachOriginalInvoice(ctx, exec, rel5)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	isReverseOriginalInvoicesDone, _ := invoiceRelReverseOriginalInvoicesCtx.Value(ctx)
+	if !isReverseOriginalInvoicesDone && o.r.ReverseOriginalInvoices != nil {
+		ctx = invoiceRelReverseOriginalInvoicesCtx.WithValue(ctx, true)
+		for _, r := range o.r.ReverseOriginalInvoices {
+			if r.o.alreadyPersisted {
+				m.R.ReverseOriginalInvoices = append(m.R.ReverseOriginalInvoices, r.o.Build())
+			} else {
+				rel6, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachReverseOriginalInvoices(ctx, exec, rel6...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a invoice and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *InvoiceTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Invoice, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableInvoice(opt)
+
+	if o.r.Organisation == nil {
+		InvoiceMods.WithNewOrganisation().Apply(ctx, o)
+	}
+
+	var rel4 *models.Organisation
+
+	if o.r.Organisation.o.alreadyPersisted {
+		rel4 = o.r.Organisation.o.Build()
+	} else {
+		rel4, err = o.r.Organisation.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.OrganisationID = omit.From(rel4.ID)
+
+	m, err := models.Invoices.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Organisation = rel4
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a invoice and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *InvoiceTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Invoice {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a invoice and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *InvoiceTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Invoice {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple invoices and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o InvoiceTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.InvoiceSlice, error) {
+	var err error
+	m := make(models.InvoiceSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple invoices and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o InvoiceTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.InvoiceSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple invoices and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o InvoiceTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.InvoiceSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Invoice has methods that act as mods for the InvoiceTemplate
+var InvoiceMods invoiceMods
+
+type invoiceMods struct{}
+
+func (m invoiceMods) RandomizeAllColumns(f *faker.Faker) InvoiceMod {
+	return InvoiceModSlice{
+		InvoiceMods.RandomID(f),
+		InvoiceMods.RandomOrganisationID(f),
+		InvoiceMods.RandomInvoiceNumber(f),
+		InvoiceMods.RandomTypeCode(f),
+		InvoiceMods.RandomStatus(f),
+		InvoiceMods.RandomIssueDate(f),
+		InvoiceMods.RandomCurrencyCode(f),
+		InvoiceMods.RandomExchangeRate(f),
+		InvoiceMods.RandomBillingPeriodStart(f),
+		InvoiceMods.RandomBillingPeriodEnd(f),
+		InvoiceMods.RandomBillingFrequency(f),
+		InvoiceMods.RandomPaymentMode(f),
+		InvoiceMods.RandomPaymentTerms(f),
+		InvoiceMods.RandomSupplierBankAccount(f),
+		InvoiceMods.RandomTotalLineAmount(f),
+		InvoiceMods.RandomTotalAllowanceAmount(f),
+		InvoiceMods.RandomTotalChargeAmount(f),
+		InvoiceMods.RandomTotalExcludingTax(f),
+		InvoiceMods.RandomTotalTaxAmount(f),
+		InvoiceMods.RandomTotalIncludingTax(f),
+		InvoiceMods.RandomRoundingAmount(f),
+		InvoiceMods.RandomPayableAmount(f),
+		InvoiceMods.RandomCreatedBy(f),
+		InvoiceMods.RandomCreatedAt(f),
+		InvoiceMods.RandomUpdatedAt(f),
+		InvoiceMods.RandomUUID(f),
+		InvoiceMods.RandomOriginalInvoiceID(f),
+		InvoiceMods.RandomOriginalUUID(f),
+		InvoiceMods.RandomOriginalInvoiceNumber(f),
+	}
+}
+
+// Set the model columns to this value
+func (m invoiceMods) ID(val int64) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) IDFunc(f func() int64) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetID() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceMods) RandomID(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) OrganisationID(val int64) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OrganisationID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) OrganisationIDFunc(f func() int64) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OrganisationID = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetOrganisationID() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OrganisationID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceMods) RandomOrganisationID(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OrganisationID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) InvoiceNumber(val string) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.InvoiceNumber = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) InvoiceNumberFunc(f func() string) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.InvoiceNumber = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetInvoiceNumber() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.InvoiceNumber = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceMods) RandomInvoiceNumber(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.InvoiceNumber = func() string {
+			return random_string(f, "50")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) TypeCode(val string) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TypeCode = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) TypeCodeFunc(f func() string) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TypeCode = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetTypeCode() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TypeCode = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceMods) RandomTypeCode(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TypeCode = func() string {
+			return random_string(f, "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) Status(val enums.InvoiceStatuses) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.Status = func() enums.InvoiceStatuses { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) StatusFunc(f func() enums.InvoiceStatuses) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.Status = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetStatus() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.Status = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceMods) RandomStatus(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.Status = func() enums.InvoiceStatuses {
+			return random_enums_InvoiceStatuses(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) IssueDate(val time.Time) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.IssueDate = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) IssueDateFunc(f func() time.Time) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.IssueDate = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetIssueDate() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.IssueDate = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceMods) RandomIssueDate(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.IssueDate = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) CurrencyCode(val string) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.CurrencyCode = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) CurrencyCodeFunc(f func() string) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.CurrencyCode = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetCurrencyCode() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.CurrencyCode = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceMods) RandomCurrencyCode(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.CurrencyCode = func() string {
+			return random_string(f, "3")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) ExchangeRate(val null.Val[decimal.Decimal]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.ExchangeRate = func() null.Val[decimal.Decimal] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) ExchangeRateFunc(f func() null.Val[decimal.Decimal]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.ExchangeRate = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetExchangeRate() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.ExchangeRate = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceMods) RandomExchangeRate(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.ExchangeRate = func() null.Val[decimal.Decimal] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_decimal_Decimal(f, "15", "6")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceMods) RandomExchangeRateNotNull(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.ExchangeRate = func() null.Val[decimal.Decimal] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_decimal_Decimal(f, "15", "6")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) BillingPeriodStart(val null.Val[time.Time]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.BillingPeriodStart = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) BillingPeriodStartFunc(f func() null.Val[time.Time]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.BillingPeriodStart = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetBillingPeriodStart() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.BillingPeriodStart = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceMods) RandomBillingPeriodStart(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.BillingPeriodStart = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceMods) RandomBillingPeriodStartNotNull(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.BillingPeriodStart = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) BillingPeriodEnd(val null.Val[time.Time]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.BillingPeriodEnd = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) BillingPeriodEndFunc(f func() null.Val[time.Time]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.BillingPeriodEnd = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetBillingPeriodEnd() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.BillingPeriodEnd = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceMods) RandomBillingPeriodEnd(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.BillingPeriodEnd = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceMods) RandomBillingPeriodEndNotNull(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.BillingPeriodEnd = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) BillingFrequency(val null.Val[string]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.BillingFrequency = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) BillingFrequencyFunc(f func() null.Val[string]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.BillingFrequency = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetBillingFrequency() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.BillingFrequency = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceMods) RandomBillingFrequency(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.BillingFrequency = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "50")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceMods) RandomBillingFrequencyNotNull(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.BillingFrequency = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "50")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) PaymentMode(val null.Val[string]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.PaymentMode = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) PaymentModeFunc(f func() null.Val[string]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.PaymentMode = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetPaymentMode() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.PaymentMode = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceMods) RandomPaymentMode(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.PaymentMode = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "2")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceMods) RandomPaymentModeNotNull(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.PaymentMode = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "2")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) PaymentTerms(val null.Val[string]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.PaymentTerms = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) PaymentTermsFunc(f func() null.Val[string]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.PaymentTerms = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetPaymentTerms() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.PaymentTerms = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceMods) RandomPaymentTerms(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.PaymentTerms = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "300")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceMods) RandomPaymentTermsNotNull(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.PaymentTerms = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "300")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) SupplierBankAccount(val null.Val[string]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.SupplierBankAccount = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) SupplierBankAccountFunc(f func() null.Val[string]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.SupplierBankAccount = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetSupplierBankAccount() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.SupplierBankAccount = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceMods) RandomSupplierBankAccount(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.SupplierBankAccount = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "150")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceMods) RandomSupplierBankAccountNotNull(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.SupplierBankAccount = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "150")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) TotalLineAmount(val decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalLineAmount = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) TotalLineAmountFunc(f func() decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalLineAmount = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetTotalLineAmount() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalLineAmount = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceMods) RandomTotalLineAmount(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalLineAmount = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) TotalAllowanceAmount(val decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalAllowanceAmount = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) TotalAllowanceAmountFunc(f func() decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalAllowanceAmount = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetTotalAllowanceAmount() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalAllowanceAmount = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceMods) RandomTotalAllowanceAmount(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalAllowanceAmount = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) TotalChargeAmount(val decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalChargeAmount = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) TotalChargeAmountFunc(f func() decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalChargeAmount = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetTotalChargeAmount() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalChargeAmount = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceMods) RandomTotalChargeAmount(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalChargeAmount = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) TotalExcludingTax(val decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalExcludingTax = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) TotalExcludingTaxFunc(f func() decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalExcludingTax = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetTotalExcludingTax() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalExcludingTax = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceMods) RandomTotalExcludingTax(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalExcludingTax = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) TotalTaxAmount(val decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalTaxAmount = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) TotalTaxAmountFunc(f func() decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalTaxAmount = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetTotalTaxAmount() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalTaxAmount = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceMods) RandomTotalTaxAmount(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalTaxAmount = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) TotalIncludingTax(val decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalIncludingTax = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) TotalIncludingTaxFunc(f func() decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalIncludingTax = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetTotalIncludingTax() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalIncludingTax = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceMods) RandomTotalIncludingTax(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.TotalIncludingTax = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) RoundingAmount(val decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.RoundingAmount = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) RoundingAmountFunc(f func() decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.RoundingAmount = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetRoundingAmount() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.RoundingAmount = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceMods) RandomRoundingAmount(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.RoundingAmount = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) PayableAmount(val decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.PayableAmount = func() decimal.Decimal { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) PayableAmountFunc(f func() decimal.Decimal) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.PayableAmount = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetPayableAmount() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.PayableAmount = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m invoiceMods) RandomPayableAmount(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.PayableAmount = func() decimal.Decimal {
+			return random_decimal_Decimal(f, "18", "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) CreatedBy(val null.Val[int64]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.CreatedBy = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) CreatedByFunc(f func() null.Val[int64]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.CreatedBy = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetCreatedBy() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.CreatedBy = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceMods) RandomCreatedBy(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.CreatedBy = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceMods) RandomCreatedByNotNull(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.CreatedBy = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) CreatedAt(val null.Val[time.Time]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) CreatedAtFunc(f func() null.Val[time.Time]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetCreatedAt() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceMods) RandomCreatedAt(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceMods) RandomCreatedAtNotNull(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) UpdatedAt(val null.Val[time.Time]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) UpdatedAtFunc(f func() null.Val[time.Time]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.UpdatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetUpdatedAt() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.UpdatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceMods) RandomUpdatedAt(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceMods) RandomUpdatedAtNotNull(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) UUID(val null.Val[string]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.UUID = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) UUIDFunc(f func() null.Val[string]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.UUID = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetUUID() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.UUID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceMods) RandomUUID(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.UUID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "26")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceMods) RandomUUIDNotNull(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.UUID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "26")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) OriginalInvoiceID(val null.Val[int64]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OriginalInvoiceID = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) OriginalInvoiceIDFunc(f func() null.Val[int64]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OriginalInvoiceID = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetOriginalInvoiceID() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OriginalInvoiceID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceMods) RandomOriginalInvoiceID(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OriginalInvoiceID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceMods) RandomOriginalInvoiceIDNotNull(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OriginalInvoiceID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) OriginalUUID(val null.Val[string]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OriginalUUID = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) OriginalUUIDFunc(f func() null.Val[string]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OriginalUUID = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetOriginalUUID() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OriginalUUID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceMods) RandomOriginalUUID(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OriginalUUID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "26")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceMods) RandomOriginalUUIDNotNull(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OriginalUUID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "26")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m invoiceMods) OriginalInvoiceNumber(val null.Val[string]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OriginalInvoiceNumber = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m invoiceMods) OriginalInvoiceNumberFunc(f func() null.Val[string]) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OriginalInvoiceNumber = f
+	})
+}
+
+// Clear any values for the column
+func (m invoiceMods) UnsetOriginalInvoiceNumber() InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OriginalInvoiceNumber = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m invoiceMods) RandomOriginalInvoiceNumber(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OriginalInvoiceNumber = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "50")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m invoiceMods) RandomOriginalInvoiceNumberNotNull(f *faker.Faker) InvoiceMod {
+	return InvoiceModFunc(func(_ context.Context, o *InvoiceTemplate) {
+		o.OriginalInvoiceNumber = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "50")
+			return null.From(val)
+		}
+	})
+}
+
+func (m invoiceMods) WithParentsCascading() InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		if isDone, _ := invoiceWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = invoiceWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithCreatedByUser(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewOrganisationWithContext(ctx, OrganisationMods.WithParentsCascading())
+			m.WithOrganisation(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewInvoiceWithContext(ctx, InvoiceMods.WithParentsCascading())
+			m.WithOriginalInvoice(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m invoiceMods) WithCreatedByUser(rel *UserTemplate) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.CreatedByUser = &invoiceRCreatedByUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m invoiceMods) WithNewCreatedByUser(mods ...UserMod) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithCreatedByUser(related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceMods) WithExistingCreatedByUser(em *models.User) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.CreatedByUser = &invoiceRCreatedByUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m invoiceMods) WithoutCreatedByUser() InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.CreatedByUser = nil
+	})
+}
+
+func (m invoiceMods) WithOrganisation(rel *OrganisationTemplate) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.Organisation = &invoiceROrganisationR{
+			o: rel,
+		}
+	})
+}
+
+func (m invoiceMods) WithNewOrganisation(mods ...OrganisationMod) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		related := o.f.NewOrganisationWithContext(ctx, mods...)
+
+		m.WithOrganisation(related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceMods) WithExistingOrganisation(em *models.Organisation) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.Organisation = &invoiceROrganisationR{
+			o: o.f.FromExistingOrganisation(em),
+		}
+	})
+}
+
+func (m invoiceMods) WithoutOrganisation() InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.Organisation = nil
+	})
+}
+
+func (m invoiceMods) WithOriginalInvoice(rel *InvoiceTemplate) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.OriginalInvoice = &invoiceROriginalInvoiceR{
+			o: rel,
+		}
+	})
+}
+
+func (m invoiceMods) WithNewOriginalInvoice(mods ...InvoiceMod) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		related := o.f.NewInvoiceWithContext(ctx, mods...)
+
+		m.WithOriginalInvoice(related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceMods) WithExistingOriginalInvoice(em *models.Invoice) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.OriginalInvoice = &invoiceROriginalInvoiceR{
+			o: o.f.FromExistingInvoice(em),
+		}
+	})
+}
+
+func (m invoiceMods) WithoutOriginalInvoice() InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.OriginalInvoice = nil
+	})
+}
+
+func (m invoiceMods) WithInvoiceAllowanceCharges(number int, related *InvoiceAllowanceChargeTemplate) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.InvoiceAllowanceCharges = []*invoiceRInvoiceAllowanceChargesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m invoiceMods) WithNewInvoiceAllowanceCharges(number int, mods ...InvoiceAllowanceChargeMod) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		related := o.f.NewInvoiceAllowanceChargeWithContext(ctx, mods...)
+		m.WithInvoiceAllowanceCharges(number, related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceMods) AddInvoiceAllowanceCharges(number int, related *InvoiceAllowanceChargeTemplate) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.InvoiceAllowanceCharges = append(o.r.InvoiceAllowanceCharges, &invoiceRInvoiceAllowanceChargesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m invoiceMods) AddNewInvoiceAllowanceCharges(number int, mods ...InvoiceAllowanceChargeMod) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		related := o.f.NewInvoiceAllowanceChargeWithContext(ctx, mods...)
+		m.AddInvoiceAllowanceCharges(number, related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceMods) AddExistingInvoiceAllowanceCharges(existingModels ...*models.InvoiceAllowanceCharge) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		for _, em := range existingModels {
+			o.r.InvoiceAllowanceCharges = append(o.r.InvoiceAllowanceCharges, &invoiceRInvoiceAllowanceChargesR{
+				o: o.f.FromExistingInvoiceAllowanceCharge(em),
+			})
+		}
+	})
+}
+
+func (m invoiceMods) WithoutInvoiceAllowanceCharges() InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.InvoiceAllowanceCharges = nil
+	})
+}
+
+func (m invoiceMods) WithInvoiceLines(number int, related *InvoiceLineTemplate) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.InvoiceLines = []*invoiceRInvoiceLinesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m invoiceMods) WithNewInvoiceLines(number int, mods ...InvoiceLineMod) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		related := o.f.NewInvoiceLineWithContext(ctx, mods...)
+		m.WithInvoiceLines(number, related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceMods) AddInvoiceLines(number int, related *InvoiceLineTemplate) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.InvoiceLines = append(o.r.InvoiceLines, &invoiceRInvoiceLinesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m invoiceMods) AddNewInvoiceLines(number int, mods ...InvoiceLineMod) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		related := o.f.NewInvoiceLineWithContext(ctx, mods...)
+		m.AddInvoiceLines(number, related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceMods) AddExistingInvoiceLines(existingModels ...*models.InvoiceLine) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		for _, em := range existingModels {
+			o.r.InvoiceLines = append(o.r.InvoiceLines, &invoiceRInvoiceLinesR{
+				o: o.f.FromExistingInvoiceLine(em),
+			})
+		}
+	})
+}
+
+func (m invoiceMods) WithoutInvoiceLines() InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.InvoiceLines = nil
+	})
+}
+
+func (m invoiceMods) WithInvoiceParties(number int, related *InvoicePartyTemplate) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.InvoiceParties = []*invoiceRInvoicePartiesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m invoiceMods) WithNewInvoiceParties(number int, mods ...InvoicePartyMod) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		related := o.f.NewInvoicePartyWithContext(ctx, mods...)
+		m.WithInvoiceParties(number, related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceMods) AddInvoiceParties(number int, related *InvoicePartyTemplate) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.InvoiceParties = append(o.r.InvoiceParties, &invoiceRInvoicePartiesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m invoiceMods) AddNewInvoiceParties(number int, mods ...InvoicePartyMod) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		related := o.f.NewInvoicePartyWithContext(ctx, mods...)
+		m.AddInvoiceParties(number, related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceMods) AddExistingInvoiceParties(existingModels ...*models.InvoiceParty) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		for _, em := range existingModels {
+			o.r.InvoiceParties = append(o.r.InvoiceParties, &invoiceRInvoicePartiesR{
+				o: o.f.FromExistingInvoiceParty(em),
+			})
+		}
+	})
+}
+
+func (m invoiceMods) WithoutInvoiceParties() InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.InvoiceParties = nil
+	})
+}
+
+func (m invoiceMods) WithReverseOriginalInvoices(number int, related *InvoiceTemplate) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.ReverseOriginalInvoices = []*invoiceRReverseOriginalInvoicesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m invoiceMods) WithNewReverseOriginalInvoices(number int, mods ...InvoiceMod) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		related := o.f.NewInvoiceWithContext(ctx, mods...)
+		m.WithReverseOriginalInvoices(number, related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceMods) AddReverseOriginalInvoices(number int, related *InvoiceTemplate) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.ReverseOriginalInvoices = append(o.r.ReverseOriginalInvoices, &invoiceRReverseOriginalInvoicesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m invoiceMods) AddNewReverseOriginalInvoices(number int, mods ...InvoiceMod) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		related := o.f.NewInvoiceWithContext(ctx, mods...)
+		m.AddReverseOriginalInvoices(number, related).Apply(ctx, o)
+	})
+}
+
+func (m invoiceMods) AddExistingReverseOriginalInvoices(existingModels ...*models.Invoice) InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		for _, em := range existingModels {
+			o.r.ReverseOriginalInvoices = append(o.r.ReverseOriginalInvoices, &invoiceRReverseOriginalInvoicesR{
+				o: o.f.FromExistingInvoice(em),
+			})
+		}
+	})
+}
+
+func (m invoiceMods) WithoutReverseOriginalInvoices() InvoiceMod {
+	return InvoiceModFunc(func(ctx context.Context, o *InvoiceTemplate) {
+		o.r.ReverseOriginalInvoices = nil
+	})
+}
@@ -0,0 +1,536 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type MfaRecoveryCodeMod interface {
+	Apply(context.Context, *MfaRecoveryCodeTemplate)
+}
+
+type MfaRecoveryCodeModFunc func(context.Context, *MfaRecoveryCodeTemplate)
+
+func (f MfaRecoveryCodeModFunc) Apply(ctx context.Context, n *MfaRecoveryCodeTemplate) {
+	f(ctx, n)
+}
+
+type MfaRecoveryCodeModSlice []MfaRecoveryCodeMod
+
+func (mods MfaRecoveryCodeModSlice) Apply(ctx context.Context, n *MfaRecoveryCodeTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// MfaRecoveryCodeTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type MfaRecoveryCodeTemplate struct {
+	ID        func() int64
+	UserID    func() int64
+	CodeHash  func() string
+	UsedAt    func() null.Val[time.Time]
+	CreatedAt func() null.Val[time.Time]
+
+	r mfaRecoveryCodeR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type mfaRecoveryCodeR struct {
+	User *mfaRecoveryCodeRUserR
+}
+
+type mfaRecoveryCodeRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the MfaRecoveryCodeTemplate
+func (o *MfaRecoveryCodeTemplate) Apply(ctx context.Context, mods ...MfaRecoveryCodeMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.MfaRecoveryCode
+// according to the relationships in the template. Nothing is inserted into the db
+func (t MfaRecoveryCodeTemplate) setModelRels(o *models.MfaRecoveryCode) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.MfaRecoveryCodes = append(rel.R.MfaRecoveryCodes, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.MfaRecoveryCodeSetter
+// this does nothing with the relationship templates
+func (o MfaRecoveryCodeTemplate) BuildSetter() *models.MfaRecoveryCodeSetter {
+	m := &models.MfaRecoveryCodeSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.CodeHash != nil {
+		val := o.CodeHash()
+		m.CodeHash = omit.From(val)
+	}
+	if o.UsedAt != nil {
+		val := o.UsedAt()
+		m.UsedAt = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.MfaRecoveryCodeSetter
+// this does nothing with the relationship templates
+func (o MfaRecoveryCodeTemplate) BuildManySetter(number int) []*models.MfaRecoveryCodeSetter {
+	m := make([]*models.MfaRecoveryCodeSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.MfaRecoveryCode
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use MfaRecoveryCodeTemplate.Create
+func (o MfaRecoveryCodeTemplate) Build() *models.MfaRecoveryCode {
+	m := &models.MfaRecoveryCode{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.CodeHash != nil {
+		m.CodeHash = o.CodeHash()
+	}
+	if o.UsedAt != nil {
+		m.UsedAt = o.UsedAt()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.MfaRecoveryCodeSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use MfaRecoveryCodeTemplate.CreateMany
+func (o MfaRecoveryCodeTemplate) BuildMany(number int) models.MfaRecoveryCodeSlice {
+	m := make(models.MfaRecoveryCodeSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableMfaRecoveryCode(m *models.MfaRecoveryCodeSetter) {
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
+	if !(m.CodeHash.IsValue()) {
+		val := random_string(nil, "255")
+		m.CodeHash = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.MfaRecoveryCode
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *MfaRecoveryCodeTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.MfaRecoveryCode) error {
+	var err error
+
+	return err
+}
+
+// Create builds a mfaRecoveryCode and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *MfaRecoveryCodeTemplate) Create(ctx context.Context, exec bob.Executor) (*models.MfaRecoveryCode, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableMfaRecoveryCode(opt)
+
+	if o.r.User == nil {
+		MfaRecoveryCodeMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel0 = o.r.User.o.Build()
+	} else {
+		rel0, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel0.ID)
+
+	m, err := models.MfaRecoveryCodes.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a mfaRecoveryCode and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *MfaRecoveryCodeTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.MfaRecoveryCode {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a mfaRecoveryCode and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *MfaRecoveryCodeTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.MfaRecoveryCode {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple mfaRecoveryCodes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o MfaRecoveryCodeTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.MfaRecoveryCodeSlice, error) {
+	var err error
+	m := make(models.MfaRecoveryCodeSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple mfaRecoveryCodes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o MfaRecoveryCodeTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.MfaRecoveryCodeSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple mfaRecoveryCodes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o MfaRecoveryCodeTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.MfaRecoveryCodeSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// MfaRecoveryCode has methods that act as mods for the MfaRecoveryCodeTemplate
+var MfaRecoveryCodeMods mfaRecoveryCodeMods
+
+type mfaRecoveryCodeMods struct{}
+
+func (m mfaRecoveryCodeMods) RandomizeAllColumns(f *faker.Faker) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModSlice{
+		MfaRecoveryCodeMods.RandomID(f),
+		MfaRecoveryCodeMods.RandomUserID(f),
+		MfaRecoveryCodeMods.RandomCodeHash(f),
+		MfaRecoveryCodeMods.RandomUsedAt(f),
+		MfaRecoveryCodeMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m mfaRecoveryCodeMods) ID(val int64) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m mfaRecoveryCodeMods) IDFunc(f func() int64) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m mfaRecoveryCodeMods) UnsetID() MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m mfaRecoveryCodeMods) RandomID(f *faker.Faker) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m mfaRecoveryCodeMods) UserID(val int64) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m mfaRecoveryCodeMods) UserIDFunc(f func() int64) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m mfaRecoveryCodeMods) UnsetUserID() MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m mfaRecoveryCodeMods) RandomUserID(f *faker.Faker) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m mfaRecoveryCodeMods) CodeHash(val string) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.CodeHash = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m mfaRecoveryCodeMods) CodeHashFunc(f func() string) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.CodeHash = f
+	})
+}
+
+// Clear any values for the column
+func (m mfaRecoveryCodeMods) UnsetCodeHash() MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.CodeHash = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m mfaRecoveryCodeMods) RandomCodeHash(f *faker.Faker) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.CodeHash = func() string {
+			return random_string(f, "255")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m mfaRecoveryCodeMods) UsedAt(val null.Val[time.Time]) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.UsedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m mfaRecoveryCodeMods) UsedAtFunc(f func() null.Val[time.Time]) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.UsedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m mfaRecoveryCodeMods) UnsetUsedAt() MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.UsedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m mfaRecoveryCodeMods) RandomUsedAt(f *faker.Faker) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.UsedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m mfaRecoveryCodeMods) RandomUsedAtNotNull(f *faker.Faker) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.UsedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m mfaRecoveryCodeMods) CreatedAt(val null.Val[time.Time]) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m mfaRecoveryCodeMods) CreatedAtFunc(f func() null.Val[time.Time]) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m mfaRecoveryCodeMods) UnsetCreatedAt() MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m mfaRecoveryCodeMods) RandomCreatedAt(f *faker.Faker) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m mfaRecoveryCodeMods) RandomCreatedAtNotNull(f *faker.Faker) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(_ context.Context, o *MfaRecoveryCodeTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m mfaRecoveryCodeMods) WithParentsCascading() MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(ctx context.Context, o *MfaRecoveryCodeTemplate) {
+		if isDone, _ := mfaRecoveryCodeWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = mfaRecoveryCodeWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m mfaRecoveryCodeMods) WithUser(rel *UserTemplate) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(ctx context.Context, o *MfaRecoveryCodeTemplate) {
+		o.r.User = &mfaRecoveryCodeRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m mfaRecoveryCodeMods) WithNewUser(mods ...UserMod) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(ctx context.Context, o *MfaRecoveryCodeTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m mfaRecoveryCodeMods) WithExistingUser(em *models.User) MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(ctx context.Context, o *MfaRecoveryCodeTemplate) {
+		o.r.User = &mfaRecoveryCodeRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m mfaRecoveryCodeMods) WithoutUser() MfaRecoveryCodeMod {
+	return MfaRecoveryCodeModFunc(func(ctx context.Context, o *MfaRecoveryCodeTemplate) {
+		o.r.User = nil
+	})
+}
@@ -0,0 +1,835 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/lib/pq"
+	"github.com/stephenafamo/bob"
+)
+
+type OauthClientMod interface {
+	Apply(context.Context, *OauthClientTemplate)
+}
+
+type OauthClientModFunc func(context.Context, *OauthClientTemplate)
+
+func (f OauthClientModFunc) Apply(ctx context.Context, n *OauthClientTemplate) {
+	f(ctx, n)
+}
+
+type OauthClientModSlice []OauthClientMod
+
+func (mods OauthClientModSlice) Apply(ctx context.Context, n *OauthClientTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// OauthClientTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type OauthClientTemplate struct {
+	ID             func() int64
+	OrganisationID func() int64
+	UserID         func() int64
+	Name           func() string
+	ClientID       func() string
+	SecretHash     func() string
+	Scopes         func() pq.StringArray
+	RevokedAt      func() null.Val[time.Time]
+	CreatedAt      func() null.Val[time.Time]
+	UpdatedAt      func() null.Val[time.Time]
+
+	r oauthClientR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type oauthClientR struct {
+	Organisation *oauthClientROrganisationR
+	User         *oauthClientRUserR
+}
+
+type oauthClientROrganisationR struct {
+	o *OrganisationTemplate
+}
+type oauthClientRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the OauthClientTemplate
+func (o *OauthClientTemplate) Apply(ctx context.Context, mods ...OauthClientMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.OauthClient
+// according to the relationships in the template. Nothing is inserted into the db
+func (t OauthClientTemplate) setModelRels(o *models.OauthClient) {
+	if t.r.Organisation != nil {
+		rel := t.r.Organisation.o.Build()
+		rel.R.OauthClients = append(rel.R.OauthClients, o)
+		o.OrganisationID = rel.ID // h2
+		o.R.Organisation = rel
+	}
+
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.OauthClients = append(rel.R.OauthClients, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.OauthClientSetter
+// this does nothing with the relationship templates
+func (o OauthClientTemplate) BuildSetter() *models.OauthClientSetter {
+	m := &models.OauthClientSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.OrganisationID != nil {
+		val := o.OrganisationID()
+		m.OrganisationID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.Name != nil {
+		val := o.Name()
+		m.Name = omit.From(val)
+	}
+	if o.ClientID != nil {
+		val := o.ClientID()
+		m.ClientID = omit.From(val)
+	}
+	if o.SecretHash != nil {
+		val := o.SecretHash()
+		m.SecretHash = omit.From(val)
+	}
+	if o.Scopes != nil {
+		val := o.Scopes()
+		m.Scopes = omit.From(val)
+	}
+	if o.RevokedAt != nil {
+		val := o.RevokedAt()
+		m.RevokedAt = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+	if o.UpdatedAt != nil {
+		val := o.UpdatedAt()
+		m.UpdatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.OauthClientSetter
+// this does nothing with the relationship templates
+func (o OauthClientTemplate) BuildManySetter(number int) []*models.OauthClientSetter {
+	m := make([]*models.OauthClientSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.OauthClient
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OauthClientTemplate.Create
+func (o OauthClientTemplate) Build() *models.OauthClient {
+	m := &models.OauthClient{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.OrganisationID != nil {
+		m.OrganisationID = o.OrganisationID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.ClientID != nil {
+		m.ClientID = o.ClientID()
+	}
+	if o.SecretHash != nil {
+		m.SecretHash = o.SecretHash()
+	}
+	if o.Scopes != nil {
+		m.Scopes = o.Scopes()
+	}
+	if o.RevokedAt != nil {
+		m.RevokedAt = o.RevokedAt()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.UpdatedAt != nil {
+		m.UpdatedAt = o.UpdatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.OauthClientSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OauthClientTemplate.CreateMany
+func (o OauthClientTemplate) BuildMany(number int) models.OauthClientSlice {
+	m := make(models.OauthClientSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableOauthClient(m *models.OauthClientSetter) {
+	if !(m.OrganisationID.IsValue()) {
+		val := random_int64(nil)
+		m.OrganisationID = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
+	if !(m.Name.IsValue()) {
+		val := random_string(nil, "100")
+		m.Name = omit.From(val)
+	}
+	if !(m.ClientID.IsValue()) {
+		val := random_string(nil, "64")
+		m.ClientID = omit.From(val)
+	}
+	if !(m.SecretHash.IsValue()) {
+		val := random_string(nil, "255")
+		m.SecretHash = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.OauthClient
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *OauthClientTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.OauthClient) error {
+	var err error
+
+	return err
+}
+
+// Create builds a oauthClient and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *OauthClientTemplate) Create(ctx context.Context, exec bob.Executor) (*models.OauthClient, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableOauthClient(opt)
+
+	if o.r.Organisation == nil {
+		OauthClientMods.WithNewOrganisation().Apply(ctx, o)
+	}
+
+	var rel0 *models.Organisation
+
+	if o.r.Organisation.o.alreadyPersisted {
+		rel0 = o.r.Organisation.o.Build()
+	} else {
+		rel0, err = o.r.Organisation.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.OrganisationID = omit.From(rel0.ID)
+
+	if o.r.User == nil {
+		OauthClientMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel1 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel1 = o.r.User.o.Build()
+	} else {
+		rel1, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel1.ID)
+
+	m, err := models.OauthClients.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Organisation = rel0
+	m.R.User = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a oauthClient and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *OauthClientTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.OauthClient {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a oauthClient and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *OauthClientTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.OauthClient {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple oauthClients and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o OauthClientTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.OauthClientSlice, error) {
+	var err error
+	m := make(models.OauthClientSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple oauthClients and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o OauthClientTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.OauthClientSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple oauthClients and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o OauthClientTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.OauthClientSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// OauthClient has methods that act as mods for the OauthClientTemplate
+var OauthClientMods oauthClientMods
+
+type oauthClientMods struct{}
+
+func (m oauthClientMods) RandomizeAllColumns(f *faker.Faker) OauthClientMod {
+	return OauthClientModSlice{
+		OauthClientMods.RandomID(f),
+		OauthClientMods.RandomOrganisationID(f),
+		OauthClientMods.RandomUserID(f),
+		OauthClientMods.RandomName(f),
+		OauthClientMods.RandomClientID(f),
+		OauthClientMods.RandomSecretHash(f),
+		OauthClientMods.RandomScopes(f),
+		OauthClientMods.RandomRevokedAt(f),
+		OauthClientMods.RandomCreatedAt(f),
+		OauthClientMods.RandomUpdatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) ID(val int64) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) IDFunc(f func() int64) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetID() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthClientMods) RandomID(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) OrganisationID(val int64) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.OrganisationID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) OrganisationIDFunc(f func() int64) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.OrganisationID = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetOrganisationID() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.OrganisationID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthClientMods) RandomOrganisationID(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.OrganisationID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) UserID(val int64) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) UserIDFunc(f func() int64) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetUserID() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthClientMods) RandomUserID(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) Name(val string) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Name = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) NameFunc(f func() string) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetName() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthClientMods) RandomName(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Name = func() string {
+			return random_string(f, "100")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) ClientID(val string) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.ClientID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) ClientIDFunc(f func() string) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.ClientID = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetClientID() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.ClientID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthClientMods) RandomClientID(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.ClientID = func() string {
+			return random_string(f, "64")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) SecretHash(val string) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.SecretHash = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) SecretHashFunc(f func() string) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.SecretHash = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetSecretHash() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.SecretHash = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthClientMods) RandomSecretHash(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.SecretHash = func() string {
+			return random_string(f, "255")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) Scopes(val pq.StringArray) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Scopes = func() pq.StringArray { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) ScopesFunc(f func() pq.StringArray) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Scopes = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetScopes() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Scopes = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthClientMods) RandomScopes(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Scopes = func() pq.StringArray {
+			return random_pq_StringArray(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) RevokedAt(val null.Val[time.Time]) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.RevokedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) RevokedAtFunc(f func() null.Val[time.Time]) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.RevokedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetRevokedAt() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.RevokedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m oauthClientMods) RandomRevokedAt(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.RevokedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m oauthClientMods) RandomRevokedAtNotNull(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.RevokedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) CreatedAt(val null.Val[time.Time]) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) CreatedAtFunc(f func() null.Val[time.Time]) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetCreatedAt() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m oauthClientMods) RandomCreatedAt(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m oauthClientMods) RandomCreatedAtNotNull(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) UpdatedAt(val null.Val[time.Time]) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) UpdatedAtFunc(f func() null.Val[time.Time]) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.UpdatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetUpdatedAt() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.UpdatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m oauthClientMods) RandomUpdatedAt(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m oauthClientMods) RandomUpdatedAtNotNull(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m oauthClientMods) WithParentsCascading() OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		if isDone, _ := oauthClientWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = oauthClientWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewOrganisationWithContext(ctx, OrganisationMods.WithParentsCascading())
+			m.WithOrganisation(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m oauthClientMods) WithOrganisation(rel *OrganisationTemplate) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		o.r.Organisation = &oauthClientROrganisationR{
+			o: rel,
+		}
+	})
+}
+
+func (m oauthClientMods) WithNewOrganisation(mods ...OrganisationMod) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		related := o.f.NewOrganisationWithContext(ctx, mods...)
+
+		m.WithOrganisation(related).Apply(ctx, o)
+	})
+}
+
+func (m oauthClientMods) WithExistingOrganisation(em *models.Organisation) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		o.r.Organisation = &oauthClientROrganisationR{
+			o: o.f.FromExistingOrganisation(em),
+		}
+	})
+}
+
+func (m oauthClientMods) WithoutOrganisation() OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		o.r.Organisation = nil
+	})
+}
+
+func (m oauthClientMods) WithUser(rel *UserTemplate) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		o.r.User = &oauthClientRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m oauthClientMods) WithNewUser(mods ...UserMod) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m oauthClientMods) WithExistingUser(em *models.User) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		o.r.User = &oauthClientRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m oauthClientMods) WithoutUser() OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		o.r.User = nil
+	})
+}
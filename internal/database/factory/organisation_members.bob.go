@@ -0,0 +1,498 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type OrganisationMemberMod interface {
+	Apply(context.Context, *OrganisationMemberTemplate)
+}
+
+type OrganisationMemberModFunc func(context.Context, *OrganisationMemberTemplate)
+
+func (f OrganisationMemberModFunc) Apply(ctx context.Context, n *OrganisationMemberTemplate) {
+	f(ctx, n)
+}
+
+type OrganisationMemberModSlice []OrganisationMemberMod
+
+func (mods OrganisationMemberModSlice) Apply(ctx context.Context, n *OrganisationMemberTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// OrganisationMemberTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type OrganisationMemberTemplate struct {
+	OrganisationID func() int64
+	UserID         func() int64
+	CreatedAt      func() null.Val[time.Time]
+
+	r organisationMemberR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type organisationMemberR struct {
+	Organisation *organisationMemberROrganisationR
+	User         *organisationMemberRUserR
+}
+
+type organisationMemberROrganisationR struct {
+	o *OrganisationTemplate
+}
+type organisationMemberRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the OrganisationMemberTemplate
+func (o *OrganisationMemberTemplate) Apply(ctx context.Context, mods ...OrganisationMemberMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.OrganisationMember
+// according to the relationships in the template. Nothing is inserted into the db
+func (t OrganisationMemberTemplate) setModelRels(o *models.OrganisationMember) {
+	if t.r.Organisation != nil {
+		rel := t.r.Organisation.o.Build()
+		rel.R.OrganisationMembers = append(rel.R.OrganisationMembers, o)
+		o.OrganisationID = rel.ID // h2
+		o.R.Organisation = rel
+	}
+
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.OrganisationMembers = append(rel.R.OrganisationMembers, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.OrganisationMemberSetter
+// this does nothing with the relationship templates
+func (o OrganisationMemberTemplate) BuildSetter() *models.OrganisationMemberSetter {
+	m := &models.OrganisationMemberSetter{}
+
+	if o.OrganisationID != nil {
+		val := o.OrganisationID()
+		m.OrganisationID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.OrganisationMemberSetter
+// this does nothing with the relationship templates
+func (o OrganisationMemberTemplate) BuildManySetter(number int) []*models.OrganisationMemberSetter {
+	m := make([]*models.OrganisationMemberSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.OrganisationMember
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OrganisationMemberTemplate.Create
+func (o OrganisationMemberTemplate) Build() *models.OrganisationMember {
+	m := &models.OrganisationMember{}
+
+	if o.OrganisationID != nil {
+		m.OrganisationID = o.OrganisationID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.OrganisationMemberSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OrganisationMemberTemplate.CreateMany
+func (o OrganisationMemberTemplate) BuildMany(number int) models.OrganisationMemberSlice {
+	m := make(models.OrganisationMemberSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableOrganisationMember(m *models.OrganisationMemberSetter) {
+	if !(m.OrganisationID.IsValue()) {
+		val := random_int64(nil)
+		m.OrganisationID = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.OrganisationMember
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *OrganisationMemberTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.OrganisationMember) error {
+	var err error
+
+	return err
+}
+
+// Create builds a organisationMember and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *OrganisationMemberTemplate) Create(ctx context.Context, exec bob.Executor) (*models.OrganisationMember, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableOrganisationMember(opt)
+
+	if o.r.Organisation == nil {
+		OrganisationMemberMods.WithNewOrganisation().Apply(ctx, o)
+	}
+
+	var rel0 *models.Organisation
+
+	if o.r.Organisation.o.alreadyPersisted {
+		rel0 = o.r.Organisation.o.Build()
+	} else {
+		rel0, err = o.r.Organisation.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.OrganisationID = omit.From(rel0.ID)
+
+	if o.r.User == nil {
+		OrganisationMemberMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel1 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel1 = o.r.User.o.Build()
+	} else {
+		rel1, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel1.ID)
+
+	m, err := models.OrganisationMembers.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Organisation = rel0
+	m.R.User = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a organisationMember and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *OrganisationMemberTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.OrganisationMember {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a organisationMember and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *OrganisationMemberTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.OrganisationMember {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple organisationMembers and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o OrganisationMemberTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.OrganisationMemberSlice, error) {
+	var err error
+	m := make(models.OrganisationMemberSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple organisationMembers and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o OrganisationMemberTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.OrganisationMemberSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple organisationMembers and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o OrganisationMemberTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.OrganisationMemberSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// OrganisationMember has methods that act as mods for the OrganisationMemberTemplate
+var OrganisationMemberMods organisationMemberMods
+
+type organisationMemberMods struct{}
+
+func (m organisationMemberMods) RandomizeAllColumns(f *faker.Faker) OrganisationMemberMod {
+	return OrganisationMemberModSlice{
+		OrganisationMemberMods.RandomOrganisationID(f),
+		OrganisationMemberMods.RandomUserID(f),
+		OrganisationMemberMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m organisationMemberMods) OrganisationID(val int64) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(_ context.Context, o *OrganisationMemberTemplate) {
+		o.OrganisationID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m organisationMemberMods) OrganisationIDFunc(f func() int64) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(_ context.Context, o *OrganisationMemberTemplate) {
+		o.OrganisationID = f
+	})
+}
+
+// Clear any values for the column
+func (m organisationMemberMods) UnsetOrganisationID() OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(_ context.Context, o *OrganisationMemberTemplate) {
+		o.OrganisationID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m organisationMemberMods) RandomOrganisationID(f *faker.Faker) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(_ context.Context, o *OrganisationMemberTemplate) {
+		o.OrganisationID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m organisationMemberMods) UserID(val int64) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(_ context.Context, o *OrganisationMemberTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m organisationMemberMods) UserIDFunc(f func() int64) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(_ context.Context, o *OrganisationMemberTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m organisationMemberMods) UnsetUserID() OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(_ context.Context, o *OrganisationMemberTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m organisationMemberMods) RandomUserID(f *faker.Faker) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(_ context.Context, o *OrganisationMemberTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m organisationMemberMods) CreatedAt(val null.Val[time.Time]) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(_ context.Context, o *OrganisationMemberTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m organisationMemberMods) CreatedAtFunc(f func() null.Val[time.Time]) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(_ context.Context, o *OrganisationMemberTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m organisationMemberMods) UnsetCreatedAt() OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(_ context.Context, o *OrganisationMemberTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m organisationMemberMods) RandomCreatedAt(f *faker.Faker) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(_ context.Context, o *OrganisationMemberTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m organisationMemberMods) RandomCreatedAtNotNull(f *faker.Faker) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(_ context.Context, o *OrganisationMemberTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m organisationMemberMods) WithParentsCascading() OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(ctx context.Context, o *OrganisationMemberTemplate) {
+		if isDone, _ := organisationMemberWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = organisationMemberWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewOrganisationWithContext(ctx, OrganisationMods.WithParentsCascading())
+			m.WithOrganisation(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m organisationMemberMods) WithOrganisation(rel *OrganisationTemplate) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(ctx context.Context, o *OrganisationMemberTemplate) {
+		o.r.Organisation = &organisationMemberROrganisationR{
+			o: rel,
+		}
+	})
+}
+
+func (m organisationMemberMods) WithNewOrganisation(mods ...OrganisationMod) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(ctx context.Context, o *OrganisationMemberTemplate) {
+		related := o.f.NewOrganisationWithContext(ctx, mods...)
+
+		m.WithOrganisation(related).Apply(ctx, o)
+	})
+}
+
+func (m organisationMemberMods) WithExistingOrganisation(em *models.Organisation) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(ctx context.Context, o *OrganisationMemberTemplate) {
+		o.r.Organisation = &organisationMemberROrganisationR{
+			o: o.f.FromExistingOrganisation(em),
+		}
+	})
+}
+
+func (m organisationMemberMods) WithoutOrganisation() OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(ctx context.Context, o *OrganisationMemberTemplate) {
+		o.r.Organisation = nil
+	})
+}
+
+func (m organisationMemberMods) WithUser(rel *UserTemplate) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(ctx context.Context, o *OrganisationMemberTemplate) {
+		o.r.User = &organisationMemberRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m organisationMemberMods) WithNewUser(mods ...UserMod) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(ctx context.Context, o *OrganisationMemberTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m organisationMemberMods) WithExistingUser(em *models.User) OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(ctx context.Context, o *OrganisationMemberTemplate) {
+		o.r.User = &organisationMemberRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m organisationMemberMods) WithoutUser() OrganisationMemberMod {
+	return OrganisationMemberModFunc(func(ctx context.Context, o *OrganisationMemberTemplate) {
+		o.r.User = nil
+	})
+}
@@ -0,0 +1,836 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type OrganisationMod interface {
+	Apply(context.Context, *OrganisationTemplate)
+}
+
+type OrganisationModFunc func(context.Context, *OrganisationTemplate)
+
+func (f OrganisationModFunc) Apply(ctx context.Context, n *OrganisationTemplate) {
+	f(ctx, n)
+}
+
+type OrganisationModSlice []OrganisationMod
+
+func (mods OrganisationModSlice) Apply(ctx context.Context, n *OrganisationTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// OrganisationTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type OrganisationTemplate struct {
+	ID        func() int64
+	Name      func() string
+	CreatedAt func() null.Val[time.Time]
+	UpdatedAt func() null.Val[time.Time]
+
+	r organisationR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type organisationR struct {
+	APIKeys             []*organisationRAPIKeysR
+	Invoices            []*organisationRInvoicesR
+	OauthClients        []*organisationROauthClientsR
+	OrganisationMembers []*organisationROrganisationMembersR
+	TaxpayerProfile     *organisationRTaxpayerProfileR
+}
+
+type organisationRAPIKeysR struct {
+	number int
+	o      *APIKeyTemplate
+}
+type organisationRInvoicesR struct {
+	number int
+	o      *InvoiceTemplate
+}
+type organisationROauthClientsR struct {
+	number int
+	o      *OauthClientTemplate
+}
+type organisationROrganisationMembersR struct {
+	number int
+	o      *OrganisationMemberTemplate
+}
+type organisationRTaxpayerProfileR struct {
+	o *TaxpayerProfileTemplate
+}
+
+// Apply mods to the OrganisationTemplate
+func (o *OrganisationTemplate) Apply(ctx context.Context, mods ...OrganisationMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Organisation
+// according to the relationships in the template. Nothing is inserted into the db
+func (t OrganisationTemplate) setModelRels(o *models.Organisation) {
+	if t.r.APIKeys != nil {
+		rel := models.APIKeySlice{}
+		for _, r := range t.r.APIKeys {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.OrganisationID = o.ID // h2
+				rel.R.Organisation = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.APIKeys = rel
+	}
+
+	if t.r.Invoices != nil {
+		rel := models.InvoiceSlice{}
+		for _, r := range t.r.Invoices {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.OrganisationID = o.ID // h2
+				rel.R.Organisation = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Invoices = rel
+	}
+
+	if t.r.OauthClients != nil {
+		rel := models.OauthClientSlice{}
+		for _, r := range t.r.OauthClients {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.OrganisationID = o.ID // h2
+				rel.R.Organisation = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.OauthClients = rel
+	}
+
+	if t.r.OrganisationMembers != nil {
+		rel := models.OrganisationMemberSlice{}
+		for _, r := range t.r.OrganisationMembers {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.OrganisationID = o.ID // h2
+				rel.R.Organisation = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.OrganisationMembers = rel
+	}
+
+	if t.r.TaxpayerProfile != nil {
+		rel := t.r.TaxpayerProfile.o.Build()
+		rel.R.Organisation = o
+		rel.OrganisationID = o.ID // h2
+		o.R.TaxpayerProfile = rel
+	}
+}
+
+// BuildSetter returns an *models.OrganisationSetter
+// this does nothing with the relationship templates
+func (o OrganisationTemplate) BuildSetter() *models.OrganisationSetter {
+	m := &models.OrganisationSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.Name != nil {
+		val := o.Name()
+		m.Name = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+	if o.UpdatedAt != nil {
+		val := o.UpdatedAt()
+		m.UpdatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.OrganisationSetter
+// this does nothing with the relationship templates
+func (o OrganisationTemplate) BuildManySetter(number int) []*models.OrganisationSetter {
+	m := make([]*models.OrganisationSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Organisation
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OrganisationTemplate.Create
+func (o OrganisationTemplate) Build() *models.Organisation {
+	m := &models.Organisation{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.UpdatedAt != nil {
+		m.UpdatedAt = o.UpdatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.OrganisationSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OrganisationTemplate.CreateMany
+func (o OrganisationTemplate) BuildMany(number int) models.OrganisationSlice {
+	m := make(models.OrganisationSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableOrganisation(m *models.OrganisationSetter) {
+	if !(m.Name.IsValue()) {
+		val := random_string(nil, "255")
+		m.Name = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Organisation
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *OrganisationTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Organisation) error {
+	var err error
+
+	isAPIKeysDone, _ := organisationRelAPIKeysCtx.Value(ctx)
+	if !isAPIKeysDone && o.r.APIKeys != nil {
+		ctx = organisationRelAPIKeysCtx.WithValue(ctx, true)
+		for _, r := range o.r.APIKeys {
+			if r.o.alreadyPersisted {
+				m.R.APIKeys = append(m.R.APIKeys, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachAPIKeys(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isInvoicesDone, _ := organisationRelInvoicesCtx.Value(ctx)
+	if !isInvoicesDone && o.r.Invoices != nil {
+		ctx = organisationRelInvoicesCtx.WithValue(ctx, true)
+		for _, r := range o.r.Invoices {
+			if r.o.alreadyPersisted {
+				m.R.Invoices = append(m.R.Invoices, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachInvoices(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isOauthClientsDone, _ := organisationRelOauthClientsCtx.Value(ctx)
+	if !isOauthClientsDone && o.r.OauthClients != nil {
+		ctx = organisationRelOauthClientsCtx.WithValue(ctx, true)
+		for _, r := range o.r.OauthClients {
+			if r.o.alreadyPersisted {
+				m.R.OauthClients = append(m.R.OauthClients, r.o.Build())
+			} else {
+				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachOauthClients(ctx, exec, rel2...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isOrganisationMembersDone, _ := organisationRelOrganisationMembersCtx.Value(ctx)
+	if !isOrganisationMembersDone && o.r.OrganisationMembers != nil {
+		ctx = organisationRelOrganisationMembersCtx.WithValue(ctx, true)
+		for _, r := range o.r.OrganisationMembers {
+			if r.o.alreadyPersisted {
+				m.R.OrganisationMembers = append(m.R.OrganisationMembers, r.o.Build())
+			} else {
+				rel3, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachOrganisationMembers(ctx, exec, rel3...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTaxpayerProfileDone, _ := organisationRelTaxpayerProfileCtx.Value(ctx)
+	if !isTaxpayerProfileDone && o.r.TaxpayerProfile != nil {
+		ctx = organisationRelTaxpayerProfileCtx.WithValue(ctx, true)
+		if o.r.TaxpayerProfile.o.alreadyPersisted {
+			m.R.TaxpayerProfile = o.r.TaxpayerProfile.o.Build()
+		} else {
+			var rel4 *models.TaxpayerProfile
+			rel4, err = o.r.TaxpayerProfile.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachTaxpayerProfile(ctx, exec, rel4)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return err
+}
+
+// Create builds a organisation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *OrganisationTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Organisation, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableOrganisation(opt)
+
+	m, err := models.Organisations.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a organisation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *OrganisationTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Organisation {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a organisation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *OrganisationTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Organisation {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple organisations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o OrganisationTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.OrganisationSlice, error) {
+	var err error
+	m := make(models.OrganisationSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple organisations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o OrganisationTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.OrganisationSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple organisations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o OrganisationTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.OrganisationSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Organisation has methods that act as mods for the OrganisationTemplate
+var OrganisationMods organisationMods
+
+type organisationMods struct{}
+
+func (m organisationMods) RandomizeAllColumns(f *faker.Faker) OrganisationMod {
+	return OrganisationModSlice{
+		OrganisationMods.RandomID(f),
+		OrganisationMods.RandomName(f),
+		OrganisationMods.RandomCreatedAt(f),
+		OrganisationMods.RandomUpdatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m organisationMods) ID(val int64) OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m organisationMods) IDFunc(f func() int64) OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m organisationMods) UnsetID() OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m organisationMods) RandomID(f *faker.Faker) OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m organisationMods) Name(val string) OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.Name = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m organisationMods) NameFunc(f func() string) OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m organisationMods) UnsetName() OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m organisationMods) RandomName(f *faker.Faker) OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.Name = func() string {
+			return random_string(f, "255")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m organisationMods) CreatedAt(val null.Val[time.Time]) OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m organisationMods) CreatedAtFunc(f func() null.Val[time.Time]) OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m organisationMods) UnsetCreatedAt() OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m organisationMods) RandomCreatedAt(f *faker.Faker) OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m organisationMods) RandomCreatedAtNotNull(f *faker.Faker) OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m organisationMods) UpdatedAt(val null.Val[time.Time]) OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m organisationMods) UpdatedAtFunc(f func() null.Val[time.Time]) OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.UpdatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m organisationMods) UnsetUpdatedAt() OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.UpdatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m organisationMods) RandomUpdatedAt(f *faker.Faker) OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m organisationMods) RandomUpdatedAtNotNull(f *faker.Faker) OrganisationMod {
+	return OrganisationModFunc(func(_ context.Context, o *OrganisationTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m organisationMods) WithParentsCascading() OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		if isDone, _ := organisationWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = organisationWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewTaxpayerProfileWithContext(ctx, TaxpayerProfileMods.WithParentsCascading())
+			m.WithTaxpayerProfile(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m organisationMods) WithTaxpayerProfile(rel *TaxpayerProfileTemplate) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		o.r.TaxpayerProfile = &organisationRTaxpayerProfileR{
+			o: rel,
+		}
+	})
+}
+
+func (m organisationMods) WithNewTaxpayerProfile(mods ...TaxpayerProfileMod) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		related := o.f.NewTaxpayerProfileWithContext(ctx, mods...)
+
+		m.WithTaxpayerProfile(related).Apply(ctx, o)
+	})
+}
+
+func (m organisationMods) WithExistingTaxpayerProfile(em *models.TaxpayerProfile) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		o.r.TaxpayerProfile = &organisationRTaxpayerProfileR{
+			o: o.f.FromExistingTaxpayerProfile(em),
+		}
+	})
+}
+
+func (m organisationMods) WithoutTaxpayerProfile() OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		o.r.TaxpayerProfile = nil
+	})
+}
+
+func (m organisationMods) WithAPIKeys(number int, related *APIKeyTemplate) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		o.r.APIKeys = []*organisationRAPIKeysR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m organisationMods) WithNewAPIKeys(number int, mods ...APIKeyMod) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		related := o.f.NewAPIKeyWithContext(ctx, mods...)
+		m.WithAPIKeys(number, related).Apply(ctx, o)
+	})
+}
+
+func (m organisationMods) AddAPIKeys(number int, related *APIKeyTemplate) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		o.r.APIKeys = append(o.r.APIKeys, &organisationRAPIKeysR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m organisationMods) AddNewAPIKeys(number int, mods ...APIKeyMod) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		related := o.f.NewAPIKeyWithContext(ctx, mods...)
+		m.AddAPIKeys(number, related).Apply(ctx, o)
+	})
+}
+
+func (m organisationMods) AddExistingAPIKeys(existingModels ...*models.APIKey) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		for _, em := range existingModels {
+			o.r.APIKeys = append(o.r.APIKeys, &organisationRAPIKeysR{
+				o: o.f.FromExistingAPIKey(em),
+			})
+		}
+	})
+}
+
+func (m organisationMods) WithoutAPIKeys() OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		o.r.APIKeys = nil
+	})
+}
+
+func (m organisationMods) WithInvoices(number int, related *InvoiceTemplate) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		o.r.Invoices = []*organisationRInvoicesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m organisationMods) WithNewInvoices(number int, mods ...InvoiceMod) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		related := o.f.NewInvoiceWithContext(ctx, mods...)
+		m.WithInvoices(number, related).Apply(ctx, o)
+	})
+}
+
+func (m organisationMods) AddInvoices(number int, related *InvoiceTemplate) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		o.r.Invoices = append(o.r.Invoices, &organisationRInvoicesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m organisationMods) AddNewInvoices(number int, mods ...InvoiceMod) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		related := o.f.NewInvoiceWithContext(ctx, mods...)
+		m.AddInvoices(number, related).Apply(ctx, o)
+	})
+}
+
+func (m organisationMods) AddExistingInvoices(existingModels ...*models.Invoice) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		for _, em := range existingModels {
+			o.r.Invoices = append(o.r.Invoices, &organisationRInvoicesR{
+				o: o.f.FromExistingInvoice(em),
+			})
+		}
+	})
+}
+
+func (m organisationMods) WithoutInvoices() OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		o.r.Invoices = nil
+	})
+}
+
+func (m organisationMods) WithOauthClients(number int, related *OauthClientTemplate) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		o.r.OauthClients = []*organisationROauthClientsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m organisationMods) WithNewOauthClients(number int, mods ...OauthClientMod) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		related := o.f.NewOauthClientWithContext(ctx, mods...)
+		m.WithOauthClients(number, related).Apply(ctx, o)
+	})
+}
+
+func (m organisationMods) AddOauthClients(number int, related *OauthClientTemplate) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		o.r.OauthClients = append(o.r.OauthClients, &organisationROauthClientsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m organisationMods) AddNewOauthClients(number int, mods ...OauthClientMod) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		related := o.f.NewOauthClientWithContext(ctx, mods...)
+		m.AddOauthClients(number, related).Apply(ctx, o)
+	})
+}
+
+func (m organisationMods) AddExistingOauthClients(existingModels ...*models.OauthClient) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		for _, em := range existingModels {
+			o.r.OauthClients = append(o.r.OauthClients, &organisationROauthClientsR{
+				o: o.f.FromExistingOauthClient(em),
+			})
+		}
+	})
+}
+
+func (m organisationMods) WithoutOauthClients() OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		o.r.OauthClients = nil
+	})
+}
+
+func (m organisationMods) WithOrganisationMembers(number int, related *OrganisationMemberTemplate) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		o.r.OrganisationMembers = []*organisationROrganisationMembersR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m organisationMods) WithNewOrganisationMembers(number int, mods ...OrganisationMemberMod) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		related := o.f.NewOrganisationMemberWithContext(ctx, mods...)
+		m.WithOrganisationMembers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m organisationMods) AddOrganisationMembers(number int, related *OrganisationMemberTemplate) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		o.r.OrganisationMembers = append(o.r.OrganisationMembers, &organisationROrganisationMembersR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m organisationMods) AddNewOrganisationMembers(number int, mods ...OrganisationMemberMod) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		related := o.f.NewOrganisationMemberWithContext(ctx, mods...)
+		m.AddOrganisationMembers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m organisationMods) AddExistingOrganisationMembers(existingModels ...*models.OrganisationMember) OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		for _, em := range existingModels {
+			o.r.OrganisationMembers = append(o.r.OrganisationMembers, &organisationROrganisationMembersR{
+				o: o.f.FromExistingOrganisationMember(em),
+			})
+		}
+	})
+}
+
+func (m organisationMods) WithoutOrganisationMembers() OrganisationMod {
+	return OrganisationModFunc(func(ctx context.Context, o *OrganisationTemplate) {
+		o.r.OrganisationMembers = nil
+	})
+}
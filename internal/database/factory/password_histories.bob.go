@@ -0,0 +1,474 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type PasswordHistoryMod interface {
+	Apply(context.Context, *PasswordHistoryTemplate)
+}
+
+type PasswordHistoryModFunc func(context.Context, *PasswordHistoryTemplate)
+
+func (f PasswordHistoryModFunc) Apply(ctx context.Context, n *PasswordHistoryTemplate) {
+	f(ctx, n)
+}
+
+type PasswordHistoryModSlice []PasswordHistoryMod
+
+func (mods PasswordHistoryModSlice) Apply(ctx context.Context, n *PasswordHistoryTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// PasswordHistoryTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type PasswordHistoryTemplate struct {
+	ID        func() int64
+	UserID    func() int64
+	Password  func() string
+	CreatedAt func() null.Val[time.Time]
+
+	r passwordHistoryR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type passwordHistoryR struct {
+	User *passwordHistoryRUserR
+}
+
+type passwordHistoryRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the PasswordHistoryTemplate
+func (o *PasswordHistoryTemplate) Apply(ctx context.Context, mods ...PasswordHistoryMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.PasswordHistory
+// according to the relationships in the template. Nothing is inserted into the db
+func (t PasswordHistoryTemplate) setModelRels(o *models.PasswordHistory) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.PasswordHistories = append(rel.R.PasswordHistories, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.PasswordHistorySetter
+// this does nothing with the relationship templates
+func (o PasswordHistoryTemplate) BuildSetter() *models.PasswordHistorySetter {
+	m := &models.PasswordHistorySetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.Password != nil {
+		val := o.Password()
+		m.Password = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.PasswordHistorySetter
+// this does nothing with the relationship templates
+func (o PasswordHistoryTemplate) BuildManySetter(number int) []*models.PasswordHistorySetter {
+	m := make([]*models.PasswordHistorySetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.PasswordHistory
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use PasswordHistoryTemplate.Create
+func (o PasswordHistoryTemplate) Build() *models.PasswordHistory {
+	m := &models.PasswordHistory{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.Password != nil {
+		m.Password = o.Password()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.PasswordHistorySlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use PasswordHistoryTemplate.CreateMany
+func (o PasswordHistoryTemplate) BuildMany(number int) models.PasswordHistorySlice {
+	m := make(models.PasswordHistorySlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatablePasswordHistory(m *models.PasswordHistorySetter) {
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
+	if !(m.Password.IsValue()) {
+		val := random_string(nil, "255")
+		m.Password = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.PasswordHistory
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *PasswordHistoryTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.PasswordHistory) error {
+	var err error
+
+	return err
+}
+
+// Create builds a passwordHistory and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *PasswordHistoryTemplate) Create(ctx context.Context, exec bob.Executor) (*models.PasswordHistory, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatablePasswordHistory(opt)
+
+	if o.r.User == nil {
+		PasswordHistoryMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel0 = o.r.User.o.Build()
+	} else {
+		rel0, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel0.ID)
+
+	m, err := models.PasswordHistories.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a passwordHistory and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *PasswordHistoryTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.PasswordHistory {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a passwordHistory and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *PasswordHistoryTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.PasswordHistory {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple passwordHistories and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o PasswordHistoryTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.PasswordHistorySlice, error) {
+	var err error
+	m := make(models.PasswordHistorySlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple passwordHistories and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o PasswordHistoryTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.PasswordHistorySlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple passwordHistories and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o PasswordHistoryTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.PasswordHistorySlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// PasswordHistory has methods that act as mods for the PasswordHistoryTemplate
+var PasswordHistoryMods passwordHistoryMods
+
+type passwordHistoryMods struct{}
+
+func (m passwordHistoryMods) RandomizeAllColumns(f *faker.Faker) PasswordHistoryMod {
+	return PasswordHistoryModSlice{
+		PasswordHistoryMods.RandomID(f),
+		PasswordHistoryMods.RandomUserID(f),
+		PasswordHistoryMods.RandomPassword(f),
+		PasswordHistoryMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m passwordHistoryMods) ID(val int64) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m passwordHistoryMods) IDFunc(f func() int64) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m passwordHistoryMods) UnsetID() PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m passwordHistoryMods) RandomID(f *faker.Faker) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m passwordHistoryMods) UserID(val int64) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m passwordHistoryMods) UserIDFunc(f func() int64) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m passwordHistoryMods) UnsetUserID() PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m passwordHistoryMods) RandomUserID(f *faker.Faker) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m passwordHistoryMods) Password(val string) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.Password = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m passwordHistoryMods) PasswordFunc(f func() string) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.Password = f
+	})
+}
+
+// Clear any values for the column
+func (m passwordHistoryMods) UnsetPassword() PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.Password = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m passwordHistoryMods) RandomPassword(f *faker.Faker) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.Password = func() string {
+			return random_string(f, "255")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m passwordHistoryMods) CreatedAt(val null.Val[time.Time]) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m passwordHistoryMods) CreatedAtFunc(f func() null.Val[time.Time]) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m passwordHistoryMods) UnsetCreatedAt() PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m passwordHistoryMods) RandomCreatedAt(f *faker.Faker) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m passwordHistoryMods) RandomCreatedAtNotNull(f *faker.Faker) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(_ context.Context, o *PasswordHistoryTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m passwordHistoryMods) WithParentsCascading() PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(ctx context.Context, o *PasswordHistoryTemplate) {
+		if isDone, _ := passwordHistoryWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = passwordHistoryWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m passwordHistoryMods) WithUser(rel *UserTemplate) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(ctx context.Context, o *PasswordHistoryTemplate) {
+		o.r.User = &passwordHistoryRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m passwordHistoryMods) WithNewUser(mods ...UserMod) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(ctx context.Context, o *PasswordHistoryTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m passwordHistoryMods) WithExistingUser(em *models.User) PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(ctx context.Context, o *PasswordHistoryTemplate) {
+		o.r.User = &passwordHistoryRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m passwordHistoryMods) WithoutUser() PasswordHistoryMod {
+	return PasswordHistoryModFunc(func(ctx context.Context, o *PasswordHistoryTemplate) {
+		o.r.User = nil
+	})
+}
@@ -0,0 +1,512 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type PermissionMod interface {
+	Apply(context.Context, *PermissionTemplate)
+}
+
+type PermissionModFunc func(context.Context, *PermissionTemplate)
+
+func (f PermissionModFunc) Apply(ctx context.Context, n *PermissionTemplate) {
+	f(ctx, n)
+}
+
+type PermissionModSlice []PermissionMod
+
+func (mods PermissionModSlice) Apply(ctx context.Context, n *PermissionTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// PermissionTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type PermissionTemplate struct {
+	ID          func() int64
+	Name        func() string
+	Description func() null.Val[string]
+	CreatedAt   func() null.Val[time.Time]
+
+	r permissionR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type permissionR struct {
+	Roles []*permissionRRolesR
+}
+
+type permissionRRolesR struct {
+	number int
+	o      *RoleTemplate
+}
+
+// Apply mods to the PermissionTemplate
+func (o *PermissionTemplate) Apply(ctx context.Context, mods ...PermissionMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Permission
+// according to the relationships in the template. Nothing is inserted into the db
+func (t PermissionTemplate) setModelRels(o *models.Permission) {
+	if t.r.Roles != nil {
+		rel := models.RoleSlice{}
+		for _, r := range t.r.Roles {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.R.Permissions = append(rel.R.Permissions, o)
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Roles = rel
+	}
+}
+
+// BuildSetter returns an *models.PermissionSetter
+// this does nothing with the relationship templates
+func (o PermissionTemplate) BuildSetter() *models.PermissionSetter {
+	m := &models.PermissionSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.Name != nil {
+		val := o.Name()
+		m.Name = omit.From(val)
+	}
+	if o.Description != nil {
+		val := o.Description()
+		m.Description = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.PermissionSetter
+// this does nothing with the relationship templates
+func (o PermissionTemplate) BuildManySetter(number int) []*models.PermissionSetter {
+	m := make([]*models.PermissionSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Permission
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use PermissionTemplate.Create
+func (o PermissionTemplate) Build() *models.Permission {
+	m := &models.Permission{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.Description != nil {
+		m.Description = o.Description()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.PermissionSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use PermissionTemplate.CreateMany
+func (o PermissionTemplate) BuildMany(number int) models.PermissionSlice {
+	m := make(models.PermissionSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatablePermission(m *models.PermissionSetter) {
+	if !(m.Name.IsValue()) {
+		val := random_string(nil, "100")
+		m.Name = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Permission
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *PermissionTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Permission) error {
+	var err error
+
+	isRolesDone, _ := permissionRelRolesCtx.Value(ctx)
+	if !isRolesDone && o.r.Roles != nil {
+		ctx = permissionRelRolesCtx.WithValue(ctx, true)
+		for _, r := range o.r.Roles {
+			if r.o.alreadyPersisted {
+				m.R.Roles = append(m.R.Roles, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachRoles(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a permission and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *PermissionTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Permission, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatablePermission(opt)
+
+	m, err := models.Permissions.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a permission and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *PermissionTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Permission {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a permission and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *PermissionTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Permission {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple permissions and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o PermissionTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.PermissionSlice, error) {
+	var err error
+	m := make(models.PermissionSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple permissions and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o PermissionTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.PermissionSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple permissions and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o PermissionTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.PermissionSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Permission has methods that act as mods for the PermissionTemplate
+var PermissionMods permissionMods
+
+type permissionMods struct{}
+
+func (m permissionMods) RandomizeAllColumns(f *faker.Faker) PermissionMod {
+	return PermissionModSlice{
+		PermissionMods.RandomID(f),
+		PermissionMods.RandomName(f),
+		PermissionMods.RandomDescription(f),
+		PermissionMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m permissionMods) ID(val int64) PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m permissionMods) IDFunc(f func() int64) PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m permissionMods) UnsetID() PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m permissionMods) RandomID(f *faker.Faker) PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m permissionMods) Name(val string) PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.Name = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m permissionMods) NameFunc(f func() string) PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m permissionMods) UnsetName() PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m permissionMods) RandomName(f *faker.Faker) PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.Name = func() string {
+			return random_string(f, "100")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m permissionMods) Description(val null.Val[string]) PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.Description = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m permissionMods) DescriptionFunc(f func() null.Val[string]) PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.Description = f
+	})
+}
+
+// Clear any values for the column
+func (m permissionMods) UnsetDescription() PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.Description = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m permissionMods) RandomDescription(f *faker.Faker) PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.Description = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "255")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m permissionMods) RandomDescriptionNotNull(f *faker.Faker) PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.Description = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "255")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m permissionMods) CreatedAt(val null.Val[time.Time]) PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m permissionMods) CreatedAtFunc(f func() null.Val[time.Time]) PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m permissionMods) UnsetCreatedAt() PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m permissionMods) RandomCreatedAt(f *faker.Faker) PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m permissionMods) RandomCreatedAtNotNull(f *faker.Faker) PermissionMod {
+	return PermissionModFunc(func(_ context.Context, o *PermissionTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m permissionMods) WithParentsCascading() PermissionMod {
+	return PermissionModFunc(func(ctx context.Context, o *PermissionTemplate) {
+		if isDone, _ := permissionWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = permissionWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
+
+func (m permissionMods) WithRoles(number int, related *RoleTemplate) PermissionMod {
+	return PermissionModFunc(func(ctx context.Context, o *PermissionTemplate) {
+		o.r.Roles = []*permissionRRolesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m permissionMods) WithNewRoles(number int, mods ...RoleMod) PermissionMod {
+	return PermissionModFunc(func(ctx context.Context, o *PermissionTemplate) {
+		related := o.f.NewRoleWithContext(ctx, mods...)
+		m.WithRoles(number, related).Apply(ctx, o)
+	})
+}
+
+func (m permissionMods) AddRoles(number int, related *RoleTemplate) PermissionMod {
+	return PermissionModFunc(func(ctx context.Context, o *PermissionTemplate) {
+		o.r.Roles = append(o.r.Roles, &permissionRRolesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m permissionMods) AddNewRoles(number int, mods ...RoleMod) PermissionMod {
+	return PermissionModFunc(func(ctx context.Context, o *PermissionTemplate) {
+		related := o.f.NewRoleWithContext(ctx, mods...)
+		m.AddRoles(number, related).Apply(ctx, o)
+	})
+}
+
+func (m permissionMods) AddExistingRoles(existingModels ...*models.Role) PermissionMod {
+	return PermissionModFunc(func(ctx context.Context, o *PermissionTemplate) {
+		for _, em := range existingModels {
+			o.r.Roles = append(o.r.Roles, &permissionRRolesR{
+				o: o.f.FromExistingRole(em),
+			})
+		}
+	})
+}
+
+func (m permissionMods) WithoutRoles() PermissionMod {
+	return PermissionModFunc(func(ctx context.Context, o *PermissionTemplate) {
+		o.r.Roles = nil
+	})
+}
@@ -0,0 +1,431 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type RolePermissionMod interface {
+	Apply(context.Context, *RolePermissionTemplate)
+}
+
+type RolePermissionModFunc func(context.Context, *RolePermissionTemplate)
+
+func (f RolePermissionModFunc) Apply(ctx context.Context, n *RolePermissionTemplate) {
+	f(ctx, n)
+}
+
+type RolePermissionModSlice []RolePermissionMod
+
+func (mods RolePermissionModSlice) Apply(ctx context.Context, n *RolePermissionTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// RolePermissionTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type RolePermissionTemplate struct {
+	RoleID       func() int64
+	PermissionID func() int64
+
+	r rolePermissionR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type rolePermissionR struct {
+	Permission *rolePermissionRPermissionR
+	Role       *rolePermissionRRoleR
+}
+
+type rolePermissionRPermissionR struct {
+	o *PermissionTemplate
+}
+type rolePermissionRRoleR struct {
+	o *RoleTemplate
+}
+
+// Apply mods to the RolePermissionTemplate
+func (o *RolePermissionTemplate) Apply(ctx context.Context, mods ...RolePermissionMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.RolePermission
+// according to the relationships in the template. Nothing is inserted into the db
+func (t RolePermissionTemplate) setModelRels(o *models.RolePermission) {
+	if t.r.Permission != nil {
+		rel := t.r.Permission.o.Build()
+		o.PermissionID = rel.ID // h2
+		o.R.Permission = rel
+	}
+
+	if t.r.Role != nil {
+		rel := t.r.Role.o.Build()
+		o.RoleID = rel.ID // h2
+		o.R.Role = rel
+	}
+}
+
+// BuildSetter returns an *models.RolePermissionSetter
+// this does nothing with the relationship templates
+func (o RolePermissionTemplate) BuildSetter() *models.RolePermissionSetter {
+	m := &models.RolePermissionSetter{}
+
+	if o.RoleID != nil {
+		val := o.RoleID()
+		m.RoleID = omit.From(val)
+	}
+	if o.PermissionID != nil {
+		val := o.PermissionID()
+		m.PermissionID = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.RolePermissionSetter
+// this does nothing with the relationship templates
+func (o RolePermissionTemplate) BuildManySetter(number int) []*models.RolePermissionSetter {
+	m := make([]*models.RolePermissionSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.RolePermission
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use RolePermissionTemplate.Create
+func (o RolePermissionTemplate) Build() *models.RolePermission {
+	m := &models.RolePermission{}
+
+	if o.RoleID != nil {
+		m.RoleID = o.RoleID()
+	}
+	if o.PermissionID != nil {
+		m.PermissionID = o.PermissionID()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.RolePermissionSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use RolePermissionTemplate.CreateMany
+func (o RolePermissionTemplate) BuildMany(number int) models.RolePermissionSlice {
+	m := make(models.RolePermissionSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableRolePermission(m *models.RolePermissionSetter) {
+	if !(m.RoleID.IsValue()) {
+		val := random_int64(nil)
+		m.RoleID = omit.From(val)
+	}
+	if !(m.PermissionID.IsValue()) {
+		val := random_int64(nil)
+		m.PermissionID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.RolePermission
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *RolePermissionTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.RolePermission) error {
+	var err error
+
+	return err
+}
+
+// Create builds a rolePermission and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *RolePermissionTemplate) Create(ctx context.Context, exec bob.Executor) (*models.RolePermission, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableRolePermission(opt)
+
+	if o.r.Permission == nil {
+		RolePermissionMods.WithNewPermission().Apply(ctx, o)
+	}
+
+	var rel0 *models.Permission
+
+	if o.r.Permission.o.alreadyPersisted {
+		rel0 = o.r.Permission.o.Build()
+	} else {
+		rel0, err = o.r.Permission.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.PermissionID = omit.From(rel0.ID)
+
+	if o.r.Role == nil {
+		RolePermissionMods.WithNewRole().Apply(ctx, o)
+	}
+
+	var rel1 *models.Role
+
+	if o.r.Role.o.alreadyPersisted {
+		rel1 = o.r.Role.o.Build()
+	} else {
+		rel1, err = o.r.Role.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.RoleID = omit.From(rel1.ID)
+
+	m, err := models.RolePermissions.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Permission = rel0
+	m.R.Role = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a rolePermission and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *RolePermissionTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.RolePermission {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a rolePermission and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *RolePermissionTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.RolePermission {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple rolePermissions and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o RolePermissionTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.RolePermissionSlice, error) {
+	var err error
+	m := make(models.RolePermissionSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple rolePermissions and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o RolePermissionTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.RolePermissionSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple rolePermissions and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o RolePermissionTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.RolePermissionSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// RolePermission has methods that act as mods for the RolePermissionTemplate
+var RolePermissionMods rolePermissionMods
+
+type rolePermissionMods struct{}
+
+func (m rolePermissionMods) RandomizeAllColumns(f *faker.Faker) RolePermissionMod {
+	return RolePermissionModSlice{
+		RolePermissionMods.RandomRoleID(f),
+		RolePermissionMods.RandomPermissionID(f),
+	}
+}
+
+// Set the model columns to this value
+func (m rolePermissionMods) RoleID(val int64) RolePermissionMod {
+	return RolePermissionModFunc(func(_ context.Context, o *RolePermissionTemplate) {
+		o.RoleID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m rolePermissionMods) RoleIDFunc(f func() int64) RolePermissionMod {
+	return RolePermissionModFunc(func(_ context.Context, o *RolePermissionTemplate) {
+		o.RoleID = f
+	})
+}
+
+// Clear any values for the column
+func (m rolePermissionMods) UnsetRoleID() RolePermissionMod {
+	return RolePermissionModFunc(func(_ context.Context, o *RolePermissionTemplate) {
+		o.RoleID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m rolePermissionMods) RandomRoleID(f *faker.Faker) RolePermissionMod {
+	return RolePermissionModFunc(func(_ context.Context, o *RolePermissionTemplate) {
+		o.RoleID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m rolePermissionMods) PermissionID(val int64) RolePermissionMod {
+	return RolePermissionModFunc(func(_ context.Context, o *RolePermissionTemplate) {
+		o.PermissionID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m rolePermissionMods) PermissionIDFunc(f func() int64) RolePermissionMod {
+	return RolePermissionModFunc(func(_ context.Context, o *RolePermissionTemplate) {
+		o.PermissionID = f
+	})
+}
+
+// Clear any values for the column
+func (m rolePermissionMods) UnsetPermissionID() RolePermissionMod {
+	return RolePermissionModFunc(func(_ context.Context, o *RolePermissionTemplate) {
+		o.PermissionID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m rolePermissionMods) RandomPermissionID(f *faker.Faker) RolePermissionMod {
+	return RolePermissionModFunc(func(_ context.Context, o *RolePermissionTemplate) {
+		o.PermissionID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+func (m rolePermissionMods) WithParentsCascading() RolePermissionMod {
+	return RolePermissionModFunc(func(ctx context.Context, o *RolePermissionTemplate) {
+		if isDone, _ := rolePermissionWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = rolePermissionWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewPermissionWithContext(ctx, PermissionMods.WithParentsCascading())
+			m.WithPermission(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewRoleWithContext(ctx, RoleMods.WithParentsCascading())
+			m.WithRole(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m rolePermissionMods) WithPermission(rel *PermissionTemplate) RolePermissionMod {
+	return RolePermissionModFunc(func(ctx context.Context, o *RolePermissionTemplate) {
+		o.r.Permission = &rolePermissionRPermissionR{
+			o: rel,
+		}
+	})
+}
+
+func (m rolePermissionMods) WithNewPermission(mods ...PermissionMod) RolePermissionMod {
+	return RolePermissionModFunc(func(ctx context.Context, o *RolePermissionTemplate) {
+		related := o.f.NewPermissionWithContext(ctx, mods...)
+
+		m.WithPermission(related).Apply(ctx, o)
+	})
+}
+
+func (m rolePermissionMods) WithExistingPermission(em *models.Permission) RolePermissionMod {
+	return RolePermissionModFunc(func(ctx context.Context, o *RolePermissionTemplate) {
+		o.r.Permission = &rolePermissionRPermissionR{
+			o: o.f.FromExistingPermission(em),
+		}
+	})
+}
+
+func (m rolePermissionMods) WithoutPermission() RolePermissionMod {
+	return RolePermissionModFunc(func(ctx context.Context, o *RolePermissionTemplate) {
+		o.r.Permission = nil
+	})
+}
+
+func (m rolePermissionMods) WithRole(rel *RoleTemplate) RolePermissionMod {
+	return RolePermissionModFunc(func(ctx context.Context, o *RolePermissionTemplate) {
+		o.r.Role = &rolePermissionRRoleR{
+			o: rel,
+		}
+	})
+}
+
+func (m rolePermissionMods) WithNewRole(mods ...RoleMod) RolePermissionMod {
+	return RolePermissionModFunc(func(ctx context.Context, o *RolePermissionTemplate) {
+		related := o.f.NewRoleWithContext(ctx, mods...)
+
+		m.WithRole(related).Apply(ctx, o)
+	})
+}
+
+func (m rolePermissionMods) WithExistingRole(em *models.Role) RolePermissionMod {
+	return RolePermissionModFunc(func(ctx context.Context, o *RolePermissionTemplate) {
+		o.r.Role = &rolePermissionRRoleR{
+			o: o.f.FromExistingRole(em),
+		}
+	})
+}
+
+func (m rolePermissionMods) WithoutRole() RolePermissionMod {
+	return RolePermissionModFunc(func(ctx context.Context, o *RolePermissionTemplate) {
+		o.r.Role = nil
+	})
+}
@@ -0,0 +1,745 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type RoleMod interface {
+	Apply(context.Context, *RoleTemplate)
+}
+
+type RoleModFunc func(context.Context, *RoleTemplate)
+
+func (f RoleModFunc) Apply(ctx context.Context, n *RoleTemplate) {
+	f(ctx, n)
+}
+
+type RoleModSlice []RoleMod
+
+func (mods RoleModSlice) Apply(ctx context.Context, n *RoleTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// RoleTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type RoleTemplate struct {
+	ID          func() int64
+	Name        func() string
+	Description func() null.Val[string]
+	CreatedAt   func() null.Val[time.Time]
+	UpdatedAt   func() null.Val[time.Time]
+
+	r roleR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type roleR struct {
+	Invitations []*roleRInvitationsR
+	Permissions []*roleRPermissionsR
+	Users       []*roleRUsersR
+}
+
+type roleRInvitationsR struct {
+	number int
+	o      *InvitationTemplate
+}
+type roleRPermissionsR struct {
+	number int
+	o      *PermissionTemplate
+}
+type roleRUsersR struct {
+	number int
+	o      *UserTemplate
+}
+
+// Apply mods to the RoleTemplate
+func (o *RoleTemplate) Apply(ctx context.Context, mods ...RoleMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Role
+// according to the relationships in the template. Nothing is inserted into the db
+func (t RoleTemplate) setModelRels(o *models.Role) {
+	if t.r.Invitations != nil {
+		rel := models.InvitationSlice{}
+		for _, r := range t.r.Invitations {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.RoleID = o.ID // h2
+				rel.R.Role = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Invitations = rel
+	}
+
+	if t.r.Permissions != nil {
+		rel := models.PermissionSlice{}
+		for _, r := range t.r.Permissions {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.R.Roles = append(rel.R.Roles, o)
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Permissions = rel
+	}
+
+	if t.r.Users != nil {
+		rel := models.UserSlice{}
+		for _, r := range t.r.Users {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.R.Roles = append(rel.R.Roles, o)
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Users = rel
+	}
+}
+
+// BuildSetter returns an *models.RoleSetter
+// this does nothing with the relationship templates
+func (o RoleTemplate) BuildSetter() *models.RoleSetter {
+	m := &models.RoleSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.Name != nil {
+		val := o.Name()
+		m.Name = omit.From(val)
+	}
+	if o.Description != nil {
+		val := o.Description()
+		m.Description = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+	if o.UpdatedAt != nil {
+		val := o.UpdatedAt()
+		m.UpdatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.RoleSetter
+// this does nothing with the relationship templates
+func (o RoleTemplate) BuildManySetter(number int) []*models.RoleSetter {
+	m := make([]*models.RoleSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Role
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use RoleTemplate.Create
+func (o RoleTemplate) Build() *models.Role {
+	m := &models.Role{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.Description != nil {
+		m.Description = o.Description()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.UpdatedAt != nil {
+		m.UpdatedAt = o.UpdatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.RoleSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use RoleTemplate.CreateMany
+func (o RoleTemplate) BuildMany(number int) models.RoleSlice {
+	m := make(models.RoleSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableRole(m *models.RoleSetter) {
+	if !(m.Name.IsValue()) {
+		val := random_string(nil, "50")
+		m.Name = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Role
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *RoleTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Role) error {
+	var err error
+
+	isInvitationsDone, _ := roleRelInvitationsCtx.Value(ctx)
+	if !isInvitationsDone && o.r.Invitations != nil {
+		ctx = roleRelInvitationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Invitations {
+			if r.o.alreadyPersisted {
+				m.R.Invitations = append(m.R.Invitations, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachInvitations(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isPermissionsDone, _ := roleRelPermissionsCtx.Value(ctx)
+	if !isPermissionsDone && o.r.Permissions != nil {
+		ctx = roleRelPermissionsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Permissions {
+			if r.o.alreadyPersisted {
+				m.R.Permissions = append(m.R.Permissions, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachPermissions(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isUsersDone, _ := roleRelUsersCtx.Value(ctx)
+	if !isUsersDone && o.r.Users != nil {
+		ctx = roleRelUsersCtx.WithValue(ctx, true)
+		for _, r := range o.r.Users {
+			if r.o.alreadyPersisted {
+				m.R.Users = append(m.R.Users, r.o.Build())
+			} else {
+				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachUsers(ctx, exec, rel2...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a role and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *RoleTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Role, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableRole(opt)
+
+	m, err := models.Roles.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a role and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *RoleTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Role {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a role and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *RoleTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Role {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple roles and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o RoleTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.RoleSlice, error) {
+	var err error
+	m := make(models.RoleSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple roles and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o RoleTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.RoleSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple roles and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o RoleTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.RoleSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Role has methods that act as mods for the RoleTemplate
+var RoleMods roleMods
+
+type roleMods struct{}
+
+func (m roleMods) RandomizeAllColumns(f *faker.Faker) RoleMod {
+	return RoleModSlice{
+		RoleMods.RandomID(f),
+		RoleMods.RandomName(f),
+		RoleMods.RandomDescription(f),
+		RoleMods.RandomCreatedAt(f),
+		RoleMods.RandomUpdatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m roleMods) ID(val int64) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m roleMods) IDFunc(f func() int64) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m roleMods) UnsetID() RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m roleMods) RandomID(f *faker.Faker) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m roleMods) Name(val string) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.Name = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m roleMods) NameFunc(f func() string) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m roleMods) UnsetName() RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m roleMods) RandomName(f *faker.Faker) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.Name = func() string {
+			return random_string(f, "50")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m roleMods) Description(val null.Val[string]) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.Description = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m roleMods) DescriptionFunc(f func() null.Val[string]) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.Description = f
+	})
+}
+
+// Clear any values for the column
+func (m roleMods) UnsetDescription() RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.Description = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m roleMods) RandomDescription(f *faker.Faker) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.Description = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "255")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m roleMods) RandomDescriptionNotNull(f *faker.Faker) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.Description = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "255")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m roleMods) CreatedAt(val null.Val[time.Time]) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m roleMods) CreatedAtFunc(f func() null.Val[time.Time]) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m roleMods) UnsetCreatedAt() RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m roleMods) RandomCreatedAt(f *faker.Faker) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m roleMods) RandomCreatedAtNotNull(f *faker.Faker) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m roleMods) UpdatedAt(val null.Val[time.Time]) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m roleMods) UpdatedAtFunc(f func() null.Val[time.Time]) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.UpdatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m roleMods) UnsetUpdatedAt() RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.UpdatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m roleMods) RandomUpdatedAt(f *faker.Faker) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m roleMods) RandomUpdatedAtNotNull(f *faker.Faker) RoleMod {
+	return RoleModFunc(func(_ context.Context, o *RoleTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m roleMods) WithParentsCascading() RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		if isDone, _ := roleWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = roleWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
+
+func (m roleMods) WithInvitations(number int, related *InvitationTemplate) RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		o.r.Invitations = []*roleRInvitationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m roleMods) WithNewInvitations(number int, mods ...InvitationMod) RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		related := o.f.NewInvitationWithContext(ctx, mods...)
+		m.WithInvitations(number, related).Apply(ctx, o)
+	})
+}
+
+func (m roleMods) AddInvitations(number int, related *InvitationTemplate) RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		o.r.Invitations = append(o.r.Invitations, &roleRInvitationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m roleMods) AddNewInvitations(number int, mods ...InvitationMod) RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		related := o.f.NewInvitationWithContext(ctx, mods...)
+		m.AddInvitations(number, related).Apply(ctx, o)
+	})
+}
+
+func (m roleMods) AddExistingInvitations(existingModels ...*models.Invitation) RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		for _, em := range existingModels {
+			o.r.Invitations = append(o.r.Invitations, &roleRInvitationsR{
+				o: o.f.FromExistingInvitation(em),
+			})
+		}
+	})
+}
+
+func (m roleMods) WithoutInvitations() RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		o.r.Invitations = nil
+	})
+}
+
+func (m roleMods) WithPermissions(number int, related *PermissionTemplate) RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		o.r.Permissions = []*roleRPermissionsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m roleMods) WithNewPermissions(number int, mods ...PermissionMod) RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		related := o.f.NewPermissionWithContext(ctx, mods...)
+		m.WithPermissions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m roleMods) AddPermissions(number int, related *PermissionTemplate) RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		o.r.Permissions = append(o.r.Permissions, &roleRPermissionsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m roleMods) AddNewPermissions(number int, mods ...PermissionMod) RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		related := o.f.NewPermissionWithContext(ctx, mods...)
+		m.AddPermissions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m roleMods) AddExistingPermissions(existingModels ...*models.Permission) RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		for _, em := range existingModels {
+			o.r.Permissions = append(o.r.Permissions, &roleRPermissionsR{
+				o: o.f.FromExistingPermission(em),
+			})
+		}
+	})
+}
+
+func (m roleMods) WithoutPermissions() RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		o.r.Permissions = nil
+	})
+}
+
+func (m roleMods) WithUsers(number int, related *UserTemplate) RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		o.r.Users = []*roleRUsersR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m roleMods) WithNewUsers(number int, mods ...UserMod) RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+		m.WithUsers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m roleMods) AddUsers(number int, related *UserTemplate) RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		o.r.Users = append(o.r.Users, &roleRUsersR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m roleMods) AddNewUsers(number int, mods ...UserMod) RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+		m.AddUsers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m roleMods) AddExistingUsers(existingModels ...*models.User) RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		for _, em := range existingModels {
+			o.r.Users = append(o.r.Users, &roleRUsersR{
+				o: o.f.FromExistingUser(em),
+			})
+		}
+	})
+}
+
+func (m roleMods) WithoutUsers() RoleMod {
+	return RoleModFunc(func(ctx context.Context, o *RoleTemplate) {
+		o.r.Users = nil
+	})
+}
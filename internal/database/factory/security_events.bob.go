@@ -0,0 +1,683 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/gofrs/uuid/v5"
+	enums "github.com/jacoobjake/einvoice-api/internal/database/enums"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/types"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+type SecurityEventMod interface {
+	Apply(context.Context, *SecurityEventTemplate)
+}
+
+type SecurityEventModFunc func(context.Context, *SecurityEventTemplate)
+
+func (f SecurityEventModFunc) Apply(ctx context.Context, n *SecurityEventTemplate) {
+	f(ctx, n)
+}
+
+type SecurityEventModSlice []SecurityEventMod
+
+func (mods SecurityEventModSlice) Apply(ctx context.Context, n *SecurityEventTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// SecurityEventTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type SecurityEventTemplate struct {
+	ID        func() int64
+	UserID    func() null.Val[int64]
+	SessionID func() null.Val[uuid.UUID]
+	Type      func() enums.SecurityEventTypes
+	IPAddress func() null.Val[pgtypes.Inet]
+	Details   func() null.Val[types.JSON[json.RawMessage]]
+	CreatedAt func() null.Val[time.Time]
+
+	r securityEventR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type securityEventR struct {
+	User *securityEventRUserR
+}
+
+type securityEventRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the SecurityEventTemplate
+func (o *SecurityEventTemplate) Apply(ctx context.Context, mods ...SecurityEventMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.SecurityEvent
+// according to the relationships in the template. Nothing is inserted into the db
+func (t SecurityEventTemplate) setModelRels(o *models.SecurityEvent) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.SecurityEvents = append(rel.R.SecurityEvents, o)
+		o.UserID = null.From(rel.ID) // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.SecurityEventSetter
+// this does nothing with the relationship templates
+func (o SecurityEventTemplate) BuildSetter() *models.SecurityEventSetter {
+	m := &models.SecurityEventSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omitnull.FromNull(val)
+	}
+	if o.SessionID != nil {
+		val := o.SessionID()
+		m.SessionID = omitnull.FromNull(val)
+	}
+	if o.Type != nil {
+		val := o.Type()
+		m.Type = omit.From(val)
+	}
+	if o.IPAddress != nil {
+		val := o.IPAddress()
+		m.IPAddress = omitnull.FromNull(val)
+	}
+	if o.Details != nil {
+		val := o.Details()
+		m.Details = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.SecurityEventSetter
+// this does nothing with the relationship templates
+func (o SecurityEventTemplate) BuildManySetter(number int) []*models.SecurityEventSetter {
+	m := make([]*models.SecurityEventSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.SecurityEvent
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use SecurityEventTemplate.Create
+func (o SecurityEventTemplate) Build() *models.SecurityEvent {
+	m := &models.SecurityEvent{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.SessionID != nil {
+		m.SessionID = o.SessionID()
+	}
+	if o.Type != nil {
+		m.Type = o.Type()
+	}
+	if o.IPAddress != nil {
+		m.IPAddress = o.IPAddress()
+	}
+	if o.Details != nil {
+		m.Details = o.Details()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.SecurityEventSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use SecurityEventTemplate.CreateMany
+func (o SecurityEventTemplate) BuildMany(number int) models.SecurityEventSlice {
+	m := make(models.SecurityEventSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableSecurityEvent(m *models.SecurityEventSetter) {
+	if !(m.Type.IsValue()) {
+		val := random_enums_SecurityEventTypes(nil)
+		m.Type = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.SecurityEvent
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *SecurityEventTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.SecurityEvent) error {
+	var err error
+
+	isUserDone, _ := securityEventRelUserCtx.Value(ctx)
+	if !isUserDone && o.r.User != nil {
+		ctx = securityEventRelUserCtx.WithValue(ctx, true)
+		if o.r.User.o.alreadyPersisted {
+			m.R.User = o.r.User.o.Build()
+		} else {
+			var rel0 *models.User
+			rel0, err = o.r.User.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachUser(ctx, exec, rel0)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return err
+}
+
+// Create builds a securityEvent and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *SecurityEventTemplate) Create(ctx context.Context, exec bob.Executor) (*models.SecurityEvent, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableSecurityEvent(opt)
+
+	m, err := models.SecurityEvents.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a securityEvent and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *SecurityEventTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.SecurityEvent {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a securityEvent and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *SecurityEventTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.SecurityEvent {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple securityEvents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o SecurityEventTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.SecurityEventSlice, error) {
+	var err error
+	m := make(models.SecurityEventSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple securityEvents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o SecurityEventTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.SecurityEventSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple securityEvents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o SecurityEventTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.SecurityEventSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// SecurityEvent has methods that act as mods for the SecurityEventTemplate
+var SecurityEventMods securityEventMods
+
+type securityEventMods struct{}
+
+func (m securityEventMods) RandomizeAllColumns(f *faker.Faker) SecurityEventMod {
+	return SecurityEventModSlice{
+		SecurityEventMods.RandomID(f),
+		SecurityEventMods.RandomUserID(f),
+		SecurityEventMods.RandomSessionID(f),
+		SecurityEventMods.RandomType(f),
+		SecurityEventMods.RandomIPAddress(f),
+		SecurityEventMods.RandomDetails(f),
+		SecurityEventMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m securityEventMods) ID(val int64) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m securityEventMods) IDFunc(f func() int64) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m securityEventMods) UnsetID() SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m securityEventMods) RandomID(f *faker.Faker) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m securityEventMods) UserID(val null.Val[int64]) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.UserID = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m securityEventMods) UserIDFunc(f func() null.Val[int64]) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m securityEventMods) UnsetUserID() SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m securityEventMods) RandomUserID(f *faker.Faker) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.UserID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m securityEventMods) RandomUserIDNotNull(f *faker.Faker) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.UserID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m securityEventMods) SessionID(val null.Val[uuid.UUID]) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.SessionID = func() null.Val[uuid.UUID] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m securityEventMods) SessionIDFunc(f func() null.Val[uuid.UUID]) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.SessionID = f
+	})
+}
+
+// Clear any values for the column
+func (m securityEventMods) UnsetSessionID() SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.SessionID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m securityEventMods) RandomSessionID(f *faker.Faker) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.SessionID = func() null.Val[uuid.UUID] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_uuid_UUID(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m securityEventMods) RandomSessionIDNotNull(f *faker.Faker) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.SessionID = func() null.Val[uuid.UUID] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_uuid_UUID(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m securityEventMods) Type(val enums.SecurityEventTypes) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.Type = func() enums.SecurityEventTypes { return val }
+	})
+}
+
+// Set the Column from the function
+func (m securityEventMods) TypeFunc(f func() enums.SecurityEventTypes) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.Type = f
+	})
+}
+
+// Clear any values for the column
+func (m securityEventMods) UnsetType() SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.Type = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m securityEventMods) RandomType(f *faker.Faker) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.Type = func() enums.SecurityEventTypes {
+			return random_enums_SecurityEventTypes(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m securityEventMods) IPAddress(val null.Val[pgtypes.Inet]) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.IPAddress = func() null.Val[pgtypes.Inet] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m securityEventMods) IPAddressFunc(f func() null.Val[pgtypes.Inet]) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.IPAddress = f
+	})
+}
+
+// Clear any values for the column
+func (m securityEventMods) UnsetIPAddress() SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.IPAddress = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m securityEventMods) RandomIPAddress(f *faker.Faker) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.IPAddress = func() null.Val[pgtypes.Inet] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_pgtypes_Inet(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m securityEventMods) RandomIPAddressNotNull(f *faker.Faker) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.IPAddress = func() null.Val[pgtypes.Inet] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_pgtypes_Inet(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m securityEventMods) Details(val null.Val[types.JSON[json.RawMessage]]) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.Details = func() null.Val[types.JSON[json.RawMessage]] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m securityEventMods) DetailsFunc(f func() null.Val[types.JSON[json.RawMessage]]) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.Details = f
+	})
+}
+
+// Clear any values for the column
+func (m securityEventMods) UnsetDetails() SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.Details = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m securityEventMods) RandomDetails(f *faker.Faker) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.Details = func() null.Val[types.JSON[json.RawMessage]] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_types_JSON_json_RawMessage_(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m securityEventMods) RandomDetailsNotNull(f *faker.Faker) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.Details = func() null.Val[types.JSON[json.RawMessage]] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_types_JSON_json_RawMessage_(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m securityEventMods) CreatedAt(val null.Val[time.Time]) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m securityEventMods) CreatedAtFunc(f func() null.Val[time.Time]) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m securityEventMods) UnsetCreatedAt() SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m securityEventMods) RandomCreatedAt(f *faker.Faker) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m securityEventMods) RandomCreatedAtNotNull(f *faker.Faker) SecurityEventMod {
+	return SecurityEventModFunc(func(_ context.Context, o *SecurityEventTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m securityEventMods) WithParentsCascading() SecurityEventMod {
+	return SecurityEventModFunc(func(ctx context.Context, o *SecurityEventTemplate) {
+		if isDone, _ := securityEventWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = securityEventWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m securityEventMods) WithUser(rel *UserTemplate) SecurityEventMod {
+	return SecurityEventModFunc(func(ctx context.Context, o *SecurityEventTemplate) {
+		o.r.User = &securityEventRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m securityEventMods) WithNewUser(mods ...UserMod) SecurityEventMod {
+	return SecurityEventModFunc(func(ctx context.Context, o *SecurityEventTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m securityEventMods) WithExistingUser(em *models.User) SecurityEventMod {
+	return SecurityEventModFunc(func(ctx context.Context, o *SecurityEventTemplate) {
+		o.r.User = &securityEventRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m securityEventMods) WithoutUser() SecurityEventMod {
+	return SecurityEventModFunc(func(ctx context.Context, o *SecurityEventTemplate) {
+		o.r.User = nil
+	})
+}
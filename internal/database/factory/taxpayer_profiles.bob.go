@@ -0,0 +1,1301 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	enums "github.com/jacoobjake/einvoice-api/internal/database/enums"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type TaxpayerProfileMod interface {
+	Apply(context.Context, *TaxpayerProfileTemplate)
+}
+
+type TaxpayerProfileModFunc func(context.Context, *TaxpayerProfileTemplate)
+
+func (f TaxpayerProfileModFunc) Apply(ctx context.Context, n *TaxpayerProfileTemplate) {
+	f(ctx, n)
+}
+
+type TaxpayerProfileModSlice []TaxpayerProfileMod
+
+func (mods TaxpayerProfileModSlice) Apply(ctx context.Context, n *TaxpayerProfileTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// TaxpayerProfileTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type TaxpayerProfileTemplate struct {
+	ID                           func() int64
+	OrganisationID               func() int64
+	Name                         func() string
+	Tin                          func() string
+	IDType                       func() enums.TaxpayerIDTypes
+	IDValue                      func() string
+	SSTRegistrationNumber        func() string
+	TourismTaxRegistrationNumber func() string
+	MsicCode                     func() string
+	BusinessActivityDescription  func() string
+	AddressLine1                 func() string
+	AddressLine2                 func() null.Val[string]
+	AddressLine3                 func() null.Val[string]
+	PostalZone                   func() null.Val[string]
+	CityName                     func() string
+	StateCode                    func() string
+	CountryCode                  func() string
+	Phone                        func() string
+	Email                        func() null.Val[string]
+	CreatedAt                    func() null.Val[time.Time]
+	UpdatedAt                    func() null.Val[time.Time]
+
+	r taxpayerProfileR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type taxpayerProfileR struct {
+	Organisation *taxpayerProfileROrganisationR
+}
+
+type taxpayerProfileROrganisationR struct {
+	o *OrganisationTemplate
+}
+
+// Apply mods to the TaxpayerProfileTemplate
+func (o *TaxpayerProfileTemplate) Apply(ctx context.Context, mods ...TaxpayerProfileMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.TaxpayerProfile
+// according to the relationships in the template. Nothing is inserted into the db
+func (t TaxpayerProfileTemplate) setModelRels(o *models.TaxpayerProfile) {
+	if t.r.Organisation != nil {
+		rel := t.r.Organisation.o.Build()
+		rel.R.TaxpayerProfile = o
+		o.OrganisationID = rel.ID // h2
+		o.R.Organisation = rel
+	}
+}
+
+// BuildSetter returns an *models.TaxpayerProfileSetter
+// this does nothing with the relationship templates
+func (o TaxpayerProfileTemplate) BuildSetter() *models.TaxpayerProfileSetter {
+	m := &models.TaxpayerProfileSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.OrganisationID != nil {
+		val := o.OrganisationID()
+		m.OrganisationID = omit.From(val)
+	}
+	if o.Name != nil {
+		val := o.Name()
+		m.Name = omit.From(val)
+	}
+	if o.Tin != nil {
+		val := o.Tin()
+		m.Tin = omit.From(val)
+	}
+	if o.IDType != nil {
+		val := o.IDType()
+		m.IDType = omit.From(val)
+	}
+	if o.IDValue != nil {
+		val := o.IDValue()
+		m.IDValue = omit.From(val)
+	}
+	if o.SSTRegistrationNumber != nil {
+		val := o.SSTRegistrationNumber()
+		m.SSTRegistrationNumber = omit.From(val)
+	}
+	if o.TourismTaxRegistrationNumber != nil {
+		val := o.TourismTaxRegistrationNumber()
+		m.TourismTaxRegistrationNumber = omit.From(val)
+	}
+	if o.MsicCode != nil {
+		val := o.MsicCode()
+		m.MsicCode = omit.From(val)
+	}
+	if o.BusinessActivityDescription != nil {
+		val := o.BusinessActivityDescription()
+		m.BusinessActivityDescription = omit.From(val)
+	}
+	if o.AddressLine1 != nil {
+		val := o.AddressLine1()
+		m.AddressLine1 = omit.From(val)
+	}
+	if o.AddressLine2 != nil {
+		val := o.AddressLine2()
+		m.AddressLine2 = omitnull.FromNull(val)
+	}
+	if o.AddressLine3 != nil {
+		val := o.AddressLine3()
+		m.AddressLine3 = omitnull.FromNull(val)
+	}
+	if o.PostalZone != nil {
+		val := o.PostalZone()
+		m.PostalZone = omitnull.FromNull(val)
+	}
+	if o.CityName != nil {
+		val := o.CityName()
+		m.CityName = omit.From(val)
+	}
+	if o.StateCode != nil {
+		val := o.StateCode()
+		m.StateCode = omit.From(val)
+	}
+	if o.CountryCode != nil {
+		val := o.CountryCode()
+		m.CountryCode = omit.From(val)
+	}
+	if o.Phone != nil {
+		val := o.Phone()
+		m.Phone = omit.From(val)
+	}
+	if o.Email != nil {
+		val := o.Email()
+		m.Email = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+	if o.UpdatedAt != nil {
+		val := o.UpdatedAt()
+		m.UpdatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.TaxpayerProfileSetter
+// this does nothing with the relationship templates
+func (o TaxpayerProfileTemplate) BuildManySetter(number int) []*models.TaxpayerProfileSetter {
+	m := make([]*models.TaxpayerProfileSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.TaxpayerProfile
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use TaxpayerProfileTemplate.Create
+func (o TaxpayerProfileTemplate) Build() *models.TaxpayerProfile {
+	m := &models.TaxpayerProfile{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.OrganisationID != nil {
+		m.OrganisationID = o.OrganisationID()
+	}
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.Tin != nil {
+		m.Tin = o.Tin()
+	}
+	if o.IDType != nil {
+		m.IDType = o.IDType()
+	}
+	if o.IDValue != nil {
+		m.IDValue = o.IDValue()
+	}
+	if o.SSTRegistrationNumber != nil {
+		m.SSTRegistrationNumber = o.SSTRegistrationNumber()
+	}
+	if o.TourismTaxRegistrationNumber != nil {
+		m.TourismTaxRegistrationNumber = o.TourismTaxRegistrationNumber()
+	}
+	if o.MsicCode != nil {
+		m.MsicCode = o.MsicCode()
+	}
+	if o.BusinessActivityDescription != nil {
+		m.BusinessActivityDescription = o.BusinessActivityDescription()
+	}
+	if o.AddressLine1 != nil {
+		m.AddressLine1 = o.AddressLine1()
+	}
+	if o.AddressLine2 != nil {
+		m.AddressLine2 = o.AddressLine2()
+	}
+	if o.AddressLine3 != nil {
+		m.AddressLine3 = o.AddressLine3()
+	}
+	if o.PostalZone != nil {
+		m.PostalZone = o.PostalZone()
+	}
+	if o.CityName != nil {
+		m.CityName = o.CityName()
+	}
+	if o.StateCode != nil {
+		m.StateCode = o.StateCode()
+	}
+	if o.CountryCode != nil {
+		m.CountryCode = o.CountryCode()
+	}
+	if o.Phone != nil {
+		m.Phone = o.Phone()
+	}
+	if o.Email != nil {
+		m.Email = o.Email()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.UpdatedAt != nil {
+		m.UpdatedAt = o.UpdatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.TaxpayerProfileSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use TaxpayerProfileTemplate.CreateMany
+func (o TaxpayerProfileTemplate) BuildMany(number int) models.TaxpayerProfileSlice {
+	m := make(models.TaxpayerProfileSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableTaxpayerProfile(m *models.TaxpayerProfileSetter) {
+	if !(m.OrganisationID.IsValue()) {
+		val := random_int64(nil)
+		m.OrganisationID = omit.From(val)
+	}
+	if !(m.Name.IsValue()) {
+		val := random_string(nil, "300")
+		m.Name = omit.From(val)
+	}
+	if !(m.Tin.IsValue()) {
+		val := random_string(nil, "14")
+		m.Tin = omit.From(val)
+	}
+	if !(m.IDType.IsValue()) {
+		val := random_enums_TaxpayerIDTypes(nil)
+		m.IDType = omit.From(val)
+	}
+	if !(m.IDValue.IsValue()) {
+		val := random_string(nil, "20")
+		m.IDValue = omit.From(val)
+	}
+	if !(m.MsicCode.IsValue()) {
+		val := random_string(nil, "5")
+		m.MsicCode = omit.From(val)
+	}
+	if !(m.BusinessActivityDescription.IsValue()) {
+		val := random_string(nil, "300")
+		m.BusinessActivityDescription = omit.From(val)
+	}
+	if !(m.AddressLine1.IsValue()) {
+		val := random_string(nil, "150")
+		m.AddressLine1 = omit.From(val)
+	}
+	if !(m.CityName.IsValue()) {
+		val := random_string(nil, "50")
+		m.CityName = omit.From(val)
+	}
+	if !(m.StateCode.IsValue()) {
+		val := random_string(nil, "2")
+		m.StateCode = omit.From(val)
+	}
+	if !(m.Phone.IsValue()) {
+		val := random_string(nil, "20")
+		m.Phone = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.TaxpayerProfile
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *TaxpayerProfileTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.TaxpayerProfile) error {
+	var err error
+
+	return err
+}
+
+// Create builds a taxpayerProfile and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *TaxpayerProfileTemplate) Create(ctx context.Context, exec bob.Executor) (*models.TaxpayerProfile, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableTaxpayerProfile(opt)
+
+	if o.r.Organisation == nil {
+		TaxpayerProfileMods.WithNewOrganisation().Apply(ctx, o)
+	}
+
+	var rel0 *models.Organisation
+
+	if o.r.Organisation.o.alreadyPersisted {
+		rel0 = o.r.Organisation.o.Build()
+	} else {
+		rel0, err = o.r.Organisation.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.OrganisationID = omit.From(rel0.ID)
+
+	m, err := models.TaxpayerProfiles.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Organisation = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a taxpayerProfile and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *TaxpayerProfileTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.TaxpayerProfile {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a taxpayerProfile and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *TaxpayerProfileTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.TaxpayerProfile {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple taxpayerProfiles and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o TaxpayerProfileTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.TaxpayerProfileSlice, error) {
+	var err error
+	m := make(models.TaxpayerProfileSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple taxpayerProfiles and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o TaxpayerProfileTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.TaxpayerProfileSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple taxpayerProfiles and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o TaxpayerProfileTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.TaxpayerProfileSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// TaxpayerProfile has methods that act as mods for the TaxpayerProfileTemplate
+var TaxpayerProfileMods taxpayerProfileMods
+
+type taxpayerProfileMods struct{}
+
+func (m taxpayerProfileMods) RandomizeAllColumns(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModSlice{
+		TaxpayerProfileMods.RandomID(f),
+		TaxpayerProfileMods.RandomOrganisationID(f),
+		TaxpayerProfileMods.RandomName(f),
+		TaxpayerProfileMods.RandomTin(f),
+		TaxpayerProfileMods.RandomIDType(f),
+		TaxpayerProfileMods.RandomIDValue(f),
+		TaxpayerProfileMods.RandomSSTRegistrationNumber(f),
+		TaxpayerProfileMods.RandomTourismTaxRegistrationNumber(f),
+		TaxpayerProfileMods.RandomMsicCode(f),
+		TaxpayerProfileMods.RandomBusinessActivityDescription(f),
+		TaxpayerProfileMods.RandomAddressLine1(f),
+		TaxpayerProfileMods.RandomAddressLine2(f),
+		TaxpayerProfileMods.RandomAddressLine3(f),
+		TaxpayerProfileMods.RandomPostalZone(f),
+		TaxpayerProfileMods.RandomCityName(f),
+		TaxpayerProfileMods.RandomStateCode(f),
+		TaxpayerProfileMods.RandomCountryCode(f),
+		TaxpayerProfileMods.RandomPhone(f),
+		TaxpayerProfileMods.RandomEmail(f),
+		TaxpayerProfileMods.RandomCreatedAt(f),
+		TaxpayerProfileMods.RandomUpdatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) ID(val int64) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) IDFunc(f func() int64) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetID() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taxpayerProfileMods) RandomID(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) OrganisationID(val int64) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.OrganisationID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) OrganisationIDFunc(f func() int64) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.OrganisationID = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetOrganisationID() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.OrganisationID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taxpayerProfileMods) RandomOrganisationID(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.OrganisationID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) Name(val string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Name = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) NameFunc(f func() string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetName() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taxpayerProfileMods) RandomName(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Name = func() string {
+			return random_string(f, "300")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) Tin(val string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Tin = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) TinFunc(f func() string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Tin = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetTin() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Tin = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taxpayerProfileMods) RandomTin(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Tin = func() string {
+			return random_string(f, "14")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) IDType(val enums.TaxpayerIDTypes) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.IDType = func() enums.TaxpayerIDTypes { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) IDTypeFunc(f func() enums.TaxpayerIDTypes) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.IDType = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetIDType() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.IDType = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taxpayerProfileMods) RandomIDType(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.IDType = func() enums.TaxpayerIDTypes {
+			return random_enums_TaxpayerIDTypes(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) IDValue(val string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.IDValue = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) IDValueFunc(f func() string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.IDValue = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetIDValue() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.IDValue = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taxpayerProfileMods) RandomIDValue(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.IDValue = func() string {
+			return random_string(f, "20")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) SSTRegistrationNumber(val string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.SSTRegistrationNumber = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) SSTRegistrationNumberFunc(f func() string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.SSTRegistrationNumber = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetSSTRegistrationNumber() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.SSTRegistrationNumber = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taxpayerProfileMods) RandomSSTRegistrationNumber(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.SSTRegistrationNumber = func() string {
+			return random_string(f, "35")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) TourismTaxRegistrationNumber(val string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.TourismTaxRegistrationNumber = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) TourismTaxRegistrationNumberFunc(f func() string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.TourismTaxRegistrationNumber = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetTourismTaxRegistrationNumber() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.TourismTaxRegistrationNumber = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taxpayerProfileMods) RandomTourismTaxRegistrationNumber(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.TourismTaxRegistrationNumber = func() string {
+			return random_string(f, "17")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) MsicCode(val string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.MsicCode = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) MsicCodeFunc(f func() string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.MsicCode = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetMsicCode() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.MsicCode = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taxpayerProfileMods) RandomMsicCode(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.MsicCode = func() string {
+			return random_string(f, "5")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) BusinessActivityDescription(val string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.BusinessActivityDescription = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) BusinessActivityDescriptionFunc(f func() string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.BusinessActivityDescription = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetBusinessActivityDescription() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.BusinessActivityDescription = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taxpayerProfileMods) RandomBusinessActivityDescription(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.BusinessActivityDescription = func() string {
+			return random_string(f, "300")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) AddressLine1(val string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.AddressLine1 = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) AddressLine1Func(f func() string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.AddressLine1 = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetAddressLine1() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.AddressLine1 = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taxpayerProfileMods) RandomAddressLine1(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.AddressLine1 = func() string {
+			return random_string(f, "150")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) AddressLine2(val null.Val[string]) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.AddressLine2 = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) AddressLine2Func(f func() null.Val[string]) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.AddressLine2 = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetAddressLine2() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.AddressLine2 = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m taxpayerProfileMods) RandomAddressLine2(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.AddressLine2 = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "150")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taxpayerProfileMods) RandomAddressLine2NotNull(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.AddressLine2 = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "150")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) AddressLine3(val null.Val[string]) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.AddressLine3 = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) AddressLine3Func(f func() null.Val[string]) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.AddressLine3 = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetAddressLine3() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.AddressLine3 = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m taxpayerProfileMods) RandomAddressLine3(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.AddressLine3 = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "150")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taxpayerProfileMods) RandomAddressLine3NotNull(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.AddressLine3 = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "150")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) PostalZone(val null.Val[string]) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.PostalZone = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) PostalZoneFunc(f func() null.Val[string]) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.PostalZone = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetPostalZone() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.PostalZone = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m taxpayerProfileMods) RandomPostalZone(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.PostalZone = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "50")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taxpayerProfileMods) RandomPostalZoneNotNull(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.PostalZone = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "50")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) CityName(val string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.CityName = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) CityNameFunc(f func() string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.CityName = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetCityName() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.CityName = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taxpayerProfileMods) RandomCityName(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.CityName = func() string {
+			return random_string(f, "50")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) StateCode(val string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.StateCode = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) StateCodeFunc(f func() string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.StateCode = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetStateCode() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.StateCode = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taxpayerProfileMods) RandomStateCode(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.StateCode = func() string {
+			return random_string(f, "2")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) CountryCode(val string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.CountryCode = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) CountryCodeFunc(f func() string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.CountryCode = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetCountryCode() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.CountryCode = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taxpayerProfileMods) RandomCountryCode(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.CountryCode = func() string {
+			return random_string(f, "3")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) Phone(val string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Phone = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) PhoneFunc(f func() string) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Phone = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetPhone() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Phone = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taxpayerProfileMods) RandomPhone(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Phone = func() string {
+			return random_string(f, "20")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) Email(val null.Val[string]) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Email = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) EmailFunc(f func() null.Val[string]) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Email = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetEmail() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Email = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m taxpayerProfileMods) RandomEmail(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Email = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "320")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taxpayerProfileMods) RandomEmailNotNull(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.Email = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "320")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) CreatedAt(val null.Val[time.Time]) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) CreatedAtFunc(f func() null.Val[time.Time]) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetCreatedAt() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m taxpayerProfileMods) RandomCreatedAt(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taxpayerProfileMods) RandomCreatedAtNotNull(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taxpayerProfileMods) UpdatedAt(val null.Val[time.Time]) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taxpayerProfileMods) UpdatedAtFunc(f func() null.Val[time.Time]) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.UpdatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m taxpayerProfileMods) UnsetUpdatedAt() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.UpdatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m taxpayerProfileMods) RandomUpdatedAt(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taxpayerProfileMods) RandomUpdatedAtNotNull(f *faker.Faker) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(_ context.Context, o *TaxpayerProfileTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m taxpayerProfileMods) WithParentsCascading() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(ctx context.Context, o *TaxpayerProfileTemplate) {
+		if isDone, _ := taxpayerProfileWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = taxpayerProfileWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewOrganisationWithContext(ctx, OrganisationMods.WithParentsCascading())
+			m.WithOrganisation(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m taxpayerProfileMods) WithOrganisation(rel *OrganisationTemplate) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(ctx context.Context, o *TaxpayerProfileTemplate) {
+		o.r.Organisation = &taxpayerProfileROrganisationR{
+			o: rel,
+		}
+	})
+}
+
+func (m taxpayerProfileMods) WithNewOrganisation(mods ...OrganisationMod) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(ctx context.Context, o *TaxpayerProfileTemplate) {
+		related := o.f.NewOrganisationWithContext(ctx, mods...)
+
+		m.WithOrganisation(related).Apply(ctx, o)
+	})
+}
+
+func (m taxpayerProfileMods) WithExistingOrganisation(em *models.Organisation) TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(ctx context.Context, o *TaxpayerProfileTemplate) {
+		o.r.Organisation = &taxpayerProfileROrganisationR{
+			o: o.f.FromExistingOrganisation(em),
+		}
+	})
+}
+
+func (m taxpayerProfileMods) WithoutOrganisation() TaxpayerProfileMod {
+	return TaxpayerProfileModFunc(func(ctx context.Context, o *TaxpayerProfileTemplate) {
+		o.r.Organisation = nil
+	})
+}
@@ -0,0 +1,686 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type UserIdentityMod interface {
+	Apply(context.Context, *UserIdentityTemplate)
+}
+
+type UserIdentityModFunc func(context.Context, *UserIdentityTemplate)
+
+func (f UserIdentityModFunc) Apply(ctx context.Context, n *UserIdentityTemplate) {
+	f(ctx, n)
+}
+
+type UserIdentityModSlice []UserIdentityMod
+
+func (mods UserIdentityModSlice) Apply(ctx context.Context, n *UserIdentityTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// UserIdentityTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type UserIdentityTemplate struct {
+	ID          func() int64
+	UserID      func() int64
+	Provider    func() string
+	Subject     func() string
+	Email       func() string
+	LastLoginAt func() null.Val[time.Time]
+	CreatedAt   func() null.Val[time.Time]
+	UpdatedAt   func() null.Val[time.Time]
+
+	r userIdentityR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type userIdentityR struct {
+	User *userIdentityRUserR
+}
+
+type userIdentityRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the UserIdentityTemplate
+func (o *UserIdentityTemplate) Apply(ctx context.Context, mods ...UserIdentityMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.UserIdentity
+// according to the relationships in the template. Nothing is inserted into the db
+func (t UserIdentityTemplate) setModelRels(o *models.UserIdentity) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.UserIdentities = append(rel.R.UserIdentities, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.UserIdentitySetter
+// this does nothing with the relationship templates
+func (o UserIdentityTemplate) BuildSetter() *models.UserIdentitySetter {
+	m := &models.UserIdentitySetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.Provider != nil {
+		val := o.Provider()
+		m.Provider = omit.From(val)
+	}
+	if o.Subject != nil {
+		val := o.Subject()
+		m.Subject = omit.From(val)
+	}
+	if o.Email != nil {
+		val := o.Email()
+		m.Email = omit.From(val)
+	}
+	if o.LastLoginAt != nil {
+		val := o.LastLoginAt()
+		m.LastLoginAt = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+	if o.UpdatedAt != nil {
+		val := o.UpdatedAt()
+		m.UpdatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.UserIdentitySetter
+// this does nothing with the relationship templates
+func (o UserIdentityTemplate) BuildManySetter(number int) []*models.UserIdentitySetter {
+	m := make([]*models.UserIdentitySetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.UserIdentity
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use UserIdentityTemplate.Create
+func (o UserIdentityTemplate) Build() *models.UserIdentity {
+	m := &models.UserIdentity{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.Provider != nil {
+		m.Provider = o.Provider()
+	}
+	if o.Subject != nil {
+		m.Subject = o.Subject()
+	}
+	if o.Email != nil {
+		m.Email = o.Email()
+	}
+	if o.LastLoginAt != nil {
+		m.LastLoginAt = o.LastLoginAt()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.UpdatedAt != nil {
+		m.UpdatedAt = o.UpdatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.UserIdentitySlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use UserIdentityTemplate.CreateMany
+func (o UserIdentityTemplate) BuildMany(number int) models.UserIdentitySlice {
+	m := make(models.UserIdentitySlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableUserIdentity(m *models.UserIdentitySetter) {
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
+	if !(m.Provider.IsValue()) {
+		val := random_string(nil, "50")
+		m.Provider = omit.From(val)
+	}
+	if !(m.Subject.IsValue()) {
+		val := random_string(nil, "255")
+		m.Subject = omit.From(val)
+	}
+	if !(m.Email.IsValue()) {
+		val := random_string(nil, "255")
+		m.Email = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.UserIdentity
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *UserIdentityTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.UserIdentity) error {
+	var err error
+
+	return err
+}
+
+// Create builds a userIdentity and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *UserIdentityTemplate) Create(ctx context.Context, exec bob.Executor) (*models.UserIdentity, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableUserIdentity(opt)
+
+	if o.r.User == nil {
+		UserIdentityMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel0 = o.r.User.o.Build()
+	} else {
+		rel0, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel0.ID)
+
+	m, err := models.UserIdentities.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a userIdentity and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *UserIdentityTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.UserIdentity {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a userIdentity and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *UserIdentityTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.UserIdentity {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple userIdentities and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o UserIdentityTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.UserIdentitySlice, error) {
+	var err error
+	m := make(models.UserIdentitySlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple userIdentities and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o UserIdentityTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.UserIdentitySlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple userIdentities and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o UserIdentityTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.UserIdentitySlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// UserIdentity has methods that act as mods for the UserIdentityTemplate
+var UserIdentityMods userIdentityMods
+
+type userIdentityMods struct{}
+
+func (m userIdentityMods) RandomizeAllColumns(f *faker.Faker) UserIdentityMod {
+	return UserIdentityModSlice{
+		UserIdentityMods.RandomID(f),
+		UserIdentityMods.RandomUserID(f),
+		UserIdentityMods.RandomProvider(f),
+		UserIdentityMods.RandomSubject(f),
+		UserIdentityMods.RandomEmail(f),
+		UserIdentityMods.RandomLastLoginAt(f),
+		UserIdentityMods.RandomCreatedAt(f),
+		UserIdentityMods.RandomUpdatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m userIdentityMods) ID(val int64) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userIdentityMods) IDFunc(f func() int64) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m userIdentityMods) UnsetID() UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userIdentityMods) RandomID(f *faker.Faker) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userIdentityMods) UserID(val int64) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userIdentityMods) UserIDFunc(f func() int64) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m userIdentityMods) UnsetUserID() UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userIdentityMods) RandomUserID(f *faker.Faker) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userIdentityMods) Provider(val string) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.Provider = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userIdentityMods) ProviderFunc(f func() string) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.Provider = f
+	})
+}
+
+// Clear any values for the column
+func (m userIdentityMods) UnsetProvider() UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.Provider = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userIdentityMods) RandomProvider(f *faker.Faker) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.Provider = func() string {
+			return random_string(f, "50")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userIdentityMods) Subject(val string) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.Subject = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userIdentityMods) SubjectFunc(f func() string) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.Subject = f
+	})
+}
+
+// Clear any values for the column
+func (m userIdentityMods) UnsetSubject() UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.Subject = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userIdentityMods) RandomSubject(f *faker.Faker) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.Subject = func() string {
+			return random_string(f, "255")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userIdentityMods) Email(val string) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.Email = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userIdentityMods) EmailFunc(f func() string) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.Email = f
+	})
+}
+
+// Clear any values for the column
+func (m userIdentityMods) UnsetEmail() UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.Email = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userIdentityMods) RandomEmail(f *faker.Faker) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.Email = func() string {
+			return random_string(f, "255")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userIdentityMods) LastLoginAt(val null.Val[time.Time]) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.LastLoginAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userIdentityMods) LastLoginAtFunc(f func() null.Val[time.Time]) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.LastLoginAt = f
+	})
+}
+
+// Clear any values for the column
+func (m userIdentityMods) UnsetLastLoginAt() UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.LastLoginAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m userIdentityMods) RandomLastLoginAt(f *faker.Faker) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.LastLoginAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m userIdentityMods) RandomLastLoginAtNotNull(f *faker.Faker) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.LastLoginAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userIdentityMods) CreatedAt(val null.Val[time.Time]) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userIdentityMods) CreatedAtFunc(f func() null.Val[time.Time]) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m userIdentityMods) UnsetCreatedAt() UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m userIdentityMods) RandomCreatedAt(f *faker.Faker) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m userIdentityMods) RandomCreatedAtNotNull(f *faker.Faker) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userIdentityMods) UpdatedAt(val null.Val[time.Time]) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userIdentityMods) UpdatedAtFunc(f func() null.Val[time.Time]) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.UpdatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m userIdentityMods) UnsetUpdatedAt() UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.UpdatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m userIdentityMods) RandomUpdatedAt(f *faker.Faker) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m userIdentityMods) RandomUpdatedAtNotNull(f *faker.Faker) UserIdentityMod {
+	return UserIdentityModFunc(func(_ context.Context, o *UserIdentityTemplate) {
+		o.UpdatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m userIdentityMods) WithParentsCascading() UserIdentityMod {
+	return UserIdentityModFunc(func(ctx context.Context, o *UserIdentityTemplate) {
+		if isDone, _ := userIdentityWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = userIdentityWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m userIdentityMods) WithUser(rel *UserTemplate) UserIdentityMod {
+	return UserIdentityModFunc(func(ctx context.Context, o *UserIdentityTemplate) {
+		o.r.User = &userIdentityRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m userIdentityMods) WithNewUser(mods ...UserMod) UserIdentityMod {
+	return UserIdentityModFunc(func(ctx context.Context, o *UserIdentityTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m userIdentityMods) WithExistingUser(em *models.User) UserIdentityMod {
+	return UserIdentityModFunc(func(ctx context.Context, o *UserIdentityTemplate) {
+		o.r.User = &userIdentityRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m userIdentityMods) WithoutUser() UserIdentityMod {
+	return UserIdentityModFunc(func(ctx context.Context, o *UserIdentityTemplate) {
+		o.r.User = nil
+	})
+}
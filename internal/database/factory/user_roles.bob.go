@@ -0,0 +1,431 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	models "github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type UserRoleMod interface {
+	Apply(context.Context, *UserRoleTemplate)
+}
+
+type UserRoleModFunc func(context.Context, *UserRoleTemplate)
+
+func (f UserRoleModFunc) Apply(ctx context.Context, n *UserRoleTemplate) {
+	f(ctx, n)
+}
+
+type UserRoleModSlice []UserRoleMod
+
+func (mods UserRoleModSlice) Apply(ctx context.Context, n *UserRoleTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// UserRoleTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type UserRoleTemplate struct {
+	UserID func() int64
+	RoleID func() int64
+
+	r userRoleR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type userRoleR struct {
+	Role *userRoleRRoleR
+	User *userRoleRUserR
+}
+
+type userRoleRRoleR struct {
+	o *RoleTemplate
+}
+type userRoleRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the UserRoleTemplate
+func (o *UserRoleTemplate) Apply(ctx context.Context, mods ...UserRoleMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.UserRole
+// according to the relationships in the template. Nothing is inserted into the db
+func (t UserRoleTemplate) setModelRels(o *models.UserRole) {
+	if t.r.Role != nil {
+		rel := t.r.Role.o.Build()
+		o.RoleID = rel.ID // h2
+		o.R.Role = rel
+	}
+
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.UserRoleSetter
+// this does nothing with the relationship templates
+func (o UserRoleTemplate) BuildSetter() *models.UserRoleSetter {
+	m := &models.UserRoleSetter{}
+
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.RoleID != nil {
+		val := o.RoleID()
+		m.RoleID = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.UserRoleSetter
+// this does nothing with the relationship templates
+func (o UserRoleTemplate) BuildManySetter(number int) []*models.UserRoleSetter {
+	m := make([]*models.UserRoleSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.UserRole
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use UserRoleTemplate.Create
+func (o UserRoleTemplate) Build() *models.UserRole {
+	m := &models.UserRole{}
+
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.RoleID != nil {
+		m.RoleID = o.RoleID()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.UserRoleSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use UserRoleTemplate.CreateMany
+func (o UserRoleTemplate) BuildMany(number int) models.UserRoleSlice {
+	m := make(models.UserRoleSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableUserRole(m *models.UserRoleSetter) {
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
+	if !(m.RoleID.IsValue()) {
+		val := random_int64(nil)
+		m.RoleID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.UserRole
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *UserRoleTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.UserRole) error {
+	var err error
+
+	return err
+}
+
+// Create builds a userRole and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *UserRoleTemplate) Create(ctx context.Context, exec bob.Executor) (*models.UserRole, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableUserRole(opt)
+
+	if o.r.Role == nil {
+		UserRoleMods.WithNewRole().Apply(ctx, o)
+	}
+
+	var rel0 *models.Role
+
+	if o.r.Role.o.alreadyPersisted {
+		rel0 = o.r.Role.o.Build()
+	} else {
+		rel0, err = o.r.Role.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.RoleID = omit.From(rel0.ID)
+
+	if o.r.User == nil {
+		UserRoleMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel1 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel1 = o.r.User.o.Build()
+	} else {
+		rel1, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel1.ID)
+
+	m, err := models.UserRoles.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Role = rel0
+	m.R.User = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a userRole and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *UserRoleTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.UserRole {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a userRole and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *UserRoleTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.UserRole {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple userRoles and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o UserRoleTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.UserRoleSlice, error) {
+	var err error
+	m := make(models.UserRoleSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple userRoles and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o UserRoleTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.UserRoleSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple userRoles and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o UserRoleTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.UserRoleSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// UserRole has methods that act as mods for the UserRoleTemplate
+var UserRoleMods userRoleMods
+
+type userRoleMods struct{}
+
+func (m userRoleMods) RandomizeAllColumns(f *faker.Faker) UserRoleMod {
+	return UserRoleModSlice{
+		UserRoleMods.RandomUserID(f),
+		UserRoleMods.RandomRoleID(f),
+	}
+}
+
+// Set the model columns to this value
+func (m userRoleMods) UserID(val int64) UserRoleMod {
+	return UserRoleModFunc(func(_ context.Context, o *UserRoleTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userRoleMods) UserIDFunc(f func() int64) UserRoleMod {
+	return UserRoleModFunc(func(_ context.Context, o *UserRoleTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m userRoleMods) UnsetUserID() UserRoleMod {
+	return UserRoleModFunc(func(_ context.Context, o *UserRoleTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userRoleMods) RandomUserID(f *faker.Faker) UserRoleMod {
+	return UserRoleModFunc(func(_ context.Context, o *UserRoleTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userRoleMods) RoleID(val int64) UserRoleMod {
+	return UserRoleModFunc(func(_ context.Context, o *UserRoleTemplate) {
+		o.RoleID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userRoleMods) RoleIDFunc(f func() int64) UserRoleMod {
+	return UserRoleModFunc(func(_ context.Context, o *UserRoleTemplate) {
+		o.RoleID = f
+	})
+}
+
+// Clear any values for the column
+func (m userRoleMods) UnsetRoleID() UserRoleMod {
+	return UserRoleModFunc(func(_ context.Context, o *UserRoleTemplate) {
+		o.RoleID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userRoleMods) RandomRoleID(f *faker.Faker) UserRoleMod {
+	return UserRoleModFunc(func(_ context.Context, o *UserRoleTemplate) {
+		o.RoleID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+func (m userRoleMods) WithParentsCascading() UserRoleMod {
+	return UserRoleModFunc(func(ctx context.Context, o *UserRoleTemplate) {
+		if isDone, _ := userRoleWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = userRoleWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewRoleWithContext(ctx, RoleMods.WithParentsCascading())
+			m.WithRole(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m userRoleMods) WithRole(rel *RoleTemplate) UserRoleMod {
+	return UserRoleModFunc(func(ctx context.Context, o *UserRoleTemplate) {
+		o.r.Role = &userRoleRRoleR{
+			o: rel,
+		}
+	})
+}
+
+func (m userRoleMods) WithNewRole(mods ...RoleMod) UserRoleMod {
+	return UserRoleModFunc(func(ctx context.Context, o *UserRoleTemplate) {
+		related := o.f.NewRoleWithContext(ctx, mods...)
+
+		m.WithRole(related).Apply(ctx, o)
+	})
+}
+
+func (m userRoleMods) WithExistingRole(em *models.Role) UserRoleMod {
+	return UserRoleModFunc(func(ctx context.Context, o *UserRoleTemplate) {
+		o.r.Role = &userRoleRRoleR{
+			o: o.f.FromExistingRole(em),
+		}
+	})
+}
+
+func (m userRoleMods) WithoutRole() UserRoleMod {
+	return UserRoleModFunc(func(ctx context.Context, o *UserRoleTemplate) {
+		o.r.Role = nil
+	})
+}
+
+func (m userRoleMods) WithUser(rel *UserTemplate) UserRoleMod {
+	return UserRoleModFunc(func(ctx context.Context, o *UserRoleTemplate) {
+		o.r.User = &userRoleRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m userRoleMods) WithNewUser(mods ...UserMod) UserRoleMod {
+	return UserRoleModFunc(func(ctx context.Context, o *UserRoleTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m userRoleMods) WithExistingUser(em *models.User) UserRoleMod {
+	return UserRoleModFunc(func(ctx context.Context, o *UserRoleTemplate) {
+		o.r.User = &userRoleRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m userRoleMods) WithoutUser() UserRoleMod {
+	return UserRoleModFunc(func(ctx context.Context, o *UserRoleTemplate) {
+		o.r.User = nil
+	})
+}
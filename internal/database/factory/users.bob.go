@@ -48,6 +48,9 @@ type UserTemplate struct {
 	CreatedAt       func() null.Val[time.Time]
 	UpdatedAt       func() null.Val[time.Time]
 	DeletedAt       func() null.Val[time.Time]
+	TotpSecret      func() null.Val[string]
+	TotpEnabledAt   func() null.Val[time.Time]
+	PendingEmail    func() null.Val[string]
 
 	r userR
 	f *Factory
@@ -56,10 +59,25 @@ type UserTemplate struct {
 }
 
 type userR struct {
-	AuthTokens   []*userRAuthTokensR
-	FailedLogins []*userRFailedLoginsR
+	APIKeys                 []*userRAPIKeysR
+	AuthTokens              []*userRAuthTokensR
+	FailedLogins            []*userRFailedLoginsR
+	AcceptedUserInvitations []*userRAcceptedUserInvitationsR
+	InvitedByInvitations    []*userRInvitedByInvitationsR
+	CreatedByInvoices       []*userRCreatedByInvoicesR
+	MfaRecoveryCodes        []*userRMfaRecoveryCodesR
+	OauthClients            []*userROauthClientsR
+	OrganisationMembers     []*userROrganisationMembersR
+	PasswordHistories       []*userRPasswordHistoriesR
+	SecurityEvents          []*userRSecurityEventsR
+	UserIdentities          []*userRUserIdentitiesR
+	Roles                   []*userRRolesR
+}
+
+type userRAPIKeysR struct {
+	number int
+	o      *APIKeyTemplate
 }
-
 type userRAuthTokensR struct {
 	number int
 	o      *AuthTokenTemplate
@@ -68,6 +86,46 @@ type userRFailedLoginsR struct {
 	number int
 	o      *FailedLoginTemplate
 }
+type userRAcceptedUserInvitationsR struct {
+	number int
+	o      *InvitationTemplate
+}
+type userRInvitedByInvitationsR struct {
+	number int
+	o      *InvitationTemplate
+}
+type userRCreatedByInvoicesR struct {
+	number int
+	o      *InvoiceTemplate
+}
+type userRMfaRecoveryCodesR struct {
+	number int
+	o      *MfaRecoveryCodeTemplate
+}
+type userROauthClientsR struct {
+	number int
+	o      *OauthClientTemplate
+}
+type userROrganisationMembersR struct {
+	number int
+	o      *OrganisationMemberTemplate
+}
+type userRPasswordHistoriesR struct {
+	number int
+	o      *PasswordHistoryTemplate
+}
+type userRSecurityEventsR struct {
+	number int
+	o      *SecurityEventTemplate
+}
+type userRUserIdentitiesR struct {
+	number int
+	o      *UserIdentityTemplate
+}
+type userRRolesR struct {
+	number int
+	o      *RoleTemplate
+}
 
 // Apply mods to the UserTemplate
 func (o *UserTemplate) Apply(ctx context.Context, mods ...UserMod) {
@@ -79,6 +137,19 @@ func (o *UserTemplate) Apply(ctx context.Context, mods ...UserMod) {
 // setModelRels creates and sets the relationships on *models.User
 // according to the relationships in the template. Nothing is inserted into the db
 func (t UserTemplate) setModelRels(o *models.User) {
+	if t.r.APIKeys != nil {
+		rel := models.APIKeySlice{}
+		for _, r := range t.r.APIKeys {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.APIKeys = rel
+	}
+
 	if t.r.AuthTokens != nil {
 		rel := models.AuthTokenSlice{}
 		for _, r := range t.r.AuthTokens {
@@ -97,13 +168,142 @@ func (t UserTemplate) setModelRels(o *models.User) {
 		for _, r := range t.r.FailedLogins {
 			related := r.o.BuildMany(r.number)
 			for _, rel := range related {
-				rel.UserID = o.ID // h2
+				rel.UserID = null.From(o.ID) // h2
 				rel.R.User = o
 			}
 			rel = append(rel, related...)
 		}
 		o.R.FailedLogins = rel
 	}
+
+	if t.r.AcceptedUserInvitations != nil {
+		rel := models.InvitationSlice{}
+		for _, r := range t.r.AcceptedUserInvitations {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.AcceptedUserID = null.From(o.ID) // h2
+				rel.R.AcceptedUserUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.AcceptedUserInvitations = rel
+	}
+
+	if t.r.InvitedByInvitations != nil {
+		rel := models.InvitationSlice{}
+		for _, r := range t.r.InvitedByInvitations {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.InvitedBy = null.From(o.ID) // h2
+				rel.R.InvitedByUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.InvitedByInvitations = rel
+	}
+
+	if t.r.CreatedByInvoices != nil {
+		rel := models.InvoiceSlice{}
+		for _, r := range t.r.CreatedByInvoices {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.CreatedBy = null.From(o.ID) // h2
+				rel.R.CreatedByUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.CreatedByInvoices = rel
+	}
+
+	if t.r.MfaRecoveryCodes != nil {
+		rel := models.MfaRecoveryCodeSlice{}
+		for _, r := range t.r.MfaRecoveryCodes {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.MfaRecoveryCodes = rel
+	}
+
+	if t.r.OauthClients != nil {
+		rel := models.OauthClientSlice{}
+		for _, r := range t.r.OauthClients {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.OauthClients = rel
+	}
+
+	if t.r.OrganisationMembers != nil {
+		rel := models.OrganisationMemberSlice{}
+		for _, r := range t.r.OrganisationMembers {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.OrganisationMembers = rel
+	}
+
+	if t.r.PasswordHistories != nil {
+		rel := models.PasswordHistorySlice{}
+		for _, r := range t.r.PasswordHistories {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.PasswordHistories = rel
+	}
+
+	if t.r.SecurityEvents != nil {
+		rel := models.SecurityEventSlice{}
+		for _, r := range t.r.SecurityEvents {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = null.From(o.ID) // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.SecurityEvents = rel
+	}
+
+	if t.r.UserIdentities != nil {
+		rel := models.UserIdentitySlice{}
+		for _, r := range t.r.UserIdentities {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.UserIdentities = rel
+	}
+
+	if t.r.Roles != nil {
+		rel := models.RoleSlice{}
+		for _, r := range t.r.Roles {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.R.Users = append(rel.R.Users, o)
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Roles = rel
+	}
 }
 
 // BuildSetter returns an *models.UserSetter
@@ -151,6 +351,18 @@ func (o UserTemplate) BuildSetter() *models.UserSetter {
 		val := o.DeletedAt()
 		m.DeletedAt = omitnull.FromNull(val)
 	}
+	if o.TotpSecret != nil {
+		val := o.TotpSecret()
+		m.TotpSecret = omitnull.FromNull(val)
+	}
+	if o.TotpEnabledAt != nil {
+		val := o.TotpEnabledAt()
+		m.TotpEnabledAt = omitnull.FromNull(val)
+	}
+	if o.PendingEmail != nil {
+		val := o.PendingEmail()
+		m.PendingEmail = omitnull.FromNull(val)
+	}
 
 	return m
 }
@@ -203,6 +415,15 @@ func (o UserTemplate) Build() *models.User {
 	if o.DeletedAt != nil {
 		m.DeletedAt = o.DeletedAt()
 	}
+	if o.TotpSecret != nil {
+		m.TotpSecret = o.TotpSecret()
+	}
+	if o.TotpEnabledAt != nil {
+		m.TotpEnabledAt = o.TotpEnabledAt()
+	}
+	if o.PendingEmail != nil {
+		m.PendingEmail = o.PendingEmail()
+	}
 
 	o.setModelRels(m)
 
@@ -247,6 +468,26 @@ func ensureCreatableUser(m *models.UserSetter) {
 func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.User) error {
 	var err error
 
+	isAPIKeysDone, _ := userRelAPIKeysCtx.Value(ctx)
+	if !isAPIKeysDone && o.r.APIKeys != nil {
+		ctx = userRelAPIKeysCtx.WithValue(ctx, true)
+		for _, r := range o.r.APIKeys {
+			if r.o.alreadyPersisted {
+				m.R.APIKeys = append(m.R.APIKeys, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachAPIKeys(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	isAuthTokensDone, _ := userRelAuthTokensCtx.Value(ctx)
 	if !isAuthTokensDone && o.r.AuthTokens != nil {
 		ctx = userRelAuthTokensCtx.WithValue(ctx, true)
@@ -254,12 +495,12 @@ func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *
 			if r.o.alreadyPersisted {
 				m.R.AuthTokens = append(m.R.AuthTokens, r.o.Build())
 			} else {
-				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
 				if err != nil {
 					return err
 				}
 
-				err = m.AttachAuthTokens(ctx, exec, rel0...)
+				err = m.AttachAuthTokens(ctx, exec, rel1...)
 				if err != nil {
 					return err
 				}
@@ -274,12 +515,12 @@ func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *
 			if r.o.alreadyPersisted {
 				m.R.FailedLogins = append(m.R.FailedLogins, r.o.Build())
 			} else {
-				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				rel2, err := r.o.CreateMany(ctx, exec, r.number)
 				if err != nil {
 					return err
 				}
 
-				err = m.AttachFailedLogins(ctx, exec, rel1...)
+				err = m.AttachFailedLogins(ctx, exec, rel2...)
 				if err != nil {
 					return err
 				}
@@ -287,80 +528,280 @@ func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *
 		}
 	}
 
-	return err
-}
-
-// Create builds a user and inserts it into the database
-// Relations objects are also inserted and placed in the .R field
-func (o *UserTemplate) Create(ctx context.Context, exec bob.Executor) (*models.User, error) {
-	var err error
-	opt := o.BuildSetter()
-	ensureCreatableUser(opt)
+	isAcceptedUserInvitationsDone, _ := userRelAcceptedUserInvitationsCtx.Value(ctx)
+	if !isAcceptedUserInvitationsDone && o.r.AcceptedUserInvitations != nil {
+		ctx = userRelAcceptedUserInvitationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.AcceptedUserInvitations {
+			if r.o.alreadyPersisted {
+				m.R.AcceptedUserInvitations = append(m.R.AcceptedUserInvitations, r.o.Build())
+			} else {
+				rel3, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
 
-	m, err := models.Users.Insert(opt).One(ctx, exec)
-	if err != nil {
-		return nil, err
+				err = m.AttachAcceptedUserInvitations(ctx, exec, rel3...)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
 
-	if err := o.insertOptRels(ctx, exec, m); err != nil {
-		return nil, err
+	isInvitedByInvitationsDone, _ := userRelInvitedByInvitationsCtx.Value(ctx)
+	if !isInvitedByInvitationsDone && o.r.InvitedByInvitations != nil {
+		ctx = userRelInvitedByInvitationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.InvitedByInvitations {
+			if r.o.alreadyPersisted {
+				m.R.InvitedByInvitations = append(m.R.InvitedByInvitations, r.o.Build())
+			} else {
+				rel4, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachInvitedByInvitations(ctx, exec, rel4...)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
-	return m, err
-}
 
-// MustCreate builds a user and inserts it into the database
-// Relations objects are also inserted and placed in the .R field
-// panics if an error occurs
-func (o *UserTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.User {
-	m, err := o.Create(ctx, exec)
-	if err != nil {
-		panic(err)
+	isCreatedByInvoicesDone, _ := userRelCreatedByInvoicesCtx.Value(ctx)
+	if !isCreatedByInvoicesDone && o.r.CreatedByInvoices != nil {
+		ctx = userRelCreatedByInvoicesCtx.WithValue(ctx, true)
+		for _, r := range o.r.CreatedByInvoices {
+			if r.o.alreadyPersisted {
+				m.R.CreatedByInvoices = append(m.R.CreatedByInvoices, r.o.Build())
+			} else {
+				rel5, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachCreatedByInvoices(ctx, exec, rel5...)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
-	return m
-}
 
-// CreateOrFail builds a user and inserts it into the database
-// Relations objects are also inserted and placed in the .R field
-// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
-func (o *UserTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.User {
-	tb.Helper()
-	m, err := o.Create(ctx, exec)
-	if err != nil {
-		tb.Fatal(err)
-		return nil
+	isMfaRecoveryCodesDone, _ := userRelMfaRecoveryCodesCtx.Value(ctx)
+	if !isMfaRecoveryCodesDone && o.r.MfaRecoveryCodes != nil {
+		ctx = userRelMfaRecoveryCodesCtx.WithValue(ctx, true)
+		for _, r := range o.r.MfaRecoveryCodes {
+			if r.o.alreadyPersisted {
+				m.R.MfaRecoveryCodes = append(m.R.MfaRecoveryCodes, r.o.Build())
+			} else {
+				rel6, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachMfaRecoveryCodes(ctx, exec, rel6...)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
-	return m
-}
 
-// CreateMany builds multiple users and inserts them into the database
-// Relations objects are also inserted and placed in the .R field
-func (o UserTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.UserSlice, error) {
-	var err error
-	m := make(models.UserSlice, number)
+	isOauthClientsDone, _ := userRelOauthClientsCtx.Value(ctx)
+	if !isOauthClientsDone && o.r.OauthClients != nil {
+		ctx = userRelOauthClientsCtx.WithValue(ctx, true)
+		for _, r := range o.r.OauthClients {
+			if r.o.alreadyPersisted {
+				m.R.OauthClients = append(m.R.OauthClients, r.o.Build())
+			} else {
+				rel7, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
 
-	for i := range m {
-		m[i], err = o.Create(ctx, exec)
-		if err != nil {
-			return nil, err
+				err = m.AttachOauthClients(ctx, exec, rel7...)
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 
-	return m, nil
-}
+	isOrganisationMembersDone, _ := userRelOrganisationMembersCtx.Value(ctx)
+	if !isOrganisationMembersDone && o.r.OrganisationMembers != nil {
+		ctx = userRelOrganisationMembersCtx.WithValue(ctx, true)
+		for _, r := range o.r.OrganisationMembers {
+			if r.o.alreadyPersisted {
+				m.R.OrganisationMembers = append(m.R.OrganisationMembers, r.o.Build())
+			} else {
+				rel8, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
 
-// MustCreateMany builds multiple users and inserts them into the database
-// Relations objects are also inserted and placed in the .R field
-// panics if an error occurs
-func (o UserTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.UserSlice {
-	m, err := o.CreateMany(ctx, exec, number)
-	if err != nil {
-		panic(err)
+				err = m.AttachOrganisationMembers(ctx, exec, rel8...)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
-	return m
-}
 
-// CreateManyOrFail builds multiple users and inserts them into the database
-// Relations objects are also inserted and placed in the .R field
+	isPasswordHistoriesDone, _ := userRelPasswordHistoriesCtx.Value(ctx)
+	if !isPasswordHistoriesDone && o.r.PasswordHistories != nil {
+		ctx = userRelPasswordHistoriesCtx.WithValue(ctx, true)
+		for _, r := range o.r.PasswordHistories {
+			if r.o.alreadyPersisted {
+				m.R.PasswordHistories = append(m.R.PasswordHistories, r.o.Build())
+			} else {
+				rel9, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachPasswordHistories(ctx, exec, rel9...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isSecurityEventsDone, _ := userRelSecurityEventsCtx.Value(ctx)
+	if !isSecurityEventsDone && o.r.SecurityEvents != nil {
+		ctx = userRelSecurityEventsCtx.WithValue(ctx, true)
+		for _, r := range o.r.SecurityEvents {
+			if r.o.alreadyPersisted {
+				m.R.SecurityEvents = append(m.R.SecurityEvents, r.o.Build())
+			} else {
+				rel10, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachSecurityEvents(ctx, exec, rel10...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isUserIdentitiesDone, _ := userRelUserIdentitiesCtx.Value(ctx)
+	if !isUserIdentitiesDone && o.r.UserIdentities != nil {
+		ctx = userRelUserIdentitiesCtx.WithValue(ctx, true)
+		for _, r := range o.r.UserIdentities {
+			if r.o.alreadyPersisted {
+				m.R.UserIdentities = append(m.R.UserIdentities, r.o.Build())
+			} else {
+				rel11, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachUserIdentities(ctx, exec, rel11...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isRolesDone, _ := userRelRolesCtx.Value(ctx)
+	if !isRolesDone && o.r.Roles != nil {
+		ctx = userRelRolesCtx.WithValue(ctx, true)
+		for _, r := range o.r.Roles {
+			if r.o.alreadyPersisted {
+				m.R.Roles = append(m.R.Roles, r.o.Build())
+			} else {
+				rel12, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachRoles(ctx, exec, rel12...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a user and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *UserTemplate) Create(ctx context.Context, exec bob.Executor) (*models.User, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableUser(opt)
+
+	m, err := models.Users.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a user and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *UserTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.User {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a user and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *UserTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.User {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple users and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o UserTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.UserSlice, error) {
+	var err error
+	m := make(models.UserSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple users and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o UserTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.UserSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple users and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
 // It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
 func (o UserTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.UserSlice {
 	tb.Helper()
@@ -389,6 +830,9 @@ func (m userMods) RandomizeAllColumns(f *faker.Faker) UserMod {
 		UserMods.RandomCreatedAt(f),
 		UserMods.RandomUpdatedAt(f),
 		UserMods.RandomDeletedAt(f),
+		UserMods.RandomTotpSecret(f),
+		UserMods.RandomTotpEnabledAt(f),
+		UserMods.RandomPendingEmail(f),
 	}
 }
 
@@ -790,107 +1234,794 @@ func (m userMods) RandomDeletedAtNotNull(f *faker.Faker) UserMod {
 	})
 }
 
-func (m userMods) WithParentsCascading() UserMod {
-	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		if isDone, _ := userWithParentsCascadingCtx.Value(ctx); isDone {
-			return
-		}
-		ctx = userWithParentsCascadingCtx.WithValue(ctx, true)
+// Set the model columns to this value
+func (m userMods) TotpSecret(val null.Val[string]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpSecret = func() null.Val[string] { return val }
 	})
 }
 
-func (m userMods) WithAuthTokens(number int, related *AuthTokenTemplate) UserMod {
-	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		o.r.AuthTokens = []*userRAuthTokensR{{
-			number: number,
-			o:      related,
-		}}
+// Set the Column from the function
+func (m userMods) TotpSecretFunc(f func() null.Val[string]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpSecret = f
 	})
 }
 
-func (m userMods) WithNewAuthTokens(number int, mods ...AuthTokenMod) UserMod {
-	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		related := o.f.NewAuthTokenWithContext(ctx, mods...)
-		m.WithAuthTokens(number, related).Apply(ctx, o)
+// Clear any values for the column
+func (m userMods) UnsetTotpSecret() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpSecret = nil
 	})
 }
 
-func (m userMods) AddAuthTokens(number int, related *AuthTokenTemplate) UserMod {
-	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		o.r.AuthTokens = append(o.r.AuthTokens, &userRAuthTokensR{
-			number: number,
-			o:      related,
-		})
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m userMods) RandomTotpSecret(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpSecret = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
 	})
 }
 
-func (m userMods) AddNewAuthTokens(number int, mods ...AuthTokenMod) UserMod {
-	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		related := o.f.NewAuthTokenWithContext(ctx, mods...)
-		m.AddAuthTokens(number, related).Apply(ctx, o)
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m userMods) RandomTotpSecretNotNull(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpSecret = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
 	})
 }
 
-func (m userMods) AddExistingAuthTokens(existingModels ...*models.AuthToken) UserMod {
-	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		for _, em := range existingModels {
-			o.r.AuthTokens = append(o.r.AuthTokens, &userRAuthTokensR{
-				o: o.f.FromExistingAuthToken(em),
-			})
-		}
+// Set the model columns to this value
+func (m userMods) TotpEnabledAt(val null.Val[time.Time]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpEnabledAt = func() null.Val[time.Time] { return val }
 	})
 }
 
-func (m userMods) WithoutAuthTokens() UserMod {
-	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		o.r.AuthTokens = nil
+// Set the Column from the function
+func (m userMods) TotpEnabledAtFunc(f func() null.Val[time.Time]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpEnabledAt = f
 	})
 }
 
-func (m userMods) WithFailedLogins(number int, related *FailedLoginTemplate) UserMod {
-	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		o.r.FailedLogins = []*userRFailedLoginsR{{
-			number: number,
-			o:      related,
-		}}
+// Clear any values for the column
+func (m userMods) UnsetTotpEnabledAt() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpEnabledAt = nil
 	})
 }
 
-func (m userMods) WithNewFailedLogins(number int, mods ...FailedLoginMod) UserMod {
-	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		related := o.f.NewFailedLoginWithContext(ctx, mods...)
-		m.WithFailedLogins(number, related).Apply(ctx, o)
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m userMods) RandomTotpEnabledAt(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpEnabledAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
 	})
 }
 
-func (m userMods) AddFailedLogins(number int, related *FailedLoginTemplate) UserMod {
-	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		o.r.FailedLogins = append(o.r.FailedLogins, &userRFailedLoginsR{
-			number: number,
-			o:      related,
-		})
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m userMods) RandomTotpEnabledAtNotNull(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpEnabledAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
 	})
 }
 
-func (m userMods) AddNewFailedLogins(number int, mods ...FailedLoginMod) UserMod {
-	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		related := o.f.NewFailedLoginWithContext(ctx, mods...)
-		m.AddFailedLogins(number, related).Apply(ctx, o)
+// Set the model columns to this value
+func (m userMods) PendingEmail(val null.Val[string]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.PendingEmail = func() null.Val[string] { return val }
 	})
 }
 
-func (m userMods) AddExistingFailedLogins(existingModels ...*models.FailedLogin) UserMod {
-	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		for _, em := range existingModels {
-			o.r.FailedLogins = append(o.r.FailedLogins, &userRFailedLoginsR{
-				o: o.f.FromExistingFailedLogin(em),
-			})
-		}
+// Set the Column from the function
+func (m userMods) PendingEmailFunc(f func() null.Val[string]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.PendingEmail = f
 	})
 }
 
-func (m userMods) WithoutFailedLogins() UserMod {
-	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		o.r.FailedLogins = nil
+// Clear any values for the column
+func (m userMods) UnsetPendingEmail() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.PendingEmail = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m userMods) RandomPendingEmail(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.PendingEmail = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "300")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m userMods) RandomPendingEmailNotNull(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.PendingEmail = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "300")
+			return null.From(val)
+		}
+	})
+}
+
+func (m userMods) WithParentsCascading() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		if isDone, _ := userWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = userWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
+
+func (m userMods) WithAPIKeys(number int, related *APIKeyTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.APIKeys = []*userRAPIKeysR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewAPIKeys(number int, mods ...APIKeyMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewAPIKeyWithContext(ctx, mods...)
+		m.WithAPIKeys(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddAPIKeys(number int, related *APIKeyTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.APIKeys = append(o.r.APIKeys, &userRAPIKeysR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewAPIKeys(number int, mods ...APIKeyMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewAPIKeyWithContext(ctx, mods...)
+		m.AddAPIKeys(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingAPIKeys(existingModels ...*models.APIKey) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.APIKeys = append(o.r.APIKeys, &userRAPIKeysR{
+				o: o.f.FromExistingAPIKey(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutAPIKeys() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.APIKeys = nil
+	})
+}
+
+func (m userMods) WithAuthTokens(number int, related *AuthTokenTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AuthTokens = []*userRAuthTokensR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewAuthTokens(number int, mods ...AuthTokenMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewAuthTokenWithContext(ctx, mods...)
+		m.WithAuthTokens(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddAuthTokens(number int, related *AuthTokenTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AuthTokens = append(o.r.AuthTokens, &userRAuthTokensR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewAuthTokens(number int, mods ...AuthTokenMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewAuthTokenWithContext(ctx, mods...)
+		m.AddAuthTokens(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingAuthTokens(existingModels ...*models.AuthToken) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.AuthTokens = append(o.r.AuthTokens, &userRAuthTokensR{
+				o: o.f.FromExistingAuthToken(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutAuthTokens() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AuthTokens = nil
+	})
+}
+
+func (m userMods) WithFailedLogins(number int, related *FailedLoginTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.FailedLogins = []*userRFailedLoginsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewFailedLogins(number int, mods ...FailedLoginMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewFailedLoginWithContext(ctx, mods...)
+		m.WithFailedLogins(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddFailedLogins(number int, related *FailedLoginTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.FailedLogins = append(o.r.FailedLogins, &userRFailedLoginsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewFailedLogins(number int, mods ...FailedLoginMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewFailedLoginWithContext(ctx, mods...)
+		m.AddFailedLogins(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingFailedLogins(existingModels ...*models.FailedLogin) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.FailedLogins = append(o.r.FailedLogins, &userRFailedLoginsR{
+				o: o.f.FromExistingFailedLogin(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutFailedLogins() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.FailedLogins = nil
+	})
+}
+
+func (m userMods) WithAcceptedUserInvitations(number int, related *InvitationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AcceptedUserInvitations = []*userRAcceptedUserInvitationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewAcceptedUserInvitations(number int, mods ...InvitationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewInvitationWithContext(ctx, mods...)
+		m.WithAcceptedUserInvitations(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddAcceptedUserInvitations(number int, related *InvitationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AcceptedUserInvitations = append(o.r.AcceptedUserInvitations, &userRAcceptedUserInvitationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewAcceptedUserInvitations(number int, mods ...InvitationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewInvitationWithContext(ctx, mods...)
+		m.AddAcceptedUserInvitations(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingAcceptedUserInvitations(existingModels ...*models.Invitation) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.AcceptedUserInvitations = append(o.r.AcceptedUserInvitations, &userRAcceptedUserInvitationsR{
+				o: o.f.FromExistingInvitation(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutAcceptedUserInvitations() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AcceptedUserInvitations = nil
+	})
+}
+
+func (m userMods) WithInvitedByInvitations(number int, related *InvitationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.InvitedByInvitations = []*userRInvitedByInvitationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewInvitedByInvitations(number int, mods ...InvitationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewInvitationWithContext(ctx, mods...)
+		m.WithInvitedByInvitations(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddInvitedByInvitations(number int, related *InvitationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.InvitedByInvitations = append(o.r.InvitedByInvitations, &userRInvitedByInvitationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewInvitedByInvitations(number int, mods ...InvitationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewInvitationWithContext(ctx, mods...)
+		m.AddInvitedByInvitations(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingInvitedByInvitations(existingModels ...*models.Invitation) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.InvitedByInvitations = append(o.r.InvitedByInvitations, &userRInvitedByInvitationsR{
+				o: o.f.FromExistingInvitation(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutInvitedByInvitations() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.InvitedByInvitations = nil
+	})
+}
+
+func (m userMods) WithCreatedByInvoices(number int, related *InvoiceTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.CreatedByInvoices = []*userRCreatedByInvoicesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewCreatedByInvoices(number int, mods ...InvoiceMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewInvoiceWithContext(ctx, mods...)
+		m.WithCreatedByInvoices(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddCreatedByInvoices(number int, related *InvoiceTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.CreatedByInvoices = append(o.r.CreatedByInvoices, &userRCreatedByInvoicesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewCreatedByInvoices(number int, mods ...InvoiceMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewInvoiceWithContext(ctx, mods...)
+		m.AddCreatedByInvoices(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingCreatedByInvoices(existingModels ...*models.Invoice) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.CreatedByInvoices = append(o.r.CreatedByInvoices, &userRCreatedByInvoicesR{
+				o: o.f.FromExistingInvoice(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutCreatedByInvoices() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.CreatedByInvoices = nil
+	})
+}
+
+func (m userMods) WithMfaRecoveryCodes(number int, related *MfaRecoveryCodeTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.MfaRecoveryCodes = []*userRMfaRecoveryCodesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewMfaRecoveryCodes(number int, mods ...MfaRecoveryCodeMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewMfaRecoveryCodeWithContext(ctx, mods...)
+		m.WithMfaRecoveryCodes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddMfaRecoveryCodes(number int, related *MfaRecoveryCodeTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.MfaRecoveryCodes = append(o.r.MfaRecoveryCodes, &userRMfaRecoveryCodesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewMfaRecoveryCodes(number int, mods ...MfaRecoveryCodeMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewMfaRecoveryCodeWithContext(ctx, mods...)
+		m.AddMfaRecoveryCodes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingMfaRecoveryCodes(existingModels ...*models.MfaRecoveryCode) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.MfaRecoveryCodes = append(o.r.MfaRecoveryCodes, &userRMfaRecoveryCodesR{
+				o: o.f.FromExistingMfaRecoveryCode(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutMfaRecoveryCodes() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.MfaRecoveryCodes = nil
+	})
+}
+
+func (m userMods) WithOauthClients(number int, related *OauthClientTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.OauthClients = []*userROauthClientsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewOauthClients(number int, mods ...OauthClientMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewOauthClientWithContext(ctx, mods...)
+		m.WithOauthClients(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddOauthClients(number int, related *OauthClientTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.OauthClients = append(o.r.OauthClients, &userROauthClientsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewOauthClients(number int, mods ...OauthClientMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewOauthClientWithContext(ctx, mods...)
+		m.AddOauthClients(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingOauthClients(existingModels ...*models.OauthClient) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.OauthClients = append(o.r.OauthClients, &userROauthClientsR{
+				o: o.f.FromExistingOauthClient(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutOauthClients() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.OauthClients = nil
+	})
+}
+
+func (m userMods) WithOrganisationMembers(number int, related *OrganisationMemberTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.OrganisationMembers = []*userROrganisationMembersR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewOrganisationMembers(number int, mods ...OrganisationMemberMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewOrganisationMemberWithContext(ctx, mods...)
+		m.WithOrganisationMembers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddOrganisationMembers(number int, related *OrganisationMemberTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.OrganisationMembers = append(o.r.OrganisationMembers, &userROrganisationMembersR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewOrganisationMembers(number int, mods ...OrganisationMemberMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewOrganisationMemberWithContext(ctx, mods...)
+		m.AddOrganisationMembers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingOrganisationMembers(existingModels ...*models.OrganisationMember) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.OrganisationMembers = append(o.r.OrganisationMembers, &userROrganisationMembersR{
+				o: o.f.FromExistingOrganisationMember(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutOrganisationMembers() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.OrganisationMembers = nil
+	})
+}
+
+func (m userMods) WithPasswordHistories(number int, related *PasswordHistoryTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.PasswordHistories = []*userRPasswordHistoriesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewPasswordHistories(number int, mods ...PasswordHistoryMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewPasswordHistoryWithContext(ctx, mods...)
+		m.WithPasswordHistories(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddPasswordHistories(number int, related *PasswordHistoryTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.PasswordHistories = append(o.r.PasswordHistories, &userRPasswordHistoriesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewPasswordHistories(number int, mods ...PasswordHistoryMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewPasswordHistoryWithContext(ctx, mods...)
+		m.AddPasswordHistories(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingPasswordHistories(existingModels ...*models.PasswordHistory) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.PasswordHistories = append(o.r.PasswordHistories, &userRPasswordHistoriesR{
+				o: o.f.FromExistingPasswordHistory(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutPasswordHistories() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.PasswordHistories = nil
+	})
+}
+
+func (m userMods) WithSecurityEvents(number int, related *SecurityEventTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.SecurityEvents = []*userRSecurityEventsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewSecurityEvents(number int, mods ...SecurityEventMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewSecurityEventWithContext(ctx, mods...)
+		m.WithSecurityEvents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddSecurityEvents(number int, related *SecurityEventTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.SecurityEvents = append(o.r.SecurityEvents, &userRSecurityEventsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewSecurityEvents(number int, mods ...SecurityEventMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewSecurityEventWithContext(ctx, mods...)
+		m.AddSecurityEvents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingSecurityEvents(existingModels ...*models.SecurityEvent) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.SecurityEvents = append(o.r.SecurityEvents, &userRSecurityEventsR{
+				o: o.f.FromExistingSecurityEvent(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutSecurityEvents() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.SecurityEvents = nil
+	})
+}
+
+func (m userMods) WithUserIdentities(number int, related *UserIdentityTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.UserIdentities = []*userRUserIdentitiesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewUserIdentities(number int, mods ...UserIdentityMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewUserIdentityWithContext(ctx, mods...)
+		m.WithUserIdentities(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddUserIdentities(number int, related *UserIdentityTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.UserIdentities = append(o.r.UserIdentities, &userRUserIdentitiesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewUserIdentities(number int, mods ...UserIdentityMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewUserIdentityWithContext(ctx, mods...)
+		m.AddUserIdentities(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingUserIdentities(existingModels ...*models.UserIdentity) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.UserIdentities = append(o.r.UserIdentities, &userRUserIdentitiesR{
+				o: o.f.FromExistingUserIdentity(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutUserIdentities() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.UserIdentities = nil
+	})
+}
+
+func (m userMods) WithRoles(number int, related *RoleTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Roles = []*userRRolesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewRoles(number int, mods ...RoleMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewRoleWithContext(ctx, mods...)
+		m.WithRoles(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddRoles(number int, related *RoleTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Roles = append(o.r.Roles, &userRRolesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewRoles(number int, mods ...RoleMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewRoleWithContext(ctx, mods...)
+		m.AddRoles(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingRoles(existingModels ...*models.Role) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Roles = append(o.r.Roles, &userRRolesR{
+				o: o.f.FromExistingRole(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutRoles() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Roles = nil
 	})
 }
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS security_events;
+DROP TYPE IF EXISTS security_event_types;
+DROP INDEX IF EXISTS idx_auth_tokens_session_id;
+
+ALTER TABLE auth_tokens
+DROP COLUMN IF EXISTS rotated_at,
+DROP COLUMN IF EXISTS revoked_at;
@@ -0,0 +1,22 @@
+-- Track refresh token rotation so a replayed token can be detected
+ALTER TABLE auth_tokens
+ADD COLUMN IF NOT EXISTS rotated_at TIMESTAMP WITH TIME ZONE,
+ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_auth_tokens_session_id ON auth_tokens(session_id);
+
+CREATE TYPE security_event_types AS ENUM ('refresh_token_reuse');
+
+-- Security Events Table
+CREATE TABLE IF NOT EXISTS security_events(
+   id bigserial PRIMARY KEY,
+   user_id BIGINT REFERENCES users(id) ON DELETE CASCADE,
+   session_id UUID,
+   type security_event_types NOT NULL,
+   ip_address INET,
+   details JSONB,
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_security_events_user_id ON security_events(user_id);
+CREATE INDEX idx_security_events_created_at ON security_events(created_at);
@@ -0,0 +1,3 @@
+ALTER TABLE auth_tokens
+DROP COLUMN IF EXISTS ip_address,
+DROP COLUMN IF EXISTS user_agent;
@@ -0,0 +1,3 @@
+ALTER TABLE auth_tokens
+ADD COLUMN IF NOT EXISTS ip_address INET,
+ADD COLUMN IF NOT EXISTS user_agent VARCHAR(512);
@@ -0,0 +1,15 @@
+-- TOTP secret is stored encrypted, MFA is only enforced once totp_enabled_at is set
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS totp_secret TEXT,
+ADD COLUMN IF NOT EXISTS totp_enabled_at TIMESTAMP WITH TIME ZONE;
+
+-- MFA Recovery Codes Table
+CREATE TABLE IF NOT EXISTS mfa_recovery_codes(
+   id bigserial PRIMARY KEY,
+   user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   code_hash VARCHAR(255) NOT NULL,
+   used_at TIMESTAMP WITH TIME ZONE,
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_mfa_recovery_codes_user_id ON mfa_recovery_codes(user_id);
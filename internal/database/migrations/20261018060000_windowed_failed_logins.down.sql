@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_failed_logins_user_id_attempted_at;
+DROP INDEX IF EXISTS idx_failed_logins_ip_address_attempted_at;
+
+DELETE FROM failed_logins WHERE user_id IS NULL;
+
+ALTER TABLE failed_logins
+ALTER COLUMN user_id SET NOT NULL;
@@ -0,0 +1,6 @@
+-- Failed logins for unknown emails are recorded against the client ip only
+ALTER TABLE failed_logins
+ALTER COLUMN user_id DROP NOT NULL;
+
+CREATE INDEX idx_failed_logins_user_id_attempted_at ON failed_logins(user_id, attempted_at);
+CREATE INDEX idx_failed_logins_ip_address_attempted_at ON failed_logins(ip_address, attempted_at);
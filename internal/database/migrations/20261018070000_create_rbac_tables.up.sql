@@ -0,0 +1,36 @@
+-- Roles Table
+CREATE TABLE IF NOT EXISTS roles(
+   id bigserial PRIMARY KEY,
+   name VARCHAR(50) UNIQUE NOT NULL,
+   description VARCHAR(255),
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+   updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER roles_update_timestamp
+BEFORE UPDATE ON roles
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+-- Permissions Table, names follow the "resource:action" format
+CREATE TABLE IF NOT EXISTS permissions(
+   id bigserial PRIMARY KEY,
+   name VARCHAR(100) UNIQUE NOT NULL,
+   description VARCHAR(255),
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions(
+   role_id BIGINT NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+   permission_id BIGINT NOT NULL REFERENCES permissions(id) ON DELETE CASCADE,
+   PRIMARY KEY (role_id, permission_id)
+);
+
+CREATE TABLE IF NOT EXISTS user_roles(
+   user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   role_id BIGINT NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+   PRIMARY KEY (user_id, role_id)
+);
+
+CREATE INDEX idx_role_permissions_permission_id ON role_permissions(permission_id);
+CREATE INDEX idx_user_roles_role_id ON user_roles(role_id);
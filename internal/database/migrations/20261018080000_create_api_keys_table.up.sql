@@ -0,0 +1,35 @@
+-- Organisations Table, the tenant that owns integrations such as API keys
+CREATE TABLE IF NOT EXISTS organisations(
+   id bigserial PRIMARY KEY,
+   name VARCHAR(255) NOT NULL,
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+   updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER organisations_update_timestamp
+BEFORE UPDATE ON organisations
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+-- API Keys Table, the key is identified by its public prefix and stored as an HMAC hash
+CREATE TABLE IF NOT EXISTS api_keys(
+   id bigserial PRIMARY KEY,
+   organisation_id BIGINT NOT NULL REFERENCES organisations(id) ON DELETE CASCADE,
+   user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   name VARCHAR(100) NOT NULL,
+   prefix VARCHAR(16) UNIQUE NOT NULL,
+   key_hash VARCHAR(255) NOT NULL,
+   scopes TEXT[] NOT NULL DEFAULT '{}',
+   expire_at TIMESTAMP WITH TIME ZONE,
+   last_used_at TIMESTAMP WITH TIME ZONE,
+   revoked_at TIMESTAMP WITH TIME ZONE,
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+   updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_api_keys_organisation_id ON api_keys(organisation_id);
+
+CREATE TRIGGER api_keys_update_timestamp
+BEFORE UPDATE ON api_keys
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
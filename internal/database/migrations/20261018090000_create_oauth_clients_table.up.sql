@@ -0,0 +1,21 @@
+-- OAuth Clients Table, confidential clients using the client_credentials grant.
+-- The secret is stored as an HMAC hash like API keys.
+CREATE TABLE IF NOT EXISTS oauth_clients(
+   id bigserial PRIMARY KEY,
+   organisation_id BIGINT NOT NULL REFERENCES organisations(id) ON DELETE CASCADE,
+   user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   name VARCHAR(100) NOT NULL,
+   client_id VARCHAR(64) UNIQUE NOT NULL,
+   secret_hash VARCHAR(255) NOT NULL,
+   scopes TEXT[] NOT NULL DEFAULT '{}',
+   revoked_at TIMESTAMP WITH TIME ZONE,
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+   updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_oauth_clients_organisation_id ON oauth_clients(organisation_id);
+
+CREATE TRIGGER oauth_clients_update_timestamp
+BEFORE UPDATE ON oauth_clients
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
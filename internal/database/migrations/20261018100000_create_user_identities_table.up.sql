@@ -0,0 +1,19 @@
+-- User Identities Table, links users to their accounts at external OIDC identity providers
+CREATE TABLE IF NOT EXISTS user_identities(
+   id bigserial PRIMARY KEY,
+   user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   provider VARCHAR(50) NOT NULL,
+   subject VARCHAR(255) NOT NULL,
+   email VARCHAR(255) NOT NULL,
+   last_login_at TIMESTAMP WITH TIME ZONE,
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+   updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+   UNIQUE (provider, subject)
+);
+
+CREATE INDEX idx_user_identities_user_id ON user_identities(user_id);
+
+CREATE TRIGGER user_identities_update_timestamp
+BEFORE UPDATE ON user_identities
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
@@ -0,0 +1,35 @@
+-- Audit Events Table, an append-only record of who did what.
+-- The actor is a snapshot without foreign keys so events outlive the users they mention.
+CREATE TABLE IF NOT EXISTS audit_events(
+   id bigserial PRIMARY KEY,
+   actor_type VARCHAR(20) NOT NULL,
+   actor_id BIGINT,
+   actor_label VARCHAR(255),
+   organisation_id BIGINT,
+   action VARCHAR(100) NOT NULL,
+   entity_type VARCHAR(50),
+   entity_id VARCHAR(64),
+   ip_address INET,
+   user_agent TEXT,
+   request_id VARCHAR(64),
+   changes JSONB,
+   metadata JSONB,
+   created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_audit_events_created_at ON audit_events(created_at);
+CREATE INDEX idx_audit_events_actor ON audit_events(actor_type, actor_id);
+CREATE INDEX idx_audit_events_entity ON audit_events(entity_type, entity_id);
+CREATE INDEX idx_audit_events_action ON audit_events(action);
+
+CREATE OR REPLACE FUNCTION prevent_audit_event_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only
+BEFORE UPDATE OR DELETE ON audit_events
+FOR EACH ROW
+EXECUTE FUNCTION prevent_audit_event_change();
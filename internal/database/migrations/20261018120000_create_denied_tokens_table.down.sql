@@ -0,0 +1 @@
+DROP TABLE IF EXISTS denied_tokens;
@@ -0,0 +1,8 @@
+-- Denied Tokens Table, revoked access tokens keyed by jti until they expire
+CREATE TABLE IF NOT EXISTS denied_tokens(
+   jti VARCHAR(255) PRIMARY KEY,
+   expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_denied_tokens_expires_at ON denied_tokens(expires_at);
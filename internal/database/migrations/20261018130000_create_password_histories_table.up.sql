@@ -0,0 +1,9 @@
+-- Password Histories Table, previous password hashes that cannot be reused
+CREATE TABLE IF NOT EXISTS password_histories(
+   id bigserial PRIMARY KEY,
+   user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   password VARCHAR(255) NOT NULL,
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_password_histories_user_id ON password_histories(user_id, id DESC);
@@ -0,0 +1,22 @@
+-- Invitations Table, accounts an admin has invited, accepted through a single-use token
+CREATE TABLE IF NOT EXISTS invitations(
+   id bigserial PRIMARY KEY,
+   email VARCHAR(300) NOT NULL,
+   role_id BIGINT NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+   invited_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+   token VARCHAR(255) UNIQUE NOT NULL,
+   expire_at TIMESTAMP WITH TIME ZONE NOT NULL,
+   accepted_at TIMESTAMP WITH TIME ZONE,
+   accepted_user_id BIGINT REFERENCES users(id) ON DELETE SET NULL,
+   revoked_at TIMESTAMP WITH TIME ZONE,
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+   updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- At most one open invitation per email, emails are stored lowercased
+CREATE UNIQUE INDEX idx_invitations_open_email ON invitations(email) WHERE accepted_at IS NULL AND revoked_at IS NULL;
+
+CREATE TRIGGER invitations_update_timestamp
+BEFORE UPDATE ON invitations
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
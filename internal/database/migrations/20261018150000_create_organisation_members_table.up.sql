@@ -0,0 +1,16 @@
+-- Organisation Members Table, the organisations (tenants) a user may act on
+CREATE TABLE IF NOT EXISTS organisation_members(
+   organisation_id BIGINT NOT NULL REFERENCES organisations(id) ON DELETE CASCADE,
+   user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+   PRIMARY KEY (organisation_id, user_id)
+);
+
+CREATE INDEX idx_organisation_members_user_id ON organisation_members(user_id);
+
+-- Users that already issued credentials for an organisation keep access to it
+INSERT INTO organisation_members(organisation_id, user_id)
+SELECT organisation_id, user_id FROM api_keys
+UNION
+SELECT organisation_id, user_id FROM oauth_clients
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS taxpayer_profiles;
+DROP TYPE IF EXISTS taxpayer_id_types;
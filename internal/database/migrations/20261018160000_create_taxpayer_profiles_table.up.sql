@@ -0,0 +1,34 @@
+-- Registration schemes LHDN accepts next to the TIN
+CREATE TYPE taxpayer_id_types AS ENUM ('NRIC', 'BRN', 'PASSPORT', 'ARMY');
+
+-- Taxpayer Profiles Table, the supplier block of every document an organisation issues.
+-- Column sizes follow the LHDN e-Invoice field limits.
+CREATE TABLE IF NOT EXISTS taxpayer_profiles(
+   id bigserial PRIMARY KEY,
+   organisation_id BIGINT UNIQUE NOT NULL REFERENCES organisations(id) ON DELETE CASCADE,
+   name VARCHAR(300) NOT NULL,
+   tin VARCHAR(14) NOT NULL,
+   id_type taxpayer_id_types NOT NULL,
+   id_value VARCHAR(20) NOT NULL,
+   -- "NA" when not registered
+   sst_registration_number VARCHAR(35) NOT NULL DEFAULT 'NA',
+   tourism_tax_registration_number VARCHAR(17) NOT NULL DEFAULT 'NA',
+   msic_code VARCHAR(5) NOT NULL,
+   business_activity_description VARCHAR(300) NOT NULL,
+   address_line1 VARCHAR(150) NOT NULL,
+   address_line2 VARCHAR(150),
+   address_line3 VARCHAR(150),
+   postal_zone VARCHAR(50),
+   city_name VARCHAR(50) NOT NULL,
+   state_code VARCHAR(2) NOT NULL,
+   country_code VARCHAR(3) NOT NULL DEFAULT 'MYS',
+   phone VARCHAR(20) NOT NULL,
+   email VARCHAR(320),
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+   updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER taxpayer_profiles_update_timestamp
+BEFORE UPDATE ON taxpayer_profiles
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS invoice_parties;
+DROP TABLE IF EXISTS invoice_allowance_charges;
+DROP TABLE IF EXISTS invoice_line_tax_breakdowns;
+DROP TABLE IF EXISTS invoice_lines;
+DROP TABLE IF EXISTS invoices;
+DROP TYPE IF EXISTS invoice_party_roles;
+DROP TYPE IF EXISTS invoice_statuses;
@@ -0,0 +1,125 @@
+-- Lifecycle of a document, from a local draft to the outcome of the LHDN validation
+CREATE TYPE invoice_statuses AS ENUM ('draft', 'validated', 'submitted', 'valid', 'invalid', 'cancelled', 'rejected');
+
+CREATE TYPE invoice_party_roles AS ENUM ('supplier', 'buyer', 'shipping_recipient');
+
+-- Invoices Table. Amounts are in the document currency, totals are derived from the lines.
+CREATE TABLE IF NOT EXISTS invoices(
+   id bigserial PRIMARY KEY,
+   organisation_id BIGINT NOT NULL REFERENCES organisations(id) ON DELETE CASCADE,
+   -- Supplier's internal document number, unique per organisation
+   invoice_number VARCHAR(50) NOT NULL,
+   type_code VARCHAR(2) NOT NULL DEFAULT '01',
+   status invoice_statuses NOT NULL DEFAULT 'draft',
+   issue_date DATE NOT NULL,
+   currency_code VARCHAR(3) NOT NULL DEFAULT 'MYR',
+   -- Rate to MYR, required for foreign currency documents
+   exchange_rate NUMERIC(15, 6),
+   billing_period_start DATE,
+   billing_period_end DATE,
+   billing_frequency VARCHAR(50),
+   payment_mode VARCHAR(2),
+   payment_terms VARCHAR(300),
+   supplier_bank_account VARCHAR(150),
+   total_line_amount NUMERIC(18, 2) NOT NULL DEFAULT 0,
+   total_allowance_amount NUMERIC(18, 2) NOT NULL DEFAULT 0,
+   total_charge_amount NUMERIC(18, 2) NOT NULL DEFAULT 0,
+   total_excluding_tax NUMERIC(18, 2) NOT NULL DEFAULT 0,
+   total_tax_amount NUMERIC(18, 2) NOT NULL DEFAULT 0,
+   total_including_tax NUMERIC(18, 2) NOT NULL DEFAULT 0,
+   rounding_amount NUMERIC(18, 2) NOT NULL DEFAULT 0,
+   payable_amount NUMERIC(18, 2) NOT NULL DEFAULT 0,
+   created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+   updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+   UNIQUE (organisation_id, invoice_number)
+);
+
+CREATE INDEX invoices_organisation_id_status_idx ON invoices(organisation_id, status);
+
+CREATE TRIGGER invoices_update_timestamp
+BEFORE UPDATE ON invoices
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+-- Invoice Lines Table
+CREATE TABLE IF NOT EXISTS invoice_lines(
+   id bigserial PRIMARY KEY,
+   invoice_id BIGINT NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+   line_number INT NOT NULL,
+   -- LHDN classification code, e.g. 022 for others
+   classification_code VARCHAR(3) NOT NULL,
+   description VARCHAR(300) NOT NULL,
+   quantity NUMERIC(18, 6) NOT NULL,
+   -- UN/ECE Recommendation 20 unit of measure, e.g. C62 for one
+   unit_code VARCHAR(3),
+   unit_price NUMERIC(18, 6) NOT NULL,
+   product_tariff_code VARCHAR(12),
+   country_of_origin VARCHAR(3),
+   -- quantity * unit_price
+   subtotal NUMERIC(18, 2) NOT NULL DEFAULT 0,
+   -- subtotal less line allowances plus line charges
+   total_excluding_tax NUMERIC(18, 2) NOT NULL DEFAULT 0,
+   tax_amount NUMERIC(18, 2) NOT NULL DEFAULT 0,
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+   UNIQUE (invoice_id, line_number)
+);
+
+-- Invoice Line Tax Breakdowns Table, one row per tax type applied to a line
+CREATE TABLE IF NOT EXISTS invoice_line_tax_breakdowns(
+   id bigserial PRIMARY KEY,
+   invoice_line_id BIGINT NOT NULL REFERENCES invoice_lines(id) ON DELETE CASCADE,
+   -- LHDN tax type, 01 to 06 or E for exempted
+   tax_type VARCHAR(2) NOT NULL,
+   -- Percentage rate, NULL for taxes charged per unit
+   rate NUMERIC(7, 4),
+   per_unit_amount NUMERIC(18, 2),
+   exemption_reason VARCHAR(300),
+   taxable_amount NUMERIC(18, 2) NOT NULL DEFAULT 0,
+   tax_amount NUMERIC(18, 2) NOT NULL DEFAULT 0,
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX invoice_line_tax_breakdowns_invoice_line_id_idx ON invoice_line_tax_breakdowns(invoice_line_id);
+
+-- Invoice Allowance Charges Table. Discounts (is_charge false) and fees (is_charge true) on a line,
+-- or on the whole document when invoice_line_id is NULL.
+CREATE TABLE IF NOT EXISTS invoice_allowance_charges(
+   id bigserial PRIMARY KEY,
+   invoice_id BIGINT NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+   invoice_line_id BIGINT REFERENCES invoice_lines(id) ON DELETE CASCADE,
+   is_charge BOOLEAN NOT NULL,
+   reason VARCHAR(300),
+   -- Percentage of the base amount, NULL for a fixed amount
+   rate NUMERIC(7, 4),
+   amount NUMERIC(18, 2) NOT NULL DEFAULT 0,
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX invoice_allowance_charges_invoice_id_idx ON invoice_allowance_charges(invoice_id);
+
+-- Invoice Parties Table. Copies of the supplier and buyer details as they were when the document was issued.
+CREATE TABLE IF NOT EXISTS invoice_parties(
+   id bigserial PRIMARY KEY,
+   invoice_id BIGINT NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+   role invoice_party_roles NOT NULL,
+   name VARCHAR(300) NOT NULL,
+   tin VARCHAR(14) NOT NULL,
+   id_type taxpayer_id_types NOT NULL,
+   id_value VARCHAR(20) NOT NULL,
+   sst_registration_number VARCHAR(35) NOT NULL DEFAULT 'NA',
+   tourism_tax_registration_number VARCHAR(17),
+   msic_code VARCHAR(5),
+   business_activity_description VARCHAR(300),
+   address_line1 VARCHAR(150) NOT NULL,
+   address_line2 VARCHAR(150),
+   address_line3 VARCHAR(150),
+   postal_zone VARCHAR(50),
+   city_name VARCHAR(50) NOT NULL,
+   state_code VARCHAR(2) NOT NULL,
+   country_code VARCHAR(3) NOT NULL DEFAULT 'MYS',
+   phone VARCHAR(20),
+   email VARCHAR(320),
+   created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+   UNIQUE (invoice_id, role)
+);
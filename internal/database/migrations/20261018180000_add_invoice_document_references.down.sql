@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_invoices_original_invoice_id;
+
+ALTER TABLE invoices
+DROP CONSTRAINT IF EXISTS invoices_type_code_check,
+DROP COLUMN IF EXISTS original_invoice_number,
+DROP COLUMN IF EXISTS original_uuid,
+DROP COLUMN IF EXISTS original_invoice_id,
+DROP COLUMN IF EXISTS uuid;
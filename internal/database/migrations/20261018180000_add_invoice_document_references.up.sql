@@ -0,0 +1,10 @@
+-- UUID assigned by LHDN once a document is accepted, and the document a credit, debit or refund note adjusts.
+-- The original's UUID and internal ID are copied as the note must keep referencing them.
+ALTER TABLE invoices
+ADD COLUMN IF NOT EXISTS uuid VARCHAR(26) UNIQUE,
+ADD COLUMN IF NOT EXISTS original_invoice_id BIGINT REFERENCES invoices(id) ON DELETE RESTRICT,
+ADD COLUMN IF NOT EXISTS original_uuid VARCHAR(26),
+ADD COLUMN IF NOT EXISTS original_invoice_number VARCHAR(50),
+ADD CONSTRAINT invoices_type_code_check CHECK (type_code IN ('01', '02', '03', '04', '11', '12', '13', '14'));
+
+CREATE INDEX idx_invoices_original_invoice_id ON invoices(original_invoice_id);
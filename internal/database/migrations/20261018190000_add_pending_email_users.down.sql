@@ -0,0 +1,9 @@
+DELETE FROM auth_tokens WHERE type = 'email_change';
+
+-- Enum values cannot be dropped, so the type is recreated without it
+ALTER TYPE auth_token_types RENAME TO auth_token_types_old;
+CREATE TYPE auth_token_types AS ENUM ('access', 'refresh', 'reset_password', 'email_verification');
+ALTER TABLE auth_tokens ALTER COLUMN type TYPE auth_token_types USING type::text::auth_token_types;
+DROP TYPE auth_token_types_old;
+
+ALTER TABLE users DROP COLUMN IF EXISTS pending_email;
@@ -0,0 +1,4 @@
+-- A changed email is kept here until the new address is confirmed
+ALTER TABLE users ADD COLUMN IF NOT EXISTS pending_email VARCHAR (300);
+
+ALTER TYPE auth_token_types ADD VALUE IF NOT EXISTS 'email_change';
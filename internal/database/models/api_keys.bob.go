@@ -0,0 +1,1032 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/lib/pq"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// APIKey is an object representing the database table.
+type APIKey struct {
+	ID             int64               `db:"id,pk" `
+	OrganisationID int64               `db:"organisation_id" `
+	UserID         int64               `db:"user_id" `
+	Name           string              `db:"name" `
+	Prefix         string              `db:"prefix" `
+	KeyHash        string              `db:"key_hash" `
+	Scopes         pq.StringArray      `db:"scopes" `
+	ExpireAt       null.Val[time.Time] `db:"expire_at" `
+	LastUsedAt     null.Val[time.Time] `db:"last_used_at" `
+	RevokedAt      null.Val[time.Time] `db:"revoked_at" `
+	CreatedAt      null.Val[time.Time] `db:"created_at" `
+	UpdatedAt      null.Val[time.Time] `db:"updated_at" `
+
+	R apiKeyR `db:"-" `
+}
+
+// APIKeySlice is an alias for a slice of pointers to APIKey.
+// This should almost always be used instead of []*APIKey.
+type APIKeySlice []*APIKey
+
+// APIKeys contains methods to work with the api_keys table
+var APIKeys = psql.NewTablex[*APIKey, APIKeySlice, *APIKeySetter]("", "api_keys", buildAPIKeyColumns("api_keys"))
+
+// APIKeysQuery is a query on the api_keys table
+type APIKeysQuery = *psql.ViewQuery[*APIKey, APIKeySlice]
+
+// apiKeyR is where relationships are stored.
+type apiKeyR struct {
+	Organisation *Organisation // api_keys.api_keys_organisation_id_fkey
+	User         *User         // api_keys.api_keys_user_id_fkey
+}
+
+func buildAPIKeyColumns(alias string) apiKeyColumns {
+	return apiKeyColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "organisation_id", "user_id", "name", "prefix", "key_hash", "scopes", "expire_at", "last_used_at", "revoked_at", "created_at", "updated_at",
+		).WithParent("api_keys"),
+		tableAlias:     alias,
+		ID:             psql.Quote(alias, "id"),
+		OrganisationID: psql.Quote(alias, "organisation_id"),
+		UserID:         psql.Quote(alias, "user_id"),
+		Name:           psql.Quote(alias, "name"),
+		Prefix:         psql.Quote(alias, "prefix"),
+		KeyHash:        psql.Quote(alias, "key_hash"),
+		Scopes:         psql.Quote(alias, "scopes"),
+		ExpireAt:       psql.Quote(alias, "expire_at"),
+		LastUsedAt:     psql.Quote(alias, "last_used_at"),
+		RevokedAt:      psql.Quote(alias, "revoked_at"),
+		CreatedAt:      psql.Quote(alias, "created_at"),
+		UpdatedAt:      psql.Quote(alias, "updated_at"),
+	}
+}
+
+type apiKeyColumns struct {
+	expr.ColumnsExpr
+	tableAlias     string
+	ID             psql.Expression
+	OrganisationID psql.Expression
+	UserID         psql.Expression
+	Name           psql.Expression
+	Prefix         psql.Expression
+	KeyHash        psql.Expression
+	Scopes         psql.Expression
+	ExpireAt       psql.Expression
+	LastUsedAt     psql.Expression
+	RevokedAt      psql.Expression
+	CreatedAt      psql.Expression
+	UpdatedAt      psql.Expression
+}
+
+func (c apiKeyColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (apiKeyColumns) AliasedAs(alias string) apiKeyColumns {
+	return buildAPIKeyColumns(alias)
+}
+
+// APIKeySetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type APIKeySetter struct {
+	ID             omit.Val[int64]          `db:"id,pk" `
+	OrganisationID omit.Val[int64]          `db:"organisation_id" `
+	UserID         omit.Val[int64]          `db:"user_id" `
+	Name           omit.Val[string]         `db:"name" `
+	Prefix         omit.Val[string]         `db:"prefix" `
+	KeyHash        omit.Val[string]         `db:"key_hash" `
+	Scopes         omit.Val[pq.StringArray] `db:"scopes" `
+	ExpireAt       omitnull.Val[time.Time]  `db:"expire_at" `
+	LastUsedAt     omitnull.Val[time.Time]  `db:"last_used_at" `
+	RevokedAt      omitnull.Val[time.Time]  `db:"revoked_at" `
+	CreatedAt      omitnull.Val[time.Time]  `db:"created_at" `
+	UpdatedAt      omitnull.Val[time.Time]  `db:"updated_at" `
+}
+
+func (s APIKeySetter) SetColumns() []string {
+	vals := make([]string, 0, 12)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.OrganisationID.IsValue() {
+		vals = append(vals, "organisation_id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if s.Prefix.IsValue() {
+		vals = append(vals, "prefix")
+	}
+	if s.KeyHash.IsValue() {
+		vals = append(vals, "key_hash")
+	}
+	if s.Scopes.IsValue() {
+		vals = append(vals, "scopes")
+	}
+	if !s.ExpireAt.IsUnset() {
+		vals = append(vals, "expire_at")
+	}
+	if !s.LastUsedAt.IsUnset() {
+		vals = append(vals, "last_used_at")
+	}
+	if !s.RevokedAt.IsUnset() {
+		vals = append(vals, "revoked_at")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	if !s.UpdatedAt.IsUnset() {
+		vals = append(vals, "updated_at")
+	}
+	return vals
+}
+
+func (s APIKeySetter) Overwrite(t *APIKey) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.OrganisationID.IsValue() {
+		t.OrganisationID = s.OrganisationID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if s.Prefix.IsValue() {
+		t.Prefix = s.Prefix.MustGet()
+	}
+	if s.KeyHash.IsValue() {
+		t.KeyHash = s.KeyHash.MustGet()
+	}
+	if s.Scopes.IsValue() {
+		t.Scopes = s.Scopes.MustGet()
+	}
+	if !s.ExpireAt.IsUnset() {
+		t.ExpireAt = s.ExpireAt.MustGetNull()
+	}
+	if !s.LastUsedAt.IsUnset() {
+		t.LastUsedAt = s.LastUsedAt.MustGetNull()
+	}
+	if !s.RevokedAt.IsUnset() {
+		t.RevokedAt = s.RevokedAt.MustGetNull()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+	if !s.UpdatedAt.IsUnset() {
+		t.UpdatedAt = s.UpdatedAt.MustGetNull()
+	}
+}
+
+func (s *APIKeySetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return APIKeys.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 12)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.OrganisationID.IsValue() {
+			vals[1] = psql.Arg(s.OrganisationID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserID.IsValue() {
+			vals[2] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.Name.IsValue() {
+			vals[3] = psql.Arg(s.Name.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.Prefix.IsValue() {
+			vals[4] = psql.Arg(s.Prefix.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.KeyHash.IsValue() {
+			vals[5] = psql.Arg(s.KeyHash.MustGet())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.Scopes.IsValue() {
+			vals[6] = psql.Arg(s.Scopes.MustGet())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if !s.ExpireAt.IsUnset() {
+			vals[7] = psql.Arg(s.ExpireAt.MustGetNull())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if !s.LastUsedAt.IsUnset() {
+			vals[8] = psql.Arg(s.LastUsedAt.MustGetNull())
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		if !s.RevokedAt.IsUnset() {
+			vals[9] = psql.Arg(s.RevokedAt.MustGetNull())
+		} else {
+			vals[9] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[10] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[10] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UpdatedAt.IsUnset() {
+			vals[11] = psql.Arg(s.UpdatedAt.MustGetNull())
+		} else {
+			vals[11] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s APIKeySetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s APIKeySetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 12)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.OrganisationID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "organisation_id")...),
+			psql.Arg(s.OrganisationID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "name")...),
+			psql.Arg(s.Name),
+		}})
+	}
+
+	if s.Prefix.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "prefix")...),
+			psql.Arg(s.Prefix),
+		}})
+	}
+
+	if s.KeyHash.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "key_hash")...),
+			psql.Arg(s.KeyHash),
+		}})
+	}
+
+	if s.Scopes.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "scopes")...),
+			psql.Arg(s.Scopes),
+		}})
+	}
+
+	if !s.ExpireAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "expire_at")...),
+			psql.Arg(s.ExpireAt),
+		}})
+	}
+
+	if !s.LastUsedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "last_used_at")...),
+			psql.Arg(s.LastUsedAt),
+		}})
+	}
+
+	if !s.RevokedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "revoked_at")...),
+			psql.Arg(s.RevokedAt),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	if !s.UpdatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "updated_at")...),
+			psql.Arg(s.UpdatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindAPIKey retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindAPIKey(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*APIKey, error) {
+	if len(cols) == 0 {
+		return APIKeys.Query(
+			sm.Where(APIKeys.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return APIKeys.Query(
+		sm.Where(APIKeys.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(APIKeys.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// APIKeyExists checks the presence of a single record by primary key
+func APIKeyExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return APIKeys.Query(
+		sm.Where(APIKeys.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after APIKey is retrieved from the database
+func (o *APIKey) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = APIKeys.AfterSelectHooks.RunHooks(ctx, exec, APIKeySlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = APIKeys.AfterInsertHooks.RunHooks(ctx, exec, APIKeySlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = APIKeys.AfterUpdateHooks.RunHooks(ctx, exec, APIKeySlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = APIKeys.AfterDeleteHooks.RunHooks(ctx, exec, APIKeySlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the APIKey
+func (o *APIKey) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *APIKey) pkEQ() dialect.Expression {
+	return psql.Quote("api_keys", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the APIKey
+func (o *APIKey) Update(ctx context.Context, exec bob.Executor, s *APIKeySetter) error {
+	v, err := APIKeys.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single APIKey record with an executor
+func (o *APIKey) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := APIKeys.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the APIKey using the executor
+func (o *APIKey) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := APIKeys.Query(
+		sm.Where(APIKeys.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after APIKeySlice is retrieved from the database
+func (o APIKeySlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = APIKeys.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = APIKeys.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = APIKeys.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = APIKeys.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o APIKeySlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("api_keys", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o APIKeySlice) copyMatchingRows(from ...*APIKey) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o APIKeySlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return APIKeys.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *APIKey:
+				o.copyMatchingRows(retrieved)
+			case []*APIKey:
+				o.copyMatchingRows(retrieved...)
+			case APIKeySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a APIKey or a slice of APIKey
+				// then run the AfterUpdateHooks on the slice
+				_, err = APIKeys.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o APIKeySlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return APIKeys.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *APIKey:
+				o.copyMatchingRows(retrieved)
+			case []*APIKey:
+				o.copyMatchingRows(retrieved...)
+			case APIKeySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a APIKey or a slice of APIKey
+				// then run the AfterDeleteHooks on the slice
+				_, err = APIKeys.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o APIKeySlice) UpdateAll(ctx context.Context, exec bob.Executor, vals APIKeySetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := APIKeys.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o APIKeySlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := APIKeys.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o APIKeySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := APIKeys.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Organisation starts a query for related objects on organisations
+func (o *APIKey) Organisation(mods ...bob.Mod[*dialect.SelectQuery]) OrganisationsQuery {
+	return Organisations.Query(append(mods,
+		sm.Where(Organisations.Columns.ID.EQ(psql.Arg(o.OrganisationID))),
+	)...)
+}
+
+func (os APIKeySlice) Organisation(mods ...bob.Mod[*dialect.SelectQuery]) OrganisationsQuery {
+	pkOrganisationID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkOrganisationID = append(pkOrganisationID, o.OrganisationID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkOrganisationID), "bigint[]")),
+	))
+
+	return Organisations.Query(append(mods,
+		sm.Where(psql.Group(Organisations.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// User starts a query for related objects on users
+func (o *APIKey) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os APIKeySlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "bigint[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachAPIKeyOrganisation0(ctx context.Context, exec bob.Executor, count int, apiKey0 *APIKey, organisation1 *Organisation) (*APIKey, error) {
+	setter := &APIKeySetter{
+		OrganisationID: omit.From(organisation1.ID),
+	}
+
+	err := apiKey0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachAPIKeyOrganisation0: %w", err)
+	}
+
+	return apiKey0, nil
+}
+
+func (apiKey0 *APIKey) InsertOrganisation(ctx context.Context, exec bob.Executor, related *OrganisationSetter) error {
+	var err error
+
+	organisation1, err := Organisations.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachAPIKeyOrganisation0(ctx, exec, 1, apiKey0, organisation1)
+	if err != nil {
+		return err
+	}
+
+	apiKey0.R.Organisation = organisation1
+
+	organisation1.R.APIKeys = append(organisation1.R.APIKeys, apiKey0)
+
+	return nil
+}
+
+func (apiKey0 *APIKey) AttachOrganisation(ctx context.Context, exec bob.Executor, organisation1 *Organisation) error {
+	var err error
+
+	_, err = attachAPIKeyOrganisation0(ctx, exec, 1, apiKey0, organisation1)
+	if err != nil {
+		return err
+	}
+
+	apiKey0.R.Organisation = organisation1
+
+	organisation1.R.APIKeys = append(organisation1.R.APIKeys, apiKey0)
+
+	return nil
+}
+
+func attachAPIKeyUser0(ctx context.Context, exec bob.Executor, count int, apiKey0 *APIKey, user1 *User) (*APIKey, error) {
+	setter := &APIKeySetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := apiKey0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachAPIKeyUser0: %w", err)
+	}
+
+	return apiKey0, nil
+}
+
+func (apiKey0 *APIKey) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachAPIKeyUser0(ctx, exec, 1, apiKey0, user1)
+	if err != nil {
+		return err
+	}
+
+	apiKey0.R.User = user1
+
+	user1.R.APIKeys = append(user1.R.APIKeys, apiKey0)
+
+	return nil
+}
+
+func (apiKey0 *APIKey) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachAPIKeyUser0(ctx, exec, 1, apiKey0, user1)
+	if err != nil {
+		return err
+	}
+
+	apiKey0.R.User = user1
+
+	user1.R.APIKeys = append(user1.R.APIKeys, apiKey0)
+
+	return nil
+}
+
+type apiKeyWhere[Q psql.Filterable] struct {
+	ID             psql.WhereMod[Q, int64]
+	OrganisationID psql.WhereMod[Q, int64]
+	UserID         psql.WhereMod[Q, int64]
+	Name           psql.WhereMod[Q, string]
+	Prefix         psql.WhereMod[Q, string]
+	KeyHash        psql.WhereMod[Q, string]
+	Scopes         psql.WhereMod[Q, pq.StringArray]
+	ExpireAt       psql.WhereNullMod[Q, time.Time]
+	LastUsedAt     psql.WhereNullMod[Q, time.Time]
+	RevokedAt      psql.WhereNullMod[Q, time.Time]
+	CreatedAt      psql.WhereNullMod[Q, time.Time]
+	UpdatedAt      psql.WhereNullMod[Q, time.Time]
+}
+
+func (apiKeyWhere[Q]) AliasedAs(alias string) apiKeyWhere[Q] {
+	return buildAPIKeyWhere[Q](buildAPIKeyColumns(alias))
+}
+
+func buildAPIKeyWhere[Q psql.Filterable](cols apiKeyColumns) apiKeyWhere[Q] {
+	return apiKeyWhere[Q]{
+		ID:             psql.Where[Q, int64](cols.ID),
+		OrganisationID: psql.Where[Q, int64](cols.OrganisationID),
+		UserID:         psql.Where[Q, int64](cols.UserID),
+		Name:           psql.Where[Q, string](cols.Name),
+		Prefix:         psql.Where[Q, string](cols.Prefix),
+		KeyHash:        psql.Where[Q, string](cols.KeyHash),
+		Scopes:         psql.Where[Q, pq.StringArray](cols.Scopes),
+		ExpireAt:       psql.WhereNull[Q, time.Time](cols.ExpireAt),
+		LastUsedAt:     psql.WhereNull[Q, time.Time](cols.LastUsedAt),
+		RevokedAt:      psql.WhereNull[Q, time.Time](cols.RevokedAt),
+		CreatedAt:      psql.WhereNull[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:      psql.WhereNull[Q, time.Time](cols.UpdatedAt),
+	}
+}
+
+func (o *APIKey) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Organisation":
+		rel, ok := retrieved.(*Organisation)
+		if !ok {
+			return fmt.Errorf("apiKey cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Organisation = rel
+
+		if rel != nil {
+			rel.R.APIKeys = APIKeySlice{o}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("apiKey cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.APIKeys = APIKeySlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("apiKey has no relationship %q", name)
+	}
+}
+
+type apiKeyPreloader struct {
+	Organisation func(...psql.PreloadOption) psql.Preloader
+	User         func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildAPIKeyPreloader() apiKeyPreloader {
+	return apiKeyPreloader{
+		Organisation: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Organisation, OrganisationSlice](psql.PreloadRel{
+				Name: "Organisation",
+				Sides: []psql.PreloadSide{
+					{
+						From:        APIKeys,
+						To:          Organisations,
+						FromColumns: []string{"organisation_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Organisations.Columns.Names(), opts...)
+		},
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        APIKeys,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type apiKeyThenLoader[Q orm.Loadable] struct {
+	Organisation func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildAPIKeyThenLoader[Q orm.Loadable]() apiKeyThenLoader[Q] {
+	type OrganisationLoadInterface interface {
+		LoadOrganisation(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return apiKeyThenLoader[Q]{
+		Organisation: thenLoadBuilder[Q](
+			"Organisation",
+			func(ctx context.Context, exec bob.Executor, retrieved OrganisationLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadOrganisation(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadOrganisation loads the apiKey's Organisation into the .R struct
+func (o *APIKey) LoadOrganisation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Organisation = nil
+
+	related, err := o.Organisation(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.APIKeys = APIKeySlice{o}
+
+	o.R.Organisation = related
+	return nil
+}
+
+// LoadOrganisation loads the apiKey's Organisation into the .R struct
+func (os APIKeySlice) LoadOrganisation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	organisations, err := os.Organisation(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range organisations {
+
+			if !(o.OrganisationID == rel.ID) {
+				continue
+			}
+
+			rel.R.APIKeys = append(rel.R.APIKeys, o)
+
+			o.R.Organisation = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the apiKey's User into the .R struct
+func (o *APIKey) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.APIKeys = APIKeySlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the apiKey's User into the .R struct
+func (os APIKeySlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.APIKeys = append(rel.R.APIKeys, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type apiKeyJoins[Q dialect.Joinable] struct {
+	typ          string
+	Organisation modAs[Q, organisationColumns]
+	User         modAs[Q, userColumns]
+}
+
+func (j apiKeyJoins[Q]) aliasedAs(alias string) apiKeyJoins[Q] {
+	return buildAPIKeyJoins[Q](buildAPIKeyColumns(alias), j.typ)
+}
+
+func buildAPIKeyJoins[Q dialect.Joinable](cols apiKeyColumns, typ string) apiKeyJoins[Q] {
+	return apiKeyJoins[Q]{
+		typ: typ,
+		Organisation: modAs[Q, organisationColumns]{
+			c: Organisations.Columns,
+			f: func(to organisationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Organisations.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.OrganisationID),
+					))
+				}
+
+				return mods
+			},
+		},
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
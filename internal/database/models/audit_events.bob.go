@@ -0,0 +1,679 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/types"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// AuditEvent is an object representing the database table.
+type AuditEvent struct {
+	ID             int64                                 `db:"id,pk" `
+	ActorType      string                                `db:"actor_type" `
+	ActorID        null.Val[int64]                       `db:"actor_id" `
+	ActorLabel     null.Val[string]                      `db:"actor_label" `
+	OrganisationID null.Val[int64]                       `db:"organisation_id" `
+	Action         string                                `db:"action" `
+	EntityType     null.Val[string]                      `db:"entity_type" `
+	EntityID       null.Val[string]                      `db:"entity_id" `
+	IPAddress      null.Val[pgtypes.Inet]                `db:"ip_address" `
+	UserAgent      null.Val[string]                      `db:"user_agent" `
+	RequestID      null.Val[string]                      `db:"request_id" `
+	Changes        null.Val[types.JSON[json.RawMessage]] `db:"changes" `
+	Metadata       null.Val[types.JSON[json.RawMessage]] `db:"metadata" `
+	CreatedAt      time.Time                             `db:"created_at" `
+}
+
+// AuditEventSlice is an alias for a slice of pointers to AuditEvent.
+// This should almost always be used instead of []*AuditEvent.
+type AuditEventSlice []*AuditEvent
+
+// AuditEvents contains methods to work with the audit_events table
+var AuditEvents = psql.NewTablex[*AuditEvent, AuditEventSlice, *AuditEventSetter]("", "audit_events", buildAuditEventColumns("audit_events"))
+
+// AuditEventsQuery is a query on the audit_events table
+type AuditEventsQuery = *psql.ViewQuery[*AuditEvent, AuditEventSlice]
+
+func buildAuditEventColumns(alias string) auditEventColumns {
+	return auditEventColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "actor_type", "actor_id", "actor_label", "organisation_id", "action", "entity_type", "entity_id", "ip_address", "user_agent", "request_id", "changes", "metadata", "created_at",
+		).WithParent("audit_events"),
+		tableAlias:     alias,
+		ID:             psql.Quote(alias, "id"),
+		ActorType:      psql.Quote(alias, "actor_type"),
+		ActorID:        psql.Quote(alias, "actor_id"),
+		ActorLabel:     psql.Quote(alias, "actor_label"),
+		OrganisationID: psql.Quote(alias, "organisation_id"),
+		Action:         psql.Quote(alias, "action"),
+		EntityType:     psql.Quote(alias, "entity_type"),
+		EntityID:       psql.Quote(alias, "entity_id"),
+		IPAddress:      psql.Quote(alias, "ip_address"),
+		UserAgent:      psql.Quote(alias, "user_agent"),
+		RequestID:      psql.Quote(alias, "request_id"),
+		Changes:        psql.Quote(alias, "changes"),
+		Metadata:       psql.Quote(alias, "metadata"),
+		CreatedAt:      psql.Quote(alias, "created_at"),
+	}
+}
+
+type auditEventColumns struct {
+	expr.ColumnsExpr
+	tableAlias     string
+	ID             psql.Expression
+	ActorType      psql.Expression
+	ActorID        psql.Expression
+	ActorLabel     psql.Expression
+	OrganisationID psql.Expression
+	Action         psql.Expression
+	EntityType     psql.Expression
+	EntityID       psql.Expression
+	IPAddress      psql.Expression
+	UserAgent      psql.Expression
+	RequestID      psql.Expression
+	Changes        psql.Expression
+	Metadata       psql.Expression
+	CreatedAt      psql.Expression
+}
+
+func (c auditEventColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (auditEventColumns) AliasedAs(alias string) auditEventColumns {
+	return buildAuditEventColumns(alias)
+}
+
+// AuditEventSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type AuditEventSetter struct {
+	ID             omit.Val[int64]                           `db:"id,pk" `
+	ActorType      omit.Val[string]                          `db:"actor_type" `
+	ActorID        omitnull.Val[int64]                       `db:"actor_id" `
+	ActorLabel     omitnull.Val[string]                      `db:"actor_label" `
+	OrganisationID omitnull.Val[int64]                       `db:"organisation_id" `
+	Action         omit.Val[string]                          `db:"action" `
+	EntityType     omitnull.Val[string]                      `db:"entity_type" `
+	EntityID       omitnull.Val[string]                      `db:"entity_id" `
+	IPAddress      omitnull.Val[pgtypes.Inet]                `db:"ip_address" `
+	UserAgent      omitnull.Val[string]                      `db:"user_agent" `
+	RequestID      omitnull.Val[string]                      `db:"request_id" `
+	Changes        omitnull.Val[types.JSON[json.RawMessage]] `db:"changes" `
+	Metadata       omitnull.Val[types.JSON[json.RawMessage]] `db:"metadata" `
+	CreatedAt      omit.Val[time.Time]                       `db:"created_at" `
+}
+
+func (s AuditEventSetter) SetColumns() []string {
+	vals := make([]string, 0, 14)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.ActorType.IsValue() {
+		vals = append(vals, "actor_type")
+	}
+	if !s.ActorID.IsUnset() {
+		vals = append(vals, "actor_id")
+	}
+	if !s.ActorLabel.IsUnset() {
+		vals = append(vals, "actor_label")
+	}
+	if !s.OrganisationID.IsUnset() {
+		vals = append(vals, "organisation_id")
+	}
+	if s.Action.IsValue() {
+		vals = append(vals, "action")
+	}
+	if !s.EntityType.IsUnset() {
+		vals = append(vals, "entity_type")
+	}
+	if !s.EntityID.IsUnset() {
+		vals = append(vals, "entity_id")
+	}
+	if !s.IPAddress.IsUnset() {
+		vals = append(vals, "ip_address")
+	}
+	if !s.UserAgent.IsUnset() {
+		vals = append(vals, "user_agent")
+	}
+	if !s.RequestID.IsUnset() {
+		vals = append(vals, "request_id")
+	}
+	if !s.Changes.IsUnset() {
+		vals = append(vals, "changes")
+	}
+	if !s.Metadata.IsUnset() {
+		vals = append(vals, "metadata")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s AuditEventSetter) Overwrite(t *AuditEvent) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.ActorType.IsValue() {
+		t.ActorType = s.ActorType.MustGet()
+	}
+	if !s.ActorID.IsUnset() {
+		t.ActorID = s.ActorID.MustGetNull()
+	}
+	if !s.ActorLabel.IsUnset() {
+		t.ActorLabel = s.ActorLabel.MustGetNull()
+	}
+	if !s.OrganisationID.IsUnset() {
+		t.OrganisationID = s.OrganisationID.MustGetNull()
+	}
+	if s.Action.IsValue() {
+		t.Action = s.Action.MustGet()
+	}
+	if !s.EntityType.IsUnset() {
+		t.EntityType = s.EntityType.MustGetNull()
+	}
+	if !s.EntityID.IsUnset() {
+		t.EntityID = s.EntityID.MustGetNull()
+	}
+	if !s.IPAddress.IsUnset() {
+		t.IPAddress = s.IPAddress.MustGetNull()
+	}
+	if !s.UserAgent.IsUnset() {
+		t.UserAgent = s.UserAgent.MustGetNull()
+	}
+	if !s.RequestID.IsUnset() {
+		t.RequestID = s.RequestID.MustGetNull()
+	}
+	if !s.Changes.IsUnset() {
+		t.Changes = s.Changes.MustGetNull()
+	}
+	if !s.Metadata.IsUnset() {
+		t.Metadata = s.Metadata.MustGetNull()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *AuditEventSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return AuditEvents.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 14)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.ActorType.IsValue() {
+			vals[1] = psql.Arg(s.ActorType.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.ActorID.IsUnset() {
+			vals[2] = psql.Arg(s.ActorID.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if !s.ActorLabel.IsUnset() {
+			vals[3] = psql.Arg(s.ActorLabel.MustGetNull())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if !s.OrganisationID.IsUnset() {
+			vals[4] = psql.Arg(s.OrganisationID.MustGetNull())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.Action.IsValue() {
+			vals[5] = psql.Arg(s.Action.MustGet())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if !s.EntityType.IsUnset() {
+			vals[6] = psql.Arg(s.EntityType.MustGetNull())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if !s.EntityID.IsUnset() {
+			vals[7] = psql.Arg(s.EntityID.MustGetNull())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if !s.IPAddress.IsUnset() {
+			vals[8] = psql.Arg(s.IPAddress.MustGetNull())
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UserAgent.IsUnset() {
+			vals[9] = psql.Arg(s.UserAgent.MustGetNull())
+		} else {
+			vals[9] = psql.Raw("DEFAULT")
+		}
+
+		if !s.RequestID.IsUnset() {
+			vals[10] = psql.Arg(s.RequestID.MustGetNull())
+		} else {
+			vals[10] = psql.Raw("DEFAULT")
+		}
+
+		if !s.Changes.IsUnset() {
+			vals[11] = psql.Arg(s.Changes.MustGetNull())
+		} else {
+			vals[11] = psql.Raw("DEFAULT")
+		}
+
+		if !s.Metadata.IsUnset() {
+			vals[12] = psql.Arg(s.Metadata.MustGetNull())
+		} else {
+			vals[12] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals[13] = psql.Arg(s.CreatedAt.MustGet())
+		} else {
+			vals[13] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s AuditEventSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s AuditEventSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 14)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.ActorType.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "actor_type")...),
+			psql.Arg(s.ActorType),
+		}})
+	}
+
+	if !s.ActorID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "actor_id")...),
+			psql.Arg(s.ActorID),
+		}})
+	}
+
+	if !s.ActorLabel.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "actor_label")...),
+			psql.Arg(s.ActorLabel),
+		}})
+	}
+
+	if !s.OrganisationID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "organisation_id")...),
+			psql.Arg(s.OrganisationID),
+		}})
+	}
+
+	if s.Action.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "action")...),
+			psql.Arg(s.Action),
+		}})
+	}
+
+	if !s.EntityType.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "entity_type")...),
+			psql.Arg(s.EntityType),
+		}})
+	}
+
+	if !s.EntityID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "entity_id")...),
+			psql.Arg(s.EntityID),
+		}})
+	}
+
+	if !s.IPAddress.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "ip_address")...),
+			psql.Arg(s.IPAddress),
+		}})
+	}
+
+	if !s.UserAgent.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_agent")...),
+			psql.Arg(s.UserAgent),
+		}})
+	}
+
+	if !s.RequestID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "request_id")...),
+			psql.Arg(s.RequestID),
+		}})
+	}
+
+	if !s.Changes.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "changes")...),
+			psql.Arg(s.Changes),
+		}})
+	}
+
+	if !s.Metadata.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "metadata")...),
+			psql.Arg(s.Metadata),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindAuditEvent retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindAuditEvent(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*AuditEvent, error) {
+	if len(cols) == 0 {
+		return AuditEvents.Query(
+			sm.Where(AuditEvents.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return AuditEvents.Query(
+		sm.Where(AuditEvents.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(AuditEvents.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// AuditEventExists checks the presence of a single record by primary key
+func AuditEventExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return AuditEvents.Query(
+		sm.Where(AuditEvents.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after AuditEvent is retrieved from the database
+func (o *AuditEvent) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = AuditEvents.AfterSelectHooks.RunHooks(ctx, exec, AuditEventSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = AuditEvents.AfterInsertHooks.RunHooks(ctx, exec, AuditEventSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = AuditEvents.AfterUpdateHooks.RunHooks(ctx, exec, AuditEventSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = AuditEvents.AfterDeleteHooks.RunHooks(ctx, exec, AuditEventSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the AuditEvent
+func (o *AuditEvent) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *AuditEvent) pkEQ() dialect.Expression {
+	return psql.Quote("audit_events", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the AuditEvent
+func (o *AuditEvent) Update(ctx context.Context, exec bob.Executor, s *AuditEventSetter) error {
+	v, err := AuditEvents.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single AuditEvent record with an executor
+func (o *AuditEvent) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := AuditEvents.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the AuditEvent using the executor
+func (o *AuditEvent) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := AuditEvents.Query(
+		sm.Where(AuditEvents.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after AuditEventSlice is retrieved from the database
+func (o AuditEventSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = AuditEvents.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = AuditEvents.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = AuditEvents.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = AuditEvents.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o AuditEventSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("audit_events", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o AuditEventSlice) copyMatchingRows(from ...*AuditEvent) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o AuditEventSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return AuditEvents.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *AuditEvent:
+				o.copyMatchingRows(retrieved)
+			case []*AuditEvent:
+				o.copyMatchingRows(retrieved...)
+			case AuditEventSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a AuditEvent or a slice of AuditEvent
+				// then run the AfterUpdateHooks on the slice
+				_, err = AuditEvents.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o AuditEventSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return AuditEvents.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *AuditEvent:
+				o.copyMatchingRows(retrieved)
+			case []*AuditEvent:
+				o.copyMatchingRows(retrieved...)
+			case AuditEventSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a AuditEvent or a slice of AuditEvent
+				// then run the AfterDeleteHooks on the slice
+				_, err = AuditEvents.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o AuditEventSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals AuditEventSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := AuditEvents.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o AuditEventSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := AuditEvents.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o AuditEventSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := AuditEvents.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type auditEventWhere[Q psql.Filterable] struct {
+	ID             psql.WhereMod[Q, int64]
+	ActorType      psql.WhereMod[Q, string]
+	ActorID        psql.WhereNullMod[Q, int64]
+	ActorLabel     psql.WhereNullMod[Q, string]
+	OrganisationID psql.WhereNullMod[Q, int64]
+	Action         psql.WhereMod[Q, string]
+	EntityType     psql.WhereNullMod[Q, string]
+	EntityID       psql.WhereNullMod[Q, string]
+	IPAddress      psql.WhereNullMod[Q, pgtypes.Inet]
+	UserAgent      psql.WhereNullMod[Q, string]
+	RequestID      psql.WhereNullMod[Q, string]
+	Changes        psql.WhereNullMod[Q, types.JSON[json.RawMessage]]
+	Metadata       psql.WhereNullMod[Q, types.JSON[json.RawMessage]]
+	CreatedAt      psql.WhereMod[Q, time.Time]
+}
+
+func (auditEventWhere[Q]) AliasedAs(alias string) auditEventWhere[Q] {
+	return buildAuditEventWhere[Q](buildAuditEventColumns(alias))
+}
+
+func buildAuditEventWhere[Q psql.Filterable](cols auditEventColumns) auditEventWhere[Q] {
+	return auditEventWhere[Q]{
+		ID:             psql.Where[Q, int64](cols.ID),
+		ActorType:      psql.Where[Q, string](cols.ActorType),
+		ActorID:        psql.WhereNull[Q, int64](cols.ActorID),
+		ActorLabel:     psql.WhereNull[Q, string](cols.ActorLabel),
+		OrganisationID: psql.WhereNull[Q, int64](cols.OrganisationID),
+		Action:         psql.Where[Q, string](cols.Action),
+		EntityType:     psql.WhereNull[Q, string](cols.EntityType),
+		EntityID:       psql.WhereNull[Q, string](cols.EntityID),
+		IPAddress:      psql.WhereNull[Q, pgtypes.Inet](cols.IPAddress),
+		UserAgent:      psql.WhereNull[Q, string](cols.UserAgent),
+		RequestID:      psql.WhereNull[Q, string](cols.RequestID),
+		Changes:        psql.WhereNull[Q, types.JSON[json.RawMessage]](cols.Changes),
+		Metadata:       psql.WhereNull[Q, types.JSON[json.RawMessage]](cols.Metadata),
+		CreatedAt:      psql.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
@@ -28,14 +28,18 @@ import (
 
 // AuthToken is an object representing the database table.
 type AuthToken struct {
-	ID        int64                `db:"id,pk" `
-	UserID    int64                `db:"user_id" `
-	Type      enums.AuthTokenTypes `db:"type" `
-	Token     string               `db:"token" `
-	ExpireAt  null.Val[time.Time]  `db:"expire_at" `
-	CreatedAt null.Val[time.Time]  `db:"created_at" `
-	UpdatedAt null.Val[time.Time]  `db:"updated_at" `
-	SessionID null.Val[uuid.UUID]  `db:"session_id" `
+	ID        int64                  `db:"id,pk" `
+	UserID    int64                  `db:"user_id" `
+	Type      enums.AuthTokenTypes   `db:"type" `
+	Token     string                 `db:"token" `
+	ExpireAt  null.Val[time.Time]    `db:"expire_at" `
+	CreatedAt null.Val[time.Time]    `db:"created_at" `
+	UpdatedAt null.Val[time.Time]    `db:"updated_at" `
+	SessionID null.Val[uuid.UUID]    `db:"session_id" `
+	RotatedAt null.Val[time.Time]    `db:"rotated_at" `
+	RevokedAt null.Val[time.Time]    `db:"revoked_at" `
+	IPAddress null.Val[pgtypes.Inet] `db:"ip_address" `
+	UserAgent null.Val[string]       `db:"user_agent" `
 
 	R authTokenR `db:"-" `
 }
@@ -58,7 +62,7 @@ type authTokenR struct {
 func buildAuthTokenColumns(alias string) authTokenColumns {
 	return authTokenColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "user_id", "type", "token", "expire_at", "created_at", "updated_at", "session_id",
+			"id", "user_id", "type", "token", "expire_at", "created_at", "updated_at", "session_id", "rotated_at", "revoked_at", "ip_address", "user_agent",
 		).WithParent("auth_tokens"),
 		tableAlias: alias,
 		ID:         psql.Quote(alias, "id"),
@@ -69,6 +73,10 @@ func buildAuthTokenColumns(alias string) authTokenColumns {
 		CreatedAt:  psql.Quote(alias, "created_at"),
 		UpdatedAt:  psql.Quote(alias, "updated_at"),
 		SessionID:  psql.Quote(alias, "session_id"),
+		RotatedAt:  psql.Quote(alias, "rotated_at"),
+		RevokedAt:  psql.Quote(alias, "revoked_at"),
+		IPAddress:  psql.Quote(alias, "ip_address"),
+		UserAgent:  psql.Quote(alias, "user_agent"),
 	}
 }
 
@@ -83,6 +91,10 @@ type authTokenColumns struct {
 	CreatedAt  psql.Expression
 	UpdatedAt  psql.Expression
 	SessionID  psql.Expression
+	RotatedAt  psql.Expression
+	RevokedAt  psql.Expression
+	IPAddress  psql.Expression
+	UserAgent  psql.Expression
 }
 
 func (c authTokenColumns) Alias() string {
@@ -105,10 +117,14 @@ type AuthTokenSetter struct {
 	CreatedAt omitnull.Val[time.Time]        `db:"created_at" `
 	UpdatedAt omitnull.Val[time.Time]        `db:"updated_at" `
 	SessionID omitnull.Val[uuid.UUID]        `db:"session_id" `
+	RotatedAt omitnull.Val[time.Time]        `db:"rotated_at" `
+	RevokedAt omitnull.Val[time.Time]        `db:"revoked_at" `
+	IPAddress omitnull.Val[pgtypes.Inet]     `db:"ip_address" `
+	UserAgent omitnull.Val[string]           `db:"user_agent" `
 }
 
 func (s AuthTokenSetter) SetColumns() []string {
-	vals := make([]string, 0, 8)
+	vals := make([]string, 0, 12)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
@@ -133,6 +149,18 @@ func (s AuthTokenSetter) SetColumns() []string {
 	if !s.SessionID.IsUnset() {
 		vals = append(vals, "session_id")
 	}
+	if !s.RotatedAt.IsUnset() {
+		vals = append(vals, "rotated_at")
+	}
+	if !s.RevokedAt.IsUnset() {
+		vals = append(vals, "revoked_at")
+	}
+	if !s.IPAddress.IsUnset() {
+		vals = append(vals, "ip_address")
+	}
+	if !s.UserAgent.IsUnset() {
+		vals = append(vals, "user_agent")
+	}
 	return vals
 }
 
@@ -161,6 +189,18 @@ func (s AuthTokenSetter) Overwrite(t *AuthToken) {
 	if !s.SessionID.IsUnset() {
 		t.SessionID = s.SessionID.MustGetNull()
 	}
+	if !s.RotatedAt.IsUnset() {
+		t.RotatedAt = s.RotatedAt.MustGetNull()
+	}
+	if !s.RevokedAt.IsUnset() {
+		t.RevokedAt = s.RevokedAt.MustGetNull()
+	}
+	if !s.IPAddress.IsUnset() {
+		t.IPAddress = s.IPAddress.MustGetNull()
+	}
+	if !s.UserAgent.IsUnset() {
+		t.UserAgent = s.UserAgent.MustGetNull()
+	}
 }
 
 func (s *AuthTokenSetter) Apply(q *dialect.InsertQuery) {
@@ -169,7 +209,7 @@ func (s *AuthTokenSetter) Apply(q *dialect.InsertQuery) {
 	})
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 8)
+		vals := make([]bob.Expression, 12)
 		if s.ID.IsValue() {
 			vals[0] = psql.Arg(s.ID.MustGet())
 		} else {
@@ -218,6 +258,30 @@ func (s *AuthTokenSetter) Apply(q *dialect.InsertQuery) {
 			vals[7] = psql.Raw("DEFAULT")
 		}
 
+		if !s.RotatedAt.IsUnset() {
+			vals[8] = psql.Arg(s.RotatedAt.MustGetNull())
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		if !s.RevokedAt.IsUnset() {
+			vals[9] = psql.Arg(s.RevokedAt.MustGetNull())
+		} else {
+			vals[9] = psql.Raw("DEFAULT")
+		}
+
+		if !s.IPAddress.IsUnset() {
+			vals[10] = psql.Arg(s.IPAddress.MustGetNull())
+		} else {
+			vals[10] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UserAgent.IsUnset() {
+			vals[11] = psql.Arg(s.UserAgent.MustGetNull())
+		} else {
+			vals[11] = psql.Raw("DEFAULT")
+		}
+
 		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
 	}))
 }
@@ -227,7 +291,7 @@ func (s AuthTokenSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s AuthTokenSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 8)
+	exprs := make([]bob.Expression, 0, 12)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -285,6 +349,34 @@ func (s AuthTokenSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if !s.RotatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "rotated_at")...),
+			psql.Arg(s.RotatedAt),
+		}})
+	}
+
+	if !s.RevokedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "revoked_at")...),
+			psql.Arg(s.RevokedAt),
+		}})
+	}
+
+	if !s.IPAddress.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "ip_address")...),
+			psql.Arg(s.IPAddress),
+		}})
+	}
+
+	if !s.UserAgent.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_agent")...),
+			psql.Arg(s.UserAgent),
+		}})
+	}
+
 	return exprs
 }
 
@@ -592,6 +684,10 @@ type authTokenWhere[Q psql.Filterable] struct {
 	CreatedAt psql.WhereNullMod[Q, time.Time]
 	UpdatedAt psql.WhereNullMod[Q, time.Time]
 	SessionID psql.WhereNullMod[Q, uuid.UUID]
+	RotatedAt psql.WhereNullMod[Q, time.Time]
+	RevokedAt psql.WhereNullMod[Q, time.Time]
+	IPAddress psql.WhereNullMod[Q, pgtypes.Inet]
+	UserAgent psql.WhereNullMod[Q, string]
 }
 
 func (authTokenWhere[Q]) AliasedAs(alias string) authTokenWhere[Q] {
@@ -608,6 +704,10 @@ func buildAuthTokenWhere[Q psql.Filterable](cols authTokenColumns) authTokenWher
 		CreatedAt: psql.WhereNull[Q, time.Time](cols.CreatedAt),
 		UpdatedAt: psql.WhereNull[Q, time.Time](cols.UpdatedAt),
 		SessionID: psql.WhereNull[Q, uuid.UUID](cols.SessionID),
+		RotatedAt: psql.WhereNull[Q, time.Time](cols.RotatedAt),
+		RevokedAt: psql.WhereNull[Q, time.Time](cols.RevokedAt),
+		IPAddress: psql.WhereNull[Q, pgtypes.Inet](cols.IPAddress),
+		UserAgent: psql.WhereNull[Q, string](cols.UserAgent),
 	}
 }
 
@@ -32,9 +32,28 @@ func (j joinSet[Q]) AliasedAs(alias string) joinSet[Q] {
 }
 
 type joins[Q dialect.Joinable] struct {
-	AuthTokens   joinSet[authTokenJoins[Q]]
-	FailedLogins joinSet[failedLoginJoins[Q]]
-	Users        joinSet[userJoins[Q]]
+	APIKeys                  joinSet[apiKeyJoins[Q]]
+	AuthTokens               joinSet[authTokenJoins[Q]]
+	FailedLogins             joinSet[failedLoginJoins[Q]]
+	Invitations              joinSet[invitationJoins[Q]]
+	InvoiceAllowanceCharges  joinSet[invoiceAllowanceChargeJoins[Q]]
+	InvoiceLineTaxBreakdowns joinSet[invoiceLineTaxBreakdownJoins[Q]]
+	InvoiceLines             joinSet[invoiceLineJoins[Q]]
+	InvoiceParties           joinSet[invoicePartyJoins[Q]]
+	Invoices                 joinSet[invoiceJoins[Q]]
+	MfaRecoveryCodes         joinSet[mfaRecoveryCodeJoins[Q]]
+	OauthClients             joinSet[oauthClientJoins[Q]]
+	OrganisationMembers      joinSet[organisationMemberJoins[Q]]
+	Organisations            joinSet[organisationJoins[Q]]
+	PasswordHistories        joinSet[passwordHistoryJoins[Q]]
+	Permissions              joinSet[permissionJoins[Q]]
+	RolePermissions          joinSet[rolePermissionJoins[Q]]
+	Roles                    joinSet[roleJoins[Q]]
+	SecurityEvents           joinSet[securityEventJoins[Q]]
+	TaxpayerProfiles         joinSet[taxpayerProfileJoins[Q]]
+	UserIdentities           joinSet[userIdentityJoins[Q]]
+	UserRoles                joinSet[userRoleJoins[Q]]
+	Users                    joinSet[userJoins[Q]]
 }
 
 func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q](c C, f F) joinSet[Q] {
@@ -47,9 +66,28 @@ func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q
 
 func getJoins[Q dialect.Joinable]() joins[Q] {
 	return joins[Q]{
-		AuthTokens:   buildJoinSet[authTokenJoins[Q]](AuthTokens.Columns, buildAuthTokenJoins),
-		FailedLogins: buildJoinSet[failedLoginJoins[Q]](FailedLogins.Columns, buildFailedLoginJoins),
-		Users:        buildJoinSet[userJoins[Q]](Users.Columns, buildUserJoins),
+		APIKeys:                  buildJoinSet[apiKeyJoins[Q]](APIKeys.Columns, buildAPIKeyJoins),
+		AuthTokens:               buildJoinSet[authTokenJoins[Q]](AuthTokens.Columns, buildAuthTokenJoins),
+		FailedLogins:             buildJoinSet[failedLoginJoins[Q]](FailedLogins.Columns, buildFailedLoginJoins),
+		Invitations:              buildJoinSet[invitationJoins[Q]](Invitations.Columns, buildInvitationJoins),
+		InvoiceAllowanceCharges:  buildJoinSet[invoiceAllowanceChargeJoins[Q]](InvoiceAllowanceCharges.Columns, buildInvoiceAllowanceChargeJoins),
+		InvoiceLineTaxBreakdowns: buildJoinSet[invoiceLineTaxBreakdownJoins[Q]](InvoiceLineTaxBreakdowns.Columns, buildInvoiceLineTaxBreakdownJoins),
+		InvoiceLines:             buildJoinSet[invoiceLineJoins[Q]](InvoiceLines.Columns, buildInvoiceLineJoins),
+		InvoiceParties:           buildJoinSet[invoicePartyJoins[Q]](InvoiceParties.Columns, buildInvoicePartyJoins),
+		Invoices:                 buildJoinSet[invoiceJoins[Q]](Invoices.Columns, buildInvoiceJoins),
+		MfaRecoveryCodes:         buildJoinSet[mfaRecoveryCodeJoins[Q]](MfaRecoveryCodes.Columns, buildMfaRecoveryCodeJoins),
+		OauthClients:             buildJoinSet[oauthClientJoins[Q]](OauthClients.Columns, buildOauthClientJoins),
+		OrganisationMembers:      buildJoinSet[organisationMemberJoins[Q]](OrganisationMembers.Columns, buildOrganisationMemberJoins),
+		Organisations:            buildJoinSet[organisationJoins[Q]](Organisations.Columns, buildOrganisationJoins),
+		PasswordHistories:        buildJoinSet[passwordHistoryJoins[Q]](PasswordHistories.Columns, buildPasswordHistoryJoins),
+		Permissions:              buildJoinSet[permissionJoins[Q]](Permissions.Columns, buildPermissionJoins),
+		RolePermissions:          buildJoinSet[rolePermissionJoins[Q]](RolePermissions.Columns, buildRolePermissionJoins),
+		Roles:                    buildJoinSet[roleJoins[Q]](Roles.Columns, buildRoleJoins),
+		SecurityEvents:           buildJoinSet[securityEventJoins[Q]](SecurityEvents.Columns, buildSecurityEventJoins),
+		TaxpayerProfiles:         buildJoinSet[taxpayerProfileJoins[Q]](TaxpayerProfiles.Columns, buildTaxpayerProfileJoins),
+		UserIdentities:           buildJoinSet[userIdentityJoins[Q]](UserIdentities.Columns, buildUserIdentityJoins),
+		UserRoles:                buildJoinSet[userRoleJoins[Q]](UserRoles.Columns, buildUserRoleJoins),
+		Users:                    buildJoinSet[userJoins[Q]](Users.Columns, buildUserJoins),
 	}
 }
 
@@ -17,16 +17,54 @@ import (
 var Preload = getPreloaders()
 
 type preloaders struct {
-	AuthToken   authTokenPreloader
-	FailedLogin failedLoginPreloader
-	User        userPreloader
+	APIKey                  apiKeyPreloader
+	AuthToken               authTokenPreloader
+	FailedLogin             failedLoginPreloader
+	Invitation              invitationPreloader
+	InvoiceAllowanceCharge  invoiceAllowanceChargePreloader
+	InvoiceLineTaxBreakdown invoiceLineTaxBreakdownPreloader
+	InvoiceLine             invoiceLinePreloader
+	InvoiceParty            invoicePartyPreloader
+	Invoice                 invoicePreloader
+	MfaRecoveryCode         mfaRecoveryCodePreloader
+	OauthClient             oauthClientPreloader
+	OrganisationMember      organisationMemberPreloader
+	Organisation            organisationPreloader
+	PasswordHistory         passwordHistoryPreloader
+	Permission              permissionPreloader
+	RolePermission          rolePermissionPreloader
+	Role                    rolePreloader
+	SecurityEvent           securityEventPreloader
+	TaxpayerProfile         taxpayerProfilePreloader
+	UserIdentity            userIdentityPreloader
+	UserRole                userRolePreloader
+	User                    userPreloader
 }
 
 func getPreloaders() preloaders {
 	return preloaders{
-		AuthToken:   buildAuthTokenPreloader(),
-		FailedLogin: buildFailedLoginPreloader(),
-		User:        buildUserPreloader(),
+		APIKey:                  buildAPIKeyPreloader(),
+		AuthToken:               buildAuthTokenPreloader(),
+		FailedLogin:             buildFailedLoginPreloader(),
+		Invitation:              buildInvitationPreloader(),
+		InvoiceAllowanceCharge:  buildInvoiceAllowanceChargePreloader(),
+		InvoiceLineTaxBreakdown: buildInvoiceLineTaxBreakdownPreloader(),
+		InvoiceLine:             buildInvoiceLinePreloader(),
+		InvoiceParty:            buildInvoicePartyPreloader(),
+		Invoice:                 buildInvoicePreloader(),
+		MfaRecoveryCode:         buildMfaRecoveryCodePreloader(),
+		OauthClient:             buildOauthClientPreloader(),
+		OrganisationMember:      buildOrganisationMemberPreloader(),
+		Organisation:            buildOrganisationPreloader(),
+		PasswordHistory:         buildPasswordHistoryPreloader(),
+		Permission:              buildPermissionPreloader(),
+		RolePermission:          buildRolePermissionPreloader(),
+		Role:                    buildRolePreloader(),
+		SecurityEvent:           buildSecurityEventPreloader(),
+		TaxpayerProfile:         buildTaxpayerProfilePreloader(),
+		UserIdentity:            buildUserIdentityPreloader(),
+		UserRole:                buildUserRolePreloader(),
+		User:                    buildUserPreloader(),
 	}
 }
 
@@ -37,16 +75,54 @@ var (
 )
 
 type thenLoaders[Q orm.Loadable] struct {
-	AuthToken   authTokenThenLoader[Q]
-	FailedLogin failedLoginThenLoader[Q]
-	User        userThenLoader[Q]
+	APIKey                  apiKeyThenLoader[Q]
+	AuthToken               authTokenThenLoader[Q]
+	FailedLogin             failedLoginThenLoader[Q]
+	Invitation              invitationThenLoader[Q]
+	InvoiceAllowanceCharge  invoiceAllowanceChargeThenLoader[Q]
+	InvoiceLineTaxBreakdown invoiceLineTaxBreakdownThenLoader[Q]
+	InvoiceLine             invoiceLineThenLoader[Q]
+	InvoiceParty            invoicePartyThenLoader[Q]
+	Invoice                 invoiceThenLoader[Q]
+	MfaRecoveryCode         mfaRecoveryCodeThenLoader[Q]
+	OauthClient             oauthClientThenLoader[Q]
+	OrganisationMember      organisationMemberThenLoader[Q]
+	Organisation            organisationThenLoader[Q]
+	PasswordHistory         passwordHistoryThenLoader[Q]
+	Permission              permissionThenLoader[Q]
+	RolePermission          rolePermissionThenLoader[Q]
+	Role                    roleThenLoader[Q]
+	SecurityEvent           securityEventThenLoader[Q]
+	TaxpayerProfile         taxpayerProfileThenLoader[Q]
+	UserIdentity            userIdentityThenLoader[Q]
+	UserRole                userRoleThenLoader[Q]
+	User                    userThenLoader[Q]
 }
 
 func getThenLoaders[Q orm.Loadable]() thenLoaders[Q] {
 	return thenLoaders[Q]{
-		AuthToken:   buildAuthTokenThenLoader[Q](),
-		FailedLogin: buildFailedLoginThenLoader[Q](),
-		User:        buildUserThenLoader[Q](),
+		APIKey:                  buildAPIKeyThenLoader[Q](),
+		AuthToken:               buildAuthTokenThenLoader[Q](),
+		FailedLogin:             buildFailedLoginThenLoader[Q](),
+		Invitation:              buildInvitationThenLoader[Q](),
+		InvoiceAllowanceCharge:  buildInvoiceAllowanceChargeThenLoader[Q](),
+		InvoiceLineTaxBreakdown: buildInvoiceLineTaxBreakdownThenLoader[Q](),
+		InvoiceLine:             buildInvoiceLineThenLoader[Q](),
+		InvoiceParty:            buildInvoicePartyThenLoader[Q](),
+		Invoice:                 buildInvoiceThenLoader[Q](),
+		MfaRecoveryCode:         buildMfaRecoveryCodeThenLoader[Q](),
+		OauthClient:             buildOauthClientThenLoader[Q](),
+		OrganisationMember:      buildOrganisationMemberThenLoader[Q](),
+		Organisation:            buildOrganisationThenLoader[Q](),
+		PasswordHistory:         buildPasswordHistoryThenLoader[Q](),
+		Permission:              buildPermissionThenLoader[Q](),
+		RolePermission:          buildRolePermissionThenLoader[Q](),
+		Role:                    buildRoleThenLoader[Q](),
+		SecurityEvent:           buildSecurityEventThenLoader[Q](),
+		TaxpayerProfile:         buildTaxpayerProfileThenLoader[Q](),
+		UserIdentity:            buildUserIdentityThenLoader[Q](),
+		UserRole:                buildUserRoleThenLoader[Q](),
+		User:                    buildUserThenLoader[Q](),
 	}
 }
 
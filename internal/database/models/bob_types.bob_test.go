@@ -6,25 +6,110 @@ package models
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 
 	"github.com/gofrs/uuid/v5"
 	enums "github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
 	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/types"
 	"github.com/stephenafamo/bob/types/pgtypes"
 )
 
 // Set the testDB to enable tests that use the database
 var testDB bob.Transactor[bob.Tx]
 
+// Make sure the type APIKey runs hooks after queries
+var _ bob.HookableType = &APIKey{}
+
+// Make sure the type AuditEvent runs hooks after queries
+var _ bob.HookableType = &AuditEvent{}
+
 // Make sure the type AuthToken runs hooks after queries
 var _ bob.HookableType = &AuthToken{}
 
+// Make sure the type DeniedToken runs hooks after queries
+var _ bob.HookableType = &DeniedToken{}
+
 // Make sure the type FailedLogin runs hooks after queries
 var _ bob.HookableType = &FailedLogin{}
 
+// Make sure the type Invitation runs hooks after queries
+var _ bob.HookableType = &Invitation{}
+
+// Make sure the type InvoiceAllowanceCharge runs hooks after queries
+var _ bob.HookableType = &InvoiceAllowanceCharge{}
+
+// Make sure the type InvoiceLineTaxBreakdown runs hooks after queries
+var _ bob.HookableType = &InvoiceLineTaxBreakdown{}
+
+// Make sure the type InvoiceLine runs hooks after queries
+var _ bob.HookableType = &InvoiceLine{}
+
+// Make sure the type InvoiceParty runs hooks after queries
+var _ bob.HookableType = &InvoiceParty{}
+
+// Make sure the type Invoice runs hooks after queries
+var _ bob.HookableType = &Invoice{}
+
+// Make sure the type MfaRecoveryCode runs hooks after queries
+var _ bob.HookableType = &MfaRecoveryCode{}
+
+// Make sure the type OauthClient runs hooks after queries
+var _ bob.HookableType = &OauthClient{}
+
+// Make sure the type OrganisationMember runs hooks after queries
+var _ bob.HookableType = &OrganisationMember{}
+
+// Make sure the type Organisation runs hooks after queries
+var _ bob.HookableType = &Organisation{}
+
+// Make sure the type PasswordHistory runs hooks after queries
+var _ bob.HookableType = &PasswordHistory{}
+
+// Make sure the type Permission runs hooks after queries
+var _ bob.HookableType = &Permission{}
+
+// Make sure the type RolePermission runs hooks after queries
+var _ bob.HookableType = &RolePermission{}
+
+// Make sure the type Role runs hooks after queries
+var _ bob.HookableType = &Role{}
+
+// Make sure the type SecurityEvent runs hooks after queries
+var _ bob.HookableType = &SecurityEvent{}
+
+// Make sure the type TaxpayerProfile runs hooks after queries
+var _ bob.HookableType = &TaxpayerProfile{}
+
+// Make sure the type UserIdentity runs hooks after queries
+var _ bob.HookableType = &UserIdentity{}
+
+// Make sure the type UserRole runs hooks after queries
+var _ bob.HookableType = &UserRole{}
+
 // Make sure the type User runs hooks after queries
 var _ bob.HookableType = &User{}
 
+// Make sure the type pq.StringArray satisfies database/sql.Scanner
+var _ sql.Scanner = (*pq.StringArray)(nil)
+
+// Make sure the type pq.StringArray satisfies database/sql/driver.Valuer
+var _ driver.Valuer = *new(pq.StringArray)
+
+// Make sure the type pgtypes.Inet satisfies database/sql.Scanner
+var _ sql.Scanner = (*pgtypes.Inet)(nil)
+
+// Make sure the type pgtypes.Inet satisfies database/sql/driver.Valuer
+var _ driver.Valuer = *new(pgtypes.Inet)
+
+// Make sure the type types.JSON[json.RawMessage] satisfies database/sql.Scanner
+var _ sql.Scanner = (*types.JSON[json.RawMessage])(nil)
+
+// Make sure the type types.JSON[json.RawMessage] satisfies database/sql/driver.Valuer
+var _ driver.Valuer = *new(types.JSON[json.RawMessage])
+
 // Make sure the type enums.AuthTokenTypes satisfies database/sql.Scanner
 var _ sql.Scanner = (*enums.AuthTokenTypes)(nil)
 
@@ -37,11 +122,35 @@ var _ sql.Scanner = (*uuid.UUID)(nil)
 // Make sure the type uuid.UUID satisfies database/sql/driver.Valuer
 var _ driver.Valuer = *new(uuid.UUID)
 
-// Make sure the type pgtypes.Inet satisfies database/sql.Scanner
-var _ sql.Scanner = (*pgtypes.Inet)(nil)
+// Make sure the type decimal.Decimal satisfies database/sql.Scanner
+var _ sql.Scanner = (*decimal.Decimal)(nil)
 
-// Make sure the type pgtypes.Inet satisfies database/sql/driver.Valuer
-var _ driver.Valuer = *new(pgtypes.Inet)
+// Make sure the type decimal.Decimal satisfies database/sql/driver.Valuer
+var _ driver.Valuer = *new(decimal.Decimal)
+
+// Make sure the type enums.InvoicePartyRoles satisfies database/sql.Scanner
+var _ sql.Scanner = (*enums.InvoicePartyRoles)(nil)
+
+// Make sure the type enums.InvoicePartyRoles satisfies database/sql/driver.Valuer
+var _ driver.Valuer = *new(enums.InvoicePartyRoles)
+
+// Make sure the type enums.TaxpayerIDTypes satisfies database/sql.Scanner
+var _ sql.Scanner = (*enums.TaxpayerIDTypes)(nil)
+
+// Make sure the type enums.TaxpayerIDTypes satisfies database/sql/driver.Valuer
+var _ driver.Valuer = *new(enums.TaxpayerIDTypes)
+
+// Make sure the type enums.InvoiceStatuses satisfies database/sql.Scanner
+var _ sql.Scanner = (*enums.InvoiceStatuses)(nil)
+
+// Make sure the type enums.InvoiceStatuses satisfies database/sql/driver.Valuer
+var _ driver.Valuer = *new(enums.InvoiceStatuses)
+
+// Make sure the type enums.SecurityEventTypes satisfies database/sql.Scanner
+var _ sql.Scanner = (*enums.SecurityEventTypes)(nil)
+
+// Make sure the type enums.SecurityEventTypes satisfies database/sql/driver.Valuer
+var _ driver.Valuer = *new(enums.SecurityEventTypes)
 
 // Make sure the type enums.UserStatuses satisfies database/sql.Scanner
 var _ sql.Scanner = (*enums.UserStatuses)(nil)
@@ -17,17 +17,80 @@ var (
 )
 
 func Where[Q psql.Filterable]() struct {
-	AuthTokens   authTokenWhere[Q]
-	FailedLogins failedLoginWhere[Q]
-	Users        userWhere[Q]
+	APIKeys                  apiKeyWhere[Q]
+	AuditEvents              auditEventWhere[Q]
+	AuthTokens               authTokenWhere[Q]
+	DeniedTokens             deniedTokenWhere[Q]
+	FailedLogins             failedLoginWhere[Q]
+	Invitations              invitationWhere[Q]
+	InvoiceAllowanceCharges  invoiceAllowanceChargeWhere[Q]
+	InvoiceLineTaxBreakdowns invoiceLineTaxBreakdownWhere[Q]
+	InvoiceLines             invoiceLineWhere[Q]
+	InvoiceParties           invoicePartyWhere[Q]
+	Invoices                 invoiceWhere[Q]
+	MfaRecoveryCodes         mfaRecoveryCodeWhere[Q]
+	OauthClients             oauthClientWhere[Q]
+	OrganisationMembers      organisationMemberWhere[Q]
+	Organisations            organisationWhere[Q]
+	PasswordHistories        passwordHistoryWhere[Q]
+	Permissions              permissionWhere[Q]
+	RolePermissions          rolePermissionWhere[Q]
+	Roles                    roleWhere[Q]
+	SecurityEvents           securityEventWhere[Q]
+	TaxpayerProfiles         taxpayerProfileWhere[Q]
+	UserIdentities           userIdentityWhere[Q]
+	UserRoles                userRoleWhere[Q]
+	Users                    userWhere[Q]
 } {
 	return struct {
-		AuthTokens   authTokenWhere[Q]
-		FailedLogins failedLoginWhere[Q]
-		Users        userWhere[Q]
+		APIKeys                  apiKeyWhere[Q]
+		AuditEvents              auditEventWhere[Q]
+		AuthTokens               authTokenWhere[Q]
+		DeniedTokens             deniedTokenWhere[Q]
+		FailedLogins             failedLoginWhere[Q]
+		Invitations              invitationWhere[Q]
+		InvoiceAllowanceCharges  invoiceAllowanceChargeWhere[Q]
+		InvoiceLineTaxBreakdowns invoiceLineTaxBreakdownWhere[Q]
+		InvoiceLines             invoiceLineWhere[Q]
+		InvoiceParties           invoicePartyWhere[Q]
+		Invoices                 invoiceWhere[Q]
+		MfaRecoveryCodes         mfaRecoveryCodeWhere[Q]
+		OauthClients             oauthClientWhere[Q]
+		OrganisationMembers      organisationMemberWhere[Q]
+		Organisations            organisationWhere[Q]
+		PasswordHistories        passwordHistoryWhere[Q]
+		Permissions              permissionWhere[Q]
+		RolePermissions          rolePermissionWhere[Q]
+		Roles                    roleWhere[Q]
+		SecurityEvents           securityEventWhere[Q]
+		TaxpayerProfiles         taxpayerProfileWhere[Q]
+		UserIdentities           userIdentityWhere[Q]
+		UserRoles                userRoleWhere[Q]
+		Users                    userWhere[Q]
 	}{
-		AuthTokens:   buildAuthTokenWhere[Q](AuthTokens.Columns),
-		FailedLogins: buildFailedLoginWhere[Q](FailedLogins.Columns),
-		Users:        buildUserWhere[Q](Users.Columns),
+		APIKeys:                  buildAPIKeyWhere[Q](APIKeys.Columns),
+		AuditEvents:              buildAuditEventWhere[Q](AuditEvents.Columns),
+		AuthTokens:               buildAuthTokenWhere[Q](AuthTokens.Columns),
+		DeniedTokens:             buildDeniedTokenWhere[Q](DeniedTokens.Columns),
+		FailedLogins:             buildFailedLoginWhere[Q](FailedLogins.Columns),
+		Invitations:              buildInvitationWhere[Q](Invitations.Columns),
+		InvoiceAllowanceCharges:  buildInvoiceAllowanceChargeWhere[Q](InvoiceAllowanceCharges.Columns),
+		InvoiceLineTaxBreakdowns: buildInvoiceLineTaxBreakdownWhere[Q](InvoiceLineTaxBreakdowns.Columns),
+		InvoiceLines:             buildInvoiceLineWhere[Q](InvoiceLines.Columns),
+		InvoiceParties:           buildInvoicePartyWhere[Q](InvoiceParties.Columns),
+		Invoices:                 buildInvoiceWhere[Q](Invoices.Columns),
+		MfaRecoveryCodes:         buildMfaRecoveryCodeWhere[Q](MfaRecoveryCodes.Columns),
+		OauthClients:             buildOauthClientWhere[Q](OauthClients.Columns),
+		OrganisationMembers:      buildOrganisationMemberWhere[Q](OrganisationMembers.Columns),
+		Organisations:            buildOrganisationWhere[Q](Organisations.Columns),
+		PasswordHistories:        buildPasswordHistoryWhere[Q](PasswordHistories.Columns),
+		Permissions:              buildPermissionWhere[Q](Permissions.Columns),
+		RolePermissions:          buildRolePermissionWhere[Q](RolePermissions.Columns),
+		Roles:                    buildRoleWhere[Q](Roles.Columns),
+		SecurityEvents:           buildSecurityEventWhere[Q](SecurityEvents.Columns),
+		TaxpayerProfiles:         buildTaxpayerProfileWhere[Q](TaxpayerProfiles.Columns),
+		UserIdentities:           buildUserIdentityWhere[Q](UserIdentities.Columns),
+		UserRoles:                buildUserRoleWhere[Q](UserRoles.Columns),
+		Users:                    buildUserWhere[Q](Users.Columns),
 	}
 }
@@ -0,0 +1,401 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+)
+
+// DeniedToken is an object representing the database table.
+type DeniedToken struct {
+	Jti       string              `db:"jti,pk" `
+	ExpiresAt time.Time           `db:"expires_at" `
+	CreatedAt null.Val[time.Time] `db:"created_at" `
+}
+
+// DeniedTokenSlice is an alias for a slice of pointers to DeniedToken.
+// This should almost always be used instead of []*DeniedToken.
+type DeniedTokenSlice []*DeniedToken
+
+// DeniedTokens contains methods to work with the denied_tokens table
+var DeniedTokens = psql.NewTablex[*DeniedToken, DeniedTokenSlice, *DeniedTokenSetter]("", "denied_tokens", buildDeniedTokenColumns("denied_tokens"))
+
+// DeniedTokensQuery is a query on the denied_tokens table
+type DeniedTokensQuery = *psql.ViewQuery[*DeniedToken, DeniedTokenSlice]
+
+func buildDeniedTokenColumns(alias string) deniedTokenColumns {
+	return deniedTokenColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"jti", "expires_at", "created_at",
+		).WithParent("denied_tokens"),
+		tableAlias: alias,
+		Jti:        psql.Quote(alias, "jti"),
+		ExpiresAt:  psql.Quote(alias, "expires_at"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+	}
+}
+
+type deniedTokenColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	Jti        psql.Expression
+	ExpiresAt  psql.Expression
+	CreatedAt  psql.Expression
+}
+
+func (c deniedTokenColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (deniedTokenColumns) AliasedAs(alias string) deniedTokenColumns {
+	return buildDeniedTokenColumns(alias)
+}
+
+// DeniedTokenSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type DeniedTokenSetter struct {
+	Jti       omit.Val[string]        `db:"jti,pk" `
+	ExpiresAt omit.Val[time.Time]     `db:"expires_at" `
+	CreatedAt omitnull.Val[time.Time] `db:"created_at" `
+}
+
+func (s DeniedTokenSetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.Jti.IsValue() {
+		vals = append(vals, "jti")
+	}
+	if s.ExpiresAt.IsValue() {
+		vals = append(vals, "expires_at")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s DeniedTokenSetter) Overwrite(t *DeniedToken) {
+	if s.Jti.IsValue() {
+		t.Jti = s.Jti.MustGet()
+	}
+	if s.ExpiresAt.IsValue() {
+		t.ExpiresAt = s.ExpiresAt.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *DeniedTokenSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return DeniedTokens.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 3)
+		if s.Jti.IsValue() {
+			vals[0] = psql.Arg(s.Jti.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.ExpiresAt.IsValue() {
+			vals[1] = psql.Arg(s.ExpiresAt.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[2] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s DeniedTokenSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s DeniedTokenSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.Jti.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "jti")...),
+			psql.Arg(s.Jti),
+		}})
+	}
+
+	if s.ExpiresAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "expires_at")...),
+			psql.Arg(s.ExpiresAt),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindDeniedToken retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindDeniedToken(ctx context.Context, exec bob.Executor, JtiPK string, cols ...string) (*DeniedToken, error) {
+	if len(cols) == 0 {
+		return DeniedTokens.Query(
+			sm.Where(DeniedTokens.Columns.Jti.EQ(psql.Arg(JtiPK))),
+		).One(ctx, exec)
+	}
+
+	return DeniedTokens.Query(
+		sm.Where(DeniedTokens.Columns.Jti.EQ(psql.Arg(JtiPK))),
+		sm.Columns(DeniedTokens.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// DeniedTokenExists checks the presence of a single record by primary key
+func DeniedTokenExists(ctx context.Context, exec bob.Executor, JtiPK string) (bool, error) {
+	return DeniedTokens.Query(
+		sm.Where(DeniedTokens.Columns.Jti.EQ(psql.Arg(JtiPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after DeniedToken is retrieved from the database
+func (o *DeniedToken) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = DeniedTokens.AfterSelectHooks.RunHooks(ctx, exec, DeniedTokenSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = DeniedTokens.AfterInsertHooks.RunHooks(ctx, exec, DeniedTokenSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = DeniedTokens.AfterUpdateHooks.RunHooks(ctx, exec, DeniedTokenSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = DeniedTokens.AfterDeleteHooks.RunHooks(ctx, exec, DeniedTokenSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the DeniedToken
+func (o *DeniedToken) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.Jti)
+}
+
+func (o *DeniedToken) pkEQ() dialect.Expression {
+	return psql.Quote("denied_tokens", "jti").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the DeniedToken
+func (o *DeniedToken) Update(ctx context.Context, exec bob.Executor, s *DeniedTokenSetter) error {
+	v, err := DeniedTokens.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single DeniedToken record with an executor
+func (o *DeniedToken) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := DeniedTokens.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the DeniedToken using the executor
+func (o *DeniedToken) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := DeniedTokens.Query(
+		sm.Where(DeniedTokens.Columns.Jti.EQ(psql.Arg(o.Jti))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after DeniedTokenSlice is retrieved from the database
+func (o DeniedTokenSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = DeniedTokens.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = DeniedTokens.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = DeniedTokens.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = DeniedTokens.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o DeniedTokenSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("denied_tokens", "jti").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o DeniedTokenSlice) copyMatchingRows(from ...*DeniedToken) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.Jti != old.Jti {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o DeniedTokenSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return DeniedTokens.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *DeniedToken:
+				o.copyMatchingRows(retrieved)
+			case []*DeniedToken:
+				o.copyMatchingRows(retrieved...)
+			case DeniedTokenSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a DeniedToken or a slice of DeniedToken
+				// then run the AfterUpdateHooks on the slice
+				_, err = DeniedTokens.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o DeniedTokenSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return DeniedTokens.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *DeniedToken:
+				o.copyMatchingRows(retrieved)
+			case []*DeniedToken:
+				o.copyMatchingRows(retrieved...)
+			case DeniedTokenSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a DeniedToken or a slice of DeniedToken
+				// then run the AfterDeleteHooks on the slice
+				_, err = DeniedTokens.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o DeniedTokenSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals DeniedTokenSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := DeniedTokens.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o DeniedTokenSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := DeniedTokens.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o DeniedTokenSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := DeniedTokens.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type deniedTokenWhere[Q psql.Filterable] struct {
+	Jti       psql.WhereMod[Q, string]
+	ExpiresAt psql.WhereMod[Q, time.Time]
+	CreatedAt psql.WhereNullMod[Q, time.Time]
+}
+
+func (deniedTokenWhere[Q]) AliasedAs(alias string) deniedTokenWhere[Q] {
+	return buildDeniedTokenWhere[Q](buildDeniedTokenColumns(alias))
+}
+
+func buildDeniedTokenWhere[Q psql.Filterable](cols deniedTokenColumns) deniedTokenWhere[Q] {
+	return deniedTokenWhere[Q]{
+		Jti:       psql.Where[Q, string](cols.Jti),
+		ExpiresAt: psql.Where[Q, time.Time](cols.ExpiresAt),
+		CreatedAt: psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
@@ -0,0 +1,1188 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Invitation is an object representing the database table.
+type Invitation struct {
+	ID             int64               `db:"id,pk" `
+	Email          string              `db:"email" `
+	RoleID         int64               `db:"role_id" `
+	InvitedBy      null.Val[int64]     `db:"invited_by" `
+	Token          string              `db:"token" `
+	ExpireAt       time.Time           `db:"expire_at" `
+	AcceptedAt     null.Val[time.Time] `db:"accepted_at" `
+	AcceptedUserID null.Val[int64]     `db:"accepted_user_id" `
+	RevokedAt      null.Val[time.Time] `db:"revoked_at" `
+	CreatedAt      null.Val[time.Time] `db:"created_at" `
+	UpdatedAt      null.Val[time.Time] `db:"updated_at" `
+
+	R invitationR `db:"-" `
+}
+
+// InvitationSlice is an alias for a slice of pointers to Invitation.
+// This should almost always be used instead of []*Invitation.
+type InvitationSlice []*Invitation
+
+// Invitations contains methods to work with the invitations table
+var Invitations = psql.NewTablex[*Invitation, InvitationSlice, *InvitationSetter]("", "invitations", buildInvitationColumns("invitations"))
+
+// InvitationsQuery is a query on the invitations table
+type InvitationsQuery = *psql.ViewQuery[*Invitation, InvitationSlice]
+
+// invitationR is where relationships are stored.
+type invitationR struct {
+	AcceptedUserUser *User // invitations.invitations_accepted_user_id_fkey
+	InvitedByUser    *User // invitations.invitations_invited_by_fkey
+	Role             *Role // invitations.invitations_role_id_fkey
+}
+
+func buildInvitationColumns(alias string) invitationColumns {
+	return invitationColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "email", "role_id", "invited_by", "token", "expire_at", "accepted_at", "accepted_user_id", "revoked_at", "created_at", "updated_at",
+		).WithParent("invitations"),
+		tableAlias:     alias,
+		ID:             psql.Quote(alias, "id"),
+		Email:          psql.Quote(alias, "email"),
+		RoleID:         psql.Quote(alias, "role_id"),
+		InvitedBy:      psql.Quote(alias, "invited_by"),
+		Token:          psql.Quote(alias, "token"),
+		ExpireAt:       psql.Quote(alias, "expire_at"),
+		AcceptedAt:     psql.Quote(alias, "accepted_at"),
+		AcceptedUserID: psql.Quote(alias, "accepted_user_id"),
+		RevokedAt:      psql.Quote(alias, "revoked_at"),
+		CreatedAt:      psql.Quote(alias, "created_at"),
+		UpdatedAt:      psql.Quote(alias, "updated_at"),
+	}
+}
+
+type invitationColumns struct {
+	expr.ColumnsExpr
+	tableAlias     string
+	ID             psql.Expression
+	Email          psql.Expression
+	RoleID         psql.Expression
+	InvitedBy      psql.Expression
+	Token          psql.Expression
+	ExpireAt       psql.Expression
+	AcceptedAt     psql.Expression
+	AcceptedUserID psql.Expression
+	RevokedAt      psql.Expression
+	CreatedAt      psql.Expression
+	UpdatedAt      psql.Expression
+}
+
+func (c invitationColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (invitationColumns) AliasedAs(alias string) invitationColumns {
+	return buildInvitationColumns(alias)
+}
+
+// InvitationSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type InvitationSetter struct {
+	ID             omit.Val[int64]         `db:"id,pk" `
+	Email          omit.Val[string]        `db:"email" `
+	RoleID         omit.Val[int64]         `db:"role_id" `
+	InvitedBy      omitnull.Val[int64]     `db:"invited_by" `
+	Token          omit.Val[string]        `db:"token" `
+	ExpireAt       omit.Val[time.Time]     `db:"expire_at" `
+	AcceptedAt     omitnull.Val[time.Time] `db:"accepted_at" `
+	AcceptedUserID omitnull.Val[int64]     `db:"accepted_user_id" `
+	RevokedAt      omitnull.Val[time.Time] `db:"revoked_at" `
+	CreatedAt      omitnull.Val[time.Time] `db:"created_at" `
+	UpdatedAt      omitnull.Val[time.Time] `db:"updated_at" `
+}
+
+func (s InvitationSetter) SetColumns() []string {
+	vals := make([]string, 0, 11)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Email.IsValue() {
+		vals = append(vals, "email")
+	}
+	if s.RoleID.IsValue() {
+		vals = append(vals, "role_id")
+	}
+	if !s.InvitedBy.IsUnset() {
+		vals = append(vals, "invited_by")
+	}
+	if s.Token.IsValue() {
+		vals = append(vals, "token")
+	}
+	if s.ExpireAt.IsValue() {
+		vals = append(vals, "expire_at")
+	}
+	if !s.AcceptedAt.IsUnset() {
+		vals = append(vals, "accepted_at")
+	}
+	if !s.AcceptedUserID.IsUnset() {
+		vals = append(vals, "accepted_user_id")
+	}
+	if !s.RevokedAt.IsUnset() {
+		vals = append(vals, "revoked_at")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	if !s.UpdatedAt.IsUnset() {
+		vals = append(vals, "updated_at")
+	}
+	return vals
+}
+
+func (s InvitationSetter) Overwrite(t *Invitation) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Email.IsValue() {
+		t.Email = s.Email.MustGet()
+	}
+	if s.RoleID.IsValue() {
+		t.RoleID = s.RoleID.MustGet()
+	}
+	if !s.InvitedBy.IsUnset() {
+		t.InvitedBy = s.InvitedBy.MustGetNull()
+	}
+	if s.Token.IsValue() {
+		t.Token = s.Token.MustGet()
+	}
+	if s.ExpireAt.IsValue() {
+		t.ExpireAt = s.ExpireAt.MustGet()
+	}
+	if !s.AcceptedAt.IsUnset() {
+		t.AcceptedAt = s.AcceptedAt.MustGetNull()
+	}
+	if !s.AcceptedUserID.IsUnset() {
+		t.AcceptedUserID = s.AcceptedUserID.MustGetNull()
+	}
+	if !s.RevokedAt.IsUnset() {
+		t.RevokedAt = s.RevokedAt.MustGetNull()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+	if !s.UpdatedAt.IsUnset() {
+		t.UpdatedAt = s.UpdatedAt.MustGetNull()
+	}
+}
+
+func (s *InvitationSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Invitations.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 11)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Email.IsValue() {
+			vals[1] = psql.Arg(s.Email.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.RoleID.IsValue() {
+			vals[2] = psql.Arg(s.RoleID.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if !s.InvitedBy.IsUnset() {
+			vals[3] = psql.Arg(s.InvitedBy.MustGetNull())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.Token.IsValue() {
+			vals[4] = psql.Arg(s.Token.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.ExpireAt.IsValue() {
+			vals[5] = psql.Arg(s.ExpireAt.MustGet())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if !s.AcceptedAt.IsUnset() {
+			vals[6] = psql.Arg(s.AcceptedAt.MustGetNull())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if !s.AcceptedUserID.IsUnset() {
+			vals[7] = psql.Arg(s.AcceptedUserID.MustGetNull())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if !s.RevokedAt.IsUnset() {
+			vals[8] = psql.Arg(s.RevokedAt.MustGetNull())
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[9] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[9] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UpdatedAt.IsUnset() {
+			vals[10] = psql.Arg(s.UpdatedAt.MustGetNull())
+		} else {
+			vals[10] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s InvitationSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s InvitationSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 11)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.Email.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "email")...),
+			psql.Arg(s.Email),
+		}})
+	}
+
+	if s.RoleID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "role_id")...),
+			psql.Arg(s.RoleID),
+		}})
+	}
+
+	if !s.InvitedBy.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "invited_by")...),
+			psql.Arg(s.InvitedBy),
+		}})
+	}
+
+	if s.Token.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "token")...),
+			psql.Arg(s.Token),
+		}})
+	}
+
+	if s.ExpireAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "expire_at")...),
+			psql.Arg(s.ExpireAt),
+		}})
+	}
+
+	if !s.AcceptedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "accepted_at")...),
+			psql.Arg(s.AcceptedAt),
+		}})
+	}
+
+	if !s.AcceptedUserID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "accepted_user_id")...),
+			psql.Arg(s.AcceptedUserID),
+		}})
+	}
+
+	if !s.RevokedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "revoked_at")...),
+			psql.Arg(s.RevokedAt),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	if !s.UpdatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "updated_at")...),
+			psql.Arg(s.UpdatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindInvitation retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindInvitation(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*Invitation, error) {
+	if len(cols) == 0 {
+		return Invitations.Query(
+			sm.Where(Invitations.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Invitations.Query(
+		sm.Where(Invitations.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(Invitations.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// InvitationExists checks the presence of a single record by primary key
+func InvitationExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return Invitations.Query(
+		sm.Where(Invitations.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Invitation is retrieved from the database
+func (o *Invitation) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Invitations.AfterSelectHooks.RunHooks(ctx, exec, InvitationSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Invitations.AfterInsertHooks.RunHooks(ctx, exec, InvitationSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Invitations.AfterUpdateHooks.RunHooks(ctx, exec, InvitationSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Invitations.AfterDeleteHooks.RunHooks(ctx, exec, InvitationSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Invitation
+func (o *Invitation) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Invitation) pkEQ() dialect.Expression {
+	return psql.Quote("invitations", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Invitation
+func (o *Invitation) Update(ctx context.Context, exec bob.Executor, s *InvitationSetter) error {
+	v, err := Invitations.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Invitation record with an executor
+func (o *Invitation) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Invitations.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Invitation using the executor
+func (o *Invitation) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Invitations.Query(
+		sm.Where(Invitations.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after InvitationSlice is retrieved from the database
+func (o InvitationSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Invitations.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Invitations.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Invitations.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Invitations.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o InvitationSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("invitations", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o InvitationSlice) copyMatchingRows(from ...*Invitation) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o InvitationSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Invitations.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Invitation:
+				o.copyMatchingRows(retrieved)
+			case []*Invitation:
+				o.copyMatchingRows(retrieved...)
+			case InvitationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Invitation or a slice of Invitation
+				// then run the AfterUpdateHooks on the slice
+				_, err = Invitations.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o InvitationSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Invitations.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Invitation:
+				o.copyMatchingRows(retrieved)
+			case []*Invitation:
+				o.copyMatchingRows(retrieved...)
+			case InvitationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Invitation or a slice of Invitation
+				// then run the AfterDeleteHooks on the slice
+				_, err = Invitations.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o InvitationSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals InvitationSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Invitations.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o InvitationSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Invitations.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o InvitationSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Invitations.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// AcceptedUserUser starts a query for related objects on users
+func (o *Invitation) AcceptedUserUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.AcceptedUserID))),
+	)...)
+}
+
+func (os InvitationSlice) AcceptedUserUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkAcceptedUserID := make(pgtypes.Array[null.Val[int64]], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkAcceptedUserID = append(pkAcceptedUserID, o.AcceptedUserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkAcceptedUserID), "bigint[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// InvitedByUser starts a query for related objects on users
+func (o *Invitation) InvitedByUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.InvitedBy))),
+	)...)
+}
+
+func (os InvitationSlice) InvitedByUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkInvitedBy := make(pgtypes.Array[null.Val[int64]], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkInvitedBy = append(pkInvitedBy, o.InvitedBy)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkInvitedBy), "bigint[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Role starts a query for related objects on roles
+func (o *Invitation) Role(mods ...bob.Mod[*dialect.SelectQuery]) RolesQuery {
+	return Roles.Query(append(mods,
+		sm.Where(Roles.Columns.ID.EQ(psql.Arg(o.RoleID))),
+	)...)
+}
+
+func (os InvitationSlice) Role(mods ...bob.Mod[*dialect.SelectQuery]) RolesQuery {
+	pkRoleID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkRoleID = append(pkRoleID, o.RoleID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkRoleID), "bigint[]")),
+	))
+
+	return Roles.Query(append(mods,
+		sm.Where(psql.Group(Roles.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachInvitationAcceptedUserUser0(ctx context.Context, exec bob.Executor, count int, invitation0 *Invitation, user1 *User) (*Invitation, error) {
+	setter := &InvitationSetter{
+		AcceptedUserID: omitnull.From(user1.ID),
+	}
+
+	err := invitation0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvitationAcceptedUserUser0: %w", err)
+	}
+
+	return invitation0, nil
+}
+
+func (invitation0 *Invitation) InsertAcceptedUserUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachInvitationAcceptedUserUser0(ctx, exec, 1, invitation0, user1)
+	if err != nil {
+		return err
+	}
+
+	invitation0.R.AcceptedUserUser = user1
+
+	user1.R.AcceptedUserInvitations = append(user1.R.AcceptedUserInvitations, invitation0)
+
+	return nil
+}
+
+func (invitation0 *Invitation) AttachAcceptedUserUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachInvitationAcceptedUserUser0(ctx, exec, 1, invitation0, user1)
+	if err != nil {
+		return err
+	}
+
+	invitation0.R.AcceptedUserUser = user1
+
+	user1.R.AcceptedUserInvitations = append(user1.R.AcceptedUserInvitations, invitation0)
+
+	return nil
+}
+
+func attachInvitationInvitedByUser0(ctx context.Context, exec bob.Executor, count int, invitation0 *Invitation, user1 *User) (*Invitation, error) {
+	setter := &InvitationSetter{
+		InvitedBy: omitnull.From(user1.ID),
+	}
+
+	err := invitation0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvitationInvitedByUser0: %w", err)
+	}
+
+	return invitation0, nil
+}
+
+func (invitation0 *Invitation) InsertInvitedByUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachInvitationInvitedByUser0(ctx, exec, 1, invitation0, user1)
+	if err != nil {
+		return err
+	}
+
+	invitation0.R.InvitedByUser = user1
+
+	user1.R.InvitedByInvitations = append(user1.R.InvitedByInvitations, invitation0)
+
+	return nil
+}
+
+func (invitation0 *Invitation) AttachInvitedByUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachInvitationInvitedByUser0(ctx, exec, 1, invitation0, user1)
+	if err != nil {
+		return err
+	}
+
+	invitation0.R.InvitedByUser = user1
+
+	user1.R.InvitedByInvitations = append(user1.R.InvitedByInvitations, invitation0)
+
+	return nil
+}
+
+func attachInvitationRole0(ctx context.Context, exec bob.Executor, count int, invitation0 *Invitation, role1 *Role) (*Invitation, error) {
+	setter := &InvitationSetter{
+		RoleID: omit.From(role1.ID),
+	}
+
+	err := invitation0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvitationRole0: %w", err)
+	}
+
+	return invitation0, nil
+}
+
+func (invitation0 *Invitation) InsertRole(ctx context.Context, exec bob.Executor, related *RoleSetter) error {
+	var err error
+
+	role1, err := Roles.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachInvitationRole0(ctx, exec, 1, invitation0, role1)
+	if err != nil {
+		return err
+	}
+
+	invitation0.R.Role = role1
+
+	role1.R.Invitations = append(role1.R.Invitations, invitation0)
+
+	return nil
+}
+
+func (invitation0 *Invitation) AttachRole(ctx context.Context, exec bob.Executor, role1 *Role) error {
+	var err error
+
+	_, err = attachInvitationRole0(ctx, exec, 1, invitation0, role1)
+	if err != nil {
+		return err
+	}
+
+	invitation0.R.Role = role1
+
+	role1.R.Invitations = append(role1.R.Invitations, invitation0)
+
+	return nil
+}
+
+type invitationWhere[Q psql.Filterable] struct {
+	ID             psql.WhereMod[Q, int64]
+	Email          psql.WhereMod[Q, string]
+	RoleID         psql.WhereMod[Q, int64]
+	InvitedBy      psql.WhereNullMod[Q, int64]
+	Token          psql.WhereMod[Q, string]
+	ExpireAt       psql.WhereMod[Q, time.Time]
+	AcceptedAt     psql.WhereNullMod[Q, time.Time]
+	AcceptedUserID psql.WhereNullMod[Q, int64]
+	RevokedAt      psql.WhereNullMod[Q, time.Time]
+	CreatedAt      psql.WhereNullMod[Q, time.Time]
+	UpdatedAt      psql.WhereNullMod[Q, time.Time]
+}
+
+func (invitationWhere[Q]) AliasedAs(alias string) invitationWhere[Q] {
+	return buildInvitationWhere[Q](buildInvitationColumns(alias))
+}
+
+func buildInvitationWhere[Q psql.Filterable](cols invitationColumns) invitationWhere[Q] {
+	return invitationWhere[Q]{
+		ID:             psql.Where[Q, int64](cols.ID),
+		Email:          psql.Where[Q, string](cols.Email),
+		RoleID:         psql.Where[Q, int64](cols.RoleID),
+		InvitedBy:      psql.WhereNull[Q, int64](cols.InvitedBy),
+		Token:          psql.Where[Q, string](cols.Token),
+		ExpireAt:       psql.Where[Q, time.Time](cols.ExpireAt),
+		AcceptedAt:     psql.WhereNull[Q, time.Time](cols.AcceptedAt),
+		AcceptedUserID: psql.WhereNull[Q, int64](cols.AcceptedUserID),
+		RevokedAt:      psql.WhereNull[Q, time.Time](cols.RevokedAt),
+		CreatedAt:      psql.WhereNull[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:      psql.WhereNull[Q, time.Time](cols.UpdatedAt),
+	}
+}
+
+func (o *Invitation) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "AcceptedUserUser":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("invitation cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AcceptedUserUser = rel
+
+		if rel != nil {
+			rel.R.AcceptedUserInvitations = InvitationSlice{o}
+		}
+		return nil
+	case "InvitedByUser":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("invitation cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.InvitedByUser = rel
+
+		if rel != nil {
+			rel.R.InvitedByInvitations = InvitationSlice{o}
+		}
+		return nil
+	case "Role":
+		rel, ok := retrieved.(*Role)
+		if !ok {
+			return fmt.Errorf("invitation cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Role = rel
+
+		if rel != nil {
+			rel.R.Invitations = InvitationSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("invitation has no relationship %q", name)
+	}
+}
+
+type invitationPreloader struct {
+	AcceptedUserUser func(...psql.PreloadOption) psql.Preloader
+	InvitedByUser    func(...psql.PreloadOption) psql.Preloader
+	Role             func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildInvitationPreloader() invitationPreloader {
+	return invitationPreloader{
+		AcceptedUserUser: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "AcceptedUserUser",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Invitations,
+						To:          Users,
+						FromColumns: []string{"accepted_user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		InvitedByUser: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "InvitedByUser",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Invitations,
+						To:          Users,
+						FromColumns: []string{"invited_by"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		Role: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Role, RoleSlice](psql.PreloadRel{
+				Name: "Role",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Invitations,
+						To:          Roles,
+						FromColumns: []string{"role_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Roles.Columns.Names(), opts...)
+		},
+	}
+}
+
+type invitationThenLoader[Q orm.Loadable] struct {
+	AcceptedUserUser func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	InvitedByUser    func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Role             func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildInvitationThenLoader[Q orm.Loadable]() invitationThenLoader[Q] {
+	type AcceptedUserUserLoadInterface interface {
+		LoadAcceptedUserUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type InvitedByUserLoadInterface interface {
+		LoadInvitedByUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type RoleLoadInterface interface {
+		LoadRole(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return invitationThenLoader[Q]{
+		AcceptedUserUser: thenLoadBuilder[Q](
+			"AcceptedUserUser",
+			func(ctx context.Context, exec bob.Executor, retrieved AcceptedUserUserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAcceptedUserUser(ctx, exec, mods...)
+			},
+		),
+		InvitedByUser: thenLoadBuilder[Q](
+			"InvitedByUser",
+			func(ctx context.Context, exec bob.Executor, retrieved InvitedByUserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadInvitedByUser(ctx, exec, mods...)
+			},
+		),
+		Role: thenLoadBuilder[Q](
+			"Role",
+			func(ctx context.Context, exec bob.Executor, retrieved RoleLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadRole(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadAcceptedUserUser loads the invitation's AcceptedUserUser into the .R struct
+func (o *Invitation) LoadAcceptedUserUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.AcceptedUserUser = nil
+
+	related, err := o.AcceptedUserUser(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.AcceptedUserInvitations = InvitationSlice{o}
+
+	o.R.AcceptedUserUser = related
+	return nil
+}
+
+// LoadAcceptedUserUser loads the invitation's AcceptedUserUser into the .R struct
+func (os InvitationSlice) LoadAcceptedUserUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.AcceptedUserUser(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+			if !o.AcceptedUserID.IsValue() {
+				continue
+			}
+
+			if !(o.AcceptedUserID.IsValue() && o.AcceptedUserID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.AcceptedUserInvitations = append(rel.R.AcceptedUserInvitations, o)
+
+			o.R.AcceptedUserUser = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadInvitedByUser loads the invitation's InvitedByUser into the .R struct
+func (o *Invitation) LoadInvitedByUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.InvitedByUser = nil
+
+	related, err := o.InvitedByUser(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.InvitedByInvitations = InvitationSlice{o}
+
+	o.R.InvitedByUser = related
+	return nil
+}
+
+// LoadInvitedByUser loads the invitation's InvitedByUser into the .R struct
+func (os InvitationSlice) LoadInvitedByUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.InvitedByUser(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+			if !o.InvitedBy.IsValue() {
+				continue
+			}
+
+			if !(o.InvitedBy.IsValue() && o.InvitedBy.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.InvitedByInvitations = append(rel.R.InvitedByInvitations, o)
+
+			o.R.InvitedByUser = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadRole loads the invitation's Role into the .R struct
+func (o *Invitation) LoadRole(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Role = nil
+
+	related, err := o.Role(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Invitations = InvitationSlice{o}
+
+	o.R.Role = related
+	return nil
+}
+
+// LoadRole loads the invitation's Role into the .R struct
+func (os InvitationSlice) LoadRole(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	roles, err := os.Role(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range roles {
+
+			if !(o.RoleID == rel.ID) {
+				continue
+			}
+
+			rel.R.Invitations = append(rel.R.Invitations, o)
+
+			o.R.Role = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type invitationJoins[Q dialect.Joinable] struct {
+	typ              string
+	AcceptedUserUser modAs[Q, userColumns]
+	InvitedByUser    modAs[Q, userColumns]
+	Role             modAs[Q, roleColumns]
+}
+
+func (j invitationJoins[Q]) aliasedAs(alias string) invitationJoins[Q] {
+	return buildInvitationJoins[Q](buildInvitationColumns(alias), j.typ)
+}
+
+func buildInvitationJoins[Q dialect.Joinable](cols invitationColumns, typ string) invitationJoins[Q] {
+	return invitationJoins[Q]{
+		typ: typ,
+		AcceptedUserUser: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.AcceptedUserID),
+					))
+				}
+
+				return mods
+			},
+		},
+		InvitedByUser: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.InvitedBy),
+					))
+				}
+
+				return mods
+			},
+		},
+		Role: modAs[Q, roleColumns]{
+			c: Roles.Columns,
+			f: func(to roleColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Roles.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.RoleID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
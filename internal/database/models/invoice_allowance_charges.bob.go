@@ -0,0 +1,935 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/shopspring/decimal"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// InvoiceAllowanceCharge is an object representing the database table.
+type InvoiceAllowanceCharge struct {
+	ID            int64                     `db:"id,pk" `
+	InvoiceID     int64                     `db:"invoice_id" `
+	InvoiceLineID null.Val[int64]           `db:"invoice_line_id" `
+	IsCharge      bool                      `db:"is_charge" `
+	Reason        null.Val[string]          `db:"reason" `
+	Rate          null.Val[decimal.Decimal] `db:"rate" `
+	Amount        decimal.Decimal           `db:"amount" `
+	CreatedAt     null.Val[time.Time]       `db:"created_at" `
+
+	R invoiceAllowanceChargeR `db:"-" `
+}
+
+// InvoiceAllowanceChargeSlice is an alias for a slice of pointers to InvoiceAllowanceCharge.
+// This should almost always be used instead of []*InvoiceAllowanceCharge.
+type InvoiceAllowanceChargeSlice []*InvoiceAllowanceCharge
+
+// InvoiceAllowanceCharges contains methods to work with the invoice_allowance_charges table
+var InvoiceAllowanceCharges = psql.NewTablex[*InvoiceAllowanceCharge, InvoiceAllowanceChargeSlice, *InvoiceAllowanceChargeSetter]("", "invoice_allowance_charges", buildInvoiceAllowanceChargeColumns("invoice_allowance_charges"))
+
+// InvoiceAllowanceChargesQuery is a query on the invoice_allowance_charges table
+type InvoiceAllowanceChargesQuery = *psql.ViewQuery[*InvoiceAllowanceCharge, InvoiceAllowanceChargeSlice]
+
+// invoiceAllowanceChargeR is where relationships are stored.
+type invoiceAllowanceChargeR struct {
+	Invoice     *Invoice     // invoice_allowance_charges.invoice_allowance_charges_invoice_id_fkey
+	InvoiceLine *InvoiceLine // invoice_allowance_charges.invoice_allowance_charges_invoice_line_id_fkey
+}
+
+func buildInvoiceAllowanceChargeColumns(alias string) invoiceAllowanceChargeColumns {
+	return invoiceAllowanceChargeColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "invoice_id", "invoice_line_id", "is_charge", "reason", "rate", "amount", "created_at",
+		).WithParent("invoice_allowance_charges"),
+		tableAlias:    alias,
+		ID:            psql.Quote(alias, "id"),
+		InvoiceID:     psql.Quote(alias, "invoice_id"),
+		InvoiceLineID: psql.Quote(alias, "invoice_line_id"),
+		IsCharge:      psql.Quote(alias, "is_charge"),
+		Reason:        psql.Quote(alias, "reason"),
+		Rate:          psql.Quote(alias, "rate"),
+		Amount:        psql.Quote(alias, "amount"),
+		CreatedAt:     psql.Quote(alias, "created_at"),
+	}
+}
+
+type invoiceAllowanceChargeColumns struct {
+	expr.ColumnsExpr
+	tableAlias    string
+	ID            psql.Expression
+	InvoiceID     psql.Expression
+	InvoiceLineID psql.Expression
+	IsCharge      psql.Expression
+	Reason        psql.Expression
+	Rate          psql.Expression
+	Amount        psql.Expression
+	CreatedAt     psql.Expression
+}
+
+func (c invoiceAllowanceChargeColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (invoiceAllowanceChargeColumns) AliasedAs(alias string) invoiceAllowanceChargeColumns {
+	return buildInvoiceAllowanceChargeColumns(alias)
+}
+
+// InvoiceAllowanceChargeSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type InvoiceAllowanceChargeSetter struct {
+	ID            omit.Val[int64]               `db:"id,pk" `
+	InvoiceID     omit.Val[int64]               `db:"invoice_id" `
+	InvoiceLineID omitnull.Val[int64]           `db:"invoice_line_id" `
+	IsCharge      omit.Val[bool]                `db:"is_charge" `
+	Reason        omitnull.Val[string]          `db:"reason" `
+	Rate          omitnull.Val[decimal.Decimal] `db:"rate" `
+	Amount        omit.Val[decimal.Decimal]     `db:"amount" `
+	CreatedAt     omitnull.Val[time.Time]       `db:"created_at" `
+}
+
+func (s InvoiceAllowanceChargeSetter) SetColumns() []string {
+	vals := make([]string, 0, 8)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.InvoiceID.IsValue() {
+		vals = append(vals, "invoice_id")
+	}
+	if !s.InvoiceLineID.IsUnset() {
+		vals = append(vals, "invoice_line_id")
+	}
+	if s.IsCharge.IsValue() {
+		vals = append(vals, "is_charge")
+	}
+	if !s.Reason.IsUnset() {
+		vals = append(vals, "reason")
+	}
+	if !s.Rate.IsUnset() {
+		vals = append(vals, "rate")
+	}
+	if s.Amount.IsValue() {
+		vals = append(vals, "amount")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s InvoiceAllowanceChargeSetter) Overwrite(t *InvoiceAllowanceCharge) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.InvoiceID.IsValue() {
+		t.InvoiceID = s.InvoiceID.MustGet()
+	}
+	if !s.InvoiceLineID.IsUnset() {
+		t.InvoiceLineID = s.InvoiceLineID.MustGetNull()
+	}
+	if s.IsCharge.IsValue() {
+		t.IsCharge = s.IsCharge.MustGet()
+	}
+	if !s.Reason.IsUnset() {
+		t.Reason = s.Reason.MustGetNull()
+	}
+	if !s.Rate.IsUnset() {
+		t.Rate = s.Rate.MustGetNull()
+	}
+	if s.Amount.IsValue() {
+		t.Amount = s.Amount.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *InvoiceAllowanceChargeSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return InvoiceAllowanceCharges.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 8)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.InvoiceID.IsValue() {
+			vals[1] = psql.Arg(s.InvoiceID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.InvoiceLineID.IsUnset() {
+			vals[2] = psql.Arg(s.InvoiceLineID.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.IsCharge.IsValue() {
+			vals[3] = psql.Arg(s.IsCharge.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if !s.Reason.IsUnset() {
+			vals[4] = psql.Arg(s.Reason.MustGetNull())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if !s.Rate.IsUnset() {
+			vals[5] = psql.Arg(s.Rate.MustGetNull())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.Amount.IsValue() {
+			vals[6] = psql.Arg(s.Amount.MustGet())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[7] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s InvoiceAllowanceChargeSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s InvoiceAllowanceChargeSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 8)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.InvoiceID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "invoice_id")...),
+			psql.Arg(s.InvoiceID),
+		}})
+	}
+
+	if !s.InvoiceLineID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "invoice_line_id")...),
+			psql.Arg(s.InvoiceLineID),
+		}})
+	}
+
+	if s.IsCharge.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "is_charge")...),
+			psql.Arg(s.IsCharge),
+		}})
+	}
+
+	if !s.Reason.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "reason")...),
+			psql.Arg(s.Reason),
+		}})
+	}
+
+	if !s.Rate.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "rate")...),
+			psql.Arg(s.Rate),
+		}})
+	}
+
+	if s.Amount.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "amount")...),
+			psql.Arg(s.Amount),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindInvoiceAllowanceCharge retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindInvoiceAllowanceCharge(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*InvoiceAllowanceCharge, error) {
+	if len(cols) == 0 {
+		return InvoiceAllowanceCharges.Query(
+			sm.Where(InvoiceAllowanceCharges.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return InvoiceAllowanceCharges.Query(
+		sm.Where(InvoiceAllowanceCharges.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(InvoiceAllowanceCharges.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// InvoiceAllowanceChargeExists checks the presence of a single record by primary key
+func InvoiceAllowanceChargeExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return InvoiceAllowanceCharges.Query(
+		sm.Where(InvoiceAllowanceCharges.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after InvoiceAllowanceCharge is retrieved from the database
+func (o *InvoiceAllowanceCharge) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = InvoiceAllowanceCharges.AfterSelectHooks.RunHooks(ctx, exec, InvoiceAllowanceChargeSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = InvoiceAllowanceCharges.AfterInsertHooks.RunHooks(ctx, exec, InvoiceAllowanceChargeSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = InvoiceAllowanceCharges.AfterUpdateHooks.RunHooks(ctx, exec, InvoiceAllowanceChargeSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = InvoiceAllowanceCharges.AfterDeleteHooks.RunHooks(ctx, exec, InvoiceAllowanceChargeSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the InvoiceAllowanceCharge
+func (o *InvoiceAllowanceCharge) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *InvoiceAllowanceCharge) pkEQ() dialect.Expression {
+	return psql.Quote("invoice_allowance_charges", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the InvoiceAllowanceCharge
+func (o *InvoiceAllowanceCharge) Update(ctx context.Context, exec bob.Executor, s *InvoiceAllowanceChargeSetter) error {
+	v, err := InvoiceAllowanceCharges.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single InvoiceAllowanceCharge record with an executor
+func (o *InvoiceAllowanceCharge) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := InvoiceAllowanceCharges.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the InvoiceAllowanceCharge using the executor
+func (o *InvoiceAllowanceCharge) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := InvoiceAllowanceCharges.Query(
+		sm.Where(InvoiceAllowanceCharges.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after InvoiceAllowanceChargeSlice is retrieved from the database
+func (o InvoiceAllowanceChargeSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = InvoiceAllowanceCharges.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = InvoiceAllowanceCharges.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = InvoiceAllowanceCharges.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = InvoiceAllowanceCharges.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o InvoiceAllowanceChargeSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("invoice_allowance_charges", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o InvoiceAllowanceChargeSlice) copyMatchingRows(from ...*InvoiceAllowanceCharge) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o InvoiceAllowanceChargeSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return InvoiceAllowanceCharges.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *InvoiceAllowanceCharge:
+				o.copyMatchingRows(retrieved)
+			case []*InvoiceAllowanceCharge:
+				o.copyMatchingRows(retrieved...)
+			case InvoiceAllowanceChargeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a InvoiceAllowanceCharge or a slice of InvoiceAllowanceCharge
+				// then run the AfterUpdateHooks on the slice
+				_, err = InvoiceAllowanceCharges.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o InvoiceAllowanceChargeSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return InvoiceAllowanceCharges.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *InvoiceAllowanceCharge:
+				o.copyMatchingRows(retrieved)
+			case []*InvoiceAllowanceCharge:
+				o.copyMatchingRows(retrieved...)
+			case InvoiceAllowanceChargeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a InvoiceAllowanceCharge or a slice of InvoiceAllowanceCharge
+				// then run the AfterDeleteHooks on the slice
+				_, err = InvoiceAllowanceCharges.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o InvoiceAllowanceChargeSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals InvoiceAllowanceChargeSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := InvoiceAllowanceCharges.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o InvoiceAllowanceChargeSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := InvoiceAllowanceCharges.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o InvoiceAllowanceChargeSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := InvoiceAllowanceCharges.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Invoice starts a query for related objects on invoices
+func (o *InvoiceAllowanceCharge) Invoice(mods ...bob.Mod[*dialect.SelectQuery]) InvoicesQuery {
+	return Invoices.Query(append(mods,
+		sm.Where(Invoices.Columns.ID.EQ(psql.Arg(o.InvoiceID))),
+	)...)
+}
+
+func (os InvoiceAllowanceChargeSlice) Invoice(mods ...bob.Mod[*dialect.SelectQuery]) InvoicesQuery {
+	pkInvoiceID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkInvoiceID = append(pkInvoiceID, o.InvoiceID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkInvoiceID), "bigint[]")),
+	))
+
+	return Invoices.Query(append(mods,
+		sm.Where(psql.Group(Invoices.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// InvoiceLine starts a query for related objects on invoice_lines
+func (o *InvoiceAllowanceCharge) InvoiceLine(mods ...bob.Mod[*dialect.SelectQuery]) InvoiceLinesQuery {
+	return InvoiceLines.Query(append(mods,
+		sm.Where(InvoiceLines.Columns.ID.EQ(psql.Arg(o.InvoiceLineID))),
+	)...)
+}
+
+func (os InvoiceAllowanceChargeSlice) InvoiceLine(mods ...bob.Mod[*dialect.SelectQuery]) InvoiceLinesQuery {
+	pkInvoiceLineID := make(pgtypes.Array[null.Val[int64]], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkInvoiceLineID = append(pkInvoiceLineID, o.InvoiceLineID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkInvoiceLineID), "bigint[]")),
+	))
+
+	return InvoiceLines.Query(append(mods,
+		sm.Where(psql.Group(InvoiceLines.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachInvoiceAllowanceChargeInvoice0(ctx context.Context, exec bob.Executor, count int, invoiceAllowanceCharge0 *InvoiceAllowanceCharge, invoice1 *Invoice) (*InvoiceAllowanceCharge, error) {
+	setter := &InvoiceAllowanceChargeSetter{
+		InvoiceID: omit.From(invoice1.ID),
+	}
+
+	err := invoiceAllowanceCharge0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvoiceAllowanceChargeInvoice0: %w", err)
+	}
+
+	return invoiceAllowanceCharge0, nil
+}
+
+func (invoiceAllowanceCharge0 *InvoiceAllowanceCharge) InsertInvoice(ctx context.Context, exec bob.Executor, related *InvoiceSetter) error {
+	var err error
+
+	invoice1, err := Invoices.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachInvoiceAllowanceChargeInvoice0(ctx, exec, 1, invoiceAllowanceCharge0, invoice1)
+	if err != nil {
+		return err
+	}
+
+	invoiceAllowanceCharge0.R.Invoice = invoice1
+
+	invoice1.R.InvoiceAllowanceCharges = append(invoice1.R.InvoiceAllowanceCharges, invoiceAllowanceCharge0)
+
+	return nil
+}
+
+func (invoiceAllowanceCharge0 *InvoiceAllowanceCharge) AttachInvoice(ctx context.Context, exec bob.Executor, invoice1 *Invoice) error {
+	var err error
+
+	_, err = attachInvoiceAllowanceChargeInvoice0(ctx, exec, 1, invoiceAllowanceCharge0, invoice1)
+	if err != nil {
+		return err
+	}
+
+	invoiceAllowanceCharge0.R.Invoice = invoice1
+
+	invoice1.R.InvoiceAllowanceCharges = append(invoice1.R.InvoiceAllowanceCharges, invoiceAllowanceCharge0)
+
+	return nil
+}
+
+func attachInvoiceAllowanceChargeInvoiceLine0(ctx context.Context, exec bob.Executor, count int, invoiceAllowanceCharge0 *InvoiceAllowanceCharge, invoiceLine1 *InvoiceLine) (*InvoiceAllowanceCharge, error) {
+	setter := &InvoiceAllowanceChargeSetter{
+		InvoiceLineID: omitnull.From(invoiceLine1.ID),
+	}
+
+	err := invoiceAllowanceCharge0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvoiceAllowanceChargeInvoiceLine0: %w", err)
+	}
+
+	return invoiceAllowanceCharge0, nil
+}
+
+func (invoiceAllowanceCharge0 *InvoiceAllowanceCharge) InsertInvoiceLine(ctx context.Context, exec bob.Executor, related *InvoiceLineSetter) error {
+	var err error
+
+	invoiceLine1, err := InvoiceLines.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachInvoiceAllowanceChargeInvoiceLine0(ctx, exec, 1, invoiceAllowanceCharge0, invoiceLine1)
+	if err != nil {
+		return err
+	}
+
+	invoiceAllowanceCharge0.R.InvoiceLine = invoiceLine1
+
+	invoiceLine1.R.InvoiceAllowanceCharges = append(invoiceLine1.R.InvoiceAllowanceCharges, invoiceAllowanceCharge0)
+
+	return nil
+}
+
+func (invoiceAllowanceCharge0 *InvoiceAllowanceCharge) AttachInvoiceLine(ctx context.Context, exec bob.Executor, invoiceLine1 *InvoiceLine) error {
+	var err error
+
+	_, err = attachInvoiceAllowanceChargeInvoiceLine0(ctx, exec, 1, invoiceAllowanceCharge0, invoiceLine1)
+	if err != nil {
+		return err
+	}
+
+	invoiceAllowanceCharge0.R.InvoiceLine = invoiceLine1
+
+	invoiceLine1.R.InvoiceAllowanceCharges = append(invoiceLine1.R.InvoiceAllowanceCharges, invoiceAllowanceCharge0)
+
+	return nil
+}
+
+type invoiceAllowanceChargeWhere[Q psql.Filterable] struct {
+	ID            psql.WhereMod[Q, int64]
+	InvoiceID     psql.WhereMod[Q, int64]
+	InvoiceLineID psql.WhereNullMod[Q, int64]
+	IsCharge      psql.WhereMod[Q, bool]
+	Reason        psql.WhereNullMod[Q, string]
+	Rate          psql.WhereNullMod[Q, decimal.Decimal]
+	Amount        psql.WhereMod[Q, decimal.Decimal]
+	CreatedAt     psql.WhereNullMod[Q, time.Time]
+}
+
+func (invoiceAllowanceChargeWhere[Q]) AliasedAs(alias string) invoiceAllowanceChargeWhere[Q] {
+	return buildInvoiceAllowanceChargeWhere[Q](buildInvoiceAllowanceChargeColumns(alias))
+}
+
+func buildInvoiceAllowanceChargeWhere[Q psql.Filterable](cols invoiceAllowanceChargeColumns) invoiceAllowanceChargeWhere[Q] {
+	return invoiceAllowanceChargeWhere[Q]{
+		ID:            psql.Where[Q, int64](cols.ID),
+		InvoiceID:     psql.Where[Q, int64](cols.InvoiceID),
+		InvoiceLineID: psql.WhereNull[Q, int64](cols.InvoiceLineID),
+		IsCharge:      psql.Where[Q, bool](cols.IsCharge),
+		Reason:        psql.WhereNull[Q, string](cols.Reason),
+		Rate:          psql.WhereNull[Q, decimal.Decimal](cols.Rate),
+		Amount:        psql.Where[Q, decimal.Decimal](cols.Amount),
+		CreatedAt:     psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *InvoiceAllowanceCharge) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Invoice":
+		rel, ok := retrieved.(*Invoice)
+		if !ok {
+			return fmt.Errorf("invoiceAllowanceCharge cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Invoice = rel
+
+		if rel != nil {
+			rel.R.InvoiceAllowanceCharges = InvoiceAllowanceChargeSlice{o}
+		}
+		return nil
+	case "InvoiceLine":
+		rel, ok := retrieved.(*InvoiceLine)
+		if !ok {
+			return fmt.Errorf("invoiceAllowanceCharge cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.InvoiceLine = rel
+
+		if rel != nil {
+			rel.R.InvoiceAllowanceCharges = InvoiceAllowanceChargeSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("invoiceAllowanceCharge has no relationship %q", name)
+	}
+}
+
+type invoiceAllowanceChargePreloader struct {
+	Invoice     func(...psql.PreloadOption) psql.Preloader
+	InvoiceLine func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildInvoiceAllowanceChargePreloader() invoiceAllowanceChargePreloader {
+	return invoiceAllowanceChargePreloader{
+		Invoice: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Invoice, InvoiceSlice](psql.PreloadRel{
+				Name: "Invoice",
+				Sides: []psql.PreloadSide{
+					{
+						From:        InvoiceAllowanceCharges,
+						To:          Invoices,
+						FromColumns: []string{"invoice_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Invoices.Columns.Names(), opts...)
+		},
+		InvoiceLine: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*InvoiceLine, InvoiceLineSlice](psql.PreloadRel{
+				Name: "InvoiceLine",
+				Sides: []psql.PreloadSide{
+					{
+						From:        InvoiceAllowanceCharges,
+						To:          InvoiceLines,
+						FromColumns: []string{"invoice_line_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, InvoiceLines.Columns.Names(), opts...)
+		},
+	}
+}
+
+type invoiceAllowanceChargeThenLoader[Q orm.Loadable] struct {
+	Invoice     func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	InvoiceLine func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildInvoiceAllowanceChargeThenLoader[Q orm.Loadable]() invoiceAllowanceChargeThenLoader[Q] {
+	type InvoiceLoadInterface interface {
+		LoadInvoice(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type InvoiceLineLoadInterface interface {
+		LoadInvoiceLine(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return invoiceAllowanceChargeThenLoader[Q]{
+		Invoice: thenLoadBuilder[Q](
+			"Invoice",
+			func(ctx context.Context, exec bob.Executor, retrieved InvoiceLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadInvoice(ctx, exec, mods...)
+			},
+		),
+		InvoiceLine: thenLoadBuilder[Q](
+			"InvoiceLine",
+			func(ctx context.Context, exec bob.Executor, retrieved InvoiceLineLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadInvoiceLine(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadInvoice loads the invoiceAllowanceCharge's Invoice into the .R struct
+func (o *InvoiceAllowanceCharge) LoadInvoice(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Invoice = nil
+
+	related, err := o.Invoice(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.InvoiceAllowanceCharges = InvoiceAllowanceChargeSlice{o}
+
+	o.R.Invoice = related
+	return nil
+}
+
+// LoadInvoice loads the invoiceAllowanceCharge's Invoice into the .R struct
+func (os InvoiceAllowanceChargeSlice) LoadInvoice(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invoices, err := os.Invoice(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invoices {
+
+			if !(o.InvoiceID == rel.ID) {
+				continue
+			}
+
+			rel.R.InvoiceAllowanceCharges = append(rel.R.InvoiceAllowanceCharges, o)
+
+			o.R.Invoice = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadInvoiceLine loads the invoiceAllowanceCharge's InvoiceLine into the .R struct
+func (o *InvoiceAllowanceCharge) LoadInvoiceLine(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.InvoiceLine = nil
+
+	related, err := o.InvoiceLine(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.InvoiceAllowanceCharges = InvoiceAllowanceChargeSlice{o}
+
+	o.R.InvoiceLine = related
+	return nil
+}
+
+// LoadInvoiceLine loads the invoiceAllowanceCharge's InvoiceLine into the .R struct
+func (os InvoiceAllowanceChargeSlice) LoadInvoiceLine(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invoiceLines, err := os.InvoiceLine(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invoiceLines {
+			if !o.InvoiceLineID.IsValue() {
+				continue
+			}
+
+			if !(o.InvoiceLineID.IsValue() && o.InvoiceLineID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.InvoiceAllowanceCharges = append(rel.R.InvoiceAllowanceCharges, o)
+
+			o.R.InvoiceLine = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type invoiceAllowanceChargeJoins[Q dialect.Joinable] struct {
+	typ         string
+	Invoice     modAs[Q, invoiceColumns]
+	InvoiceLine modAs[Q, invoiceLineColumns]
+}
+
+func (j invoiceAllowanceChargeJoins[Q]) aliasedAs(alias string) invoiceAllowanceChargeJoins[Q] {
+	return buildInvoiceAllowanceChargeJoins[Q](buildInvoiceAllowanceChargeColumns(alias), j.typ)
+}
+
+func buildInvoiceAllowanceChargeJoins[Q dialect.Joinable](cols invoiceAllowanceChargeColumns, typ string) invoiceAllowanceChargeJoins[Q] {
+	return invoiceAllowanceChargeJoins[Q]{
+		typ: typ,
+		Invoice: modAs[Q, invoiceColumns]{
+			c: Invoices.Columns,
+			f: func(to invoiceColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Invoices.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.InvoiceID),
+					))
+				}
+
+				return mods
+			},
+		},
+		InvoiceLine: modAs[Q, invoiceLineColumns]{
+			c: InvoiceLines.Columns,
+			f: func(to invoiceLineColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, InvoiceLines.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.InvoiceLineID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
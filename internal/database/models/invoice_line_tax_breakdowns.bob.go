@@ -0,0 +1,781 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/shopspring/decimal"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// InvoiceLineTaxBreakdown is an object representing the database table.
+type InvoiceLineTaxBreakdown struct {
+	ID              int64                     `db:"id,pk" `
+	InvoiceLineID   int64                     `db:"invoice_line_id" `
+	TaxType         string                    `db:"tax_type" `
+	Rate            null.Val[decimal.Decimal] `db:"rate" `
+	PerUnitAmount   null.Val[decimal.Decimal] `db:"per_unit_amount" `
+	ExemptionReason null.Val[string]          `db:"exemption_reason" `
+	TaxableAmount   decimal.Decimal           `db:"taxable_amount" `
+	TaxAmount       decimal.Decimal           `db:"tax_amount" `
+	CreatedAt       null.Val[time.Time]       `db:"created_at" `
+
+	R invoiceLineTaxBreakdownR `db:"-" `
+}
+
+// InvoiceLineTaxBreakdownSlice is an alias for a slice of pointers to InvoiceLineTaxBreakdown.
+// This should almost always be used instead of []*InvoiceLineTaxBreakdown.
+type InvoiceLineTaxBreakdownSlice []*InvoiceLineTaxBreakdown
+
+// InvoiceLineTaxBreakdowns contains methods to work with the invoice_line_tax_breakdowns table
+var InvoiceLineTaxBreakdowns = psql.NewTablex[*InvoiceLineTaxBreakdown, InvoiceLineTaxBreakdownSlice, *InvoiceLineTaxBreakdownSetter]("", "invoice_line_tax_breakdowns", buildInvoiceLineTaxBreakdownColumns("invoice_line_tax_breakdowns"))
+
+// InvoiceLineTaxBreakdownsQuery is a query on the invoice_line_tax_breakdowns table
+type InvoiceLineTaxBreakdownsQuery = *psql.ViewQuery[*InvoiceLineTaxBreakdown, InvoiceLineTaxBreakdownSlice]
+
+// invoiceLineTaxBreakdownR is where relationships are stored.
+type invoiceLineTaxBreakdownR struct {
+	InvoiceLine *InvoiceLine // invoice_line_tax_breakdowns.invoice_line_tax_breakdowns_invoice_line_id_fkey
+}
+
+func buildInvoiceLineTaxBreakdownColumns(alias string) invoiceLineTaxBreakdownColumns {
+	return invoiceLineTaxBreakdownColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "invoice_line_id", "tax_type", "rate", "per_unit_amount", "exemption_reason", "taxable_amount", "tax_amount", "created_at",
+		).WithParent("invoice_line_tax_breakdowns"),
+		tableAlias:      alias,
+		ID:              psql.Quote(alias, "id"),
+		InvoiceLineID:   psql.Quote(alias, "invoice_line_id"),
+		TaxType:         psql.Quote(alias, "tax_type"),
+		Rate:            psql.Quote(alias, "rate"),
+		PerUnitAmount:   psql.Quote(alias, "per_unit_amount"),
+		ExemptionReason: psql.Quote(alias, "exemption_reason"),
+		TaxableAmount:   psql.Quote(alias, "taxable_amount"),
+		TaxAmount:       psql.Quote(alias, "tax_amount"),
+		CreatedAt:       psql.Quote(alias, "created_at"),
+	}
+}
+
+type invoiceLineTaxBreakdownColumns struct {
+	expr.ColumnsExpr
+	tableAlias      string
+	ID              psql.Expression
+	InvoiceLineID   psql.Expression
+	TaxType         psql.Expression
+	Rate            psql.Expression
+	PerUnitAmount   psql.Expression
+	ExemptionReason psql.Expression
+	TaxableAmount   psql.Expression
+	TaxAmount       psql.Expression
+	CreatedAt       psql.Expression
+}
+
+func (c invoiceLineTaxBreakdownColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (invoiceLineTaxBreakdownColumns) AliasedAs(alias string) invoiceLineTaxBreakdownColumns {
+	return buildInvoiceLineTaxBreakdownColumns(alias)
+}
+
+// InvoiceLineTaxBreakdownSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type InvoiceLineTaxBreakdownSetter struct {
+	ID              omit.Val[int64]               `db:"id,pk" `
+	InvoiceLineID   omit.Val[int64]               `db:"invoice_line_id" `
+	TaxType         omit.Val[string]              `db:"tax_type" `
+	Rate            omitnull.Val[decimal.Decimal] `db:"rate" `
+	PerUnitAmount   omitnull.Val[decimal.Decimal] `db:"per_unit_amount" `
+	ExemptionReason omitnull.Val[string]          `db:"exemption_reason" `
+	TaxableAmount   omit.Val[decimal.Decimal]     `db:"taxable_amount" `
+	TaxAmount       omit.Val[decimal.Decimal]     `db:"tax_amount" `
+	CreatedAt       omitnull.Val[time.Time]       `db:"created_at" `
+}
+
+func (s InvoiceLineTaxBreakdownSetter) SetColumns() []string {
+	vals := make([]string, 0, 9)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.InvoiceLineID.IsValue() {
+		vals = append(vals, "invoice_line_id")
+	}
+	if s.TaxType.IsValue() {
+		vals = append(vals, "tax_type")
+	}
+	if !s.Rate.IsUnset() {
+		vals = append(vals, "rate")
+	}
+	if !s.PerUnitAmount.IsUnset() {
+		vals = append(vals, "per_unit_amount")
+	}
+	if !s.ExemptionReason.IsUnset() {
+		vals = append(vals, "exemption_reason")
+	}
+	if s.TaxableAmount.IsValue() {
+		vals = append(vals, "taxable_amount")
+	}
+	if s.TaxAmount.IsValue() {
+		vals = append(vals, "tax_amount")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s InvoiceLineTaxBreakdownSetter) Overwrite(t *InvoiceLineTaxBreakdown) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.InvoiceLineID.IsValue() {
+		t.InvoiceLineID = s.InvoiceLineID.MustGet()
+	}
+	if s.TaxType.IsValue() {
+		t.TaxType = s.TaxType.MustGet()
+	}
+	if !s.Rate.IsUnset() {
+		t.Rate = s.Rate.MustGetNull()
+	}
+	if !s.PerUnitAmount.IsUnset() {
+		t.PerUnitAmount = s.PerUnitAmount.MustGetNull()
+	}
+	if !s.ExemptionReason.IsUnset() {
+		t.ExemptionReason = s.ExemptionReason.MustGetNull()
+	}
+	if s.TaxableAmount.IsValue() {
+		t.TaxableAmount = s.TaxableAmount.MustGet()
+	}
+	if s.TaxAmount.IsValue() {
+		t.TaxAmount = s.TaxAmount.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *InvoiceLineTaxBreakdownSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return InvoiceLineTaxBreakdowns.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 9)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.InvoiceLineID.IsValue() {
+			vals[1] = psql.Arg(s.InvoiceLineID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.TaxType.IsValue() {
+			vals[2] = psql.Arg(s.TaxType.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if !s.Rate.IsUnset() {
+			vals[3] = psql.Arg(s.Rate.MustGetNull())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if !s.PerUnitAmount.IsUnset() {
+			vals[4] = psql.Arg(s.PerUnitAmount.MustGetNull())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if !s.ExemptionReason.IsUnset() {
+			vals[5] = psql.Arg(s.ExemptionReason.MustGetNull())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.TaxableAmount.IsValue() {
+			vals[6] = psql.Arg(s.TaxableAmount.MustGet())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if s.TaxAmount.IsValue() {
+			vals[7] = psql.Arg(s.TaxAmount.MustGet())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[8] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s InvoiceLineTaxBreakdownSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s InvoiceLineTaxBreakdownSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 9)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.InvoiceLineID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "invoice_line_id")...),
+			psql.Arg(s.InvoiceLineID),
+		}})
+	}
+
+	if s.TaxType.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "tax_type")...),
+			psql.Arg(s.TaxType),
+		}})
+	}
+
+	if !s.Rate.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "rate")...),
+			psql.Arg(s.Rate),
+		}})
+	}
+
+	if !s.PerUnitAmount.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "per_unit_amount")...),
+			psql.Arg(s.PerUnitAmount),
+		}})
+	}
+
+	if !s.ExemptionReason.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "exemption_reason")...),
+			psql.Arg(s.ExemptionReason),
+		}})
+	}
+
+	if s.TaxableAmount.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "taxable_amount")...),
+			psql.Arg(s.TaxableAmount),
+		}})
+	}
+
+	if s.TaxAmount.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "tax_amount")...),
+			psql.Arg(s.TaxAmount),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindInvoiceLineTaxBreakdown retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindInvoiceLineTaxBreakdown(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*InvoiceLineTaxBreakdown, error) {
+	if len(cols) == 0 {
+		return InvoiceLineTaxBreakdowns.Query(
+			sm.Where(InvoiceLineTaxBreakdowns.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return InvoiceLineTaxBreakdowns.Query(
+		sm.Where(InvoiceLineTaxBreakdowns.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(InvoiceLineTaxBreakdowns.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// InvoiceLineTaxBreakdownExists checks the presence of a single record by primary key
+func InvoiceLineTaxBreakdownExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return InvoiceLineTaxBreakdowns.Query(
+		sm.Where(InvoiceLineTaxBreakdowns.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after InvoiceLineTaxBreakdown is retrieved from the database
+func (o *InvoiceLineTaxBreakdown) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = InvoiceLineTaxBreakdowns.AfterSelectHooks.RunHooks(ctx, exec, InvoiceLineTaxBreakdownSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = InvoiceLineTaxBreakdowns.AfterInsertHooks.RunHooks(ctx, exec, InvoiceLineTaxBreakdownSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = InvoiceLineTaxBreakdowns.AfterUpdateHooks.RunHooks(ctx, exec, InvoiceLineTaxBreakdownSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = InvoiceLineTaxBreakdowns.AfterDeleteHooks.RunHooks(ctx, exec, InvoiceLineTaxBreakdownSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the InvoiceLineTaxBreakdown
+func (o *InvoiceLineTaxBreakdown) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *InvoiceLineTaxBreakdown) pkEQ() dialect.Expression {
+	return psql.Quote("invoice_line_tax_breakdowns", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the InvoiceLineTaxBreakdown
+func (o *InvoiceLineTaxBreakdown) Update(ctx context.Context, exec bob.Executor, s *InvoiceLineTaxBreakdownSetter) error {
+	v, err := InvoiceLineTaxBreakdowns.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single InvoiceLineTaxBreakdown record with an executor
+func (o *InvoiceLineTaxBreakdown) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := InvoiceLineTaxBreakdowns.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the InvoiceLineTaxBreakdown using the executor
+func (o *InvoiceLineTaxBreakdown) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := InvoiceLineTaxBreakdowns.Query(
+		sm.Where(InvoiceLineTaxBreakdowns.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after InvoiceLineTaxBreakdownSlice is retrieved from the database
+func (o InvoiceLineTaxBreakdownSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = InvoiceLineTaxBreakdowns.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = InvoiceLineTaxBreakdowns.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = InvoiceLineTaxBreakdowns.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = InvoiceLineTaxBreakdowns.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o InvoiceLineTaxBreakdownSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("invoice_line_tax_breakdowns", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o InvoiceLineTaxBreakdownSlice) copyMatchingRows(from ...*InvoiceLineTaxBreakdown) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o InvoiceLineTaxBreakdownSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return InvoiceLineTaxBreakdowns.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *InvoiceLineTaxBreakdown:
+				o.copyMatchingRows(retrieved)
+			case []*InvoiceLineTaxBreakdown:
+				o.copyMatchingRows(retrieved...)
+			case InvoiceLineTaxBreakdownSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a InvoiceLineTaxBreakdown or a slice of InvoiceLineTaxBreakdown
+				// then run the AfterUpdateHooks on the slice
+				_, err = InvoiceLineTaxBreakdowns.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o InvoiceLineTaxBreakdownSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return InvoiceLineTaxBreakdowns.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *InvoiceLineTaxBreakdown:
+				o.copyMatchingRows(retrieved)
+			case []*InvoiceLineTaxBreakdown:
+				o.copyMatchingRows(retrieved...)
+			case InvoiceLineTaxBreakdownSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a InvoiceLineTaxBreakdown or a slice of InvoiceLineTaxBreakdown
+				// then run the AfterDeleteHooks on the slice
+				_, err = InvoiceLineTaxBreakdowns.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o InvoiceLineTaxBreakdownSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals InvoiceLineTaxBreakdownSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := InvoiceLineTaxBreakdowns.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o InvoiceLineTaxBreakdownSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := InvoiceLineTaxBreakdowns.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o InvoiceLineTaxBreakdownSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := InvoiceLineTaxBreakdowns.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// InvoiceLine starts a query for related objects on invoice_lines
+func (o *InvoiceLineTaxBreakdown) InvoiceLine(mods ...bob.Mod[*dialect.SelectQuery]) InvoiceLinesQuery {
+	return InvoiceLines.Query(append(mods,
+		sm.Where(InvoiceLines.Columns.ID.EQ(psql.Arg(o.InvoiceLineID))),
+	)...)
+}
+
+func (os InvoiceLineTaxBreakdownSlice) InvoiceLine(mods ...bob.Mod[*dialect.SelectQuery]) InvoiceLinesQuery {
+	pkInvoiceLineID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkInvoiceLineID = append(pkInvoiceLineID, o.InvoiceLineID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkInvoiceLineID), "bigint[]")),
+	))
+
+	return InvoiceLines.Query(append(mods,
+		sm.Where(psql.Group(InvoiceLines.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachInvoiceLineTaxBreakdownInvoiceLine0(ctx context.Context, exec bob.Executor, count int, invoiceLineTaxBreakdown0 *InvoiceLineTaxBreakdown, invoiceLine1 *InvoiceLine) (*InvoiceLineTaxBreakdown, error) {
+	setter := &InvoiceLineTaxBreakdownSetter{
+		InvoiceLineID: omit.From(invoiceLine1.ID),
+	}
+
+	err := invoiceLineTaxBreakdown0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvoiceLineTaxBreakdownInvoiceLine0: %w", err)
+	}
+
+	return invoiceLineTaxBreakdown0, nil
+}
+
+func (invoiceLineTaxBreakdown0 *InvoiceLineTaxBreakdown) InsertInvoiceLine(ctx context.Context, exec bob.Executor, related *InvoiceLineSetter) error {
+	var err error
+
+	invoiceLine1, err := InvoiceLines.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachInvoiceLineTaxBreakdownInvoiceLine0(ctx, exec, 1, invoiceLineTaxBreakdown0, invoiceLine1)
+	if err != nil {
+		return err
+	}
+
+	invoiceLineTaxBreakdown0.R.InvoiceLine = invoiceLine1
+
+	invoiceLine1.R.InvoiceLineTaxBreakdowns = append(invoiceLine1.R.InvoiceLineTaxBreakdowns, invoiceLineTaxBreakdown0)
+
+	return nil
+}
+
+func (invoiceLineTaxBreakdown0 *InvoiceLineTaxBreakdown) AttachInvoiceLine(ctx context.Context, exec bob.Executor, invoiceLine1 *InvoiceLine) error {
+	var err error
+
+	_, err = attachInvoiceLineTaxBreakdownInvoiceLine0(ctx, exec, 1, invoiceLineTaxBreakdown0, invoiceLine1)
+	if err != nil {
+		return err
+	}
+
+	invoiceLineTaxBreakdown0.R.InvoiceLine = invoiceLine1
+
+	invoiceLine1.R.InvoiceLineTaxBreakdowns = append(invoiceLine1.R.InvoiceLineTaxBreakdowns, invoiceLineTaxBreakdown0)
+
+	return nil
+}
+
+type invoiceLineTaxBreakdownWhere[Q psql.Filterable] struct {
+	ID              psql.WhereMod[Q, int64]
+	InvoiceLineID   psql.WhereMod[Q, int64]
+	TaxType         psql.WhereMod[Q, string]
+	Rate            psql.WhereNullMod[Q, decimal.Decimal]
+	PerUnitAmount   psql.WhereNullMod[Q, decimal.Decimal]
+	ExemptionReason psql.WhereNullMod[Q, string]
+	TaxableAmount   psql.WhereMod[Q, decimal.Decimal]
+	TaxAmount       psql.WhereMod[Q, decimal.Decimal]
+	CreatedAt       psql.WhereNullMod[Q, time.Time]
+}
+
+func (invoiceLineTaxBreakdownWhere[Q]) AliasedAs(alias string) invoiceLineTaxBreakdownWhere[Q] {
+	return buildInvoiceLineTaxBreakdownWhere[Q](buildInvoiceLineTaxBreakdownColumns(alias))
+}
+
+func buildInvoiceLineTaxBreakdownWhere[Q psql.Filterable](cols invoiceLineTaxBreakdownColumns) invoiceLineTaxBreakdownWhere[Q] {
+	return invoiceLineTaxBreakdownWhere[Q]{
+		ID:              psql.Where[Q, int64](cols.ID),
+		InvoiceLineID:   psql.Where[Q, int64](cols.InvoiceLineID),
+		TaxType:         psql.Where[Q, string](cols.TaxType),
+		Rate:            psql.WhereNull[Q, decimal.Decimal](cols.Rate),
+		PerUnitAmount:   psql.WhereNull[Q, decimal.Decimal](cols.PerUnitAmount),
+		ExemptionReason: psql.WhereNull[Q, string](cols.ExemptionReason),
+		TaxableAmount:   psql.Where[Q, decimal.Decimal](cols.TaxableAmount),
+		TaxAmount:       psql.Where[Q, decimal.Decimal](cols.TaxAmount),
+		CreatedAt:       psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *InvoiceLineTaxBreakdown) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "InvoiceLine":
+		rel, ok := retrieved.(*InvoiceLine)
+		if !ok {
+			return fmt.Errorf("invoiceLineTaxBreakdown cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.InvoiceLine = rel
+
+		if rel != nil {
+			rel.R.InvoiceLineTaxBreakdowns = InvoiceLineTaxBreakdownSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("invoiceLineTaxBreakdown has no relationship %q", name)
+	}
+}
+
+type invoiceLineTaxBreakdownPreloader struct {
+	InvoiceLine func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildInvoiceLineTaxBreakdownPreloader() invoiceLineTaxBreakdownPreloader {
+	return invoiceLineTaxBreakdownPreloader{
+		InvoiceLine: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*InvoiceLine, InvoiceLineSlice](psql.PreloadRel{
+				Name: "InvoiceLine",
+				Sides: []psql.PreloadSide{
+					{
+						From:        InvoiceLineTaxBreakdowns,
+						To:          InvoiceLines,
+						FromColumns: []string{"invoice_line_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, InvoiceLines.Columns.Names(), opts...)
+		},
+	}
+}
+
+type invoiceLineTaxBreakdownThenLoader[Q orm.Loadable] struct {
+	InvoiceLine func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildInvoiceLineTaxBreakdownThenLoader[Q orm.Loadable]() invoiceLineTaxBreakdownThenLoader[Q] {
+	type InvoiceLineLoadInterface interface {
+		LoadInvoiceLine(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return invoiceLineTaxBreakdownThenLoader[Q]{
+		InvoiceLine: thenLoadBuilder[Q](
+			"InvoiceLine",
+			func(ctx context.Context, exec bob.Executor, retrieved InvoiceLineLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadInvoiceLine(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadInvoiceLine loads the invoiceLineTaxBreakdown's InvoiceLine into the .R struct
+func (o *InvoiceLineTaxBreakdown) LoadInvoiceLine(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.InvoiceLine = nil
+
+	related, err := o.InvoiceLine(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.InvoiceLineTaxBreakdowns = InvoiceLineTaxBreakdownSlice{o}
+
+	o.R.InvoiceLine = related
+	return nil
+}
+
+// LoadInvoiceLine loads the invoiceLineTaxBreakdown's InvoiceLine into the .R struct
+func (os InvoiceLineTaxBreakdownSlice) LoadInvoiceLine(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invoiceLines, err := os.InvoiceLine(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invoiceLines {
+
+			if !(o.InvoiceLineID == rel.ID) {
+				continue
+			}
+
+			rel.R.InvoiceLineTaxBreakdowns = append(rel.R.InvoiceLineTaxBreakdowns, o)
+
+			o.R.InvoiceLine = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type invoiceLineTaxBreakdownJoins[Q dialect.Joinable] struct {
+	typ         string
+	InvoiceLine modAs[Q, invoiceLineColumns]
+}
+
+func (j invoiceLineTaxBreakdownJoins[Q]) aliasedAs(alias string) invoiceLineTaxBreakdownJoins[Q] {
+	return buildInvoiceLineTaxBreakdownJoins[Q](buildInvoiceLineTaxBreakdownColumns(alias), j.typ)
+}
+
+func buildInvoiceLineTaxBreakdownJoins[Q dialect.Joinable](cols invoiceLineTaxBreakdownColumns, typ string) invoiceLineTaxBreakdownJoins[Q] {
+	return invoiceLineTaxBreakdownJoins[Q]{
+		typ: typ,
+		InvoiceLine: modAs[Q, invoiceLineColumns]{
+			c: InvoiceLines.Columns,
+			f: func(to invoiceLineColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, InvoiceLines.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.InvoiceLineID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
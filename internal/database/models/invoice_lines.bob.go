@@ -0,0 +1,1295 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/shopspring/decimal"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// InvoiceLine is an object representing the database table.
+type InvoiceLine struct {
+	ID                 int64               `db:"id,pk" `
+	InvoiceID          int64               `db:"invoice_id" `
+	LineNumber         int32               `db:"line_number" `
+	ClassificationCode string              `db:"classification_code" `
+	Description        string              `db:"description" `
+	Quantity           decimal.Decimal     `db:"quantity" `
+	UnitCode           null.Val[string]    `db:"unit_code" `
+	UnitPrice          decimal.Decimal     `db:"unit_price" `
+	ProductTariffCode  null.Val[string]    `db:"product_tariff_code" `
+	CountryOfOrigin    null.Val[string]    `db:"country_of_origin" `
+	Subtotal           decimal.Decimal     `db:"subtotal" `
+	TotalExcludingTax  decimal.Decimal     `db:"total_excluding_tax" `
+	TaxAmount          decimal.Decimal     `db:"tax_amount" `
+	CreatedAt          null.Val[time.Time] `db:"created_at" `
+
+	R invoiceLineR `db:"-" `
+}
+
+// InvoiceLineSlice is an alias for a slice of pointers to InvoiceLine.
+// This should almost always be used instead of []*InvoiceLine.
+type InvoiceLineSlice []*InvoiceLine
+
+// InvoiceLines contains methods to work with the invoice_lines table
+var InvoiceLines = psql.NewTablex[*InvoiceLine, InvoiceLineSlice, *InvoiceLineSetter]("", "invoice_lines", buildInvoiceLineColumns("invoice_lines"))
+
+// InvoiceLinesQuery is a query on the invoice_lines table
+type InvoiceLinesQuery = *psql.ViewQuery[*InvoiceLine, InvoiceLineSlice]
+
+// invoiceLineR is where relationships are stored.
+type invoiceLineR struct {
+	InvoiceAllowanceCharges  InvoiceAllowanceChargeSlice  // invoice_allowance_charges.invoice_allowance_charges_invoice_line_id_fkey
+	InvoiceLineTaxBreakdowns InvoiceLineTaxBreakdownSlice // invoice_line_tax_breakdowns.invoice_line_tax_breakdowns_invoice_line_id_fkey
+	Invoice                  *Invoice                     // invoice_lines.invoice_lines_invoice_id_fkey
+}
+
+func buildInvoiceLineColumns(alias string) invoiceLineColumns {
+	return invoiceLineColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "invoice_id", "line_number", "classification_code", "description", "quantity", "unit_code", "unit_price", "product_tariff_code", "country_of_origin", "subtotal", "total_excluding_tax", "tax_amount", "created_at",
+		).WithParent("invoice_lines"),
+		tableAlias:         alias,
+		ID:                 psql.Quote(alias, "id"),
+		InvoiceID:          psql.Quote(alias, "invoice_id"),
+		LineNumber:         psql.Quote(alias, "line_number"),
+		ClassificationCode: psql.Quote(alias, "classification_code"),
+		Description:        psql.Quote(alias, "description"),
+		Quantity:           psql.Quote(alias, "quantity"),
+		UnitCode:           psql.Quote(alias, "unit_code"),
+		UnitPrice:          psql.Quote(alias, "unit_price"),
+		ProductTariffCode:  psql.Quote(alias, "product_tariff_code"),
+		CountryOfOrigin:    psql.Quote(alias, "country_of_origin"),
+		Subtotal:           psql.Quote(alias, "subtotal"),
+		TotalExcludingTax:  psql.Quote(alias, "total_excluding_tax"),
+		TaxAmount:          psql.Quote(alias, "tax_amount"),
+		CreatedAt:          psql.Quote(alias, "created_at"),
+	}
+}
+
+type invoiceLineColumns struct {
+	expr.ColumnsExpr
+	tableAlias         string
+	ID                 psql.Expression
+	InvoiceID          psql.Expression
+	LineNumber         psql.Expression
+	ClassificationCode psql.Expression
+	Description        psql.Expression
+	Quantity           psql.Expression
+	UnitCode           psql.Expression
+	UnitPrice          psql.Expression
+	ProductTariffCode  psql.Expression
+	CountryOfOrigin    psql.Expression
+	Subtotal           psql.Expression
+	TotalExcludingTax  psql.Expression
+	TaxAmount          psql.Expression
+	CreatedAt          psql.Expression
+}
+
+func (c invoiceLineColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (invoiceLineColumns) AliasedAs(alias string) invoiceLineColumns {
+	return buildInvoiceLineColumns(alias)
+}
+
+// InvoiceLineSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type InvoiceLineSetter struct {
+	ID                 omit.Val[int64]           `db:"id,pk" `
+	InvoiceID          omit.Val[int64]           `db:"invoice_id" `
+	LineNumber         omit.Val[int32]           `db:"line_number" `
+	ClassificationCode omit.Val[string]          `db:"classification_code" `
+	Description        omit.Val[string]          `db:"description" `
+	Quantity           omit.Val[decimal.Decimal] `db:"quantity" `
+	UnitCode           omitnull.Val[string]      `db:"unit_code" `
+	UnitPrice          omit.Val[decimal.Decimal] `db:"unit_price" `
+	ProductTariffCode  omitnull.Val[string]      `db:"product_tariff_code" `
+	CountryOfOrigin    omitnull.Val[string]      `db:"country_of_origin" `
+	Subtotal           omit.Val[decimal.Decimal] `db:"subtotal" `
+	TotalExcludingTax  omit.Val[decimal.Decimal] `db:"total_excluding_tax" `
+	TaxAmount          omit.Val[decimal.Decimal] `db:"tax_amount" `
+	CreatedAt          omitnull.Val[time.Time]   `db:"created_at" `
+}
+
+func (s InvoiceLineSetter) SetColumns() []string {
+	vals := make([]string, 0, 14)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.InvoiceID.IsValue() {
+		vals = append(vals, "invoice_id")
+	}
+	if s.LineNumber.IsValue() {
+		vals = append(vals, "line_number")
+	}
+	if s.ClassificationCode.IsValue() {
+		vals = append(vals, "classification_code")
+	}
+	if s.Description.IsValue() {
+		vals = append(vals, "description")
+	}
+	if s.Quantity.IsValue() {
+		vals = append(vals, "quantity")
+	}
+	if !s.UnitCode.IsUnset() {
+		vals = append(vals, "unit_code")
+	}
+	if s.UnitPrice.IsValue() {
+		vals = append(vals, "unit_price")
+	}
+	if !s.ProductTariffCode.IsUnset() {
+		vals = append(vals, "product_tariff_code")
+	}
+	if !s.CountryOfOrigin.IsUnset() {
+		vals = append(vals, "country_of_origin")
+	}
+	if s.Subtotal.IsValue() {
+		vals = append(vals, "subtotal")
+	}
+	if s.TotalExcludingTax.IsValue() {
+		vals = append(vals, "total_excluding_tax")
+	}
+	if s.TaxAmount.IsValue() {
+		vals = append(vals, "tax_amount")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s InvoiceLineSetter) Overwrite(t *InvoiceLine) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.InvoiceID.IsValue() {
+		t.InvoiceID = s.InvoiceID.MustGet()
+	}
+	if s.LineNumber.IsValue() {
+		t.LineNumber = s.LineNumber.MustGet()
+	}
+	if s.ClassificationCode.IsValue() {
+		t.ClassificationCode = s.ClassificationCode.MustGet()
+	}
+	if s.Description.IsValue() {
+		t.Description = s.Description.MustGet()
+	}
+	if s.Quantity.IsValue() {
+		t.Quantity = s.Quantity.MustGet()
+	}
+	if !s.UnitCode.IsUnset() {
+		t.UnitCode = s.UnitCode.MustGetNull()
+	}
+	if s.UnitPrice.IsValue() {
+		t.UnitPrice = s.UnitPrice.MustGet()
+	}
+	if !s.ProductTariffCode.IsUnset() {
+		t.ProductTariffCode = s.ProductTariffCode.MustGetNull()
+	}
+	if !s.CountryOfOrigin.IsUnset() {
+		t.CountryOfOrigin = s.CountryOfOrigin.MustGetNull()
+	}
+	if s.Subtotal.IsValue() {
+		t.Subtotal = s.Subtotal.MustGet()
+	}
+	if s.TotalExcludingTax.IsValue() {
+		t.TotalExcludingTax = s.TotalExcludingTax.MustGet()
+	}
+	if s.TaxAmount.IsValue() {
+		t.TaxAmount = s.TaxAmount.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *InvoiceLineSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return InvoiceLines.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 14)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.InvoiceID.IsValue() {
+			vals[1] = psql.Arg(s.InvoiceID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.LineNumber.IsValue() {
+			vals[2] = psql.Arg(s.LineNumber.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.ClassificationCode.IsValue() {
+			vals[3] = psql.Arg(s.ClassificationCode.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.Description.IsValue() {
+			vals[4] = psql.Arg(s.Description.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.Quantity.IsValue() {
+			vals[5] = psql.Arg(s.Quantity.MustGet())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UnitCode.IsUnset() {
+			vals[6] = psql.Arg(s.UnitCode.MustGetNull())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if s.UnitPrice.IsValue() {
+			vals[7] = psql.Arg(s.UnitPrice.MustGet())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if !s.ProductTariffCode.IsUnset() {
+			vals[8] = psql.Arg(s.ProductTariffCode.MustGetNull())
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CountryOfOrigin.IsUnset() {
+			vals[9] = psql.Arg(s.CountryOfOrigin.MustGetNull())
+		} else {
+			vals[9] = psql.Raw("DEFAULT")
+		}
+
+		if s.Subtotal.IsValue() {
+			vals[10] = psql.Arg(s.Subtotal.MustGet())
+		} else {
+			vals[10] = psql.Raw("DEFAULT")
+		}
+
+		if s.TotalExcludingTax.IsValue() {
+			vals[11] = psql.Arg(s.TotalExcludingTax.MustGet())
+		} else {
+			vals[11] = psql.Raw("DEFAULT")
+		}
+
+		if s.TaxAmount.IsValue() {
+			vals[12] = psql.Arg(s.TaxAmount.MustGet())
+		} else {
+			vals[12] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[13] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[13] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s InvoiceLineSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s InvoiceLineSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 14)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.InvoiceID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "invoice_id")...),
+			psql.Arg(s.InvoiceID),
+		}})
+	}
+
+	if s.LineNumber.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "line_number")...),
+			psql.Arg(s.LineNumber),
+		}})
+	}
+
+	if s.ClassificationCode.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "classification_code")...),
+			psql.Arg(s.ClassificationCode),
+		}})
+	}
+
+	if s.Description.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "description")...),
+			psql.Arg(s.Description),
+		}})
+	}
+
+	if s.Quantity.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "quantity")...),
+			psql.Arg(s.Quantity),
+		}})
+	}
+
+	if !s.UnitCode.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "unit_code")...),
+			psql.Arg(s.UnitCode),
+		}})
+	}
+
+	if s.UnitPrice.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "unit_price")...),
+			psql.Arg(s.UnitPrice),
+		}})
+	}
+
+	if !s.ProductTariffCode.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "product_tariff_code")...),
+			psql.Arg(s.ProductTariffCode),
+		}})
+	}
+
+	if !s.CountryOfOrigin.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "country_of_origin")...),
+			psql.Arg(s.CountryOfOrigin),
+		}})
+	}
+
+	if s.Subtotal.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "subtotal")...),
+			psql.Arg(s.Subtotal),
+		}})
+	}
+
+	if s.TotalExcludingTax.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "total_excluding_tax")...),
+			psql.Arg(s.TotalExcludingTax),
+		}})
+	}
+
+	if s.TaxAmount.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "tax_amount")...),
+			psql.Arg(s.TaxAmount),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindInvoiceLine retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindInvoiceLine(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*InvoiceLine, error) {
+	if len(cols) == 0 {
+		return InvoiceLines.Query(
+			sm.Where(InvoiceLines.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return InvoiceLines.Query(
+		sm.Where(InvoiceLines.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(InvoiceLines.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// InvoiceLineExists checks the presence of a single record by primary key
+func InvoiceLineExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return InvoiceLines.Query(
+		sm.Where(InvoiceLines.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after InvoiceLine is retrieved from the database
+func (o *InvoiceLine) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = InvoiceLines.AfterSelectHooks.RunHooks(ctx, exec, InvoiceLineSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = InvoiceLines.AfterInsertHooks.RunHooks(ctx, exec, InvoiceLineSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = InvoiceLines.AfterUpdateHooks.RunHooks(ctx, exec, InvoiceLineSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = InvoiceLines.AfterDeleteHooks.RunHooks(ctx, exec, InvoiceLineSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the InvoiceLine
+func (o *InvoiceLine) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *InvoiceLine) pkEQ() dialect.Expression {
+	return psql.Quote("invoice_lines", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the InvoiceLine
+func (o *InvoiceLine) Update(ctx context.Context, exec bob.Executor, s *InvoiceLineSetter) error {
+	v, err := InvoiceLines.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single InvoiceLine record with an executor
+func (o *InvoiceLine) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := InvoiceLines.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the InvoiceLine using the executor
+func (o *InvoiceLine) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := InvoiceLines.Query(
+		sm.Where(InvoiceLines.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after InvoiceLineSlice is retrieved from the database
+func (o InvoiceLineSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = InvoiceLines.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = InvoiceLines.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = InvoiceLines.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = InvoiceLines.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o InvoiceLineSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("invoice_lines", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o InvoiceLineSlice) copyMatchingRows(from ...*InvoiceLine) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o InvoiceLineSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return InvoiceLines.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *InvoiceLine:
+				o.copyMatchingRows(retrieved)
+			case []*InvoiceLine:
+				o.copyMatchingRows(retrieved...)
+			case InvoiceLineSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a InvoiceLine or a slice of InvoiceLine
+				// then run the AfterUpdateHooks on the slice
+				_, err = InvoiceLines.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o InvoiceLineSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return InvoiceLines.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *InvoiceLine:
+				o.copyMatchingRows(retrieved)
+			case []*InvoiceLine:
+				o.copyMatchingRows(retrieved...)
+			case InvoiceLineSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a InvoiceLine or a slice of InvoiceLine
+				// then run the AfterDeleteHooks on the slice
+				_, err = InvoiceLines.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o InvoiceLineSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals InvoiceLineSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := InvoiceLines.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o InvoiceLineSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := InvoiceLines.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o InvoiceLineSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := InvoiceLines.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// InvoiceAllowanceCharges starts a query for related objects on invoice_allowance_charges
+func (o *InvoiceLine) InvoiceAllowanceCharges(mods ...bob.Mod[*dialect.SelectQuery]) InvoiceAllowanceChargesQuery {
+	return InvoiceAllowanceCharges.Query(append(mods,
+		sm.Where(InvoiceAllowanceCharges.Columns.InvoiceLineID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os InvoiceLineSlice) InvoiceAllowanceCharges(mods ...bob.Mod[*dialect.SelectQuery]) InvoiceAllowanceChargesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return InvoiceAllowanceCharges.Query(append(mods,
+		sm.Where(psql.Group(InvoiceAllowanceCharges.Columns.InvoiceLineID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// InvoiceLineTaxBreakdowns starts a query for related objects on invoice_line_tax_breakdowns
+func (o *InvoiceLine) InvoiceLineTaxBreakdowns(mods ...bob.Mod[*dialect.SelectQuery]) InvoiceLineTaxBreakdownsQuery {
+	return InvoiceLineTaxBreakdowns.Query(append(mods,
+		sm.Where(InvoiceLineTaxBreakdowns.Columns.InvoiceLineID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os InvoiceLineSlice) InvoiceLineTaxBreakdowns(mods ...bob.Mod[*dialect.SelectQuery]) InvoiceLineTaxBreakdownsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return InvoiceLineTaxBreakdowns.Query(append(mods,
+		sm.Where(psql.Group(InvoiceLineTaxBreakdowns.Columns.InvoiceLineID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Invoice starts a query for related objects on invoices
+func (o *InvoiceLine) Invoice(mods ...bob.Mod[*dialect.SelectQuery]) InvoicesQuery {
+	return Invoices.Query(append(mods,
+		sm.Where(Invoices.Columns.ID.EQ(psql.Arg(o.InvoiceID))),
+	)...)
+}
+
+func (os InvoiceLineSlice) Invoice(mods ...bob.Mod[*dialect.SelectQuery]) InvoicesQuery {
+	pkInvoiceID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkInvoiceID = append(pkInvoiceID, o.InvoiceID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkInvoiceID), "bigint[]")),
+	))
+
+	return Invoices.Query(append(mods,
+		sm.Where(psql.Group(Invoices.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertInvoiceLineInvoiceAllowanceCharges0(ctx context.Context, exec bob.Executor, invoiceAllowanceCharges1 []*InvoiceAllowanceChargeSetter, invoiceLine0 *InvoiceLine) (InvoiceAllowanceChargeSlice, error) {
+	for i := range invoiceAllowanceCharges1 {
+		invoiceAllowanceCharges1[i].InvoiceLineID = omitnull.From(invoiceLine0.ID)
+	}
+
+	ret, err := InvoiceAllowanceCharges.Insert(bob.ToMods(invoiceAllowanceCharges1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertInvoiceLineInvoiceAllowanceCharges0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachInvoiceLineInvoiceAllowanceCharges0(ctx context.Context, exec bob.Executor, count int, invoiceAllowanceCharges1 InvoiceAllowanceChargeSlice, invoiceLine0 *InvoiceLine) (InvoiceAllowanceChargeSlice, error) {
+	setter := &InvoiceAllowanceChargeSetter{
+		InvoiceLineID: omitnull.From(invoiceLine0.ID),
+	}
+
+	err := invoiceAllowanceCharges1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvoiceLineInvoiceAllowanceCharges0: %w", err)
+	}
+
+	return invoiceAllowanceCharges1, nil
+}
+
+func (invoiceLine0 *InvoiceLine) InsertInvoiceAllowanceCharges(ctx context.Context, exec bob.Executor, related ...*InvoiceAllowanceChargeSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	invoiceAllowanceCharges1, err := insertInvoiceLineInvoiceAllowanceCharges0(ctx, exec, related, invoiceLine0)
+	if err != nil {
+		return err
+	}
+
+	invoiceLine0.R.InvoiceAllowanceCharges = append(invoiceLine0.R.InvoiceAllowanceCharges, invoiceAllowanceCharges1...)
+
+	for _, rel := range invoiceAllowanceCharges1 {
+		rel.R.InvoiceLine = invoiceLine0
+	}
+	return nil
+}
+
+func (invoiceLine0 *InvoiceLine) AttachInvoiceAllowanceCharges(ctx context.Context, exec bob.Executor, related ...*InvoiceAllowanceCharge) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	invoiceAllowanceCharges1 := InvoiceAllowanceChargeSlice(related)
+
+	_, err = attachInvoiceLineInvoiceAllowanceCharges0(ctx, exec, len(related), invoiceAllowanceCharges1, invoiceLine0)
+	if err != nil {
+		return err
+	}
+
+	invoiceLine0.R.InvoiceAllowanceCharges = append(invoiceLine0.R.InvoiceAllowanceCharges, invoiceAllowanceCharges1...)
+
+	for _, rel := range related {
+		rel.R.InvoiceLine = invoiceLine0
+	}
+
+	return nil
+}
+
+func insertInvoiceLineInvoiceLineTaxBreakdowns0(ctx context.Context, exec bob.Executor, invoiceLineTaxBreakdowns1 []*InvoiceLineTaxBreakdownSetter, invoiceLine0 *InvoiceLine) (InvoiceLineTaxBreakdownSlice, error) {
+	for i := range invoiceLineTaxBreakdowns1 {
+		invoiceLineTaxBreakdowns1[i].InvoiceLineID = omit.From(invoiceLine0.ID)
+	}
+
+	ret, err := InvoiceLineTaxBreakdowns.Insert(bob.ToMods(invoiceLineTaxBreakdowns1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertInvoiceLineInvoiceLineTaxBreakdowns0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachInvoiceLineInvoiceLineTaxBreakdowns0(ctx context.Context, exec bob.Executor, count int, invoiceLineTaxBreakdowns1 InvoiceLineTaxBreakdownSlice, invoiceLine0 *InvoiceLine) (InvoiceLineTaxBreakdownSlice, error) {
+	setter := &InvoiceLineTaxBreakdownSetter{
+		InvoiceLineID: omit.From(invoiceLine0.ID),
+	}
+
+	err := invoiceLineTaxBreakdowns1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvoiceLineInvoiceLineTaxBreakdowns0: %w", err)
+	}
+
+	return invoiceLineTaxBreakdowns1, nil
+}
+
+func (invoiceLine0 *InvoiceLine) InsertInvoiceLineTaxBreakdowns(ctx context.Context, exec bob.Executor, related ...*InvoiceLineTaxBreakdownSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	invoiceLineTaxBreakdowns1, err := insertInvoiceLineInvoiceLineTaxBreakdowns0(ctx, exec, related, invoiceLine0)
+	if err != nil {
+		return err
+	}
+
+	invoiceLine0.R.InvoiceLineTaxBreakdowns = append(invoiceLine0.R.InvoiceLineTaxBreakdowns, invoiceLineTaxBreakdowns1...)
+
+	for _, rel := range invoiceLineTaxBreakdowns1 {
+		rel.R.InvoiceLine = invoiceLine0
+	}
+	return nil
+}
+
+func (invoiceLine0 *InvoiceLine) AttachInvoiceLineTaxBreakdowns(ctx context.Context, exec bob.Executor, related ...*InvoiceLineTaxBreakdown) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	invoiceLineTaxBreakdowns1 := InvoiceLineTaxBreakdownSlice(related)
+
+	_, err = attachInvoiceLineInvoiceLineTaxBreakdowns0(ctx, exec, len(related), invoiceLineTaxBreakdowns1, invoiceLine0)
+	if err != nil {
+		return err
+	}
+
+	invoiceLine0.R.InvoiceLineTaxBreakdowns = append(invoiceLine0.R.InvoiceLineTaxBreakdowns, invoiceLineTaxBreakdowns1...)
+
+	for _, rel := range related {
+		rel.R.InvoiceLine = invoiceLine0
+	}
+
+	return nil
+}
+
+func attachInvoiceLineInvoice0(ctx context.Context, exec bob.Executor, count int, invoiceLine0 *InvoiceLine, invoice1 *Invoice) (*InvoiceLine, error) {
+	setter := &InvoiceLineSetter{
+		InvoiceID: omit.From(invoice1.ID),
+	}
+
+	err := invoiceLine0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvoiceLineInvoice0: %w", err)
+	}
+
+	return invoiceLine0, nil
+}
+
+func (invoiceLine0 *InvoiceLine) InsertInvoice(ctx context.Context, exec bob.Executor, related *InvoiceSetter) error {
+	var err error
+
+	invoice1, err := Invoices.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachInvoiceLineInvoice0(ctx, exec, 1, invoiceLine0, invoice1)
+	if err != nil {
+		return err
+	}
+
+	invoiceLine0.R.Invoice = invoice1
+
+	invoice1.R.InvoiceLines = append(invoice1.R.InvoiceLines, invoiceLine0)
+
+	return nil
+}
+
+func (invoiceLine0 *InvoiceLine) AttachInvoice(ctx context.Context, exec bob.Executor, invoice1 *Invoice) error {
+	var err error
+
+	_, err = attachInvoiceLineInvoice0(ctx, exec, 1, invoiceLine0, invoice1)
+	if err != nil {
+		return err
+	}
+
+	invoiceLine0.R.Invoice = invoice1
+
+	invoice1.R.InvoiceLines = append(invoice1.R.InvoiceLines, invoiceLine0)
+
+	return nil
+}
+
+type invoiceLineWhere[Q psql.Filterable] struct {
+	ID                 psql.WhereMod[Q, int64]
+	InvoiceID          psql.WhereMod[Q, int64]
+	LineNumber         psql.WhereMod[Q, int32]
+	ClassificationCode psql.WhereMod[Q, string]
+	Description        psql.WhereMod[Q, string]
+	Quantity           psql.WhereMod[Q, decimal.Decimal]
+	UnitCode           psql.WhereNullMod[Q, string]
+	UnitPrice          psql.WhereMod[Q, decimal.Decimal]
+	ProductTariffCode  psql.WhereNullMod[Q, string]
+	CountryOfOrigin    psql.WhereNullMod[Q, string]
+	Subtotal           psql.WhereMod[Q, decimal.Decimal]
+	TotalExcludingTax  psql.WhereMod[Q, decimal.Decimal]
+	TaxAmount          psql.WhereMod[Q, decimal.Decimal]
+	CreatedAt          psql.WhereNullMod[Q, time.Time]
+}
+
+func (invoiceLineWhere[Q]) AliasedAs(alias string) invoiceLineWhere[Q] {
+	return buildInvoiceLineWhere[Q](buildInvoiceLineColumns(alias))
+}
+
+func buildInvoiceLineWhere[Q psql.Filterable](cols invoiceLineColumns) invoiceLineWhere[Q] {
+	return invoiceLineWhere[Q]{
+		ID:                 psql.Where[Q, int64](cols.ID),
+		InvoiceID:          psql.Where[Q, int64](cols.InvoiceID),
+		LineNumber:         psql.Where[Q, int32](cols.LineNumber),
+		ClassificationCode: psql.Where[Q, string](cols.ClassificationCode),
+		Description:        psql.Where[Q, string](cols.Description),
+		Quantity:           psql.Where[Q, decimal.Decimal](cols.Quantity),
+		UnitCode:           psql.WhereNull[Q, string](cols.UnitCode),
+		UnitPrice:          psql.Where[Q, decimal.Decimal](cols.UnitPrice),
+		ProductTariffCode:  psql.WhereNull[Q, string](cols.ProductTariffCode),
+		CountryOfOrigin:    psql.WhereNull[Q, string](cols.CountryOfOrigin),
+		Subtotal:           psql.Where[Q, decimal.Decimal](cols.Subtotal),
+		TotalExcludingTax:  psql.Where[Q, decimal.Decimal](cols.TotalExcludingTax),
+		TaxAmount:          psql.Where[Q, decimal.Decimal](cols.TaxAmount),
+		CreatedAt:          psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *InvoiceLine) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "InvoiceAllowanceCharges":
+		rels, ok := retrieved.(InvoiceAllowanceChargeSlice)
+		if !ok {
+			return fmt.Errorf("invoiceLine cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.InvoiceAllowanceCharges = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.InvoiceLine = o
+			}
+		}
+		return nil
+	case "InvoiceLineTaxBreakdowns":
+		rels, ok := retrieved.(InvoiceLineTaxBreakdownSlice)
+		if !ok {
+			return fmt.Errorf("invoiceLine cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.InvoiceLineTaxBreakdowns = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.InvoiceLine = o
+			}
+		}
+		return nil
+	case "Invoice":
+		rel, ok := retrieved.(*Invoice)
+		if !ok {
+			return fmt.Errorf("invoiceLine cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Invoice = rel
+
+		if rel != nil {
+			rel.R.InvoiceLines = InvoiceLineSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("invoiceLine has no relationship %q", name)
+	}
+}
+
+type invoiceLinePreloader struct {
+	Invoice func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildInvoiceLinePreloader() invoiceLinePreloader {
+	return invoiceLinePreloader{
+		Invoice: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Invoice, InvoiceSlice](psql.PreloadRel{
+				Name: "Invoice",
+				Sides: []psql.PreloadSide{
+					{
+						From:        InvoiceLines,
+						To:          Invoices,
+						FromColumns: []string{"invoice_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Invoices.Columns.Names(), opts...)
+		},
+	}
+}
+
+type invoiceLineThenLoader[Q orm.Loadable] struct {
+	InvoiceAllowanceCharges  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	InvoiceLineTaxBreakdowns func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Invoice                  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildInvoiceLineThenLoader[Q orm.Loadable]() invoiceLineThenLoader[Q] {
+	type InvoiceAllowanceChargesLoadInterface interface {
+		LoadInvoiceAllowanceCharges(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type InvoiceLineTaxBreakdownsLoadInterface interface {
+		LoadInvoiceLineTaxBreakdowns(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type InvoiceLoadInterface interface {
+		LoadInvoice(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return invoiceLineThenLoader[Q]{
+		InvoiceAllowanceCharges: thenLoadBuilder[Q](
+			"InvoiceAllowanceCharges",
+			func(ctx context.Context, exec bob.Executor, retrieved InvoiceAllowanceChargesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadInvoiceAllowanceCharges(ctx, exec, mods...)
+			},
+		),
+		InvoiceLineTaxBreakdowns: thenLoadBuilder[Q](
+			"InvoiceLineTaxBreakdowns",
+			func(ctx context.Context, exec bob.Executor, retrieved InvoiceLineTaxBreakdownsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadInvoiceLineTaxBreakdowns(ctx, exec, mods...)
+			},
+		),
+		Invoice: thenLoadBuilder[Q](
+			"Invoice",
+			func(ctx context.Context, exec bob.Executor, retrieved InvoiceLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadInvoice(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadInvoiceAllowanceCharges loads the invoiceLine's InvoiceAllowanceCharges into the .R struct
+func (o *InvoiceLine) LoadInvoiceAllowanceCharges(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.InvoiceAllowanceCharges = nil
+
+	related, err := o.InvoiceAllowanceCharges(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.InvoiceLine = o
+	}
+
+	o.R.InvoiceAllowanceCharges = related
+	return nil
+}
+
+// LoadInvoiceAllowanceCharges loads the invoiceLine's InvoiceAllowanceCharges into the .R struct
+func (os InvoiceLineSlice) LoadInvoiceAllowanceCharges(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invoiceAllowanceCharges, err := os.InvoiceAllowanceCharges(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.InvoiceAllowanceCharges = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invoiceAllowanceCharges {
+
+			if !rel.InvoiceLineID.IsValue() {
+				continue
+			}
+			if !(rel.InvoiceLineID.IsValue() && o.ID == rel.InvoiceLineID.MustGet()) {
+				continue
+			}
+
+			rel.R.InvoiceLine = o
+
+			o.R.InvoiceAllowanceCharges = append(o.R.InvoiceAllowanceCharges, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadInvoiceLineTaxBreakdowns loads the invoiceLine's InvoiceLineTaxBreakdowns into the .R struct
+func (o *InvoiceLine) LoadInvoiceLineTaxBreakdowns(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.InvoiceLineTaxBreakdowns = nil
+
+	related, err := o.InvoiceLineTaxBreakdowns(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.InvoiceLine = o
+	}
+
+	o.R.InvoiceLineTaxBreakdowns = related
+	return nil
+}
+
+// LoadInvoiceLineTaxBreakdowns loads the invoiceLine's InvoiceLineTaxBreakdowns into the .R struct
+func (os InvoiceLineSlice) LoadInvoiceLineTaxBreakdowns(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invoiceLineTaxBreakdowns, err := os.InvoiceLineTaxBreakdowns(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.InvoiceLineTaxBreakdowns = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invoiceLineTaxBreakdowns {
+
+			if !(o.ID == rel.InvoiceLineID) {
+				continue
+			}
+
+			rel.R.InvoiceLine = o
+
+			o.R.InvoiceLineTaxBreakdowns = append(o.R.InvoiceLineTaxBreakdowns, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadInvoice loads the invoiceLine's Invoice into the .R struct
+func (o *InvoiceLine) LoadInvoice(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Invoice = nil
+
+	related, err := o.Invoice(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.InvoiceLines = InvoiceLineSlice{o}
+
+	o.R.Invoice = related
+	return nil
+}
+
+// LoadInvoice loads the invoiceLine's Invoice into the .R struct
+func (os InvoiceLineSlice) LoadInvoice(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invoices, err := os.Invoice(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invoices {
+
+			if !(o.InvoiceID == rel.ID) {
+				continue
+			}
+
+			rel.R.InvoiceLines = append(rel.R.InvoiceLines, o)
+
+			o.R.Invoice = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type invoiceLineJoins[Q dialect.Joinable] struct {
+	typ                      string
+	InvoiceAllowanceCharges  modAs[Q, invoiceAllowanceChargeColumns]
+	InvoiceLineTaxBreakdowns modAs[Q, invoiceLineTaxBreakdownColumns]
+	Invoice                  modAs[Q, invoiceColumns]
+}
+
+func (j invoiceLineJoins[Q]) aliasedAs(alias string) invoiceLineJoins[Q] {
+	return buildInvoiceLineJoins[Q](buildInvoiceLineColumns(alias), j.typ)
+}
+
+func buildInvoiceLineJoins[Q dialect.Joinable](cols invoiceLineColumns, typ string) invoiceLineJoins[Q] {
+	return invoiceLineJoins[Q]{
+		typ: typ,
+		InvoiceAllowanceCharges: modAs[Q, invoiceAllowanceChargeColumns]{
+			c: InvoiceAllowanceCharges.Columns,
+			f: func(to invoiceAllowanceChargeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, InvoiceAllowanceCharges.Name().As(to.Alias())).On(
+						to.InvoiceLineID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		InvoiceLineTaxBreakdowns: modAs[Q, invoiceLineTaxBreakdownColumns]{
+			c: InvoiceLineTaxBreakdowns.Columns,
+			f: func(to invoiceLineTaxBreakdownColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, InvoiceLineTaxBreakdowns.Name().As(to.Alias())).On(
+						to.InvoiceLineID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Invoice: modAs[Q, invoiceColumns]{
+			c: Invoices.Columns,
+			f: func(to invoiceColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Invoices.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.InvoiceID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
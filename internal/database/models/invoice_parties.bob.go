@@ -0,0 +1,1081 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	enums "github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// InvoiceParty is an object representing the database table.
+type InvoiceParty struct {
+	ID                           int64                   `db:"id,pk" `
+	InvoiceID                    int64                   `db:"invoice_id" `
+	Role                         enums.InvoicePartyRoles `db:"role" `
+	Name                         string                  `db:"name" `
+	Tin                          string                  `db:"tin" `
+	IDType                       enums.TaxpayerIDTypes   `db:"id_type" `
+	IDValue                      string                  `db:"id_value" `
+	SSTRegistrationNumber        string                  `db:"sst_registration_number" `
+	TourismTaxRegistrationNumber null.Val[string]        `db:"tourism_tax_registration_number" `
+	MsicCode                     null.Val[string]        `db:"msic_code" `
+	BusinessActivityDescription  null.Val[string]        `db:"business_activity_description" `
+	AddressLine1                 string                  `db:"address_line1" `
+	AddressLine2                 null.Val[string]        `db:"address_line2" `
+	AddressLine3                 null.Val[string]        `db:"address_line3" `
+	PostalZone                   null.Val[string]        `db:"postal_zone" `
+	CityName                     string                  `db:"city_name" `
+	StateCode                    string                  `db:"state_code" `
+	CountryCode                  string                  `db:"country_code" `
+	Phone                        null.Val[string]        `db:"phone" `
+	Email                        null.Val[string]        `db:"email" `
+	CreatedAt                    null.Val[time.Time]     `db:"created_at" `
+
+	R invoicePartyR `db:"-" `
+}
+
+// InvoicePartySlice is an alias for a slice of pointers to InvoiceParty.
+// This should almost always be used instead of []*InvoiceParty.
+type InvoicePartySlice []*InvoiceParty
+
+// InvoiceParties contains methods to work with the invoice_parties table
+var InvoiceParties = psql.NewTablex[*InvoiceParty, InvoicePartySlice, *InvoicePartySetter]("", "invoice_parties", buildInvoicePartyColumns("invoice_parties"))
+
+// InvoicePartiesQuery is a query on the invoice_parties table
+type InvoicePartiesQuery = *psql.ViewQuery[*InvoiceParty, InvoicePartySlice]
+
+// invoicePartyR is where relationships are stored.
+type invoicePartyR struct {
+	Invoice *Invoice // invoice_parties.invoice_parties_invoice_id_fkey
+}
+
+func buildInvoicePartyColumns(alias string) invoicePartyColumns {
+	return invoicePartyColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "invoice_id", "role", "name", "tin", "id_type", "id_value", "sst_registration_number", "tourism_tax_registration_number", "msic_code", "business_activity_description", "address_line1", "address_line2", "address_line3", "postal_zone", "city_name", "state_code", "country_code", "phone", "email", "created_at",
+		).WithParent("invoice_parties"),
+		tableAlias:                   alias,
+		ID:                           psql.Quote(alias, "id"),
+		InvoiceID:                    psql.Quote(alias, "invoice_id"),
+		Role:                         psql.Quote(alias, "role"),
+		Name:                         psql.Quote(alias, "name"),
+		Tin:                          psql.Quote(alias, "tin"),
+		IDType:                       psql.Quote(alias, "id_type"),
+		IDValue:                      psql.Quote(alias, "id_value"),
+		SSTRegistrationNumber:        psql.Quote(alias, "sst_registration_number"),
+		TourismTaxRegistrationNumber: psql.Quote(alias, "tourism_tax_registration_number"),
+		MsicCode:                     psql.Quote(alias, "msic_code"),
+		BusinessActivityDescription:  psql.Quote(alias, "business_activity_description"),
+		AddressLine1:                 psql.Quote(alias, "address_line1"),
+		AddressLine2:                 psql.Quote(alias, "address_line2"),
+		AddressLine3:                 psql.Quote(alias, "address_line3"),
+		PostalZone:                   psql.Quote(alias, "postal_zone"),
+		CityName:                     psql.Quote(alias, "city_name"),
+		StateCode:                    psql.Quote(alias, "state_code"),
+		CountryCode:                  psql.Quote(alias, "country_code"),
+		Phone:                        psql.Quote(alias, "phone"),
+		Email:                        psql.Quote(alias, "email"),
+		CreatedAt:                    psql.Quote(alias, "created_at"),
+	}
+}
+
+type invoicePartyColumns struct {
+	expr.ColumnsExpr
+	tableAlias                   string
+	ID                           psql.Expression
+	InvoiceID                    psql.Expression
+	Role                         psql.Expression
+	Name                         psql.Expression
+	Tin                          psql.Expression
+	IDType                       psql.Expression
+	IDValue                      psql.Expression
+	SSTRegistrationNumber        psql.Expression
+	TourismTaxRegistrationNumber psql.Expression
+	MsicCode                     psql.Expression
+	BusinessActivityDescription  psql.Expression
+	AddressLine1                 psql.Expression
+	AddressLine2                 psql.Expression
+	AddressLine3                 psql.Expression
+	PostalZone                   psql.Expression
+	CityName                     psql.Expression
+	StateCode                    psql.Expression
+	CountryCode                  psql.Expression
+	Phone                        psql.Expression
+	Email                        psql.Expression
+	CreatedAt                    psql.Expression
+}
+
+func (c invoicePartyColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (invoicePartyColumns) AliasedAs(alias string) invoicePartyColumns {
+	return buildInvoicePartyColumns(alias)
+}
+
+// InvoicePartySetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type InvoicePartySetter struct {
+	ID                           omit.Val[int64]                   `db:"id,pk" `
+	InvoiceID                    omit.Val[int64]                   `db:"invoice_id" `
+	Role                         omit.Val[enums.InvoicePartyRoles] `db:"role" `
+	Name                         omit.Val[string]                  `db:"name" `
+	Tin                          omit.Val[string]                  `db:"tin" `
+	IDType                       omit.Val[enums.TaxpayerIDTypes]   `db:"id_type" `
+	IDValue                      omit.Val[string]                  `db:"id_value" `
+	SSTRegistrationNumber        omit.Val[string]                  `db:"sst_registration_number" `
+	TourismTaxRegistrationNumber omitnull.Val[string]              `db:"tourism_tax_registration_number" `
+	MsicCode                     omitnull.Val[string]              `db:"msic_code" `
+	BusinessActivityDescription  omitnull.Val[string]              `db:"business_activity_description" `
+	AddressLine1                 omit.Val[string]                  `db:"address_line1" `
+	AddressLine2                 omitnull.Val[string]              `db:"address_line2" `
+	AddressLine3                 omitnull.Val[string]              `db:"address_line3" `
+	PostalZone                   omitnull.Val[string]              `db:"postal_zone" `
+	CityName                     omit.Val[string]                  `db:"city_name" `
+	StateCode                    omit.Val[string]                  `db:"state_code" `
+	CountryCode                  omit.Val[string]                  `db:"country_code" `
+	Phone                        omitnull.Val[string]              `db:"phone" `
+	Email                        omitnull.Val[string]              `db:"email" `
+	CreatedAt                    omitnull.Val[time.Time]           `db:"created_at" `
+}
+
+func (s InvoicePartySetter) SetColumns() []string {
+	vals := make([]string, 0, 21)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.InvoiceID.IsValue() {
+		vals = append(vals, "invoice_id")
+	}
+	if s.Role.IsValue() {
+		vals = append(vals, "role")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if s.Tin.IsValue() {
+		vals = append(vals, "tin")
+	}
+	if s.IDType.IsValue() {
+		vals = append(vals, "id_type")
+	}
+	if s.IDValue.IsValue() {
+		vals = append(vals, "id_value")
+	}
+	if s.SSTRegistrationNumber.IsValue() {
+		vals = append(vals, "sst_registration_number")
+	}
+	if !s.TourismTaxRegistrationNumber.IsUnset() {
+		vals = append(vals, "tourism_tax_registration_number")
+	}
+	if !s.MsicCode.IsUnset() {
+		vals = append(vals, "msic_code")
+	}
+	if !s.BusinessActivityDescription.IsUnset() {
+		vals = append(vals, "business_activity_description")
+	}
+	if s.AddressLine1.IsValue() {
+		vals = append(vals, "address_line1")
+	}
+	if !s.AddressLine2.IsUnset() {
+		vals = append(vals, "address_line2")
+	}
+	if !s.AddressLine3.IsUnset() {
+		vals = append(vals, "address_line3")
+	}
+	if !s.PostalZone.IsUnset() {
+		vals = append(vals, "postal_zone")
+	}
+	if s.CityName.IsValue() {
+		vals = append(vals, "city_name")
+	}
+	if s.StateCode.IsValue() {
+		vals = append(vals, "state_code")
+	}
+	if s.CountryCode.IsValue() {
+		vals = append(vals, "country_code")
+	}
+	if !s.Phone.IsUnset() {
+		vals = append(vals, "phone")
+	}
+	if !s.Email.IsUnset() {
+		vals = append(vals, "email")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s InvoicePartySetter) Overwrite(t *InvoiceParty) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.InvoiceID.IsValue() {
+		t.InvoiceID = s.InvoiceID.MustGet()
+	}
+	if s.Role.IsValue() {
+		t.Role = s.Role.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if s.Tin.IsValue() {
+		t.Tin = s.Tin.MustGet()
+	}
+	if s.IDType.IsValue() {
+		t.IDType = s.IDType.MustGet()
+	}
+	if s.IDValue.IsValue() {
+		t.IDValue = s.IDValue.MustGet()
+	}
+	if s.SSTRegistrationNumber.IsValue() {
+		t.SSTRegistrationNumber = s.SSTRegistrationNumber.MustGet()
+	}
+	if !s.TourismTaxRegistrationNumber.IsUnset() {
+		t.TourismTaxRegistrationNumber = s.TourismTaxRegistrationNumber.MustGetNull()
+	}
+	if !s.MsicCode.IsUnset() {
+		t.MsicCode = s.MsicCode.MustGetNull()
+	}
+	if !s.BusinessActivityDescription.IsUnset() {
+		t.BusinessActivityDescription = s.BusinessActivityDescription.MustGetNull()
+	}
+	if s.AddressLine1.IsValue() {
+		t.AddressLine1 = s.AddressLine1.MustGet()
+	}
+	if !s.AddressLine2.IsUnset() {
+		t.AddressLine2 = s.AddressLine2.MustGetNull()
+	}
+	if !s.AddressLine3.IsUnset() {
+		t.AddressLine3 = s.AddressLine3.MustGetNull()
+	}
+	if !s.PostalZone.IsUnset() {
+		t.PostalZone = s.PostalZone.MustGetNull()
+	}
+	if s.CityName.IsValue() {
+		t.CityName = s.CityName.MustGet()
+	}
+	if s.StateCode.IsValue() {
+		t.StateCode = s.StateCode.MustGet()
+	}
+	if s.CountryCode.IsValue() {
+		t.CountryCode = s.CountryCode.MustGet()
+	}
+	if !s.Phone.IsUnset() {
+		t.Phone = s.Phone.MustGetNull()
+	}
+	if !s.Email.IsUnset() {
+		t.Email = s.Email.MustGetNull()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *InvoicePartySetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return InvoiceParties.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 21)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.InvoiceID.IsValue() {
+			vals[1] = psql.Arg(s.InvoiceID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Role.IsValue() {
+			vals[2] = psql.Arg(s.Role.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.Name.IsValue() {
+			vals[3] = psql.Arg(s.Name.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.Tin.IsValue() {
+			vals[4] = psql.Arg(s.Tin.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.IDType.IsValue() {
+			vals[5] = psql.Arg(s.IDType.MustGet())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.IDValue.IsValue() {
+			vals[6] = psql.Arg(s.IDValue.MustGet())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if s.SSTRegistrationNumber.IsValue() {
+			vals[7] = psql.Arg(s.SSTRegistrationNumber.MustGet())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if !s.TourismTaxRegistrationNumber.IsUnset() {
+			vals[8] = psql.Arg(s.TourismTaxRegistrationNumber.MustGetNull())
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		if !s.MsicCode.IsUnset() {
+			vals[9] = psql.Arg(s.MsicCode.MustGetNull())
+		} else {
+			vals[9] = psql.Raw("DEFAULT")
+		}
+
+		if !s.BusinessActivityDescription.IsUnset() {
+			vals[10] = psql.Arg(s.BusinessActivityDescription.MustGetNull())
+		} else {
+			vals[10] = psql.Raw("DEFAULT")
+		}
+
+		if s.AddressLine1.IsValue() {
+			vals[11] = psql.Arg(s.AddressLine1.MustGet())
+		} else {
+			vals[11] = psql.Raw("DEFAULT")
+		}
+
+		if !s.AddressLine2.IsUnset() {
+			vals[12] = psql.Arg(s.AddressLine2.MustGetNull())
+		} else {
+			vals[12] = psql.Raw("DEFAULT")
+		}
+
+		if !s.AddressLine3.IsUnset() {
+			vals[13] = psql.Arg(s.AddressLine3.MustGetNull())
+		} else {
+			vals[13] = psql.Raw("DEFAULT")
+		}
+
+		if !s.PostalZone.IsUnset() {
+			vals[14] = psql.Arg(s.PostalZone.MustGetNull())
+		} else {
+			vals[14] = psql.Raw("DEFAULT")
+		}
+
+		if s.CityName.IsValue() {
+			vals[15] = psql.Arg(s.CityName.MustGet())
+		} else {
+			vals[15] = psql.Raw("DEFAULT")
+		}
+
+		if s.StateCode.IsValue() {
+			vals[16] = psql.Arg(s.StateCode.MustGet())
+		} else {
+			vals[16] = psql.Raw("DEFAULT")
+		}
+
+		if s.CountryCode.IsValue() {
+			vals[17] = psql.Arg(s.CountryCode.MustGet())
+		} else {
+			vals[17] = psql.Raw("DEFAULT")
+		}
+
+		if !s.Phone.IsUnset() {
+			vals[18] = psql.Arg(s.Phone.MustGetNull())
+		} else {
+			vals[18] = psql.Raw("DEFAULT")
+		}
+
+		if !s.Email.IsUnset() {
+			vals[19] = psql.Arg(s.Email.MustGetNull())
+		} else {
+			vals[19] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[20] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[20] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s InvoicePartySetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s InvoicePartySetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 21)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.InvoiceID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "invoice_id")...),
+			psql.Arg(s.InvoiceID),
+		}})
+	}
+
+	if s.Role.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "role")...),
+			psql.Arg(s.Role),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "name")...),
+			psql.Arg(s.Name),
+		}})
+	}
+
+	if s.Tin.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "tin")...),
+			psql.Arg(s.Tin),
+		}})
+	}
+
+	if s.IDType.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id_type")...),
+			psql.Arg(s.IDType),
+		}})
+	}
+
+	if s.IDValue.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id_value")...),
+			psql.Arg(s.IDValue),
+		}})
+	}
+
+	if s.SSTRegistrationNumber.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "sst_registration_number")...),
+			psql.Arg(s.SSTRegistrationNumber),
+		}})
+	}
+
+	if !s.TourismTaxRegistrationNumber.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "tourism_tax_registration_number")...),
+			psql.Arg(s.TourismTaxRegistrationNumber),
+		}})
+	}
+
+	if !s.MsicCode.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "msic_code")...),
+			psql.Arg(s.MsicCode),
+		}})
+	}
+
+	if !s.BusinessActivityDescription.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "business_activity_description")...),
+			psql.Arg(s.BusinessActivityDescription),
+		}})
+	}
+
+	if s.AddressLine1.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "address_line1")...),
+			psql.Arg(s.AddressLine1),
+		}})
+	}
+
+	if !s.AddressLine2.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "address_line2")...),
+			psql.Arg(s.AddressLine2),
+		}})
+	}
+
+	if !s.AddressLine3.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "address_line3")...),
+			psql.Arg(s.AddressLine3),
+		}})
+	}
+
+	if !s.PostalZone.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "postal_zone")...),
+			psql.Arg(s.PostalZone),
+		}})
+	}
+
+	if s.CityName.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "city_name")...),
+			psql.Arg(s.CityName),
+		}})
+	}
+
+	if s.StateCode.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "state_code")...),
+			psql.Arg(s.StateCode),
+		}})
+	}
+
+	if s.CountryCode.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "country_code")...),
+			psql.Arg(s.CountryCode),
+		}})
+	}
+
+	if !s.Phone.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "phone")...),
+			psql.Arg(s.Phone),
+		}})
+	}
+
+	if !s.Email.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "email")...),
+			psql.Arg(s.Email),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindInvoiceParty retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindInvoiceParty(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*InvoiceParty, error) {
+	if len(cols) == 0 {
+		return InvoiceParties.Query(
+			sm.Where(InvoiceParties.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return InvoiceParties.Query(
+		sm.Where(InvoiceParties.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(InvoiceParties.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// InvoicePartyExists checks the presence of a single record by primary key
+func InvoicePartyExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return InvoiceParties.Query(
+		sm.Where(InvoiceParties.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after InvoiceParty is retrieved from the database
+func (o *InvoiceParty) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = InvoiceParties.AfterSelectHooks.RunHooks(ctx, exec, InvoicePartySlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = InvoiceParties.AfterInsertHooks.RunHooks(ctx, exec, InvoicePartySlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = InvoiceParties.AfterUpdateHooks.RunHooks(ctx, exec, InvoicePartySlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = InvoiceParties.AfterDeleteHooks.RunHooks(ctx, exec, InvoicePartySlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the InvoiceParty
+func (o *InvoiceParty) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *InvoiceParty) pkEQ() dialect.Expression {
+	return psql.Quote("invoice_parties", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the InvoiceParty
+func (o *InvoiceParty) Update(ctx context.Context, exec bob.Executor, s *InvoicePartySetter) error {
+	v, err := InvoiceParties.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single InvoiceParty record with an executor
+func (o *InvoiceParty) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := InvoiceParties.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the InvoiceParty using the executor
+func (o *InvoiceParty) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := InvoiceParties.Query(
+		sm.Where(InvoiceParties.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after InvoicePartySlice is retrieved from the database
+func (o InvoicePartySlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = InvoiceParties.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = InvoiceParties.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = InvoiceParties.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = InvoiceParties.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o InvoicePartySlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("invoice_parties", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o InvoicePartySlice) copyMatchingRows(from ...*InvoiceParty) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o InvoicePartySlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return InvoiceParties.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *InvoiceParty:
+				o.copyMatchingRows(retrieved)
+			case []*InvoiceParty:
+				o.copyMatchingRows(retrieved...)
+			case InvoicePartySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a InvoiceParty or a slice of InvoiceParty
+				// then run the AfterUpdateHooks on the slice
+				_, err = InvoiceParties.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o InvoicePartySlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return InvoiceParties.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *InvoiceParty:
+				o.copyMatchingRows(retrieved)
+			case []*InvoiceParty:
+				o.copyMatchingRows(retrieved...)
+			case InvoicePartySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a InvoiceParty or a slice of InvoiceParty
+				// then run the AfterDeleteHooks on the slice
+				_, err = InvoiceParties.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o InvoicePartySlice) UpdateAll(ctx context.Context, exec bob.Executor, vals InvoicePartySetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := InvoiceParties.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o InvoicePartySlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := InvoiceParties.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o InvoicePartySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := InvoiceParties.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Invoice starts a query for related objects on invoices
+func (o *InvoiceParty) Invoice(mods ...bob.Mod[*dialect.SelectQuery]) InvoicesQuery {
+	return Invoices.Query(append(mods,
+		sm.Where(Invoices.Columns.ID.EQ(psql.Arg(o.InvoiceID))),
+	)...)
+}
+
+func (os InvoicePartySlice) Invoice(mods ...bob.Mod[*dialect.SelectQuery]) InvoicesQuery {
+	pkInvoiceID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkInvoiceID = append(pkInvoiceID, o.InvoiceID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkInvoiceID), "bigint[]")),
+	))
+
+	return Invoices.Query(append(mods,
+		sm.Where(psql.Group(Invoices.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachInvoicePartyInvoice0(ctx context.Context, exec bob.Executor, count int, invoiceParty0 *InvoiceParty, invoice1 *Invoice) (*InvoiceParty, error) {
+	setter := &InvoicePartySetter{
+		InvoiceID: omit.From(invoice1.ID),
+	}
+
+	err := invoiceParty0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvoicePartyInvoice0: %w", err)
+	}
+
+	return invoiceParty0, nil
+}
+
+func (invoiceParty0 *InvoiceParty) InsertInvoice(ctx context.Context, exec bob.Executor, related *InvoiceSetter) error {
+	var err error
+
+	invoice1, err := Invoices.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachInvoicePartyInvoice0(ctx, exec, 1, invoiceParty0, invoice1)
+	if err != nil {
+		return err
+	}
+
+	invoiceParty0.R.Invoice = invoice1
+
+	invoice1.R.InvoiceParties = append(invoice1.R.InvoiceParties, invoiceParty0)
+
+	return nil
+}
+
+func (invoiceParty0 *InvoiceParty) AttachInvoice(ctx context.Context, exec bob.Executor, invoice1 *Invoice) error {
+	var err error
+
+	_, err = attachInvoicePartyInvoice0(ctx, exec, 1, invoiceParty0, invoice1)
+	if err != nil {
+		return err
+	}
+
+	invoiceParty0.R.Invoice = invoice1
+
+	invoice1.R.InvoiceParties = append(invoice1.R.InvoiceParties, invoiceParty0)
+
+	return nil
+}
+
+type invoicePartyWhere[Q psql.Filterable] struct {
+	ID                           psql.WhereMod[Q, int64]
+	InvoiceID                    psql.WhereMod[Q, int64]
+	Role                         psql.WhereMod[Q, enums.InvoicePartyRoles]
+	Name                         psql.WhereMod[Q, string]
+	Tin                          psql.WhereMod[Q, string]
+	IDType                       psql.WhereMod[Q, enums.TaxpayerIDTypes]
+	IDValue                      psql.WhereMod[Q, string]
+	SSTRegistrationNumber        psql.WhereMod[Q, string]
+	TourismTaxRegistrationNumber psql.WhereNullMod[Q, string]
+	MsicCode                     psql.WhereNullMod[Q, string]
+	BusinessActivityDescription  psql.WhereNullMod[Q, string]
+	AddressLine1                 psql.WhereMod[Q, string]
+	AddressLine2                 psql.WhereNullMod[Q, string]
+	AddressLine3                 psql.WhereNullMod[Q, string]
+	PostalZone                   psql.WhereNullMod[Q, string]
+	CityName                     psql.WhereMod[Q, string]
+	StateCode                    psql.WhereMod[Q, string]
+	CountryCode                  psql.WhereMod[Q, string]
+	Phone                        psql.WhereNullMod[Q, string]
+	Email                        psql.WhereNullMod[Q, string]
+	CreatedAt                    psql.WhereNullMod[Q, time.Time]
+}
+
+func (invoicePartyWhere[Q]) AliasedAs(alias string) invoicePartyWhere[Q] {
+	return buildInvoicePartyWhere[Q](buildInvoicePartyColumns(alias))
+}
+
+func buildInvoicePartyWhere[Q psql.Filterable](cols invoicePartyColumns) invoicePartyWhere[Q] {
+	return invoicePartyWhere[Q]{
+		ID:                           psql.Where[Q, int64](cols.ID),
+		InvoiceID:                    psql.Where[Q, int64](cols.InvoiceID),
+		Role:                         psql.Where[Q, enums.InvoicePartyRoles](cols.Role),
+		Name:                         psql.Where[Q, string](cols.Name),
+		Tin:                          psql.Where[Q, string](cols.Tin),
+		IDType:                       psql.Where[Q, enums.TaxpayerIDTypes](cols.IDType),
+		IDValue:                      psql.Where[Q, string](cols.IDValue),
+		SSTRegistrationNumber:        psql.Where[Q, string](cols.SSTRegistrationNumber),
+		TourismTaxRegistrationNumber: psql.WhereNull[Q, string](cols.TourismTaxRegistrationNumber),
+		MsicCode:                     psql.WhereNull[Q, string](cols.MsicCode),
+		BusinessActivityDescription:  psql.WhereNull[Q, string](cols.BusinessActivityDescription),
+		AddressLine1:                 psql.Where[Q, string](cols.AddressLine1),
+		AddressLine2:                 psql.WhereNull[Q, string](cols.AddressLine2),
+		AddressLine3:                 psql.WhereNull[Q, string](cols.AddressLine3),
+		PostalZone:                   psql.WhereNull[Q, string](cols.PostalZone),
+		CityName:                     psql.Where[Q, string](cols.CityName),
+		StateCode:                    psql.Where[Q, string](cols.StateCode),
+		CountryCode:                  psql.Where[Q, string](cols.CountryCode),
+		Phone:                        psql.WhereNull[Q, string](cols.Phone),
+		Email:                        psql.WhereNull[Q, string](cols.Email),
+		CreatedAt:                    psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *InvoiceParty) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Invoice":
+		rel, ok := retrieved.(*Invoice)
+		if !ok {
+			return fmt.Errorf("invoiceParty cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Invoice = rel
+
+		if rel != nil {
+			rel.R.InvoiceParties = InvoicePartySlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("invoiceParty has no relationship %q", name)
+	}
+}
+
+type invoicePartyPreloader struct {
+	Invoice func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildInvoicePartyPreloader() invoicePartyPreloader {
+	return invoicePartyPreloader{
+		Invoice: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Invoice, InvoiceSlice](psql.PreloadRel{
+				Name: "Invoice",
+				Sides: []psql.PreloadSide{
+					{
+						From:        InvoiceParties,
+						To:          Invoices,
+						FromColumns: []string{"invoice_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Invoices.Columns.Names(), opts...)
+		},
+	}
+}
+
+type invoicePartyThenLoader[Q orm.Loadable] struct {
+	Invoice func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildInvoicePartyThenLoader[Q orm.Loadable]() invoicePartyThenLoader[Q] {
+	type InvoiceLoadInterface interface {
+		LoadInvoice(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return invoicePartyThenLoader[Q]{
+		Invoice: thenLoadBuilder[Q](
+			"Invoice",
+			func(ctx context.Context, exec bob.Executor, retrieved InvoiceLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadInvoice(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadInvoice loads the invoiceParty's Invoice into the .R struct
+func (o *InvoiceParty) LoadInvoice(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Invoice = nil
+
+	related, err := o.Invoice(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.InvoiceParties = InvoicePartySlice{o}
+
+	o.R.Invoice = related
+	return nil
+}
+
+// LoadInvoice loads the invoiceParty's Invoice into the .R struct
+func (os InvoicePartySlice) LoadInvoice(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invoices, err := os.Invoice(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invoices {
+
+			if !(o.InvoiceID == rel.ID) {
+				continue
+			}
+
+			rel.R.InvoiceParties = append(rel.R.InvoiceParties, o)
+
+			o.R.Invoice = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type invoicePartyJoins[Q dialect.Joinable] struct {
+	typ     string
+	Invoice modAs[Q, invoiceColumns]
+}
+
+func (j invoicePartyJoins[Q]) aliasedAs(alias string) invoicePartyJoins[Q] {
+	return buildInvoicePartyJoins[Q](buildInvoicePartyColumns(alias), j.typ)
+}
+
+func buildInvoicePartyJoins[Q dialect.Joinable](cols invoicePartyColumns, typ string) invoicePartyJoins[Q] {
+	return invoicePartyJoins[Q]{
+		typ: typ,
+		Invoice: modAs[Q, invoiceColumns]{
+			c: Invoices.Columns,
+			f: func(to invoiceColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Invoices.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.InvoiceID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
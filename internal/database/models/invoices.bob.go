@@ -0,0 +1,2415 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	enums "github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/shopspring/decimal"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Invoice is an object representing the database table.
+type Invoice struct {
+	ID                    int64                     `db:"id,pk" `
+	OrganisationID        int64                     `db:"organisation_id" `
+	InvoiceNumber         string                    `db:"invoice_number" `
+	TypeCode              string                    `db:"type_code" `
+	Status                enums.InvoiceStatuses     `db:"status" `
+	IssueDate             time.Time                 `db:"issue_date" `
+	CurrencyCode          string                    `db:"currency_code" `
+	ExchangeRate          null.Val[decimal.Decimal] `db:"exchange_rate" `
+	BillingPeriodStart    null.Val[time.Time]       `db:"billing_period_start" `
+	BillingPeriodEnd      null.Val[time.Time]       `db:"billing_period_end" `
+	BillingFrequency      null.Val[string]          `db:"billing_frequency" `
+	PaymentMode           null.Val[string]          `db:"payment_mode" `
+	PaymentTerms          null.Val[string]          `db:"payment_terms" `
+	SupplierBankAccount   null.Val[string]          `db:"supplier_bank_account" `
+	TotalLineAmount       decimal.Decimal           `db:"total_line_amount" `
+	TotalAllowanceAmount  decimal.Decimal           `db:"total_allowance_amount" `
+	TotalChargeAmount     decimal.Decimal           `db:"total_charge_amount" `
+	TotalExcludingTax     decimal.Decimal           `db:"total_excluding_tax" `
+	TotalTaxAmount        decimal.Decimal           `db:"total_tax_amount" `
+	TotalIncludingTax     decimal.Decimal           `db:"total_including_tax" `
+	RoundingAmount        decimal.Decimal           `db:"rounding_amount" `
+	PayableAmount         decimal.Decimal           `db:"payable_amount" `
+	CreatedBy             null.Val[int64]           `db:"created_by" `
+	CreatedAt             null.Val[time.Time]       `db:"created_at" `
+	UpdatedAt             null.Val[time.Time]       `db:"updated_at" `
+	UUID                  null.Val[string]          `db:"uuid" `
+	OriginalInvoiceID     null.Val[int64]           `db:"original_invoice_id" `
+	OriginalUUID          null.Val[string]          `db:"original_uuid" `
+	OriginalInvoiceNumber null.Val[string]          `db:"original_invoice_number" `
+
+	R invoiceR `db:"-" `
+}
+
+// InvoiceSlice is an alias for a slice of pointers to Invoice.
+// This should almost always be used instead of []*Invoice.
+type InvoiceSlice []*Invoice
+
+// Invoices contains methods to work with the invoices table
+var Invoices = psql.NewTablex[*Invoice, InvoiceSlice, *InvoiceSetter]("", "invoices", buildInvoiceColumns("invoices"))
+
+// InvoicesQuery is a query on the invoices table
+type InvoicesQuery = *psql.ViewQuery[*Invoice, InvoiceSlice]
+
+// invoiceR is where relationships are stored.
+type invoiceR struct {
+	InvoiceAllowanceCharges InvoiceAllowanceChargeSlice // invoice_allowance_charges.invoice_allowance_charges_invoice_id_fkey
+	InvoiceLines            InvoiceLineSlice            // invoice_lines.invoice_lines_invoice_id_fkey
+	InvoiceParties          InvoicePartySlice           // invoice_parties.invoice_parties_invoice_id_fkey
+	CreatedByUser           *User                       // invoices.invoices_created_by_fkey
+	Organisation            *Organisation               // invoices.invoices_organisation_id_fkey
+	OriginalInvoice         *Invoice                    // invoices.invoices_original_invoice_id_fkey
+	ReverseOriginalInvoices InvoiceSlice                // invoices.invoices_original_invoice_id_fkey__self_join_reverse
+}
+
+func buildInvoiceColumns(alias string) invoiceColumns {
+	return invoiceColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "organisation_id", "invoice_number", "type_code", "status", "issue_date", "currency_code", "exchange_rate", "billing_period_start", "billing_period_end", "billing_frequency", "payment_mode", "payment_terms", "supplier_bank_account", "total_line_amount", "total_allowance_amount", "total_charge_amount", "total_excluding_tax", "total_tax_amount", "total_including_tax", "rounding_amount", "payable_amount", "created_by", "created_at", "updated_at", "uuid", "original_invoice_id", "original_uuid", "original_invoice_number",
+		).WithParent("invoices"),
+		tableAlias:            alias,
+		ID:                    psql.Quote(alias, "id"),
+		OrganisationID:        psql.Quote(alias, "organisation_id"),
+		InvoiceNumber:         psql.Quote(alias, "invoice_number"),
+		TypeCode:              psql.Quote(alias, "type_code"),
+		Status:                psql.Quote(alias, "status"),
+		IssueDate:             psql.Quote(alias, "issue_date"),
+		CurrencyCode:          psql.Quote(alias, "currency_code"),
+		ExchangeRate:          psql.Quote(alias, "exchange_rate"),
+		BillingPeriodStart:    psql.Quote(alias, "billing_period_start"),
+		BillingPeriodEnd:      psql.Quote(alias, "billing_period_end"),
+		BillingFrequency:      psql.Quote(alias, "billing_frequency"),
+		PaymentMode:           psql.Quote(alias, "payment_mode"),
+		PaymentTerms:          psql.Quote(alias, "payment_terms"),
+		SupplierBankAccount:   psql.Quote(alias, "supplier_bank_account"),
+		TotalLineAmount:       psql.Quote(alias, "total_line_amount"),
+		TotalAllowanceAmount:  psql.Quote(alias, "total_allowance_amount"),
+		TotalChargeAmount:     psql.Quote(alias, "total_charge_amount"),
+		TotalExcludingTax:     psql.Quote(alias, "total_excluding_tax"),
+		TotalTaxAmount:        psql.Quote(alias, "total_tax_amount"),
+		TotalIncludingTax:     psql.Quote(alias, "total_including_tax"),
+		RoundingAmount:        psql.Quote(alias, "rounding_amount"),
+		PayableAmount:         psql.Quote(alias, "payable_amount"),
+		CreatedBy:             psql.Quote(alias, "created_by"),
+		CreatedAt:             psql.Quote(alias, "created_at"),
+		UpdatedAt:             psql.Quote(alias, "updated_at"),
+		UUID:                  psql.Quote(alias, "uuid"),
+		OriginalInvoiceID:     psql.Quote(alias, "original_invoice_id"),
+		OriginalUUID:          psql.Quote(alias, "original_uuid"),
+		OriginalInvoiceNumber: psql.Quote(alias, "original_invoice_number"),
+	}
+}
+
+type invoiceColumns struct {
+	expr.ColumnsExpr
+	tableAlias            string
+	ID                    psql.Expression
+	OrganisationID        psql.Expression
+	InvoiceNumber         psql.Expression
+	TypeCode              psql.Expression
+	Status                psql.Expression
+	IssueDate             psql.Expression
+	CurrencyCode          psql.Expression
+	ExchangeRate          psql.Expression
+	BillingPeriodStart    psql.Expression
+	BillingPeriodEnd      psql.Expression
+	BillingFrequency      psql.Expression
+	PaymentMode           psql.Expression
+	PaymentTerms          psql.Expression
+	SupplierBankAccount   psql.Expression
+	TotalLineAmount       psql.Expression
+	TotalAllowanceAmount  psql.Expression
+	TotalChargeAmount     psql.Expression
+	TotalExcludingTax     psql.Expression
+	TotalTaxAmount        psql.Expression
+	TotalIncludingTax     psql.Expression
+	RoundingAmount        psql.Expression
+	PayableAmount         psql.Expression
+	CreatedBy             psql.Expression
+	CreatedAt             psql.Expression
+	UpdatedAt             psql.Expression
+	UUID                  psql.Expression
+	OriginalInvoiceID     psql.Expression
+	OriginalUUID          psql.Expression
+	OriginalInvoiceNumber psql.Expression
+}
+
+func (c invoiceColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (invoiceColumns) AliasedAs(alias string) invoiceColumns {
+	return buildInvoiceColumns(alias)
+}
+
+// InvoiceSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type InvoiceSetter struct {
+	ID                    omit.Val[int64]                 `db:"id,pk" `
+	OrganisationID        omit.Val[int64]                 `db:"organisation_id" `
+	InvoiceNumber         omit.Val[string]                `db:"invoice_number" `
+	TypeCode              omit.Val[string]                `db:"type_code" `
+	Status                omit.Val[enums.InvoiceStatuses] `db:"status" `
+	IssueDate             omit.Val[time.Time]             `db:"issue_date" `
+	CurrencyCode          omit.Val[string]                `db:"currency_code" `
+	ExchangeRate          omitnull.Val[decimal.Decimal]   `db:"exchange_rate" `
+	BillingPeriodStart    omitnull.Val[time.Time]         `db:"billing_period_start" `
+	BillingPeriodEnd      omitnull.Val[time.Time]         `db:"billing_period_end" `
+	BillingFrequency      omitnull.Val[string]            `db:"billing_frequency" `
+	PaymentMode           omitnull.Val[string]            `db:"payment_mode" `
+	PaymentTerms          omitnull.Val[string]            `db:"payment_terms" `
+	SupplierBankAccount   omitnull.Val[string]            `db:"supplier_bank_account" `
+	TotalLineAmount       omit.Val[decimal.Decimal]       `db:"total_line_amount" `
+	TotalAllowanceAmount  omit.Val[decimal.Decimal]       `db:"total_allowance_amount" `
+	TotalChargeAmount     omit.Val[decimal.Decimal]       `db:"total_charge_amount" `
+	TotalExcludingTax     omit.Val[decimal.Decimal]       `db:"total_excluding_tax" `
+	TotalTaxAmount        omit.Val[decimal.Decimal]       `db:"total_tax_amount" `
+	TotalIncludingTax     omit.Val[decimal.Decimal]       `db:"total_including_tax" `
+	RoundingAmount        omit.Val[decimal.Decimal]       `db:"rounding_amount" `
+	PayableAmount         omit.Val[decimal.Decimal]       `db:"payable_amount" `
+	CreatedBy             omitnull.Val[int64]             `db:"created_by" `
+	CreatedAt             omitnull.Val[time.Time]         `db:"created_at" `
+	UpdatedAt             omitnull.Val[time.Time]         `db:"updated_at" `
+	UUID                  omitnull.Val[string]            `db:"uuid" `
+	OriginalInvoiceID     omitnull.Val[int64]             `db:"original_invoice_id" `
+	OriginalUUID          omitnull.Val[string]            `db:"original_uuid" `
+	OriginalInvoiceNumber omitnull.Val[string]            `db:"original_invoice_number" `
+}
+
+func (s InvoiceSetter) SetColumns() []string {
+	vals := make([]string, 0, 29)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.OrganisationID.IsValue() {
+		vals = append(vals, "organisation_id")
+	}
+	if s.InvoiceNumber.IsValue() {
+		vals = append(vals, "invoice_number")
+	}
+	if s.TypeCode.IsValue() {
+		vals = append(vals, "type_code")
+	}
+	if s.Status.IsValue() {
+		vals = append(vals, "status")
+	}
+	if s.IssueDate.IsValue() {
+		vals = append(vals, "issue_date")
+	}
+	if s.CurrencyCode.IsValue() {
+		vals = append(vals, "currency_code")
+	}
+	if !s.ExchangeRate.IsUnset() {
+		vals = append(vals, "exchange_rate")
+	}
+	if !s.BillingPeriodStart.IsUnset() {
+		vals = append(vals, "billing_period_start")
+	}
+	if !s.BillingPeriodEnd.IsUnset() {
+		vals = append(vals, "billing_period_end")
+	}
+	if !s.BillingFrequency.IsUnset() {
+		vals = append(vals, "billing_frequency")
+	}
+	if !s.PaymentMode.IsUnset() {
+		vals = append(vals, "payment_mode")
+	}
+	if !s.PaymentTerms.IsUnset() {
+		vals = append(vals, "payment_terms")
+	}
+	if !s.SupplierBankAccount.IsUnset() {
+		vals = append(vals, "supplier_bank_account")
+	}
+	if s.TotalLineAmount.IsValue() {
+		vals = append(vals, "total_line_amount")
+	}
+	if s.TotalAllowanceAmount.IsValue() {
+		vals = append(vals, "total_allowance_amount")
+	}
+	if s.TotalChargeAmount.IsValue() {
+		vals = append(vals, "total_charge_amount")
+	}
+	if s.TotalExcludingTax.IsValue() {
+		vals = append(vals, "total_excluding_tax")
+	}
+	if s.TotalTaxAmount.IsValue() {
+		vals = append(vals, "total_tax_amount")
+	}
+	if s.TotalIncludingTax.IsValue() {
+		vals = append(vals, "total_including_tax")
+	}
+	if s.RoundingAmount.IsValue() {
+		vals = append(vals, "rounding_amount")
+	}
+	if s.PayableAmount.IsValue() {
+		vals = append(vals, "payable_amount")
+	}
+	if !s.CreatedBy.IsUnset() {
+		vals = append(vals, "created_by")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	if !s.UpdatedAt.IsUnset() {
+		vals = append(vals, "updated_at")
+	}
+	if !s.UUID.IsUnset() {
+		vals = append(vals, "uuid")
+	}
+	if !s.OriginalInvoiceID.IsUnset() {
+		vals = append(vals, "original_invoice_id")
+	}
+	if !s.OriginalUUID.IsUnset() {
+		vals = append(vals, "original_uuid")
+	}
+	if !s.OriginalInvoiceNumber.IsUnset() {
+		vals = append(vals, "original_invoice_number")
+	}
+	return vals
+}
+
+func (s InvoiceSetter) Overwrite(t *Invoice) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.OrganisationID.IsValue() {
+		t.OrganisationID = s.OrganisationID.MustGet()
+	}
+	if s.InvoiceNumber.IsValue() {
+		t.InvoiceNumber = s.InvoiceNumber.MustGet()
+	}
+	if s.TypeCode.IsValue() {
+		t.TypeCode = s.TypeCode.MustGet()
+	}
+	if s.Status.IsValue() {
+		t.Status = s.Status.MustGet()
+	}
+	if s.IssueDate.IsValue() {
+		t.IssueDate = s.IssueDate.MustGet()
+	}
+	if s.CurrencyCode.IsValue() {
+		t.CurrencyCode = s.CurrencyCode.MustGet()
+	}
+	if !s.ExchangeRate.IsUnset() {
+		t.ExchangeRate = s.ExchangeRate.MustGetNull()
+	}
+	if !s.BillingPeriodStart.IsUnset() {
+		t.BillingPeriodStart = s.BillingPeriodStart.MustGetNull()
+	}
+	if !s.BillingPeriodEnd.IsUnset() {
+		t.BillingPeriodEnd = s.BillingPeriodEnd.MustGetNull()
+	}
+	if !s.BillingFrequency.IsUnset() {
+		t.BillingFrequency = s.BillingFrequency.MustGetNull()
+	}
+	if !s.PaymentMode.IsUnset() {
+		t.PaymentMode = s.PaymentMode.MustGetNull()
+	}
+	if !s.PaymentTerms.IsUnset() {
+		t.PaymentTerms = s.PaymentTerms.MustGetNull()
+	}
+	if !s.SupplierBankAccount.IsUnset() {
+		t.SupplierBankAccount = s.SupplierBankAccount.MustGetNull()
+	}
+	if s.TotalLineAmount.IsValue() {
+		t.TotalLineAmount = s.TotalLineAmount.MustGet()
+	}
+	if s.TotalAllowanceAmount.IsValue() {
+		t.TotalAllowanceAmount = s.TotalAllowanceAmount.MustGet()
+	}
+	if s.TotalChargeAmount.IsValue() {
+		t.TotalChargeAmount = s.TotalChargeAmount.MustGet()
+	}
+	if s.TotalExcludingTax.IsValue() {
+		t.TotalExcludingTax = s.TotalExcludingTax.MustGet()
+	}
+	if s.TotalTaxAmount.IsValue() {
+		t.TotalTaxAmount = s.TotalTaxAmount.MustGet()
+	}
+	if s.TotalIncludingTax.IsValue() {
+		t.TotalIncludingTax = s.TotalIncludingTax.MustGet()
+	}
+	if s.RoundingAmount.IsValue() {
+		t.RoundingAmount = s.RoundingAmount.MustGet()
+	}
+	if s.PayableAmount.IsValue() {
+		t.PayableAmount = s.PayableAmount.MustGet()
+	}
+	if !s.CreatedBy.IsUnset() {
+		t.CreatedBy = s.CreatedBy.MustGetNull()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+	if !s.UpdatedAt.IsUnset() {
+		t.UpdatedAt = s.UpdatedAt.MustGetNull()
+	}
+	if !s.UUID.IsUnset() {
+		t.UUID = s.UUID.MustGetNull()
+	}
+	if !s.OriginalInvoiceID.IsUnset() {
+		t.OriginalInvoiceID = s.OriginalInvoiceID.MustGetNull()
+	}
+	if !s.OriginalUUID.IsUnset() {
+		t.OriginalUUID = s.OriginalUUID.MustGetNull()
+	}
+	if !s.OriginalInvoiceNumber.IsUnset() {
+		t.OriginalInvoiceNumber = s.OriginalInvoiceNumber.MustGetNull()
+	}
+}
+
+func (s *InvoiceSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Invoices.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 29)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.OrganisationID.IsValue() {
+			vals[1] = psql.Arg(s.OrganisationID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.InvoiceNumber.IsValue() {
+			vals[2] = psql.Arg(s.InvoiceNumber.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.TypeCode.IsValue() {
+			vals[3] = psql.Arg(s.TypeCode.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.Status.IsValue() {
+			vals[4] = psql.Arg(s.Status.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.IssueDate.IsValue() {
+			vals[5] = psql.Arg(s.IssueDate.MustGet())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.CurrencyCode.IsValue() {
+			vals[6] = psql.Arg(s.CurrencyCode.MustGet())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if !s.ExchangeRate.IsUnset() {
+			vals[7] = psql.Arg(s.ExchangeRate.MustGetNull())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if !s.BillingPeriodStart.IsUnset() {
+			vals[8] = psql.Arg(s.BillingPeriodStart.MustGetNull())
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		if !s.BillingPeriodEnd.IsUnset() {
+			vals[9] = psql.Arg(s.BillingPeriodEnd.MustGetNull())
+		} else {
+			vals[9] = psql.Raw("DEFAULT")
+		}
+
+		if !s.BillingFrequency.IsUnset() {
+			vals[10] = psql.Arg(s.BillingFrequency.MustGetNull())
+		} else {
+			vals[10] = psql.Raw("DEFAULT")
+		}
+
+		if !s.PaymentMode.IsUnset() {
+			vals[11] = psql.Arg(s.PaymentMode.MustGetNull())
+		} else {
+			vals[11] = psql.Raw("DEFAULT")
+		}
+
+		if !s.PaymentTerms.IsUnset() {
+			vals[12] = psql.Arg(s.PaymentTerms.MustGetNull())
+		} else {
+			vals[12] = psql.Raw("DEFAULT")
+		}
+
+		if !s.SupplierBankAccount.IsUnset() {
+			vals[13] = psql.Arg(s.SupplierBankAccount.MustGetNull())
+		} else {
+			vals[13] = psql.Raw("DEFAULT")
+		}
+
+		if s.TotalLineAmount.IsValue() {
+			vals[14] = psql.Arg(s.TotalLineAmount.MustGet())
+		} else {
+			vals[14] = psql.Raw("DEFAULT")
+		}
+
+		if s.TotalAllowanceAmount.IsValue() {
+			vals[15] = psql.Arg(s.TotalAllowanceAmount.MustGet())
+		} else {
+			vals[15] = psql.Raw("DEFAULT")
+		}
+
+		if s.TotalChargeAmount.IsValue() {
+			vals[16] = psql.Arg(s.TotalChargeAmount.MustGet())
+		} else {
+			vals[16] = psql.Raw("DEFAULT")
+		}
+
+		if s.TotalExcludingTax.IsValue() {
+			vals[17] = psql.Arg(s.TotalExcludingTax.MustGet())
+		} else {
+			vals[17] = psql.Raw("DEFAULT")
+		}
+
+		if s.TotalTaxAmount.IsValue() {
+			vals[18] = psql.Arg(s.TotalTaxAmount.MustGet())
+		} else {
+			vals[18] = psql.Raw("DEFAULT")
+		}
+
+		if s.TotalIncludingTax.IsValue() {
+			vals[19] = psql.Arg(s.TotalIncludingTax.MustGet())
+		} else {
+			vals[19] = psql.Raw("DEFAULT")
+		}
+
+		if s.RoundingAmount.IsValue() {
+			vals[20] = psql.Arg(s.RoundingAmount.MustGet())
+		} else {
+			vals[20] = psql.Raw("DEFAULT")
+		}
+
+		if s.PayableAmount.IsValue() {
+			vals[21] = psql.Arg(s.PayableAmount.MustGet())
+		} else {
+			vals[21] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedBy.IsUnset() {
+			vals[22] = psql.Arg(s.CreatedBy.MustGetNull())
+		} else {
+			vals[22] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[23] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[23] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UpdatedAt.IsUnset() {
+			vals[24] = psql.Arg(s.UpdatedAt.MustGetNull())
+		} else {
+			vals[24] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UUID.IsUnset() {
+			vals[25] = psql.Arg(s.UUID.MustGetNull())
+		} else {
+			vals[25] = psql.Raw("DEFAULT")
+		}
+
+		if !s.OriginalInvoiceID.IsUnset() {
+			vals[26] = psql.Arg(s.OriginalInvoiceID.MustGetNull())
+		} else {
+			vals[26] = psql.Raw("DEFAULT")
+		}
+
+		if !s.OriginalUUID.IsUnset() {
+			vals[27] = psql.Arg(s.OriginalUUID.MustGetNull())
+		} else {
+			vals[27] = psql.Raw("DEFAULT")
+		}
+
+		if !s.OriginalInvoiceNumber.IsUnset() {
+			vals[28] = psql.Arg(s.OriginalInvoiceNumber.MustGetNull())
+		} else {
+			vals[28] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s InvoiceSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s InvoiceSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 29)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.OrganisationID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "organisation_id")...),
+			psql.Arg(s.OrganisationID),
+		}})
+	}
+
+	if s.InvoiceNumber.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "invoice_number")...),
+			psql.Arg(s.InvoiceNumber),
+		}})
+	}
+
+	if s.TypeCode.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "type_code")...),
+			psql.Arg(s.TypeCode),
+		}})
+	}
+
+	if s.Status.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "status")...),
+			psql.Arg(s.Status),
+		}})
+	}
+
+	if s.IssueDate.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "issue_date")...),
+			psql.Arg(s.IssueDate),
+		}})
+	}
+
+	if s.CurrencyCode.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "currency_code")...),
+			psql.Arg(s.CurrencyCode),
+		}})
+	}
+
+	if !s.ExchangeRate.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "exchange_rate")...),
+			psql.Arg(s.ExchangeRate),
+		}})
+	}
+
+	if !s.BillingPeriodStart.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "billing_period_start")...),
+			psql.Arg(s.BillingPeriodStart),
+		}})
+	}
+
+	if !s.BillingPeriodEnd.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "billing_period_end")...),
+			psql.Arg(s.BillingPeriodEnd),
+		}})
+	}
+
+	if !s.BillingFrequency.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "billing_frequency")...),
+			psql.Arg(s.BillingFrequency),
+		}})
+	}
+
+	if !s.PaymentMode.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "payment_mode")...),
+			psql.Arg(s.PaymentMode),
+		}})
+	}
+
+	if !s.PaymentTerms.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "payment_terms")...),
+			psql.Arg(s.PaymentTerms),
+		}})
+	}
+
+	if !s.SupplierBankAccount.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "supplier_bank_account")...),
+			psql.Arg(s.SupplierBankAccount),
+		}})
+	}
+
+	if s.TotalLineAmount.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "total_line_amount")...),
+			psql.Arg(s.TotalLineAmount),
+		}})
+	}
+
+	if s.TotalAllowanceAmount.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "total_allowance_amount")...),
+			psql.Arg(s.TotalAllowanceAmount),
+		}})
+	}
+
+	if s.TotalChargeAmount.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "total_charge_amount")...),
+			psql.Arg(s.TotalChargeAmount),
+		}})
+	}
+
+	if s.TotalExcludingTax.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "total_excluding_tax")...),
+			psql.Arg(s.TotalExcludingTax),
+		}})
+	}
+
+	if s.TotalTaxAmount.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "total_tax_amount")...),
+			psql.Arg(s.TotalTaxAmount),
+		}})
+	}
+
+	if s.TotalIncludingTax.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "total_including_tax")...),
+			psql.Arg(s.TotalIncludingTax),
+		}})
+	}
+
+	if s.RoundingAmount.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "rounding_amount")...),
+			psql.Arg(s.RoundingAmount),
+		}})
+	}
+
+	if s.PayableAmount.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "payable_amount")...),
+			psql.Arg(s.PayableAmount),
+		}})
+	}
+
+	if !s.CreatedBy.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_by")...),
+			psql.Arg(s.CreatedBy),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	if !s.UpdatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "updated_at")...),
+			psql.Arg(s.UpdatedAt),
+		}})
+	}
+
+	if !s.UUID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "uuid")...),
+			psql.Arg(s.UUID),
+		}})
+	}
+
+	if !s.OriginalInvoiceID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "original_invoice_id")...),
+			psql.Arg(s.OriginalInvoiceID),
+		}})
+	}
+
+	if !s.OriginalUUID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "original_uuid")...),
+			psql.Arg(s.OriginalUUID),
+		}})
+	}
+
+	if !s.OriginalInvoiceNumber.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "original_invoice_number")...),
+			psql.Arg(s.OriginalInvoiceNumber),
+		}})
+	}
+
+	return exprs
+}
+
+// FindInvoice retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindInvoice(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*Invoice, error) {
+	if len(cols) == 0 {
+		return Invoices.Query(
+			sm.Where(Invoices.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Invoices.Query(
+		sm.Where(Invoices.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(Invoices.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// InvoiceExists checks the presence of a single record by primary key
+func InvoiceExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return Invoices.Query(
+		sm.Where(Invoices.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Invoice is retrieved from the database
+func (o *Invoice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Invoices.AfterSelectHooks.RunHooks(ctx, exec, InvoiceSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Invoices.AfterInsertHooks.RunHooks(ctx, exec, InvoiceSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Invoices.AfterUpdateHooks.RunHooks(ctx, exec, InvoiceSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Invoices.AfterDeleteHooks.RunHooks(ctx, exec, InvoiceSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Invoice
+func (o *Invoice) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Invoice) pkEQ() dialect.Expression {
+	return psql.Quote("invoices", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Invoice
+func (o *Invoice) Update(ctx context.Context, exec bob.Executor, s *InvoiceSetter) error {
+	v, err := Invoices.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Invoice record with an executor
+func (o *Invoice) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Invoices.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Invoice using the executor
+func (o *Invoice) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Invoices.Query(
+		sm.Where(Invoices.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after InvoiceSlice is retrieved from the database
+func (o InvoiceSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Invoices.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Invoices.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Invoices.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Invoices.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o InvoiceSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("invoices", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o InvoiceSlice) copyMatchingRows(from ...*Invoice) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o InvoiceSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Invoices.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Invoice:
+				o.copyMatchingRows(retrieved)
+			case []*Invoice:
+				o.copyMatchingRows(retrieved...)
+			case InvoiceSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Invoice or a slice of Invoice
+				// then run the AfterUpdateHooks on the slice
+				_, err = Invoices.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o InvoiceSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Invoices.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Invoice:
+				o.copyMatchingRows(retrieved)
+			case []*Invoice:
+				o.copyMatchingRows(retrieved...)
+			case InvoiceSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Invoice or a slice of Invoice
+				// then run the AfterDeleteHooks on the slice
+				_, err = Invoices.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o InvoiceSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals InvoiceSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Invoices.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o InvoiceSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Invoices.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o InvoiceSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Invoices.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// InvoiceAllowanceCharges starts a query for related objects on invoice_allowance_charges
+func (o *Invoice) InvoiceAllowanceCharges(mods ...bob.Mod[*dialect.SelectQuery]) InvoiceAllowanceChargesQuery {
+	return InvoiceAllowanceCharges.Query(append(mods,
+		sm.Where(InvoiceAllowanceCharges.Columns.InvoiceID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os InvoiceSlice) InvoiceAllowanceCharges(mods ...bob.Mod[*dialect.SelectQuery]) InvoiceAllowanceChargesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return InvoiceAllowanceCharges.Query(append(mods,
+		sm.Where(psql.Group(InvoiceAllowanceCharges.Columns.InvoiceID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// InvoiceLines starts a query for related objects on invoice_lines
+func (o *Invoice) InvoiceLines(mods ...bob.Mod[*dialect.SelectQuery]) InvoiceLinesQuery {
+	return InvoiceLines.Query(append(mods,
+		sm.Where(InvoiceLines.Columns.InvoiceID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os InvoiceSlice) InvoiceLines(mods ...bob.Mod[*dialect.SelectQuery]) InvoiceLinesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return InvoiceLines.Query(append(mods,
+		sm.Where(psql.Group(InvoiceLines.Columns.InvoiceID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// InvoiceParties starts a query for related objects on invoice_parties
+func (o *Invoice) InvoiceParties(mods ...bob.Mod[*dialect.SelectQuery]) InvoicePartiesQuery {
+	return InvoiceParties.Query(append(mods,
+		sm.Where(InvoiceParties.Columns.InvoiceID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os InvoiceSlice) InvoiceParties(mods ...bob.Mod[*dialect.SelectQuery]) InvoicePartiesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return InvoiceParties.Query(append(mods,
+		sm.Where(psql.Group(InvoiceParties.Columns.InvoiceID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// CreatedByUser starts a query for related objects on users
+func (o *Invoice) CreatedByUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.CreatedBy))),
+	)...)
+}
+
+func (os InvoiceSlice) CreatedByUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkCreatedBy := make(pgtypes.Array[null.Val[int64]], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkCreatedBy = append(pkCreatedBy, o.CreatedBy)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkCreatedBy), "bigint[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Organisation starts a query for related objects on organisations
+func (o *Invoice) Organisation(mods ...bob.Mod[*dialect.SelectQuery]) OrganisationsQuery {
+	return Organisations.Query(append(mods,
+		sm.Where(Organisations.Columns.ID.EQ(psql.Arg(o.OrganisationID))),
+	)...)
+}
+
+func (os InvoiceSlice) Organisation(mods ...bob.Mod[*dialect.SelectQuery]) OrganisationsQuery {
+	pkOrganisationID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkOrganisationID = append(pkOrganisationID, o.OrganisationID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkOrganisationID), "bigint[]")),
+	))
+
+	return Organisations.Query(append(mods,
+		sm.Where(psql.Group(Organisations.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// OriginalInvoice starts a query for related objects on invoices
+func (o *Invoice) OriginalInvoice(mods ...bob.Mod[*dialect.SelectQuery]) InvoicesQuery {
+	return Invoices.Query(append(mods,
+		sm.Where(Invoices.Columns.ID.EQ(psql.Arg(o.OriginalInvoiceID))),
+	)...)
+}
+
+func (os InvoiceSlice) OriginalInvoice(mods ...bob.Mod[*dialect.SelectQuery]) InvoicesQuery {
+	pkOriginalInvoiceID := make(pgtypes.Array[null.Val[int64]], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkOriginalInvoiceID = append(pkOriginalInvoiceID, o.OriginalInvoiceID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkOriginalInvoiceID), "bigint[]")),
+	))
+
+	return Invoices.Query(append(mods,
+		sm.Where(psql.Group(Invoices.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ReverseOriginalInvoices starts a query for related objects on invoices
+func (o *Invoice) ReverseOriginalInvoices(mods ...bob.Mod[*dialect.SelectQuery]) InvoicesQuery {
+	return Invoices.Query(append(mods,
+		sm.Where(Invoices.Columns.OriginalInvoiceID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os InvoiceSlice) ReverseOriginalInvoices(mods ...bob.Mod[*dialect.SelectQuery]) InvoicesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Invoices.Query(append(mods,
+		sm.Where(psql.Group(Invoices.Columns.OriginalInvoiceID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertInvoiceInvoiceAllowanceCharges0(ctx context.Context, exec bob.Executor, invoiceAllowanceCharges1 []*InvoiceAllowanceChargeSetter, invoice0 *Invoice) (InvoiceAllowanceChargeSlice, error) {
+	for i := range invoiceAllowanceCharges1 {
+		invoiceAllowanceCharges1[i].InvoiceID = omit.From(invoice0.ID)
+	}
+
+	ret, err := InvoiceAllowanceCharges.Insert(bob.ToMods(invoiceAllowanceCharges1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertInvoiceInvoiceAllowanceCharges0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachInvoiceInvoiceAllowanceCharges0(ctx context.Context, exec bob.Executor, count int, invoiceAllowanceCharges1 InvoiceAllowanceChargeSlice, invoice0 *Invoice) (InvoiceAllowanceChargeSlice, error) {
+	setter := &InvoiceAllowanceChargeSetter{
+		InvoiceID: omit.From(invoice0.ID),
+	}
+
+	err := invoiceAllowanceCharges1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvoiceInvoiceAllowanceCharges0: %w", err)
+	}
+
+	return invoiceAllowanceCharges1, nil
+}
+
+func (invoice0 *Invoice) InsertInvoiceAllowanceCharges(ctx context.Context, exec bob.Executor, related ...*InvoiceAllowanceChargeSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	invoiceAllowanceCharges1, err := insertInvoiceInvoiceAllowanceCharges0(ctx, exec, related, invoice0)
+	if err != nil {
+		return err
+	}
+
+	invoice0.R.InvoiceAllowanceCharges = append(invoice0.R.InvoiceAllowanceCharges, invoiceAllowanceCharges1...)
+
+	for _, rel := range invoiceAllowanceCharges1 {
+		rel.R.Invoice = invoice0
+	}
+	return nil
+}
+
+func (invoice0 *Invoice) AttachInvoiceAllowanceCharges(ctx context.Context, exec bob.Executor, related ...*InvoiceAllowanceCharge) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	invoiceAllowanceCharges1 := InvoiceAllowanceChargeSlice(related)
+
+	_, err = attachInvoiceInvoiceAllowanceCharges0(ctx, exec, len(related), invoiceAllowanceCharges1, invoice0)
+	if err != nil {
+		return err
+	}
+
+	invoice0.R.InvoiceAllowanceCharges = append(invoice0.R.InvoiceAllowanceCharges, invoiceAllowanceCharges1...)
+
+	for _, rel := range related {
+		rel.R.Invoice = invoice0
+	}
+
+	return nil
+}
+
+func insertInvoiceInvoiceLines0(ctx context.Context, exec bob.Executor, invoiceLines1 []*InvoiceLineSetter, invoice0 *Invoice) (InvoiceLineSlice, error) {
+	for i := range invoiceLines1 {
+		invoiceLines1[i].InvoiceID = omit.From(invoice0.ID)
+	}
+
+	ret, err := InvoiceLines.Insert(bob.ToMods(invoiceLines1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertInvoiceInvoiceLines0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachInvoiceInvoiceLines0(ctx context.Context, exec bob.Executor, count int, invoiceLines1 InvoiceLineSlice, invoice0 *Invoice) (InvoiceLineSlice, error) {
+	setter := &InvoiceLineSetter{
+		InvoiceID: omit.From(invoice0.ID),
+	}
+
+	err := invoiceLines1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvoiceInvoiceLines0: %w", err)
+	}
+
+	return invoiceLines1, nil
+}
+
+func (invoice0 *Invoice) InsertInvoiceLines(ctx context.Context, exec bob.Executor, related ...*InvoiceLineSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	invoiceLines1, err := insertInvoiceInvoiceLines0(ctx, exec, related, invoice0)
+	if err != nil {
+		return err
+	}
+
+	invoice0.R.InvoiceLines = append(invoice0.R.InvoiceLines, invoiceLines1...)
+
+	for _, rel := range invoiceLines1 {
+		rel.R.Invoice = invoice0
+	}
+	return nil
+}
+
+func (invoice0 *Invoice) AttachInvoiceLines(ctx context.Context, exec bob.Executor, related ...*InvoiceLine) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	invoiceLines1 := InvoiceLineSlice(related)
+
+	_, err = attachInvoiceInvoiceLines0(ctx, exec, len(related), invoiceLines1, invoice0)
+	if err != nil {
+		return err
+	}
+
+	invoice0.R.InvoiceLines = append(invoice0.R.InvoiceLines, invoiceLines1...)
+
+	for _, rel := range related {
+		rel.R.Invoice = invoice0
+	}
+
+	return nil
+}
+
+func insertInvoiceInvoiceParties0(ctx context.Context, exec bob.Executor, invoiceParties1 []*InvoicePartySetter, invoice0 *Invoice) (InvoicePartySlice, error) {
+	for i := range invoiceParties1 {
+		invoiceParties1[i].InvoiceID = omit.From(invoice0.ID)
+	}
+
+	ret, err := InvoiceParties.Insert(bob.ToMods(invoiceParties1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertInvoiceInvoiceParties0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachInvoiceInvoiceParties0(ctx context.Context, exec bob.Executor, count int, invoiceParties1 InvoicePartySlice, invoice0 *Invoice) (InvoicePartySlice, error) {
+	setter := &InvoicePartySetter{
+		InvoiceID: omit.From(invoice0.ID),
+	}
+
+	err := invoiceParties1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvoiceInvoiceParties0: %w", err)
+	}
+
+	return invoiceParties1, nil
+}
+
+func (invoice0 *Invoice) InsertInvoiceParties(ctx context.Context, exec bob.Executor, related ...*InvoicePartySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	invoiceParties1, err := insertInvoiceInvoiceParties0(ctx, exec, related, invoice0)
+	if err != nil {
+		return err
+	}
+
+	invoice0.R.InvoiceParties = append(invoice0.R.InvoiceParties, invoiceParties1...)
+
+	for _, rel := range invoiceParties1 {
+		rel.R.Invoice = invoice0
+	}
+	return nil
+}
+
+func (invoice0 *Invoice) AttachInvoiceParties(ctx context.Context, exec bob.Executor, related ...*InvoiceParty) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	invoiceParties1 := InvoicePartySlice(related)
+
+	_, err = attachInvoiceInvoiceParties0(ctx, exec, len(related), invoiceParties1, invoice0)
+	if err != nil {
+		return err
+	}
+
+	invoice0.R.InvoiceParties = append(invoice0.R.InvoiceParties, invoiceParties1...)
+
+	for _, rel := range related {
+		rel.R.Invoice = invoice0
+	}
+
+	return nil
+}
+
+func attachInvoiceCreatedByUser0(ctx context.Context, exec bob.Executor, count int, invoice0 *Invoice, user1 *User) (*Invoice, error) {
+	setter := &InvoiceSetter{
+		CreatedBy: omitnull.From(user1.ID),
+	}
+
+	err := invoice0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvoiceCreatedByUser0: %w", err)
+	}
+
+	return invoice0, nil
+}
+
+func (invoice0 *Invoice) InsertCreatedByUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachInvoiceCreatedByUser0(ctx, exec, 1, invoice0, user1)
+	if err != nil {
+		return err
+	}
+
+	invoice0.R.CreatedByUser = user1
+
+	user1.R.CreatedByInvoices = append(user1.R.CreatedByInvoices, invoice0)
+
+	return nil
+}
+
+func (invoice0 *Invoice) AttachCreatedByUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachInvoiceCreatedByUser0(ctx, exec, 1, invoice0, user1)
+	if err != nil {
+		return err
+	}
+
+	invoice0.R.CreatedByUser = user1
+
+	user1.R.CreatedByInvoices = append(user1.R.CreatedByInvoices, invoice0)
+
+	return nil
+}
+
+func attachInvoiceOrganisation0(ctx context.Context, exec bob.Executor, count int, invoice0 *Invoice, organisation1 *Organisation) (*Invoice, error) {
+	setter := &InvoiceSetter{
+		OrganisationID: omit.From(organisation1.ID),
+	}
+
+	err := invoice0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvoiceOrganisation0: %w", err)
+	}
+
+	return invoice0, nil
+}
+
+func (invoice0 *Invoice) InsertOrganisation(ctx context.Context, exec bob.Executor, related *OrganisationSetter) error {
+	var err error
+
+	organisation1, err := Organisations.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachInvoiceOrganisation0(ctx, exec, 1, invoice0, organisation1)
+	if err != nil {
+		return err
+	}
+
+	invoice0.R.Organisation = organisation1
+
+	organisation1.R.Invoices = append(organisation1.R.Invoices, invoice0)
+
+	return nil
+}
+
+func (invoice0 *Invoice) AttachOrganisation(ctx context.Context, exec bob.Executor, organisation1 *Organisation) error {
+	var err error
+
+	_, err = attachInvoiceOrganisation0(ctx, exec, 1, invoice0, organisation1)
+	if err != nil {
+		return err
+	}
+
+	invoice0.R.Organisation = organisation1
+
+	organisation1.R.Invoices = append(organisation1.R.Invoices, invoice0)
+
+	return nil
+}
+
+func attachInvoiceOriginalInvoice0(ctx context.Context, exec bob.Executor, count int, invoice0 *Invoice, invoice1 *Invoice) (*Invoice, error) {
+	setter := &InvoiceSetter{
+		OriginalInvoiceID: omitnull.From(invoice1.ID),
+	}
+
+	err := invoice0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvoiceOriginalInvoice0: %w", err)
+	}
+
+	return invoice0, nil
+}
+
+func (invoice0 *Invoice) InsertOriginalInvoice(ctx context.Context, exec bob.Executor, related *InvoiceSetter) error {
+	var err error
+
+	invoice1, err := Invoices.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachInvoiceOriginalInvoice0(ctx, exec, 1, invoice0, invoice1)
+	if err != nil {
+		return err
+	}
+
+	invoice0.R.OriginalInvoice = invoice1
+
+	invoice1.R.OriginalInvoice = invoice0
+
+	return nil
+}
+
+func (invoice0 *Invoice) AttachOriginalInvoice(ctx context.Context, exec bob.Executor, invoice1 *Invoice) error {
+	var err error
+
+	_, err = attachInvoiceOriginalInvoice0(ctx, exec, 1, invoice0, invoice1)
+	if err != nil {
+		return err
+	}
+
+	invoice0.R.OriginalInvoice = invoice1
+
+	invoice1.R.OriginalInvoice = invoice0
+
+	return nil
+}
+
+func insertInvoiceReverseOriginalInvoices0(ctx context.Context, exec bob.Executor, invoices1 []*InvoiceSetter, invoice0 *Invoice) (InvoiceSlice, error) {
+	for i := range invoices1 {
+		invoices1[i].OriginalInvoiceID = omitnull.From(invoice0.ID)
+	}
+
+	ret, err := Invoices.Insert(bob.ToMods(invoices1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertInvoiceReverseOriginalInvoices0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachInvoiceReverseOriginalInvoices0(ctx context.Context, exec bob.Executor, count int, invoices1 InvoiceSlice, invoice0 *Invoice) (InvoiceSlice, error) {
+	setter := &InvoiceSetter{
+		OriginalInvoiceID: omitnull.From(invoice0.ID),
+	}
+
+	err := invoices1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachInvoiceReverseOriginalInvoices0: %w", err)
+	}
+
+	return invoices1, nil
+}
+
+func (invoice0 *Invoice) InsertReverseOriginalInvoices(ctx context.Context, exec bob.Executor, related ...*InvoiceSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	invoices1, err := insertInvoiceReverseOriginalInvoices0(ctx, exec, related, invoice0)
+	if err != nil {
+		return err
+	}
+
+	invoice0.R.ReverseOriginalInvoices = append(invoice0.R.ReverseOriginalInvoices, invoices1...)
+
+	for _, rel := range invoices1 {
+		rel.R.ReverseOriginalInvoices = append(rel.R.ReverseOriginalInvoices, invoice0)
+	}
+	return nil
+}
+
+func (invoice0 *Invoice) AttachReverseOriginalInvoices(ctx context.Context, exec bob.Executor, related ...*Invoice) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	invoices1 := InvoiceSlice(related)
+
+	_, err = attachInvoiceReverseOriginalInvoices0(ctx, exec, len(related), invoices1, invoice0)
+	if err != nil {
+		return err
+	}
+
+	invoice0.R.ReverseOriginalInvoices = append(invoice0.R.ReverseOriginalInvoices, invoices1...)
+
+	for _, rel := range related {
+		rel.R.ReverseOriginalInvoices = append(rel.R.ReverseOriginalInvoices, invoice0)
+	}
+
+	return nil
+}
+
+type invoiceWhere[Q psql.Filterable] struct {
+	ID                    psql.WhereMod[Q, int64]
+	OrganisationID        psql.WhereMod[Q, int64]
+	InvoiceNumber         psql.WhereMod[Q, string]
+	TypeCode              psql.WhereMod[Q, string]
+	Status                psql.WhereMod[Q, enums.InvoiceStatuses]
+	IssueDate             psql.WhereMod[Q, time.Time]
+	CurrencyCode          psql.WhereMod[Q, string]
+	ExchangeRate          psql.WhereNullMod[Q, decimal.Decimal]
+	BillingPeriodStart    psql.WhereNullMod[Q, time.Time]
+	BillingPeriodEnd      psql.WhereNullMod[Q, time.Time]
+	BillingFrequency      psql.WhereNullMod[Q, string]
+	PaymentMode           psql.WhereNullMod[Q, string]
+	PaymentTerms          psql.WhereNullMod[Q, string]
+	SupplierBankAccount   psql.WhereNullMod[Q, string]
+	TotalLineAmount       psql.WhereMod[Q, decimal.Decimal]
+	TotalAllowanceAmount  psql.WhereMod[Q, decimal.Decimal]
+	TotalChargeAmount     psql.WhereMod[Q, decimal.Decimal]
+	TotalExcludingTax     psql.WhereMod[Q, decimal.Decimal]
+	TotalTaxAmount        psql.WhereMod[Q, decimal.Decimal]
+	TotalIncludingTax     psql.WhereMod[Q, decimal.Decimal]
+	RoundingAmount        psql.WhereMod[Q, decimal.Decimal]
+	PayableAmount         psql.WhereMod[Q, decimal.Decimal]
+	CreatedBy             psql.WhereNullMod[Q, int64]
+	CreatedAt             psql.WhereNullMod[Q, time.Time]
+	UpdatedAt             psql.WhereNullMod[Q, time.Time]
+	UUID                  psql.WhereNullMod[Q, string]
+	OriginalInvoiceID     psql.WhereNullMod[Q, int64]
+	OriginalUUID          psql.WhereNullMod[Q, string]
+	OriginalInvoiceNumber psql.WhereNullMod[Q, string]
+}
+
+func (invoiceWhere[Q]) AliasedAs(alias string) invoiceWhere[Q] {
+	return buildInvoiceWhere[Q](buildInvoiceColumns(alias))
+}
+
+func buildInvoiceWhere[Q psql.Filterable](cols invoiceColumns) invoiceWhere[Q] {
+	return invoiceWhere[Q]{
+		ID:                    psql.Where[Q, int64](cols.ID),
+		OrganisationID:        psql.Where[Q, int64](cols.OrganisationID),
+		InvoiceNumber:         psql.Where[Q, string](cols.InvoiceNumber),
+		TypeCode:              psql.Where[Q, string](cols.TypeCode),
+		Status:                psql.Where[Q, enums.InvoiceStatuses](cols.Status),
+		IssueDate:             psql.Where[Q, time.Time](cols.IssueDate),
+		CurrencyCode:          psql.Where[Q, string](cols.CurrencyCode),
+		ExchangeRate:          psql.WhereNull[Q, decimal.Decimal](cols.ExchangeRate),
+		BillingPeriodStart:    psql.WhereNull[Q, time.Time](cols.BillingPeriodStart),
+		BillingPeriodEnd:      psql.WhereNull[Q, time.Time](cols.BillingPeriodEnd),
+		BillingFrequency:      psql.WhereNull[Q, string](cols.BillingFrequency),
+		PaymentMode:           psql.WhereNull[Q, string](cols.PaymentMode),
+		PaymentTerms:          psql.WhereNull[Q, string](cols.PaymentTerms),
+		SupplierBankAccount:   psql.WhereNull[Q, string](cols.SupplierBankAccount),
+		TotalLineAmount:       psql.Where[Q, decimal.Decimal](cols.TotalLineAmount),
+		TotalAllowanceAmount:  psql.Where[Q, decimal.Decimal](cols.TotalAllowanceAmount),
+		TotalChargeAmount:     psql.Where[Q, decimal.Decimal](cols.TotalChargeAmount),
+		TotalExcludingTax:     psql.Where[Q, decimal.Decimal](cols.TotalExcludingTax),
+		TotalTaxAmount:        psql.Where[Q, decimal.Decimal](cols.TotalTaxAmount),
+		TotalIncludingTax:     psql.Where[Q, decimal.Decimal](cols.TotalIncludingTax),
+		RoundingAmount:        psql.Where[Q, decimal.Decimal](cols.RoundingAmount),
+		PayableAmount:         psql.Where[Q, decimal.Decimal](cols.PayableAmount),
+		CreatedBy:             psql.WhereNull[Q, int64](cols.CreatedBy),
+		CreatedAt:             psql.WhereNull[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:             psql.WhereNull[Q, time.Time](cols.UpdatedAt),
+		UUID:                  psql.WhereNull[Q, string](cols.UUID),
+		OriginalInvoiceID:     psql.WhereNull[Q, int64](cols.OriginalInvoiceID),
+		OriginalUUID:          psql.WhereNull[Q, string](cols.OriginalUUID),
+		OriginalInvoiceNumber: psql.WhereNull[Q, string](cols.OriginalInvoiceNumber),
+	}
+}
+
+func (o *Invoice) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "InvoiceAllowanceCharges":
+		rels, ok := retrieved.(InvoiceAllowanceChargeSlice)
+		if !ok {
+			return fmt.Errorf("invoice cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.InvoiceAllowanceCharges = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Invoice = o
+			}
+		}
+		return nil
+	case "InvoiceLines":
+		rels, ok := retrieved.(InvoiceLineSlice)
+		if !ok {
+			return fmt.Errorf("invoice cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.InvoiceLines = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Invoice = o
+			}
+		}
+		return nil
+	case "InvoiceParties":
+		rels, ok := retrieved.(InvoicePartySlice)
+		if !ok {
+			return fmt.Errorf("invoice cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.InvoiceParties = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Invoice = o
+			}
+		}
+		return nil
+	case "CreatedByUser":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("invoice cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.CreatedByUser = rel
+
+		if rel != nil {
+			rel.R.CreatedByInvoices = InvoiceSlice{o}
+		}
+		return nil
+	case "Organisation":
+		rel, ok := retrieved.(*Organisation)
+		if !ok {
+			return fmt.Errorf("invoice cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Organisation = rel
+
+		if rel != nil {
+			rel.R.Invoices = InvoiceSlice{o}
+		}
+		return nil
+	case "OriginalInvoice":
+		rel, ok := retrieved.(*Invoice)
+		if !ok {
+			return fmt.Errorf("invoice cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.OriginalInvoice = rel
+
+		if rel != nil {
+			rel.R.OriginalInvoice = o
+		}
+		return nil
+	case "ReverseOriginalInvoices":
+		rels, ok := retrieved.(InvoiceSlice)
+		if !ok {
+			return fmt.Errorf("invoice cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ReverseOriginalInvoices = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ReverseOriginalInvoices = InvoiceSlice{o}
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("invoice has no relationship %q", name)
+	}
+}
+
+type invoicePreloader struct {
+	CreatedByUser   func(...psql.PreloadOption) psql.Preloader
+	Organisation    func(...psql.PreloadOption) psql.Preloader
+	OriginalInvoice func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildInvoicePreloader() invoicePreloader {
+	return invoicePreloader{
+		CreatedByUser: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "CreatedByUser",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Invoices,
+						To:          Users,
+						FromColumns: []string{"created_by"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		Organisation: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Organisation, OrganisationSlice](psql.PreloadRel{
+				Name: "Organisation",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Invoices,
+						To:          Organisations,
+						FromColumns: []string{"organisation_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Organisations.Columns.Names(), opts...)
+		},
+		OriginalInvoice: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Invoice, InvoiceSlice](psql.PreloadRel{
+				Name: "OriginalInvoice",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Invoices,
+						To:          Invoices,
+						FromColumns: []string{"original_invoice_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Invoices.Columns.Names(), opts...)
+		},
+	}
+}
+
+type invoiceThenLoader[Q orm.Loadable] struct {
+	InvoiceAllowanceCharges func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	InvoiceLines            func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	InvoiceParties          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	CreatedByUser           func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Organisation            func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	OriginalInvoice         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ReverseOriginalInvoices func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildInvoiceThenLoader[Q orm.Loadable]() invoiceThenLoader[Q] {
+	type InvoiceAllowanceChargesLoadInterface interface {
+		LoadInvoiceAllowanceCharges(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type InvoiceLinesLoadInterface interface {
+		LoadInvoiceLines(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type InvoicePartiesLoadInterface interface {
+		LoadInvoiceParties(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type CreatedByUserLoadInterface interface {
+		LoadCreatedByUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type OrganisationLoadInterface interface {
+		LoadOrganisation(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type OriginalInvoiceLoadInterface interface {
+		LoadOriginalInvoice(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ReverseOriginalInvoicesLoadInterface interface {
+		LoadReverseOriginalInvoices(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return invoiceThenLoader[Q]{
+		InvoiceAllowanceCharges: thenLoadBuilder[Q](
+			"InvoiceAllowanceCharges",
+			func(ctx context.Context, exec bob.Executor, retrieved InvoiceAllowanceChargesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadInvoiceAllowanceCharges(ctx, exec, mods...)
+			},
+		),
+		InvoiceLines: thenLoadBuilder[Q](
+			"InvoiceLines",
+			func(ctx context.Context, exec bob.Executor, retrieved InvoiceLinesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadInvoiceLines(ctx, exec, mods...)
+			},
+		),
+		InvoiceParties: thenLoadBuilder[Q](
+			"InvoiceParties",
+			func(ctx context.Context, exec bob.Executor, retrieved InvoicePartiesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadInvoiceParties(ctx, exec, mods...)
+			},
+		),
+		CreatedByUser: thenLoadBuilder[Q](
+			"CreatedByUser",
+			func(ctx context.Context, exec bob.Executor, retrieved CreatedByUserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadCreatedByUser(ctx, exec, mods...)
+			},
+		),
+		Organisation: thenLoadBuilder[Q](
+			"Organisation",
+			func(ctx context.Context, exec bob.Executor, retrieved OrganisationLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadOrganisation(ctx, exec, mods...)
+			},
+		),
+		OriginalInvoice: thenLoadBuilder[Q](
+			"OriginalInvoice",
+			func(ctx context.Context, exec bob.Executor, retrieved OriginalInvoiceLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadOriginalInvoice(ctx, exec, mods...)
+			},
+		),
+		ReverseOriginalInvoices: thenLoadBuilder[Q](
+			"ReverseOriginalInvoices",
+			func(ctx context.Context, exec bob.Executor, retrieved ReverseOriginalInvoicesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadReverseOriginalInvoices(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadInvoiceAllowanceCharges loads the invoice's InvoiceAllowanceCharges into the .R struct
+func (o *Invoice) LoadInvoiceAllowanceCharges(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.InvoiceAllowanceCharges = nil
+
+	related, err := o.InvoiceAllowanceCharges(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Invoice = o
+	}
+
+	o.R.InvoiceAllowanceCharges = related
+	return nil
+}
+
+// LoadInvoiceAllowanceCharges loads the invoice's InvoiceAllowanceCharges into the .R struct
+func (os InvoiceSlice) LoadInvoiceAllowanceCharges(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invoiceAllowanceCharges, err := os.InvoiceAllowanceCharges(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.InvoiceAllowanceCharges = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invoiceAllowanceCharges {
+
+			if !(o.ID == rel.InvoiceID) {
+				continue
+			}
+
+			rel.R.Invoice = o
+
+			o.R.InvoiceAllowanceCharges = append(o.R.InvoiceAllowanceCharges, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadInvoiceLines loads the invoice's InvoiceLines into the .R struct
+func (o *Invoice) LoadInvoiceLines(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.InvoiceLines = nil
+
+	related, err := o.InvoiceLines(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Invoice = o
+	}
+
+	o.R.InvoiceLines = related
+	return nil
+}
+
+// LoadInvoiceLines loads the invoice's InvoiceLines into the .R struct
+func (os InvoiceSlice) LoadInvoiceLines(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invoiceLines, err := os.InvoiceLines(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.InvoiceLines = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invoiceLines {
+
+			if !(o.ID == rel.InvoiceID) {
+				continue
+			}
+
+			rel.R.Invoice = o
+
+			o.R.InvoiceLines = append(o.R.InvoiceLines, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadInvoiceParties loads the invoice's InvoiceParties into the .R struct
+func (o *Invoice) LoadInvoiceParties(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.InvoiceParties = nil
+
+	related, err := o.InvoiceParties(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Invoice = o
+	}
+
+	o.R.InvoiceParties = related
+	return nil
+}
+
+// LoadInvoiceParties loads the invoice's InvoiceParties into the .R struct
+func (os InvoiceSlice) LoadInvoiceParties(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invoiceParties, err := os.InvoiceParties(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.InvoiceParties = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invoiceParties {
+
+			if !(o.ID == rel.InvoiceID) {
+				continue
+			}
+
+			rel.R.Invoice = o
+
+			o.R.InvoiceParties = append(o.R.InvoiceParties, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByUser loads the invoice's CreatedByUser into the .R struct
+func (o *Invoice) LoadCreatedByUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.CreatedByUser = nil
+
+	related, err := o.CreatedByUser(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.CreatedByInvoices = InvoiceSlice{o}
+
+	o.R.CreatedByUser = related
+	return nil
+}
+
+// LoadCreatedByUser loads the invoice's CreatedByUser into the .R struct
+func (os InvoiceSlice) LoadCreatedByUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.CreatedByUser(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+			if !o.CreatedBy.IsValue() {
+				continue
+			}
+
+			if !(o.CreatedBy.IsValue() && o.CreatedBy.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.CreatedByInvoices = append(rel.R.CreatedByInvoices, o)
+
+			o.R.CreatedByUser = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadOrganisation loads the invoice's Organisation into the .R struct
+func (o *Invoice) LoadOrganisation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Organisation = nil
+
+	related, err := o.Organisation(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Invoices = InvoiceSlice{o}
+
+	o.R.Organisation = related
+	return nil
+}
+
+// LoadOrganisation loads the invoice's Organisation into the .R struct
+func (os InvoiceSlice) LoadOrganisation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	organisations, err := os.Organisation(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range organisations {
+
+			if !(o.OrganisationID == rel.ID) {
+				continue
+			}
+
+			rel.R.Invoices = append(rel.R.Invoices, o)
+
+			o.R.Organisation = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadOriginalInvoice loads the invoice's OriginalInvoice into the .R struct
+func (o *Invoice) LoadOriginalInvoice(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.OriginalInvoice = nil
+
+	related, err := o.OriginalInvoice(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.OriginalInvoice = o
+
+	o.R.OriginalInvoice = related
+	return nil
+}
+
+// LoadOriginalInvoice loads the invoice's OriginalInvoice into the .R struct
+func (os InvoiceSlice) LoadOriginalInvoice(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invoices, err := os.OriginalInvoice(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invoices {
+			if !o.OriginalInvoiceID.IsValue() {
+				continue
+			}
+
+			if !(o.OriginalInvoiceID.IsValue() && o.OriginalInvoiceID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.OriginalInvoice = o
+
+			o.R.OriginalInvoice = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadReverseOriginalInvoices loads the invoice's ReverseOriginalInvoices into the .R struct
+func (o *Invoice) LoadReverseOriginalInvoices(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ReverseOriginalInvoices = nil
+
+	related, err := o.ReverseOriginalInvoices(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ReverseOriginalInvoices = InvoiceSlice{o}
+	}
+
+	o.R.ReverseOriginalInvoices = related
+	return nil
+}
+
+// LoadReverseOriginalInvoices loads the invoice's ReverseOriginalInvoices into the .R struct
+func (os InvoiceSlice) LoadReverseOriginalInvoices(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invoices, err := os.ReverseOriginalInvoices(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ReverseOriginalInvoices = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invoices {
+
+			if !rel.OriginalInvoiceID.IsValue() {
+				continue
+			}
+			if !(rel.OriginalInvoiceID.IsValue() && o.ID == rel.OriginalInvoiceID.MustGet()) {
+				continue
+			}
+
+			rel.R.ReverseOriginalInvoices = append(rel.R.ReverseOriginalInvoices, o)
+
+			o.R.ReverseOriginalInvoices = append(o.R.ReverseOriginalInvoices, rel)
+		}
+	}
+
+	return nil
+}
+
+type invoiceJoins[Q dialect.Joinable] struct {
+	typ                     string
+	InvoiceAllowanceCharges modAs[Q, invoiceAllowanceChargeColumns]
+	InvoiceLines            modAs[Q, invoiceLineColumns]
+	InvoiceParties          modAs[Q, invoicePartyColumns]
+	CreatedByUser           modAs[Q, userColumns]
+	Organisation            modAs[Q, organisationColumns]
+	OriginalInvoice         modAs[Q, invoiceColumns]
+	ReverseOriginalInvoices modAs[Q, invoiceColumns]
+}
+
+func (j invoiceJoins[Q]) aliasedAs(alias string) invoiceJoins[Q] {
+	return buildInvoiceJoins[Q](buildInvoiceColumns(alias), j.typ)
+}
+
+func buildInvoiceJoins[Q dialect.Joinable](cols invoiceColumns, typ string) invoiceJoins[Q] {
+	return invoiceJoins[Q]{
+		typ: typ,
+		InvoiceAllowanceCharges: modAs[Q, invoiceAllowanceChargeColumns]{
+			c: InvoiceAllowanceCharges.Columns,
+			f: func(to invoiceAllowanceChargeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, InvoiceAllowanceCharges.Name().As(to.Alias())).On(
+						to.InvoiceID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		InvoiceLines: modAs[Q, invoiceLineColumns]{
+			c: InvoiceLines.Columns,
+			f: func(to invoiceLineColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, InvoiceLines.Name().As(to.Alias())).On(
+						to.InvoiceID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		InvoiceParties: modAs[Q, invoicePartyColumns]{
+			c: InvoiceParties.Columns,
+			f: func(to invoicePartyColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, InvoiceParties.Name().As(to.Alias())).On(
+						to.InvoiceID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		CreatedByUser: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.CreatedBy),
+					))
+				}
+
+				return mods
+			},
+		},
+		Organisation: modAs[Q, organisationColumns]{
+			c: Organisations.Columns,
+			f: func(to organisationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Organisations.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.OrganisationID),
+					))
+				}
+
+				return mods
+			},
+		},
+		OriginalInvoice: modAs[Q, invoiceColumns]{
+			c: Invoices.Columns,
+			f: func(to invoiceColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Invoices.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.OriginalInvoiceID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ReverseOriginalInvoices: modAs[Q, invoiceColumns]{
+			c: Invoices.Columns,
+			f: func(to invoiceColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Invoices.Name().As(to.Alias())).On(
+						to.OriginalInvoiceID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,982 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/lib/pq"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// OauthClient is an object representing the database table.
+type OauthClient struct {
+	ID             int64               `db:"id,pk" `
+	OrganisationID int64               `db:"organisation_id" `
+	UserID         int64               `db:"user_id" `
+	Name           string              `db:"name" `
+	ClientID       string              `db:"client_id" `
+	SecretHash     string              `db:"secret_hash" `
+	Scopes         pq.StringArray      `db:"scopes" `
+	RevokedAt      null.Val[time.Time] `db:"revoked_at" `
+	CreatedAt      null.Val[time.Time] `db:"created_at" `
+	UpdatedAt      null.Val[time.Time] `db:"updated_at" `
+
+	R oauthClientR `db:"-" `
+}
+
+// OauthClientSlice is an alias for a slice of pointers to OauthClient.
+// This should almost always be used instead of []*OauthClient.
+type OauthClientSlice []*OauthClient
+
+// OauthClients contains methods to work with the oauth_clients table
+var OauthClients = psql.NewTablex[*OauthClient, OauthClientSlice, *OauthClientSetter]("", "oauth_clients", buildOauthClientColumns("oauth_clients"))
+
+// OauthClientsQuery is a query on the oauth_clients table
+type OauthClientsQuery = *psql.ViewQuery[*OauthClient, OauthClientSlice]
+
+// oauthClientR is where relationships are stored.
+type oauthClientR struct {
+	Organisation *Organisation // oauth_clients.oauth_clients_organisation_id_fkey
+	User         *User         // oauth_clients.oauth_clients_user_id_fkey
+}
+
+func buildOauthClientColumns(alias string) oauthClientColumns {
+	return oauthClientColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "organisation_id", "user_id", "name", "client_id", "secret_hash", "scopes", "revoked_at", "created_at", "updated_at",
+		).WithParent("oauth_clients"),
+		tableAlias:     alias,
+		ID:             psql.Quote(alias, "id"),
+		OrganisationID: psql.Quote(alias, "organisation_id"),
+		UserID:         psql.Quote(alias, "user_id"),
+		Name:           psql.Quote(alias, "name"),
+		ClientID:       psql.Quote(alias, "client_id"),
+		SecretHash:     psql.Quote(alias, "secret_hash"),
+		Scopes:         psql.Quote(alias, "scopes"),
+		RevokedAt:      psql.Quote(alias, "revoked_at"),
+		CreatedAt:      psql.Quote(alias, "created_at"),
+		UpdatedAt:      psql.Quote(alias, "updated_at"),
+	}
+}
+
+type oauthClientColumns struct {
+	expr.ColumnsExpr
+	tableAlias     string
+	ID             psql.Expression
+	OrganisationID psql.Expression
+	UserID         psql.Expression
+	Name           psql.Expression
+	ClientID       psql.Expression
+	SecretHash     psql.Expression
+	Scopes         psql.Expression
+	RevokedAt      psql.Expression
+	CreatedAt      psql.Expression
+	UpdatedAt      psql.Expression
+}
+
+func (c oauthClientColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (oauthClientColumns) AliasedAs(alias string) oauthClientColumns {
+	return buildOauthClientColumns(alias)
+}
+
+// OauthClientSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type OauthClientSetter struct {
+	ID             omit.Val[int64]          `db:"id,pk" `
+	OrganisationID omit.Val[int64]          `db:"organisation_id" `
+	UserID         omit.Val[int64]          `db:"user_id" `
+	Name           omit.Val[string]         `db:"name" `
+	ClientID       omit.Val[string]         `db:"client_id" `
+	SecretHash     omit.Val[string]         `db:"secret_hash" `
+	Scopes         omit.Val[pq.StringArray] `db:"scopes" `
+	RevokedAt      omitnull.Val[time.Time]  `db:"revoked_at" `
+	CreatedAt      omitnull.Val[time.Time]  `db:"created_at" `
+	UpdatedAt      omitnull.Val[time.Time]  `db:"updated_at" `
+}
+
+func (s OauthClientSetter) SetColumns() []string {
+	vals := make([]string, 0, 10)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.OrganisationID.IsValue() {
+		vals = append(vals, "organisation_id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if s.ClientID.IsValue() {
+		vals = append(vals, "client_id")
+	}
+	if s.SecretHash.IsValue() {
+		vals = append(vals, "secret_hash")
+	}
+	if s.Scopes.IsValue() {
+		vals = append(vals, "scopes")
+	}
+	if !s.RevokedAt.IsUnset() {
+		vals = append(vals, "revoked_at")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	if !s.UpdatedAt.IsUnset() {
+		vals = append(vals, "updated_at")
+	}
+	return vals
+}
+
+func (s OauthClientSetter) Overwrite(t *OauthClient) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.OrganisationID.IsValue() {
+		t.OrganisationID = s.OrganisationID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if s.ClientID.IsValue() {
+		t.ClientID = s.ClientID.MustGet()
+	}
+	if s.SecretHash.IsValue() {
+		t.SecretHash = s.SecretHash.MustGet()
+	}
+	if s.Scopes.IsValue() {
+		t.Scopes = s.Scopes.MustGet()
+	}
+	if !s.RevokedAt.IsUnset() {
+		t.RevokedAt = s.RevokedAt.MustGetNull()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+	if !s.UpdatedAt.IsUnset() {
+		t.UpdatedAt = s.UpdatedAt.MustGetNull()
+	}
+}
+
+func (s *OauthClientSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return OauthClients.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 10)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.OrganisationID.IsValue() {
+			vals[1] = psql.Arg(s.OrganisationID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserID.IsValue() {
+			vals[2] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.Name.IsValue() {
+			vals[3] = psql.Arg(s.Name.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.ClientID.IsValue() {
+			vals[4] = psql.Arg(s.ClientID.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.SecretHash.IsValue() {
+			vals[5] = psql.Arg(s.SecretHash.MustGet())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.Scopes.IsValue() {
+			vals[6] = psql.Arg(s.Scopes.MustGet())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if !s.RevokedAt.IsUnset() {
+			vals[7] = psql.Arg(s.RevokedAt.MustGetNull())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[8] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UpdatedAt.IsUnset() {
+			vals[9] = psql.Arg(s.UpdatedAt.MustGetNull())
+		} else {
+			vals[9] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s OauthClientSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s OauthClientSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 10)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.OrganisationID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "organisation_id")...),
+			psql.Arg(s.OrganisationID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "name")...),
+			psql.Arg(s.Name),
+		}})
+	}
+
+	if s.ClientID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "client_id")...),
+			psql.Arg(s.ClientID),
+		}})
+	}
+
+	if s.SecretHash.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "secret_hash")...),
+			psql.Arg(s.SecretHash),
+		}})
+	}
+
+	if s.Scopes.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "scopes")...),
+			psql.Arg(s.Scopes),
+		}})
+	}
+
+	if !s.RevokedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "revoked_at")...),
+			psql.Arg(s.RevokedAt),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	if !s.UpdatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "updated_at")...),
+			psql.Arg(s.UpdatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindOauthClient retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindOauthClient(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*OauthClient, error) {
+	if len(cols) == 0 {
+		return OauthClients.Query(
+			sm.Where(OauthClients.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return OauthClients.Query(
+		sm.Where(OauthClients.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(OauthClients.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// OauthClientExists checks the presence of a single record by primary key
+func OauthClientExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return OauthClients.Query(
+		sm.Where(OauthClients.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after OauthClient is retrieved from the database
+func (o *OauthClient) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OauthClients.AfterSelectHooks.RunHooks(ctx, exec, OauthClientSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = OauthClients.AfterInsertHooks.RunHooks(ctx, exec, OauthClientSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = OauthClients.AfterUpdateHooks.RunHooks(ctx, exec, OauthClientSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = OauthClients.AfterDeleteHooks.RunHooks(ctx, exec, OauthClientSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the OauthClient
+func (o *OauthClient) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *OauthClient) pkEQ() dialect.Expression {
+	return psql.Quote("oauth_clients", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the OauthClient
+func (o *OauthClient) Update(ctx context.Context, exec bob.Executor, s *OauthClientSetter) error {
+	v, err := OauthClients.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single OauthClient record with an executor
+func (o *OauthClient) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := OauthClients.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the OauthClient using the executor
+func (o *OauthClient) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := OauthClients.Query(
+		sm.Where(OauthClients.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after OauthClientSlice is retrieved from the database
+func (o OauthClientSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OauthClients.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = OauthClients.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = OauthClients.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = OauthClients.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o OauthClientSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("oauth_clients", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o OauthClientSlice) copyMatchingRows(from ...*OauthClient) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o OauthClientSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OauthClients.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OauthClient:
+				o.copyMatchingRows(retrieved)
+			case []*OauthClient:
+				o.copyMatchingRows(retrieved...)
+			case OauthClientSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OauthClient or a slice of OauthClient
+				// then run the AfterUpdateHooks on the slice
+				_, err = OauthClients.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o OauthClientSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OauthClients.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OauthClient:
+				o.copyMatchingRows(retrieved)
+			case []*OauthClient:
+				o.copyMatchingRows(retrieved...)
+			case OauthClientSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OauthClient or a slice of OauthClient
+				// then run the AfterDeleteHooks on the slice
+				_, err = OauthClients.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o OauthClientSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals OauthClientSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OauthClients.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o OauthClientSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OauthClients.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o OauthClientSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := OauthClients.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Organisation starts a query for related objects on organisations
+func (o *OauthClient) Organisation(mods ...bob.Mod[*dialect.SelectQuery]) OrganisationsQuery {
+	return Organisations.Query(append(mods,
+		sm.Where(Organisations.Columns.ID.EQ(psql.Arg(o.OrganisationID))),
+	)...)
+}
+
+func (os OauthClientSlice) Organisation(mods ...bob.Mod[*dialect.SelectQuery]) OrganisationsQuery {
+	pkOrganisationID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkOrganisationID = append(pkOrganisationID, o.OrganisationID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkOrganisationID), "bigint[]")),
+	))
+
+	return Organisations.Query(append(mods,
+		sm.Where(psql.Group(Organisations.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// User starts a query for related objects on users
+func (o *OauthClient) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os OauthClientSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "bigint[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachOauthClientOrganisation0(ctx context.Context, exec bob.Executor, count int, oauthClient0 *OauthClient, organisation1 *Organisation) (*OauthClient, error) {
+	setter := &OauthClientSetter{
+		OrganisationID: omit.From(organisation1.ID),
+	}
+
+	err := oauthClient0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthClientOrganisation0: %w", err)
+	}
+
+	return oauthClient0, nil
+}
+
+func (oauthClient0 *OauthClient) InsertOrganisation(ctx context.Context, exec bob.Executor, related *OrganisationSetter) error {
+	var err error
+
+	organisation1, err := Organisations.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachOauthClientOrganisation0(ctx, exec, 1, oauthClient0, organisation1)
+	if err != nil {
+		return err
+	}
+
+	oauthClient0.R.Organisation = organisation1
+
+	organisation1.R.OauthClients = append(organisation1.R.OauthClients, oauthClient0)
+
+	return nil
+}
+
+func (oauthClient0 *OauthClient) AttachOrganisation(ctx context.Context, exec bob.Executor, organisation1 *Organisation) error {
+	var err error
+
+	_, err = attachOauthClientOrganisation0(ctx, exec, 1, oauthClient0, organisation1)
+	if err != nil {
+		return err
+	}
+
+	oauthClient0.R.Organisation = organisation1
+
+	organisation1.R.OauthClients = append(organisation1.R.OauthClients, oauthClient0)
+
+	return nil
+}
+
+func attachOauthClientUser0(ctx context.Context, exec bob.Executor, count int, oauthClient0 *OauthClient, user1 *User) (*OauthClient, error) {
+	setter := &OauthClientSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := oauthClient0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthClientUser0: %w", err)
+	}
+
+	return oauthClient0, nil
+}
+
+func (oauthClient0 *OauthClient) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachOauthClientUser0(ctx, exec, 1, oauthClient0, user1)
+	if err != nil {
+		return err
+	}
+
+	oauthClient0.R.User = user1
+
+	user1.R.OauthClients = append(user1.R.OauthClients, oauthClient0)
+
+	return nil
+}
+
+func (oauthClient0 *OauthClient) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachOauthClientUser0(ctx, exec, 1, oauthClient0, user1)
+	if err != nil {
+		return err
+	}
+
+	oauthClient0.R.User = user1
+
+	user1.R.OauthClients = append(user1.R.OauthClients, oauthClient0)
+
+	return nil
+}
+
+type oauthClientWhere[Q psql.Filterable] struct {
+	ID             psql.WhereMod[Q, int64]
+	OrganisationID psql.WhereMod[Q, int64]
+	UserID         psql.WhereMod[Q, int64]
+	Name           psql.WhereMod[Q, string]
+	ClientID       psql.WhereMod[Q, string]
+	SecretHash     psql.WhereMod[Q, string]
+	Scopes         psql.WhereMod[Q, pq.StringArray]
+	RevokedAt      psql.WhereNullMod[Q, time.Time]
+	CreatedAt      psql.WhereNullMod[Q, time.Time]
+	UpdatedAt      psql.WhereNullMod[Q, time.Time]
+}
+
+func (oauthClientWhere[Q]) AliasedAs(alias string) oauthClientWhere[Q] {
+	return buildOauthClientWhere[Q](buildOauthClientColumns(alias))
+}
+
+func buildOauthClientWhere[Q psql.Filterable](cols oauthClientColumns) oauthClientWhere[Q] {
+	return oauthClientWhere[Q]{
+		ID:             psql.Where[Q, int64](cols.ID),
+		OrganisationID: psql.Where[Q, int64](cols.OrganisationID),
+		UserID:         psql.Where[Q, int64](cols.UserID),
+		Name:           psql.Where[Q, string](cols.Name),
+		ClientID:       psql.Where[Q, string](cols.ClientID),
+		SecretHash:     psql.Where[Q, string](cols.SecretHash),
+		Scopes:         psql.Where[Q, pq.StringArray](cols.Scopes),
+		RevokedAt:      psql.WhereNull[Q, time.Time](cols.RevokedAt),
+		CreatedAt:      psql.WhereNull[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:      psql.WhereNull[Q, time.Time](cols.UpdatedAt),
+	}
+}
+
+func (o *OauthClient) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Organisation":
+		rel, ok := retrieved.(*Organisation)
+		if !ok {
+			return fmt.Errorf("oauthClient cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Organisation = rel
+
+		if rel != nil {
+			rel.R.OauthClients = OauthClientSlice{o}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("oauthClient cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.OauthClients = OauthClientSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("oauthClient has no relationship %q", name)
+	}
+}
+
+type oauthClientPreloader struct {
+	Organisation func(...psql.PreloadOption) psql.Preloader
+	User         func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildOauthClientPreloader() oauthClientPreloader {
+	return oauthClientPreloader{
+		Organisation: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Organisation, OrganisationSlice](psql.PreloadRel{
+				Name: "Organisation",
+				Sides: []psql.PreloadSide{
+					{
+						From:        OauthClients,
+						To:          Organisations,
+						FromColumns: []string{"organisation_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Organisations.Columns.Names(), opts...)
+		},
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        OauthClients,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type oauthClientThenLoader[Q orm.Loadable] struct {
+	Organisation func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildOauthClientThenLoader[Q orm.Loadable]() oauthClientThenLoader[Q] {
+	type OrganisationLoadInterface interface {
+		LoadOrganisation(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return oauthClientThenLoader[Q]{
+		Organisation: thenLoadBuilder[Q](
+			"Organisation",
+			func(ctx context.Context, exec bob.Executor, retrieved OrganisationLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadOrganisation(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadOrganisation loads the oauthClient's Organisation into the .R struct
+func (o *OauthClient) LoadOrganisation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Organisation = nil
+
+	related, err := o.Organisation(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.OauthClients = OauthClientSlice{o}
+
+	o.R.Organisation = related
+	return nil
+}
+
+// LoadOrganisation loads the oauthClient's Organisation into the .R struct
+func (os OauthClientSlice) LoadOrganisation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	organisations, err := os.Organisation(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range organisations {
+
+			if !(o.OrganisationID == rel.ID) {
+				continue
+			}
+
+			rel.R.OauthClients = append(rel.R.OauthClients, o)
+
+			o.R.Organisation = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the oauthClient's User into the .R struct
+func (o *OauthClient) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.OauthClients = OauthClientSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the oauthClient's User into the .R struct
+func (os OauthClientSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.OauthClients = append(rel.R.OauthClients, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type oauthClientJoins[Q dialect.Joinable] struct {
+	typ          string
+	Organisation modAs[Q, organisationColumns]
+	User         modAs[Q, userColumns]
+}
+
+func (j oauthClientJoins[Q]) aliasedAs(alias string) oauthClientJoins[Q] {
+	return buildOauthClientJoins[Q](buildOauthClientColumns(alias), j.typ)
+}
+
+func buildOauthClientJoins[Q dialect.Joinable](cols oauthClientColumns, typ string) oauthClientJoins[Q] {
+	return oauthClientJoins[Q]{
+		typ: typ,
+		Organisation: modAs[Q, organisationColumns]{
+			c: Organisations.Columns,
+			f: func(to organisationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Organisations.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.OrganisationID),
+					))
+				}
+
+				return mods
+			},
+		},
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,816 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// OrganisationMember is an object representing the database table.
+type OrganisationMember struct {
+	OrganisationID int64               `db:"organisation_id,pk" `
+	UserID         int64               `db:"user_id,pk" `
+	CreatedAt      null.Val[time.Time] `db:"created_at" `
+
+	R organisationMemberR `db:"-" `
+}
+
+// OrganisationMemberSlice is an alias for a slice of pointers to OrganisationMember.
+// This should almost always be used instead of []*OrganisationMember.
+type OrganisationMemberSlice []*OrganisationMember
+
+// OrganisationMembers contains methods to work with the organisation_members table
+var OrganisationMembers = psql.NewTablex[*OrganisationMember, OrganisationMemberSlice, *OrganisationMemberSetter]("", "organisation_members", buildOrganisationMemberColumns("organisation_members"))
+
+// OrganisationMembersQuery is a query on the organisation_members table
+type OrganisationMembersQuery = *psql.ViewQuery[*OrganisationMember, OrganisationMemberSlice]
+
+// organisationMemberR is where relationships are stored.
+type organisationMemberR struct {
+	Organisation *Organisation // organisation_members.organisation_members_organisation_id_fkey
+	User         *User         // organisation_members.organisation_members_user_id_fkey
+}
+
+func buildOrganisationMemberColumns(alias string) organisationMemberColumns {
+	return organisationMemberColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"organisation_id", "user_id", "created_at",
+		).WithParent("organisation_members"),
+		tableAlias:     alias,
+		OrganisationID: psql.Quote(alias, "organisation_id"),
+		UserID:         psql.Quote(alias, "user_id"),
+		CreatedAt:      psql.Quote(alias, "created_at"),
+	}
+}
+
+type organisationMemberColumns struct {
+	expr.ColumnsExpr
+	tableAlias     string
+	OrganisationID psql.Expression
+	UserID         psql.Expression
+	CreatedAt      psql.Expression
+}
+
+func (c organisationMemberColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (organisationMemberColumns) AliasedAs(alias string) organisationMemberColumns {
+	return buildOrganisationMemberColumns(alias)
+}
+
+// OrganisationMemberSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type OrganisationMemberSetter struct {
+	OrganisationID omit.Val[int64]         `db:"organisation_id,pk" `
+	UserID         omit.Val[int64]         `db:"user_id,pk" `
+	CreatedAt      omitnull.Val[time.Time] `db:"created_at" `
+}
+
+func (s OrganisationMemberSetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.OrganisationID.IsValue() {
+		vals = append(vals, "organisation_id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s OrganisationMemberSetter) Overwrite(t *OrganisationMember) {
+	if s.OrganisationID.IsValue() {
+		t.OrganisationID = s.OrganisationID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *OrganisationMemberSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return OrganisationMembers.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 3)
+		if s.OrganisationID.IsValue() {
+			vals[0] = psql.Arg(s.OrganisationID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserID.IsValue() {
+			vals[1] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[2] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s OrganisationMemberSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s OrganisationMemberSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.OrganisationID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "organisation_id")...),
+			psql.Arg(s.OrganisationID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindOrganisationMember retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindOrganisationMember(ctx context.Context, exec bob.Executor, OrganisationIDPK int64, UserIDPK int64, cols ...string) (*OrganisationMember, error) {
+	if len(cols) == 0 {
+		return OrganisationMembers.Query(
+			sm.Where(OrganisationMembers.Columns.OrganisationID.EQ(psql.Arg(OrganisationIDPK))),
+			sm.Where(OrganisationMembers.Columns.UserID.EQ(psql.Arg(UserIDPK))),
+		).One(ctx, exec)
+	}
+
+	return OrganisationMembers.Query(
+		sm.Where(OrganisationMembers.Columns.OrganisationID.EQ(psql.Arg(OrganisationIDPK))),
+		sm.Where(OrganisationMembers.Columns.UserID.EQ(psql.Arg(UserIDPK))),
+		sm.Columns(OrganisationMembers.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// OrganisationMemberExists checks the presence of a single record by primary key
+func OrganisationMemberExists(ctx context.Context, exec bob.Executor, OrganisationIDPK int64, UserIDPK int64) (bool, error) {
+	return OrganisationMembers.Query(
+		sm.Where(OrganisationMembers.Columns.OrganisationID.EQ(psql.Arg(OrganisationIDPK))),
+		sm.Where(OrganisationMembers.Columns.UserID.EQ(psql.Arg(UserIDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after OrganisationMember is retrieved from the database
+func (o *OrganisationMember) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OrganisationMembers.AfterSelectHooks.RunHooks(ctx, exec, OrganisationMemberSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = OrganisationMembers.AfterInsertHooks.RunHooks(ctx, exec, OrganisationMemberSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = OrganisationMembers.AfterUpdateHooks.RunHooks(ctx, exec, OrganisationMemberSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = OrganisationMembers.AfterDeleteHooks.RunHooks(ctx, exec, OrganisationMemberSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the OrganisationMember
+func (o *OrganisationMember) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.OrganisationID,
+		o.UserID,
+	)
+}
+
+func (o *OrganisationMember) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("organisation_members", "organisation_id"), psql.Quote("organisation_members", "user_id")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the OrganisationMember
+func (o *OrganisationMember) Update(ctx context.Context, exec bob.Executor, s *OrganisationMemberSetter) error {
+	v, err := OrganisationMembers.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single OrganisationMember record with an executor
+func (o *OrganisationMember) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := OrganisationMembers.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the OrganisationMember using the executor
+func (o *OrganisationMember) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := OrganisationMembers.Query(
+		sm.Where(OrganisationMembers.Columns.OrganisationID.EQ(psql.Arg(o.OrganisationID))),
+		sm.Where(OrganisationMembers.Columns.UserID.EQ(psql.Arg(o.UserID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after OrganisationMemberSlice is retrieved from the database
+func (o OrganisationMemberSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OrganisationMembers.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = OrganisationMembers.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = OrganisationMembers.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = OrganisationMembers.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o OrganisationMemberSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("organisation_members", "organisation_id"), psql.Quote("organisation_members", "user_id")).In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o OrganisationMemberSlice) copyMatchingRows(from ...*OrganisationMember) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.OrganisationID != old.OrganisationID {
+				continue
+			}
+			if new.UserID != old.UserID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o OrganisationMemberSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OrganisationMembers.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OrganisationMember:
+				o.copyMatchingRows(retrieved)
+			case []*OrganisationMember:
+				o.copyMatchingRows(retrieved...)
+			case OrganisationMemberSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OrganisationMember or a slice of OrganisationMember
+				// then run the AfterUpdateHooks on the slice
+				_, err = OrganisationMembers.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o OrganisationMemberSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OrganisationMembers.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OrganisationMember:
+				o.copyMatchingRows(retrieved)
+			case []*OrganisationMember:
+				o.copyMatchingRows(retrieved...)
+			case OrganisationMemberSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OrganisationMember or a slice of OrganisationMember
+				// then run the AfterDeleteHooks on the slice
+				_, err = OrganisationMembers.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o OrganisationMemberSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals OrganisationMemberSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OrganisationMembers.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o OrganisationMemberSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OrganisationMembers.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o OrganisationMemberSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := OrganisationMembers.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Organisation starts a query for related objects on organisations
+func (o *OrganisationMember) Organisation(mods ...bob.Mod[*dialect.SelectQuery]) OrganisationsQuery {
+	return Organisations.Query(append(mods,
+		sm.Where(Organisations.Columns.ID.EQ(psql.Arg(o.OrganisationID))),
+	)...)
+}
+
+func (os OrganisationMemberSlice) Organisation(mods ...bob.Mod[*dialect.SelectQuery]) OrganisationsQuery {
+	pkOrganisationID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkOrganisationID = append(pkOrganisationID, o.OrganisationID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkOrganisationID), "bigint[]")),
+	))
+
+	return Organisations.Query(append(mods,
+		sm.Where(psql.Group(Organisations.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// User starts a query for related objects on users
+func (o *OrganisationMember) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os OrganisationMemberSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "bigint[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachOrganisationMemberOrganisation0(ctx context.Context, exec bob.Executor, count int, organisationMember0 *OrganisationMember, organisation1 *Organisation) (*OrganisationMember, error) {
+	setter := &OrganisationMemberSetter{
+		OrganisationID: omit.From(organisation1.ID),
+	}
+
+	err := organisationMember0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOrganisationMemberOrganisation0: %w", err)
+	}
+
+	return organisationMember0, nil
+}
+
+func (organisationMember0 *OrganisationMember) InsertOrganisation(ctx context.Context, exec bob.Executor, related *OrganisationSetter) error {
+	var err error
+
+	organisation1, err := Organisations.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachOrganisationMemberOrganisation0(ctx, exec, 1, organisationMember0, organisation1)
+	if err != nil {
+		return err
+	}
+
+	organisationMember0.R.Organisation = organisation1
+
+	organisation1.R.OrganisationMembers = append(organisation1.R.OrganisationMembers, organisationMember0)
+
+	return nil
+}
+
+func (organisationMember0 *OrganisationMember) AttachOrganisation(ctx context.Context, exec bob.Executor, organisation1 *Organisation) error {
+	var err error
+
+	_, err = attachOrganisationMemberOrganisation0(ctx, exec, 1, organisationMember0, organisation1)
+	if err != nil {
+		return err
+	}
+
+	organisationMember0.R.Organisation = organisation1
+
+	organisation1.R.OrganisationMembers = append(organisation1.R.OrganisationMembers, organisationMember0)
+
+	return nil
+}
+
+func attachOrganisationMemberUser0(ctx context.Context, exec bob.Executor, count int, organisationMember0 *OrganisationMember, user1 *User) (*OrganisationMember, error) {
+	setter := &OrganisationMemberSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := organisationMember0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOrganisationMemberUser0: %w", err)
+	}
+
+	return organisationMember0, nil
+}
+
+func (organisationMember0 *OrganisationMember) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachOrganisationMemberUser0(ctx, exec, 1, organisationMember0, user1)
+	if err != nil {
+		return err
+	}
+
+	organisationMember0.R.User = user1
+
+	user1.R.OrganisationMembers = append(user1.R.OrganisationMembers, organisationMember0)
+
+	return nil
+}
+
+func (organisationMember0 *OrganisationMember) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachOrganisationMemberUser0(ctx, exec, 1, organisationMember0, user1)
+	if err != nil {
+		return err
+	}
+
+	organisationMember0.R.User = user1
+
+	user1.R.OrganisationMembers = append(user1.R.OrganisationMembers, organisationMember0)
+
+	return nil
+}
+
+type organisationMemberWhere[Q psql.Filterable] struct {
+	OrganisationID psql.WhereMod[Q, int64]
+	UserID         psql.WhereMod[Q, int64]
+	CreatedAt      psql.WhereNullMod[Q, time.Time]
+}
+
+func (organisationMemberWhere[Q]) AliasedAs(alias string) organisationMemberWhere[Q] {
+	return buildOrganisationMemberWhere[Q](buildOrganisationMemberColumns(alias))
+}
+
+func buildOrganisationMemberWhere[Q psql.Filterable](cols organisationMemberColumns) organisationMemberWhere[Q] {
+	return organisationMemberWhere[Q]{
+		OrganisationID: psql.Where[Q, int64](cols.OrganisationID),
+		UserID:         psql.Where[Q, int64](cols.UserID),
+		CreatedAt:      psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *OrganisationMember) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Organisation":
+		rel, ok := retrieved.(*Organisation)
+		if !ok {
+			return fmt.Errorf("organisationMember cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Organisation = rel
+
+		if rel != nil {
+			rel.R.OrganisationMembers = OrganisationMemberSlice{o}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("organisationMember cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.OrganisationMembers = OrganisationMemberSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("organisationMember has no relationship %q", name)
+	}
+}
+
+type organisationMemberPreloader struct {
+	Organisation func(...psql.PreloadOption) psql.Preloader
+	User         func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildOrganisationMemberPreloader() organisationMemberPreloader {
+	return organisationMemberPreloader{
+		Organisation: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Organisation, OrganisationSlice](psql.PreloadRel{
+				Name: "Organisation",
+				Sides: []psql.PreloadSide{
+					{
+						From:        OrganisationMembers,
+						To:          Organisations,
+						FromColumns: []string{"organisation_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Organisations.Columns.Names(), opts...)
+		},
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        OrganisationMembers,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type organisationMemberThenLoader[Q orm.Loadable] struct {
+	Organisation func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildOrganisationMemberThenLoader[Q orm.Loadable]() organisationMemberThenLoader[Q] {
+	type OrganisationLoadInterface interface {
+		LoadOrganisation(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return organisationMemberThenLoader[Q]{
+		Organisation: thenLoadBuilder[Q](
+			"Organisation",
+			func(ctx context.Context, exec bob.Executor, retrieved OrganisationLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadOrganisation(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadOrganisation loads the organisationMember's Organisation into the .R struct
+func (o *OrganisationMember) LoadOrganisation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Organisation = nil
+
+	related, err := o.Organisation(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.OrganisationMembers = OrganisationMemberSlice{o}
+
+	o.R.Organisation = related
+	return nil
+}
+
+// LoadOrganisation loads the organisationMember's Organisation into the .R struct
+func (os OrganisationMemberSlice) LoadOrganisation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	organisations, err := os.Organisation(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range organisations {
+
+			if !(o.OrganisationID == rel.ID) {
+				continue
+			}
+
+			rel.R.OrganisationMembers = append(rel.R.OrganisationMembers, o)
+
+			o.R.Organisation = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the organisationMember's User into the .R struct
+func (o *OrganisationMember) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.OrganisationMembers = OrganisationMemberSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the organisationMember's User into the .R struct
+func (os OrganisationMemberSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.OrganisationMembers = append(rel.R.OrganisationMembers, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type organisationMemberJoins[Q dialect.Joinable] struct {
+	typ          string
+	Organisation modAs[Q, organisationColumns]
+	User         modAs[Q, userColumns]
+}
+
+func (j organisationMemberJoins[Q]) aliasedAs(alias string) organisationMemberJoins[Q] {
+	return buildOrganisationMemberJoins[Q](buildOrganisationMemberColumns(alias), j.typ)
+}
+
+func buildOrganisationMemberJoins[Q dialect.Joinable](cols organisationMemberColumns, typ string) organisationMemberJoins[Q] {
+	return organisationMemberJoins[Q]{
+		typ: typ,
+		Organisation: modAs[Q, organisationColumns]{
+			c: Organisations.Columns,
+			f: func(to organisationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Organisations.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.OrganisationID),
+					))
+				}
+
+				return mods
+			},
+		},
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,1433 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Organisation is an object representing the database table.
+type Organisation struct {
+	ID        int64               `db:"id,pk" `
+	Name      string              `db:"name" `
+	CreatedAt null.Val[time.Time] `db:"created_at" `
+	UpdatedAt null.Val[time.Time] `db:"updated_at" `
+
+	R organisationR `db:"-" `
+}
+
+// OrganisationSlice is an alias for a slice of pointers to Organisation.
+// This should almost always be used instead of []*Organisation.
+type OrganisationSlice []*Organisation
+
+// Organisations contains methods to work with the organisations table
+var Organisations = psql.NewTablex[*Organisation, OrganisationSlice, *OrganisationSetter]("", "organisations", buildOrganisationColumns("organisations"))
+
+// OrganisationsQuery is a query on the organisations table
+type OrganisationsQuery = *psql.ViewQuery[*Organisation, OrganisationSlice]
+
+// organisationR is where relationships are stored.
+type organisationR struct {
+	APIKeys             APIKeySlice             // api_keys.api_keys_organisation_id_fkey
+	Invoices            InvoiceSlice            // invoices.invoices_organisation_id_fkey
+	OauthClients        OauthClientSlice        // oauth_clients.oauth_clients_organisation_id_fkey
+	OrganisationMembers OrganisationMemberSlice // organisation_members.organisation_members_organisation_id_fkey
+	TaxpayerProfile     *TaxpayerProfile        // taxpayer_profiles.taxpayer_profiles_organisation_id_fkey
+}
+
+func buildOrganisationColumns(alias string) organisationColumns {
+	return organisationColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "name", "created_at", "updated_at",
+		).WithParent("organisations"),
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		Name:       psql.Quote(alias, "name"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+		UpdatedAt:  psql.Quote(alias, "updated_at"),
+	}
+}
+
+type organisationColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         psql.Expression
+	Name       psql.Expression
+	CreatedAt  psql.Expression
+	UpdatedAt  psql.Expression
+}
+
+func (c organisationColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (organisationColumns) AliasedAs(alias string) organisationColumns {
+	return buildOrganisationColumns(alias)
+}
+
+// OrganisationSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type OrganisationSetter struct {
+	ID        omit.Val[int64]         `db:"id,pk" `
+	Name      omit.Val[string]        `db:"name" `
+	CreatedAt omitnull.Val[time.Time] `db:"created_at" `
+	UpdatedAt omitnull.Val[time.Time] `db:"updated_at" `
+}
+
+func (s OrganisationSetter) SetColumns() []string {
+	vals := make([]string, 0, 4)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	if !s.UpdatedAt.IsUnset() {
+		vals = append(vals, "updated_at")
+	}
+	return vals
+}
+
+func (s OrganisationSetter) Overwrite(t *Organisation) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+	if !s.UpdatedAt.IsUnset() {
+		t.UpdatedAt = s.UpdatedAt.MustGetNull()
+	}
+}
+
+func (s *OrganisationSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Organisations.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 4)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Name.IsValue() {
+			vals[1] = psql.Arg(s.Name.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[2] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UpdatedAt.IsUnset() {
+			vals[3] = psql.Arg(s.UpdatedAt.MustGetNull())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s OrganisationSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s OrganisationSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 4)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "name")...),
+			psql.Arg(s.Name),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	if !s.UpdatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "updated_at")...),
+			psql.Arg(s.UpdatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindOrganisation retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindOrganisation(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*Organisation, error) {
+	if len(cols) == 0 {
+		return Organisations.Query(
+			sm.Where(Organisations.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Organisations.Query(
+		sm.Where(Organisations.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(Organisations.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// OrganisationExists checks the presence of a single record by primary key
+func OrganisationExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return Organisations.Query(
+		sm.Where(Organisations.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Organisation is retrieved from the database
+func (o *Organisation) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Organisations.AfterSelectHooks.RunHooks(ctx, exec, OrganisationSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Organisations.AfterInsertHooks.RunHooks(ctx, exec, OrganisationSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Organisations.AfterUpdateHooks.RunHooks(ctx, exec, OrganisationSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Organisations.AfterDeleteHooks.RunHooks(ctx, exec, OrganisationSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Organisation
+func (o *Organisation) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Organisation) pkEQ() dialect.Expression {
+	return psql.Quote("organisations", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Organisation
+func (o *Organisation) Update(ctx context.Context, exec bob.Executor, s *OrganisationSetter) error {
+	v, err := Organisations.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Organisation record with an executor
+func (o *Organisation) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Organisations.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Organisation using the executor
+func (o *Organisation) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Organisations.Query(
+		sm.Where(Organisations.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after OrganisationSlice is retrieved from the database
+func (o OrganisationSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Organisations.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Organisations.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Organisations.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Organisations.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o OrganisationSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("organisations", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o OrganisationSlice) copyMatchingRows(from ...*Organisation) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o OrganisationSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Organisations.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Organisation:
+				o.copyMatchingRows(retrieved)
+			case []*Organisation:
+				o.copyMatchingRows(retrieved...)
+			case OrganisationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Organisation or a slice of Organisation
+				// then run the AfterUpdateHooks on the slice
+				_, err = Organisations.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o OrganisationSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Organisations.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Organisation:
+				o.copyMatchingRows(retrieved)
+			case []*Organisation:
+				o.copyMatchingRows(retrieved...)
+			case OrganisationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Organisation or a slice of Organisation
+				// then run the AfterDeleteHooks on the slice
+				_, err = Organisations.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o OrganisationSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals OrganisationSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Organisations.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o OrganisationSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Organisations.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o OrganisationSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Organisations.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// APIKeys starts a query for related objects on api_keys
+func (o *Organisation) APIKeys(mods ...bob.Mod[*dialect.SelectQuery]) APIKeysQuery {
+	return APIKeys.Query(append(mods,
+		sm.Where(APIKeys.Columns.OrganisationID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os OrganisationSlice) APIKeys(mods ...bob.Mod[*dialect.SelectQuery]) APIKeysQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return APIKeys.Query(append(mods,
+		sm.Where(psql.Group(APIKeys.Columns.OrganisationID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Invoices starts a query for related objects on invoices
+func (o *Organisation) Invoices(mods ...bob.Mod[*dialect.SelectQuery]) InvoicesQuery {
+	return Invoices.Query(append(mods,
+		sm.Where(Invoices.Columns.OrganisationID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os OrganisationSlice) Invoices(mods ...bob.Mod[*dialect.SelectQuery]) InvoicesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Invoices.Query(append(mods,
+		sm.Where(psql.Group(Invoices.Columns.OrganisationID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// OauthClients starts a query for related objects on oauth_clients
+func (o *Organisation) OauthClients(mods ...bob.Mod[*dialect.SelectQuery]) OauthClientsQuery {
+	return OauthClients.Query(append(mods,
+		sm.Where(OauthClients.Columns.OrganisationID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os OrganisationSlice) OauthClients(mods ...bob.Mod[*dialect.SelectQuery]) OauthClientsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return OauthClients.Query(append(mods,
+		sm.Where(psql.Group(OauthClients.Columns.OrganisationID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// OrganisationMembers starts a query for related objects on organisation_members
+func (o *Organisation) OrganisationMembers(mods ...bob.Mod[*dialect.SelectQuery]) OrganisationMembersQuery {
+	return OrganisationMembers.Query(append(mods,
+		sm.Where(OrganisationMembers.Columns.OrganisationID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os OrganisationSlice) OrganisationMembers(mods ...bob.Mod[*dialect.SelectQuery]) OrganisationMembersQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return OrganisationMembers.Query(append(mods,
+		sm.Where(psql.Group(OrganisationMembers.Columns.OrganisationID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TaxpayerProfile starts a query for related objects on taxpayer_profiles
+func (o *Organisation) TaxpayerProfile(mods ...bob.Mod[*dialect.SelectQuery]) TaxpayerProfilesQuery {
+	return TaxpayerProfiles.Query(append(mods,
+		sm.Where(TaxpayerProfiles.Columns.OrganisationID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os OrganisationSlice) TaxpayerProfile(mods ...bob.Mod[*dialect.SelectQuery]) TaxpayerProfilesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return TaxpayerProfiles.Query(append(mods,
+		sm.Where(psql.Group(TaxpayerProfiles.Columns.OrganisationID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertOrganisationAPIKeys0(ctx context.Context, exec bob.Executor, apiKeys1 []*APIKeySetter, organisation0 *Organisation) (APIKeySlice, error) {
+	for i := range apiKeys1 {
+		apiKeys1[i].OrganisationID = omit.From(organisation0.ID)
+	}
+
+	ret, err := APIKeys.Insert(bob.ToMods(apiKeys1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertOrganisationAPIKeys0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachOrganisationAPIKeys0(ctx context.Context, exec bob.Executor, count int, apiKeys1 APIKeySlice, organisation0 *Organisation) (APIKeySlice, error) {
+	setter := &APIKeySetter{
+		OrganisationID: omit.From(organisation0.ID),
+	}
+
+	err := apiKeys1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOrganisationAPIKeys0: %w", err)
+	}
+
+	return apiKeys1, nil
+}
+
+func (organisation0 *Organisation) InsertAPIKeys(ctx context.Context, exec bob.Executor, related ...*APIKeySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	apiKeys1, err := insertOrganisationAPIKeys0(ctx, exec, related, organisation0)
+	if err != nil {
+		return err
+	}
+
+	organisation0.R.APIKeys = append(organisation0.R.APIKeys, apiKeys1...)
+
+	for _, rel := range apiKeys1 {
+		rel.R.Organisation = organisation0
+	}
+	return nil
+}
+
+func (organisation0 *Organisation) AttachAPIKeys(ctx context.Context, exec bob.Executor, related ...*APIKey) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	apiKeys1 := APIKeySlice(related)
+
+	_, err = attachOrganisationAPIKeys0(ctx, exec, len(related), apiKeys1, organisation0)
+	if err != nil {
+		return err
+	}
+
+	organisation0.R.APIKeys = append(organisation0.R.APIKeys, apiKeys1...)
+
+	for _, rel := range related {
+		rel.R.Organisation = organisation0
+	}
+
+	return nil
+}
+
+func insertOrganisationInvoices0(ctx context.Context, exec bob.Executor, invoices1 []*InvoiceSetter, organisation0 *Organisation) (InvoiceSlice, error) {
+	for i := range invoices1 {
+		invoices1[i].OrganisationID = omit.From(organisation0.ID)
+	}
+
+	ret, err := Invoices.Insert(bob.ToMods(invoices1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertOrganisationInvoices0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachOrganisationInvoices0(ctx context.Context, exec bob.Executor, count int, invoices1 InvoiceSlice, organisation0 *Organisation) (InvoiceSlice, error) {
+	setter := &InvoiceSetter{
+		OrganisationID: omit.From(organisation0.ID),
+	}
+
+	err := invoices1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOrganisationInvoices0: %w", err)
+	}
+
+	return invoices1, nil
+}
+
+func (organisation0 *Organisation) InsertInvoices(ctx context.Context, exec bob.Executor, related ...*InvoiceSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	invoices1, err := insertOrganisationInvoices0(ctx, exec, related, organisation0)
+	if err != nil {
+		return err
+	}
+
+	organisation0.R.Invoices = append(organisation0.R.Invoices, invoices1...)
+
+	for _, rel := range invoices1 {
+		rel.R.Organisation = organisation0
+	}
+	return nil
+}
+
+func (organisation0 *Organisation) AttachInvoices(ctx context.Context, exec bob.Executor, related ...*Invoice) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	invoices1 := InvoiceSlice(related)
+
+	_, err = attachOrganisationInvoices0(ctx, exec, len(related), invoices1, organisation0)
+	if err != nil {
+		return err
+	}
+
+	organisation0.R.Invoices = append(organisation0.R.Invoices, invoices1...)
+
+	for _, rel := range related {
+		rel.R.Organisation = organisation0
+	}
+
+	return nil
+}
+
+func insertOrganisationOauthClients0(ctx context.Context, exec bob.Executor, oauthClients1 []*OauthClientSetter, organisation0 *Organisation) (OauthClientSlice, error) {
+	for i := range oauthClients1 {
+		oauthClients1[i].OrganisationID = omit.From(organisation0.ID)
+	}
+
+	ret, err := OauthClients.Insert(bob.ToMods(oauthClients1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertOrganisationOauthClients0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachOrganisationOauthClients0(ctx context.Context, exec bob.Executor, count int, oauthClients1 OauthClientSlice, organisation0 *Organisation) (OauthClientSlice, error) {
+	setter := &OauthClientSetter{
+		OrganisationID: omit.From(organisation0.ID),
+	}
+
+	err := oauthClients1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOrganisationOauthClients0: %w", err)
+	}
+
+	return oauthClients1, nil
+}
+
+func (organisation0 *Organisation) InsertOauthClients(ctx context.Context, exec bob.Executor, related ...*OauthClientSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	oauthClients1, err := insertOrganisationOauthClients0(ctx, exec, related, organisation0)
+	if err != nil {
+		return err
+	}
+
+	organisation0.R.OauthClients = append(organisation0.R.OauthClients, oauthClients1...)
+
+	for _, rel := range oauthClients1 {
+		rel.R.Organisation = organisation0
+	}
+	return nil
+}
+
+func (organisation0 *Organisation) AttachOauthClients(ctx context.Context, exec bob.Executor, related ...*OauthClient) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	oauthClients1 := OauthClientSlice(related)
+
+	_, err = attachOrganisationOauthClients0(ctx, exec, len(related), oauthClients1, organisation0)
+	if err != nil {
+		return err
+	}
+
+	organisation0.R.OauthClients = append(organisation0.R.OauthClients, oauthClients1...)
+
+	for _, rel := range related {
+		rel.R.Organisation = organisation0
+	}
+
+	return nil
+}
+
+func insertOrganisationOrganisationMembers0(ctx context.Context, exec bob.Executor, organisationMembers1 []*OrganisationMemberSetter, organisation0 *Organisation) (OrganisationMemberSlice, error) {
+	for i := range organisationMembers1 {
+		organisationMembers1[i].OrganisationID = omit.From(organisation0.ID)
+	}
+
+	ret, err := OrganisationMembers.Insert(bob.ToMods(organisationMembers1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertOrganisationOrganisationMembers0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachOrganisationOrganisationMembers0(ctx context.Context, exec bob.Executor, count int, organisationMembers1 OrganisationMemberSlice, organisation0 *Organisation) (OrganisationMemberSlice, error) {
+	setter := &OrganisationMemberSetter{
+		OrganisationID: omit.From(organisation0.ID),
+	}
+
+	err := organisationMembers1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOrganisationOrganisationMembers0: %w", err)
+	}
+
+	return organisationMembers1, nil
+}
+
+func (organisation0 *Organisation) InsertOrganisationMembers(ctx context.Context, exec bob.Executor, related ...*OrganisationMemberSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	organisationMembers1, err := insertOrganisationOrganisationMembers0(ctx, exec, related, organisation0)
+	if err != nil {
+		return err
+	}
+
+	organisation0.R.OrganisationMembers = append(organisation0.R.OrganisationMembers, organisationMembers1...)
+
+	for _, rel := range organisationMembers1 {
+		rel.R.Organisation = organisation0
+	}
+	return nil
+}
+
+func (organisation0 *Organisation) AttachOrganisationMembers(ctx context.Context, exec bob.Executor, related ...*OrganisationMember) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	organisationMembers1 := OrganisationMemberSlice(related)
+
+	_, err = attachOrganisationOrganisationMembers0(ctx, exec, len(related), organisationMembers1, organisation0)
+	if err != nil {
+		return err
+	}
+
+	organisation0.R.OrganisationMembers = append(organisation0.R.OrganisationMembers, organisationMembers1...)
+
+	for _, rel := range related {
+		rel.R.Organisation = organisation0
+	}
+
+	return nil
+}
+
+func insertOrganisationTaxpayerProfile0(ctx context.Context, exec bob.Executor, taxpayerProfile1 *TaxpayerProfileSetter, organisation0 *Organisation) (*TaxpayerProfile, error) {
+	taxpayerProfile1.OrganisationID = omit.From(organisation0.ID)
+
+	ret, err := TaxpayerProfiles.Insert(taxpayerProfile1).One(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertOrganisationTaxpayerProfile0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachOrganisationTaxpayerProfile0(ctx context.Context, exec bob.Executor, count int, taxpayerProfile1 *TaxpayerProfile, organisation0 *Organisation) (*TaxpayerProfile, error) {
+	setter := &TaxpayerProfileSetter{
+		OrganisationID: omit.From(organisation0.ID),
+	}
+
+	err := taxpayerProfile1.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOrganisationTaxpayerProfile0: %w", err)
+	}
+
+	return taxpayerProfile1, nil
+}
+
+func (organisation0 *Organisation) InsertTaxpayerProfile(ctx context.Context, exec bob.Executor, related *TaxpayerProfileSetter) error {
+	var err error
+
+	taxpayerProfile1, err := insertOrganisationTaxpayerProfile0(ctx, exec, related, organisation0)
+	if err != nil {
+		return err
+	}
+
+	organisation0.R.TaxpayerProfile = taxpayerProfile1
+
+	taxpayerProfile1.R.Organisation = organisation0
+
+	return nil
+}
+
+func (organisation0 *Organisation) AttachTaxpayerProfile(ctx context.Context, exec bob.Executor, taxpayerProfile1 *TaxpayerProfile) error {
+	var err error
+
+	_, err = attachOrganisationTaxpayerProfile0(ctx, exec, 1, taxpayerProfile1, organisation0)
+	if err != nil {
+		return err
+	}
+
+	organisation0.R.TaxpayerProfile = taxpayerProfile1
+
+	taxpayerProfile1.R.Organisation = organisation0
+
+	return nil
+}
+
+type organisationWhere[Q psql.Filterable] struct {
+	ID        psql.WhereMod[Q, int64]
+	Name      psql.WhereMod[Q, string]
+	CreatedAt psql.WhereNullMod[Q, time.Time]
+	UpdatedAt psql.WhereNullMod[Q, time.Time]
+}
+
+func (organisationWhere[Q]) AliasedAs(alias string) organisationWhere[Q] {
+	return buildOrganisationWhere[Q](buildOrganisationColumns(alias))
+}
+
+func buildOrganisationWhere[Q psql.Filterable](cols organisationColumns) organisationWhere[Q] {
+	return organisationWhere[Q]{
+		ID:        psql.Where[Q, int64](cols.ID),
+		Name:      psql.Where[Q, string](cols.Name),
+		CreatedAt: psql.WhereNull[Q, time.Time](cols.CreatedAt),
+		UpdatedAt: psql.WhereNull[Q, time.Time](cols.UpdatedAt),
+	}
+}
+
+func (o *Organisation) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "APIKeys":
+		rels, ok := retrieved.(APIKeySlice)
+		if !ok {
+			return fmt.Errorf("organisation cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.APIKeys = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Organisation = o
+			}
+		}
+		return nil
+	case "Invoices":
+		rels, ok := retrieved.(InvoiceSlice)
+		if !ok {
+			return fmt.Errorf("organisation cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Invoices = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Organisation = o
+			}
+		}
+		return nil
+	case "OauthClients":
+		rels, ok := retrieved.(OauthClientSlice)
+		if !ok {
+			return fmt.Errorf("organisation cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.OauthClients = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Organisation = o
+			}
+		}
+		return nil
+	case "OrganisationMembers":
+		rels, ok := retrieved.(OrganisationMemberSlice)
+		if !ok {
+			return fmt.Errorf("organisation cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.OrganisationMembers = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Organisation = o
+			}
+		}
+		return nil
+	case "TaxpayerProfile":
+		rel, ok := retrieved.(*TaxpayerProfile)
+		if !ok {
+			return fmt.Errorf("organisation cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TaxpayerProfile = rel
+
+		if rel != nil {
+			rel.R.Organisation = o
+		}
+		return nil
+	default:
+		return fmt.Errorf("organisation has no relationship %q", name)
+	}
+}
+
+type organisationPreloader struct {
+	TaxpayerProfile func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildOrganisationPreloader() organisationPreloader {
+	return organisationPreloader{
+		TaxpayerProfile: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*TaxpayerProfile, TaxpayerProfileSlice](psql.PreloadRel{
+				Name: "TaxpayerProfile",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Organisations,
+						To:          TaxpayerProfiles,
+						FromColumns: []string{"id"},
+						ToColumns:   []string{"organisation_id"},
+					},
+				},
+			}, TaxpayerProfiles.Columns.Names(), opts...)
+		},
+	}
+}
+
+type organisationThenLoader[Q orm.Loadable] struct {
+	APIKeys             func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Invoices            func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	OauthClients        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	OrganisationMembers func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TaxpayerProfile     func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildOrganisationThenLoader[Q orm.Loadable]() organisationThenLoader[Q] {
+	type APIKeysLoadInterface interface {
+		LoadAPIKeys(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type InvoicesLoadInterface interface {
+		LoadInvoices(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type OauthClientsLoadInterface interface {
+		LoadOauthClients(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type OrganisationMembersLoadInterface interface {
+		LoadOrganisationMembers(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TaxpayerProfileLoadInterface interface {
+		LoadTaxpayerProfile(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return organisationThenLoader[Q]{
+		APIKeys: thenLoadBuilder[Q](
+			"APIKeys",
+			func(ctx context.Context, exec bob.Executor, retrieved APIKeysLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAPIKeys(ctx, exec, mods...)
+			},
+		),
+		Invoices: thenLoadBuilder[Q](
+			"Invoices",
+			func(ctx context.Context, exec bob.Executor, retrieved InvoicesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadInvoices(ctx, exec, mods...)
+			},
+		),
+		OauthClients: thenLoadBuilder[Q](
+			"OauthClients",
+			func(ctx context.Context, exec bob.Executor, retrieved OauthClientsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadOauthClients(ctx, exec, mods...)
+			},
+		),
+		OrganisationMembers: thenLoadBuilder[Q](
+			"OrganisationMembers",
+			func(ctx context.Context, exec bob.Executor, retrieved OrganisationMembersLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadOrganisationMembers(ctx, exec, mods...)
+			},
+		),
+		TaxpayerProfile: thenLoadBuilder[Q](
+			"TaxpayerProfile",
+			func(ctx context.Context, exec bob.Executor, retrieved TaxpayerProfileLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTaxpayerProfile(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadAPIKeys loads the organisation's APIKeys into the .R struct
+func (o *Organisation) LoadAPIKeys(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.APIKeys = nil
+
+	related, err := o.APIKeys(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Organisation = o
+	}
+
+	o.R.APIKeys = related
+	return nil
+}
+
+// LoadAPIKeys loads the organisation's APIKeys into the .R struct
+func (os OrganisationSlice) LoadAPIKeys(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	apiKeys, err := os.APIKeys(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.APIKeys = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range apiKeys {
+
+			if !(o.ID == rel.OrganisationID) {
+				continue
+			}
+
+			rel.R.Organisation = o
+
+			o.R.APIKeys = append(o.R.APIKeys, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadInvoices loads the organisation's Invoices into the .R struct
+func (o *Organisation) LoadInvoices(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Invoices = nil
+
+	related, err := o.Invoices(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Organisation = o
+	}
+
+	o.R.Invoices = related
+	return nil
+}
+
+// LoadInvoices loads the organisation's Invoices into the .R struct
+func (os OrganisationSlice) LoadInvoices(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invoices, err := os.Invoices(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Invoices = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invoices {
+
+			if !(o.ID == rel.OrganisationID) {
+				continue
+			}
+
+			rel.R.Organisation = o
+
+			o.R.Invoices = append(o.R.Invoices, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadOauthClients loads the organisation's OauthClients into the .R struct
+func (o *Organisation) LoadOauthClients(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.OauthClients = nil
+
+	related, err := o.OauthClients(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Organisation = o
+	}
+
+	o.R.OauthClients = related
+	return nil
+}
+
+// LoadOauthClients loads the organisation's OauthClients into the .R struct
+func (os OrganisationSlice) LoadOauthClients(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oauthClients, err := os.OauthClients(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.OauthClients = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range oauthClients {
+
+			if !(o.ID == rel.OrganisationID) {
+				continue
+			}
+
+			rel.R.Organisation = o
+
+			o.R.OauthClients = append(o.R.OauthClients, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadOrganisationMembers loads the organisation's OrganisationMembers into the .R struct
+func (o *Organisation) LoadOrganisationMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.OrganisationMembers = nil
+
+	related, err := o.OrganisationMembers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Organisation = o
+	}
+
+	o.R.OrganisationMembers = related
+	return nil
+}
+
+// LoadOrganisationMembers loads the organisation's OrganisationMembers into the .R struct
+func (os OrganisationSlice) LoadOrganisationMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	organisationMembers, err := os.OrganisationMembers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.OrganisationMembers = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range organisationMembers {
+
+			if !(o.ID == rel.OrganisationID) {
+				continue
+			}
+
+			rel.R.Organisation = o
+
+			o.R.OrganisationMembers = append(o.R.OrganisationMembers, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadTaxpayerProfile loads the organisation's TaxpayerProfile into the .R struct
+func (o *Organisation) LoadTaxpayerProfile(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.TaxpayerProfile = nil
+
+	related, err := o.TaxpayerProfile(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Organisation = o
+
+	o.R.TaxpayerProfile = related
+	return nil
+}
+
+// LoadTaxpayerProfile loads the organisation's TaxpayerProfile into the .R struct
+func (os OrganisationSlice) LoadTaxpayerProfile(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	taxpayerProfiles, err := os.TaxpayerProfile(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range taxpayerProfiles {
+
+			if !(o.ID == rel.OrganisationID) {
+				continue
+			}
+
+			rel.R.Organisation = o
+
+			o.R.TaxpayerProfile = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type organisationJoins[Q dialect.Joinable] struct {
+	typ                 string
+	APIKeys             modAs[Q, apiKeyColumns]
+	Invoices            modAs[Q, invoiceColumns]
+	OauthClients        modAs[Q, oauthClientColumns]
+	OrganisationMembers modAs[Q, organisationMemberColumns]
+	TaxpayerProfile     modAs[Q, taxpayerProfileColumns]
+}
+
+func (j organisationJoins[Q]) aliasedAs(alias string) organisationJoins[Q] {
+	return buildOrganisationJoins[Q](buildOrganisationColumns(alias), j.typ)
+}
+
+func buildOrganisationJoins[Q dialect.Joinable](cols organisationColumns, typ string) organisationJoins[Q] {
+	return organisationJoins[Q]{
+		typ: typ,
+		APIKeys: modAs[Q, apiKeyColumns]{
+			c: APIKeys.Columns,
+			f: func(to apiKeyColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, APIKeys.Name().As(to.Alias())).On(
+						to.OrganisationID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Invoices: modAs[Q, invoiceColumns]{
+			c: Invoices.Columns,
+			f: func(to invoiceColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Invoices.Name().As(to.Alias())).On(
+						to.OrganisationID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		OauthClients: modAs[Q, oauthClientColumns]{
+			c: OauthClients.Columns,
+			f: func(to oauthClientColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthClients.Name().As(to.Alias())).On(
+						to.OrganisationID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		OrganisationMembers: modAs[Q, organisationMemberColumns]{
+			c: OrganisationMembers.Columns,
+			f: func(to organisationMemberColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OrganisationMembers.Name().As(to.Alias())).On(
+						to.OrganisationID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		TaxpayerProfile: modAs[Q, taxpayerProfileColumns]{
+			c: TaxpayerProfiles.Columns,
+			f: func(to taxpayerProfileColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TaxpayerProfiles.Name().As(to.Alias())).On(
+						to.OrganisationID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
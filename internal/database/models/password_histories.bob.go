@@ -0,0 +1,655 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// PasswordHistory is an object representing the database table.
+type PasswordHistory struct {
+	ID        int64               `db:"id,pk" `
+	UserID    int64               `db:"user_id" `
+	Password  string              `db:"password" `
+	CreatedAt null.Val[time.Time] `db:"created_at" `
+
+	R passwordHistoryR `db:"-" `
+}
+
+// PasswordHistorySlice is an alias for a slice of pointers to PasswordHistory.
+// This should almost always be used instead of []*PasswordHistory.
+type PasswordHistorySlice []*PasswordHistory
+
+// PasswordHistories contains methods to work with the password_histories table
+var PasswordHistories = psql.NewTablex[*PasswordHistory, PasswordHistorySlice, *PasswordHistorySetter]("", "password_histories", buildPasswordHistoryColumns("password_histories"))
+
+// PasswordHistoriesQuery is a query on the password_histories table
+type PasswordHistoriesQuery = *psql.ViewQuery[*PasswordHistory, PasswordHistorySlice]
+
+// passwordHistoryR is where relationships are stored.
+type passwordHistoryR struct {
+	User *User // password_histories.password_histories_user_id_fkey
+}
+
+func buildPasswordHistoryColumns(alias string) passwordHistoryColumns {
+	return passwordHistoryColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "user_id", "password", "created_at",
+		).WithParent("password_histories"),
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		UserID:     psql.Quote(alias, "user_id"),
+		Password:   psql.Quote(alias, "password"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+	}
+}
+
+type passwordHistoryColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         psql.Expression
+	UserID     psql.Expression
+	Password   psql.Expression
+	CreatedAt  psql.Expression
+}
+
+func (c passwordHistoryColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (passwordHistoryColumns) AliasedAs(alias string) passwordHistoryColumns {
+	return buildPasswordHistoryColumns(alias)
+}
+
+// PasswordHistorySetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type PasswordHistorySetter struct {
+	ID        omit.Val[int64]         `db:"id,pk" `
+	UserID    omit.Val[int64]         `db:"user_id" `
+	Password  omit.Val[string]        `db:"password" `
+	CreatedAt omitnull.Val[time.Time] `db:"created_at" `
+}
+
+func (s PasswordHistorySetter) SetColumns() []string {
+	vals := make([]string, 0, 4)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.Password.IsValue() {
+		vals = append(vals, "password")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s PasswordHistorySetter) Overwrite(t *PasswordHistory) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.Password.IsValue() {
+		t.Password = s.Password.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *PasswordHistorySetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return PasswordHistories.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 4)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserID.IsValue() {
+			vals[1] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Password.IsValue() {
+			vals[2] = psql.Arg(s.Password.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[3] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s PasswordHistorySetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s PasswordHistorySetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 4)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.Password.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "password")...),
+			psql.Arg(s.Password),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindPasswordHistory retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindPasswordHistory(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*PasswordHistory, error) {
+	if len(cols) == 0 {
+		return PasswordHistories.Query(
+			sm.Where(PasswordHistories.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return PasswordHistories.Query(
+		sm.Where(PasswordHistories.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(PasswordHistories.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// PasswordHistoryExists checks the presence of a single record by primary key
+func PasswordHistoryExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return PasswordHistories.Query(
+		sm.Where(PasswordHistories.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after PasswordHistory is retrieved from the database
+func (o *PasswordHistory) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = PasswordHistories.AfterSelectHooks.RunHooks(ctx, exec, PasswordHistorySlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = PasswordHistories.AfterInsertHooks.RunHooks(ctx, exec, PasswordHistorySlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = PasswordHistories.AfterUpdateHooks.RunHooks(ctx, exec, PasswordHistorySlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = PasswordHistories.AfterDeleteHooks.RunHooks(ctx, exec, PasswordHistorySlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the PasswordHistory
+func (o *PasswordHistory) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *PasswordHistory) pkEQ() dialect.Expression {
+	return psql.Quote("password_histories", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the PasswordHistory
+func (o *PasswordHistory) Update(ctx context.Context, exec bob.Executor, s *PasswordHistorySetter) error {
+	v, err := PasswordHistories.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single PasswordHistory record with an executor
+func (o *PasswordHistory) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := PasswordHistories.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the PasswordHistory using the executor
+func (o *PasswordHistory) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := PasswordHistories.Query(
+		sm.Where(PasswordHistories.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after PasswordHistorySlice is retrieved from the database
+func (o PasswordHistorySlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = PasswordHistories.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = PasswordHistories.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = PasswordHistories.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = PasswordHistories.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o PasswordHistorySlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("password_histories", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o PasswordHistorySlice) copyMatchingRows(from ...*PasswordHistory) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o PasswordHistorySlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return PasswordHistories.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *PasswordHistory:
+				o.copyMatchingRows(retrieved)
+			case []*PasswordHistory:
+				o.copyMatchingRows(retrieved...)
+			case PasswordHistorySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a PasswordHistory or a slice of PasswordHistory
+				// then run the AfterUpdateHooks on the slice
+				_, err = PasswordHistories.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o PasswordHistorySlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return PasswordHistories.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *PasswordHistory:
+				o.copyMatchingRows(retrieved)
+			case []*PasswordHistory:
+				o.copyMatchingRows(retrieved...)
+			case PasswordHistorySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a PasswordHistory or a slice of PasswordHistory
+				// then run the AfterDeleteHooks on the slice
+				_, err = PasswordHistories.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o PasswordHistorySlice) UpdateAll(ctx context.Context, exec bob.Executor, vals PasswordHistorySetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := PasswordHistories.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o PasswordHistorySlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := PasswordHistories.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o PasswordHistorySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := PasswordHistories.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on users
+func (o *PasswordHistory) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os PasswordHistorySlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "bigint[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachPasswordHistoryUser0(ctx context.Context, exec bob.Executor, count int, passwordHistory0 *PasswordHistory, user1 *User) (*PasswordHistory, error) {
+	setter := &PasswordHistorySetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := passwordHistory0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachPasswordHistoryUser0: %w", err)
+	}
+
+	return passwordHistory0, nil
+}
+
+func (passwordHistory0 *PasswordHistory) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachPasswordHistoryUser0(ctx, exec, 1, passwordHistory0, user1)
+	if err != nil {
+		return err
+	}
+
+	passwordHistory0.R.User = user1
+
+	user1.R.PasswordHistories = append(user1.R.PasswordHistories, passwordHistory0)
+
+	return nil
+}
+
+func (passwordHistory0 *PasswordHistory) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachPasswordHistoryUser0(ctx, exec, 1, passwordHistory0, user1)
+	if err != nil {
+		return err
+	}
+
+	passwordHistory0.R.User = user1
+
+	user1.R.PasswordHistories = append(user1.R.PasswordHistories, passwordHistory0)
+
+	return nil
+}
+
+type passwordHistoryWhere[Q psql.Filterable] struct {
+	ID        psql.WhereMod[Q, int64]
+	UserID    psql.WhereMod[Q, int64]
+	Password  psql.WhereMod[Q, string]
+	CreatedAt psql.WhereNullMod[Q, time.Time]
+}
+
+func (passwordHistoryWhere[Q]) AliasedAs(alias string) passwordHistoryWhere[Q] {
+	return buildPasswordHistoryWhere[Q](buildPasswordHistoryColumns(alias))
+}
+
+func buildPasswordHistoryWhere[Q psql.Filterable](cols passwordHistoryColumns) passwordHistoryWhere[Q] {
+	return passwordHistoryWhere[Q]{
+		ID:        psql.Where[Q, int64](cols.ID),
+		UserID:    psql.Where[Q, int64](cols.UserID),
+		Password:  psql.Where[Q, string](cols.Password),
+		CreatedAt: psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *PasswordHistory) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("passwordHistory cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.PasswordHistories = PasswordHistorySlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("passwordHistory has no relationship %q", name)
+	}
+}
+
+type passwordHistoryPreloader struct {
+	User func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildPasswordHistoryPreloader() passwordHistoryPreloader {
+	return passwordHistoryPreloader{
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        PasswordHistories,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type passwordHistoryThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildPasswordHistoryThenLoader[Q orm.Loadable]() passwordHistoryThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return passwordHistoryThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the passwordHistory's User into the .R struct
+func (o *PasswordHistory) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.PasswordHistories = PasswordHistorySlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the passwordHistory's User into the .R struct
+func (os PasswordHistorySlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.PasswordHistories = append(rel.R.PasswordHistories, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type passwordHistoryJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+}
+
+func (j passwordHistoryJoins[Q]) aliasedAs(alias string) passwordHistoryJoins[Q] {
+	return buildPasswordHistoryJoins[Q](buildPasswordHistoryColumns(alias), j.typ)
+}
+
+func buildPasswordHistoryJoins[Q dialect.Joinable](cols passwordHistoryColumns, typ string) passwordHistoryJoins[Q] {
+	return passwordHistoryJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,702 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+	"github.com/stephenafamo/scan"
+)
+
+// Permission is an object representing the database table.
+type Permission struct {
+	ID          int64               `db:"id,pk" `
+	Name        string              `db:"name" `
+	Description null.Val[string]    `db:"description" `
+	CreatedAt   null.Val[time.Time] `db:"created_at" `
+
+	R permissionR `db:"-" `
+}
+
+// PermissionSlice is an alias for a slice of pointers to Permission.
+// This should almost always be used instead of []*Permission.
+type PermissionSlice []*Permission
+
+// Permissions contains methods to work with the permissions table
+var Permissions = psql.NewTablex[*Permission, PermissionSlice, *PermissionSetter]("", "permissions", buildPermissionColumns("permissions"))
+
+// PermissionsQuery is a query on the permissions table
+type PermissionsQuery = *psql.ViewQuery[*Permission, PermissionSlice]
+
+// permissionR is where relationships are stored.
+type permissionR struct {
+	Roles RoleSlice // role_permissions.role_permissions_permission_id_fkeyrole_permissions.role_permissions_role_id_fkey
+}
+
+func buildPermissionColumns(alias string) permissionColumns {
+	return permissionColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "name", "description", "created_at",
+		).WithParent("permissions"),
+		tableAlias:  alias,
+		ID:          psql.Quote(alias, "id"),
+		Name:        psql.Quote(alias, "name"),
+		Description: psql.Quote(alias, "description"),
+		CreatedAt:   psql.Quote(alias, "created_at"),
+	}
+}
+
+type permissionColumns struct {
+	expr.ColumnsExpr
+	tableAlias  string
+	ID          psql.Expression
+	Name        psql.Expression
+	Description psql.Expression
+	CreatedAt   psql.Expression
+}
+
+func (c permissionColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (permissionColumns) AliasedAs(alias string) permissionColumns {
+	return buildPermissionColumns(alias)
+}
+
+// PermissionSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type PermissionSetter struct {
+	ID          omit.Val[int64]         `db:"id,pk" `
+	Name        omit.Val[string]        `db:"name" `
+	Description omitnull.Val[string]    `db:"description" `
+	CreatedAt   omitnull.Val[time.Time] `db:"created_at" `
+}
+
+func (s PermissionSetter) SetColumns() []string {
+	vals := make([]string, 0, 4)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if !s.Description.IsUnset() {
+		vals = append(vals, "description")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s PermissionSetter) Overwrite(t *Permission) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if !s.Description.IsUnset() {
+		t.Description = s.Description.MustGetNull()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *PermissionSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Permissions.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 4)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Name.IsValue() {
+			vals[1] = psql.Arg(s.Name.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.Description.IsUnset() {
+			vals[2] = psql.Arg(s.Description.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[3] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s PermissionSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s PermissionSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 4)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "name")...),
+			psql.Arg(s.Name),
+		}})
+	}
+
+	if !s.Description.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "description")...),
+			psql.Arg(s.Description),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindPermission retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindPermission(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*Permission, error) {
+	if len(cols) == 0 {
+		return Permissions.Query(
+			sm.Where(Permissions.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Permissions.Query(
+		sm.Where(Permissions.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(Permissions.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// PermissionExists checks the presence of a single record by primary key
+func PermissionExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return Permissions.Query(
+		sm.Where(Permissions.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Permission is retrieved from the database
+func (o *Permission) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Permissions.AfterSelectHooks.RunHooks(ctx, exec, PermissionSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Permissions.AfterInsertHooks.RunHooks(ctx, exec, PermissionSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Permissions.AfterUpdateHooks.RunHooks(ctx, exec, PermissionSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Permissions.AfterDeleteHooks.RunHooks(ctx, exec, PermissionSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Permission
+func (o *Permission) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Permission) pkEQ() dialect.Expression {
+	return psql.Quote("permissions", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Permission
+func (o *Permission) Update(ctx context.Context, exec bob.Executor, s *PermissionSetter) error {
+	v, err := Permissions.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Permission record with an executor
+func (o *Permission) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Permissions.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Permission using the executor
+func (o *Permission) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Permissions.Query(
+		sm.Where(Permissions.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after PermissionSlice is retrieved from the database
+func (o PermissionSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Permissions.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Permissions.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Permissions.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Permissions.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o PermissionSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("permissions", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o PermissionSlice) copyMatchingRows(from ...*Permission) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o PermissionSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Permissions.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Permission:
+				o.copyMatchingRows(retrieved)
+			case []*Permission:
+				o.copyMatchingRows(retrieved...)
+			case PermissionSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Permission or a slice of Permission
+				// then run the AfterUpdateHooks on the slice
+				_, err = Permissions.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o PermissionSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Permissions.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Permission:
+				o.copyMatchingRows(retrieved)
+			case []*Permission:
+				o.copyMatchingRows(retrieved...)
+			case PermissionSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Permission or a slice of Permission
+				// then run the AfterDeleteHooks on the slice
+				_, err = Permissions.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o PermissionSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals PermissionSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Permissions.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o PermissionSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Permissions.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o PermissionSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Permissions.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Roles starts a query for related objects on roles
+func (o *Permission) Roles(mods ...bob.Mod[*dialect.SelectQuery]) RolesQuery {
+	return Roles.Query(append(mods,
+		sm.InnerJoin(RolePermissions.NameAs()).On(
+			Roles.Columns.ID.EQ(RolePermissions.Columns.RoleID)),
+		sm.Where(RolePermissions.Columns.PermissionID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os PermissionSlice) Roles(mods ...bob.Mod[*dialect.SelectQuery]) RolesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Roles.Query(append(mods,
+		sm.InnerJoin(RolePermissions.NameAs()).On(
+			Roles.Columns.ID.EQ(RolePermissions.Columns.RoleID),
+		),
+		sm.Where(psql.Group(RolePermissions.Columns.PermissionID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachPermissionRoles0(ctx context.Context, exec bob.Executor, count int, permission0 *Permission, roles2 RoleSlice) (RolePermissionSlice, error) {
+	setters := make([]*RolePermissionSetter, count)
+	for i := range count {
+		setters[i] = &RolePermissionSetter{
+			PermissionID: omit.From(permission0.ID),
+			RoleID:       omit.From(roles2[i].ID),
+		}
+	}
+
+	rolePermissions1, err := RolePermissions.Insert(bob.ToMods(setters...)).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("attachPermissionRoles0: %w", err)
+	}
+
+	return rolePermissions1, nil
+}
+
+func (permission0 *Permission) InsertRoles(ctx context.Context, exec bob.Executor, related ...*RoleSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	inserted, err := Roles.Insert(bob.ToMods(related...)).All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+	roles2 := RoleSlice(inserted)
+
+	_, err = attachPermissionRoles0(ctx, exec, len(related), permission0, roles2)
+	if err != nil {
+		return err
+	}
+
+	permission0.R.Roles = append(permission0.R.Roles, roles2...)
+
+	for _, rel := range roles2 {
+		rel.R.Permissions = append(rel.R.Permissions, permission0)
+	}
+	return nil
+}
+
+func (permission0 *Permission) AttachRoles(ctx context.Context, exec bob.Executor, related ...*Role) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	roles2 := RoleSlice(related)
+
+	_, err = attachPermissionRoles0(ctx, exec, len(related), permission0, roles2)
+	if err != nil {
+		return err
+	}
+
+	permission0.R.Roles = append(permission0.R.Roles, roles2...)
+
+	for _, rel := range related {
+		rel.R.Permissions = append(rel.R.Permissions, permission0)
+	}
+
+	return nil
+}
+
+type permissionWhere[Q psql.Filterable] struct {
+	ID          psql.WhereMod[Q, int64]
+	Name        psql.WhereMod[Q, string]
+	Description psql.WhereNullMod[Q, string]
+	CreatedAt   psql.WhereNullMod[Q, time.Time]
+}
+
+func (permissionWhere[Q]) AliasedAs(alias string) permissionWhere[Q] {
+	return buildPermissionWhere[Q](buildPermissionColumns(alias))
+}
+
+func buildPermissionWhere[Q psql.Filterable](cols permissionColumns) permissionWhere[Q] {
+	return permissionWhere[Q]{
+		ID:          psql.Where[Q, int64](cols.ID),
+		Name:        psql.Where[Q, string](cols.Name),
+		Description: psql.WhereNull[Q, string](cols.Description),
+		CreatedAt:   psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *Permission) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Roles":
+		rels, ok := retrieved.(RoleSlice)
+		if !ok {
+			return fmt.Errorf("permission cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Roles = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Permissions = PermissionSlice{o}
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("permission has no relationship %q", name)
+	}
+}
+
+type permissionPreloader struct{}
+
+func buildPermissionPreloader() permissionPreloader {
+	return permissionPreloader{}
+}
+
+type permissionThenLoader[Q orm.Loadable] struct {
+	Roles func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildPermissionThenLoader[Q orm.Loadable]() permissionThenLoader[Q] {
+	type RolesLoadInterface interface {
+		LoadRoles(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return permissionThenLoader[Q]{
+		Roles: thenLoadBuilder[Q](
+			"Roles",
+			func(ctx context.Context, exec bob.Executor, retrieved RolesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadRoles(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadRoles loads the permission's Roles into the .R struct
+func (o *Permission) LoadRoles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Roles = nil
+
+	related, err := o.Roles(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Permissions = PermissionSlice{o}
+	}
+
+	o.R.Roles = related
+	return nil
+}
+
+// LoadRoles loads the permission's Roles into the .R struct
+func (os PermissionSlice) LoadRoles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	// since we are changing the columns, we need to check if the original columns were set or add the defaults
+	sq := dialect.SelectQuery{}
+	for _, mod := range mods {
+		mod.Apply(&sq)
+	}
+
+	if len(sq.SelectList.Columns) == 0 {
+		mods = append(mods, sm.Columns(Roles.Columns))
+	}
+
+	q := os.Roles(append(
+		mods,
+		sm.Columns(RolePermissions.Columns.PermissionID.As("related_permissions.ID")),
+	)...)
+
+	IDSlice := []int64{}
+
+	mapper := scan.Mod(scan.StructMapper[*Role](), func(ctx context.Context, cols []string) (scan.BeforeFunc, func(any, any) error) {
+		return func(row *scan.Row) (any, error) {
+				IDSlice = append(IDSlice, *new(int64))
+				row.ScheduleScanByName("related_permissions.ID", &IDSlice[len(IDSlice)-1])
+
+				return nil, nil
+			},
+			func(any, any) error {
+				return nil
+			}
+	})
+
+	roles, err := bob.Allx[bob.SliceTransformer[*Role, RoleSlice]](ctx, exec, q, mapper)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Roles = nil
+	}
+
+	for _, o := range os {
+		for i, rel := range roles {
+			if o.ID == IDSlice[i] {
+				continue
+			}
+
+			rel.R.Permissions = append(rel.R.Permissions, o)
+
+			o.R.Roles = append(o.R.Roles, rel)
+		}
+	}
+
+	return nil
+}
+
+type permissionJoins[Q dialect.Joinable] struct {
+	typ   string
+	Roles modAs[Q, roleColumns]
+}
+
+func (j permissionJoins[Q]) aliasedAs(alias string) permissionJoins[Q] {
+	return buildPermissionJoins[Q](buildPermissionColumns(alias), j.typ)
+}
+
+func buildPermissionJoins[Q dialect.Joinable](cols permissionColumns, typ string) permissionJoins[Q] {
+	return permissionJoins[Q]{
+		typ: typ,
+		Roles: modAs[Q, roleColumns]{
+			c: Roles.Columns,
+			f: func(to roleColumns) bob.Mod[Q] {
+				random := strconv.FormatInt(randInt(), 10)
+				mods := make(mods.QueryMods[Q], 0, 2)
+
+				{
+					to := RolePermissions.Columns.AliasedAs(RolePermissions.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, RolePermissions.Name().As(to.Alias())).On(
+						to.PermissionID.EQ(cols.ID),
+					))
+				}
+				{
+					cols := RolePermissions.Columns.AliasedAs(RolePermissions.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, Roles.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.RoleID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
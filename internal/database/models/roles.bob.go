@@ -0,0 +1,1143 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+	"github.com/stephenafamo/scan"
+)
+
+// Role is an object representing the database table.
+type Role struct {
+	ID          int64               `db:"id,pk" `
+	Name        string              `db:"name" `
+	Description null.Val[string]    `db:"description" `
+	CreatedAt   null.Val[time.Time] `db:"created_at" `
+	UpdatedAt   null.Val[time.Time] `db:"updated_at" `
+
+	R roleR `db:"-" `
+}
+
+// RoleSlice is an alias for a slice of pointers to Role.
+// This should almost always be used instead of []*Role.
+type RoleSlice []*Role
+
+// Roles contains methods to work with the roles table
+var Roles = psql.NewTablex[*Role, RoleSlice, *RoleSetter]("", "roles", buildRoleColumns("roles"))
+
+// RolesQuery is a query on the roles table
+type RolesQuery = *psql.ViewQuery[*Role, RoleSlice]
+
+// roleR is where relationships are stored.
+type roleR struct {
+	Invitations InvitationSlice // invitations.invitations_role_id_fkey
+	Permissions PermissionSlice // role_permissions.role_permissions_permission_id_fkeyrole_permissions.role_permissions_role_id_fkey
+	Users       UserSlice       // user_roles.user_roles_role_id_fkeyuser_roles.user_roles_user_id_fkey
+}
+
+func buildRoleColumns(alias string) roleColumns {
+	return roleColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "name", "description", "created_at", "updated_at",
+		).WithParent("roles"),
+		tableAlias:  alias,
+		ID:          psql.Quote(alias, "id"),
+		Name:        psql.Quote(alias, "name"),
+		Description: psql.Quote(alias, "description"),
+		CreatedAt:   psql.Quote(alias, "created_at"),
+		UpdatedAt:   psql.Quote(alias, "updated_at"),
+	}
+}
+
+type roleColumns struct {
+	expr.ColumnsExpr
+	tableAlias  string
+	ID          psql.Expression
+	Name        psql.Expression
+	Description psql.Expression
+	CreatedAt   psql.Expression
+	UpdatedAt   psql.Expression
+}
+
+func (c roleColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (roleColumns) AliasedAs(alias string) roleColumns {
+	return buildRoleColumns(alias)
+}
+
+// RoleSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type RoleSetter struct {
+	ID          omit.Val[int64]         `db:"id,pk" `
+	Name        omit.Val[string]        `db:"name" `
+	Description omitnull.Val[string]    `db:"description" `
+	CreatedAt   omitnull.Val[time.Time] `db:"created_at" `
+	UpdatedAt   omitnull.Val[time.Time] `db:"updated_at" `
+}
+
+func (s RoleSetter) SetColumns() []string {
+	vals := make([]string, 0, 5)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if !s.Description.IsUnset() {
+		vals = append(vals, "description")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	if !s.UpdatedAt.IsUnset() {
+		vals = append(vals, "updated_at")
+	}
+	return vals
+}
+
+func (s RoleSetter) Overwrite(t *Role) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if !s.Description.IsUnset() {
+		t.Description = s.Description.MustGetNull()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+	if !s.UpdatedAt.IsUnset() {
+		t.UpdatedAt = s.UpdatedAt.MustGetNull()
+	}
+}
+
+func (s *RoleSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Roles.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 5)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Name.IsValue() {
+			vals[1] = psql.Arg(s.Name.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.Description.IsUnset() {
+			vals[2] = psql.Arg(s.Description.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[3] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UpdatedAt.IsUnset() {
+			vals[4] = psql.Arg(s.UpdatedAt.MustGetNull())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s RoleSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s RoleSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 5)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "name")...),
+			psql.Arg(s.Name),
+		}})
+	}
+
+	if !s.Description.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "description")...),
+			psql.Arg(s.Description),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	if !s.UpdatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "updated_at")...),
+			psql.Arg(s.UpdatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindRole retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindRole(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*Role, error) {
+	if len(cols) == 0 {
+		return Roles.Query(
+			sm.Where(Roles.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Roles.Query(
+		sm.Where(Roles.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(Roles.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// RoleExists checks the presence of a single record by primary key
+func RoleExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return Roles.Query(
+		sm.Where(Roles.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Role is retrieved from the database
+func (o *Role) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Roles.AfterSelectHooks.RunHooks(ctx, exec, RoleSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Roles.AfterInsertHooks.RunHooks(ctx, exec, RoleSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Roles.AfterUpdateHooks.RunHooks(ctx, exec, RoleSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Roles.AfterDeleteHooks.RunHooks(ctx, exec, RoleSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Role
+func (o *Role) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Role) pkEQ() dialect.Expression {
+	return psql.Quote("roles", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Role
+func (o *Role) Update(ctx context.Context, exec bob.Executor, s *RoleSetter) error {
+	v, err := Roles.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Role record with an executor
+func (o *Role) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Roles.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Role using the executor
+func (o *Role) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Roles.Query(
+		sm.Where(Roles.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after RoleSlice is retrieved from the database
+func (o RoleSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Roles.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Roles.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Roles.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Roles.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o RoleSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("roles", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o RoleSlice) copyMatchingRows(from ...*Role) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o RoleSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Roles.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Role:
+				o.copyMatchingRows(retrieved)
+			case []*Role:
+				o.copyMatchingRows(retrieved...)
+			case RoleSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Role or a slice of Role
+				// then run the AfterUpdateHooks on the slice
+				_, err = Roles.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o RoleSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Roles.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Role:
+				o.copyMatchingRows(retrieved)
+			case []*Role:
+				o.copyMatchingRows(retrieved...)
+			case RoleSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Role or a slice of Role
+				// then run the AfterDeleteHooks on the slice
+				_, err = Roles.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o RoleSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals RoleSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Roles.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o RoleSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Roles.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o RoleSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Roles.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Invitations starts a query for related objects on invitations
+func (o *Role) Invitations(mods ...bob.Mod[*dialect.SelectQuery]) InvitationsQuery {
+	return Invitations.Query(append(mods,
+		sm.Where(Invitations.Columns.RoleID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os RoleSlice) Invitations(mods ...bob.Mod[*dialect.SelectQuery]) InvitationsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Invitations.Query(append(mods,
+		sm.Where(psql.Group(Invitations.Columns.RoleID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Permissions starts a query for related objects on permissions
+func (o *Role) Permissions(mods ...bob.Mod[*dialect.SelectQuery]) PermissionsQuery {
+	return Permissions.Query(append(mods,
+		sm.InnerJoin(RolePermissions.NameAs()).On(
+			Permissions.Columns.ID.EQ(RolePermissions.Columns.PermissionID)),
+		sm.Where(RolePermissions.Columns.RoleID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os RoleSlice) Permissions(mods ...bob.Mod[*dialect.SelectQuery]) PermissionsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Permissions.Query(append(mods,
+		sm.InnerJoin(RolePermissions.NameAs()).On(
+			Permissions.Columns.ID.EQ(RolePermissions.Columns.PermissionID),
+		),
+		sm.Where(psql.Group(RolePermissions.Columns.RoleID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Users starts a query for related objects on users
+func (o *Role) Users(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.InnerJoin(UserRoles.NameAs()).On(
+			Users.Columns.ID.EQ(UserRoles.Columns.UserID)),
+		sm.Where(UserRoles.Columns.RoleID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os RoleSlice) Users(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.InnerJoin(UserRoles.NameAs()).On(
+			Users.Columns.ID.EQ(UserRoles.Columns.UserID),
+		),
+		sm.Where(psql.Group(UserRoles.Columns.RoleID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertRoleInvitations0(ctx context.Context, exec bob.Executor, invitations1 []*InvitationSetter, role0 *Role) (InvitationSlice, error) {
+	for i := range invitations1 {
+		invitations1[i].RoleID = omit.From(role0.ID)
+	}
+
+	ret, err := Invitations.Insert(bob.ToMods(invitations1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertRoleInvitations0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachRoleInvitations0(ctx context.Context, exec bob.Executor, count int, invitations1 InvitationSlice, role0 *Role) (InvitationSlice, error) {
+	setter := &InvitationSetter{
+		RoleID: omit.From(role0.ID),
+	}
+
+	err := invitations1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachRoleInvitations0: %w", err)
+	}
+
+	return invitations1, nil
+}
+
+func (role0 *Role) InsertInvitations(ctx context.Context, exec bob.Executor, related ...*InvitationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	invitations1, err := insertRoleInvitations0(ctx, exec, related, role0)
+	if err != nil {
+		return err
+	}
+
+	role0.R.Invitations = append(role0.R.Invitations, invitations1...)
+
+	for _, rel := range invitations1 {
+		rel.R.Role = role0
+	}
+	return nil
+}
+
+func (role0 *Role) AttachInvitations(ctx context.Context, exec bob.Executor, related ...*Invitation) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	invitations1 := InvitationSlice(related)
+
+	_, err = attachRoleInvitations0(ctx, exec, len(related), invitations1, role0)
+	if err != nil {
+		return err
+	}
+
+	role0.R.Invitations = append(role0.R.Invitations, invitations1...)
+
+	for _, rel := range related {
+		rel.R.Role = role0
+	}
+
+	return nil
+}
+
+func attachRolePermissions0(ctx context.Context, exec bob.Executor, count int, role0 *Role, permissions2 PermissionSlice) (RolePermissionSlice, error) {
+	setters := make([]*RolePermissionSetter, count)
+	for i := range count {
+		setters[i] = &RolePermissionSetter{
+			RoleID:       omit.From(role0.ID),
+			PermissionID: omit.From(permissions2[i].ID),
+		}
+	}
+
+	rolePermissions1, err := RolePermissions.Insert(bob.ToMods(setters...)).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("attachRolePermissions0: %w", err)
+	}
+
+	return rolePermissions1, nil
+}
+
+func (role0 *Role) InsertPermissions(ctx context.Context, exec bob.Executor, related ...*PermissionSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	inserted, err := Permissions.Insert(bob.ToMods(related...)).All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+	permissions2 := PermissionSlice(inserted)
+
+	_, err = attachRolePermissions0(ctx, exec, len(related), role0, permissions2)
+	if err != nil {
+		return err
+	}
+
+	role0.R.Permissions = append(role0.R.Permissions, permissions2...)
+
+	for _, rel := range permissions2 {
+		rel.R.Roles = append(rel.R.Roles, role0)
+	}
+	return nil
+}
+
+func (role0 *Role) AttachPermissions(ctx context.Context, exec bob.Executor, related ...*Permission) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	permissions2 := PermissionSlice(related)
+
+	_, err = attachRolePermissions0(ctx, exec, len(related), role0, permissions2)
+	if err != nil {
+		return err
+	}
+
+	role0.R.Permissions = append(role0.R.Permissions, permissions2...)
+
+	for _, rel := range related {
+		rel.R.Roles = append(rel.R.Roles, role0)
+	}
+
+	return nil
+}
+
+func attachRoleUsers0(ctx context.Context, exec bob.Executor, count int, role0 *Role, users2 UserSlice) (UserRoleSlice, error) {
+	setters := make([]*UserRoleSetter, count)
+	for i := range count {
+		setters[i] = &UserRoleSetter{
+			RoleID: omit.From(role0.ID),
+			UserID: omit.From(users2[i].ID),
+		}
+	}
+
+	userRoles1, err := UserRoles.Insert(bob.ToMods(setters...)).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("attachRoleUsers0: %w", err)
+	}
+
+	return userRoles1, nil
+}
+
+func (role0 *Role) InsertUsers(ctx context.Context, exec bob.Executor, related ...*UserSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	inserted, err := Users.Insert(bob.ToMods(related...)).All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+	users2 := UserSlice(inserted)
+
+	_, err = attachRoleUsers0(ctx, exec, len(related), role0, users2)
+	if err != nil {
+		return err
+	}
+
+	role0.R.Users = append(role0.R.Users, users2...)
+
+	for _, rel := range users2 {
+		rel.R.Roles = append(rel.R.Roles, role0)
+	}
+	return nil
+}
+
+func (role0 *Role) AttachUsers(ctx context.Context, exec bob.Executor, related ...*User) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	users2 := UserSlice(related)
+
+	_, err = attachRoleUsers0(ctx, exec, len(related), role0, users2)
+	if err != nil {
+		return err
+	}
+
+	role0.R.Users = append(role0.R.Users, users2...)
+
+	for _, rel := range related {
+		rel.R.Roles = append(rel.R.Roles, role0)
+	}
+
+	return nil
+}
+
+type roleWhere[Q psql.Filterable] struct {
+	ID          psql.WhereMod[Q, int64]
+	Name        psql.WhereMod[Q, string]
+	Description psql.WhereNullMod[Q, string]
+	CreatedAt   psql.WhereNullMod[Q, time.Time]
+	UpdatedAt   psql.WhereNullMod[Q, time.Time]
+}
+
+func (roleWhere[Q]) AliasedAs(alias string) roleWhere[Q] {
+	return buildRoleWhere[Q](buildRoleColumns(alias))
+}
+
+func buildRoleWhere[Q psql.Filterable](cols roleColumns) roleWhere[Q] {
+	return roleWhere[Q]{
+		ID:          psql.Where[Q, int64](cols.ID),
+		Name:        psql.Where[Q, string](cols.Name),
+		Description: psql.WhereNull[Q, string](cols.Description),
+		CreatedAt:   psql.WhereNull[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:   psql.WhereNull[Q, time.Time](cols.UpdatedAt),
+	}
+}
+
+func (o *Role) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Invitations":
+		rels, ok := retrieved.(InvitationSlice)
+		if !ok {
+			return fmt.Errorf("role cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Invitations = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Role = o
+			}
+		}
+		return nil
+	case "Permissions":
+		rels, ok := retrieved.(PermissionSlice)
+		if !ok {
+			return fmt.Errorf("role cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Permissions = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Roles = RoleSlice{o}
+			}
+		}
+		return nil
+	case "Users":
+		rels, ok := retrieved.(UserSlice)
+		if !ok {
+			return fmt.Errorf("role cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Users = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Roles = RoleSlice{o}
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("role has no relationship %q", name)
+	}
+}
+
+type rolePreloader struct{}
+
+func buildRolePreloader() rolePreloader {
+	return rolePreloader{}
+}
+
+type roleThenLoader[Q orm.Loadable] struct {
+	Invitations func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Permissions func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Users       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildRoleThenLoader[Q orm.Loadable]() roleThenLoader[Q] {
+	type InvitationsLoadInterface interface {
+		LoadInvitations(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type PermissionsLoadInterface interface {
+		LoadPermissions(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UsersLoadInterface interface {
+		LoadUsers(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return roleThenLoader[Q]{
+		Invitations: thenLoadBuilder[Q](
+			"Invitations",
+			func(ctx context.Context, exec bob.Executor, retrieved InvitationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadInvitations(ctx, exec, mods...)
+			},
+		),
+		Permissions: thenLoadBuilder[Q](
+			"Permissions",
+			func(ctx context.Context, exec bob.Executor, retrieved PermissionsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadPermissions(ctx, exec, mods...)
+			},
+		),
+		Users: thenLoadBuilder[Q](
+			"Users",
+			func(ctx context.Context, exec bob.Executor, retrieved UsersLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUsers(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadInvitations loads the role's Invitations into the .R struct
+func (o *Role) LoadInvitations(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Invitations = nil
+
+	related, err := o.Invitations(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Role = o
+	}
+
+	o.R.Invitations = related
+	return nil
+}
+
+// LoadInvitations loads the role's Invitations into the .R struct
+func (os RoleSlice) LoadInvitations(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invitations, err := os.Invitations(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Invitations = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invitations {
+
+			if !(o.ID == rel.RoleID) {
+				continue
+			}
+
+			rel.R.Role = o
+
+			o.R.Invitations = append(o.R.Invitations, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadPermissions loads the role's Permissions into the .R struct
+func (o *Role) LoadPermissions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Permissions = nil
+
+	related, err := o.Permissions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Roles = RoleSlice{o}
+	}
+
+	o.R.Permissions = related
+	return nil
+}
+
+// LoadPermissions loads the role's Permissions into the .R struct
+func (os RoleSlice) LoadPermissions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	// since we are changing the columns, we need to check if the original columns were set or add the defaults
+	sq := dialect.SelectQuery{}
+	for _, mod := range mods {
+		mod.Apply(&sq)
+	}
+
+	if len(sq.SelectList.Columns) == 0 {
+		mods = append(mods, sm.Columns(Permissions.Columns))
+	}
+
+	q := os.Permissions(append(
+		mods,
+		sm.Columns(RolePermissions.Columns.RoleID.As("related_roles.ID")),
+	)...)
+
+	IDSlice := []int64{}
+
+	mapper := scan.Mod(scan.StructMapper[*Permission](), func(ctx context.Context, cols []string) (scan.BeforeFunc, func(any, any) error) {
+		return func(row *scan.Row) (any, error) {
+				IDSlice = append(IDSlice, *new(int64))
+				row.ScheduleScanByName("related_roles.ID", &IDSlice[len(IDSlice)-1])
+
+				return nil, nil
+			},
+			func(any, any) error {
+				return nil
+			}
+	})
+
+	permissions, err := bob.Allx[bob.SliceTransformer[*Permission, PermissionSlice]](ctx, exec, q, mapper)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Permissions = nil
+	}
+
+	for _, o := range os {
+		for i, rel := range permissions {
+			if o.ID == IDSlice[i] {
+				continue
+			}
+
+			rel.R.Roles = append(rel.R.Roles, o)
+
+			o.R.Permissions = append(o.R.Permissions, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadUsers loads the role's Users into the .R struct
+func (o *Role) LoadUsers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Users = nil
+
+	related, err := o.Users(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Roles = RoleSlice{o}
+	}
+
+	o.R.Users = related
+	return nil
+}
+
+// LoadUsers loads the role's Users into the .R struct
+func (os RoleSlice) LoadUsers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	// since we are changing the columns, we need to check if the original columns were set or add the defaults
+	sq := dialect.SelectQuery{}
+	for _, mod := range mods {
+		mod.Apply(&sq)
+	}
+
+	if len(sq.SelectList.Columns) == 0 {
+		mods = append(mods, sm.Columns(Users.Columns))
+	}
+
+	q := os.Users(append(
+		mods,
+		sm.Columns(UserRoles.Columns.RoleID.As("related_roles.ID")),
+	)...)
+
+	IDSlice := []int64{}
+
+	mapper := scan.Mod(scan.StructMapper[*User](), func(ctx context.Context, cols []string) (scan.BeforeFunc, func(any, any) error) {
+		return func(row *scan.Row) (any, error) {
+				IDSlice = append(IDSlice, *new(int64))
+				row.ScheduleScanByName("related_roles.ID", &IDSlice[len(IDSlice)-1])
+
+				return nil, nil
+			},
+			func(any, any) error {
+				return nil
+			}
+	})
+
+	users, err := bob.Allx[bob.SliceTransformer[*User, UserSlice]](ctx, exec, q, mapper)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Users = nil
+	}
+
+	for _, o := range os {
+		for i, rel := range users {
+			if o.ID == IDSlice[i] {
+				continue
+			}
+
+			rel.R.Roles = append(rel.R.Roles, o)
+
+			o.R.Users = append(o.R.Users, rel)
+		}
+	}
+
+	return nil
+}
+
+type roleJoins[Q dialect.Joinable] struct {
+	typ         string
+	Invitations modAs[Q, invitationColumns]
+	Permissions modAs[Q, permissionColumns]
+	Users       modAs[Q, userColumns]
+}
+
+func (j roleJoins[Q]) aliasedAs(alias string) roleJoins[Q] {
+	return buildRoleJoins[Q](buildRoleColumns(alias), j.typ)
+}
+
+func buildRoleJoins[Q dialect.Joinable](cols roleColumns, typ string) roleJoins[Q] {
+	return roleJoins[Q]{
+		typ: typ,
+		Invitations: modAs[Q, invitationColumns]{
+			c: Invitations.Columns,
+			f: func(to invitationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Invitations.Name().As(to.Alias())).On(
+						to.RoleID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Permissions: modAs[Q, permissionColumns]{
+			c: Permissions.Columns,
+			f: func(to permissionColumns) bob.Mod[Q] {
+				random := strconv.FormatInt(randInt(), 10)
+				mods := make(mods.QueryMods[Q], 0, 2)
+
+				{
+					to := RolePermissions.Columns.AliasedAs(RolePermissions.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, RolePermissions.Name().As(to.Alias())).On(
+						to.RoleID.EQ(cols.ID),
+					))
+				}
+				{
+					cols := RolePermissions.Columns.AliasedAs(RolePermissions.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, Permissions.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.PermissionID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Users: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				random := strconv.FormatInt(randInt(), 10)
+				mods := make(mods.QueryMods[Q], 0, 2)
+
+				{
+					to := UserRoles.Columns.AliasedAs(UserRoles.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, UserRoles.Name().As(to.Alias())).On(
+						to.RoleID.EQ(cols.ID),
+					))
+				}
+				{
+					cols := UserRoles.Columns.AliasedAs(UserRoles.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
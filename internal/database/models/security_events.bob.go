@@ -0,0 +1,737 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/gofrs/uuid/v5"
+	enums "github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// SecurityEvent is an object representing the database table.
+type SecurityEvent struct {
+	ID        int64                                 `db:"id,pk" `
+	UserID    null.Val[int64]                       `db:"user_id" `
+	SessionID null.Val[uuid.UUID]                   `db:"session_id" `
+	Type      enums.SecurityEventTypes              `db:"type" `
+	IPAddress null.Val[pgtypes.Inet]                `db:"ip_address" `
+	Details   null.Val[types.JSON[json.RawMessage]] `db:"details" `
+	CreatedAt null.Val[time.Time]                   `db:"created_at" `
+
+	R securityEventR `db:"-" `
+}
+
+// SecurityEventSlice is an alias for a slice of pointers to SecurityEvent.
+// This should almost always be used instead of []*SecurityEvent.
+type SecurityEventSlice []*SecurityEvent
+
+// SecurityEvents contains methods to work with the security_events table
+var SecurityEvents = psql.NewTablex[*SecurityEvent, SecurityEventSlice, *SecurityEventSetter]("", "security_events", buildSecurityEventColumns("security_events"))
+
+// SecurityEventsQuery is a query on the security_events table
+type SecurityEventsQuery = *psql.ViewQuery[*SecurityEvent, SecurityEventSlice]
+
+// securityEventR is where relationships are stored.
+type securityEventR struct {
+	User *User // security_events.security_events_user_id_fkey
+}
+
+func buildSecurityEventColumns(alias string) securityEventColumns {
+	return securityEventColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "user_id", "session_id", "type", "ip_address", "details", "created_at",
+		).WithParent("security_events"),
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		UserID:     psql.Quote(alias, "user_id"),
+		SessionID:  psql.Quote(alias, "session_id"),
+		Type:       psql.Quote(alias, "type"),
+		IPAddress:  psql.Quote(alias, "ip_address"),
+		Details:    psql.Quote(alias, "details"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+	}
+}
+
+type securityEventColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         psql.Expression
+	UserID     psql.Expression
+	SessionID  psql.Expression
+	Type       psql.Expression
+	IPAddress  psql.Expression
+	Details    psql.Expression
+	CreatedAt  psql.Expression
+}
+
+func (c securityEventColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (securityEventColumns) AliasedAs(alias string) securityEventColumns {
+	return buildSecurityEventColumns(alias)
+}
+
+// SecurityEventSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type SecurityEventSetter struct {
+	ID        omit.Val[int64]                           `db:"id,pk" `
+	UserID    omitnull.Val[int64]                       `db:"user_id" `
+	SessionID omitnull.Val[uuid.UUID]                   `db:"session_id" `
+	Type      omit.Val[enums.SecurityEventTypes]        `db:"type" `
+	IPAddress omitnull.Val[pgtypes.Inet]                `db:"ip_address" `
+	Details   omitnull.Val[types.JSON[json.RawMessage]] `db:"details" `
+	CreatedAt omitnull.Val[time.Time]                   `db:"created_at" `
+}
+
+func (s SecurityEventSetter) SetColumns() []string {
+	vals := make([]string, 0, 7)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if !s.UserID.IsUnset() {
+		vals = append(vals, "user_id")
+	}
+	if !s.SessionID.IsUnset() {
+		vals = append(vals, "session_id")
+	}
+	if s.Type.IsValue() {
+		vals = append(vals, "type")
+	}
+	if !s.IPAddress.IsUnset() {
+		vals = append(vals, "ip_address")
+	}
+	if !s.Details.IsUnset() {
+		vals = append(vals, "details")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s SecurityEventSetter) Overwrite(t *SecurityEvent) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if !s.UserID.IsUnset() {
+		t.UserID = s.UserID.MustGetNull()
+	}
+	if !s.SessionID.IsUnset() {
+		t.SessionID = s.SessionID.MustGetNull()
+	}
+	if s.Type.IsValue() {
+		t.Type = s.Type.MustGet()
+	}
+	if !s.IPAddress.IsUnset() {
+		t.IPAddress = s.IPAddress.MustGetNull()
+	}
+	if !s.Details.IsUnset() {
+		t.Details = s.Details.MustGetNull()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *SecurityEventSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return SecurityEvents.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 7)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UserID.IsUnset() {
+			vals[1] = psql.Arg(s.UserID.MustGetNull())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.SessionID.IsUnset() {
+			vals[2] = psql.Arg(s.SessionID.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.Type.IsValue() {
+			vals[3] = psql.Arg(s.Type.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if !s.IPAddress.IsUnset() {
+			vals[4] = psql.Arg(s.IPAddress.MustGetNull())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if !s.Details.IsUnset() {
+			vals[5] = psql.Arg(s.Details.MustGetNull())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[6] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s SecurityEventSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s SecurityEventSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 7)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if !s.UserID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if !s.SessionID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "session_id")...),
+			psql.Arg(s.SessionID),
+		}})
+	}
+
+	if s.Type.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "type")...),
+			psql.Arg(s.Type),
+		}})
+	}
+
+	if !s.IPAddress.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "ip_address")...),
+			psql.Arg(s.IPAddress),
+		}})
+	}
+
+	if !s.Details.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "details")...),
+			psql.Arg(s.Details),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindSecurityEvent retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindSecurityEvent(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*SecurityEvent, error) {
+	if len(cols) == 0 {
+		return SecurityEvents.Query(
+			sm.Where(SecurityEvents.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return SecurityEvents.Query(
+		sm.Where(SecurityEvents.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(SecurityEvents.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// SecurityEventExists checks the presence of a single record by primary key
+func SecurityEventExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return SecurityEvents.Query(
+		sm.Where(SecurityEvents.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after SecurityEvent is retrieved from the database
+func (o *SecurityEvent) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = SecurityEvents.AfterSelectHooks.RunHooks(ctx, exec, SecurityEventSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = SecurityEvents.AfterInsertHooks.RunHooks(ctx, exec, SecurityEventSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = SecurityEvents.AfterUpdateHooks.RunHooks(ctx, exec, SecurityEventSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = SecurityEvents.AfterDeleteHooks.RunHooks(ctx, exec, SecurityEventSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the SecurityEvent
+func (o *SecurityEvent) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *SecurityEvent) pkEQ() dialect.Expression {
+	return psql.Quote("security_events", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the SecurityEvent
+func (o *SecurityEvent) Update(ctx context.Context, exec bob.Executor, s *SecurityEventSetter) error {
+	v, err := SecurityEvents.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single SecurityEvent record with an executor
+func (o *SecurityEvent) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := SecurityEvents.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the SecurityEvent using the executor
+func (o *SecurityEvent) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := SecurityEvents.Query(
+		sm.Where(SecurityEvents.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after SecurityEventSlice is retrieved from the database
+func (o SecurityEventSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = SecurityEvents.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = SecurityEvents.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = SecurityEvents.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = SecurityEvents.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o SecurityEventSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("security_events", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o SecurityEventSlice) copyMatchingRows(from ...*SecurityEvent) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o SecurityEventSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return SecurityEvents.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *SecurityEvent:
+				o.copyMatchingRows(retrieved)
+			case []*SecurityEvent:
+				o.copyMatchingRows(retrieved...)
+			case SecurityEventSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a SecurityEvent or a slice of SecurityEvent
+				// then run the AfterUpdateHooks on the slice
+				_, err = SecurityEvents.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o SecurityEventSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return SecurityEvents.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *SecurityEvent:
+				o.copyMatchingRows(retrieved)
+			case []*SecurityEvent:
+				o.copyMatchingRows(retrieved...)
+			case SecurityEventSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a SecurityEvent or a slice of SecurityEvent
+				// then run the AfterDeleteHooks on the slice
+				_, err = SecurityEvents.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o SecurityEventSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals SecurityEventSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := SecurityEvents.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o SecurityEventSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := SecurityEvents.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o SecurityEventSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := SecurityEvents.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on users
+func (o *SecurityEvent) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os SecurityEventSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[null.Val[int64]], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "bigint[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachSecurityEventUser0(ctx context.Context, exec bob.Executor, count int, securityEvent0 *SecurityEvent, user1 *User) (*SecurityEvent, error) {
+	setter := &SecurityEventSetter{
+		UserID: omitnull.From(user1.ID),
+	}
+
+	err := securityEvent0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachSecurityEventUser0: %w", err)
+	}
+
+	return securityEvent0, nil
+}
+
+func (securityEvent0 *SecurityEvent) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachSecurityEventUser0(ctx, exec, 1, securityEvent0, user1)
+	if err != nil {
+		return err
+	}
+
+	securityEvent0.R.User = user1
+
+	user1.R.SecurityEvents = append(user1.R.SecurityEvents, securityEvent0)
+
+	return nil
+}
+
+func (securityEvent0 *SecurityEvent) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachSecurityEventUser0(ctx, exec, 1, securityEvent0, user1)
+	if err != nil {
+		return err
+	}
+
+	securityEvent0.R.User = user1
+
+	user1.R.SecurityEvents = append(user1.R.SecurityEvents, securityEvent0)
+
+	return nil
+}
+
+type securityEventWhere[Q psql.Filterable] struct {
+	ID        psql.WhereMod[Q, int64]
+	UserID    psql.WhereNullMod[Q, int64]
+	SessionID psql.WhereNullMod[Q, uuid.UUID]
+	Type      psql.WhereMod[Q, enums.SecurityEventTypes]
+	IPAddress psql.WhereNullMod[Q, pgtypes.Inet]
+	Details   psql.WhereNullMod[Q, types.JSON[json.RawMessage]]
+	CreatedAt psql.WhereNullMod[Q, time.Time]
+}
+
+func (securityEventWhere[Q]) AliasedAs(alias string) securityEventWhere[Q] {
+	return buildSecurityEventWhere[Q](buildSecurityEventColumns(alias))
+}
+
+func buildSecurityEventWhere[Q psql.Filterable](cols securityEventColumns) securityEventWhere[Q] {
+	return securityEventWhere[Q]{
+		ID:        psql.Where[Q, int64](cols.ID),
+		UserID:    psql.WhereNull[Q, int64](cols.UserID),
+		SessionID: psql.WhereNull[Q, uuid.UUID](cols.SessionID),
+		Type:      psql.Where[Q, enums.SecurityEventTypes](cols.Type),
+		IPAddress: psql.WhereNull[Q, pgtypes.Inet](cols.IPAddress),
+		Details:   psql.WhereNull[Q, types.JSON[json.RawMessage]](cols.Details),
+		CreatedAt: psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *SecurityEvent) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("securityEvent cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.SecurityEvents = SecurityEventSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("securityEvent has no relationship %q", name)
+	}
+}
+
+type securityEventPreloader struct {
+	User func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildSecurityEventPreloader() securityEventPreloader {
+	return securityEventPreloader{
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        SecurityEvents,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type securityEventThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildSecurityEventThenLoader[Q orm.Loadable]() securityEventThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return securityEventThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the securityEvent's User into the .R struct
+func (o *SecurityEvent) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.SecurityEvents = SecurityEventSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the securityEvent's User into the .R struct
+func (os SecurityEventSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+			if !o.UserID.IsValue() {
+				continue
+			}
+
+			if !(o.UserID.IsValue() && o.UserID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.SecurityEvents = append(rel.R.SecurityEvents, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type securityEventJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+}
+
+func (j securityEventJoins[Q]) aliasedAs(alias string) securityEventJoins[Q] {
+	return buildSecurityEventJoins[Q](buildSecurityEventColumns(alias), j.typ)
+}
+
+func buildSecurityEventJoins[Q dialect.Joinable](cols securityEventColumns, typ string) securityEventJoins[Q] {
+	return securityEventJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,1081 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	enums "github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// TaxpayerProfile is an object representing the database table.
+type TaxpayerProfile struct {
+	ID                           int64                 `db:"id,pk" `
+	OrganisationID               int64                 `db:"organisation_id" `
+	Name                         string                `db:"name" `
+	Tin                          string                `db:"tin" `
+	IDType                       enums.TaxpayerIDTypes `db:"id_type" `
+	IDValue                      string                `db:"id_value" `
+	SSTRegistrationNumber        string                `db:"sst_registration_number" `
+	TourismTaxRegistrationNumber string                `db:"tourism_tax_registration_number" `
+	MsicCode                     string                `db:"msic_code" `
+	BusinessActivityDescription  string                `db:"business_activity_description" `
+	AddressLine1                 string                `db:"address_line1" `
+	AddressLine2                 null.Val[string]      `db:"address_line2" `
+	AddressLine3                 null.Val[string]      `db:"address_line3" `
+	PostalZone                   null.Val[string]      `db:"postal_zone" `
+	CityName                     string                `db:"city_name" `
+	StateCode                    string                `db:"state_code" `
+	CountryCode                  string                `db:"country_code" `
+	Phone                        string                `db:"phone" `
+	Email                        null.Val[string]      `db:"email" `
+	CreatedAt                    null.Val[time.Time]   `db:"created_at" `
+	UpdatedAt                    null.Val[time.Time]   `db:"updated_at" `
+
+	R taxpayerProfileR `db:"-" `
+}
+
+// TaxpayerProfileSlice is an alias for a slice of pointers to TaxpayerProfile.
+// This should almost always be used instead of []*TaxpayerProfile.
+type TaxpayerProfileSlice []*TaxpayerProfile
+
+// TaxpayerProfiles contains methods to work with the taxpayer_profiles table
+var TaxpayerProfiles = psql.NewTablex[*TaxpayerProfile, TaxpayerProfileSlice, *TaxpayerProfileSetter]("", "taxpayer_profiles", buildTaxpayerProfileColumns("taxpayer_profiles"))
+
+// TaxpayerProfilesQuery is a query on the taxpayer_profiles table
+type TaxpayerProfilesQuery = *psql.ViewQuery[*TaxpayerProfile, TaxpayerProfileSlice]
+
+// taxpayerProfileR is where relationships are stored.
+type taxpayerProfileR struct {
+	Organisation *Organisation // taxpayer_profiles.taxpayer_profiles_organisation_id_fkey
+}
+
+func buildTaxpayerProfileColumns(alias string) taxpayerProfileColumns {
+	return taxpayerProfileColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "organisation_id", "name", "tin", "id_type", "id_value", "sst_registration_number", "tourism_tax_registration_number", "msic_code", "business_activity_description", "address_line1", "address_line2", "address_line3", "postal_zone", "city_name", "state_code", "country_code", "phone", "email", "created_at", "updated_at",
+		).WithParent("taxpayer_profiles"),
+		tableAlias:                   alias,
+		ID:                           psql.Quote(alias, "id"),
+		OrganisationID:               psql.Quote(alias, "organisation_id"),
+		Name:                         psql.Quote(alias, "name"),
+		Tin:                          psql.Quote(alias, "tin"),
+		IDType:                       psql.Quote(alias, "id_type"),
+		IDValue:                      psql.Quote(alias, "id_value"),
+		SSTRegistrationNumber:        psql.Quote(alias, "sst_registration_number"),
+		TourismTaxRegistrationNumber: psql.Quote(alias, "tourism_tax_registration_number"),
+		MsicCode:                     psql.Quote(alias, "msic_code"),
+		BusinessActivityDescription:  psql.Quote(alias, "business_activity_description"),
+		AddressLine1:                 psql.Quote(alias, "address_line1"),
+		AddressLine2:                 psql.Quote(alias, "address_line2"),
+		AddressLine3:                 psql.Quote(alias, "address_line3"),
+		PostalZone:                   psql.Quote(alias, "postal_zone"),
+		CityName:                     psql.Quote(alias, "city_name"),
+		StateCode:                    psql.Quote(alias, "state_code"),
+		CountryCode:                  psql.Quote(alias, "country_code"),
+		Phone:                        psql.Quote(alias, "phone"),
+		Email:                        psql.Quote(alias, "email"),
+		CreatedAt:                    psql.Quote(alias, "created_at"),
+		UpdatedAt:                    psql.Quote(alias, "updated_at"),
+	}
+}
+
+type taxpayerProfileColumns struct {
+	expr.ColumnsExpr
+	tableAlias                   string
+	ID                           psql.Expression
+	OrganisationID               psql.Expression
+	Name                         psql.Expression
+	Tin                          psql.Expression
+	IDType                       psql.Expression
+	IDValue                      psql.Expression
+	SSTRegistrationNumber        psql.Expression
+	TourismTaxRegistrationNumber psql.Expression
+	MsicCode                     psql.Expression
+	BusinessActivityDescription  psql.Expression
+	AddressLine1                 psql.Expression
+	AddressLine2                 psql.Expression
+	AddressLine3                 psql.Expression
+	PostalZone                   psql.Expression
+	CityName                     psql.Expression
+	StateCode                    psql.Expression
+	CountryCode                  psql.Expression
+	Phone                        psql.Expression
+	Email                        psql.Expression
+	CreatedAt                    psql.Expression
+	UpdatedAt                    psql.Expression
+}
+
+func (c taxpayerProfileColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (taxpayerProfileColumns) AliasedAs(alias string) taxpayerProfileColumns {
+	return buildTaxpayerProfileColumns(alias)
+}
+
+// TaxpayerProfileSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type TaxpayerProfileSetter struct {
+	ID                           omit.Val[int64]                 `db:"id,pk" `
+	OrganisationID               omit.Val[int64]                 `db:"organisation_id" `
+	Name                         omit.Val[string]                `db:"name" `
+	Tin                          omit.Val[string]                `db:"tin" `
+	IDType                       omit.Val[enums.TaxpayerIDTypes] `db:"id_type" `
+	IDValue                      omit.Val[string]                `db:"id_value" `
+	SSTRegistrationNumber        omit.Val[string]                `db:"sst_registration_number" `
+	TourismTaxRegistrationNumber omit.Val[string]                `db:"tourism_tax_registration_number" `
+	MsicCode                     omit.Val[string]                `db:"msic_code" `
+	BusinessActivityDescription  omit.Val[string]                `db:"business_activity_description" `
+	AddressLine1                 omit.Val[string]                `db:"address_line1" `
+	AddressLine2                 omitnull.Val[string]            `db:"address_line2" `
+	AddressLine3                 omitnull.Val[string]            `db:"address_line3" `
+	PostalZone                   omitnull.Val[string]            `db:"postal_zone" `
+	CityName                     omit.Val[string]                `db:"city_name" `
+	StateCode                    omit.Val[string]                `db:"state_code" `
+	CountryCode                  omit.Val[string]                `db:"country_code" `
+	Phone                        omit.Val[string]                `db:"phone" `
+	Email                        omitnull.Val[string]            `db:"email" `
+	CreatedAt                    omitnull.Val[time.Time]         `db:"created_at" `
+	UpdatedAt                    omitnull.Val[time.Time]         `db:"updated_at" `
+}
+
+func (s TaxpayerProfileSetter) SetColumns() []string {
+	vals := make([]string, 0, 21)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.OrganisationID.IsValue() {
+		vals = append(vals, "organisation_id")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if s.Tin.IsValue() {
+		vals = append(vals, "tin")
+	}
+	if s.IDType.IsValue() {
+		vals = append(vals, "id_type")
+	}
+	if s.IDValue.IsValue() {
+		vals = append(vals, "id_value")
+	}
+	if s.SSTRegistrationNumber.IsValue() {
+		vals = append(vals, "sst_registration_number")
+	}
+	if s.TourismTaxRegistrationNumber.IsValue() {
+		vals = append(vals, "tourism_tax_registration_number")
+	}
+	if s.MsicCode.IsValue() {
+		vals = append(vals, "msic_code")
+	}
+	if s.BusinessActivityDescription.IsValue() {
+		vals = append(vals, "business_activity_description")
+	}
+	if s.AddressLine1.IsValue() {
+		vals = append(vals, "address_line1")
+	}
+	if !s.AddressLine2.IsUnset() {
+		vals = append(vals, "address_line2")
+	}
+	if !s.AddressLine3.IsUnset() {
+		vals = append(vals, "address_line3")
+	}
+	if !s.PostalZone.IsUnset() {
+		vals = append(vals, "postal_zone")
+	}
+	if s.CityName.IsValue() {
+		vals = append(vals, "city_name")
+	}
+	if s.StateCode.IsValue() {
+		vals = append(vals, "state_code")
+	}
+	if s.CountryCode.IsValue() {
+		vals = append(vals, "country_code")
+	}
+	if s.Phone.IsValue() {
+		vals = append(vals, "phone")
+	}
+	if !s.Email.IsUnset() {
+		vals = append(vals, "email")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	if !s.UpdatedAt.IsUnset() {
+		vals = append(vals, "updated_at")
+	}
+	return vals
+}
+
+func (s TaxpayerProfileSetter) Overwrite(t *TaxpayerProfile) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.OrganisationID.IsValue() {
+		t.OrganisationID = s.OrganisationID.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if s.Tin.IsValue() {
+		t.Tin = s.Tin.MustGet()
+	}
+	if s.IDType.IsValue() {
+		t.IDType = s.IDType.MustGet()
+	}
+	if s.IDValue.IsValue() {
+		t.IDValue = s.IDValue.MustGet()
+	}
+	if s.SSTRegistrationNumber.IsValue() {
+		t.SSTRegistrationNumber = s.SSTRegistrationNumber.MustGet()
+	}
+	if s.TourismTaxRegistrationNumber.IsValue() {
+		t.TourismTaxRegistrationNumber = s.TourismTaxRegistrationNumber.MustGet()
+	}
+	if s.MsicCode.IsValue() {
+		t.MsicCode = s.MsicCode.MustGet()
+	}
+	if s.BusinessActivityDescription.IsValue() {
+		t.BusinessActivityDescription = s.BusinessActivityDescription.MustGet()
+	}
+	if s.AddressLine1.IsValue() {
+		t.AddressLine1 = s.AddressLine1.MustGet()
+	}
+	if !s.AddressLine2.IsUnset() {
+		t.AddressLine2 = s.AddressLine2.MustGetNull()
+	}
+	if !s.AddressLine3.IsUnset() {
+		t.AddressLine3 = s.AddressLine3.MustGetNull()
+	}
+	if !s.PostalZone.IsUnset() {
+		t.PostalZone = s.PostalZone.MustGetNull()
+	}
+	if s.CityName.IsValue() {
+		t.CityName = s.CityName.MustGet()
+	}
+	if s.StateCode.IsValue() {
+		t.StateCode = s.StateCode.MustGet()
+	}
+	if s.CountryCode.IsValue() {
+		t.CountryCode = s.CountryCode.MustGet()
+	}
+	if s.Phone.IsValue() {
+		t.Phone = s.Phone.MustGet()
+	}
+	if !s.Email.IsUnset() {
+		t.Email = s.Email.MustGetNull()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+	if !s.UpdatedAt.IsUnset() {
+		t.UpdatedAt = s.UpdatedAt.MustGetNull()
+	}
+}
+
+func (s *TaxpayerProfileSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return TaxpayerProfiles.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 21)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.OrganisationID.IsValue() {
+			vals[1] = psql.Arg(s.OrganisationID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Name.IsValue() {
+			vals[2] = psql.Arg(s.Name.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.Tin.IsValue() {
+			vals[3] = psql.Arg(s.Tin.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.IDType.IsValue() {
+			vals[4] = psql.Arg(s.IDType.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.IDValue.IsValue() {
+			vals[5] = psql.Arg(s.IDValue.MustGet())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.SSTRegistrationNumber.IsValue() {
+			vals[6] = psql.Arg(s.SSTRegistrationNumber.MustGet())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if s.TourismTaxRegistrationNumber.IsValue() {
+			vals[7] = psql.Arg(s.TourismTaxRegistrationNumber.MustGet())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if s.MsicCode.IsValue() {
+			vals[8] = psql.Arg(s.MsicCode.MustGet())
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		if s.BusinessActivityDescription.IsValue() {
+			vals[9] = psql.Arg(s.BusinessActivityDescription.MustGet())
+		} else {
+			vals[9] = psql.Raw("DEFAULT")
+		}
+
+		if s.AddressLine1.IsValue() {
+			vals[10] = psql.Arg(s.AddressLine1.MustGet())
+		} else {
+			vals[10] = psql.Raw("DEFAULT")
+		}
+
+		if !s.AddressLine2.IsUnset() {
+			vals[11] = psql.Arg(s.AddressLine2.MustGetNull())
+		} else {
+			vals[11] = psql.Raw("DEFAULT")
+		}
+
+		if !s.AddressLine3.IsUnset() {
+			vals[12] = psql.Arg(s.AddressLine3.MustGetNull())
+		} else {
+			vals[12] = psql.Raw("DEFAULT")
+		}
+
+		if !s.PostalZone.IsUnset() {
+			vals[13] = psql.Arg(s.PostalZone.MustGetNull())
+		} else {
+			vals[13] = psql.Raw("DEFAULT")
+		}
+
+		if s.CityName.IsValue() {
+			vals[14] = psql.Arg(s.CityName.MustGet())
+		} else {
+			vals[14] = psql.Raw("DEFAULT")
+		}
+
+		if s.StateCode.IsValue() {
+			vals[15] = psql.Arg(s.StateCode.MustGet())
+		} else {
+			vals[15] = psql.Raw("DEFAULT")
+		}
+
+		if s.CountryCode.IsValue() {
+			vals[16] = psql.Arg(s.CountryCode.MustGet())
+		} else {
+			vals[16] = psql.Raw("DEFAULT")
+		}
+
+		if s.Phone.IsValue() {
+			vals[17] = psql.Arg(s.Phone.MustGet())
+		} else {
+			vals[17] = psql.Raw("DEFAULT")
+		}
+
+		if !s.Email.IsUnset() {
+			vals[18] = psql.Arg(s.Email.MustGetNull())
+		} else {
+			vals[18] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[19] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[19] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UpdatedAt.IsUnset() {
+			vals[20] = psql.Arg(s.UpdatedAt.MustGetNull())
+		} else {
+			vals[20] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s TaxpayerProfileSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s TaxpayerProfileSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 21)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.OrganisationID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "organisation_id")...),
+			psql.Arg(s.OrganisationID),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "name")...),
+			psql.Arg(s.Name),
+		}})
+	}
+
+	if s.Tin.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "tin")...),
+			psql.Arg(s.Tin),
+		}})
+	}
+
+	if s.IDType.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id_type")...),
+			psql.Arg(s.IDType),
+		}})
+	}
+
+	if s.IDValue.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id_value")...),
+			psql.Arg(s.IDValue),
+		}})
+	}
+
+	if s.SSTRegistrationNumber.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "sst_registration_number")...),
+			psql.Arg(s.SSTRegistrationNumber),
+		}})
+	}
+
+	if s.TourismTaxRegistrationNumber.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "tourism_tax_registration_number")...),
+			psql.Arg(s.TourismTaxRegistrationNumber),
+		}})
+	}
+
+	if s.MsicCode.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "msic_code")...),
+			psql.Arg(s.MsicCode),
+		}})
+	}
+
+	if s.BusinessActivityDescription.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "business_activity_description")...),
+			psql.Arg(s.BusinessActivityDescription),
+		}})
+	}
+
+	if s.AddressLine1.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "address_line1")...),
+			psql.Arg(s.AddressLine1),
+		}})
+	}
+
+	if !s.AddressLine2.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "address_line2")...),
+			psql.Arg(s.AddressLine2),
+		}})
+	}
+
+	if !s.AddressLine3.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "address_line3")...),
+			psql.Arg(s.AddressLine3),
+		}})
+	}
+
+	if !s.PostalZone.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "postal_zone")...),
+			psql.Arg(s.PostalZone),
+		}})
+	}
+
+	if s.CityName.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "city_name")...),
+			psql.Arg(s.CityName),
+		}})
+	}
+
+	if s.StateCode.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "state_code")...),
+			psql.Arg(s.StateCode),
+		}})
+	}
+
+	if s.CountryCode.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "country_code")...),
+			psql.Arg(s.CountryCode),
+		}})
+	}
+
+	if s.Phone.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "phone")...),
+			psql.Arg(s.Phone),
+		}})
+	}
+
+	if !s.Email.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "email")...),
+			psql.Arg(s.Email),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	if !s.UpdatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "updated_at")...),
+			psql.Arg(s.UpdatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindTaxpayerProfile retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindTaxpayerProfile(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*TaxpayerProfile, error) {
+	if len(cols) == 0 {
+		return TaxpayerProfiles.Query(
+			sm.Where(TaxpayerProfiles.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return TaxpayerProfiles.Query(
+		sm.Where(TaxpayerProfiles.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(TaxpayerProfiles.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// TaxpayerProfileExists checks the presence of a single record by primary key
+func TaxpayerProfileExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return TaxpayerProfiles.Query(
+		sm.Where(TaxpayerProfiles.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after TaxpayerProfile is retrieved from the database
+func (o *TaxpayerProfile) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = TaxpayerProfiles.AfterSelectHooks.RunHooks(ctx, exec, TaxpayerProfileSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = TaxpayerProfiles.AfterInsertHooks.RunHooks(ctx, exec, TaxpayerProfileSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = TaxpayerProfiles.AfterUpdateHooks.RunHooks(ctx, exec, TaxpayerProfileSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = TaxpayerProfiles.AfterDeleteHooks.RunHooks(ctx, exec, TaxpayerProfileSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the TaxpayerProfile
+func (o *TaxpayerProfile) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *TaxpayerProfile) pkEQ() dialect.Expression {
+	return psql.Quote("taxpayer_profiles", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the TaxpayerProfile
+func (o *TaxpayerProfile) Update(ctx context.Context, exec bob.Executor, s *TaxpayerProfileSetter) error {
+	v, err := TaxpayerProfiles.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single TaxpayerProfile record with an executor
+func (o *TaxpayerProfile) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := TaxpayerProfiles.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the TaxpayerProfile using the executor
+func (o *TaxpayerProfile) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := TaxpayerProfiles.Query(
+		sm.Where(TaxpayerProfiles.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after TaxpayerProfileSlice is retrieved from the database
+func (o TaxpayerProfileSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = TaxpayerProfiles.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = TaxpayerProfiles.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = TaxpayerProfiles.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = TaxpayerProfiles.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o TaxpayerProfileSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("taxpayer_profiles", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o TaxpayerProfileSlice) copyMatchingRows(from ...*TaxpayerProfile) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o TaxpayerProfileSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return TaxpayerProfiles.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *TaxpayerProfile:
+				o.copyMatchingRows(retrieved)
+			case []*TaxpayerProfile:
+				o.copyMatchingRows(retrieved...)
+			case TaxpayerProfileSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a TaxpayerProfile or a slice of TaxpayerProfile
+				// then run the AfterUpdateHooks on the slice
+				_, err = TaxpayerProfiles.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o TaxpayerProfileSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return TaxpayerProfiles.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *TaxpayerProfile:
+				o.copyMatchingRows(retrieved)
+			case []*TaxpayerProfile:
+				o.copyMatchingRows(retrieved...)
+			case TaxpayerProfileSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a TaxpayerProfile or a slice of TaxpayerProfile
+				// then run the AfterDeleteHooks on the slice
+				_, err = TaxpayerProfiles.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o TaxpayerProfileSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals TaxpayerProfileSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := TaxpayerProfiles.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o TaxpayerProfileSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := TaxpayerProfiles.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o TaxpayerProfileSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := TaxpayerProfiles.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Organisation starts a query for related objects on organisations
+func (o *TaxpayerProfile) Organisation(mods ...bob.Mod[*dialect.SelectQuery]) OrganisationsQuery {
+	return Organisations.Query(append(mods,
+		sm.Where(Organisations.Columns.ID.EQ(psql.Arg(o.OrganisationID))),
+	)...)
+}
+
+func (os TaxpayerProfileSlice) Organisation(mods ...bob.Mod[*dialect.SelectQuery]) OrganisationsQuery {
+	pkOrganisationID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkOrganisationID = append(pkOrganisationID, o.OrganisationID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkOrganisationID), "bigint[]")),
+	))
+
+	return Organisations.Query(append(mods,
+		sm.Where(psql.Group(Organisations.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachTaxpayerProfileOrganisation0(ctx context.Context, exec bob.Executor, count int, taxpayerProfile0 *TaxpayerProfile, organisation1 *Organisation) (*TaxpayerProfile, error) {
+	setter := &TaxpayerProfileSetter{
+		OrganisationID: omit.From(organisation1.ID),
+	}
+
+	err := taxpayerProfile0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTaxpayerProfileOrganisation0: %w", err)
+	}
+
+	return taxpayerProfile0, nil
+}
+
+func (taxpayerProfile0 *TaxpayerProfile) InsertOrganisation(ctx context.Context, exec bob.Executor, related *OrganisationSetter) error {
+	var err error
+
+	organisation1, err := Organisations.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTaxpayerProfileOrganisation0(ctx, exec, 1, taxpayerProfile0, organisation1)
+	if err != nil {
+		return err
+	}
+
+	taxpayerProfile0.R.Organisation = organisation1
+
+	organisation1.R.TaxpayerProfile = taxpayerProfile0
+
+	return nil
+}
+
+func (taxpayerProfile0 *TaxpayerProfile) AttachOrganisation(ctx context.Context, exec bob.Executor, organisation1 *Organisation) error {
+	var err error
+
+	_, err = attachTaxpayerProfileOrganisation0(ctx, exec, 1, taxpayerProfile0, organisation1)
+	if err != nil {
+		return err
+	}
+
+	taxpayerProfile0.R.Organisation = organisation1
+
+	organisation1.R.TaxpayerProfile = taxpayerProfile0
+
+	return nil
+}
+
+type taxpayerProfileWhere[Q psql.Filterable] struct {
+	ID                           psql.WhereMod[Q, int64]
+	OrganisationID               psql.WhereMod[Q, int64]
+	Name                         psql.WhereMod[Q, string]
+	Tin                          psql.WhereMod[Q, string]
+	IDType                       psql.WhereMod[Q, enums.TaxpayerIDTypes]
+	IDValue                      psql.WhereMod[Q, string]
+	SSTRegistrationNumber        psql.WhereMod[Q, string]
+	TourismTaxRegistrationNumber psql.WhereMod[Q, string]
+	MsicCode                     psql.WhereMod[Q, string]
+	BusinessActivityDescription  psql.WhereMod[Q, string]
+	AddressLine1                 psql.WhereMod[Q, string]
+	AddressLine2                 psql.WhereNullMod[Q, string]
+	AddressLine3                 psql.WhereNullMod[Q, string]
+	PostalZone                   psql.WhereNullMod[Q, string]
+	CityName                     psql.WhereMod[Q, string]
+	StateCode                    psql.WhereMod[Q, string]
+	CountryCode                  psql.WhereMod[Q, string]
+	Phone                        psql.WhereMod[Q, string]
+	Email                        psql.WhereNullMod[Q, string]
+	CreatedAt                    psql.WhereNullMod[Q, time.Time]
+	UpdatedAt                    psql.WhereNullMod[Q, time.Time]
+}
+
+func (taxpayerProfileWhere[Q]) AliasedAs(alias string) taxpayerProfileWhere[Q] {
+	return buildTaxpayerProfileWhere[Q](buildTaxpayerProfileColumns(alias))
+}
+
+func buildTaxpayerProfileWhere[Q psql.Filterable](cols taxpayerProfileColumns) taxpayerProfileWhere[Q] {
+	return taxpayerProfileWhere[Q]{
+		ID:                           psql.Where[Q, int64](cols.ID),
+		OrganisationID:               psql.Where[Q, int64](cols.OrganisationID),
+		Name:                         psql.Where[Q, string](cols.Name),
+		Tin:                          psql.Where[Q, string](cols.Tin),
+		IDType:                       psql.Where[Q, enums.TaxpayerIDTypes](cols.IDType),
+		IDValue:                      psql.Where[Q, string](cols.IDValue),
+		SSTRegistrationNumber:        psql.Where[Q, string](cols.SSTRegistrationNumber),
+		TourismTaxRegistrationNumber: psql.Where[Q, string](cols.TourismTaxRegistrationNumber),
+		MsicCode:                     psql.Where[Q, string](cols.MsicCode),
+		BusinessActivityDescription:  psql.Where[Q, string](cols.BusinessActivityDescription),
+		AddressLine1:                 psql.Where[Q, string](cols.AddressLine1),
+		AddressLine2:                 psql.WhereNull[Q, string](cols.AddressLine2),
+		AddressLine3:                 psql.WhereNull[Q, string](cols.AddressLine3),
+		PostalZone:                   psql.WhereNull[Q, string](cols.PostalZone),
+		CityName:                     psql.Where[Q, string](cols.CityName),
+		StateCode:                    psql.Where[Q, string](cols.StateCode),
+		CountryCode:                  psql.Where[Q, string](cols.CountryCode),
+		Phone:                        psql.Where[Q, string](cols.Phone),
+		Email:                        psql.WhereNull[Q, string](cols.Email),
+		CreatedAt:                    psql.WhereNull[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:                    psql.WhereNull[Q, time.Time](cols.UpdatedAt),
+	}
+}
+
+func (o *TaxpayerProfile) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Organisation":
+		rel, ok := retrieved.(*Organisation)
+		if !ok {
+			return fmt.Errorf("taxpayerProfile cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Organisation = rel
+
+		if rel != nil {
+			rel.R.TaxpayerProfile = o
+		}
+		return nil
+	default:
+		return fmt.Errorf("taxpayerProfile has no relationship %q", name)
+	}
+}
+
+type taxpayerProfilePreloader struct {
+	Organisation func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildTaxpayerProfilePreloader() taxpayerProfilePreloader {
+	return taxpayerProfilePreloader{
+		Organisation: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Organisation, OrganisationSlice](psql.PreloadRel{
+				Name: "Organisation",
+				Sides: []psql.PreloadSide{
+					{
+						From:        TaxpayerProfiles,
+						To:          Organisations,
+						FromColumns: []string{"organisation_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Organisations.Columns.Names(), opts...)
+		},
+	}
+}
+
+type taxpayerProfileThenLoader[Q orm.Loadable] struct {
+	Organisation func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildTaxpayerProfileThenLoader[Q orm.Loadable]() taxpayerProfileThenLoader[Q] {
+	type OrganisationLoadInterface interface {
+		LoadOrganisation(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return taxpayerProfileThenLoader[Q]{
+		Organisation: thenLoadBuilder[Q](
+			"Organisation",
+			func(ctx context.Context, exec bob.Executor, retrieved OrganisationLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadOrganisation(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadOrganisation loads the taxpayerProfile's Organisation into the .R struct
+func (o *TaxpayerProfile) LoadOrganisation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Organisation = nil
+
+	related, err := o.Organisation(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.TaxpayerProfile = o
+
+	o.R.Organisation = related
+	return nil
+}
+
+// LoadOrganisation loads the taxpayerProfile's Organisation into the .R struct
+func (os TaxpayerProfileSlice) LoadOrganisation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	organisations, err := os.Organisation(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range organisations {
+
+			if !(o.OrganisationID == rel.ID) {
+				continue
+			}
+
+			rel.R.TaxpayerProfile = o
+
+			o.R.Organisation = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type taxpayerProfileJoins[Q dialect.Joinable] struct {
+	typ          string
+	Organisation modAs[Q, organisationColumns]
+}
+
+func (j taxpayerProfileJoins[Q]) aliasedAs(alias string) taxpayerProfileJoins[Q] {
+	return buildTaxpayerProfileJoins[Q](buildTaxpayerProfileColumns(alias), j.typ)
+}
+
+func buildTaxpayerProfileJoins[Q dialect.Joinable](cols taxpayerProfileColumns, typ string) taxpayerProfileJoins[Q] {
+	return taxpayerProfileJoins[Q]{
+		typ: typ,
+		Organisation: modAs[Q, organisationColumns]{
+			c: Organisations.Columns,
+			f: func(to organisationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Organisations.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.OrganisationID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,755 @@
+// Code generated by BobGen psql v0.41.1. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// UserIdentity is an object representing the database table.
+type UserIdentity struct {
+	ID          int64               `db:"id,pk" `
+	UserID      int64               `db:"user_id" `
+	Provider    string              `db:"provider" `
+	Subject     string              `db:"subject" `
+	Email       string              `db:"email" `
+	LastLoginAt null.Val[time.Time] `db:"last_login_at" `
+	CreatedAt   null.Val[time.Time] `db:"created_at" `
+	UpdatedAt   null.Val[time.Time] `db:"updated_at" `
+
+	R userIdentityR `db:"-" `
+}
+
+// UserIdentitySlice is an alias for a slice of pointers to UserIdentity.
+// This should almost always be used instead of []*UserIdentity.
+type UserIdentitySlice []*UserIdentity
+
+// UserIdentities contains methods to work with the user_identities table
+var UserIdentities = psql.NewTablex[*UserIdentity, UserIdentitySlice, *UserIdentitySetter]("", "user_identities", buildUserIdentityColumns("user_identities"))
+
+// UserIdentitiesQuery is a query on the user_identities table
+type UserIdentitiesQuery = *psql.ViewQuery[*UserIdentity, UserIdentitySlice]
+
+// userIdentityR is where relationships are stored.
+type userIdentityR struct {
+	User *User // user_identities.user_identities_user_id_fkey
+}
+
+func buildUserIdentityColumns(alias string) userIdentityColumns {
+	return userIdentityColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "user_id", "provider", "subject", "email", "last_login_at", "created_at", "updated_at",
+		).WithParent("user_identities"),
+		tableAlias:  alias,
+		ID:          psql.Quote(alias, "id"),
+		UserID:      psql.Quote(alias, "user_id"),
+		Provider:    psql.Quote(alias, "provider"),
+		Subject:     psql.Quote(alias, "subject"),
+		Email:       psql.Quote(alias, "email"),
+		LastLoginAt: psql.Quote(alias, "last_login_at"),
+		CreatedAt:   psql.Quote(alias, "created_at"),
+		UpdatedAt:   psql.Quote(alias, "updated_at"),
+	}
+}
+
+type userIdentityColumns struct {
+	expr.ColumnsExpr
+	tableAlias  string
+	ID          psql.Expression
+	UserID      psql.Expression
+	Provider    psql.Expression
+	Subject     psql.Expression
+	Email       psql.Expression
+	LastLoginAt psql.Expression
+	CreatedAt   psql.Expression
+	UpdatedAt   psql.Expression
+}
+
+func (c userIdentityColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (userIdentityColumns) AliasedAs(alias string) userIdentityColumns {
+	return buildUserIdentityColumns(alias)
+}
+
+// UserIdentitySetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type UserIdentitySetter struct {
+	ID          omit.Val[int64]         `db:"id,pk" `
+	UserID      omit.Val[int64]         `db:"user_id" `
+	Provider    omit.Val[string]        `db:"provider" `
+	Subject     omit.Val[string]        `db:"subject" `
+	Email       omit.Val[string]        `db:"email" `
+	LastLoginAt omitnull.Val[time.Time] `db:"last_login_at" `
+	CreatedAt   omitnull.Val[time.Time] `db:"created_at" `
+	UpdatedAt   omitnull.Val[time.Time] `db:"updated_at" `
+}
+
+func (s UserIdentitySetter) SetColumns() []string {
+	vals := make([]string, 0, 8)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.Provider.IsValue() {
+		vals = append(vals, "provider")
+	}
+	if s.Subject.IsValue() {
+		vals = append(vals, "subject")
+	}
+	if s.Email.IsValue() {
+		vals = append(vals, "email")
+	}
+	if !s.LastLoginAt.IsUnset() {
+		vals = append(vals, "last_login_at")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	if !s.UpdatedAt.IsUnset() {
+		vals = append(vals, "updated_at")
+	}
+	return vals
+}
+
+func (s UserIdentitySetter) Overwrite(t *UserIdentity) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.Provider.IsValue() {
+		t.Provider = s.Provider.MustGet()
+	}
+	if s.Subject.IsValue() {
+		t.Subject = s.Subject.MustGet()
+	}
+	if s.Email.IsValue() {
+		t.Email = s.Email.MustGet()
+	}
+	if !s.LastLoginAt.IsUnset() {
+		t.LastLoginAt = s.LastLoginAt.MustGetNull()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+	if !s.UpdatedAt.IsUnset() {
+		t.UpdatedAt = s.UpdatedAt.MustGetNull()
+	}
+}
+
+func (s *UserIdentitySetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return UserIdentities.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 8)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserID.IsValue() {
+			vals[1] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Provider.IsValue() {
+			vals[2] = psql.Arg(s.Provider.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.Subject.IsValue() {
+			vals[3] = psql.Arg(s.Subject.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.Email.IsValue() {
+			vals[4] = psql.Arg(s.Email.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if !s.LastLoginAt.IsUnset() {
+			vals[5] = psql.Arg(s.LastLoginAt.MustGetNull())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[6] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UpdatedAt.IsUnset() {
+			vals[7] = psql.Arg(s.UpdatedAt.MustGetNull())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s UserIdentitySetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s UserIdentitySetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 8)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.Provider.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "provider")...),
+			psql.Arg(s.Provider),
+		}})
+	}
+
+	if s.Subject.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "subject")...),
+			psql.Arg(s.Subject),
+		}})
+	}
+
+	if s.Email.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "email")...),
+			psql.Arg(s.Email),
+		}})
+	}
+
+	if !s.LastLoginAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "last_login_at")...),
+			psql.Arg(s.LastLoginAt),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	if !s.UpdatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "updated_at")...),
+			psql.Arg(s.UpdatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindUserIdentity retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindUserIdentity(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*UserIdentity, error) {
+	if len(cols) == 0 {
+		return UserIdentities.Query(
+			sm.Where(UserIdentities.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return UserIdentities.Query(
+		sm.Where(UserIdentities.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(UserIdentities.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// UserIdentityExists checks the presence of a single record by primary key
+func UserIdentityExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return UserIdentities.Query(
+		sm.Where(UserIdentities.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after UserIdentity is retrieved from the database
+func (o *UserIdentity) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = UserIdentities.AfterSelectHooks.RunHooks(ctx, exec, UserIdentitySlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = UserIdentities.AfterInsertHooks.RunHooks(ctx, exec, UserIdentitySlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = UserIdentities.AfterUpdateHooks.RunHooks(ctx, exec, UserIdentitySlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = UserIdentities.AfterDeleteHooks.RunHooks(ctx, exec, UserIdentitySlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the UserIdentity
+func (o *UserIdentity) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *UserIdentity) pkEQ() dialect.Expression {
+	return psql.Quote("user_identities", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the UserIdentity
+func (o *UserIdentity) Update(ctx context.Context, exec bob.Executor, s *UserIdentitySetter) error {
+	v, err := UserIdentities.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single UserIdentity record with an executor
+func (o *UserIdentity) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := UserIdentities.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the UserIdentity using the executor
+func (o *UserIdentity) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := UserIdentities.Query(
+		sm.Where(UserIdentities.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after UserIdentitySlice is retrieved from the database
+func (o UserIdentitySlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = UserIdentities.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = UserIdentities.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = UserIdentities.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = UserIdentities.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o UserIdentitySlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("user_identities", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o UserIdentitySlice) copyMatchingRows(from ...*UserIdentity) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o UserIdentitySlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return UserIdentities.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *UserIdentity:
+				o.copyMatchingRows(retrieved)
+			case []*UserIdentity:
+				o.copyMatchingRows(retrieved...)
+			case UserIdentitySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a UserIdentity or a slice of UserIdentity
+				// then run the AfterUpdateHooks on the slice
+				_, err = UserIdentities.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o UserIdentitySlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return UserIdentities.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *UserIdentity:
+				o.copyMatchingRows(retrieved)
+			case []*UserIdentity:
+				o.copyMatchingRows(retrieved...)
+			case UserIdentitySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a UserIdentity or a slice of UserIdentity
+				// then run the AfterDeleteHooks on the slice
+				_, err = UserIdentities.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o UserIdentitySlice) UpdateAll(ctx context.Context, exec bob.Executor, vals UserIdentitySetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := UserIdentities.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o UserIdentitySlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := UserIdentities.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o UserIdentitySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := UserIdentities.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on users
+func (o *UserIdentity) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os UserIdentitySlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "bigint[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachUserIdentityUser0(ctx context.Context, exec bob.Executor, count int, userIdentity0 *UserIdentity, user1 *User) (*UserIdentity, error) {
+	setter := &UserIdentitySetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := userIdentity0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserIdentityUser0: %w", err)
+	}
+
+	return userIdentity0, nil
+}
+
+func (userIdentity0 *UserIdentity) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachUserIdentityUser0(ctx, exec, 1, userIdentity0, user1)
+	if err != nil {
+		return err
+	}
+
+	userIdentity0.R.User = user1
+
+	user1.R.UserIdentities = append(user1.R.UserIdentities, userIdentity0)
+
+	return nil
+}
+
+func (userIdentity0 *UserIdentity) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachUserIdentityUser0(ctx, exec, 1, userIdentity0, user1)
+	if err != nil {
+		return err
+	}
+
+	userIdentity0.R.User = user1
+
+	user1.R.UserIdentities = append(user1.R.UserIdentities, userIdentity0)
+
+	return nil
+}
+
+type userIdentityWhere[Q psql.Filterable] struct {
+	ID          psql.WhereMod[Q, int64]
+	UserID      psql.WhereMod[Q, int64]
+	Provider    psql.WhereMod[Q, string]
+	Subject     psql.WhereMod[Q, string]
+	Email       psql.WhereMod[Q, string]
+	LastLoginAt psql.WhereNullMod[Q, time.Time]
+	CreatedAt   psql.WhereNullMod[Q, time.Time]
+	UpdatedAt   psql.WhereNullMod[Q, time.Time]
+}
+
+func (userIdentityWhere[Q]) AliasedAs(alias string) userIdentityWhere[Q] {
+	return buildUserIdentityWhere[Q](buildUserIdentityColumns(alias))
+}
+
+func buildUserIdentityWhere[Q psql.Filterable](cols userIdentityColumns) userIdentityWhere[Q] {
+	return userIdentityWhere[Q]{
+		ID:          psql.Where[Q, int64](cols.ID),
+		UserID:      psql.Where[Q, int64](cols.UserID),
+		Provider:    psql.Where[Q, string](cols.Provider),
+		Subject:     psql.Where[Q, string](cols.Subject),
+		Email:       psql.Where[Q, string](cols.Email),
+		LastLoginAt: psql.WhereNull[Q, time.Time](cols.LastLoginAt),
+		CreatedAt:   psql.WhereNull[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:   psql.WhereNull[Q, time.Time](cols.UpdatedAt),
+	}
+}
+
+func (o *UserIdentity) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("userIdentity cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.UserIdentities = UserIdentitySlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("userIdentity has no relationship %q", name)
+	}
+}
+
+type userIdentityPreloader struct {
+	User func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildUserIdentityPreloader() userIdentityPreloader {
+	return userIdentityPreloader{
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        UserIdentities,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type userIdentityThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildUserIdentityThenLoader[Q orm.Loadable]() userIdentityThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return userIdentityThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the userIdentity's User into the .R struct
+func (o *UserIdentity) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.UserIdentities = UserIdentitySlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the userIdentity's User into the .R struct
+func (os UserIdentitySlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.UserIdentities = append(rel.R.UserIdentities, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type userIdentityJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+}
+
+func (j userIdentityJoins[Q]) aliasedAs(alias string) userIdentityJoins[Q] {
+	return buildUserIdentityJoins[Q](buildUserIdentityColumns(alias), j.typ)
+}
+
+func buildUserIdentityJoins[Q dialect.Joinable](cols userIdentityColumns, typ string) userIdentityJoins[Q] {
+	return userIdentityJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
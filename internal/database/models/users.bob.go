@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strconv"
 	"time"
 
 	"github.com/aarondl/opt/null"
@@ -23,6 +24,7 @@ import (
 	"github.com/stephenafamo/bob/mods"
 	"github.com/stephenafamo/bob/orm"
 	"github.com/stephenafamo/bob/types/pgtypes"
+	"github.com/stephenafamo/scan"
 )
 
 // User is an object representing the database table.
@@ -37,6 +39,9 @@ type User struct {
 	CreatedAt       null.Val[time.Time] `db:"created_at" `
 	UpdatedAt       null.Val[time.Time] `db:"updated_at" `
 	DeletedAt       null.Val[time.Time] `db:"deleted_at" `
+	TotpSecret      null.Val[string]    `db:"totp_secret" `
+	TotpEnabledAt   null.Val[time.Time] `db:"totp_enabled_at" `
+	PendingEmail    null.Val[string]    `db:"pending_email" `
 
 	R userR `db:"-" `
 }
@@ -53,14 +58,25 @@ type UsersQuery = *psql.ViewQuery[*User, UserSlice]
 
 // userR is where relationships are stored.
 type userR struct {
-	AuthTokens   AuthTokenSlice   // auth_tokens.auth_tokens_user_id_fkey
-	FailedLogins FailedLoginSlice // failed_logins.failed_logins_user_id_fkey
+	APIKeys                 APIKeySlice             // api_keys.api_keys_user_id_fkey
+	AuthTokens              AuthTokenSlice          // auth_tokens.auth_tokens_user_id_fkey
+	FailedLogins            FailedLoginSlice        // failed_logins.failed_logins_user_id_fkey
+	AcceptedUserInvitations InvitationSlice         // invitations.invitations_accepted_user_id_fkey
+	InvitedByInvitations    InvitationSlice         // invitations.invitations_invited_by_fkey
+	CreatedByInvoices       InvoiceSlice            // invoices.invoices_created_by_fkey
+	MfaRecoveryCodes        MfaRecoveryCodeSlice    // mfa_recovery_codes.mfa_recovery_codes_user_id_fkey
+	OauthClients            OauthClientSlice        // oauth_clients.oauth_clients_user_id_fkey
+	OrganisationMembers     OrganisationMemberSlice // organisation_members.organisation_members_user_id_fkey
+	PasswordHistories       PasswordHistorySlice    // password_histories.password_histories_user_id_fkey
+	SecurityEvents          SecurityEventSlice      // security_events.security_events_user_id_fkey
+	UserIdentities          UserIdentitySlice       // user_identities.user_identities_user_id_fkey
+	Roles                   RoleSlice               // user_roles.user_roles_role_id_fkeyuser_roles.user_roles_user_id_fkey
 }
 
 func buildUserColumns(alias string) userColumns {
 	return userColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "first_name", "last_name", "password", "email", "email_verified_at", "status", "created_at", "updated_at", "deleted_at",
+			"id", "first_name", "last_name", "password", "email", "email_verified_at", "status", "created_at", "updated_at", "deleted_at", "totp_secret", "totp_enabled_at", "pending_email",
 		).WithParent("users"),
 		tableAlias:      alias,
 		ID:              psql.Quote(alias, "id"),
@@ -73,6 +89,9 @@ func buildUserColumns(alias string) userColumns {
 		CreatedAt:       psql.Quote(alias, "created_at"),
 		UpdatedAt:       psql.Quote(alias, "updated_at"),
 		DeletedAt:       psql.Quote(alias, "deleted_at"),
+		TotpSecret:      psql.Quote(alias, "totp_secret"),
+		TotpEnabledAt:   psql.Quote(alias, "totp_enabled_at"),
+		PendingEmail:    psql.Quote(alias, "pending_email"),
 	}
 }
 
@@ -89,6 +108,9 @@ type userColumns struct {
 	CreatedAt       psql.Expression
 	UpdatedAt       psql.Expression
 	DeletedAt       psql.Expression
+	TotpSecret      psql.Expression
+	TotpEnabledAt   psql.Expression
+	PendingEmail    psql.Expression
 }
 
 func (c userColumns) Alias() string {
@@ -113,10 +135,13 @@ type UserSetter struct {
 	CreatedAt       omitnull.Val[time.Time]      `db:"created_at" `
 	UpdatedAt       omitnull.Val[time.Time]      `db:"updated_at" `
 	DeletedAt       omitnull.Val[time.Time]      `db:"deleted_at" `
+	TotpSecret      omitnull.Val[string]         `db:"totp_secret" `
+	TotpEnabledAt   omitnull.Val[time.Time]      `db:"totp_enabled_at" `
+	PendingEmail    omitnull.Val[string]         `db:"pending_email" `
 }
 
 func (s UserSetter) SetColumns() []string {
-	vals := make([]string, 0, 10)
+	vals := make([]string, 0, 13)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
@@ -147,6 +172,15 @@ func (s UserSetter) SetColumns() []string {
 	if !s.DeletedAt.IsUnset() {
 		vals = append(vals, "deleted_at")
 	}
+	if !s.TotpSecret.IsUnset() {
+		vals = append(vals, "totp_secret")
+	}
+	if !s.TotpEnabledAt.IsUnset() {
+		vals = append(vals, "totp_enabled_at")
+	}
+	if !s.PendingEmail.IsUnset() {
+		vals = append(vals, "pending_email")
+	}
 	return vals
 }
 
@@ -181,6 +215,15 @@ func (s UserSetter) Overwrite(t *User) {
 	if !s.DeletedAt.IsUnset() {
 		t.DeletedAt = s.DeletedAt.MustGetNull()
 	}
+	if !s.TotpSecret.IsUnset() {
+		t.TotpSecret = s.TotpSecret.MustGetNull()
+	}
+	if !s.TotpEnabledAt.IsUnset() {
+		t.TotpEnabledAt = s.TotpEnabledAt.MustGetNull()
+	}
+	if !s.PendingEmail.IsUnset() {
+		t.PendingEmail = s.PendingEmail.MustGetNull()
+	}
 }
 
 func (s *UserSetter) Apply(q *dialect.InsertQuery) {
@@ -189,7 +232,7 @@ func (s *UserSetter) Apply(q *dialect.InsertQuery) {
 	})
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 10)
+		vals := make([]bob.Expression, 13)
 		if s.ID.IsValue() {
 			vals[0] = psql.Arg(s.ID.MustGet())
 		} else {
@@ -250,6 +293,24 @@ func (s *UserSetter) Apply(q *dialect.InsertQuery) {
 			vals[9] = psql.Raw("DEFAULT")
 		}
 
+		if !s.TotpSecret.IsUnset() {
+			vals[10] = psql.Arg(s.TotpSecret.MustGetNull())
+		} else {
+			vals[10] = psql.Raw("DEFAULT")
+		}
+
+		if !s.TotpEnabledAt.IsUnset() {
+			vals[11] = psql.Arg(s.TotpEnabledAt.MustGetNull())
+		} else {
+			vals[11] = psql.Raw("DEFAULT")
+		}
+
+		if !s.PendingEmail.IsUnset() {
+			vals[12] = psql.Arg(s.PendingEmail.MustGetNull())
+		} else {
+			vals[12] = psql.Raw("DEFAULT")
+		}
+
 		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
 	}))
 }
@@ -259,7 +320,7 @@ func (s UserSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s UserSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 10)
+	exprs := make([]bob.Expression, 0, 13)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -331,6 +392,27 @@ func (s UserSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if !s.TotpSecret.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "totp_secret")...),
+			psql.Arg(s.TotpSecret),
+		}})
+	}
+
+	if !s.TotpEnabledAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "totp_enabled_at")...),
+			psql.Arg(s.TotpEnabledAt),
+		}})
+	}
+
+	if !s.PendingEmail.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "pending_email")...),
+			psql.Arg(s.PendingEmail),
+		}})
+	}
+
 	return exprs
 }
 
@@ -557,6 +639,30 @@ func (o UserSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 	return nil
 }
 
+// APIKeys starts a query for related objects on api_keys
+func (o *User) APIKeys(mods ...bob.Mod[*dialect.SelectQuery]) APIKeysQuery {
+	return APIKeys.Query(append(mods,
+		sm.Where(APIKeys.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) APIKeys(mods ...bob.Mod[*dialect.SelectQuery]) APIKeysQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return APIKeys.Query(append(mods,
+		sm.Where(psql.Group(APIKeys.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
 // AuthTokens starts a query for related objects on auth_tokens
 func (o *User) AuthTokens(mods ...bob.Mod[*dialect.SelectQuery]) AuthTokensQuery {
 	return AuthTokens.Query(append(mods,
@@ -605,134 +711,311 @@ func (os UserSlice) FailedLogins(mods ...bob.Mod[*dialect.SelectQuery]) FailedLo
 	)...)
 }
 
-func insertUserAuthTokens0(ctx context.Context, exec bob.Executor, authTokens1 []*AuthTokenSetter, user0 *User) (AuthTokenSlice, error) {
-	for i := range authTokens1 {
-		authTokens1[i].UserID = omit.From(user0.ID)
+// AcceptedUserInvitations starts a query for related objects on invitations
+func (o *User) AcceptedUserInvitations(mods ...bob.Mod[*dialect.SelectQuery]) InvitationsQuery {
+	return Invitations.Query(append(mods,
+		sm.Where(Invitations.Columns.AcceptedUserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) AcceptedUserInvitations(mods ...bob.Mod[*dialect.SelectQuery]) InvitationsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
 
-	ret, err := AuthTokens.Insert(bob.ToMods(authTokens1...)).All(ctx, exec)
-	if err != nil {
-		return ret, fmt.Errorf("insertUserAuthTokens0: %w", err)
+	return Invitations.Query(append(mods,
+		sm.Where(psql.Group(Invitations.Columns.AcceptedUserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// InvitedByInvitations starts a query for related objects on invitations
+func (o *User) InvitedByInvitations(mods ...bob.Mod[*dialect.SelectQuery]) InvitationsQuery {
+	return Invitations.Query(append(mods,
+		sm.Where(Invitations.Columns.InvitedBy.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) InvitedByInvitations(mods ...bob.Mod[*dialect.SelectQuery]) InvitationsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
 
-	return ret, nil
+	return Invitations.Query(append(mods,
+		sm.Where(psql.Group(Invitations.Columns.InvitedBy).OP("IN", PKArgExpr)),
+	)...)
 }
 
-func attachUserAuthTokens0(ctx context.Context, exec bob.Executor, count int, authTokens1 AuthTokenSlice, user0 *User) (AuthTokenSlice, error) {
-	setter := &AuthTokenSetter{
-		UserID: omit.From(user0.ID),
+// CreatedByInvoices starts a query for related objects on invoices
+func (o *User) CreatedByInvoices(mods ...bob.Mod[*dialect.SelectQuery]) InvoicesQuery {
+	return Invoices.Query(append(mods,
+		sm.Where(Invoices.Columns.CreatedBy.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) CreatedByInvoices(mods ...bob.Mod[*dialect.SelectQuery]) InvoicesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
 
-	err := authTokens1.UpdateAll(ctx, exec, *setter)
-	if err != nil {
-		return nil, fmt.Errorf("attachUserAuthTokens0: %w", err)
+	return Invoices.Query(append(mods,
+		sm.Where(psql.Group(Invoices.Columns.CreatedBy).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// MfaRecoveryCodes starts a query for related objects on mfa_recovery_codes
+func (o *User) MfaRecoveryCodes(mods ...bob.Mod[*dialect.SelectQuery]) MfaRecoveryCodesQuery {
+	return MfaRecoveryCodes.Query(append(mods,
+		sm.Where(MfaRecoveryCodes.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) MfaRecoveryCodes(mods ...bob.Mod[*dialect.SelectQuery]) MfaRecoveryCodesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
 
-	return authTokens1, nil
+	return MfaRecoveryCodes.Query(append(mods,
+		sm.Where(psql.Group(MfaRecoveryCodes.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-func (user0 *User) InsertAuthTokens(ctx context.Context, exec bob.Executor, related ...*AuthTokenSetter) error {
-	if len(related) == 0 {
-		return nil
+// OauthClients starts a query for related objects on oauth_clients
+func (o *User) OauthClients(mods ...bob.Mod[*dialect.SelectQuery]) OauthClientsQuery {
+	return OauthClients.Query(append(mods,
+		sm.Where(OauthClients.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) OauthClients(mods ...bob.Mod[*dialect.SelectQuery]) OauthClientsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
 
-	var err error
+	return OauthClients.Query(append(mods,
+		sm.Where(psql.Group(OauthClients.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
 
-	authTokens1, err := insertUserAuthTokens0(ctx, exec, related, user0)
-	if err != nil {
-		return err
+// OrganisationMembers starts a query for related objects on organisation_members
+func (o *User) OrganisationMembers(mods ...bob.Mod[*dialect.SelectQuery]) OrganisationMembersQuery {
+	return OrganisationMembers.Query(append(mods,
+		sm.Where(OrganisationMembers.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) OrganisationMembers(mods ...bob.Mod[*dialect.SelectQuery]) OrganisationMembersQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
 
-	user0.R.AuthTokens = append(user0.R.AuthTokens, authTokens1...)
+	return OrganisationMembers.Query(append(mods,
+		sm.Where(psql.Group(OrganisationMembers.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
 
-	for _, rel := range authTokens1 {
-		rel.R.User = user0
+// PasswordHistories starts a query for related objects on password_histories
+func (o *User) PasswordHistories(mods ...bob.Mod[*dialect.SelectQuery]) PasswordHistoriesQuery {
+	return PasswordHistories.Query(append(mods,
+		sm.Where(PasswordHistories.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) PasswordHistories(mods ...bob.Mod[*dialect.SelectQuery]) PasswordHistoriesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
-	return nil
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
+
+	return PasswordHistories.Query(append(mods,
+		sm.Where(psql.Group(PasswordHistories.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-func (user0 *User) AttachAuthTokens(ctx context.Context, exec bob.Executor, related ...*AuthToken) error {
-	if len(related) == 0 {
-		return nil
+// SecurityEvents starts a query for related objects on security_events
+func (o *User) SecurityEvents(mods ...bob.Mod[*dialect.SelectQuery]) SecurityEventsQuery {
+	return SecurityEvents.Query(append(mods,
+		sm.Where(SecurityEvents.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) SecurityEvents(mods ...bob.Mod[*dialect.SelectQuery]) SecurityEventsQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
 
-	var err error
-	authTokens1 := AuthTokenSlice(related)
+	return SecurityEvents.Query(append(mods,
+		sm.Where(psql.Group(SecurityEvents.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
 
-	_, err = attachUserAuthTokens0(ctx, exec, len(related), authTokens1, user0)
-	if err != nil {
-		return err
+// UserIdentities starts a query for related objects on user_identities
+func (o *User) UserIdentities(mods ...bob.Mod[*dialect.SelectQuery]) UserIdentitiesQuery {
+	return UserIdentities.Query(append(mods,
+		sm.Where(UserIdentities.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) UserIdentities(mods ...bob.Mod[*dialect.SelectQuery]) UserIdentitiesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
 
-	user0.R.AuthTokens = append(user0.R.AuthTokens, authTokens1...)
+	return UserIdentities.Query(append(mods,
+		sm.Where(psql.Group(UserIdentities.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
 
-	for _, rel := range related {
-		rel.R.User = user0
+// Roles starts a query for related objects on roles
+func (o *User) Roles(mods ...bob.Mod[*dialect.SelectQuery]) RolesQuery {
+	return Roles.Query(append(mods,
+		sm.InnerJoin(UserRoles.NameAs()).On(
+			Roles.Columns.ID.EQ(UserRoles.Columns.RoleID)),
+		sm.Where(UserRoles.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Roles(mods ...bob.Mod[*dialect.SelectQuery]) RolesQuery {
+	pkID := make(pgtypes.Array[int64], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "bigint[]")),
+	))
 
-	return nil
+	return Roles.Query(append(mods,
+		sm.InnerJoin(UserRoles.NameAs()).On(
+			Roles.Columns.ID.EQ(UserRoles.Columns.RoleID),
+		),
+		sm.Where(psql.Group(UserRoles.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-func insertUserFailedLogins0(ctx context.Context, exec bob.Executor, failedLogins1 []*FailedLoginSetter, user0 *User) (FailedLoginSlice, error) {
-	for i := range failedLogins1 {
-		failedLogins1[i].UserID = omit.From(user0.ID)
+func insertUserAPIKeys0(ctx context.Context, exec bob.Executor, apiKeys1 []*APIKeySetter, user0 *User) (APIKeySlice, error) {
+	for i := range apiKeys1 {
+		apiKeys1[i].UserID = omit.From(user0.ID)
 	}
 
-	ret, err := FailedLogins.Insert(bob.ToMods(failedLogins1...)).All(ctx, exec)
+	ret, err := APIKeys.Insert(bob.ToMods(apiKeys1...)).All(ctx, exec)
 	if err != nil {
-		return ret, fmt.Errorf("insertUserFailedLogins0: %w", err)
+		return ret, fmt.Errorf("insertUserAPIKeys0: %w", err)
 	}
 
 	return ret, nil
 }
 
-func attachUserFailedLogins0(ctx context.Context, exec bob.Executor, count int, failedLogins1 FailedLoginSlice, user0 *User) (FailedLoginSlice, error) {
-	setter := &FailedLoginSetter{
+func attachUserAPIKeys0(ctx context.Context, exec bob.Executor, count int, apiKeys1 APIKeySlice, user0 *User) (APIKeySlice, error) {
+	setter := &APIKeySetter{
 		UserID: omit.From(user0.ID),
 	}
 
-	err := failedLogins1.UpdateAll(ctx, exec, *setter)
+	err := apiKeys1.UpdateAll(ctx, exec, *setter)
 	if err != nil {
-		return nil, fmt.Errorf("attachUserFailedLogins0: %w", err)
+		return nil, fmt.Errorf("attachUserAPIKeys0: %w", err)
 	}
 
-	return failedLogins1, nil
+	return apiKeys1, nil
 }
 
-func (user0 *User) InsertFailedLogins(ctx context.Context, exec bob.Executor, related ...*FailedLoginSetter) error {
+func (user0 *User) InsertAPIKeys(ctx context.Context, exec bob.Executor, related ...*APIKeySetter) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 
-	failedLogins1, err := insertUserFailedLogins0(ctx, exec, related, user0)
+	apiKeys1, err := insertUserAPIKeys0(ctx, exec, related, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.FailedLogins = append(user0.R.FailedLogins, failedLogins1...)
+	user0.R.APIKeys = append(user0.R.APIKeys, apiKeys1...)
 
-	for _, rel := range failedLogins1 {
+	for _, rel := range apiKeys1 {
 		rel.R.User = user0
 	}
 	return nil
 }
 
-func (user0 *User) AttachFailedLogins(ctx context.Context, exec bob.Executor, related ...*FailedLogin) error {
+func (user0 *User) AttachAPIKeys(ctx context.Context, exec bob.Executor, related ...*APIKey) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
-	failedLogins1 := FailedLoginSlice(related)
+	apiKeys1 := APIKeySlice(related)
 
-	_, err = attachUserFailedLogins0(ctx, exec, len(related), failedLogins1, user0)
+	_, err = attachUserAPIKeys0(ctx, exec, len(related), apiKeys1, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.FailedLogins = append(user0.R.FailedLogins, failedLogins1...)
+	user0.R.APIKeys = append(user0.R.APIKeys, apiKeys1...)
 
 	for _, rel := range related {
 		rel.R.User = user0
@@ -741,122 +1024,1827 @@ func (user0 *User) AttachFailedLogins(ctx context.Context, exec bob.Executor, re
 	return nil
 }
 
-type userWhere[Q psql.Filterable] struct {
-	ID              psql.WhereMod[Q, int64]
-	FirstName       psql.WhereMod[Q, string]
-	LastName        psql.WhereMod[Q, string]
-	Password        psql.WhereMod[Q, string]
-	Email           psql.WhereMod[Q, string]
-	EmailVerifiedAt psql.WhereNullMod[Q, time.Time]
-	Status          psql.WhereMod[Q, enums.UserStatuses]
-	CreatedAt       psql.WhereNullMod[Q, time.Time]
-	UpdatedAt       psql.WhereNullMod[Q, time.Time]
-	DeletedAt       psql.WhereNullMod[Q, time.Time]
-}
+func insertUserAuthTokens0(ctx context.Context, exec bob.Executor, authTokens1 []*AuthTokenSetter, user0 *User) (AuthTokenSlice, error) {
+	for i := range authTokens1 {
+		authTokens1[i].UserID = omit.From(user0.ID)
+	}
 
-func (userWhere[Q]) AliasedAs(alias string) userWhere[Q] {
-	return buildUserWhere[Q](buildUserColumns(alias))
+	ret, err := AuthTokens.Insert(bob.ToMods(authTokens1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserAuthTokens0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserAuthTokens0(ctx context.Context, exec bob.Executor, count int, authTokens1 AuthTokenSlice, user0 *User) (AuthTokenSlice, error) {
+	setter := &AuthTokenSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := authTokens1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserAuthTokens0: %w", err)
+	}
+
+	return authTokens1, nil
+}
+
+func (user0 *User) InsertAuthTokens(ctx context.Context, exec bob.Executor, related ...*AuthTokenSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	authTokens1, err := insertUserAuthTokens0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.AuthTokens = append(user0.R.AuthTokens, authTokens1...)
+
+	for _, rel := range authTokens1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachAuthTokens(ctx context.Context, exec bob.Executor, related ...*AuthToken) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	authTokens1 := AuthTokenSlice(related)
+
+	_, err = attachUserAuthTokens0(ctx, exec, len(related), authTokens1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.AuthTokens = append(user0.R.AuthTokens, authTokens1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserFailedLogins0(ctx context.Context, exec bob.Executor, failedLogins1 []*FailedLoginSetter, user0 *User) (FailedLoginSlice, error) {
+	for i := range failedLogins1 {
+		failedLogins1[i].UserID = omitnull.From(user0.ID)
+	}
+
+	ret, err := FailedLogins.Insert(bob.ToMods(failedLogins1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserFailedLogins0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserFailedLogins0(ctx context.Context, exec bob.Executor, count int, failedLogins1 FailedLoginSlice, user0 *User) (FailedLoginSlice, error) {
+	setter := &FailedLoginSetter{
+		UserID: omitnull.From(user0.ID),
+	}
+
+	err := failedLogins1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserFailedLogins0: %w", err)
+	}
+
+	return failedLogins1, nil
+}
+
+func (user0 *User) InsertFailedLogins(ctx context.Context, exec bob.Executor, related ...*FailedLoginSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	failedLogins1, err := insertUserFailedLogins0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.FailedLogins = append(user0.R.FailedLogins, failedLogins1...)
+
+	for _, rel := range failedLogins1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachFailedLogins(ctx context.Context, exec bob.Executor, related ...*FailedLogin) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	failedLogins1 := FailedLoginSlice(related)
+
+	_, err = attachUserFailedLogins0(ctx, exec, len(related), failedLogins1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.FailedLogins = append(user0.R.FailedLogins, failedLogins1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserAcceptedUserInvitations0(ctx context.Context, exec bob.Executor, invitations1 []*InvitationSetter, user0 *User) (InvitationSlice, error) {
+	for i := range invitations1 {
+		invitations1[i].AcceptedUserID = omitnull.From(user0.ID)
+	}
+
+	ret, err := Invitations.Insert(bob.ToMods(invitations1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserAcceptedUserInvitations0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserAcceptedUserInvitations0(ctx context.Context, exec bob.Executor, count int, invitations1 InvitationSlice, user0 *User) (InvitationSlice, error) {
+	setter := &InvitationSetter{
+		AcceptedUserID: omitnull.From(user0.ID),
+	}
+
+	err := invitations1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserAcceptedUserInvitations0: %w", err)
+	}
+
+	return invitations1, nil
+}
+
+func (user0 *User) InsertAcceptedUserInvitations(ctx context.Context, exec bob.Executor, related ...*InvitationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	invitations1, err := insertUserAcceptedUserInvitations0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.AcceptedUserInvitations = append(user0.R.AcceptedUserInvitations, invitations1...)
+
+	for _, rel := range invitations1 {
+		rel.R.AcceptedUserUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachAcceptedUserInvitations(ctx context.Context, exec bob.Executor, related ...*Invitation) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	invitations1 := InvitationSlice(related)
+
+	_, err = attachUserAcceptedUserInvitations0(ctx, exec, len(related), invitations1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.AcceptedUserInvitations = append(user0.R.AcceptedUserInvitations, invitations1...)
+
+	for _, rel := range related {
+		rel.R.AcceptedUserUser = user0
+	}
+
+	return nil
+}
+
+func insertUserInvitedByInvitations0(ctx context.Context, exec bob.Executor, invitations1 []*InvitationSetter, user0 *User) (InvitationSlice, error) {
+	for i := range invitations1 {
+		invitations1[i].InvitedBy = omitnull.From(user0.ID)
+	}
+
+	ret, err := Invitations.Insert(bob.ToMods(invitations1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserInvitedByInvitations0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserInvitedByInvitations0(ctx context.Context, exec bob.Executor, count int, invitations1 InvitationSlice, user0 *User) (InvitationSlice, error) {
+	setter := &InvitationSetter{
+		InvitedBy: omitnull.From(user0.ID),
+	}
+
+	err := invitations1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserInvitedByInvitations0: %w", err)
+	}
+
+	return invitations1, nil
+}
+
+func (user0 *User) InsertInvitedByInvitations(ctx context.Context, exec bob.Executor, related ...*InvitationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	invitations1, err := insertUserInvitedByInvitations0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.InvitedByInvitations = append(user0.R.InvitedByInvitations, invitations1...)
+
+	for _, rel := range invitations1 {
+		rel.R.InvitedByUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachInvitedByInvitations(ctx context.Context, exec bob.Executor, related ...*Invitation) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	invitations1 := InvitationSlice(related)
+
+	_, err = attachUserInvitedByInvitations0(ctx, exec, len(related), invitations1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.InvitedByInvitations = append(user0.R.InvitedByInvitations, invitations1...)
+
+	for _, rel := range related {
+		rel.R.InvitedByUser = user0
+	}
+
+	return nil
+}
+
+func insertUserCreatedByInvoices0(ctx context.Context, exec bob.Executor, invoices1 []*InvoiceSetter, user0 *User) (InvoiceSlice, error) {
+	for i := range invoices1 {
+		invoices1[i].CreatedBy = omitnull.From(user0.ID)
+	}
+
+	ret, err := Invoices.Insert(bob.ToMods(invoices1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserCreatedByInvoices0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserCreatedByInvoices0(ctx context.Context, exec bob.Executor, count int, invoices1 InvoiceSlice, user0 *User) (InvoiceSlice, error) {
+	setter := &InvoiceSetter{
+		CreatedBy: omitnull.From(user0.ID),
+	}
+
+	err := invoices1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserCreatedByInvoices0: %w", err)
+	}
+
+	return invoices1, nil
+}
+
+func (user0 *User) InsertCreatedByInvoices(ctx context.Context, exec bob.Executor, related ...*InvoiceSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	invoices1, err := insertUserCreatedByInvoices0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.CreatedByInvoices = append(user0.R.CreatedByInvoices, invoices1...)
+
+	for _, rel := range invoices1 {
+		rel.R.CreatedByUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachCreatedByInvoices(ctx context.Context, exec bob.Executor, related ...*Invoice) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	invoices1 := InvoiceSlice(related)
+
+	_, err = attachUserCreatedByInvoices0(ctx, exec, len(related), invoices1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.CreatedByInvoices = append(user0.R.CreatedByInvoices, invoices1...)
+
+	for _, rel := range related {
+		rel.R.CreatedByUser = user0
+	}
+
+	return nil
+}
+
+func insertUserMfaRecoveryCodes0(ctx context.Context, exec bob.Executor, mfaRecoveryCodes1 []*MfaRecoveryCodeSetter, user0 *User) (MfaRecoveryCodeSlice, error) {
+	for i := range mfaRecoveryCodes1 {
+		mfaRecoveryCodes1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := MfaRecoveryCodes.Insert(bob.ToMods(mfaRecoveryCodes1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserMfaRecoveryCodes0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserMfaRecoveryCodes0(ctx context.Context, exec bob.Executor, count int, mfaRecoveryCodes1 MfaRecoveryCodeSlice, user0 *User) (MfaRecoveryCodeSlice, error) {
+	setter := &MfaRecoveryCodeSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := mfaRecoveryCodes1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserMfaRecoveryCodes0: %w", err)
+	}
+
+	return mfaRecoveryCodes1, nil
+}
+
+func (user0 *User) InsertMfaRecoveryCodes(ctx context.Context, exec bob.Executor, related ...*MfaRecoveryCodeSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	mfaRecoveryCodes1, err := insertUserMfaRecoveryCodes0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.MfaRecoveryCodes = append(user0.R.MfaRecoveryCodes, mfaRecoveryCodes1...)
+
+	for _, rel := range mfaRecoveryCodes1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachMfaRecoveryCodes(ctx context.Context, exec bob.Executor, related ...*MfaRecoveryCode) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	mfaRecoveryCodes1 := MfaRecoveryCodeSlice(related)
+
+	_, err = attachUserMfaRecoveryCodes0(ctx, exec, len(related), mfaRecoveryCodes1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.MfaRecoveryCodes = append(user0.R.MfaRecoveryCodes, mfaRecoveryCodes1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserOauthClients0(ctx context.Context, exec bob.Executor, oauthClients1 []*OauthClientSetter, user0 *User) (OauthClientSlice, error) {
+	for i := range oauthClients1 {
+		oauthClients1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := OauthClients.Insert(bob.ToMods(oauthClients1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserOauthClients0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserOauthClients0(ctx context.Context, exec bob.Executor, count int, oauthClients1 OauthClientSlice, user0 *User) (OauthClientSlice, error) {
+	setter := &OauthClientSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := oauthClients1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserOauthClients0: %w", err)
+	}
+
+	return oauthClients1, nil
+}
+
+func (user0 *User) InsertOauthClients(ctx context.Context, exec bob.Executor, related ...*OauthClientSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	oauthClients1, err := insertUserOauthClients0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.OauthClients = append(user0.R.OauthClients, oauthClients1...)
+
+	for _, rel := range oauthClients1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachOauthClients(ctx context.Context, exec bob.Executor, related ...*OauthClient) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	oauthClients1 := OauthClientSlice(related)
+
+	_, err = attachUserOauthClients0(ctx, exec, len(related), oauthClients1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.OauthClients = append(user0.R.OauthClients, oauthClients1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserOrganisationMembers0(ctx context.Context, exec bob.Executor, organisationMembers1 []*OrganisationMemberSetter, user0 *User) (OrganisationMemberSlice, error) {
+	for i := range organisationMembers1 {
+		organisationMembers1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := OrganisationMembers.Insert(bob.ToMods(organisationMembers1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserOrganisationMembers0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserOrganisationMembers0(ctx context.Context, exec bob.Executor, count int, organisationMembers1 OrganisationMemberSlice, user0 *User) (OrganisationMemberSlice, error) {
+	setter := &OrganisationMemberSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := organisationMembers1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserOrganisationMembers0: %w", err)
+	}
+
+	return organisationMembers1, nil
+}
+
+func (user0 *User) InsertOrganisationMembers(ctx context.Context, exec bob.Executor, related ...*OrganisationMemberSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	organisationMembers1, err := insertUserOrganisationMembers0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.OrganisationMembers = append(user0.R.OrganisationMembers, organisationMembers1...)
+
+	for _, rel := range organisationMembers1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachOrganisationMembers(ctx context.Context, exec bob.Executor, related ...*OrganisationMember) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	organisationMembers1 := OrganisationMemberSlice(related)
+
+	_, err = attachUserOrganisationMembers0(ctx, exec, len(related), organisationMembers1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.OrganisationMembers = append(user0.R.OrganisationMembers, organisationMembers1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserPasswordHistories0(ctx context.Context, exec bob.Executor, passwordHistories1 []*PasswordHistorySetter, user0 *User) (PasswordHistorySlice, error) {
+	for i := range passwordHistories1 {
+		passwordHistories1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := PasswordHistories.Insert(bob.ToMods(passwordHistories1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserPasswordHistories0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserPasswordHistories0(ctx context.Context, exec bob.Executor, count int, passwordHistories1 PasswordHistorySlice, user0 *User) (PasswordHistorySlice, error) {
+	setter := &PasswordHistorySetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := passwordHistories1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserPasswordHistories0: %w", err)
+	}
+
+	return passwordHistories1, nil
+}
+
+func (user0 *User) InsertPasswordHistories(ctx context.Context, exec bob.Executor, related ...*PasswordHistorySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	passwordHistories1, err := insertUserPasswordHistories0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.PasswordHistories = append(user0.R.PasswordHistories, passwordHistories1...)
+
+	for _, rel := range passwordHistories1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachPasswordHistories(ctx context.Context, exec bob.Executor, related ...*PasswordHistory) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	passwordHistories1 := PasswordHistorySlice(related)
+
+	_, err = attachUserPasswordHistories0(ctx, exec, len(related), passwordHistories1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.PasswordHistories = append(user0.R.PasswordHistories, passwordHistories1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserSecurityEvents0(ctx context.Context, exec bob.Executor, securityEvents1 []*SecurityEventSetter, user0 *User) (SecurityEventSlice, error) {
+	for i := range securityEvents1 {
+		securityEvents1[i].UserID = omitnull.From(user0.ID)
+	}
+
+	ret, err := SecurityEvents.Insert(bob.ToMods(securityEvents1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserSecurityEvents0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserSecurityEvents0(ctx context.Context, exec bob.Executor, count int, securityEvents1 SecurityEventSlice, user0 *User) (SecurityEventSlice, error) {
+	setter := &SecurityEventSetter{
+		UserID: omitnull.From(user0.ID),
+	}
+
+	err := securityEvents1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserSecurityEvents0: %w", err)
+	}
+
+	return securityEvents1, nil
+}
+
+func (user0 *User) InsertSecurityEvents(ctx context.Context, exec bob.Executor, related ...*SecurityEventSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	securityEvents1, err := insertUserSecurityEvents0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.SecurityEvents = append(user0.R.SecurityEvents, securityEvents1...)
+
+	for _, rel := range securityEvents1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachSecurityEvents(ctx context.Context, exec bob.Executor, related ...*SecurityEvent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	securityEvents1 := SecurityEventSlice(related)
+
+	_, err = attachUserSecurityEvents0(ctx, exec, len(related), securityEvents1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.SecurityEvents = append(user0.R.SecurityEvents, securityEvents1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserUserIdentities0(ctx context.Context, exec bob.Executor, userIdentities1 []*UserIdentitySetter, user0 *User) (UserIdentitySlice, error) {
+	for i := range userIdentities1 {
+		userIdentities1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := UserIdentities.Insert(bob.ToMods(userIdentities1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUserIdentities0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUserIdentities0(ctx context.Context, exec bob.Executor, count int, userIdentities1 UserIdentitySlice, user0 *User) (UserIdentitySlice, error) {
+	setter := &UserIdentitySetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := userIdentities1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUserIdentities0: %w", err)
+	}
+
+	return userIdentities1, nil
+}
+
+func (user0 *User) InsertUserIdentities(ctx context.Context, exec bob.Executor, related ...*UserIdentitySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	userIdentities1, err := insertUserUserIdentities0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UserIdentities = append(user0.R.UserIdentities, userIdentities1...)
+
+	for _, rel := range userIdentities1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachUserIdentities(ctx context.Context, exec bob.Executor, related ...*UserIdentity) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	userIdentities1 := UserIdentitySlice(related)
+
+	_, err = attachUserUserIdentities0(ctx, exec, len(related), userIdentities1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UserIdentities = append(user0.R.UserIdentities, userIdentities1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func attachUserRoles0(ctx context.Context, exec bob.Executor, count int, user0 *User, roles2 RoleSlice) (UserRoleSlice, error) {
+	setters := make([]*UserRoleSetter, count)
+	for i := range count {
+		setters[i] = &UserRoleSetter{
+			UserID: omit.From(user0.ID),
+			RoleID: omit.From(roles2[i].ID),
+		}
+	}
+
+	userRoles1, err := UserRoles.Insert(bob.ToMods(setters...)).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserRoles0: %w", err)
+	}
+
+	return userRoles1, nil
+}
+
+func (user0 *User) InsertRoles(ctx context.Context, exec bob.Executor, related ...*RoleSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	inserted, err := Roles.Insert(bob.ToMods(related...)).All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+	roles2 := RoleSlice(inserted)
+
+	_, err = attachUserRoles0(ctx, exec, len(related), user0, roles2)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Roles = append(user0.R.Roles, roles2...)
+
+	for _, rel := range roles2 {
+		rel.R.Users = append(rel.R.Users, user0)
+	}
+	return nil
+}
+
+func (user0 *User) AttachRoles(ctx context.Context, exec bob.Executor, related ...*Role) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	roles2 := RoleSlice(related)
+
+	_, err = attachUserRoles0(ctx, exec, len(related), user0, roles2)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Roles = append(user0.R.Roles, roles2...)
+
+	for _, rel := range related {
+		rel.R.Users = append(rel.R.Users, user0)
+	}
+
+	return nil
+}
+
+type userWhere[Q psql.Filterable] struct {
+	ID              psql.WhereMod[Q, int64]
+	FirstName       psql.WhereMod[Q, string]
+	LastName        psql.WhereMod[Q, string]
+	Password        psql.WhereMod[Q, string]
+	Email           psql.WhereMod[Q, string]
+	EmailVerifiedAt psql.WhereNullMod[Q, time.Time]
+	Status          psql.WhereMod[Q, enums.UserStatuses]
+	CreatedAt       psql.WhereNullMod[Q, time.Time]
+	UpdatedAt       psql.WhereNullMod[Q, time.Time]
+	DeletedAt       psql.WhereNullMod[Q, time.Time]
+	TotpSecret      psql.WhereNullMod[Q, string]
+	TotpEnabledAt   psql.WhereNullMod[Q, time.Time]
+	PendingEmail    psql.WhereNullMod[Q, string]
+}
+
+func (userWhere[Q]) AliasedAs(alias string) userWhere[Q] {
+	return buildUserWhere[Q](buildUserColumns(alias))
+}
+
+func buildUserWhere[Q psql.Filterable](cols userColumns) userWhere[Q] {
+	return userWhere[Q]{
+		ID:              psql.Where[Q, int64](cols.ID),
+		FirstName:       psql.Where[Q, string](cols.FirstName),
+		LastName:        psql.Where[Q, string](cols.LastName),
+		Password:        psql.Where[Q, string](cols.Password),
+		Email:           psql.Where[Q, string](cols.Email),
+		EmailVerifiedAt: psql.WhereNull[Q, time.Time](cols.EmailVerifiedAt),
+		Status:          psql.Where[Q, enums.UserStatuses](cols.Status),
+		CreatedAt:       psql.WhereNull[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:       psql.WhereNull[Q, time.Time](cols.UpdatedAt),
+		DeletedAt:       psql.WhereNull[Q, time.Time](cols.DeletedAt),
+		TotpSecret:      psql.WhereNull[Q, string](cols.TotpSecret),
+		TotpEnabledAt:   psql.WhereNull[Q, time.Time](cols.TotpEnabledAt),
+		PendingEmail:    psql.WhereNull[Q, string](cols.PendingEmail),
+	}
+}
+
+func (o *User) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "APIKeys":
+		rels, ok := retrieved.(APIKeySlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.APIKeys = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "AuthTokens":
+		rels, ok := retrieved.(AuthTokenSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AuthTokens = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "FailedLogins":
+		rels, ok := retrieved.(FailedLoginSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.FailedLogins = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "AcceptedUserInvitations":
+		rels, ok := retrieved.(InvitationSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AcceptedUserInvitations = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.AcceptedUserUser = o
+			}
+		}
+		return nil
+	case "InvitedByInvitations":
+		rels, ok := retrieved.(InvitationSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.InvitedByInvitations = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.InvitedByUser = o
+			}
+		}
+		return nil
+	case "CreatedByInvoices":
+		rels, ok := retrieved.(InvoiceSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.CreatedByInvoices = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.CreatedByUser = o
+			}
+		}
+		return nil
+	case "MfaRecoveryCodes":
+		rels, ok := retrieved.(MfaRecoveryCodeSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.MfaRecoveryCodes = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "OauthClients":
+		rels, ok := retrieved.(OauthClientSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.OauthClients = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "OrganisationMembers":
+		rels, ok := retrieved.(OrganisationMemberSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.OrganisationMembers = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "PasswordHistories":
+		rels, ok := retrieved.(PasswordHistorySlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.PasswordHistories = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "SecurityEvents":
+		rels, ok := retrieved.(SecurityEventSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.SecurityEvents = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "UserIdentities":
+		rels, ok := retrieved.(UserIdentitySlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UserIdentities = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Roles":
+		rels, ok := retrieved.(RoleSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Roles = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Users = UserSlice{o}
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("user has no relationship %q", name)
+	}
+}
+
+type userPreloader struct{}
+
+func buildUserPreloader() userPreloader {
+	return userPreloader{}
+}
+
+type userThenLoader[Q orm.Loadable] struct {
+	APIKeys                 func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	AuthTokens              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	FailedLogins            func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	AcceptedUserInvitations func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	InvitedByInvitations    func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	CreatedByInvoices       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	MfaRecoveryCodes        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	OauthClients            func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	OrganisationMembers     func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	PasswordHistories       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	SecurityEvents          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	UserIdentities          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Roles                   func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildUserThenLoader[Q orm.Loadable]() userThenLoader[Q] {
+	type APIKeysLoadInterface interface {
+		LoadAPIKeys(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type AuthTokensLoadInterface interface {
+		LoadAuthTokens(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type FailedLoginsLoadInterface interface {
+		LoadFailedLogins(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type AcceptedUserInvitationsLoadInterface interface {
+		LoadAcceptedUserInvitations(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type InvitedByInvitationsLoadInterface interface {
+		LoadInvitedByInvitations(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type CreatedByInvoicesLoadInterface interface {
+		LoadCreatedByInvoices(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type MfaRecoveryCodesLoadInterface interface {
+		LoadMfaRecoveryCodes(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type OauthClientsLoadInterface interface {
+		LoadOauthClients(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type OrganisationMembersLoadInterface interface {
+		LoadOrganisationMembers(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type PasswordHistoriesLoadInterface interface {
+		LoadPasswordHistories(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type SecurityEventsLoadInterface interface {
+		LoadSecurityEvents(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserIdentitiesLoadInterface interface {
+		LoadUserIdentities(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type RolesLoadInterface interface {
+		LoadRoles(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return userThenLoader[Q]{
+		APIKeys: thenLoadBuilder[Q](
+			"APIKeys",
+			func(ctx context.Context, exec bob.Executor, retrieved APIKeysLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAPIKeys(ctx, exec, mods...)
+			},
+		),
+		AuthTokens: thenLoadBuilder[Q](
+			"AuthTokens",
+			func(ctx context.Context, exec bob.Executor, retrieved AuthTokensLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAuthTokens(ctx, exec, mods...)
+			},
+		),
+		FailedLogins: thenLoadBuilder[Q](
+			"FailedLogins",
+			func(ctx context.Context, exec bob.Executor, retrieved FailedLoginsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadFailedLogins(ctx, exec, mods...)
+			},
+		),
+		AcceptedUserInvitations: thenLoadBuilder[Q](
+			"AcceptedUserInvitations",
+			func(ctx context.Context, exec bob.Executor, retrieved AcceptedUserInvitationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAcceptedUserInvitations(ctx, exec, mods...)
+			},
+		),
+		InvitedByInvitations: thenLoadBuilder[Q](
+			"InvitedByInvitations",
+			func(ctx context.Context, exec bob.Executor, retrieved InvitedByInvitationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadInvitedByInvitations(ctx, exec, mods...)
+			},
+		),
+		CreatedByInvoices: thenLoadBuilder[Q](
+			"CreatedByInvoices",
+			func(ctx context.Context, exec bob.Executor, retrieved CreatedByInvoicesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadCreatedByInvoices(ctx, exec, mods...)
+			},
+		),
+		MfaRecoveryCodes: thenLoadBuilder[Q](
+			"MfaRecoveryCodes",
+			func(ctx context.Context, exec bob.Executor, retrieved MfaRecoveryCodesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadMfaRecoveryCodes(ctx, exec, mods...)
+			},
+		),
+		OauthClients: thenLoadBuilder[Q](
+			"OauthClients",
+			func(ctx context.Context, exec bob.Executor, retrieved OauthClientsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadOauthClients(ctx, exec, mods...)
+			},
+		),
+		OrganisationMembers: thenLoadBuilder[Q](
+			"OrganisationMembers",
+			func(ctx context.Context, exec bob.Executor, retrieved OrganisationMembersLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadOrganisationMembers(ctx, exec, mods...)
+			},
+		),
+		PasswordHistories: thenLoadBuilder[Q](
+			"PasswordHistories",
+			func(ctx context.Context, exec bob.Executor, retrieved PasswordHistoriesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadPasswordHistories(ctx, exec, mods...)
+			},
+		),
+		SecurityEvents: thenLoadBuilder[Q](
+			"SecurityEvents",
+			func(ctx context.Context, exec bob.Executor, retrieved SecurityEventsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadSecurityEvents(ctx, exec, mods...)
+			},
+		),
+		UserIdentities: thenLoadBuilder[Q](
+			"UserIdentities",
+			func(ctx context.Context, exec bob.Executor, retrieved UserIdentitiesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUserIdentities(ctx, exec, mods...)
+			},
+		),
+		Roles: thenLoadBuilder[Q](
+			"Roles",
+			func(ctx context.Context, exec bob.Executor, retrieved RolesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadRoles(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadAPIKeys loads the user's APIKeys into the .R struct
+func (o *User) LoadAPIKeys(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.APIKeys = nil
+
+	related, err := o.APIKeys(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.APIKeys = related
+	return nil
+}
+
+// LoadAPIKeys loads the user's APIKeys into the .R struct
+func (os UserSlice) LoadAPIKeys(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	apiKeys, err := os.APIKeys(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.APIKeys = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range apiKeys {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.APIKeys = append(o.R.APIKeys, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadAuthTokens loads the user's AuthTokens into the .R struct
+func (o *User) LoadAuthTokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.AuthTokens = nil
+
+	related, err := o.AuthTokens(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.AuthTokens = related
+	return nil
+}
+
+// LoadAuthTokens loads the user's AuthTokens into the .R struct
+func (os UserSlice) LoadAuthTokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	authTokens, err := os.AuthTokens(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.AuthTokens = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range authTokens {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.AuthTokens = append(o.R.AuthTokens, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadFailedLogins loads the user's FailedLogins into the .R struct
+func (o *User) LoadFailedLogins(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.FailedLogins = nil
+
+	related, err := o.FailedLogins(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.FailedLogins = related
+	return nil
+}
+
+// LoadFailedLogins loads the user's FailedLogins into the .R struct
+func (os UserSlice) LoadFailedLogins(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	failedLogins, err := os.FailedLogins(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.FailedLogins = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range failedLogins {
+
+			if !rel.UserID.IsValue() {
+				continue
+			}
+			if !(rel.UserID.IsValue() && o.ID == rel.UserID.MustGet()) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.FailedLogins = append(o.R.FailedLogins, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadAcceptedUserInvitations loads the user's AcceptedUserInvitations into the .R struct
+func (o *User) LoadAcceptedUserInvitations(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.AcceptedUserInvitations = nil
+
+	related, err := o.AcceptedUserInvitations(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.AcceptedUserUser = o
+	}
+
+	o.R.AcceptedUserInvitations = related
+	return nil
+}
+
+// LoadAcceptedUserInvitations loads the user's AcceptedUserInvitations into the .R struct
+func (os UserSlice) LoadAcceptedUserInvitations(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invitations, err := os.AcceptedUserInvitations(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.AcceptedUserInvitations = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invitations {
+
+			if !rel.AcceptedUserID.IsValue() {
+				continue
+			}
+			if !(rel.AcceptedUserID.IsValue() && o.ID == rel.AcceptedUserID.MustGet()) {
+				continue
+			}
+
+			rel.R.AcceptedUserUser = o
+
+			o.R.AcceptedUserInvitations = append(o.R.AcceptedUserInvitations, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadInvitedByInvitations loads the user's InvitedByInvitations into the .R struct
+func (o *User) LoadInvitedByInvitations(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.InvitedByInvitations = nil
+
+	related, err := o.InvitedByInvitations(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.InvitedByUser = o
+	}
+
+	o.R.InvitedByInvitations = related
+	return nil
+}
+
+// LoadInvitedByInvitations loads the user's InvitedByInvitations into the .R struct
+func (os UserSlice) LoadInvitedByInvitations(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invitations, err := os.InvitedByInvitations(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.InvitedByInvitations = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invitations {
+
+			if !rel.InvitedBy.IsValue() {
+				continue
+			}
+			if !(rel.InvitedBy.IsValue() && o.ID == rel.InvitedBy.MustGet()) {
+				continue
+			}
+
+			rel.R.InvitedByUser = o
+
+			o.R.InvitedByInvitations = append(o.R.InvitedByInvitations, rel)
+		}
+	}
+
+	return nil
 }
 
-func buildUserWhere[Q psql.Filterable](cols userColumns) userWhere[Q] {
-	return userWhere[Q]{
-		ID:              psql.Where[Q, int64](cols.ID),
-		FirstName:       psql.Where[Q, string](cols.FirstName),
-		LastName:        psql.Where[Q, string](cols.LastName),
-		Password:        psql.Where[Q, string](cols.Password),
-		Email:           psql.Where[Q, string](cols.Email),
-		EmailVerifiedAt: psql.WhereNull[Q, time.Time](cols.EmailVerifiedAt),
-		Status:          psql.Where[Q, enums.UserStatuses](cols.Status),
-		CreatedAt:       psql.WhereNull[Q, time.Time](cols.CreatedAt),
-		UpdatedAt:       psql.WhereNull[Q, time.Time](cols.UpdatedAt),
-		DeletedAt:       psql.WhereNull[Q, time.Time](cols.DeletedAt),
+// LoadCreatedByInvoices loads the user's CreatedByInvoices into the .R struct
+func (o *User) LoadCreatedByInvoices(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.CreatedByInvoices = nil
+
+	related, err := o.CreatedByInvoices(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.CreatedByUser = o
+	}
+
+	o.R.CreatedByInvoices = related
+	return nil
+}
+
+// LoadCreatedByInvoices loads the user's CreatedByInvoices into the .R struct
+func (os UserSlice) LoadCreatedByInvoices(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	invoices, err := os.CreatedByInvoices(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.CreatedByInvoices = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range invoices {
+
+			if !rel.CreatedBy.IsValue() {
+				continue
+			}
+			if !(rel.CreatedBy.IsValue() && o.ID == rel.CreatedBy.MustGet()) {
+				continue
+			}
+
+			rel.R.CreatedByUser = o
+
+			o.R.CreatedByInvoices = append(o.R.CreatedByInvoices, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadMfaRecoveryCodes loads the user's MfaRecoveryCodes into the .R struct
+func (o *User) LoadMfaRecoveryCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.MfaRecoveryCodes = nil
+
+	related, err := o.MfaRecoveryCodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.MfaRecoveryCodes = related
+	return nil
+}
+
+// LoadMfaRecoveryCodes loads the user's MfaRecoveryCodes into the .R struct
+func (os UserSlice) LoadMfaRecoveryCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	mfaRecoveryCodes, err := os.MfaRecoveryCodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.MfaRecoveryCodes = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range mfaRecoveryCodes {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.MfaRecoveryCodes = append(o.R.MfaRecoveryCodes, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadOauthClients loads the user's OauthClients into the .R struct
+func (o *User) LoadOauthClients(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.OauthClients = nil
+
+	related, err := o.OauthClients(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.OauthClients = related
+	return nil
+}
+
+// LoadOauthClients loads the user's OauthClients into the .R struct
+func (os UserSlice) LoadOauthClients(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oauthClients, err := os.OauthClients(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.OauthClients = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range oauthClients {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.OauthClients = append(o.R.OauthClients, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadOrganisationMembers loads the user's OrganisationMembers into the .R struct
+func (o *User) LoadOrganisationMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.OrganisationMembers = nil
+
+	related, err := o.OrganisationMembers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.OrganisationMembers = related
+	return nil
+}
+
+// LoadOrganisationMembers loads the user's OrganisationMembers into the .R struct
+func (os UserSlice) LoadOrganisationMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	organisationMembers, err := os.OrganisationMembers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.OrganisationMembers = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range organisationMembers {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.OrganisationMembers = append(o.R.OrganisationMembers, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadPasswordHistories loads the user's PasswordHistories into the .R struct
+func (o *User) LoadPasswordHistories(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.PasswordHistories = nil
+
+	related, err := o.PasswordHistories(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.PasswordHistories = related
+	return nil
+}
+
+// LoadPasswordHistories loads the user's PasswordHistories into the .R struct
+func (os UserSlice) LoadPasswordHistories(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
 	}
-}
 
-func (o *User) Preload(name string, retrieved any) error {
-	if o == nil {
-		return nil
+	passwordHistories, err := os.PasswordHistories(mods...).All(ctx, exec)
+	if err != nil {
+		return err
 	}
 
-	switch name {
-	case "AuthTokens":
-		rels, ok := retrieved.(AuthTokenSlice)
-		if !ok {
-			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+	for _, o := range os {
+		if o == nil {
+			continue
 		}
 
-		o.R.AuthTokens = rels
+		o.R.PasswordHistories = nil
+	}
 
-		for _, rel := range rels {
-			if rel != nil {
-				rel.R.User = o
-			}
-		}
-		return nil
-	case "FailedLogins":
-		rels, ok := retrieved.(FailedLoginSlice)
-		if !ok {
-			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+	for _, o := range os {
+		if o == nil {
+			continue
 		}
 
-		o.R.FailedLogins = rels
+		for _, rel := range passwordHistories {
 
-		for _, rel := range rels {
-			if rel != nil {
-				rel.R.User = o
+			if !(o.ID == rel.UserID) {
+				continue
 			}
-		}
-		return nil
-	default:
-		return fmt.Errorf("user has no relationship %q", name)
-	}
-}
-
-type userPreloader struct{}
-
-func buildUserPreloader() userPreloader {
-	return userPreloader{}
-}
 
-type userThenLoader[Q orm.Loadable] struct {
-	AuthTokens   func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
-	FailedLogins func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
-}
+			rel.R.User = o
 
-func buildUserThenLoader[Q orm.Loadable]() userThenLoader[Q] {
-	type AuthTokensLoadInterface interface {
-		LoadAuthTokens(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
-	}
-	type FailedLoginsLoadInterface interface {
-		LoadFailedLogins(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+			o.R.PasswordHistories = append(o.R.PasswordHistories, rel)
+		}
 	}
 
-	return userThenLoader[Q]{
-		AuthTokens: thenLoadBuilder[Q](
-			"AuthTokens",
-			func(ctx context.Context, exec bob.Executor, retrieved AuthTokensLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
-				return retrieved.LoadAuthTokens(ctx, exec, mods...)
-			},
-		),
-		FailedLogins: thenLoadBuilder[Q](
-			"FailedLogins",
-			func(ctx context.Context, exec bob.Executor, retrieved FailedLoginsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
-				return retrieved.LoadFailedLogins(ctx, exec, mods...)
-			},
-		),
-	}
+	return nil
 }
 
-// LoadAuthTokens loads the user's AuthTokens into the .R struct
-func (o *User) LoadAuthTokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadSecurityEvents loads the user's SecurityEvents into the .R struct
+func (o *User) LoadSecurityEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.AuthTokens = nil
+	o.R.SecurityEvents = nil
 
-	related, err := o.AuthTokens(mods...).All(ctx, exec)
+	related, err := o.SecurityEvents(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -865,17 +2853,17 @@ func (o *User) LoadAuthTokens(ctx context.Context, exec bob.Executor, mods ...bo
 		rel.R.User = o
 	}
 
-	o.R.AuthTokens = related
+	o.R.SecurityEvents = related
 	return nil
 }
 
-// LoadAuthTokens loads the user's AuthTokens into the .R struct
-func (os UserSlice) LoadAuthTokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadSecurityEvents loads the user's SecurityEvents into the .R struct
+func (os UserSlice) LoadSecurityEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	authTokens, err := os.AuthTokens(mods...).All(ctx, exec)
+	securityEvents, err := os.SecurityEvents(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -885,7 +2873,7 @@ func (os UserSlice) LoadAuthTokens(ctx context.Context, exec bob.Executor, mods
 			continue
 		}
 
-		o.R.AuthTokens = nil
+		o.R.SecurityEvents = nil
 	}
 
 	for _, o := range os {
@@ -893,31 +2881,34 @@ func (os UserSlice) LoadAuthTokens(ctx context.Context, exec bob.Executor, mods
 			continue
 		}
 
-		for _, rel := range authTokens {
+		for _, rel := range securityEvents {
 
-			if !(o.ID == rel.UserID) {
+			if !rel.UserID.IsValue() {
+				continue
+			}
+			if !(rel.UserID.IsValue() && o.ID == rel.UserID.MustGet()) {
 				continue
 			}
 
 			rel.R.User = o
 
-			o.R.AuthTokens = append(o.R.AuthTokens, rel)
+			o.R.SecurityEvents = append(o.R.SecurityEvents, rel)
 		}
 	}
 
 	return nil
 }
 
-// LoadFailedLogins loads the user's FailedLogins into the .R struct
-func (o *User) LoadFailedLogins(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserIdentities loads the user's UserIdentities into the .R struct
+func (o *User) LoadUserIdentities(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.FailedLogins = nil
+	o.R.UserIdentities = nil
 
-	related, err := o.FailedLogins(mods...).All(ctx, exec)
+	related, err := o.UserIdentities(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -926,17 +2917,17 @@ func (o *User) LoadFailedLogins(ctx context.Context, exec bob.Executor, mods ...
 		rel.R.User = o
 	}
 
-	o.R.FailedLogins = related
+	o.R.UserIdentities = related
 	return nil
 }
 
-// LoadFailedLogins loads the user's FailedLogins into the .R struct
-func (os UserSlice) LoadFailedLogins(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserIdentities loads the user's UserIdentities into the .R struct
+func (os UserSlice) LoadUserIdentities(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	failedLogins, err := os.FailedLogins(mods...).All(ctx, exec)
+	userIdentities, err := os.UserIdentities(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -946,7 +2937,7 @@ func (os UserSlice) LoadFailedLogins(ctx context.Context, exec bob.Executor, mod
 			continue
 		}
 
-		o.R.FailedLogins = nil
+		o.R.UserIdentities = nil
 	}
 
 	for _, o := range os {
@@ -954,7 +2945,7 @@ func (os UserSlice) LoadFailedLogins(ctx context.Context, exec bob.Executor, mod
 			continue
 		}
 
-		for _, rel := range failedLogins {
+		for _, rel := range userIdentities {
 
 			if !(o.ID == rel.UserID) {
 				continue
@@ -962,7 +2953,88 @@ func (os UserSlice) LoadFailedLogins(ctx context.Context, exec bob.Executor, mod
 
 			rel.R.User = o
 
-			o.R.FailedLogins = append(o.R.FailedLogins, rel)
+			o.R.UserIdentities = append(o.R.UserIdentities, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadRoles loads the user's Roles into the .R struct
+func (o *User) LoadRoles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Roles = nil
+
+	related, err := o.Roles(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Users = UserSlice{o}
+	}
+
+	o.R.Roles = related
+	return nil
+}
+
+// LoadRoles loads the user's Roles into the .R struct
+func (os UserSlice) LoadRoles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	// since we are changing the columns, we need to check if the original columns were set or add the defaults
+	sq := dialect.SelectQuery{}
+	for _, mod := range mods {
+		mod.Apply(&sq)
+	}
+
+	if len(sq.SelectList.Columns) == 0 {
+		mods = append(mods, sm.Columns(Roles.Columns))
+	}
+
+	q := os.Roles(append(
+		mods,
+		sm.Columns(UserRoles.Columns.UserID.As("related_users.ID")),
+	)...)
+
+	IDSlice := []int64{}
+
+	mapper := scan.Mod(scan.StructMapper[*Role](), func(ctx context.Context, cols []string) (scan.BeforeFunc, func(any, any) error) {
+		return func(row *scan.Row) (any, error) {
+				IDSlice = append(IDSlice, *new(int64))
+				row.ScheduleScanByName("related_users.ID", &IDSlice[len(IDSlice)-1])
+
+				return nil, nil
+			},
+			func(any, any) error {
+				return nil
+			}
+	})
+
+	roles, err := bob.Allx[bob.SliceTransformer[*Role, RoleSlice]](ctx, exec, q, mapper)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Roles = nil
+	}
+
+	for _, o := range os {
+		for i, rel := range roles {
+			if o.ID == IDSlice[i] {
+				continue
+			}
+
+			rel.R.Users = append(rel.R.Users, o)
+
+			o.R.Roles = append(o.R.Roles, rel)
 		}
 	}
 
@@ -970,9 +3042,20 @@ func (os UserSlice) LoadFailedLogins(ctx context.Context, exec bob.Executor, mod
 }
 
 type userJoins[Q dialect.Joinable] struct {
-	typ          string
-	AuthTokens   modAs[Q, authTokenColumns]
-	FailedLogins modAs[Q, failedLoginColumns]
+	typ                     string
+	APIKeys                 modAs[Q, apiKeyColumns]
+	AuthTokens              modAs[Q, authTokenColumns]
+	FailedLogins            modAs[Q, failedLoginColumns]
+	AcceptedUserInvitations modAs[Q, invitationColumns]
+	InvitedByInvitations    modAs[Q, invitationColumns]
+	CreatedByInvoices       modAs[Q, invoiceColumns]
+	MfaRecoveryCodes        modAs[Q, mfaRecoveryCodeColumns]
+	OauthClients            modAs[Q, oauthClientColumns]
+	OrganisationMembers     modAs[Q, organisationMemberColumns]
+	PasswordHistories       modAs[Q, passwordHistoryColumns]
+	SecurityEvents          modAs[Q, securityEventColumns]
+	UserIdentities          modAs[Q, userIdentityColumns]
+	Roles                   modAs[Q, roleColumns]
 }
 
 func (j userJoins[Q]) aliasedAs(alias string) userJoins[Q] {
@@ -982,6 +3065,20 @@ func (j userJoins[Q]) aliasedAs(alias string) userJoins[Q] {
 func buildUserJoins[Q dialect.Joinable](cols userColumns, typ string) userJoins[Q] {
 	return userJoins[Q]{
 		typ: typ,
+		APIKeys: modAs[Q, apiKeyColumns]{
+			c: APIKeys.Columns,
+			f: func(to apiKeyColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, APIKeys.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 		AuthTokens: modAs[Q, authTokenColumns]{
 			c: AuthTokens.Columns,
 			f: func(to authTokenColumns) bob.Mod[Q] {
@@ -1007,6 +3104,154 @@ func buildUserJoins[Q dialect.Joinable](cols userColumns, typ string) userJoins[
 					))
 				}
 
+				return mods
+			},
+		},
+		AcceptedUserInvitations: modAs[Q, invitationColumns]{
+			c: Invitations.Columns,
+			f: func(to invitationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Invitations.Name().As(to.Alias())).On(
+						to.AcceptedUserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		InvitedByInvitations: modAs[Q, invitationColumns]{
+			c: Invitations.Columns,
+			f: func(to invitationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Invitations.Name().As(to.Alias())).On(
+						to.InvitedBy.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		CreatedByInvoices: modAs[Q, invoiceColumns]{
+			c: Invoices.Columns,
+			f: func(to invoiceColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Invoices.Name().As(to.Alias())).On(
+						to.CreatedBy.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		MfaRecoveryCodes: modAs[Q, mfaRecoveryCodeColumns]{
+			c: MfaRecoveryCodes.Columns,
+			f: func(to mfaRecoveryCodeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, MfaRecoveryCodes.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		OauthClients: modAs[Q, oauthClientColumns]{
+			c: OauthClients.Columns,
+			f: func(to oauthClientColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthClients.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		OrganisationMembers: modAs[Q, organisationMemberColumns]{
+			c: OrganisationMembers.Columns,
+			f: func(to organisationMemberColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OrganisationMembers.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		PasswordHistories: modAs[Q, passwordHistoryColumns]{
+			c: PasswordHistories.Columns,
+			f: func(to passwordHistoryColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, PasswordHistories.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		SecurityEvents: modAs[Q, securityEventColumns]{
+			c: SecurityEvents.Columns,
+			f: func(to securityEventColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, SecurityEvents.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		UserIdentities: modAs[Q, userIdentityColumns]{
+			c: UserIdentities.Columns,
+			f: func(to userIdentityColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, UserIdentities.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Roles: modAs[Q, roleColumns]{
+			c: Roles.Columns,
+			f: func(to roleColumns) bob.Mod[Q] {
+				random := strconv.FormatInt(randInt(), 10)
+				mods := make(mods.QueryMods[Q], 0, 2)
+
+				{
+					to := UserRoles.Columns.AliasedAs(UserRoles.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, UserRoles.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+				{
+					cols := UserRoles.Columns.AliasedAs(UserRoles.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, Roles.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.RoleID),
+					))
+				}
+
 				return mods
 			},
 		},
@@ -0,0 +1,63 @@
+package seeders
+
+import (
+	"context"
+	"log"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+)
+
+const defaultOrganisationName = "Default Organisation"
+
+// SeedOrganisations creates the default organisation when no organisation exists yet,
+// with every existing user as a member
+func SeedOrganisations(db *bob.DB) error {
+	ctx := context.Background()
+
+	count, err := models.Organisations.Query().Count(ctx, db)
+
+	if err != nil {
+		log.Fatalf("failed to count organisations: %v", err)
+		return err
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	organisation, err := models.Organisations.Insert(&models.OrganisationSetter{
+		Name: omit.From(defaultOrganisationName),
+	}).One(ctx, db)
+
+	if err != nil {
+		log.Fatalf("failed to seed default organisation: %v", err)
+		return err
+	}
+
+	users, err := models.Users.Query().All(ctx, db)
+
+	if err != nil {
+		log.Fatalf("failed to fetch users: %v", err)
+		return err
+	}
+
+	for _, user := range users {
+		_, err = models.OrganisationMembers.Insert(
+			&models.OrganisationMemberSetter{
+				OrganisationID: omit.From(organisation.ID),
+				UserID:         omit.From(user.ID),
+			},
+			im.OnConflict().DoNothing(),
+		).Exec(ctx, db)
+
+		if err != nil {
+			log.Fatalf("failed to seed default organisation members: %v", err)
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package seeders
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/pkg/rbac"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+)
+
+// Syncs the built-in roles and permissions from the rbac package, safe to run repeatedly
+func seedRoles(db *bob.DB) error {
+	ctx := context.Background()
+	permissionIds := map[string]int64{}
+
+	for name, description := range rbac.Permissions {
+		permission, err := models.Permissions.Insert(
+			&models.PermissionSetter{
+				Name:        omit.From(name),
+				Description: omitnull.From(description),
+			},
+			im.OnConflict("name").DoUpdate(im.SetExcluded("description")),
+		).One(ctx, db)
+
+		if err != nil {
+			return err
+		}
+
+		permissionIds[name] = permission.ID
+	}
+
+	for name, definition := range rbac.Roles {
+		role, err := models.Roles.Insert(
+			&models.RoleSetter{
+				Name:        omit.From(name),
+				Description: omitnull.From(definition.Description),
+			},
+			im.OnConflict("name").DoUpdate(im.SetExcluded("description")),
+		).One(ctx, db)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = models.RolePermissions.Delete(
+			dm.Where(models.RolePermissions.Columns.RoleID.EQ(psql.Arg(role.ID))),
+		).Exec(ctx, db)
+
+		if err != nil {
+			return err
+		}
+
+		permissions := append([]string{}, definition.Permissions...)
+		sort.Strings(permissions)
+
+		for _, permission := range permissions {
+			_, err := models.RolePermissions.Insert(&models.RolePermissionSetter{
+				RoleID:       omit.From(role.ID),
+				PermissionID: omit.From(permissionIds[permission]),
+			}).Exec(ctx, db)
+
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -3,16 +3,26 @@ package seeders
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
 	"github.com/jacoobjake/einvoice-api/internal/database/models"
-	"github.com/jacoobjake/einvoice-api/pkg"
+	"github.com/jacoobjake/einvoice-api/pkg/password"
+	"github.com/jacoobjake/einvoice-api/pkg/rbac"
 	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
 )
 
 // Implement user seeding logic here
-func SeedUsers(db *bob.DB) error {
-	if err := seedSuperAdmin(db); err != nil {
+func SeedUsers(db *bob.DB, hasher *password.Hasher) error {
+	if err := seedRoles(db); err != nil {
+		log.Fatalf("failed to seed roles: %v", err)
+		return err
+	}
+
+	if err := seedSuperAdmin(db, hasher); err != nil {
 		log.Fatalf("failed to seed super admin: %v", err)
 		return err
 	}
@@ -21,24 +31,43 @@ func SeedUsers(db *bob.DB) error {
 }
 
 // Implement super admin seeding logic here
-func seedSuperAdmin(db *bob.DB) error {
+func seedSuperAdmin(db *bob.DB, hasher *password.Hasher) error {
 	ctx := context.Background()
-	pw, err := pkg.HashPassword("superadminpassword")
+	pw, err := hasher.Hash("superadminpassword")
 
 	if err != nil {
 		return err
 	}
 
-	_, insErr := models.Users.Insert(&models.UserSetter{
+	user, insErr := models.Users.Insert(&models.UserSetter{
 		FirstName: omit.From("super"),
 		LastName:  omit.From("admin"),
 		Email:     omit.From("superadmin@example.com"),
-		Password:  omit.From(string(pw)),
+		Password:  omit.From(pw),
+		// Seeded accounts are trusted, skip email verification
+		EmailVerifiedAt: omitnull.From(time.Now()),
 	}).One(ctx, db)
 
 	if insErr != nil {
 		return insErr
 	}
 
+	role, err := models.Roles.Query(
+		sm.Where(models.Roles.Columns.Name.EQ(psql.Arg(rbac.RoleSuperAdmin))),
+	).One(ctx, db)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = models.UserRoles.Insert(&models.UserRoleSetter{
+		UserID: omit.From(user.ID),
+		RoleID: omit.From(role.ID),
+	}).Exec(ctx, db)
+
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
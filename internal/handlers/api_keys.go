@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/internal/services"
+	pkgError "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/response"
+	"github.com/pkg/errors"
+)
+
+type APIKeyHandler struct {
+	APIKeyService *services.APIKeyService
+}
+
+type CreateAPIKeyRequest struct {
+	Name     string     `json:"name" binding:"required,max=100"`
+	Scopes   []string   `json:"scopes" binding:"required,min=1,dive,required"`
+	ExpireAt *time.Time `json:"expire_at"`
+}
+
+func respondOrganisationNotFound(c *gin.Context) {
+	c.JSON(http.StatusNotFound, response.JSONApiResponse{
+		Success: false,
+		Code:    http.StatusNotFound,
+		Message: "organisation not found",
+	})
+}
+
+func (h *APIKeyHandler) Create(c *gin.Context) {
+	organisationId, err := strconv.ParseInt(c.Param("organisationId"), 10, 64)
+
+	if err != nil {
+		respondOrganisationNotFound(c)
+		return
+	}
+
+	var req CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success:          false,
+			Code:             http.StatusUnprocessableEntity,
+			Message:          "invalid request data",
+			ValidationErrors: pkgError.FormatValidationError(err),
+		})
+		return
+	}
+
+	if req.ExpireAt != nil && !req.ExpireAt.After(time.Now()) {
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusUnprocessableEntity,
+			Message: "invalid request data",
+			ValidationErrors: []pkgError.ValidationError{{
+				Field:   "ExpireAt",
+				Tag:     "future",
+				Message: "ExpireAt must be in the future",
+			}},
+		})
+		return
+	}
+
+	user := c.MustGet("user").(*models.User)
+	permissions := c.GetStringSlice("permissions")
+
+	apiKey, plain, err := h.APIKeyService.Create(c.Request.Context(), user, permissions, organisationId, req.Name, req.Scopes, req.ExpireAt)
+
+	if err != nil {
+		log.Println("error creating api key", err)
+
+		cause := errors.Cause(err)
+		switch cause.(type) {
+		case pkgError.NotFoundError:
+			respondOrganisationNotFound(c)
+		case pkgError.InvalidScopeError:
+			c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusUnprocessableEntity,
+				Message: "invalid request data",
+				ValidationErrors: []pkgError.ValidationError{{
+					Field:   "Scopes",
+					Tag:     "scope",
+					Message: cause.Error(),
+				}},
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+				Success: false,
+				Message: "an error occurred while creating api key",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.JSONApiResponse{
+		Success: true,
+		Message: "api key created, store the key somewhere safe as it will not be shown again",
+		Data: gin.H{
+			"api_key": apiKey,
+			"key":     plain,
+		},
+	})
+}
+
+func (h *APIKeyHandler) List(c *gin.Context) {
+	organisationId, err := strconv.ParseInt(c.Param("organisationId"), 10, 64)
+
+	if err != nil {
+		respondOrganisationNotFound(c)
+		return
+	}
+
+	apiKeys, err := h.APIKeyService.List(c.Request.Context(), organisationId)
+
+	if err != nil {
+		log.Println("error listing api keys", err)
+		c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+			Success: false,
+			Message: "an error occurred while fetching api keys",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data:    apiKeys,
+	})
+}
+
+func (h *APIKeyHandler) Revoke(c *gin.Context) {
+	organisationId, orgErr := strconv.ParseInt(c.Param("organisationId"), 10, 64)
+	id, idErr := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	if orgErr != nil || idErr != nil {
+		c.JSON(http.StatusNotFound, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusNotFound,
+			Message: "api key not found",
+		})
+		return
+	}
+
+	err := h.APIKeyService.Revoke(c.Request.Context(), organisationId, id)
+
+	if err != nil {
+		log.Println("error revoking api key", err)
+
+		switch errors.Cause(err).(type) {
+		case pkgError.NotFoundError:
+			c.JSON(http.StatusNotFound, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusNotFound,
+				Message: "api key not found",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+				Success: false,
+				Message: "an error occurred while revoking api key",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "api key revoked successfully",
+	})
+}
+
+func NewAPIKeyHandler(APIKeyService *services.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{
+		APIKeyService: APIKeyService,
+	}
+}
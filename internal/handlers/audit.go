@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/repositories"
+	"github.com/jacoobjake/einvoice-api/internal/services"
+	pkgError "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/response"
+)
+
+type AuditHandler struct {
+	AuditService *services.AuditService
+}
+
+type AuditEventQuery struct {
+	ActorType      string    `form:"actor_type"`
+	ActorID        int64     `form:"actor_id" binding:"omitempty,min=1"`
+	OrganisationID int64     `form:"organisation_id" binding:"omitempty,min=1"`
+	Action         string    `form:"action"`
+	EntityType     string    `form:"entity_type"`
+	EntityID       string    `form:"entity_id"`
+	From           time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To             time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Page           int       `form:"page,default=1" binding:"min=1"`
+	PerPage        int       `form:"per_page,default=50" binding:"min=1,max=200"`
+}
+
+func (q AuditEventQuery) filter() repositories.AuditEventFilter {
+	return repositories.AuditEventFilter{
+		ActorType:      q.ActorType,
+		ActorID:        q.ActorID,
+		OrganisationID: q.OrganisationID,
+		Action:         q.Action,
+		EntityType:     q.EntityType,
+		EntityID:       q.EntityID,
+		From:           q.From,
+		To:             q.To,
+	}
+}
+
+func bindAuditEventQuery(c *gin.Context) (AuditEventQuery, bool) {
+	var query AuditEventQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		log.Println("Error binding query:", err)
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success:          false,
+			Code:             http.StatusUnprocessableEntity,
+			Message:          "invalid request data",
+			ValidationErrors: pkgError.FormatValidationError(err),
+		})
+		return query, false
+	}
+
+	return query, true
+}
+
+func (h *AuditHandler) List(c *gin.Context) {
+	query, ok := bindAuditEventQuery(c)
+
+	if !ok {
+		return
+	}
+
+	events, total, err := h.AuditService.List(c.Request.Context(), query.filter(), query.Page, query.PerPage)
+
+	if err != nil {
+		log.Println("error listing audit events", err)
+		c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+			Success: false,
+			Message: "an error occurred while fetching audit events",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data: gin.H{
+			"events": events,
+			"pagination": response.Pagination{
+				Page:    query.Page,
+				PerPage: query.PerPage,
+				Total:   total,
+			},
+		},
+	})
+}
+
+// Export streams every matching event as CSV, ignoring pagination.
+func (h *AuditHandler) Export(c *gin.Context) {
+	query, ok := bindAuditEventQuery(c)
+
+	if !ok {
+		return
+	}
+
+	filename := fmt.Sprintf("audit-events-%s.csv", time.Now().UTC().Format("20060102150405"))
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Status(http.StatusOK)
+
+	// Rows are already on the wire when a later batch fails, so the error can only be logged
+	if err := h.AuditService.ExportCSV(c.Request.Context(), query.filter(), c.Writer); err != nil {
+		log.Println("error exporting audit events", err)
+	}
+}
+
+func NewAuditHandler(AuditService *services.AuditService) *AuditHandler {
+	return &AuditHandler{
+		AuditService: AuditService,
+	}
+}
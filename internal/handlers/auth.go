@@ -2,9 +2,14 @@ package handlers
 
 import (
 	"log"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
 	"github.com/jacoobjake/einvoice-api/internal/services"
 	pkgError "github.com/jacoobjake/einvoice-api/pkg/error"
 	"github.com/jacoobjake/einvoice-api/pkg/response"
@@ -36,13 +41,31 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	token, refreshToken, err := h.AuthService.Token(c.Request.Context(), req.Email, req.Password)
 
 	if err != nil {
-		log.Println("Error during login:", err)
+		log.Println("Login not completed:", err)
 
 		var msg string
 		cause := errors.Cause(err)
-		switch cause.(type) {
+		switch e := cause.(type) {
+		case pkgError.MFARequiredError:
+			c.JSON(http.StatusOK, response.JSONApiResponse{
+				Success: true,
+				Message: e.Error(),
+				Data: gin.H{
+					"mfa_required": true,
+					"mfa_token":    e.ChallengeToken,
+				},
+			})
+			return
 		case pkgError.MaxLoginAttemptError:
-			msg = cause.Error()
+			respondLockedOut(c, e)
+			return
+		case pkgError.EmailNotVerifiedError:
+			c.JSON(http.StatusForbidden, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusForbidden,
+				Message: cause.Error(),
+			})
+			return
 		default:
 			msg = "invalid credentials"
 		}
@@ -64,9 +87,61 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	})
 }
 
+func respondLockedOut(c *gin.Context, e pkgError.MaxLoginAttemptError) {
+	c.Header("Retry-After", strconv.Itoa(int(math.Ceil(time.Until(e.UnlockAt).Seconds()))))
+	c.JSON(http.StatusUnauthorized, response.JSONApiResponse{
+		Success: false,
+		Code:    http.StatusUnauthorized,
+		Message: e.Error(),
+		Data: gin.H{
+			"unlock_at": e.UnlockAt,
+		},
+	})
+}
+
+// UnlockUser lifts the login lockout of a user.
+func (h *AuthHandler) UnlockUser(c *gin.Context) {
+	userId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	if err != nil {
+		c.JSON(http.StatusNotFound, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusNotFound,
+			Message: "user not found",
+		})
+		return
+	}
+
+	err = h.AuthService.UnlockUser(c.Request.Context(), userId)
+
+	if err != nil {
+		log.Println("error unlocking user", err)
+
+		switch errors.Cause(err).(type) {
+		case pkgError.NotFoundError:
+			c.JSON(http.StatusNotFound, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusNotFound,
+				Message: "user not found",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+				Success: false,
+				Message: "an error occurred while unlocking user",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "user unlocked successfully",
+	})
+}
+
 func (h *AuthHandler) Logout(c *gin.Context) {
 	token := c.GetString("auth_token")
-	err := h.AuthService.RevokeToken(c, token)
+	err := h.AuthService.RevokeToken(c.Request.Context(), token)
 
 	if err != nil {
 		log.Println("error revoking token", err)
@@ -122,6 +197,368 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 	})
 }
 
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var req ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success:          false,
+			Code:             http.StatusUnprocessableEntity,
+			Message:          "invalid request data",
+			ValidationErrors: pkgError.FormatValidationError(err),
+		})
+		return
+	}
+
+	// Always respond the same way so registered emails cannot be discovered
+	if err := h.AuthService.ForgotPassword(c.Request.Context(), req.Email); err != nil {
+		log.Println("error requesting password reset", err)
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "if the email is registered, a password reset link has been sent",
+	})
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var req ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success:          false,
+			Code:             http.StatusUnprocessableEntity,
+			Message:          "invalid request data",
+			ValidationErrors: pkgError.FormatValidationError(err),
+		})
+		return
+	}
+
+	err := h.AuthService.ResetPassword(c.Request.Context(), req.Token, req.Password)
+
+	if err != nil {
+		log.Println("error resetting password", err)
+
+		cause := errors.Cause(err)
+		switch cause.(type) {
+		case pkgError.InvalidPasswordError:
+			c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusUnprocessableEntity,
+				Message: "invalid request data",
+				ValidationErrors: []pkgError.ValidationError{{
+					Field:   "Password",
+					Tag:     "password",
+					Message: cause.Error(),
+				}},
+			})
+		case pkgError.InvalidTokenError:
+			c.JSON(http.StatusBadRequest, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusBadRequest,
+				Message: cause.Error(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+				Success: false,
+				Message: "an error occurred while resetting password",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "password reset successfully",
+	})
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+func (h *AuthHandler) VerifyEmail(c *gin.Context) {
+	var req VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success:          false,
+			Code:             http.StatusUnprocessableEntity,
+			Message:          "invalid request data",
+			ValidationErrors: pkgError.FormatValidationError(err),
+		})
+		return
+	}
+
+	err := h.AuthService.VerifyEmail(c.Request.Context(), req.Token)
+
+	if err != nil {
+		log.Println("error verifying email", err)
+
+		cause := errors.Cause(err)
+		switch cause.(type) {
+		case pkgError.InvalidTokenError:
+			c.JSON(http.StatusBadRequest, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusBadRequest,
+				Message: cause.Error(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+				Success: false,
+				Message: "an error occurred while verifying email",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "email verified successfully",
+	})
+}
+
+// ConfirmEmailChange switches the account to the new email the confirmation link was sent to.
+func (h *AuthHandler) ConfirmEmailChange(c *gin.Context) {
+	var req VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	err := h.AuthService.ConfirmEmailChange(c.Request.Context(), req.Token)
+
+	if err != nil {
+		log.Println("error confirming email change", err)
+
+		cause := errors.Cause(err)
+		switch cause.(type) {
+		case pkgError.InvalidTokenError:
+			c.JSON(http.StatusBadRequest, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusBadRequest,
+				Message: cause.Error(),
+			})
+		default:
+			respondUserError(c, err, "an error occurred while confirming email change")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "email changed successfully",
+	})
+}
+
+type ResendEmailVerificationRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+func (h *AuthHandler) ResendEmailVerification(c *gin.Context) {
+	var req ResendEmailVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success:          false,
+			Code:             http.StatusUnprocessableEntity,
+			Message:          "invalid request data",
+			ValidationErrors: pkgError.FormatValidationError(err),
+		})
+		return
+	}
+
+	err := h.AuthService.ResendEmailVerification(c.Request.Context(), req.Email)
+
+	if err != nil {
+		log.Println("error resending email verification", err)
+
+		cause := errors.Cause(err)
+		switch e := cause.(type) {
+		case pkgError.TooManyRequestsError:
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(e.RetryAfter.Seconds()))))
+			c.JSON(http.StatusTooManyRequests, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusTooManyRequests,
+				Message: e.Error(),
+			})
+			return
+		}
+	}
+
+	// Any other failure is hidden so registered emails cannot be discovered
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "if the email is registered and unverified, a verification link has been sent",
+	})
+}
+
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+	user := c.MustGet("user").(*models.User)
+	sessionId := c.MustGet("session_id").(uuid.UUID)
+
+	sessions, err := h.AuthService.ListSessions(c.Request.Context(), user.ID, sessionId)
+
+	if err != nil {
+		log.Println("error listing sessions", err)
+		c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+			Success: false,
+			Message: "an error occurred while fetching sessions",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data:    sessions,
+	})
+}
+
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+	user := c.MustGet("user").(*models.User)
+	sessionId, err := uuid.FromString(c.Param("id"))
+
+	if err != nil {
+		c.JSON(http.StatusNotFound, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusNotFound,
+			Message: "session not found",
+		})
+		return
+	}
+
+	err = h.AuthService.RevokeSession(c.Request.Context(), user.ID, sessionId)
+
+	if err != nil {
+		log.Println("error revoking session", err)
+
+		switch errors.Cause(err).(type) {
+		case pkgError.NotFoundError:
+			c.JSON(http.StatusNotFound, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusNotFound,
+				Message: "session not found",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+				Success: false,
+				Message: "an error occurred while revoking session",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "session revoked successfully",
+	})
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	Password        string `json:"password" binding:"required"`
+}
+
+// ChangePassword keeps the current session signed in and logs out every other one.
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	user := c.MustGet("user").(*models.User)
+	sessionId := c.MustGet("session_id").(uuid.UUID)
+
+	var req ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success:          false,
+			Code:             http.StatusUnprocessableEntity,
+			Message:          "invalid request data",
+			ValidationErrors: pkgError.FormatValidationError(err),
+		})
+		return
+	}
+
+	err := h.AuthService.ChangePassword(c.Request.Context(), user, sessionId, req.CurrentPassword, req.Password)
+
+	if err != nil {
+		log.Println("error changing password", err)
+
+		cause := errors.Cause(err)
+		switch cause.(type) {
+		case pkgError.InvalidPasswordError:
+			c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusUnprocessableEntity,
+				Message: "invalid request data",
+				ValidationErrors: []pkgError.ValidationError{{
+					Field:   "Password",
+					Tag:     "password",
+					Message: cause.Error(),
+				}},
+			})
+		case pkgError.InvalidCredentialsError:
+			c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusUnprocessableEntity,
+				Message: "invalid request data",
+				ValidationErrors: []pkgError.ValidationError{{
+					Field:   "CurrentPassword",
+					Tag:     "current_password",
+					Message: "current password is incorrect",
+				}},
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+				Success: false,
+				Message: "an error occurred while changing password",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "password changed successfully, other sessions have been logged out",
+	})
+}
+
+func (h *AuthHandler) RevokeAllSessions(c *gin.Context) {
+	user := c.MustGet("user").(*models.User)
+
+	count, err := h.AuthService.RevokeAllSessions(c.Request.Context(), user.ID)
+
+	if err != nil {
+		log.Println("error revoking all sessions", err)
+		c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+			Success: false,
+			Message: "an error occurred while revoking sessions",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "logged out of all sessions",
+		Data: gin.H{
+			"revoked_sessions": count,
+		},
+	})
+}
+
+// JWKS publishes the token verification keys in the standard JWK Set format
+// so it can be consumed by off-the-shelf JWT libraries.
+func (h *AuthHandler) JWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.AuthService.JWKS())
+}
+
 func NewAuthHandler(AuthService *services.AuthService) *AuthHandler {
 	return &AuthHandler{
 		AuthService: AuthService,
@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/internal/repositories"
+	"github.com/jacoobjake/einvoice-api/internal/services"
+	pkgError "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/response"
+	"github.com/pkg/errors"
+)
+
+type InvitationHandler struct {
+	InvitationService *services.InvitationService
+}
+
+type CreateInvitationRequest struct {
+	Email string `json:"email" binding:"required,email,max=300"`
+	Role  string `json:"role" binding:"required,max=100"`
+}
+
+type AcceptInvitationRequest struct {
+	Token     string `json:"token" binding:"required"`
+	FirstName string `json:"first_name" binding:"required,max=50"`
+	LastName  string `json:"last_name" binding:"required,max=50"`
+	Password  string `json:"password" binding:"required"`
+}
+
+type InvitationQuery struct {
+	Status  string `form:"status" binding:"omitempty,oneof=pending expired accepted revoked"`
+	Email   string `form:"email" binding:"max=300"`
+	Page    int    `form:"page,default=1" binding:"min=1"`
+	PerPage int    `form:"per_page,default=20" binding:"min=1,max=100"`
+}
+
+func bindInvitationId(c *gin.Context) (int64, bool) {
+	invitationId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	if err != nil {
+		c.JSON(http.StatusNotFound, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusNotFound,
+			Message: "invitation not found",
+		})
+		return 0, false
+	}
+
+	return invitationId, true
+}
+
+func respondInvitationError(c *gin.Context, err error, message string) {
+	cause := errors.Cause(err)
+
+	switch cause.(type) {
+	case pkgError.InvalidRoleError:
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusUnprocessableEntity,
+			Message: "invalid request data",
+			ValidationErrors: []pkgError.ValidationError{{
+				Field:   "Role",
+				Tag:     "role",
+				Message: cause.Error(),
+			}},
+		})
+	case pkgError.InvalidTokenError:
+		c.JSON(http.StatusBadRequest, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusBadRequest,
+			Message: cause.Error(),
+		})
+	default:
+		respondUserError(c, err, message)
+	}
+}
+
+func (h *InvitationHandler) Create(c *gin.Context) {
+	user := c.MustGet("user").(*models.User)
+
+	var req CreateInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	invitation, err := h.InvitationService.Invite(c.Request.Context(), user, c.GetStringSlice("permissions"), req.Email, req.Role)
+
+	if err != nil {
+		log.Println("error creating invitation", err)
+		respondInvitationError(c, err, "an error occurred while creating invitation")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.JSONApiResponse{
+		Success: true,
+		Code:    http.StatusCreated,
+		Message: "invitation sent successfully",
+		Data:    gin.H{"invitation": invitation},
+	})
+}
+
+func (h *InvitationHandler) List(c *gin.Context) {
+	var query InvitationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	filter := repositories.InvitationFilter{
+		Status: query.Status,
+		Email:  query.Email,
+	}
+
+	invitations, total, err := h.InvitationService.List(c.Request.Context(), filter, query.Page, query.PerPage)
+
+	if err != nil {
+		log.Println("error listing invitations", err)
+		c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+			Success: false,
+			Message: "an error occurred while fetching invitations",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data: gin.H{
+			"invitations": invitations,
+			"pagination": response.Pagination{
+				Page:    query.Page,
+				PerPage: query.PerPage,
+				Total:   total,
+			},
+		},
+	})
+}
+
+func (h *InvitationHandler) Resend(c *gin.Context) {
+	invitationId, ok := bindInvitationId(c)
+
+	if !ok {
+		return
+	}
+
+	invitation, err := h.InvitationService.Resend(c.Request.Context(), invitationId)
+
+	if err != nil {
+		log.Println("error resending invitation", err)
+		respondInvitationError(c, err, "an error occurred while resending invitation")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "invitation resent successfully",
+		Data:    gin.H{"invitation": invitation},
+	})
+}
+
+func (h *InvitationHandler) Revoke(c *gin.Context) {
+	invitationId, ok := bindInvitationId(c)
+
+	if !ok {
+		return
+	}
+
+	invitation, err := h.InvitationService.Revoke(c.Request.Context(), invitationId)
+
+	if err != nil {
+		log.Println("error revoking invitation", err)
+		respondInvitationError(c, err, "an error occurred while revoking invitation")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "invitation revoked successfully",
+		Data:    gin.H{"invitation": invitation},
+	})
+}
+
+// Accept creates the invitee's account, they sign in through the regular login afterwards.
+func (h *InvitationHandler) Accept(c *gin.Context) {
+	var req AcceptInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	user, err := h.InvitationService.Accept(c.Request.Context(), req.Token, req.FirstName, req.LastName, req.Password)
+
+	if err != nil {
+		log.Println("error accepting invitation", err)
+		respondInvitationError(c, err, "an error occurred while accepting invitation")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.JSONApiResponse{
+		Success: true,
+		Code:    http.StatusCreated,
+		Message: "invitation accepted successfully",
+		Data:    gin.H{"user": user},
+	})
+}
+
+func NewInvitationHandler(InvitationService *services.InvitationService) *InvitationHandler {
+	return &InvitationHandler{
+		InvitationService: InvitationService,
+	}
+}
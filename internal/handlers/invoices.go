@@ -0,0 +1,488 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/repositories"
+	"github.com/jacoobjake/einvoice-api/internal/services"
+	pkgError "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/invoice"
+	"github.com/jacoobjake/einvoice-api/pkg/lhdn"
+	"github.com/jacoobjake/einvoice-api/pkg/response"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+type InvoiceHandler struct {
+	InvoiceService *services.InvoiceService
+}
+
+// InvoicePartyRequest is the buyer or shipping recipient of an invoice, the supplier comes from the taxpayer profile.
+// Self-billed invoices are the other way round, the organisation being the buyer.
+type InvoicePartyRequest struct {
+	Name                  string `json:"name" binding:"required,max=300"`
+	TIN                   string `json:"tin" binding:"required,lhdn_tin"`
+	IDType                string `json:"id_type" binding:"required,oneof=NRIC BRN PASSPORT ARMY"`
+	IDValue               string `json:"id_value" binding:"required,lhdn_id=IDType"`
+	SSTRegistrationNumber string `json:"sst_registration_number" binding:"omitempty,max=35,lhdn_sst"`
+	AddressLine1          string `json:"address_line1" binding:"required,max=150"`
+	AddressLine2          string `json:"address_line2" binding:"omitempty,max=150"`
+	AddressLine3          string `json:"address_line3" binding:"omitempty,max=150"`
+	PostalZone            string `json:"postal_zone" binding:"omitempty,max=50"`
+	CityName              string `json:"city_name" binding:"required,max=50"`
+	StateCode             string `json:"state_code" binding:"required,lhdn_state"`
+	CountryCode           string `json:"country_code" binding:"omitempty,lhdn_country"`
+	Phone                 string `json:"phone" binding:"omitempty,max=20,lhdn_phone"`
+	Email                 string `json:"email" binding:"omitempty,email,max=320"`
+}
+
+// InvoiceSupplierRequest is the supplier of a self-billed invoice. Suppliers without a business activity
+// classification, such as individuals, default to MSIC code 00000 and NA.
+type InvoiceSupplierRequest struct {
+	InvoicePartyRequest
+	TourismTaxRegistrationNumber string `json:"tourism_tax_registration_number" binding:"omitempty,max=17,lhdn_ttx"`
+	MSICCode                     string `json:"msic_code" binding:"omitempty,lhdn_msic"`
+	BusinessActivityDescription  string `json:"business_activity_description" binding:"omitempty,max=300"`
+}
+
+// InvoiceTaxRequest is charged at a percentage rate or an amount per unit. Exemptions need a reason.
+type InvoiceTaxRequest struct {
+	TaxType         string              `json:"tax_type" binding:"required,lhdn_tax_type"`
+	Rate            decimal.NullDecimal `json:"rate" binding:"omitempty,gte=0,lte=100"`
+	PerUnitAmount   decimal.NullDecimal `json:"per_unit_amount" binding:"omitempty,gte=0"`
+	ExemptionReason string              `json:"exemption_reason" binding:"required_if=TaxType E,max=300"`
+}
+
+// InvoiceAllowanceChargeRequest is a discount, or a fee when is_charge, of a fixed amount or a percentage rate.
+type InvoiceAllowanceChargeRequest struct {
+	IsCharge bool                `json:"is_charge"`
+	Reason   string              `json:"reason" binding:"max=300"`
+	Rate     decimal.NullDecimal `json:"rate" binding:"omitempty,gt=0,lte=100"`
+	Amount   decimal.Decimal     `json:"amount" binding:"gte=0"`
+}
+
+type InvoiceLineRequest struct {
+	ClassificationCode string                          `json:"classification_code" binding:"required,lhdn_classification"`
+	Description        string                          `json:"description" binding:"required,max=300"`
+	Quantity           decimal.Decimal                 `json:"quantity" binding:"gt=0"`
+	UnitCode           string                          `json:"unit_code" binding:"omitempty,min=2,max=3,alphanum"`
+	UnitPrice          decimal.Decimal                 `json:"unit_price" binding:"gte=0"`
+	ProductTariffCode  string                          `json:"product_tariff_code" binding:"omitempty,max=12"`
+	CountryOfOrigin    string                          `json:"country_of_origin" binding:"omitempty,lhdn_country"`
+	Taxes              []InvoiceTaxRequest             `json:"taxes" binding:"required,min=1,dive"`
+	AllowanceCharges   []InvoiceAllowanceChargeRequest `json:"allowance_charges" binding:"dive"`
+}
+
+type SaveInvoiceRequest struct {
+	// 01 for an invoice, 11 for a self-billed invoice the organisation issues on behalf of its supplier
+	TypeCode      string              `json:"type_code" binding:"omitempty,oneof=01 11"`
+	InvoiceNumber string              `json:"invoice_number" binding:"required,max=50"`
+	IssueDate     string              `json:"issue_date" binding:"required,datetime=2006-01-02"`
+	CurrencyCode  string              `json:"currency_code" binding:"required,iso4217"`
+	ExchangeRate  decimal.NullDecimal `json:"exchange_rate" binding:"required_unless=CurrencyCode MYR,omitempty,gt=0,lt=1000000000"`
+	// Both ends of the billing period in YYYY-MM-DD
+	BillingPeriodStart  string                          `json:"billing_period_start" binding:"required_with=BillingPeriodEnd,omitempty,datetime=2006-01-02"`
+	BillingPeriodEnd    string                          `json:"billing_period_end" binding:"required_with=BillingPeriodStart,omitempty,datetime=2006-01-02"`
+	BillingFrequency    string                          `json:"billing_frequency" binding:"omitempty,max=50"`
+	PaymentMode         string                          `json:"payment_mode" binding:"omitempty,lhdn_payment_mode"`
+	PaymentTerms        string                          `json:"payment_terms" binding:"omitempty,max=300"`
+	SupplierBankAccount string                          `json:"supplier_bank_account" binding:"omitempty,max=150"`
+	Supplier            *InvoiceSupplierRequest         `json:"supplier" binding:"required_if=TypeCode 11,excluded_unless=TypeCode 11"`
+	Buyer               *InvoicePartyRequest            `json:"buyer" binding:"required_unless=TypeCode 11,excluded_if=TypeCode 11"`
+	ShippingRecipient   *InvoicePartyRequest            `json:"shipping_recipient" binding:"omitempty"`
+	Lines               []InvoiceLineRequest            `json:"lines" binding:"required,min=1,max=1000,dive"`
+	AllowanceCharges    []InvoiceAllowanceChargeRequest `json:"allowance_charges" binding:"dive"`
+}
+
+// CreateNoteRequest is a credit, debit or refund note, the self-billed ones adjust self-billed invoices.
+// The parties and currency are those of the invoice it adjusts.
+type CreateNoteRequest struct {
+	TypeCode      string `json:"type_code" binding:"required,oneof=02 03 04 12 13 14"`
+	InvoiceNumber string `json:"invoice_number" binding:"required,max=50"`
+	IssueDate     string `json:"issue_date" binding:"required,datetime=2006-01-02"`
+	// Both ends of the billing period in YYYY-MM-DD
+	BillingPeriodStart  string                          `json:"billing_period_start" binding:"required_with=BillingPeriodEnd,omitempty,datetime=2006-01-02"`
+	BillingPeriodEnd    string                          `json:"billing_period_end" binding:"required_with=BillingPeriodStart,omitempty,datetime=2006-01-02"`
+	PaymentMode         string                          `json:"payment_mode" binding:"omitempty,lhdn_payment_mode"`
+	PaymentTerms        string                          `json:"payment_terms" binding:"omitempty,max=300"`
+	SupplierBankAccount string                          `json:"supplier_bank_account" binding:"omitempty,max=150"`
+	Lines               []InvoiceLineRequest            `json:"lines" binding:"required,min=1,max=1000,dive"`
+	AllowanceCharges    []InvoiceAllowanceChargeRequest `json:"allowance_charges" binding:"dive"`
+}
+
+// CalculateInvoiceRequest is the part of an invoice its amounts are worked out from.
+type CalculateInvoiceRequest struct {
+	CurrencyCode     string                          `json:"currency_code" binding:"required,iso4217"`
+	PaymentMode      string                          `json:"payment_mode" binding:"omitempty,lhdn_payment_mode"`
+	Lines            []InvoiceLineRequest            `json:"lines" binding:"required,min=1,max=1000,dive"`
+	AllowanceCharges []InvoiceAllowanceChargeRequest `json:"allowance_charges" binding:"dive"`
+}
+
+type InvoiceQuery struct {
+	Status     string `form:"status" binding:"omitempty,oneof=draft validated submitted valid invalid cancelled rejected"`
+	TypeCode   string `form:"type_code" binding:"omitempty,oneof=01 02 03 04 11 12 13 14"`
+	Search     string `form:"search" binding:"max=50"`
+	IssuedFrom string `form:"issued_from" binding:"omitempty,datetime=2006-01-02"`
+	IssuedTo   string `form:"issued_to" binding:"omitempty,datetime=2006-01-02"`
+	// Prefix with "-" for descending order
+	Sort    string `form:"sort,default=-issue_date" binding:"oneof=id -id invoice_number -invoice_number issue_date -issue_date payable_amount -payable_amount created_at -created_at"`
+	Page    int    `form:"page,default=1" binding:"min=1"`
+	PerPage int    `form:"per_page,default=20" binding:"min=1,max=100"`
+}
+
+func (r InvoicePartyRequest) party() *invoice.Party {
+	return &invoice.Party{
+		Name:                  r.Name,
+		TIN:                   r.TIN,
+		IDType:                r.IDType,
+		IDValue:               r.IDValue,
+		SSTRegistrationNumber: orDefault(r.SSTRegistrationNumber, lhdn.NotApplicable),
+		AddressLine1:          r.AddressLine1,
+		AddressLine2:          r.AddressLine2,
+		AddressLine3:          r.AddressLine3,
+		PostalZone:            r.PostalZone,
+		CityName:              r.CityName,
+		StateCode:             r.StateCode,
+		CountryCode:           orDefault(r.CountryCode, lhdn.DefaultCountryCode),
+		Phone:                 r.Phone,
+		Email:                 r.Email,
+	}
+}
+
+func (r InvoiceSupplierRequest) party() *invoice.Party {
+	party := r.InvoicePartyRequest.party()
+	party.TourismTaxRegistrationNumber = orDefault(r.TourismTaxRegistrationNumber, lhdn.NotApplicable)
+	party.MSICCode = orDefault(r.MSICCode, lhdn.MSICNotApplicable)
+	party.BusinessActivityDescription = orDefault(r.BusinessActivityDescription, lhdn.NotApplicable)
+
+	return party
+}
+
+func toDomainAllowanceCharges(requests []InvoiceAllowanceChargeRequest) []invoice.AllowanceCharge {
+	allowanceCharges := make([]invoice.AllowanceCharge, 0, len(requests))
+	for _, r := range requests {
+		allowanceCharges = append(allowanceCharges, invoice.AllowanceCharge{
+			IsCharge: r.IsCharge,
+			Reason:   r.Reason,
+			Rate:     r.Rate,
+			Amount:   r.Amount,
+		})
+	}
+
+	return allowanceCharges
+}
+
+func toDomainLines(requests []InvoiceLineRequest) []invoice.Line {
+	lines := make([]invoice.Line, 0, len(requests))
+	for _, line := range requests {
+		taxes := make([]invoice.TaxBreakdown, 0, len(line.Taxes))
+		for _, tax := range line.Taxes {
+			taxes = append(taxes, invoice.TaxBreakdown{
+				TaxType:         tax.TaxType,
+				Rate:            tax.Rate,
+				PerUnitAmount:   tax.PerUnitAmount,
+				ExemptionReason: tax.ExemptionReason,
+			})
+		}
+
+		lines = append(lines, invoice.Line{
+			ClassificationCode: line.ClassificationCode,
+			Description:        line.Description,
+			Quantity:           line.Quantity,
+			UnitCode:           line.UnitCode,
+			UnitPrice:          line.UnitPrice,
+			ProductTariffCode:  line.ProductTariffCode,
+			CountryOfOrigin:    line.CountryOfOrigin,
+			Taxes:              taxes,
+			AllowanceCharges:   toDomainAllowanceCharges(line.AllowanceCharges),
+		})
+	}
+
+	return lines
+}
+
+func (r SaveInvoiceRequest) draft() invoice.Invoice {
+	draft := invoice.Invoice{
+		Number:              r.InvoiceNumber,
+		TypeCode:            orDefault(r.TypeCode, lhdn.DocumentTypeInvoice),
+		IssueDate:           r.IssueDate,
+		CurrencyCode:        r.CurrencyCode,
+		ExchangeRate:        r.ExchangeRate,
+		BillingPeriodStart:  r.BillingPeriodStart,
+		BillingPeriodEnd:    r.BillingPeriodEnd,
+		BillingFrequency:    r.BillingFrequency,
+		PaymentMode:         r.PaymentMode,
+		PaymentTerms:        r.PaymentTerms,
+		SupplierBankAccount: r.SupplierBankAccount,
+		Lines:               toDomainLines(r.Lines),
+		AllowanceCharges:    toDomainAllowanceCharges(r.AllowanceCharges),
+	}
+
+	if r.Supplier != nil {
+		draft.Supplier = r.Supplier.party()
+	}
+
+	if r.Buyer != nil {
+		draft.Buyer = r.Buyer.party()
+	}
+
+	if r.ShippingRecipient != nil {
+		draft.ShippingRecipient = r.ShippingRecipient.party()
+	}
+
+	return draft
+}
+
+func (r CreateNoteRequest) draft() invoice.Invoice {
+	return invoice.Invoice{
+		Number:              r.InvoiceNumber,
+		TypeCode:            r.TypeCode,
+		IssueDate:           r.IssueDate,
+		BillingPeriodStart:  r.BillingPeriodStart,
+		BillingPeriodEnd:    r.BillingPeriodEnd,
+		PaymentMode:         r.PaymentMode,
+		PaymentTerms:        r.PaymentTerms,
+		SupplierBankAccount: r.SupplierBankAccount,
+		Lines:               toDomainLines(r.Lines),
+		AllowanceCharges:    toDomainAllowanceCharges(r.AllowanceCharges),
+	}
+}
+
+func (r CalculateInvoiceRequest) draft() invoice.Invoice {
+	return invoice.Invoice{
+		CurrencyCode:     r.CurrencyCode,
+		PaymentMode:      r.PaymentMode,
+		Lines:            toDomainLines(r.Lines),
+		AllowanceCharges: toDomainAllowanceCharges(r.AllowanceCharges),
+	}
+}
+
+func bindInvoiceId(c *gin.Context) (int64, bool) {
+	invoiceId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	if err != nil {
+		c.JSON(http.StatusNotFound, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusNotFound,
+			Message: "invoice not found",
+		})
+		return 0, false
+	}
+
+	return invoiceId, true
+}
+
+func respondInvoiceError(c *gin.Context, err error, message string) {
+	cause := errors.Cause(err)
+
+	switch cause.(type) {
+	case pkgError.InvalidInvoiceError:
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusUnprocessableEntity,
+			Message: "invalid request data",
+			ValidationErrors: []pkgError.ValidationError{{
+				Field:   cause.(pkgError.InvalidInvoiceError).Field,
+				Tag:     "calculation",
+				Message: cause.Error(),
+			}},
+		})
+	default:
+		respondInvoiceError(c, err, message)
+	}
+}
+
+func (h *InvoiceHandler) List(c *gin.Context) {
+	var query InvoiceQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	filter := repositories.InvoiceFilter{
+		Status:     query.Status,
+		TypeCode:   query.TypeCode,
+		Search:     query.Search,
+		IssuedFrom: query.IssuedFrom,
+		IssuedTo:   query.IssuedTo,
+	}
+
+	invoices, total, err := h.InvoiceService.List(c.Request.Context(), filter, query.Sort, query.Page, query.PerPage)
+
+	if err != nil {
+		log.Println("error listing invoices", err)
+		c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+			Success: false,
+			Message: "an error occurred while fetching invoices",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data: gin.H{
+			"invoices": invoices,
+			"pagination": response.Pagination{
+				Page:    query.Page,
+				PerPage: query.PerPage,
+				Total:   total,
+			},
+		},
+	})
+}
+
+func (h *InvoiceHandler) Get(c *gin.Context) {
+	invoiceId, ok := bindInvoiceId(c)
+
+	if !ok {
+		return
+	}
+
+	result, err := h.InvoiceService.Get(c.Request.Context(), invoiceId)
+
+	if err != nil {
+		log.Println("error fetching invoice", err)
+		respondInvoiceError(c, err, "an error occurred while fetching invoice")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data:    gin.H{"invoice": result},
+	})
+}
+
+func (h *InvoiceHandler) Create(c *gin.Context) {
+	var req SaveInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	result, err := h.InvoiceService.Create(c.Request.Context(), c.GetInt64("organisation_id"), req.draft())
+
+	if err != nil {
+		log.Println("error creating invoice", err)
+		respondInvoiceError(c, err, "an error occurred while creating invoice")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.JSONApiResponse{
+		Success: true,
+		Code:    http.StatusCreated,
+		Message: "invoice created successfully",
+		Data:    gin.H{"invoice": result},
+	})
+}
+
+// CreateNote creates a draft credit, debit or refund note adjusting the invoice.
+func (h *InvoiceHandler) CreateNote(c *gin.Context) {
+	invoiceId, ok := bindInvoiceId(c)
+
+	if !ok {
+		return
+	}
+
+	var req CreateNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	result, err := h.InvoiceService.CreateNote(c.Request.Context(), c.GetInt64("organisation_id"), invoiceId, req.draft())
+
+	if err != nil {
+		log.Println("error creating note", err)
+		respondInvoiceError(c, err, "an error occurred while creating note")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.JSONApiResponse{
+		Success: true,
+		Code:    http.StatusCreated,
+		Message: "note created successfully",
+		Data:    gin.H{"invoice": result},
+	})
+}
+
+// Calculate previews the amounts, taxes and totals of an invoice without saving it.
+func (h *InvoiceHandler) Calculate(c *gin.Context) {
+	var req CalculateInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	result, err := h.InvoiceService.Calculate(req.draft())
+
+	if err != nil {
+		respondInvoiceError(c, err, "an error occurred while calculating invoice")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data: gin.H{
+			"lines":             result.Lines,
+			"allowance_charges": result.AllowanceCharges,
+			"totals":            result.Totals,
+		},
+	})
+}
+
+// Update replaces a draft invoice.
+func (h *InvoiceHandler) Update(c *gin.Context) {
+	invoiceId, ok := bindInvoiceId(c)
+
+	if !ok {
+		return
+	}
+
+	var req SaveInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	result, err := h.InvoiceService.Update(c.Request.Context(), c.GetInt64("organisation_id"), invoiceId, req.draft())
+
+	if err != nil {
+		log.Println("error updating invoice", err)
+		respondInvoiceError(c, err, "an error occurred while updating invoice")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "invoice updated successfully",
+		Data:    gin.H{"invoice": result},
+	})
+}
+
+func (h *InvoiceHandler) Delete(c *gin.Context) {
+	invoiceId, ok := bindInvoiceId(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.InvoiceService.Delete(c.Request.Context(), c.GetInt64("organisation_id"), invoiceId); err != nil {
+		log.Println("error deleting invoice", err)
+		respondInvoiceError(c, err, "an error occurred while deleting invoice")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "invoice deleted successfully",
+	})
+}
+
+func NewInvoiceHandler(InvoiceService *services.InvoiceService) *InvoiceHandler {
+	return &InvoiceHandler{
+		InvoiceService: InvoiceService,
+	}
+}
@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	pkgError "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/response"
+	"github.com/pkg/errors"
+)
+
+type LoginMFARequest struct {
+	MFAToken     string `json:"mfa_token" binding:"required"`
+	Code         string `json:"code" binding:"required_without=RecoveryCode"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
+func (h *AuthHandler) LoginMFA(c *gin.Context) {
+	var req LoginMFARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success:          false,
+			Code:             http.StatusUnprocessableEntity,
+			Message:          "invalid request data",
+			ValidationErrors: pkgError.FormatValidationError(err),
+		})
+		return
+	}
+
+	token, refreshToken, err := h.AuthService.CompleteMFALogin(c.Request.Context(), req.MFAToken, req.Code, req.RecoveryCode)
+
+	if err != nil {
+		log.Println("Error during mfa login:", err)
+
+		var msg string
+		cause := errors.Cause(err)
+		switch e := cause.(type) {
+		case pkgError.MaxLoginAttemptError:
+			respondLockedOut(c, e)
+			return
+		case pkgError.InvalidMFACodeError, pkgError.InvalidTokenError:
+			msg = cause.Error()
+		default:
+			msg = "invalid credentials"
+		}
+		c.JSON(http.StatusUnauthorized, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusUnauthorized,
+			Message: msg,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "login successful",
+		Data: gin.H{
+			"token":         token,
+			"refresh_token": refreshToken,
+		},
+	})
+}
+
+func (h *AuthHandler) EnrollTOTP(c *gin.Context) {
+	user := c.MustGet("user").(*models.User)
+
+	enrollment, err := h.AuthService.EnrollTOTP(c.Request.Context(), user)
+
+	if err != nil {
+		log.Println("error enrolling totp", err)
+		respondMFAError(c, err, "an error occurred while enrolling two-factor authentication")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "scan the otpauth uri with an authenticator app, then confirm with a code",
+		Data:    enrollment,
+	})
+}
+
+type ConfirmTOTPRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+func (h *AuthHandler) ConfirmTOTP(c *gin.Context) {
+	var req ConfirmTOTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success:          false,
+			Code:             http.StatusUnprocessableEntity,
+			Message:          "invalid request data",
+			ValidationErrors: pkgError.FormatValidationError(err),
+		})
+		return
+	}
+
+	user := c.MustGet("user").(*models.User)
+
+	codes, err := h.AuthService.ConfirmTOTP(c.Request.Context(), user, req.Code)
+
+	if err != nil {
+		log.Println("error confirming totp", err)
+		respondMFAError(c, err, "an error occurred while enabling two-factor authentication")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "two-factor authentication enabled, store the recovery codes somewhere safe",
+		Data: gin.H{
+			"recovery_codes": codes,
+		},
+	})
+}
+
+type DisableTOTPRequest struct {
+	Password string `json:"password" binding:"required"`
+}
+
+func (h *AuthHandler) DisableTOTP(c *gin.Context) {
+	var req DisableTOTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success:          false,
+			Code:             http.StatusUnprocessableEntity,
+			Message:          "invalid request data",
+			ValidationErrors: pkgError.FormatValidationError(err),
+		})
+		return
+	}
+
+	user := c.MustGet("user").(*models.User)
+
+	err := h.AuthService.DisableTOTP(c.Request.Context(), user, req.Password)
+
+	if err != nil {
+		log.Println("error disabling totp", err)
+		respondMFAError(c, err, "an error occurred while disabling two-factor authentication")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "two-factor authentication disabled",
+	})
+}
+
+func respondMFAError(c *gin.Context, err error, fallback string) {
+	cause := errors.Cause(err)
+	switch cause.(type) {
+	case pkgError.MFAAlreadyEnabledError, pkgError.MFANotEnabledError:
+		c.JSON(http.StatusConflict, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusConflict,
+			Message: cause.Error(),
+		})
+	case pkgError.InvalidMFACodeError, pkgError.InvalidCredentialsError:
+		c.JSON(http.StatusBadRequest, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusBadRequest,
+			Message: cause.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+			Success: false,
+			Message: fallback,
+		})
+	}
+}
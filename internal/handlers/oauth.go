@@ -0,0 +1,305 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/internal/services"
+	pkgError "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/response"
+	"github.com/pkg/errors"
+)
+
+// The token, introspection and revocation endpoints answer in the OAuth2 wire format
+// instead of response.JSONApiResponse so standard client libraries can talk to them.
+
+type OAuthHandler struct {
+	OAuthService *services.OAuthService
+}
+
+type OAuthError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+type TokenRequest struct {
+	GrantType    string `form:"grant_type" json:"grant_type" binding:"required"`
+	Scope        string `form:"scope" json:"scope"`
+	ClientID     string `form:"client_id" json:"client_id"`
+	ClientSecret string `form:"client_secret" json:"client_secret"`
+}
+
+type TokenIntrospectionRequest struct {
+	Token         string `form:"token" json:"token" binding:"required"`
+	TokenTypeHint string `form:"token_type_hint" json:"token_type_hint"`
+	ClientID      string `form:"client_id" json:"client_id"`
+	ClientSecret  string `form:"client_secret" json:"client_secret"`
+}
+
+type CreateOAuthClientRequest struct {
+	Name   string   `json:"name" binding:"required,max=100"`
+	Scopes []string `json:"scopes" binding:"required,min=1,dive,required"`
+}
+
+func oauthError(c *gin.Context, status int, code string, description string) {
+	c.JSON(status, OAuthError{Error: code, ErrorDescription: description})
+}
+
+// clientCredentials reads the client credentials from HTTP Basic auth, falling back to the request body.
+func clientCredentials(c *gin.Context, clientId string, clientSecret string) (string, string, bool) {
+	if id, secret, ok := c.Request.BasicAuth(); ok {
+		return id, secret, true
+	}
+
+	return clientId, clientSecret, clientId != "" && clientSecret != ""
+}
+
+func respondInvalidClient(c *gin.Context) {
+	c.Header("WWW-Authenticate", `Basic realm="oauth"`)
+	oauthError(c, http.StatusUnauthorized, "invalid_client", "client authentication failed")
+}
+
+func (h *OAuthHandler) Token(c *gin.Context) {
+	c.Header("Cache-Control", "no-store")
+	c.Header("Pragma", "no-cache")
+
+	var req TokenRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Println("Error binding token request:", err)
+		oauthError(c, http.StatusBadRequest, "invalid_request", "grant_type is required")
+		return
+	}
+
+	if req.GrantType != "client_credentials" {
+		oauthError(c, http.StatusBadRequest, "unsupported_grant_type", "only client_credentials is supported")
+		return
+	}
+
+	clientId, clientSecret, ok := clientCredentials(c, req.ClientID, req.ClientSecret)
+
+	if !ok {
+		respondInvalidClient(c)
+		return
+	}
+
+	token, err := h.OAuthService.ClientCredentialsToken(c.Request.Context(), clientId, clientSecret, req.Scope)
+
+	if err != nil {
+		log.Println("error issuing client token", err)
+
+		cause := errors.Cause(err)
+		switch cause.(type) {
+		case pkgError.InvalidClientError:
+			respondInvalidClient(c)
+		case pkgError.InvalidScopeError:
+			oauthError(c, http.StatusBadRequest, "invalid_scope", cause.Error())
+		default:
+			oauthError(c, http.StatusInternalServerError, "server_error", "")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, token)
+}
+
+func (h *OAuthHandler) Introspect(c *gin.Context) {
+	c.Header("Cache-Control", "no-store")
+
+	var req TokenIntrospectionRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Println("Error binding introspection request:", err)
+		oauthError(c, http.StatusBadRequest, "invalid_request", "token is required")
+		return
+	}
+
+	clientId, clientSecret, ok := clientCredentials(c, req.ClientID, req.ClientSecret)
+
+	if !ok {
+		respondInvalidClient(c)
+		return
+	}
+
+	introspection, err := h.OAuthService.Introspect(c.Request.Context(), clientId, clientSecret, req.Token)
+
+	if err != nil {
+		log.Println("error introspecting token", err)
+
+		switch errors.Cause(err).(type) {
+		case pkgError.InvalidClientError:
+			respondInvalidClient(c)
+		default:
+			oauthError(c, http.StatusInternalServerError, "server_error", "")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, introspection)
+}
+
+func (h *OAuthHandler) Revoke(c *gin.Context) {
+	var req TokenIntrospectionRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Println("Error binding revocation request:", err)
+		oauthError(c, http.StatusBadRequest, "invalid_request", "token is required")
+		return
+	}
+
+	clientId, clientSecret, ok := clientCredentials(c, req.ClientID, req.ClientSecret)
+
+	if !ok {
+		respondInvalidClient(c)
+		return
+	}
+
+	err := h.OAuthService.Revoke(c.Request.Context(), clientId, clientSecret, req.Token)
+
+	if err != nil {
+		log.Println("error revoking client token", err)
+
+		switch errors.Cause(err).(type) {
+		case pkgError.InvalidClientError:
+			respondInvalidClient(c)
+		default:
+			oauthError(c, http.StatusInternalServerError, "server_error", "")
+		}
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *OAuthHandler) CreateClient(c *gin.Context) {
+	organisationId, err := strconv.ParseInt(c.Param("organisationId"), 10, 64)
+
+	if err != nil {
+		respondOrganisationNotFound(c)
+		return
+	}
+
+	var req CreateOAuthClientRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success:          false,
+			Code:             http.StatusUnprocessableEntity,
+			Message:          "invalid request data",
+			ValidationErrors: pkgError.FormatValidationError(err),
+		})
+		return
+	}
+
+	user := c.MustGet("user").(*models.User)
+	permissions := c.GetStringSlice("permissions")
+
+	client, secret, err := h.OAuthService.CreateClient(c.Request.Context(), user, permissions, organisationId, req.Name, req.Scopes)
+
+	if err != nil {
+		log.Println("error creating oauth client", err)
+
+		cause := errors.Cause(err)
+		switch cause.(type) {
+		case pkgError.NotFoundError:
+			respondOrganisationNotFound(c)
+		case pkgError.InvalidScopeError:
+			c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusUnprocessableEntity,
+				Message: "invalid request data",
+				ValidationErrors: []pkgError.ValidationError{{
+					Field:   "Scopes",
+					Tag:     "scope",
+					Message: cause.Error(),
+				}},
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+				Success: false,
+				Message: "an error occurred while creating oauth client",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.JSONApiResponse{
+		Success: true,
+		Message: "oauth client created, store the client secret somewhere safe as it will not be shown again",
+		Data: gin.H{
+			"client":        client,
+			"client_secret": secret,
+		},
+	})
+}
+
+func (h *OAuthHandler) ListClients(c *gin.Context) {
+	organisationId, err := strconv.ParseInt(c.Param("organisationId"), 10, 64)
+
+	if err != nil {
+		respondOrganisationNotFound(c)
+		return
+	}
+
+	clients, err := h.OAuthService.ListClients(c.Request.Context(), organisationId)
+
+	if err != nil {
+		log.Println("error listing oauth clients", err)
+		c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+			Success: false,
+			Message: "an error occurred while fetching oauth clients",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data:    clients,
+	})
+}
+
+func (h *OAuthHandler) RevokeClient(c *gin.Context) {
+	organisationId, orgErr := strconv.ParseInt(c.Param("organisationId"), 10, 64)
+	id, idErr := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	if orgErr != nil || idErr != nil {
+		c.JSON(http.StatusNotFound, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusNotFound,
+			Message: "oauth client not found",
+		})
+		return
+	}
+
+	err := h.OAuthService.RevokeClient(c.Request.Context(), organisationId, id)
+
+	if err != nil {
+		log.Println("error revoking oauth client", err)
+
+		switch errors.Cause(err).(type) {
+		case pkgError.NotFoundError:
+			c.JSON(http.StatusNotFound, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusNotFound,
+				Message: "oauth client not found",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+				Success: false,
+				Message: "an error occurred while revoking oauth client",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "oauth client revoked successfully",
+	})
+}
+
+func NewOAuthHandler(OAuthService *services.OAuthService) *OAuthHandler {
+	return &OAuthHandler{
+		OAuthService: OAuthService,
+	}
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/services"
+	pkgError "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/response"
+	"github.com/pkg/errors"
+)
+
+type OIDCHandler struct {
+	OIDCService *services.OIDCService
+}
+
+type OIDCCallbackRequest struct {
+	Code  string `json:"code" binding:"required"`
+	State string `json:"state" binding:"required"`
+}
+
+func (h *OIDCHandler) Providers(c *gin.Context) {
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data: gin.H{
+			"providers": h.OIDCService.Providers(),
+		},
+	})
+}
+
+func (h *OIDCHandler) Authorize(c *gin.Context) {
+	url, err := h.OIDCService.AuthorizationURL(c.Request.Context(), c.Param("provider"))
+
+	if err != nil {
+		log.Println("error starting sso login", err)
+
+		switch errors.Cause(err).(type) {
+		case pkgError.NotFoundError:
+			c.JSON(http.StatusNotFound, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusNotFound,
+				Message: "identity provider not found",
+			})
+		default:
+			c.JSON(http.StatusBadGateway, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusBadGateway,
+				Message: "identity provider is unavailable",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data: gin.H{
+			"authorization_url": url,
+		},
+	})
+}
+
+// Callback is called by the frontend with the code and state the identity provider redirected back with.
+func (h *OIDCHandler) Callback(c *gin.Context) {
+	var req OIDCCallbackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success:          false,
+			Code:             http.StatusUnprocessableEntity,
+			Message:          "invalid request data",
+			ValidationErrors: pkgError.FormatValidationError(err),
+		})
+		return
+	}
+
+	token, refreshToken, err := h.OIDCService.Login(c.Request.Context(), c.Param("provider"), req.Code, req.State)
+
+	if err != nil {
+		log.Println("sso login not completed:", err)
+
+		cause := errors.Cause(err)
+		switch cause.(type) {
+		case pkgError.NotFoundError:
+			c.JSON(http.StatusNotFound, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusNotFound,
+				Message: "identity provider not found",
+			})
+		case pkgError.InvalidTokenError:
+			c.JSON(http.StatusBadRequest, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusBadRequest,
+				Message: "sign in expired or was already used, please try again",
+			})
+		case pkgError.SSODeniedError:
+			c.JSON(http.StatusForbidden, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusForbidden,
+				Message: cause.Error(),
+			})
+		default:
+			c.JSON(http.StatusUnauthorized, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusUnauthorized,
+				Message: "sign in with identity provider failed",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "login successful",
+		Data: gin.H{
+			"token":         token,
+			"refresh_token": refreshToken,
+		},
+	})
+}
+
+func NewOIDCHandler(OIDCService *services.OIDCService) *OIDCHandler {
+	return &OIDCHandler{
+		OIDCService: OIDCService,
+	}
+}
@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/internal/services"
+	"github.com/jacoobjake/einvoice-api/pkg/response"
+)
+
+type OrganisationHandler struct {
+	OrganisationService *services.OrganisationService
+}
+
+type CreateOrganisationRequest struct {
+	Name string `json:"name" binding:"required,max=255"`
+}
+
+type AddOrganisationMemberRequest struct {
+	UserID int64 `json:"user_id" binding:"required,min=1"`
+}
+
+func bindOrganisationId(c *gin.Context) (int64, bool) {
+	organisationId, err := strconv.ParseInt(c.Param("organisationId"), 10, 64)
+
+	if err != nil {
+		respondOrganisationNotFound(c)
+		return 0, false
+	}
+
+	return organisationId, true
+}
+
+// Mine lists the organisations the authenticated user can pick with the X-Organisation-ID header.
+func (h *OrganisationHandler) Mine(c *gin.Context) {
+	user := c.MustGet("user").(*models.User)
+
+	organisations, err := h.OrganisationService.ListForUser(c.Request.Context(), user)
+
+	if err != nil {
+		log.Println("error listing user organisations", err)
+		c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+			Success: false,
+			Message: "an error occurred while fetching organisations",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data:    gin.H{"organisations": organisations},
+	})
+}
+
+func (h *OrganisationHandler) List(c *gin.Context) {
+	organisations, err := h.OrganisationService.List(c.Request.Context())
+
+	if err != nil {
+		log.Println("error listing organisations", err)
+		c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+			Success: false,
+			Message: "an error occurred while fetching organisations",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data:    gin.H{"organisations": organisations},
+	})
+}
+
+func (h *OrganisationHandler) Create(c *gin.Context) {
+	var req CreateOrganisationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	organisation, err := h.OrganisationService.Create(c.Request.Context(), req.Name)
+
+	if err != nil {
+		log.Println("error creating organisation", err)
+		c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+			Success: false,
+			Message: "an error occurred while creating organisation",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.JSONApiResponse{
+		Success: true,
+		Code:    http.StatusCreated,
+		Message: "organisation created successfully",
+		Data:    gin.H{"organisation": organisation},
+	})
+}
+
+func (h *OrganisationHandler) ListMembers(c *gin.Context) {
+	organisationId, ok := bindOrganisationId(c)
+
+	if !ok {
+		return
+	}
+
+	members, err := h.OrganisationService.ListMembers(c.Request.Context(), organisationId)
+
+	if err != nil {
+		log.Println("error listing organisation members", err)
+		respondUserError(c, err, "an error occurred while fetching organisation members")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data:    gin.H{"members": members},
+	})
+}
+
+func (h *OrganisationHandler) AddMember(c *gin.Context) {
+	organisationId, ok := bindOrganisationId(c)
+
+	if !ok {
+		return
+	}
+
+	var req AddOrganisationMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	if err := h.OrganisationService.AddMember(c.Request.Context(), organisationId, req.UserID); err != nil {
+		log.Println("error adding organisation member", err)
+		respondUserError(c, err, "an error occurred while adding organisation member")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "member added successfully",
+	})
+}
+
+func (h *OrganisationHandler) RemoveMember(c *gin.Context) {
+	organisationId, ok := bindOrganisationId(c)
+
+	if !ok {
+		return
+	}
+
+	userId, ok := bindUserId(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.OrganisationService.RemoveMember(c.Request.Context(), organisationId, userId); err != nil {
+		log.Println("error removing organisation member", err)
+		respondUserError(c, err, "an error occurred while removing organisation member")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "member removed successfully",
+	})
+}
+
+func NewOrganisationHandler(OrganisationService *services.OrganisationService) *OrganisationHandler {
+	return &OrganisationHandler{
+		OrganisationService: OrganisationService,
+	}
+}
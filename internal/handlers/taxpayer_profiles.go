@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/internal/services"
+	"github.com/jacoobjake/einvoice-api/pkg/lhdn"
+	"github.com/jacoobjake/einvoice-api/pkg/response"
+)
+
+type TaxpayerProfileHandler struct {
+	TaxpayerProfileService *services.TaxpayerProfileService
+}
+
+// SaveTaxpayerProfileRequest holds the supplier fields of the LHDN e-Invoice specification.
+// Registration numbers the taxpayer does not hold default to NA.
+type SaveTaxpayerProfileRequest struct {
+	Name                         string `json:"name" binding:"required,max=300"`
+	TIN                          string `json:"tin" binding:"required,lhdn_tin"`
+	IDType                       string `json:"id_type" binding:"required,oneof=NRIC BRN PASSPORT ARMY"`
+	IDValue                      string `json:"id_value" binding:"required,lhdn_id=IDType"`
+	SSTRegistrationNumber        string `json:"sst_registration_number" binding:"omitempty,max=35,lhdn_sst"`
+	TourismTaxRegistrationNumber string `json:"tourism_tax_registration_number" binding:"omitempty,max=17,lhdn_ttx"`
+	MSICCode                     string `json:"msic_code" binding:"required,lhdn_msic"`
+	BusinessActivityDescription  string `json:"business_activity_description" binding:"required,max=300"`
+	AddressLine1                 string `json:"address_line1" binding:"required,max=150"`
+	AddressLine2                 string `json:"address_line2" binding:"omitempty,max=150"`
+	AddressLine3                 string `json:"address_line3" binding:"omitempty,max=150"`
+	PostalZone                   string `json:"postal_zone" binding:"omitempty,max=50"`
+	CityName                     string `json:"city_name" binding:"required,max=50"`
+	StateCode                    string `json:"state_code" binding:"required,lhdn_state"`
+	CountryCode                  string `json:"country_code" binding:"omitempty,lhdn_country"`
+	Phone                        string `json:"phone" binding:"required,max=20,lhdn_phone"`
+	Email                        string `json:"email" binding:"omitempty,email,max=320"`
+}
+
+func optionalString(value string) omitnull.Val[string] {
+	if value == "" {
+		return omitnull.FromPtr[string](nil)
+	}
+
+	return omitnull.From(value)
+}
+
+func orDefault(value string, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+
+	return value
+}
+
+func (r SaveTaxpayerProfileRequest) setter() models.TaxpayerProfileSetter {
+	return models.TaxpayerProfileSetter{
+		Name:                         omit.From(r.Name),
+		Tin:                          omit.From(r.TIN),
+		IDType:                       omit.From(enums.TaxpayerIDTypes(r.IDType)),
+		IDValue:                      omit.From(r.IDValue),
+		SSTRegistrationNumber:        omit.From(orDefault(r.SSTRegistrationNumber, lhdn.NotApplicable)),
+		TourismTaxRegistrationNumber: omit.From(orDefault(r.TourismTaxRegistrationNumber, lhdn.NotApplicable)),
+		MsicCode:                     omit.From(r.MSICCode),
+		BusinessActivityDescription:  omit.From(r.BusinessActivityDescription),
+		AddressLine1:                 omit.From(r.AddressLine1),
+		AddressLine2:                 optionalString(r.AddressLine2),
+		AddressLine3:                 optionalString(r.AddressLine3),
+		PostalZone:                   optionalString(r.PostalZone),
+		CityName:                     omit.From(r.CityName),
+		StateCode:                    omit.From(r.StateCode),
+		CountryCode:                  omit.From(orDefault(r.CountryCode, lhdn.DefaultCountryCode)),
+		Phone:                        omit.From(r.Phone),
+		Email:                        optionalString(r.Email),
+	}
+}
+
+func (h *TaxpayerProfileHandler) Get(c *gin.Context) {
+	organisationId := c.GetInt64("organisation_id")
+
+	profile, err := h.TaxpayerProfileService.Get(c.Request.Context(), organisationId)
+
+	if err != nil {
+		log.Println("error fetching taxpayer profile", err)
+		respondUserError(c, err, "an error occurred while fetching taxpayer profile")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data:    gin.H{"taxpayer_profile": profile},
+	})
+}
+
+// Save creates or replaces the taxpayer profile of the organisation.
+func (h *TaxpayerProfileHandler) Save(c *gin.Context) {
+	organisationId := c.GetInt64("organisation_id")
+
+	var req SaveTaxpayerProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	profile, created, err := h.TaxpayerProfileService.Save(c.Request.Context(), organisationId, req.setter())
+
+	if err != nil {
+		log.Println("error saving taxpayer profile", err)
+		respondUserError(c, err, "an error occurred while saving taxpayer profile")
+		return
+	}
+
+	status, message := http.StatusOK, "taxpayer profile updated successfully"
+
+	if created {
+		status, message = http.StatusCreated, "taxpayer profile created successfully"
+	}
+
+	c.JSON(status, response.JSONApiResponse{
+		Success: true,
+		Code:    status,
+		Message: message,
+		Data:    gin.H{"taxpayer_profile": profile},
+	})
+}
+
+func (h *TaxpayerProfileHandler) Delete(c *gin.Context) {
+	organisationId := c.GetInt64("organisation_id")
+
+	if err := h.TaxpayerProfileService.Delete(c.Request.Context(), organisationId); err != nil {
+		log.Println("error deleting taxpayer profile", err)
+		respondUserError(c, err, "an error occurred while deleting taxpayer profile")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "taxpayer profile deleted successfully",
+	})
+}
+
+func NewTaxpayerProfileHandler(TaxpayerProfileService *services.TaxpayerProfileService) *TaxpayerProfileHandler {
+	return &TaxpayerProfileHandler{
+		TaxpayerProfileService: TaxpayerProfileService,
+	}
+}
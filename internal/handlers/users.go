@@ -1 +1,393 @@
 package handlers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/internal/repositories"
+	"github.com/jacoobjake/einvoice-api/internal/services"
+	pkgError "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/response"
+	"github.com/pkg/errors"
+)
+
+type UserHandler struct {
+	UserService *services.UserService
+}
+
+type CreateUserRequest struct {
+	FirstName string `json:"first_name" binding:"required,max=50"`
+	LastName  string `json:"last_name" binding:"required,max=50"`
+	Email     string `json:"email" binding:"required,email,max=300"`
+	// Leave empty to generate a one-time password
+	Password string `json:"password"`
+	// Email the generated password to the user instead of returning it
+	SendPasswordEmail bool   `json:"send_password_email"`
+	Status            string `json:"status" binding:"omitempty,oneof=active inactive suspended"`
+}
+
+type UpdateUserRequest struct {
+	FirstName *string `json:"first_name" binding:"omitempty,min=1,max=50"`
+	LastName  *string `json:"last_name" binding:"omitempty,min=1,max=50"`
+	Email     *string `json:"email" binding:"omitempty,email,max=300"`
+}
+
+// UpdateMeRequest is the user's own profile change, a new email needs the current password.
+type UpdateMeRequest struct {
+	FirstName       *string `json:"first_name" binding:"omitempty,min=1,max=50"`
+	LastName        *string `json:"last_name" binding:"omitempty,min=1,max=50"`
+	Email           *string `json:"email" binding:"omitempty,email,max=300"`
+	CurrentPassword string  `json:"current_password" binding:"required_with=Email"`
+}
+
+type UpdateUserStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=active inactive suspended"`
+}
+
+type UserQuery struct {
+	Status  string `form:"status" binding:"omitempty,oneof=active inactive suspended"`
+	Search  string `form:"search" binding:"max=300"`
+	Deleted string `form:"deleted,default=without" binding:"oneof=without with only"`
+	// Prefix with "-" for descending order
+	Sort    string `form:"sort,default=-created_at" binding:"oneof=id -id first_name -first_name last_name -last_name email -email status -status created_at -created_at"`
+	Page    int    `form:"page,default=1" binding:"min=1"`
+	PerPage int    `form:"per_page,default=20" binding:"min=1,max=100"`
+}
+
+func bindUserId(c *gin.Context) (int64, bool) {
+	userId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	if err != nil {
+		c.JSON(http.StatusNotFound, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusNotFound,
+			Message: "user not found",
+		})
+		return 0, false
+	}
+
+	return userId, true
+}
+
+func respondUserError(c *gin.Context, err error, message string) {
+	cause := errors.Cause(err)
+
+	switch cause.(type) {
+	case pkgError.NotFoundError:
+		c.JSON(http.StatusNotFound, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusNotFound,
+			Message: cause.Error(),
+		})
+	case pkgError.ForbiddenError:
+		c.JSON(http.StatusForbidden, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusForbidden,
+			Message: cause.Error(),
+		})
+	case pkgError.ConflictError:
+		c.JSON(http.StatusConflict, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusConflict,
+			Message: cause.Error(),
+		})
+	case pkgError.InvalidPasswordError:
+		c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+			Success: false,
+			Code:    http.StatusUnprocessableEntity,
+			Message: "invalid request data",
+			ValidationErrors: []pkgError.ValidationError{{
+				Field:   "Password",
+				Tag:     "password",
+				Message: cause.Error(),
+			}},
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+			Success: false,
+			Message: message,
+		})
+	}
+}
+
+func respondInvalidRequest(c *gin.Context, err error) {
+	log.Println("Error binding request:", err)
+	c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+		Success:          false,
+		Code:             http.StatusUnprocessableEntity,
+		Message:          "invalid request data",
+		ValidationErrors: pkgError.FormatValidationError(err),
+	})
+}
+
+func (h *UserHandler) Create(c *gin.Context) {
+	var req CreateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	setter := models.UserSetter{
+		FirstName: omit.From(req.FirstName),
+		LastName:  omit.From(req.LastName),
+		Email:     omit.From(req.Email),
+	}
+
+	if req.Password != "" {
+		setter.Password = omit.From(req.Password)
+	}
+
+	if req.Status != "" {
+		setter.Status = omit.From(enums.UserStatuses(req.Status))
+	}
+
+	user, plainPw, err := h.UserService.CreateUser(c.Request.Context(), setter, req.SendPasswordEmail)
+
+	if err != nil {
+		log.Println("error creating user", err)
+		respondUserError(c, err, "an error occurred while creating user")
+		return
+	}
+
+	data := gin.H{"user": user}
+
+	// Shown once, the password cannot be retrieved later
+	if plainPw != "" {
+		data["generated_password"] = plainPw
+	}
+
+	c.JSON(http.StatusCreated, response.JSONApiResponse{
+		Success: true,
+		Code:    http.StatusCreated,
+		Message: "user created successfully",
+		Data:    data,
+	})
+}
+
+func (h *UserHandler) List(c *gin.Context) {
+	var query UserQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	filter := repositories.UserFilter{
+		Status:  query.Status,
+		Search:  query.Search,
+		Deleted: query.Deleted,
+	}
+
+	users, total, err := h.UserService.ListUsers(c.Request.Context(), filter, query.Sort, query.Page, query.PerPage)
+
+	if err != nil {
+		log.Println("error listing users", err)
+		c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+			Success: false,
+			Message: "an error occurred while fetching users",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data: gin.H{
+			"users": users,
+			"pagination": response.Pagination{
+				Page:    query.Page,
+				PerPage: query.PerPage,
+				Total:   total,
+			},
+		},
+	})
+}
+
+func (h *UserHandler) Get(c *gin.Context) {
+	userId, ok := bindUserId(c)
+
+	if !ok {
+		return
+	}
+
+	user, err := h.UserService.GetUser(c.Request.Context(), userId)
+
+	if err != nil {
+		log.Println("error fetching user", err)
+		respondUserError(c, err, "an error occurred while fetching user")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data:    gin.H{"user": user},
+	})
+}
+
+func (h *UserHandler) Update(c *gin.Context) {
+	userId, ok := bindUserId(c)
+
+	if !ok {
+		return
+	}
+
+	var req UpdateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	user, err := h.UserService.UpdateUser(c.Request.Context(), c.GetStringSlice("permissions"), userId, services.UserUpdate{
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+		Email:     req.Email,
+	})
+
+	if err != nil {
+		log.Println("error updating user", err)
+		respondUserError(c, err, "an error occurred while updating user")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "user updated successfully",
+		Data:    gin.H{"user": user},
+	})
+}
+
+func (h *UserHandler) UpdateStatus(c *gin.Context) {
+	userId, ok := bindUserId(c)
+
+	if !ok {
+		return
+	}
+
+	var req UpdateUserStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	user, err := h.UserService.UpdateStatus(c.Request.Context(), c.GetStringSlice("permissions"), userId, enums.UserStatuses(req.Status))
+
+	if err != nil {
+		log.Println("error updating user status", err)
+		respondUserError(c, err, "an error occurred while updating user status")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "user status updated successfully",
+		Data:    gin.H{"user": user},
+	})
+}
+
+func (h *UserHandler) Delete(c *gin.Context) {
+	userId, ok := bindUserId(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.UserService.DeleteUser(c.Request.Context(), c.GetStringSlice("permissions"), userId); err != nil {
+		log.Println("error deleting user", err)
+		respondUserError(c, err, "an error occurred while deleting user")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "user deleted successfully",
+	})
+}
+
+func (h *UserHandler) Restore(c *gin.Context) {
+	userId, ok := bindUserId(c)
+
+	if !ok {
+		return
+	}
+
+	user, err := h.UserService.RestoreUser(c.Request.Context(), c.GetStringSlice("permissions"), userId)
+
+	if err != nil {
+		log.Println("error restoring user", err)
+		respondUserError(c, err, "an error occurred while restoring user")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: "user restored successfully",
+		Data:    gin.H{"user": user},
+	})
+}
+
+// Me returns the authenticated user's own account.
+func (h *UserHandler) Me(c *gin.Context) {
+	user := c.MustGet("user").(*models.User)
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Data:    gin.H{"user": h.UserService.Profile(user)},
+	})
+}
+
+func (h *UserHandler) UpdateMe(c *gin.Context) {
+	user := c.MustGet("user").(*models.User)
+
+	var req UpdateMeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	profile, err := h.UserService.UpdateProfile(c.Request.Context(), user, req.CurrentPassword, services.UserUpdate{
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+		Email:     req.Email,
+	})
+
+	if err != nil {
+		log.Println("error updating profile", err)
+
+		if _, ok := errors.Cause(err).(pkgError.InvalidCredentialsError); ok {
+			c.JSON(http.StatusUnprocessableEntity, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusUnprocessableEntity,
+				Message: "invalid request data",
+				ValidationErrors: []pkgError.ValidationError{{
+					Field:   "CurrentPassword",
+					Tag:     "current_password",
+					Message: "current password is incorrect",
+				}},
+			})
+			return
+		}
+
+		respondUserError(c, err, "an error occurred while updating profile")
+		return
+	}
+
+	message := "profile updated successfully"
+	if profile.PendingEmail != nil {
+		message = "profile updated, confirm the new email address from the link sent to it"
+	}
+
+	c.JSON(http.StatusOK, response.JSONApiResponse{
+		Success: true,
+		Message: message,
+		Data:    gin.H{"user": profile},
+	})
+}
+
+func NewUserHandler(UserService *services.UserService) *UserHandler {
+	return &UserHandler{
+		UserService: UserService,
+	}
+}
@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/pkg/errors"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+var APIKeys = models.APIKeys
+
+type APIKeyRepository struct {
+	db bob.Executor
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, apiKey *models.APIKeySetter) (*models.APIKey, error) {
+	createdKey, err := APIKeys.Insert(apiKey).One(ctx, r.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error inserting api_keys")
+	}
+	return createdKey, nil
+}
+
+func (r *APIKeyRepository) FindByPrefix(ctx context.Context, prefix string) (*models.APIKey, error) {
+	apiKey, err := APIKeys.Query(
+		sm.Where(APIKeys.Columns.Prefix.EQ(psql.Arg(prefix))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching api key")
+	}
+
+	return apiKey, nil
+}
+
+func (r *APIKeyRepository) FindByOrganisationID(ctx context.Context, organisationId int64, id int64) (*models.APIKey, error) {
+	apiKey, err := APIKeys.Query(
+		sm.Where(APIKeys.Columns.ID.EQ(psql.Arg(id))),
+		sm.Where(APIKeys.Columns.OrganisationID.EQ(psql.Arg(organisationId))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching api key")
+	}
+
+	return apiKey, nil
+}
+
+func (r *APIKeyRepository) ListByOrganisationID(ctx context.Context, organisationId int64) ([]*models.APIKey, error) {
+	apiKeys, err := APIKeys.Query(
+		sm.Where(APIKeys.Columns.OrganisationID.EQ(psql.Arg(organisationId))),
+		sm.OrderBy(APIKeys.Columns.CreatedAt).Desc(),
+	).All(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching api keys")
+	}
+
+	return apiKeys, nil
+}
+
+func (r *APIKeyRepository) Revoke(ctx context.Context, apiKey *models.APIKey) error {
+	err := apiKey.Update(ctx, r.db, &models.APIKeySetter{
+		RevokedAt: omitnull.From(time.Now()),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "error revoking api key")
+	}
+
+	return nil
+}
+
+// TouchLastUsed records that the key was used, at most once per interval to avoid a write on every request.
+func (r *APIKeyRepository) TouchLastUsed(ctx context.Context, apiKey *models.APIKey, interval time.Duration) error {
+	now := time.Now()
+	touch := models.APIKeySetter{
+		LastUsedAt: omitnull.From(now),
+	}
+
+	_, err := APIKeys.Update(
+		touch.UpdateMod(),
+		um.Where(
+			psql.And(
+				APIKeys.Columns.ID.EQ(psql.Arg(apiKey.ID)),
+				psql.Or(
+					APIKeys.Columns.LastUsedAt.IsNull(),
+					APIKeys.Columns.LastUsedAt.LT(psql.Arg(now.Add(-interval))),
+				),
+			),
+		),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		return errors.Wrap(err, "error executing touch api key query")
+	}
+
+	return nil
+}
+
+func NewAPIKeyRepository(db bob.Executor) *APIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/pkg/errors"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+var AuditEvents = models.AuditEvents
+
+type AuditEventRepository struct {
+	db bob.Executor
+}
+
+// AuditEventFilter narrows audit event queries, zero values are ignored.
+type AuditEventFilter struct {
+	ActorType      string
+	ActorID        int64
+	OrganisationID int64
+	Action         string
+	EntityType     string
+	EntityID       string
+	From           time.Time
+	To             time.Time
+}
+
+func (f AuditEventFilter) mods() []bob.Mod[*dialect.SelectQuery] {
+	cols := AuditEvents.Columns
+	mods := []bob.Mod[*dialect.SelectQuery]{}
+
+	if f.ActorType != "" {
+		mods = append(mods, sm.Where(cols.ActorType.EQ(psql.Arg(f.ActorType))))
+	}
+	if f.ActorID != 0 {
+		mods = append(mods, sm.Where(cols.ActorID.EQ(psql.Arg(f.ActorID))))
+	}
+	if f.OrganisationID != 0 {
+		mods = append(mods, sm.Where(cols.OrganisationID.EQ(psql.Arg(f.OrganisationID))))
+	}
+	if f.Action != "" {
+		mods = append(mods, sm.Where(cols.Action.EQ(psql.Arg(f.Action))))
+	}
+	if f.EntityType != "" {
+		mods = append(mods, sm.Where(cols.EntityType.EQ(psql.Arg(f.EntityType))))
+	}
+	if f.EntityID != "" {
+		mods = append(mods, sm.Where(cols.EntityID.EQ(psql.Arg(f.EntityID))))
+	}
+	if !f.From.IsZero() {
+		mods = append(mods, sm.Where(cols.CreatedAt.GTE(psql.Arg(f.From))))
+	}
+	if !f.To.IsZero() {
+		mods = append(mods, sm.Where(cols.CreatedAt.LT(psql.Arg(f.To))))
+	}
+
+	return mods
+}
+
+func (r *AuditEventRepository) Create(ctx context.Context, event *models.AuditEventSetter) (*models.AuditEvent, error) {
+	createdEvent, err := AuditEvents.Insert(event).One(ctx, r.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error inserting audit_events")
+	}
+	return createdEvent, nil
+}
+
+// List returns a page of matching events, newest first.
+func (r *AuditEventRepository) List(ctx context.Context, filter AuditEventFilter, limit int, offset int) ([]*models.AuditEvent, error) {
+	mods := append(filter.mods(),
+		sm.OrderBy(AuditEvents.Columns.ID).Desc(),
+		sm.Limit(limit),
+		sm.Offset(offset),
+	)
+
+	events, err := AuditEvents.Query(mods...).All(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching audit events")
+	}
+
+	return events, nil
+}
+
+func (r *AuditEventRepository) Count(ctx context.Context, filter AuditEventFilter) (int64, error) {
+	count, err := AuditEvents.Query(filter.mods()...).Count(ctx, r.db)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "error counting audit events")
+	}
+
+	return count, nil
+}
+
+// ListAfter returns matching events with an id greater than afterId in id order, used to walk large result sets.
+func (r *AuditEventRepository) ListAfter(ctx context.Context, filter AuditEventFilter, afterId int64, limit int) ([]*models.AuditEvent, error) {
+	mods := append(filter.mods(),
+		sm.Where(AuditEvents.Columns.ID.GT(psql.Arg(afterId))),
+		sm.OrderBy(AuditEvents.Columns.ID).Asc(),
+		sm.Limit(limit),
+	)
+
+	events, err := AuditEvents.Query(mods...).All(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching audit events")
+	}
+
+	return events, nil
+}
+
+func NewAuditEventRepository(db bob.Executor) *AuditEventRepository {
+	return &AuditEventRepository{db: db}
+}
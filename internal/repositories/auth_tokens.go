@@ -11,12 +11,19 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
 	"github.com/stephenafamo/bob/dialect/psql/sm"
 	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
 )
 
 var AuthTokens = models.AuthTokens
 
+type SessionStart struct {
+	SessionID uuid.UUID `db:"session_id"`
+	StartedAt time.Time `db:"started_at"`
+}
+
 type AuthTokenRepository struct {
 	db bob.Executor
 }
@@ -100,6 +107,155 @@ func (r *AuthTokenRepository) InvalidateActiveTokensBySessionID(ctx context.Cont
 	return nil
 }
 
+// activeRefreshTokenMods matches refresh tokens that can still be exchanged.
+// Every active session holds exactly one of them.
+func activeRefreshTokenMods(userID int64) []bob.Mod[*dialect.SelectQuery] {
+	return []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(AuthTokens.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.Where(AuthTokens.Columns.Type.EQ(psql.Arg(enums.AuthTokenTypesRefresh))),
+		sm.Where(AuthTokens.Columns.SessionID.IsNotNull()),
+		sm.Where(AuthTokens.Columns.RotatedAt.IsNull()),
+		sm.Where(AuthTokens.Columns.RevokedAt.IsNull()),
+		sm.Where(AuthTokens.Columns.ExpireAt.GT(psql.Arg(time.Now()))),
+	}
+}
+
+func (r *AuthTokenRepository) ListActiveRefreshTokensByUserID(ctx context.Context, userID int64) ([]*models.AuthToken, error) {
+	mods := append(activeRefreshTokenMods(userID), sm.OrderBy(AuthTokens.Columns.CreatedAt).Desc())
+
+	tokens, err := AuthTokens.Query(mods...).All(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching active refresh tokens")
+	}
+
+	return tokens, nil
+}
+
+func (r *AuthTokenRepository) FindActiveRefreshTokenBySessionID(ctx context.Context, userID int64, sessionID uuid.UUID) (*models.AuthToken, error) {
+	mods := append(activeRefreshTokenMods(userID), sm.Where(AuthTokens.Columns.SessionID.EQ(psql.Arg(sessionID))))
+
+	token, err := AuthTokens.Query(mods...).One(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching active refresh token")
+	}
+
+	return token, nil
+}
+
+// GetSessionStartTimes returns when each session was first issued a token.
+func (r *AuthTokenRepository) GetSessionStartTimes(ctx context.Context, sessionIDs []uuid.UUID) (map[uuid.UUID]time.Time, error) {
+	startTimes := map[uuid.UUID]time.Time{}
+
+	if len(sessionIDs) == 0 {
+		return startTimes, nil
+	}
+
+	ids := make([]bob.Expression, len(sessionIDs))
+	for i, id := range sessionIDs {
+		ids[i] = psql.Arg(id)
+	}
+
+	rows, err := bob.All(ctx, r.db, psql.Select(
+		sm.Columns(
+			AuthTokens.Columns.SessionID,
+			psql.F("MIN", AuthTokens.Columns.CreatedAt)().As("started_at"),
+		),
+		sm.From(AuthTokens.Name()),
+		sm.Where(AuthTokens.Columns.SessionID.In(ids...)),
+		sm.GroupBy(AuthTokens.Columns.SessionID),
+	), scan.StructMapper[SessionStart]())
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching session start times")
+	}
+
+	for _, row := range rows {
+		startTimes[row.SessionID] = row.StartedAt
+	}
+
+	return startTimes, nil
+}
+
+// MarkRotated expires a refresh token that has been exchanged for a new pair.
+// Returns false when the token was already rotated or revoked, i.e. it is being reused.
+func (r *AuthTokenRepository) MarkRotated(ctx context.Context, token *models.AuthToken) (bool, error) {
+	now := time.Now()
+	rotate := models.AuthTokenSetter{
+		ExpireAt:  omitnull.From(now),
+		RotatedAt: omitnull.From(now),
+	}
+
+	count, err := AuthTokens.Update(
+		rotate.UpdateMod(),
+		um.Where(
+			psql.And(
+				AuthTokens.Columns.ID.EQ(psql.Arg(token.ID)),
+				AuthTokens.Columns.RotatedAt.IsNull(),
+				AuthTokens.Columns.RevokedAt.IsNull(),
+			),
+		),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		return false, errors.Wrap(err, "error executing rotate token query")
+	}
+
+	return count > 0, nil
+}
+
+// ConsumeToken expires a single-use token.
+// Returns false when the token has already been used or has expired.
+func (r *AuthTokenRepository) ConsumeToken(ctx context.Context, token *models.AuthToken) (bool, error) {
+	now := time.Now()
+	consume := models.AuthTokenSetter{
+		ExpireAt: omitnull.From(now),
+	}
+
+	count, err := AuthTokens.Update(
+		consume.UpdateMod(),
+		um.Where(
+			psql.And(
+				AuthTokens.Columns.ID.EQ(psql.Arg(token.ID)),
+				AuthTokens.Columns.ExpireAt.GT(psql.Arg(now)),
+				AuthTokens.Columns.RevokedAt.IsNull(),
+			),
+		),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		return false, errors.Wrap(err, "error executing consume token query")
+	}
+
+	return count > 0, nil
+}
+
+// RevokeTokensBySessionID revokes every token issued to the session, regardless of type.
+func (r *AuthTokenRepository) RevokeTokensBySessionID(ctx context.Context, sessionID uuid.UUID) error {
+	now := time.Now()
+	revoke := models.AuthTokenSetter{
+		ExpireAt:  omitnull.From(now),
+		RevokedAt: omitnull.From(now),
+	}
+
+	_, err := AuthTokens.Update(
+		revoke.UpdateMod(),
+		um.Where(
+			psql.And(
+				AuthTokens.Columns.SessionID.EQ(psql.Arg(sessionID)),
+				AuthTokens.Columns.RevokedAt.IsNull(),
+			),
+		),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		return errors.Wrap(err, "error executing revoke session tokens query")
+	}
+
+	return nil
+}
+
 func NewAuthTokenRepository(db bob.Executor) *AuthTokenRepository {
 	return &AuthTokenRepository{db: db}
 }
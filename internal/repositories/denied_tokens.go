@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/pkg/errors"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+var DeniedTokens = models.DeniedTokens
+
+// DeniedTokenRepository is the Postgres token denylist, for deployments without Redis.
+type DeniedTokenRepository struct {
+	db bob.Executor
+}
+
+func (r *DeniedTokenRepository) Deny(ctx context.Context, jti string, expiresAt time.Time) error {
+	if !expiresAt.After(time.Now()) {
+		return nil
+	}
+
+	_, err := DeniedTokens.Insert(
+		&models.DeniedTokenSetter{
+			Jti:       omit.From(jti),
+			ExpiresAt: omit.From(expiresAt),
+		},
+		im.OnConflict(DeniedTokens.Columns.Jti).DoNothing(),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		return errors.Wrap(err, "error inserting denied_tokens")
+	}
+
+	// Revocations are rare, pruning on write keeps the table small without a scheduler
+	if err := r.DeleteExpired(ctx); err != nil {
+		log.Println("error pruning expired denied tokens", err)
+	}
+
+	return nil
+}
+
+func (r *DeniedTokenRepository) IsDenied(ctx context.Context, jti string) (bool, error) {
+	count, err := DeniedTokens.Query(
+		sm.Where(DeniedTokens.Columns.Jti.EQ(psql.Arg(jti))),
+		sm.Where(DeniedTokens.Columns.ExpiresAt.GT(psql.Arg(time.Now()))),
+	).Count(ctx, r.db)
+
+	if err != nil {
+		return false, errors.Wrap(err, "error fetching denied token")
+	}
+
+	return count > 0, nil
+}
+
+func (r *DeniedTokenRepository) DeleteExpired(ctx context.Context) error {
+	_, err := DeniedTokens.Delete(
+		dm.Where(DeniedTokens.Columns.ExpiresAt.LTE(psql.Arg(time.Now()))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		return errors.Wrap(err, "error deleting expired denied tokens")
+	}
+
+	return nil
+}
+
+func NewDeniedTokenRepository(db bob.Executor) *DeniedTokenRepository {
+	return &DeniedTokenRepository{db: db}
+}
@@ -2,8 +2,10 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
 	"github.com/jacoobjake/einvoice-api/internal/database/models"
 	"github.com/jacoobjake/einvoice-api/pkg"
 	"github.com/pkg/errors"
@@ -11,6 +13,7 @@ import (
 	"github.com/stephenafamo/bob/dialect/psql"
 	"github.com/stephenafamo/bob/dialect/psql/dm"
 	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/types/pgtypes"
 )
 
 var FailedLogins = models.FailedLogins
@@ -34,23 +37,55 @@ func (r *FailedLoginRepository) CaptureFailedLogin(ctx context.Context, userId i
 		return nil, errors.New("invalid client ip")
 	}
 	data := &models.FailedLoginSetter{
-		UserID:    omit.From(userId),
+		UserID:    omitnull.From(userId),
 		IPAddress: omit.From(clientIp),
 	}
 
 	return r.Create(ctx, data)
 }
 
-func (r *FailedLoginRepository) GetFailedLoginCountByUserId(ctx context.Context, userId int64) (int64, error) {
+// CaptureFailedLoginByIP records a failed login that cannot be tied to a user, e.g. an unknown email.
+func (r *FailedLoginRepository) CaptureFailedLoginByIP(ctx context.Context) (*models.FailedLogin, error) {
+	clientIp, ok := pkg.GetCtxClientIp(ctx)
+
+	if !ok {
+		return nil, errors.New("invalid client ip")
+	}
+	data := &models.FailedLoginSetter{
+		IPAddress: omit.From(clientIp),
+	}
+
+	return r.Create(ctx, data)
+}
+
+func (r *FailedLoginRepository) listAttemptTimes(ctx context.Context, where psql.Expression, since time.Time, limit int) ([]time.Time, error) {
 	failedLogins, err := FailedLogins.Query(
-		sm.Where(FailedLogins.Columns.UserID.EQ(psql.Arg(userId))),
-	).Count(ctx, r.db)
+		sm.Where(where),
+		sm.Where(FailedLogins.Columns.AttemptedAt.GTE(psql.Arg(since))),
+		sm.OrderBy(FailedLogins.Columns.AttemptedAt).Desc(),
+		sm.Limit(uint64(limit)),
+	).All(ctx, r.db)
 
 	if err != nil {
-		return 0, errors.New("error querying failed_logins count by userId")
+		return nil, errors.Wrap(err, "error querying failed_logins attempt times")
+	}
+
+	times := make([]time.Time, 0, len(failedLogins))
+	for _, fl := range failedLogins {
+		times = append(times, fl.AttemptedAt.GetOrZero())
 	}
 
-	return failedLogins, nil
+	return times, nil
+}
+
+// ListAttemptTimesByUserID returns up to limit failed login times of the user since the given time, newest first.
+func (r *FailedLoginRepository) ListAttemptTimesByUserID(ctx context.Context, userId int64, since time.Time, limit int) ([]time.Time, error) {
+	return r.listAttemptTimes(ctx, FailedLogins.Columns.UserID.EQ(psql.Arg(userId)), since, limit)
+}
+
+// ListAttemptTimesByIP returns up to limit failed login times from the ip since the given time, newest first.
+func (r *FailedLoginRepository) ListAttemptTimesByIP(ctx context.Context, ip pgtypes.Inet, since time.Time, limit int) ([]time.Time, error) {
+	return r.listAttemptTimes(ctx, FailedLogins.Columns.IPAddress.EQ(psql.Arg(ip)), since, limit)
 }
 
 func (r *FailedLoginRepository) ClearUserFailedLogin(ctx context.Context, userId int64) (int64, error) {
@@ -0,0 +1,211 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/pkg/errors"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+var Invitations = models.Invitations
+
+// Invitation statuses in InvitationFilter, derived from the timestamps.
+const (
+	InvitationPending  = "pending"
+	InvitationExpired  = "expired"
+	InvitationAccepted = "accepted"
+	InvitationRevoked  = "revoked"
+)
+
+// InvitationOpenEmailIndex keeps a single open invitation per email.
+const InvitationOpenEmailIndex = "idx_invitations_open_email"
+
+// ErrInvitationClosed is returned when accepting an invitation that is no longer pending.
+var ErrInvitationClosed = errors.New("invitation is no longer pending")
+
+// InvitationRepository needs a bob.DB rather than an executor as accepting an invitation creates its user in one transaction.
+type InvitationRepository struct {
+	db bob.DB
+}
+
+// InvitationFilter narrows invitation queries, zero values are ignored.
+type InvitationFilter struct {
+	Status string
+	Email  string
+}
+
+func (f InvitationFilter) mods() []bob.Mod[*dialect.SelectQuery] {
+	cols := Invitations.Columns
+	mods := []bob.Mod[*dialect.SelectQuery]{}
+	now := psql.Arg(time.Now())
+
+	switch f.Status {
+	case InvitationPending:
+		mods = append(mods,
+			sm.Where(cols.AcceptedAt.IsNull()),
+			sm.Where(cols.RevokedAt.IsNull()),
+			sm.Where(cols.ExpireAt.GT(now)),
+		)
+	case InvitationExpired:
+		mods = append(mods,
+			sm.Where(cols.AcceptedAt.IsNull()),
+			sm.Where(cols.RevokedAt.IsNull()),
+			sm.Where(cols.ExpireAt.LTE(now)),
+		)
+	case InvitationAccepted:
+		mods = append(mods, sm.Where(cols.AcceptedAt.IsNotNull()))
+	case InvitationRevoked:
+		mods = append(mods, sm.Where(cols.RevokedAt.IsNotNull()))
+	}
+
+	if f.Email != "" {
+		mods = append(mods, sm.Where(cols.Email.EQ(psql.Arg(f.Email))))
+	}
+
+	return mods
+}
+
+func (r *InvitationRepository) Create(ctx context.Context, invitation *models.InvitationSetter) (*models.Invitation, error) {
+	createdInvitation, err := Invitations.Insert(invitation).One(ctx, r.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error inserting invitations")
+	}
+	return createdInvitation, nil
+}
+
+func (r *InvitationRepository) FindById(ctx context.Context, id int64) (*models.Invitation, error) {
+	return models.FindInvitation(ctx, r.db, id)
+}
+
+func (r *InvitationRepository) FindByToken(ctx context.Context, token string) (*models.Invitation, error) {
+	invitation, err := Invitations.Query(
+		sm.Where(Invitations.Columns.Token.EQ(psql.Arg(token))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching invitation")
+	}
+
+	return invitation, nil
+}
+
+// FindOpenByEmail returns the invitation that is neither accepted nor revoked, expired or not.
+func (r *InvitationRepository) FindOpenByEmail(ctx context.Context, email string) (*models.Invitation, error) {
+	invitation, err := Invitations.Query(
+		sm.Where(Invitations.Columns.Email.EQ(psql.Arg(email))),
+		sm.Where(Invitations.Columns.AcceptedAt.IsNull()),
+		sm.Where(Invitations.Columns.RevokedAt.IsNull()),
+	).One(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching invitation")
+	}
+
+	return invitation, nil
+}
+
+// List returns a page of matching invitations, newest first.
+func (r *InvitationRepository) List(ctx context.Context, filter InvitationFilter, limit, offset int) (models.InvitationSlice, error) {
+	mods := append(filter.mods(),
+		sm.OrderBy(Invitations.Columns.ID).Desc(),
+		sm.Limit(uint64(limit)),
+		sm.Offset(uint64(offset)),
+	)
+
+	invitations, err := Invitations.Query(mods...).All(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching invitation list")
+	}
+
+	return invitations, nil
+}
+
+func (r *InvitationRepository) Count(ctx context.Context, filter InvitationFilter) (int64, error) {
+	count, err := Invitations.Query(filter.mods()...).Count(ctx, r.db)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "error counting invitations")
+	}
+
+	return count, nil
+}
+
+func (r *InvitationRepository) Update(ctx context.Context, invitation *models.Invitation, data *models.InvitationSetter) error {
+	if err := invitation.Update(ctx, r.db, data); err != nil {
+		return errors.Wrap(err, "error updating invitation record")
+	}
+
+	return nil
+}
+
+// Accept claims the pending invitation, creates the user from it with the invited role and records the
+// user on the invitation, all or nothing. Concurrent accepts of the same invitation wait on the claim and
+// get ErrInvitationClosed, as do invitations that have been accepted, revoked or have expired.
+func (r *InvitationRepository) Accept(ctx context.Context, invitation *models.Invitation, user *models.UserSetter) (*models.User, error) {
+	var created *models.User
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		now := time.Now()
+		claim := models.InvitationSetter{AcceptedAt: omitnull.From(now)}
+
+		count, err := Invitations.Update(
+			claim.UpdateMod(),
+			um.Where(
+				psql.And(
+					Invitations.Columns.ID.EQ(psql.Arg(invitation.ID)),
+					Invitations.Columns.AcceptedAt.IsNull(),
+					Invitations.Columns.RevokedAt.IsNull(),
+					Invitations.Columns.ExpireAt.GT(psql.Arg(now)),
+				),
+			),
+		).Exec(ctx, exec)
+
+		if err != nil {
+			return errors.Wrap(err, "error executing claim invitation query")
+		}
+
+		if count == 0 {
+			return ErrInvitationClosed
+		}
+
+		created, err = Users.Insert(user).One(ctx, exec)
+
+		if err != nil {
+			return errors.Wrap(err, "error inserting user record")
+		}
+
+		_, err = UserRoles.Insert(&models.UserRoleSetter{
+			UserID: omit.From(created.ID),
+			RoleID: omit.From(invitation.RoleID),
+		}).Exec(ctx, exec)
+
+		if err != nil {
+			return errors.Wrap(err, "error inserting user_roles")
+		}
+
+		if err := invitation.Update(ctx, exec, &models.InvitationSetter{AcceptedUserID: omitnull.From(created.ID)}); err != nil {
+			return errors.Wrap(err, "error updating invitation record")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error accepting invitation")
+	}
+
+	return created, nil
+}
+
+func NewInvitationRepository(db bob.DB) *InvitationRepository {
+	return &InvitationRepository{db: db}
+}
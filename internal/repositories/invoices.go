@@ -0,0 +1,330 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/pkg/errors"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+var (
+	Invoices                 = models.Invoices
+	InvoiceLines             = models.InvoiceLines
+	InvoiceParties           = models.InvoiceParties
+	InvoiceAllowanceCharges  = models.InvoiceAllowanceCharges
+	InvoiceLineTaxBreakdowns = models.InvoiceLineTaxBreakdowns
+)
+
+// InvoiceRepository needs a bob.DB rather than an executor as an invoice and its rows are written in one transaction.
+type InvoiceRepository struct {
+	db bob.DB
+}
+
+// InvoiceDocument is an invoice with the rows that belong to it.
+type InvoiceDocument struct {
+	Invoice *models.InvoiceSetter
+	Parties []*models.InvoicePartySetter
+	Lines   []InvoiceLineDocument
+	// Allowances and charges on the whole document
+	AllowanceCharges []*models.InvoiceAllowanceChargeSetter
+	// Check runs first in the transaction writing the document, an error rolls it back
+	Check func(ctx context.Context, exec bob.Executor) error
+}
+
+type InvoiceLineDocument struct {
+	Line             *models.InvoiceLineSetter
+	Taxes            []*models.InvoiceLineTaxBreakdownSetter
+	AllowanceCharges []*models.InvoiceAllowanceChargeSetter
+}
+
+// InvoiceSortColumns maps the sortable fields to their columns.
+var InvoiceSortColumns = map[string]psql.Expression{
+	"id":             Invoices.Columns.ID,
+	"invoice_number": Invoices.Columns.InvoiceNumber,
+	"issue_date":     Invoices.Columns.IssueDate,
+	"payable_amount": Invoices.Columns.PayableAmount,
+	"created_at":     Invoices.Columns.CreatedAt,
+}
+
+// InvoiceFilter narrows invoice queries, zero values are ignored.
+type InvoiceFilter struct {
+	Status   string
+	TypeCode string
+	// Case-insensitive match on the invoice number
+	Search string
+	// Issue date range in YYYY-MM-DD, both ends included
+	IssuedFrom string
+	IssuedTo   string
+}
+
+func (f InvoiceFilter) mods() []bob.Mod[*dialect.SelectQuery] {
+	cols := Invoices.Columns
+	mods := []bob.Mod[*dialect.SelectQuery]{}
+
+	if f.Status != "" {
+		mods = append(mods, sm.Where(cols.Status.EQ(psql.Arg(f.Status))))
+	}
+	if f.TypeCode != "" {
+		mods = append(mods, sm.Where(cols.TypeCode.EQ(psql.Arg(f.TypeCode))))
+	}
+	if f.Search != "" {
+		pattern := psql.Arg("%" + likeEscaper.Replace(f.Search) + "%")
+		mods = append(mods, sm.Where(cols.InvoiceNumber.OP("ILIKE", pattern)))
+	}
+	if f.IssuedFrom != "" {
+		mods = append(mods, sm.Where(cols.IssueDate.GTE(psql.Cast(psql.Arg(f.IssuedFrom), "date"))))
+	}
+	if f.IssuedTo != "" {
+		mods = append(mods, sm.Where(cols.IssueDate.LTE(psql.Cast(psql.Arg(f.IssuedTo), "date"))))
+	}
+
+	return mods
+}
+
+func (r *InvoiceRepository) FindById(ctx context.Context, id int64) (*models.Invoice, error) {
+	return models.FindInvoice(ctx, r.db, id)
+}
+
+// LoadDocument loads the parties, the document allowances and charges, and the lines in order with
+// their taxes, allowances and charges into the R struct of the invoice.
+func (r *InvoiceRepository) LoadDocument(ctx context.Context, invoice *models.Invoice) error {
+	if err := invoice.LoadInvoiceParties(ctx, r.db); err != nil {
+		return errors.Wrap(err, "error loading invoice parties")
+	}
+
+	err := invoice.LoadInvoiceAllowanceCharges(ctx, r.db,
+		sm.Where(InvoiceAllowanceCharges.Columns.InvoiceLineID.IsNull()),
+		sm.OrderBy(InvoiceAllowanceCharges.Columns.ID).Asc(),
+	)
+
+	if err != nil {
+		return errors.Wrap(err, "error loading invoice allowance charges")
+	}
+
+	if err := invoice.LoadInvoiceLines(ctx, r.db, sm.OrderBy(InvoiceLines.Columns.LineNumber).Asc()); err != nil {
+		return errors.Wrap(err, "error loading invoice lines")
+	}
+
+	lines := invoice.R.InvoiceLines
+
+	if err := lines.LoadInvoiceLineTaxBreakdowns(ctx, r.db, sm.OrderBy(InvoiceLineTaxBreakdowns.Columns.ID).Asc()); err != nil {
+		return errors.Wrap(err, "error loading invoice line taxes")
+	}
+
+	if err := lines.LoadInvoiceAllowanceCharges(ctx, r.db, sm.OrderBy(InvoiceAllowanceCharges.Columns.ID).Asc()); err != nil {
+		return errors.Wrap(err, "error loading invoice line allowance charges")
+	}
+
+	return nil
+}
+
+// LoadParties loads the parties of a page of invoices.
+func (r *InvoiceRepository) LoadParties(ctx context.Context, invoices models.InvoiceSlice) error {
+	if err := invoices.LoadInvoiceParties(ctx, r.db); err != nil {
+		return errors.Wrap(err, "error loading invoice parties")
+	}
+
+	return nil
+}
+
+// Lock holds the invoice row until the end of the transaction of exec.
+func (r *InvoiceRepository) Lock(ctx context.Context, exec bob.Executor, id int64) error {
+	_, err := Invoices.Query(sm.Where(Invoices.Columns.ID.EQ(psql.Arg(id))), sm.ForUpdate()).One(ctx, exec)
+
+	if err != nil {
+		return errors.Wrap(err, "error locking invoice")
+	}
+
+	return nil
+}
+
+// FindNotes returns the credit, debit and refund notes adjusting the invoice, leaving out the ones
+// LHDN rejected or that were cancelled.
+func (r *InvoiceRepository) FindNotes(ctx context.Context, exec bob.Executor, originalId int64) (models.InvoiceSlice, error) {
+	notes, err := Invoices.Query(
+		sm.Where(Invoices.Columns.OriginalInvoiceID.EQ(psql.Arg(originalId))),
+		sm.Where(Invoices.Columns.Status.NotIn(
+			psql.Arg(enums.InvoiceStatusesInvalid),
+			psql.Arg(enums.InvoiceStatusesCancelled),
+			psql.Arg(enums.InvoiceStatusesRejected),
+		)),
+	).All(ctx, exec)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching invoice notes")
+	}
+
+	return notes, nil
+}
+
+func runCheck(ctx context.Context, exec bob.Executor, document InvoiceDocument) error {
+	if document.Check == nil {
+		return nil
+	}
+
+	return document.Check(ctx, exec)
+}
+
+func insertDocumentRows(ctx context.Context, exec bob.Executor, invoice *models.Invoice, document InvoiceDocument) error {
+	if err := invoice.InsertInvoiceParties(ctx, exec, document.Parties...); err != nil {
+		return errors.Wrap(err, "error inserting invoice_parties")
+	}
+
+	if err := invoice.InsertInvoiceAllowanceCharges(ctx, exec, document.AllowanceCharges...); err != nil {
+		return errors.Wrap(err, "error inserting invoice_allowance_charges")
+	}
+
+	for _, lineDocument := range document.Lines {
+		lineDocument.Line.InvoiceID = omit.From(invoice.ID)
+		line, err := InvoiceLines.Insert(lineDocument.Line).One(ctx, exec)
+
+		if err != nil {
+			return errors.Wrap(err, "error inserting invoice_lines")
+		}
+
+		if err := line.InsertInvoiceLineTaxBreakdowns(ctx, exec, lineDocument.Taxes...); err != nil {
+			return errors.Wrap(err, "error inserting invoice_line_tax_breakdowns")
+		}
+
+		for _, allowanceCharge := range lineDocument.AllowanceCharges {
+			allowanceCharge.InvoiceID = omit.From(invoice.ID)
+		}
+
+		if err := line.InsertInvoiceAllowanceCharges(ctx, exec, lineDocument.AllowanceCharges...); err != nil {
+			return errors.Wrap(err, "error inserting invoice_allowance_charges")
+		}
+
+		invoice.R.InvoiceLines = append(invoice.R.InvoiceLines, line)
+	}
+
+	return nil
+}
+
+// Create inserts the invoice and its rows, all or nothing.
+func (r *InvoiceRepository) Create(ctx context.Context, document InvoiceDocument) (*models.Invoice, error) {
+	var invoice *models.Invoice
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		if err := runCheck(ctx, exec, document); err != nil {
+			return err
+		}
+
+		var err error
+
+		invoice, err = Invoices.Insert(document.Invoice).One(ctx, exec)
+
+		if err != nil {
+			return errors.Wrap(err, "error inserting invoices")
+		}
+
+		return insertDocumentRows(ctx, exec, invoice, document)
+	})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating invoice")
+	}
+
+	return invoice, nil
+}
+
+// Replace updates the invoice and swaps its rows for the ones of the document, all or nothing.
+func (r *InvoiceRepository) Replace(ctx context.Context, invoice *models.Invoice, document InvoiceDocument) error {
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		if err := runCheck(ctx, exec, document); err != nil {
+			return err
+		}
+
+		if err := invoice.Update(ctx, exec, document.Invoice); err != nil {
+			return errors.Wrap(err, "error updating invoice record")
+		}
+
+		// Taxes, allowances and charges of the lines go with them
+		_, err := InvoiceLines.Delete(dm.Where(InvoiceLines.Columns.InvoiceID.EQ(psql.Arg(invoice.ID)))).Exec(ctx, exec)
+
+		if err != nil {
+			return errors.Wrap(err, "error deleting invoice_lines")
+		}
+
+		_, err = InvoiceAllowanceCharges.Delete(dm.Where(InvoiceAllowanceCharges.Columns.InvoiceID.EQ(psql.Arg(invoice.ID)))).Exec(ctx, exec)
+
+		if err != nil {
+			return errors.Wrap(err, "error deleting invoice_allowance_charges")
+		}
+
+		_, err = InvoiceParties.Delete(dm.Where(InvoiceParties.Columns.InvoiceID.EQ(psql.Arg(invoice.ID)))).Exec(ctx, exec)
+
+		if err != nil {
+			return errors.Wrap(err, "error deleting invoice_parties")
+		}
+
+		invoice.R.InvoiceParties = nil
+		invoice.R.InvoiceAllowanceCharges = nil
+		invoice.R.InvoiceLines = nil
+
+		return insertDocumentRows(ctx, exec, invoice, document)
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "error replacing invoice")
+	}
+
+	return nil
+}
+
+func (r *InvoiceRepository) Delete(ctx context.Context, invoice *models.Invoice) error {
+	if err := invoice.Delete(ctx, r.db); err != nil {
+		return errors.Wrap(err, "error deleting invoice record")
+	}
+
+	return nil
+}
+
+// List returns a page of invoices ordered by sort, one of InvoiceSortColumns, ties broken by id.
+func (r *InvoiceRepository) List(ctx context.Context, filter InvoiceFilter, sort string, desc bool, limit, offset int) (models.InvoiceSlice, error) {
+	column, ok := InvoiceSortColumns[sort]
+
+	if !ok {
+		return nil, errors.Errorf("unsupported sort column: %s", sort)
+	}
+
+	orderBy := sm.OrderBy(column).Asc()
+	if desc {
+		orderBy = sm.OrderBy(column).Desc()
+	}
+
+	mods := append(filter.mods(),
+		orderBy,
+		sm.OrderBy(Invoices.Columns.ID).Asc(),
+		sm.Limit(uint64(limit)),
+		sm.Offset(uint64(offset)),
+	)
+
+	invoices, err := Invoices.Query(mods...).All(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching invoice list")
+	}
+
+	return invoices, nil
+}
+
+func (r *InvoiceRepository) Count(ctx context.Context, filter InvoiceFilter) (int64, error) {
+	count, err := Invoices.Query(filter.mods()...).Count(ctx, r.db)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "error counting invoices")
+	}
+
+	return count, nil
+}
+
+func NewInvoiceRepository(db bob.DB) *InvoiceRepository {
+	return &InvoiceRepository{db: db}
+}
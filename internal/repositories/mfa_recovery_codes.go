@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/pkg/errors"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+var MfaRecoveryCodes = models.MfaRecoveryCodes
+
+type MfaRecoveryCodeRepository struct {
+	db bob.Executor
+}
+
+// ReplaceByUserID deletes the user's recovery codes and stores the given hashed codes.
+func (r *MfaRecoveryCodeRepository) ReplaceByUserID(ctx context.Context, userId int64, codeHashes []string) error {
+	if err := r.DeleteByUserID(ctx, userId); err != nil {
+		return errors.Wrap(err, "error deleting previous recovery codes")
+	}
+
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	setters := make([]bob.Mod[*dialect.InsertQuery], len(codeHashes))
+	for i, hash := range codeHashes {
+		setters[i] = &models.MfaRecoveryCodeSetter{
+			UserID:   omit.From(userId),
+			CodeHash: omit.From(hash),
+		}
+	}
+
+	_, err := MfaRecoveryCodes.Insert(setters...).Exec(ctx, r.db)
+
+	if err != nil {
+		return errors.Wrap(err, "error inserting mfa_recovery_codes")
+	}
+
+	return nil
+}
+
+func (r *MfaRecoveryCodeRepository) DeleteByUserID(ctx context.Context, userId int64) error {
+	_, err := MfaRecoveryCodes.Delete(
+		dm.Where(MfaRecoveryCodes.Columns.UserID.EQ(psql.Arg(userId))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		return errors.Wrap(err, "error executing delete mfa_recovery_codes by user_id")
+	}
+
+	return nil
+}
+
+// Consume marks an unused recovery code as used. Returns false when no such code exists.
+func (r *MfaRecoveryCodeRepository) Consume(ctx context.Context, userId int64, codeHash string) (bool, error) {
+	use := models.MfaRecoveryCodeSetter{
+		UsedAt: omitnull.From(time.Now()),
+	}
+
+	count, err := MfaRecoveryCodes.Update(
+		use.UpdateMod(),
+		um.Where(
+			psql.And(
+				MfaRecoveryCodes.Columns.UserID.EQ(psql.Arg(userId)),
+				MfaRecoveryCodes.Columns.CodeHash.EQ(psql.Arg(codeHash)),
+				MfaRecoveryCodes.Columns.UsedAt.IsNull(),
+			),
+		),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		return false, errors.Wrap(err, "error executing consume recovery code query")
+	}
+
+	return count > 0, nil
+}
+
+func NewMfaRecoveryCodeRepository(db bob.Executor) *MfaRecoveryCodeRepository {
+	return &MfaRecoveryCodeRepository{db: db}
+}
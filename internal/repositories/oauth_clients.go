@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/pkg/errors"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+var OauthClients = models.OauthClients
+
+type OauthClientRepository struct {
+	db bob.Executor
+}
+
+func (r *OauthClientRepository) Create(ctx context.Context, client *models.OauthClientSetter) (*models.OauthClient, error) {
+	createdClient, err := OauthClients.Insert(client).One(ctx, r.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error inserting oauth_clients")
+	}
+	return createdClient, nil
+}
+
+func (r *OauthClientRepository) FindByClientID(ctx context.Context, clientId string) (*models.OauthClient, error) {
+	client, err := OauthClients.Query(
+		sm.Where(OauthClients.Columns.ClientID.EQ(psql.Arg(clientId))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching oauth client")
+	}
+
+	return client, nil
+}
+
+func (r *OauthClientRepository) FindByOrganisationID(ctx context.Context, organisationId int64, id int64) (*models.OauthClient, error) {
+	client, err := OauthClients.Query(
+		sm.Where(OauthClients.Columns.ID.EQ(psql.Arg(id))),
+		sm.Where(OauthClients.Columns.OrganisationID.EQ(psql.Arg(organisationId))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching oauth client")
+	}
+
+	return client, nil
+}
+
+func (r *OauthClientRepository) ListByOrganisationID(ctx context.Context, organisationId int64) ([]*models.OauthClient, error) {
+	clients, err := OauthClients.Query(
+		sm.Where(OauthClients.Columns.OrganisationID.EQ(psql.Arg(organisationId))),
+		sm.OrderBy(OauthClients.Columns.CreatedAt).Desc(),
+	).All(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching oauth clients")
+	}
+
+	return clients, nil
+}
+
+func (r *OauthClientRepository) Revoke(ctx context.Context, client *models.OauthClient) error {
+	err := client.Update(ctx, r.db, &models.OauthClientSetter{
+		RevokedAt: omitnull.From(time.Now()),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "error revoking oauth client")
+	}
+
+	return nil
+}
+
+func NewOauthClientRepository(db bob.Executor) *OauthClientRepository {
+	return &OauthClientRepository{db: db}
+}
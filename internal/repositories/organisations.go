@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/pkg/errors"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+var Organisations = models.Organisations
+var OrganisationMembers = models.OrganisationMembers
+
+type OrganisationRepository struct {
+	db bob.Executor
+}
+
+func (r *OrganisationRepository) FindById(ctx context.Context, id int64) (*models.Organisation, error) {
+	return models.FindOrganisation(ctx, r.db, id)
+}
+
+func (r *OrganisationRepository) Create(ctx context.Context, organisation *models.OrganisationSetter) (*models.Organisation, error) {
+	createdOrganisation, err := Organisations.Insert(organisation).One(ctx, r.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error inserting organisation record")
+	}
+	return createdOrganisation, nil
+}
+
+func (r *OrganisationRepository) List(ctx context.Context) (models.OrganisationSlice, error) {
+	organisations, err := Organisations.Query(
+		sm.OrderBy(Organisations.Columns.Name),
+	).All(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching organisations")
+	}
+
+	return organisations, nil
+}
+
+// ListByUserID returns the organisations the user is a member of.
+func (r *OrganisationRepository) ListByUserID(ctx context.Context, userId int64) (models.OrganisationSlice, error) {
+	organisations, err := Organisations.Query(
+		sm.InnerJoin(OrganisationMembers.Name()).On(OrganisationMembers.Columns.OrganisationID.EQ(Organisations.Columns.ID)),
+		sm.Where(OrganisationMembers.Columns.UserID.EQ(psql.Arg(userId))),
+		sm.OrderBy(Organisations.Columns.Name),
+	).All(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching user organisations")
+	}
+
+	return organisations, nil
+}
+
+func (r *OrganisationRepository) IsMember(ctx context.Context, organisationId int64, userId int64) (bool, error) {
+	exists, err := OrganisationMembers.Query(
+		sm.Where(OrganisationMembers.Columns.OrganisationID.EQ(psql.Arg(organisationId))),
+		sm.Where(OrganisationMembers.Columns.UserID.EQ(psql.Arg(userId))),
+	).Exists(ctx, r.db)
+
+	if err != nil {
+		return false, errors.Wrap(err, "error querying organisation_members")
+	}
+
+	return exists, nil
+}
+
+// ListMembers returns the users of the organisation, soft deleted users excluded.
+func (r *OrganisationRepository) ListMembers(ctx context.Context, organisationId int64) (models.UserSlice, error) {
+	users, err := Users.Query(
+		sm.InnerJoin(OrganisationMembers.Name()).On(OrganisationMembers.Columns.UserID.EQ(Users.Columns.ID)),
+		sm.Where(OrganisationMembers.Columns.OrganisationID.EQ(psql.Arg(organisationId))),
+		sm.Where(Users.Columns.DeletedAt.IsNull()),
+		sm.OrderBy(Users.Columns.ID),
+	).All(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching organisation members")
+	}
+
+	return users, nil
+}
+
+// AddMember returns false when the user already is a member.
+func (r *OrganisationRepository) AddMember(ctx context.Context, organisationId int64, userId int64) (bool, error) {
+	count, err := OrganisationMembers.Insert(
+		&models.OrganisationMemberSetter{
+			OrganisationID: omit.From(organisationId),
+			UserID:         omit.From(userId),
+		},
+		im.OnConflict().DoNothing(),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		return false, errors.Wrap(err, "error inserting organisation_members")
+	}
+
+	return count > 0, nil
+}
+
+// RemoveMember returns false when the user was not a member.
+func (r *OrganisationRepository) RemoveMember(ctx context.Context, organisationId int64, userId int64) (bool, error) {
+	count, err := OrganisationMembers.Delete(
+		dm.Where(
+			psql.And(
+				OrganisationMembers.Columns.OrganisationID.EQ(psql.Arg(organisationId)),
+				OrganisationMembers.Columns.UserID.EQ(psql.Arg(userId)),
+			),
+		),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		return false, errors.Wrap(err, "error deleting organisation_members")
+	}
+
+	return count > 0, nil
+}
+
+func NewOrganisationRepository(db bob.Executor) *OrganisationRepository {
+	return &OrganisationRepository{db: db}
+}
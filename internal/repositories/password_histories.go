@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/pkg/errors"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+var PasswordHistories = models.PasswordHistories
+
+type PasswordHistoryRepository struct {
+	db bob.Executor
+}
+
+func (r *PasswordHistoryRepository) Create(ctx context.Context, userId int64, hashedPw string) error {
+	_, err := PasswordHistories.Insert(&models.PasswordHistorySetter{
+		UserID:   omit.From(userId),
+		Password: omit.From(hashedPw),
+	}).Exec(ctx, r.db)
+
+	if err != nil {
+		return errors.Wrap(err, "error inserting password_histories")
+	}
+
+	return nil
+}
+
+// ListRecentByUserID returns the user's latest password hashes, newest first.
+func (r *PasswordHistoryRepository) ListRecentByUserID(ctx context.Context, userId int64, limit int) (models.PasswordHistorySlice, error) {
+	histories, err := PasswordHistories.Query(
+		sm.Where(PasswordHistories.Columns.UserID.EQ(psql.Arg(userId))),
+		sm.OrderBy(PasswordHistories.Columns.ID).Desc(),
+		sm.Limit(uint64(limit)),
+	).All(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching password histories")
+	}
+
+	return histories, nil
+}
+
+// PruneByUserID deletes all but the user's latest keep password hashes.
+func (r *PasswordHistoryRepository) PruneByUserID(ctx context.Context, userId int64, keep int) error {
+	recent, err := r.ListRecentByUserID(ctx, userId, keep)
+
+	if err != nil {
+		return err
+	}
+
+	if len(recent) < keep {
+		return nil
+	}
+
+	_, err = PasswordHistories.Delete(
+		dm.Where(PasswordHistories.Columns.UserID.EQ(psql.Arg(userId))),
+		dm.Where(PasswordHistories.Columns.ID.LT(psql.Arg(recent[len(recent)-1].ID))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		return errors.Wrap(err, "error deleting password histories")
+	}
+
+	return nil
+}
+
+func NewPasswordHistoryRepository(db bob.Executor) *PasswordHistoryRepository {
+	return &PasswordHistoryRepository{db: db}
+}
@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/pkg/errors"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/scan"
+)
+
+var Roles = models.Roles
+var Permissions = models.Permissions
+var RolePermissions = models.RolePermissions
+var UserRoles = models.UserRoles
+
+type RoleRepository struct {
+	db bob.Executor
+}
+
+func (r *RoleRepository) FindByName(ctx context.Context, name string) (*models.Role, error) {
+	role, err := Roles.Query(
+		sm.Where(Roles.Columns.Name.EQ(psql.Arg(name))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching role")
+	}
+
+	return role, nil
+}
+
+func (r *RoleRepository) List(ctx context.Context) ([]*models.Role, error) {
+	roles, err := Roles.Query(
+		sm.OrderBy(Roles.Columns.Name),
+	).All(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching roles")
+	}
+
+	return roles, nil
+}
+
+// ListRolesByUserID returns the roles assigned to the user.
+func (r *RoleRepository) ListRolesByUserID(ctx context.Context, userId int64) ([]*models.Role, error) {
+	roles, err := Roles.Query(
+		sm.InnerJoin(UserRoles.Name()).On(UserRoles.Columns.RoleID.EQ(Roles.Columns.ID)),
+		sm.Where(UserRoles.Columns.UserID.EQ(psql.Arg(userId))),
+		sm.OrderBy(Roles.Columns.Name),
+	).All(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching user roles")
+	}
+
+	return roles, nil
+}
+
+// ListPermissionNamesByUserID returns the distinct permissions granted to the user through their roles.
+func (r *RoleRepository) ListPermissionNamesByUserID(ctx context.Context, userId int64) ([]string, error) {
+	names, err := bob.All(ctx, r.db, psql.Select(
+		sm.Distinct(),
+		sm.Columns(Permissions.Columns.Name),
+		sm.From(Permissions.Name()),
+		sm.InnerJoin(RolePermissions.Name()).On(RolePermissions.Columns.PermissionID.EQ(Permissions.Columns.ID)),
+		sm.InnerJoin(UserRoles.Name()).On(UserRoles.Columns.RoleID.EQ(RolePermissions.Columns.RoleID)),
+		sm.Where(UserRoles.Columns.UserID.EQ(psql.Arg(userId))),
+		sm.OrderBy(Permissions.Columns.Name),
+	), scan.SingleColumnMapper[string])
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching user permissions")
+	}
+
+	return names, nil
+}
+
+// ListPermissionNamesByRoleID returns the permissions the role grants.
+func (r *RoleRepository) ListPermissionNamesByRoleID(ctx context.Context, roleId int64) ([]string, error) {
+	names, err := bob.All(ctx, r.db, psql.Select(
+		sm.Columns(Permissions.Columns.Name),
+		sm.From(Permissions.Name()),
+		sm.InnerJoin(RolePermissions.Name()).On(RolePermissions.Columns.PermissionID.EQ(Permissions.Columns.ID)),
+		sm.Where(RolePermissions.Columns.RoleID.EQ(psql.Arg(roleId))),
+		sm.OrderBy(Permissions.Columns.Name),
+	), scan.SingleColumnMapper[string])
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching role permissions")
+	}
+
+	return names, nil
+}
+
+func (r *RoleRepository) AssignRole(ctx context.Context, userId int64, roleId int64) error {
+	_, err := UserRoles.Insert(
+		&models.UserRoleSetter{
+			UserID: omit.From(userId),
+			RoleID: omit.From(roleId),
+		},
+		im.OnConflict().DoNothing(),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		return errors.Wrap(err, "error inserting user_roles")
+	}
+
+	return nil
+}
+
+func (r *RoleRepository) RemoveRole(ctx context.Context, userId int64, roleId int64) error {
+	_, err := UserRoles.Delete(
+		dm.Where(
+			psql.And(
+				UserRoles.Columns.UserID.EQ(psql.Arg(userId)),
+				UserRoles.Columns.RoleID.EQ(psql.Arg(roleId)),
+			),
+		),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		return errors.Wrap(err, "error deleting user_roles")
+	}
+
+	return nil
+}
+
+func NewRoleRepository(db bob.Executor) *RoleRepository {
+	return &RoleRepository{db: db}
+}
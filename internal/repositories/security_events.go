@@ -0,0 +1,57 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/pkg"
+	"github.com/pkg/errors"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/types"
+)
+
+var SecurityEvents = models.SecurityEvents
+
+type SecurityEventRepository struct {
+	db bob.Executor
+}
+
+func (r *SecurityEventRepository) Create(ctx context.Context, event *models.SecurityEventSetter) (*models.SecurityEvent, error) {
+	createdEvent, err := SecurityEvents.Insert(event).One(ctx, r.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error inserting security_events")
+	}
+	return createdEvent, nil
+}
+
+// Record stores a security event for the user and session, capturing the client ip from the context.
+func (r *SecurityEventRepository) Record(ctx context.Context, eventType enums.SecurityEventTypes, userId int64, sessionId uuid.UUID, details any) (*models.SecurityEvent, error) {
+	data := &models.SecurityEventSetter{
+		UserID:    omitnull.From(userId),
+		SessionID: omitnull.From(sessionId),
+		Type:      omit.From(eventType),
+	}
+
+	if clientIp, ok := pkg.GetCtxClientIp(ctx); ok {
+		data.IPAddress = omitnull.From(clientIp)
+	}
+
+	if details != nil {
+		raw, err := json.Marshal(details)
+		if err != nil {
+			return nil, errors.Wrap(err, "error encoding security event details")
+		}
+		data.Details = omitnull.From(types.NewJSON(json.RawMessage(raw)))
+	}
+
+	return r.Create(ctx, data)
+}
+
+func NewSecurityEventRepository(db bob.Executor) *SecurityEventRepository {
+	return &SecurityEventRepository{db: db}
+}
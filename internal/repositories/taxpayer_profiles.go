@@ -0,0 +1,57 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/pkg/errors"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+var TaxpayerProfiles = models.TaxpayerProfiles
+
+type TaxpayerProfileRepository struct {
+	db bob.Executor
+}
+
+func (r *TaxpayerProfileRepository) FindByOrganisationID(ctx context.Context, organisationId int64) (*models.TaxpayerProfile, error) {
+	profile, err := TaxpayerProfiles.Query(
+		sm.Where(TaxpayerProfiles.Columns.OrganisationID.EQ(psql.Arg(organisationId))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching taxpayer profile")
+	}
+
+	return profile, nil
+}
+
+func (r *TaxpayerProfileRepository) Create(ctx context.Context, profile *models.TaxpayerProfileSetter) (*models.TaxpayerProfile, error) {
+	createdProfile, err := TaxpayerProfiles.Insert(profile).One(ctx, r.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error inserting taxpayer_profiles")
+	}
+	return createdProfile, nil
+}
+
+func (r *TaxpayerProfileRepository) Update(ctx context.Context, profile *models.TaxpayerProfile, data *models.TaxpayerProfileSetter) error {
+	if err := profile.Update(ctx, r.db, data); err != nil {
+		return errors.Wrap(err, "error updating taxpayer profile record")
+	}
+
+	return nil
+}
+
+func (r *TaxpayerProfileRepository) Delete(ctx context.Context, profile *models.TaxpayerProfile) error {
+	if err := profile.Delete(ctx, r.db); err != nil {
+		return errors.Wrap(err, "error deleting taxpayer profile record")
+	}
+
+	return nil
+}
+
+func NewTaxpayerProfileRepository(db bob.Executor) *TaxpayerProfileRepository {
+	return &TaxpayerProfileRepository{db: db}
+}
@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/pkg"
+	"github.com/pkg/errors"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+)
+
+// ErrCrossTenantWrite is returned when a row is inserted for another organisation than the one on the context.
+var ErrCrossTenantWrite = errors.New("cannot write rows of another organisation")
+
+// Tenant tables hold an organisation_id column. Once a tenant is set on the context with
+// pkg.SetCtxOrganisationId, every select, update and delete on them only reaches that
+// organisation's rows and inserts default to it. Without a tenant, such as on admin routes
+// or while authenticating a credential, queries are not scoped.
+func init() {
+	scopeToTenant(&models.APIKeys.SelectQueryHooks, &models.APIKeys.UpdateQueryHooks, &models.APIKeys.DeleteQueryHooks, models.APIKeys.Columns.OrganisationID)
+	models.APIKeys.BeforeInsertHooks.AppendHooks(func(ctx context.Context, _ bob.Executor, s *models.APIKeySetter) (context.Context, error) {
+		return ctx, setTenant(ctx, &s.OrganisationID)
+	})
+
+	scopeToTenant(&models.OauthClients.SelectQueryHooks, &models.OauthClients.UpdateQueryHooks, &models.OauthClients.DeleteQueryHooks, models.OauthClients.Columns.OrganisationID)
+	models.OauthClients.BeforeInsertHooks.AppendHooks(func(ctx context.Context, _ bob.Executor, s *models.OauthClientSetter) (context.Context, error) {
+		return ctx, setTenant(ctx, &s.OrganisationID)
+	})
+
+	scopeToTenant(&models.TaxpayerProfiles.SelectQueryHooks, &models.TaxpayerProfiles.UpdateQueryHooks, &models.TaxpayerProfiles.DeleteQueryHooks, models.TaxpayerProfiles.Columns.OrganisationID)
+	models.TaxpayerProfiles.BeforeInsertHooks.AppendHooks(func(ctx context.Context, _ bob.Executor, s *models.TaxpayerProfileSetter) (context.Context, error) {
+		return ctx, setTenant(ctx, &s.OrganisationID)
+	})
+
+	scopeToTenant(&models.Invoices.SelectQueryHooks, &models.Invoices.UpdateQueryHooks, &models.Invoices.DeleteQueryHooks, models.Invoices.Columns.OrganisationID)
+	models.Invoices.BeforeInsertHooks.AppendHooks(func(ctx context.Context, _ bob.Executor, s *models.InvoiceSetter) (context.Context, error) {
+		return ctx, setTenant(ctx, &s.OrganisationID)
+	})
+
+	// Audit events of users outside any organisation are left out of a tenant's log
+	scopeToTenant(&models.AuditEvents.SelectQueryHooks, &models.AuditEvents.UpdateQueryHooks, &models.AuditEvents.DeleteQueryHooks, models.AuditEvents.Columns.OrganisationID)
+	models.AuditEvents.BeforeInsertHooks.AppendHooks(func(ctx context.Context, _ bob.Executor, s *models.AuditEventSetter) (context.Context, error) {
+		if organisationId, ok := pkg.GetCtxOrganisationId(ctx); ok && s.OrganisationID.IsUnset() {
+			s.OrganisationID.Set(organisationId)
+		}
+		return ctx, nil
+	})
+}
+
+func tenantCondition(ctx context.Context, column psql.Expression) (psql.Expression, bool) {
+	organisationId, ok := pkg.GetCtxOrganisationId(ctx)
+
+	if !ok {
+		return psql.Expression{}, false
+	}
+
+	return column.EQ(psql.Arg(organisationId)), true
+}
+
+func scopeToTenant(
+	selectHooks *bob.Hooks[*dialect.SelectQuery, bob.SkipQueryHooksKey],
+	updateHooks *bob.Hooks[*dialect.UpdateQuery, bob.SkipQueryHooksKey],
+	deleteHooks *bob.Hooks[*dialect.DeleteQuery, bob.SkipQueryHooksKey],
+	column psql.Expression,
+) {
+	selectHooks.AppendHooks(func(ctx context.Context, _ bob.Executor, q *dialect.SelectQuery) (context.Context, error) {
+		if condition, ok := tenantCondition(ctx, column); ok {
+			q.AppendWhere(condition)
+		}
+		return ctx, nil
+	})
+
+	updateHooks.AppendHooks(func(ctx context.Context, _ bob.Executor, q *dialect.UpdateQuery) (context.Context, error) {
+		if condition, ok := tenantCondition(ctx, column); ok {
+			q.AppendWhere(condition)
+		}
+		return ctx, nil
+	})
+
+	deleteHooks.AppendHooks(func(ctx context.Context, _ bob.Executor, q *dialect.DeleteQuery) (context.Context, error) {
+		if condition, ok := tenantCondition(ctx, column); ok {
+			q.AppendWhere(condition)
+		}
+		return ctx, nil
+	})
+}
+
+// setTenant fills in the organisation of a new row and rejects rows meant for another one.
+func setTenant(ctx context.Context, organisationIdField *omit.Val[int64]) error {
+	organisationId, ok := pkg.GetCtxOrganisationId(ctx)
+
+	if !ok {
+		return nil
+	}
+
+	if current, isSet := organisationIdField.Get(); isSet && current != organisationId {
+		return ErrCrossTenantWrite
+	}
+
+	organisationIdField.Set(organisationId)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/pkg/errors"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+var UserIdentities = models.UserIdentities
+
+type UserIdentityRepository struct {
+	db bob.Executor
+}
+
+func (r *UserIdentityRepository) FindByProviderSubject(ctx context.Context, provider string, subject string) (*models.UserIdentity, error) {
+	identity, err := UserIdentities.Query(
+		sm.Where(UserIdentities.Columns.Provider.EQ(psql.Arg(provider))),
+		sm.Where(UserIdentities.Columns.Subject.EQ(psql.Arg(subject))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching user identity")
+	}
+
+	return identity, nil
+}
+
+func (r *UserIdentityRepository) Create(ctx context.Context, identity *models.UserIdentitySetter) (*models.UserIdentity, error) {
+	createdIdentity, err := UserIdentities.Insert(identity).One(ctx, r.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error inserting user_identities")
+	}
+	return createdIdentity, nil
+}
+
+func (r *UserIdentityRepository) TouchLastLogin(ctx context.Context, identity *models.UserIdentity) error {
+	err := identity.Update(ctx, r.db, &models.UserIdentitySetter{
+		LastLoginAt: omitnull.From(time.Now()),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "error updating user identity last login")
+	}
+
+	return nil
+}
+
+func NewUserIdentityRepository(db bob.Executor) *UserIdentityRepository {
+	return &UserIdentityRepository{db: db}
+}
@@ -2,11 +2,15 @@ package repositories
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"github.com/aarondl/opt/omitnull"
 	"github.com/jacoobjake/einvoice-api/internal/database/models"
 	"github.com/pkg/errors"
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
 	"github.com/stephenafamo/bob/dialect/psql/sm"
 )
 
@@ -61,6 +65,19 @@ func (r *UserRepository) FindByEmailOrFail(ctx context.Context, email string) (*
 	return user, nil
 }
 
+// ExistsByEmail reports whether the email is taken, by soft deleted users as well, ignoring case.
+func (r *UserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	exists, err := Users.Query(
+		sm.Where(psql.F("LOWER", Users.Columns.Email)().EQ(psql.Arg(strings.ToLower(email)))),
+	).Exists(ctx, r.db)
+
+	if err != nil {
+		return false, errors.Wrap(err, "error querying user")
+	}
+
+	return exists, nil
+}
+
 func (r *UserRepository) Create(ctx context.Context, user *models.UserSetter) (*models.User, error) {
 	createdUser, err := Users.Insert(user).One(ctx, r.db)
 	if err != nil {
@@ -77,15 +94,96 @@ func (r *UserRepository) Update(ctx context.Context, user *models.User, data *mo
 	return user, nil
 }
 
+// Delete soft deletes the user, the users_soft_delete trigger turns the DELETE into setting deleted_at.
 func (r *UserRepository) Delete(ctx context.Context, user *models.User) error {
-	return user.Delete(ctx, r.db)
+	if err := user.Delete(ctx, r.db); err != nil {
+		return errors.Wrap(err, "error deleting user record")
+	}
+
+	// The trigger cancels the DELETE, read back the row it updated instead
+	if err := user.Reload(ctx, r.db); err != nil {
+		return errors.Wrap(err, "error reloading deleted user record")
+	}
+
+	return nil
+}
+
+// Deleted user visibility in UserFilter.
+const (
+	WithoutDeleted = "without"
+	WithDeleted    = "with"
+	OnlyDeleted    = "only"
+)
+
+// UserSortColumns maps the sortable fields to their columns.
+var UserSortColumns = map[string]psql.Expression{
+	"id":         Users.Columns.ID,
+	"first_name": Users.Columns.FirstName,
+	"last_name":  Users.Columns.LastName,
+	"email":      Users.Columns.Email,
+	"status":     Users.Columns.Status,
+	"created_at": Users.Columns.CreatedAt,
 }
 
-func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]*models.User, error) {
-	users, err := Users.Query(
+// UserFilter narrows user queries, zero values are ignored.
+type UserFilter struct {
+	Status string
+	// Case-insensitive match on the full name or email
+	Search string
+	// WithoutDeleted (default), WithDeleted or OnlyDeleted
+	Deleted string
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (f UserFilter) mods() []bob.Mod[*dialect.SelectQuery] {
+	cols := Users.Columns
+	mods := []bob.Mod[*dialect.SelectQuery]{}
+
+	switch f.Deleted {
+	case WithDeleted:
+	case OnlyDeleted:
+		mods = append(mods, sm.Where(cols.DeletedAt.IsNotNull()))
+	default:
+		mods = append(mods, sm.Where(cols.DeletedAt.IsNull()))
+	}
+
+	if f.Status != "" {
+		mods = append(mods, sm.Where(cols.Status.EQ(psql.Arg(f.Status))))
+	}
+	if f.Search != "" {
+		pattern := psql.Arg("%" + likeEscaper.Replace(f.Search) + "%")
+		fullName := psql.Group(psql.Concat(cols.FirstName, psql.S(" "), cols.LastName))
+		mods = append(mods, sm.Where(psql.Or(
+			fullName.OP("ILIKE", pattern),
+			cols.Email.OP("ILIKE", pattern),
+		)))
+	}
+
+	return mods
+}
+
+// List returns a page of users ordered by sort, one of UserSortColumns, ties broken by id.
+func (r *UserRepository) List(ctx context.Context, filter UserFilter, sort string, desc bool, limit, offset int) (models.UserSlice, error) {
+	column, ok := UserSortColumns[sort]
+
+	if !ok {
+		return nil, errors.Errorf("unsupported sort column: %s", sort)
+	}
+
+	orderBy := sm.OrderBy(column).Asc()
+	if desc {
+		orderBy = sm.OrderBy(column).Desc()
+	}
+
+	mods := append(filter.mods(),
+		orderBy,
+		sm.OrderBy(Users.Columns.ID).Asc(),
 		sm.Limit(uint64(limit)),
 		sm.Offset(uint64(offset)),
-	).All(ctx, r.db)
+	)
+
+	users, err := Users.Query(mods...).All(ctx, r.db)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "error fetching user list")
@@ -94,6 +192,29 @@ func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]*models
 	return users, nil
 }
 
+func (r *UserRepository) Count(ctx context.Context, filter UserFilter) (int64, error) {
+	count, err := Users.Query(filter.mods()...).Count(ctx, r.db)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "error counting users")
+	}
+
+	return count, nil
+}
+
+// Restore clears deleted_at on a soft deleted user.
+func (r *UserRepository) Restore(ctx context.Context, user *models.User) error {
+	err := user.Update(ctx, r.db, &models.UserSetter{
+		DeletedAt: omitnull.FromPtr[time.Time](nil),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "error restoring user record")
+	}
+
+	return nil
+}
+
 func NewUserRepository(db bob.Executor) *UserRepository {
 	return &UserRepository{db: db}
 }
@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/handlers"
+	"github.com/jacoobjake/einvoice-api/internal/routes/middlewares"
+	"github.com/jacoobjake/einvoice-api/pkg/rbac"
+)
+
+func RegisterAdminRoutes(rg *gin.RouterGroup, authHandler *handlers.AuthHandler, auditHandler *handlers.AuditHandler, userHandler *handlers.UserHandler) {
+
+	adminGroup := rg.Group("/admin")
+	{
+		adminGroup.Use(middlewares.AuthMiddleware(authHandler.AuthService))
+
+		usersGroup := adminGroup.Group("/users")
+		{
+			usersGroup.GET("", middlewares.RequirePermission(rbac.UserRead), userHandler.List)
+			usersGroup.POST("", middlewares.RequirePermission(rbac.UserWrite), userHandler.Create)
+			usersGroup.GET("/:id", middlewares.RequirePermission(rbac.UserRead), userHandler.Get)
+			usersGroup.PATCH("/:id", middlewares.RequirePermission(rbac.UserWrite), userHandler.Update)
+			usersGroup.PUT("/:id/status", middlewares.RequirePermission(rbac.UserWrite), userHandler.UpdateStatus)
+			usersGroup.DELETE("/:id", middlewares.RequirePermission(rbac.UserWrite), userHandler.Delete)
+			usersGroup.POST("/:id/restore", middlewares.RequirePermission(rbac.UserWrite), userHandler.Restore)
+			usersGroup.POST("/:id/unlock", middlewares.RequirePermission(rbac.UserUnlock), authHandler.UnlockUser)
+		}
+
+		auditGroup := adminGroup.Group("/audit-events", middlewares.RequirePermission(rbac.AuditRead))
+		{
+			auditGroup.GET("", auditHandler.List)
+			auditGroup.GET("/export", auditHandler.Export)
+		}
+	}
+}
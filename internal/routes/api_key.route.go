@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/handlers"
+	"github.com/jacoobjake/einvoice-api/internal/routes/middlewares"
+	"github.com/jacoobjake/einvoice-api/pkg/rbac"
+)
+
+func RegisterAPIKeyRoutes(rg *gin.RouterGroup, handler *handlers.APIKeyHandler, authHandler *handlers.AuthHandler, organisationHandler *handlers.OrganisationHandler) {
+
+	// Keys are only managed with a user login, a key cannot mint other keys
+	apiKeyGroup := rg.Group("/organisations/:organisationId/api-keys")
+	{
+		apiKeyGroup.Use(
+			middlewares.AuthMiddleware(authHandler.AuthService),
+			middlewares.TenantMiddleware(organisationHandler.OrganisationService),
+			middlewares.RequirePermission(rbac.APIKeyManage),
+		)
+
+		apiKeyGroup.POST("", handler.Create)
+		apiKeyGroup.GET("", handler.List)
+		apiKeyGroup.DELETE("/:id", handler.Revoke)
+	}
+}
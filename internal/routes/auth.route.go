@@ -1,19 +1,57 @@
 package routes
 
 import (
+	"time"
+
 	"github.com/gin-gonic/gin"
+	cfg_ratelimit "github.com/jacoobjake/einvoice-api/config/ratelimit"
 	"github.com/jacoobjake/einvoice-api/internal/handlers"
 	"github.com/jacoobjake/einvoice-api/internal/routes/middlewares"
+	"github.com/jacoobjake/einvoice-api/pkg/ratelimit"
 )
 
-func RegisterAuthRoutes(rg *gin.RouterGroup, handler *handlers.AuthHandler) {
+func RegisterAuthRoutes(rg *gin.RouterGroup, handler *handlers.AuthHandler, limiter *ratelimit.Limiter, rlCfg *cfg_ratelimit.RateLimitConfig) {
+	publicLimit := middlewares.StrictRateLimitMiddleware(limiter, "auth", ratelimit.Limit{
+		Requests: rlCfg.AuthRequests,
+		Period:   time.Duration(rlCfg.AuthPeriodSec) * time.Second,
+	}, middlewares.RateLimitByClientIP)
+
+	apiLimit := middlewares.RateLimitMiddleware(limiter, "api", ratelimit.Limit{
+		Requests: rlCfg.APIRequests,
+		Period:   time.Duration(rlCfg.APIPeriodSec) * time.Second,
+	}, middlewares.RateLimitByUser)
 
 	authGroup := rg.Group("/auth")
 	{
-		authGroup.POST("/login", handler.Login)
-		authGroup.POST("/refresh", handler.RefreshToken)
+		authGroup.GET("/.well-known/jwks.json", handler.JWKS)
+
+		publicGroup := authGroup.Group("", publicLimit)
+		{
+			publicGroup.POST("/login", handler.Login)
+			publicGroup.POST("/login/mfa", handler.LoginMFA)
+			publicGroup.POST("/refresh", handler.RefreshToken)
+			publicGroup.POST("/password/forgot", handler.ForgotPassword)
+			publicGroup.POST("/password/reset", handler.ResetPassword)
+			publicGroup.POST("/email/verify", handler.VerifyEmail)
+			publicGroup.POST("/email/confirm", handler.ConfirmEmailChange)
+			publicGroup.POST("/email/resend", handler.ResendEmailVerification)
+		}
 
-		authGroup.Use(middlewares.AuthMiddleware(handler.AuthService))
+		authGroup.Use(middlewares.AuthMiddleware(handler.AuthService), apiLimit)
 		authGroup.POST("/logout", handler.Logout)
+
+		sessionGroup := authGroup.Group("/sessions")
+		{
+			sessionGroup.GET("", handler.ListSessions)
+			sessionGroup.DELETE("", handler.RevokeAllSessions)
+			sessionGroup.DELETE("/:id", handler.RevokeSession)
+		}
+
+		totpGroup := authGroup.Group("/mfa/totp")
+		{
+			totpGroup.POST("/enroll", handler.EnrollTOTP)
+			totpGroup.POST("/confirm", handler.ConfirmTOTP)
+			totpGroup.DELETE("", handler.DisableTOTP)
+		}
 	}
 }
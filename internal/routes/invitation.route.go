@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	cfg_ratelimit "github.com/jacoobjake/einvoice-api/config/ratelimit"
+	"github.com/jacoobjake/einvoice-api/internal/handlers"
+	"github.com/jacoobjake/einvoice-api/internal/routes/middlewares"
+	"github.com/jacoobjake/einvoice-api/pkg/ratelimit"
+	"github.com/jacoobjake/einvoice-api/pkg/rbac"
+)
+
+func RegisterInvitationRoutes(rg *gin.RouterGroup, handler *handlers.InvitationHandler, authHandler *handlers.AuthHandler, limiter *ratelimit.Limiter, rlCfg *cfg_ratelimit.RateLimitConfig) {
+	publicLimit := middlewares.StrictRateLimitMiddleware(limiter, "auth", ratelimit.Limit{
+		Requests: rlCfg.AuthRequests,
+		Period:   time.Duration(rlCfg.AuthPeriodSec) * time.Second,
+	}, middlewares.RateLimitByClientIP)
+
+	// Accepting is public, the invitee has no account yet
+	rg.POST("/invitations/accept", publicLimit, handler.Accept)
+
+	adminGroup := rg.Group("/admin/invitations")
+	{
+		adminGroup.Use(middlewares.AuthMiddleware(authHandler.AuthService), middlewares.RequirePermission(rbac.UserInvite))
+
+		adminGroup.GET("", handler.List)
+		adminGroup.POST("", handler.Create)
+		adminGroup.POST("/:id/resend", handler.Resend)
+		adminGroup.DELETE("/:id", handler.Revoke)
+	}
+}
@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	cfg_ratelimit "github.com/jacoobjake/einvoice-api/config/ratelimit"
+	"github.com/jacoobjake/einvoice-api/internal/handlers"
+	"github.com/jacoobjake/einvoice-api/internal/routes/middlewares"
+	"github.com/jacoobjake/einvoice-api/pkg/ratelimit"
+	"github.com/jacoobjake/einvoice-api/pkg/rbac"
+)
+
+func RegisterInvoiceRoutes(rg *gin.RouterGroup, handler *handlers.InvoiceHandler, authHandler *handlers.AuthHandler, apiKeyHandler *handlers.APIKeyHandler, oauthHandler *handlers.OAuthHandler, organisationHandler *handlers.OrganisationHandler, limiter *ratelimit.Limiter, rlCfg *cfg_ratelimit.RateLimitConfig) {
+	// Integrations get a bucket per key, everyone else shares the user's api limit
+	apiLimit := middlewares.RateLimitMiddleware(limiter, "api", ratelimit.Limit{
+		Requests: rlCfg.APIRequests,
+		Period:   time.Duration(rlCfg.APIPeriodSec) * time.Second,
+	}, middlewares.RateLimitByAPIKey)
+	verifiedEmail := middlewares.RequireVerifiedEmail(authHandler.AuthService)
+
+	// Invoices of the organisation picked with the X-Organisation-ID header, or the one
+	// an API key or OAuth client was issued for
+	invoiceGroup := rg.Group("/invoices")
+	{
+		invoiceGroup.Use(
+			middlewares.IntegrationAuthMiddleware(authHandler.AuthService, apiKeyHandler.APIKeyService, oauthHandler.OAuthService),
+			apiLimit,
+			middlewares.TenantMiddleware(organisationHandler.OrganisationService),
+		)
+
+		invoiceGroup.GET("", middlewares.RequirePermission(rbac.InvoiceRead), handler.List)
+		invoiceGroup.POST("", middlewares.RequirePermission(rbac.InvoiceCreate), verifiedEmail, handler.Create)
+		invoiceGroup.POST("/calculate", middlewares.RequirePermission(rbac.InvoiceCreate), handler.Calculate)
+		invoiceGroup.GET("/:id", middlewares.RequirePermission(rbac.InvoiceRead), handler.Get)
+		invoiceGroup.PUT("/:id", middlewares.RequirePermission(rbac.InvoiceCreate), verifiedEmail, handler.Update)
+		invoiceGroup.DELETE("/:id", middlewares.RequirePermission(rbac.InvoiceCreate), verifiedEmail, handler.Delete)
+		// Credit, debit and refund notes adjusting the invoice
+		invoiceGroup.POST("/:id/notes", middlewares.RequirePermission(rbac.InvoiceCreate), verifiedEmail, handler.CreateNote)
+	}
+}
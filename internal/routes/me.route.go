@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	cfg_ratelimit "github.com/jacoobjake/einvoice-api/config/ratelimit"
+	"github.com/jacoobjake/einvoice-api/internal/handlers"
+	"github.com/jacoobjake/einvoice-api/internal/routes/middlewares"
+	"github.com/jacoobjake/einvoice-api/pkg/ratelimit"
+)
+
+func RegisterMeRoutes(rg *gin.RouterGroup, userHandler *handlers.UserHandler, organisationHandler *handlers.OrganisationHandler, authHandler *handlers.AuthHandler, limiter *ratelimit.Limiter, rlCfg *cfg_ratelimit.RateLimitConfig) {
+	apiLimit := middlewares.RateLimitMiddleware(limiter, "api", ratelimit.Limit{
+		Requests: rlCfg.APIRequests,
+		Period:   time.Duration(rlCfg.APIPeriodSec) * time.Second,
+	}, middlewares.RateLimitByUser)
+
+	meGroup := rg.Group("/me")
+	{
+		meGroup.Use(middlewares.AuthMiddleware(authHandler.AuthService), apiLimit)
+
+		meGroup.GET("", userHandler.Me)
+		meGroup.PATCH("", userHandler.UpdateMe)
+		meGroup.POST("/password", authHandler.ChangePassword)
+		meGroup.GET("/organisations", organisationHandler.Mine)
+	}
+}
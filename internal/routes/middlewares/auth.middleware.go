@@ -6,8 +6,14 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
 	"github.com/jacoobjake/einvoice-api/internal/services"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	pkgError "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/rbac"
 	"github.com/jacoobjake/einvoice-api/pkg/response"
+	"github.com/pkg/errors"
 )
 
 func AuthMiddleware(authService *services.AuthService) gin.HandlerFunc {
@@ -26,7 +32,16 @@ func AuthMiddleware(authService *services.AuthService) gin.HandlerFunc {
 
 		token := strings.TrimPrefix(authHeader, "Bearer ")
 
-		user, err := authService.VerifyToken(c.Request.Context(), token)
+		user, claims, err := authService.VerifyToken(c.Request.Context(), token)
+
+		if _, ok := errors.Cause(err).(pkgError.EmailNotVerifiedError); ok {
+			c.JSON(http.StatusForbidden, response.JSONApiResponse{
+				Success: false,
+				Message: "Email address has not been verified",
+			})
+			c.Abort()
+			return
+		}
 
 		if err != nil {
 			log.Println("error verifying token", err)
@@ -38,9 +53,124 @@ func AuthMiddleware(authService *services.AuthService) gin.HandlerFunc {
 			return
 		}
 
+		// Attribute audit events of this request to the user
+		actor := services.UserActor(user)
+		c.Request = c.Request.WithContext(audit.SetCtxActor(c.Request.Context(), actor))
+
 		// Set authorized user in context
 		c.Set("user", user)
 		c.Set("auth_token", token)
+		c.Set("session_id", claims.SessionID)
+		c.Set("permissions", claims.Permissions)
+
+		c.Next()
+	}
+}
+
+// IntegrationAuthMiddleware authenticates machine clients by the X-API-Key header or an OAuth
+// client access token and everyone else like AuthMiddleware. Machine clients get their
+// scopes as permissions and act as the user that created the credential.
+func IntegrationAuthMiddleware(authService *services.AuthService, apiKeyService *services.APIKeyService, oauthService *services.OAuthService) gin.HandlerFunc {
+	authMiddleware := AuthMiddleware(authService)
+
+	return func(c *gin.Context) {
+		if rawKey := c.GetHeader("X-API-Key"); rawKey != "" {
+			key, user, err := apiKeyService.Authenticate(c.Request.Context(), rawKey)
+
+			if err != nil {
+				log.Println("error verifying api key", err)
+				c.JSON(http.StatusUnauthorized, response.JSONApiResponse{
+					Success: false,
+					Message: "Invalid API key",
+				})
+				c.Abort()
+				return
+			}
+
+			setMachineContext(c, user, key.Scopes, key.OrganisationID, audit.Actor{
+				Type:           audit.ActorAPIKey,
+				ID:             key.ID,
+				Label:          key.Prefix,
+				OrganisationID: key.OrganisationID,
+			})
+			c.Set("api_key", key)
+			c.Next()
+			return
+		}
+
+		token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+
+		if !oauthService.IsClientToken(token) {
+			authMiddleware(c)
+			return
+		}
+
+		client, user, claims, err := oauthService.VerifyAccessToken(c.Request.Context(), token)
+
+		if err != nil {
+			log.Println("error verifying client token", err)
+			c.JSON(http.StatusUnauthorized, response.JSONApiResponse{
+				Success: false,
+				Message: "Invalid token",
+			})
+			c.Abort()
+			return
+		}
+
+		setMachineContext(c, user, claims.Scopes(), client.OrganisationID, audit.Actor{
+			Type:           audit.ActorOAuthClient,
+			ID:             client.ID,
+			Label:          client.ClientID,
+			OrganisationID: client.OrganisationID,
+		})
+		c.Set("auth_token", token)
+		c.Set("oauth_client", client)
+		c.Next()
+	}
+}
+
+// Machine credentials are not tied to a login session
+func setMachineContext(c *gin.Context, user *models.User, scopes []string, organisationId int64, actor audit.Actor) {
+	c.Request = c.Request.WithContext(audit.SetCtxActor(c.Request.Context(), actor))
+	c.Set("user", user)
+	c.Set("auth_token", "")
+	c.Set("session_id", uuid.Nil)
+	c.Set("permissions", scopes)
+	c.Set("organisation_id", organisationId)
+}
+
+// RequireVerifiedEmail guards actions, such as issuing invoices, that the email
+// verification policy withholds from unverified users. Must run after AuthMiddleware.
+func RequireVerifiedEmail(authService *services.AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user := c.MustGet("user").(*models.User)
+
+		if !authService.HasRequiredEmailVerification(user) {
+			c.JSON(http.StatusForbidden, response.JSONApiResponse{
+				Success: false,
+				Message: "Email address has not been verified",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequirePermission only lets through users whose token grants the permission. Must run after AuthMiddleware.
+func RequirePermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		permissions := c.GetStringSlice("permissions")
+
+		if !rbac.HasPermission(permissions, permission) {
+			c.JSON(http.StatusForbidden, response.JSONApiResponse{
+				Success: false,
+				Message: "Forbidden",
+			})
+			c.Abort()
+			return
+		}
 
 		c.Next()
 	}
@@ -0,0 +1,120 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/config"
+	"github.com/jacoobjake/einvoice-api/config/auth"
+	"github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/jacoobjake/einvoice-api/internal/database/factory"
+	"github.com/jacoobjake/einvoice-api/internal/repositories"
+	"github.com/jacoobjake/einvoice-api/internal/services"
+	"github.com/jacoobjake/einvoice-api/pkg/rbac"
+	_ "github.com/lib/pq"
+	"github.com/stephenafamo/bob"
+)
+
+// Set TEST_DATABASE_URL to a migrated database to run the tests that need one.
+func testTx(t *testing.T) bob.Tx {
+	t.Helper()
+
+	url := os.Getenv("TEST_DATABASE_URL")
+
+	if url == "" {
+		t.Skip("No database connection provided")
+	}
+
+	db, err := bob.Open("postgres", url)
+
+	if err != nil {
+		t.Fatalf("error connecting to database: %v", err)
+	}
+
+	t.Cleanup(func() { db.Close() })
+
+	tx, err := db.BeginTx(context.Background(), nil)
+
+	if err != nil {
+		t.Fatalf("error starting transaction: %v", err)
+	}
+
+	t.Cleanup(func() { tx.Rollback(context.Background()) })
+
+	return tx
+}
+
+func TestIntegrationAuthMiddlewareAPIKey(t *testing.T) {
+	tx := testTx(t)
+	ctx := context.Background()
+	f := factory.New()
+
+	user, err := f.NewUser(factory.UserMods.Status(enums.UserStatusesActive)).Create(ctx, tx)
+
+	if err != nil {
+		t.Fatalf("error creating user: %v", err)
+	}
+
+	organisation, err := f.NewOrganisation().Create(ctx, tx)
+
+	if err != nil {
+		t.Fatalf("error creating organisation: %v", err)
+	}
+
+	cfg := &config.Config{AuthConfig: &auth.AuthConfig{RefreshTokenSecret: "test_refresh_token_secret"}}
+	auditService := services.NewAuditService(repositories.NewAuditEventRepository(tx))
+	apiKeyService := services.NewAPIKeyService(
+		repositories.NewAPIKeyRepository(tx),
+		repositories.NewOrganisationRepository(tx),
+		repositories.NewUserRepository(tx),
+		auditService,
+		cfg,
+	)
+
+	_, plain, err := apiKeyService.Create(ctx, user, []string{rbac.InvoiceCreate}, organisation.ID, "Accounting system", []string{rbac.InvoiceCreate}, nil)
+
+	if err != nil {
+		t.Fatalf("error creating api key: %v", err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	// Requests with an X-API-Key never reach the user or OAuth client checks
+	r.POST("/invoices",
+		IntegrationAuthMiddleware(nil, apiKeyService, nil),
+		RequirePermission(rbac.InvoiceCreate),
+		func(c *gin.Context) {
+			if got := c.GetInt64("organisation_id"); got != organisation.ID {
+				t.Errorf("organisation_id = %d, want %d", got, organisation.ID)
+			}
+			c.Status(http.StatusNoContent)
+		},
+	)
+
+	tests := []struct {
+		name   string
+		apiKey string
+		want   int
+	}{
+		{"invoice:create key", plain, http.StatusNoContent},
+		{"unknown key", "eik_unknown_secret", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/invoices", nil)
+			req.Header.Set("X-API-Key", tt.apiKey)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.want, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package middlewares
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/pkg/ratelimit"
+	"github.com/jacoobjake/einvoice-api/pkg/response"
+)
+
+// RateLimitKeyFunc identifies who a request is counted against.
+type RateLimitKeyFunc func(c *gin.Context) string
+
+func RateLimitByClientIP(c *gin.Context) string {
+	return fmt.Sprintf("ip:%s", c.ClientIP())
+}
+
+// RateLimitByUser counts against the authenticated user, falling back to the client ip.
+// Must run after AuthMiddleware.
+func RateLimitByUser(c *gin.Context) string {
+	if user, ok := c.Get("user"); ok {
+		return fmt.Sprintf("user:%d", user.(*models.User).ID)
+	}
+	return RateLimitByClientIP(c)
+}
+
+// RateLimitByAPIKey counts against the X-API-Key header, falling back to the user and then the client ip.
+// The key is hashed so it never ends up in redis in plain text. Must run after IntegrationAuthMiddleware.
+func RateLimitByAPIKey(c *gin.Context) string {
+	if apiKey := c.GetHeader("X-API-Key"); apiKey != "" {
+		sum := sha256.Sum256([]byte(apiKey))
+		return fmt.Sprintf("api_key:%s", hex.EncodeToString(sum[:]))
+	}
+	return RateLimitByUser(c)
+}
+
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
+
+// RateLimitMiddleware applies a token bucket per key. The name scopes the buckets,
+// so route groups sharing a name share their limits. Requests are let through when redis is unavailable.
+func RateLimitMiddleware(limiter *ratelimit.Limiter, name string, limit ratelimit.Limit, keyFunc RateLimitKeyFunc) gin.HandlerFunc {
+	return rateLimit(limiter, name, limit, keyFunc, false)
+}
+
+// StrictRateLimitMiddleware is RateLimitMiddleware for public endpoints that take credentials,
+// requests are refused while redis is unavailable instead of going through unlimited.
+func StrictRateLimitMiddleware(limiter *ratelimit.Limiter, name string, limit ratelimit.Limit, keyFunc RateLimitKeyFunc) gin.HandlerFunc {
+	return rateLimit(limiter, name, limit, keyFunc, true)
+}
+
+func rateLimit(limiter *ratelimit.Limiter, name string, limit ratelimit.Limit, keyFunc RateLimitKeyFunc, failClosed bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := fmt.Sprintf("%s:%s", name, keyFunc(c))
+
+		result, err := limiter.Allow(c.Request.Context(), key, limit)
+
+		if err != nil {
+			log.Println("error checking rate limit", err)
+
+			if failClosed {
+				c.JSON(http.StatusServiceUnavailable, response.JSONApiResponse{
+					Success: false,
+					Code:    http.StatusServiceUnavailable,
+					Message: "Service temporarily unavailable",
+				})
+				c.Abort()
+				return
+			}
+
+			c.Next()
+			return
+		}
+
+		c.Header("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("X-RateLimit-Reset", seconds(result.ResetAfter))
+
+		if !result.Allowed {
+			c.Header("Retry-After", seconds(result.RetryAfter))
+			c.JSON(http.StatusTooManyRequests, response.JSONApiResponse{
+				Success: false,
+				Code:    http.StatusTooManyRequests,
+				Message: "Too many requests",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
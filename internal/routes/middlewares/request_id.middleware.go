@@ -0,0 +1,30 @@
+package middlewares
+
+import (
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jacoobjake/einvoice-api/pkg"
+)
+
+const requestIdHeader = "X-Request-ID"
+
+// Ids from upstream proxies are kept when they are safe to log and store
+var validRequestId = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,64}$`)
+
+func RequestIdMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestId := c.GetHeader(requestIdHeader)
+
+		if !validRequestId.MatchString(requestId) {
+			requestId = uuid.Must(uuid.NewV4()).String()
+		}
+
+		ctx := pkg.SetCtxRequestId(c.Request.Context(), requestId)
+		c.Request = c.Request.WithContext(ctx)
+		c.Header(requestIdHeader, requestId)
+
+		c.Next()
+	}
+}
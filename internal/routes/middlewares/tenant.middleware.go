@@ -0,0 +1,105 @@
+package middlewares
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/internal/services"
+	"github.com/jacoobjake/einvoice-api/pkg"
+	pkgError "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/response"
+	"github.com/pkg/errors"
+)
+
+const OrganisationHeader = "X-Organisation-ID"
+
+// requestedOrganisationId reads the organisation from the :organisationId path parameter or the
+// X-Organisation-ID header, 0 when neither is given.
+func requestedOrganisationId(c *gin.Context) (int64, error) {
+	raw := c.Param("organisationId")
+
+	if raw == "" {
+		raw = c.GetHeader(OrganisationHeader)
+	}
+
+	if raw == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(raw, 10, 64)
+}
+
+// TenantMiddleware resolves the organisation the request acts on and sets it on the request context,
+// which scopes repository queries to it. Machine credentials are bound to the organisation they were
+// issued for, users pick one of their organisations or get their only one.
+// Must run after AuthMiddleware or IntegrationAuthMiddleware.
+func TenantMiddleware(organisationService *services.OrganisationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requested, err := requestedOrganisationId(c)
+
+		if err != nil {
+			c.JSON(http.StatusNotFound, response.JSONApiResponse{
+				Success: false,
+				Message: "organisation not found",
+			})
+			c.Abort()
+			return
+		}
+
+		var organisationId int64
+
+		if bound, ok := c.Get("organisation_id"); ok {
+			organisationId = bound.(int64)
+
+			if requested != 0 && requested != organisationId {
+				c.JSON(http.StatusForbidden, response.JSONApiResponse{
+					Success: false,
+					Message: "Forbidden",
+				})
+				c.Abort()
+				return
+			}
+		} else {
+			user := c.MustGet("user").(*models.User)
+			organisationId, err = organisationService.ResolveTenant(c.Request.Context(), user, c.GetStringSlice("permissions"), requested)
+		}
+
+		if err != nil {
+			cause := errors.Cause(err)
+
+			switch cause.(type) {
+			case pkgError.OrganisationRequiredError:
+				c.JSON(http.StatusBadRequest, response.JSONApiResponse{
+					Success: false,
+					Message: cause.Error(),
+				})
+			case pkgError.NotOrganisationMemberError:
+				c.JSON(http.StatusForbidden, response.JSONApiResponse{
+					Success: false,
+					Message: cause.Error(),
+				})
+			case pkgError.NotFoundError:
+				c.JSON(http.StatusNotFound, response.JSONApiResponse{
+					Success: false,
+					Message: cause.Error(),
+				})
+			default:
+				log.Println("error resolving organisation", err)
+				c.JSON(http.StatusInternalServerError, response.JSONApiResponse{
+					Success: false,
+					Message: "an error occurred while resolving organisation",
+				})
+			}
+			c.Abort()
+			return
+		}
+
+		c.Set("organisation_id", organisationId)
+		c.Request = c.Request.WithContext(pkg.SetCtxOrganisationId(c.Request.Context(), organisationId))
+
+		c.Next()
+	}
+}
@@ -0,0 +1,15 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/pkg"
+)
+
+func UserAgentMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := pkg.SetCtxUserAgent(c.Request.Context(), c.Request.UserAgent())
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
@@ -0,0 +1,40 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	cfg_ratelimit "github.com/jacoobjake/einvoice-api/config/ratelimit"
+	"github.com/jacoobjake/einvoice-api/internal/handlers"
+	"github.com/jacoobjake/einvoice-api/internal/routes/middlewares"
+	"github.com/jacoobjake/einvoice-api/pkg/ratelimit"
+	"github.com/jacoobjake/einvoice-api/pkg/rbac"
+)
+
+func RegisterOAuthRoutes(rg *gin.RouterGroup, handler *handlers.OAuthHandler, authHandler *handlers.AuthHandler, organisationHandler *handlers.OrganisationHandler, limiter *ratelimit.Limiter, rlCfg *cfg_ratelimit.RateLimitConfig) {
+	publicLimit := middlewares.StrictRateLimitMiddleware(limiter, "oauth", ratelimit.Limit{
+		Requests: rlCfg.AuthRequests,
+		Period:   time.Duration(rlCfg.AuthPeriodSec) * time.Second,
+	}, middlewares.RateLimitByClientIP)
+
+	// Clients authenticate on every call with their own credentials
+	oauthGroup := rg.Group("/oauth", publicLimit)
+	{
+		oauthGroup.POST("/token", handler.Token)
+		oauthGroup.POST("/introspect", handler.Introspect)
+		oauthGroup.POST("/revoke", handler.Revoke)
+	}
+
+	clientGroup := rg.Group("/organisations/:organisationId/oauth-clients")
+	{
+		clientGroup.Use(
+			middlewares.AuthMiddleware(authHandler.AuthService),
+			middlewares.TenantMiddleware(organisationHandler.OrganisationService),
+			middlewares.RequirePermission(rbac.OAuthClientManage),
+		)
+
+		clientGroup.POST("", handler.CreateClient)
+		clientGroup.GET("", handler.ListClients)
+		clientGroup.DELETE("/:id", handler.RevokeClient)
+	}
+}
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	cfg_ratelimit "github.com/jacoobjake/einvoice-api/config/ratelimit"
+	"github.com/jacoobjake/einvoice-api/internal/handlers"
+	"github.com/jacoobjake/einvoice-api/internal/routes/middlewares"
+	"github.com/jacoobjake/einvoice-api/pkg/ratelimit"
+)
+
+func RegisterOIDCRoutes(rg *gin.RouterGroup, handler *handlers.OIDCHandler, limiter *ratelimit.Limiter, rlCfg *cfg_ratelimit.RateLimitConfig) {
+	publicLimit := middlewares.StrictRateLimitMiddleware(limiter, "auth", ratelimit.Limit{
+		Requests: rlCfg.AuthRequests,
+		Period:   time.Duration(rlCfg.AuthPeriodSec) * time.Second,
+	}, middlewares.RateLimitByClientIP)
+
+	oidcGroup := rg.Group("/auth/oidc", publicLimit)
+	{
+		oidcGroup.GET("/providers", handler.Providers)
+		oidcGroup.GET("/:provider/authorize", handler.Authorize)
+		oidcGroup.POST("/:provider/callback", handler.Callback)
+	}
+}
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/handlers"
+	"github.com/jacoobjake/einvoice-api/internal/routes/middlewares"
+	"github.com/jacoobjake/einvoice-api/pkg/rbac"
+)
+
+func RegisterOrganisationRoutes(rg *gin.RouterGroup, handler *handlers.OrganisationHandler, authHandler *handlers.AuthHandler) {
+
+	organisationGroup := rg.Group("/admin/organisations")
+	{
+		organisationGroup.Use(middlewares.AuthMiddleware(authHandler.AuthService), middlewares.RequirePermission(rbac.OrganisationManage))
+
+		organisationGroup.GET("", handler.List)
+		organisationGroup.POST("", handler.Create)
+		organisationGroup.GET("/:organisationId/members", handler.ListMembers)
+		organisationGroup.POST("/:organisationId/members", handler.AddMember)
+		organisationGroup.DELETE("/:organisationId/members/:id", handler.RemoveMember)
+	}
+}
@@ -7,31 +7,81 @@ import (
 	"github.com/jacoobjake/einvoice-api/internal/repositories"
 	"github.com/jacoobjake/einvoice-api/internal/routes/middlewares"
 	"github.com/jacoobjake/einvoice-api/internal/services"
+	"github.com/jacoobjake/einvoice-api/pkg/denylist"
+	"github.com/jacoobjake/einvoice-api/pkg/keyring"
+	"github.com/jacoobjake/einvoice-api/pkg/mailer"
+	"github.com/jacoobjake/einvoice-api/pkg/password"
+	"github.com/jacoobjake/einvoice-api/pkg/ratelimit"
 	"github.com/jacoobjake/einvoice-api/pkg/redisclient"
+	"github.com/jacoobjake/einvoice-api/pkg/secretbox"
 	"github.com/stephenafamo/bob"
 )
 
-func RegisterRoutes(r *gin.Engine, db bob.DB, cfg *config.Config, rdb *redisclient.RedisClient) {
+func RegisterRoutes(r *gin.Engine, db bob.DB, cfg *config.Config, rdb *redisclient.RedisClient, tokenDenylist denylist.TokenDenylist, kr *keyring.Keyring, mail mailer.Mailer, box *secretbox.SecretBox, hasher *password.Hasher, breached *password.BreachedChecker) {
 	// Initialize repositories
 	authTokenRepo := repositories.NewAuthTokenRepository(db)
 	userRepo := repositories.NewUserRepository(db)
 	flRepo := repositories.NewFailedLoginRepository(db)
+	seRepo := repositories.NewSecurityEventRepository(db)
+	mfaRepo := repositories.NewMfaRecoveryCodeRepository(db)
+	roleRepo := repositories.NewRoleRepository(db)
+	orgRepo := repositories.NewOrganisationRepository(db)
+	apiKeyRepo := repositories.NewAPIKeyRepository(db)
+	oauthClientRepo := repositories.NewOauthClientRepository(db)
+	identityRepo := repositories.NewUserIdentityRepository(db)
+	auditRepo := repositories.NewAuditEventRepository(db)
+	pwHistoryRepo := repositories.NewPasswordHistoryRepository(db)
+	invitationRepo := repositories.NewInvitationRepository(db)
+	taxpayerProfileRepo := repositories.NewTaxpayerProfileRepository(db)
+	invoiceRepo := repositories.NewInvoiceRepository(db)
 
 	// Initialize services
-	authService := services.NewAuthService(authTokenRepo, userRepo, flRepo, cfg, rdb)
+	auditService := services.NewAuditService(auditRepo)
+	authService := services.NewAuthService(authTokenRepo, userRepo, flRepo, seRepo, mfaRepo, roleRepo, pwHistoryRepo, auditService, cfg, rdb, tokenDenylist, kr, mail, box, hasher, breached)
+	apiKeyService := services.NewAPIKeyService(apiKeyRepo, orgRepo, userRepo, auditService, cfg)
+	oauthService := services.NewOAuthService(oauthClientRepo, orgRepo, userRepo, authService, auditService, cfg)
+	oidcService := services.NewOIDCService(identityRepo, userRepo, authService, cfg, rdb)
+	userService := services.NewUserService(userRepo, roleRepo, authService, auditService)
+	organisationService := services.NewOrganisationService(orgRepo, userRepo, auditService)
+	invitationService := services.NewInvitationService(invitationRepo, userRepo, roleRepo, authService, auditService)
+	taxpayerProfileService := services.NewTaxpayerProfileService(taxpayerProfileRepo, auditService)
+	invoiceService := services.NewInvoiceService(invoiceRepo, taxpayerProfileRepo, auditService)
+
+	// Initialize rate limiter
+	limiter := ratelimit.NewLimiter(rdb)
 
 	// Initialize handlers
 	authHandler := handlers.NewAuthHandler(authService)
+	apiKeyHandler := handlers.NewAPIKeyHandler(apiKeyService)
+	oauthHandler := handlers.NewOAuthHandler(oauthService)
+	oidcHandler := handlers.NewOIDCHandler(oidcService)
+	auditHandler := handlers.NewAuditHandler(auditService)
+	userHandler := handlers.NewUserHandler(userService)
+	invitationHandler := handlers.NewInvitationHandler(invitationService)
+	organisationHandler := handlers.NewOrganisationHandler(organisationService)
+	taxpayerProfileHandler := handlers.NewTaxpayerProfileHandler(taxpayerProfileService)
+	invoiceHandler := handlers.NewInvoiceHandler(invoiceService)
 
 	// Register Global Middlewares
 	r.Use(
-		middlewares.ClientIpMiddleware(), // Set Client IP to context
+		middlewares.RequestIdMiddleware(), // Set Request ID to context and response
+		middlewares.ClientIpMiddleware(),  // Set Client IP to context
+		middlewares.UserAgentMiddleware(), // Set User Agent to context
 	)
 
 	// Register routes
 	apiGroup := r.Group("/api")
 	{
-		RegisterAuthRoutes(apiGroup, authHandler)
+		RegisterAuthRoutes(apiGroup, authHandler, limiter, cfg.RateLimitConfig)
+		RegisterOIDCRoutes(apiGroup, oidcHandler, limiter, cfg.RateLimitConfig)
+		RegisterMeRoutes(apiGroup, userHandler, organisationHandler, authHandler, limiter, cfg.RateLimitConfig)
+		RegisterAdminRoutes(apiGroup, authHandler, auditHandler, userHandler)
+		RegisterInvitationRoutes(apiGroup, invitationHandler, authHandler, limiter, cfg.RateLimitConfig)
+		RegisterOrganisationRoutes(apiGroup, organisationHandler, authHandler)
+		RegisterAPIKeyRoutes(apiGroup, apiKeyHandler, authHandler, organisationHandler)
+		RegisterOAuthRoutes(apiGroup, oauthHandler, authHandler, organisationHandler, limiter, cfg.RateLimitConfig)
+		RegisterTaxpayerProfileRoutes(apiGroup, taxpayerProfileHandler, authHandler, apiKeyHandler, oauthHandler, organisationHandler)
+		RegisterInvoiceRoutes(apiGroup, invoiceHandler, authHandler, apiKeyHandler, oauthHandler, organisationHandler, limiter, cfg.RateLimitConfig)
 		// Add other route registrations here
 	}
 }
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/jacoobjake/einvoice-api/internal/handlers"
+	"github.com/jacoobjake/einvoice-api/internal/routes/middlewares"
+	"github.com/jacoobjake/einvoice-api/pkg/rbac"
+)
+
+func RegisterTaxpayerProfileRoutes(rg *gin.RouterGroup, handler *handlers.TaxpayerProfileHandler, authHandler *handlers.AuthHandler, apiKeyHandler *handlers.APIKeyHandler, oauthHandler *handlers.OAuthHandler, organisationHandler *handlers.OrganisationHandler) {
+
+	// The profile of the organisation picked with the X-Organisation-ID header, or the one
+	// an API key or OAuth client was issued for
+	taxpayerGroup := rg.Group("/taxpayer-profile")
+	{
+		taxpayerGroup.Use(
+			middlewares.IntegrationAuthMiddleware(authHandler.AuthService, apiKeyHandler.APIKeyService, oauthHandler.OAuthService),
+			middlewares.TenantMiddleware(organisationHandler.OrganisationService),
+		)
+
+		taxpayerGroup.GET("", middlewares.RequirePermission(rbac.TaxpayerRead), handler.Get)
+		taxpayerGroup.PUT("", middlewares.RequirePermission(rbac.TaxpayerWrite), handler.Save)
+		taxpayerGroup.DELETE("", middlewares.RequirePermission(rbac.TaxpayerWrite), handler.Delete)
+	}
+}
@@ -0,0 +1,278 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jacoobjake/einvoice-api/config"
+	"github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/internal/repositories"
+	"github.com/jacoobjake/einvoice-api/pkg"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	pkgErr "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/rbac"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// API keys look like "eik_<prefix>_<secret>". The prefix is stored in plain text to find
+// the key and to let users tell their keys apart, the whole key is only stored hashed.
+const (
+	apiKeyType         = "eik"
+	apiKeyPrefixLength = 8
+	apiKeySecretLength = 40
+	// How often last_used_at is written for a key in constant use
+	apiKeyTouchInterval = time.Minute
+)
+
+type APIKeyService struct {
+	repo     *repositories.APIKeyRepository
+	orgRepo  *repositories.OrganisationRepository
+	userRepo *repositories.UserRepository
+	audit    *AuditService
+	config   *config.Config
+}
+
+type APIKey struct {
+	ID             int64      `json:"id"`
+	OrganisationID int64      `json:"organisation_id"`
+	Name           string     `json:"name"`
+	Prefix         string     `json:"prefix"`
+	Scopes         []string   `json:"scopes"`
+	ExpireAt       *time.Time `json:"expire_at"`
+	LastUsedAt     *time.Time `json:"last_used_at"`
+	RevokedAt      *time.Time `json:"revoked_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+func toAPIKey(key *models.APIKey) APIKey {
+	return APIKey{
+		ID:             key.ID,
+		OrganisationID: key.OrganisationID,
+		Name:           key.Name,
+		Prefix:         key.Prefix,
+		Scopes:         key.Scopes,
+		ExpireAt:       key.ExpireAt.Ptr(),
+		LastUsedAt:     key.LastUsedAt.Ptr(),
+		RevokedAt:      key.RevokedAt.Ptr(),
+		CreatedAt:      key.CreatedAt.GetOrZero(),
+	}
+}
+
+// checkGrantableScopes makes sure integration credentials never get more than the user creating them.
+func checkGrantableScopes(permissions []string, scopes []string) error {
+	for _, scope := range scopes {
+		if _, known := rbac.Permissions[scope]; !known || !rbac.HasPermission(permissions, scope) {
+			return pkgErr.InvalidScopeError{Scope: scope}
+		}
+	}
+
+	return nil
+}
+
+func (s *APIKeyService) hashKey(key string) (string, error) {
+	return pkg.HashToken(s.config.AuthConfig.RefreshTokenSecret, key)
+}
+
+// Create issues a key for the organisation and returns it with the plain key, which is never shown again.
+// Scopes are limited to the permissions of the creator so a key can never do more than its creator.
+func (s *APIKeyService) Create(ctx context.Context, user *models.User, permissions []string, organisationId int64, name string, scopes []string, expireAt *time.Time) (*APIKey, string, error) {
+	_, err := s.orgRepo.FindById(ctx, organisationId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "", pkgErr.NotFoundError{Resource: "organisation"}
+	}
+
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error fetching organisation")
+	}
+
+	if err := checkGrantableScopes(permissions, scopes); err != nil {
+		return nil, "", err
+	}
+
+	prefix, err := pkg.GenerateRandomString(apiKeyPrefixLength)
+
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error generating api key prefix")
+	}
+
+	secret, err := pkg.GenerateRandomString(apiKeySecretLength)
+
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error generating api key secret")
+	}
+
+	plain := fmt.Sprintf("%s_%s_%s", apiKeyType, prefix, secret)
+	hashed, err := s.hashKey(plain)
+
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error hashing api key")
+	}
+
+	data := &models.APIKeySetter{
+		OrganisationID: omit.From(organisationId),
+		UserID:         omit.From(user.ID),
+		Name:           omit.From(name),
+		Prefix:         omit.From(prefix),
+		KeyHash:        omit.From(hashed),
+		Scopes:         omit.From(pq.StringArray(scopes)),
+	}
+
+	if expireAt != nil {
+		data.ExpireAt = omitnull.From(*expireAt)
+	}
+
+	created, err := s.repo.Create(ctx, data)
+
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error storing api key")
+	}
+
+	apiKey := toAPIKey(created)
+	changes, err := audit.Diff(nil, apiKey)
+
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error diffing api key")
+	}
+
+	s.audit.Record(ctx, AuditEntry{
+		Action:         audit.ActionAPIKeyCreate,
+		OrganisationID: organisationId,
+		EntityType:     audit.EntityAPIKey,
+		EntityID:       apiKey.ID,
+		Changes:        changes,
+	})
+
+	return &apiKey, plain, nil
+}
+
+func (s *APIKeyService) List(ctx context.Context, organisationId int64) ([]APIKey, error) {
+	keys, err := s.repo.ListByOrganisationID(ctx, organisationId)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching api keys")
+	}
+
+	apiKeys := make([]APIKey, 0, len(keys))
+	for _, key := range keys {
+		apiKeys = append(apiKeys, toAPIKey(key))
+	}
+
+	return apiKeys, nil
+}
+
+func (s *APIKeyService) Revoke(ctx context.Context, organisationId int64, id int64) error {
+	key, err := s.repo.FindByOrganisationID(ctx, organisationId, id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return pkgErr.NotFoundError{Resource: "api key"}
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "error fetching api key")
+	}
+
+	if key.RevokedAt.IsValue() {
+		return nil
+	}
+
+	before := toAPIKey(key)
+
+	if err := s.repo.Revoke(ctx, key); err != nil {
+		return errors.Wrap(err, "error revoking api key")
+	}
+
+	changes, err := audit.Diff(before, toAPIKey(key))
+
+	if err != nil {
+		return errors.Wrap(err, "error diffing api key")
+	}
+
+	s.audit.Record(ctx, AuditEntry{
+		Action:         audit.ActionAPIKeyRevoke,
+		OrganisationID: organisationId,
+		EntityType:     audit.EntityAPIKey,
+		EntityID:       key.ID,
+		Changes:        changes,
+	})
+
+	return nil
+}
+
+// Authenticate resolves a plain API key to the key and the user that created it.
+func (s *APIKeyService) Authenticate(ctx context.Context, plain string) (*models.APIKey, *models.User, error) {
+	parts := strings.Split(plain, "_")
+
+	if len(parts) != 3 || parts[0] != apiKeyType {
+		return nil, nil, pkgErr.InvalidTokenError{}
+	}
+
+	key, err := s.repo.FindByPrefix(ctx, parts[1])
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, pkgErr.InvalidTokenError{}
+	}
+
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error fetching api key")
+	}
+
+	hashed, err := s.hashKey(plain)
+
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error hashing api key")
+	}
+
+	if !hmac.Equal([]byte(hashed), []byte(key.KeyHash)) {
+		return nil, nil, pkgErr.InvalidTokenError{}
+	}
+
+	if key.RevokedAt.IsValue() {
+		return nil, nil, pkgErr.InvalidTokenError{}
+	}
+
+	if expireAt, isset := key.ExpireAt.Get(); isset && !expireAt.After(time.Now()) {
+		return nil, nil, pkgErr.InvalidTokenError{}
+	}
+
+	user, err := s.userRepo.FindByIdOrFail(ctx, key.UserID)
+
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error fetching api key owner")
+	}
+
+	// Keys stop working with their creator's account
+	if !user.DeletedAt.IsNull() || user.Status != enums.UserStatusesActive {
+		return nil, nil, pkgErr.InvalidTokenError{}
+	}
+
+	if err := s.repo.TouchLastUsed(ctx, key, apiKeyTouchInterval); err != nil {
+		return nil, nil, errors.Wrap(err, "error updating api key last used")
+	}
+
+	return key, user, nil
+}
+
+func NewAPIKeyService(
+	repo *repositories.APIKeyRepository,
+	orgRepo *repositories.OrganisationRepository,
+	userRepo *repositories.UserRepository,
+	auditService *AuditService,
+	config *config.Config,
+) *APIKeyService {
+	return &APIKeyService{
+		repo:     repo,
+		orgRepo:  orgRepo,
+		userRepo: userRepo,
+		audit:    auditService,
+		config:   config,
+	}
+}
@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/internal/repositories"
+	"github.com/jacoobjake/einvoice-api/pkg"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	"github.com/pkg/errors"
+	"github.com/stephenafamo/bob/types"
+)
+
+const auditExportBatchSize = 1000
+
+type AuditService struct {
+	repo *repositories.AuditEventRepository
+}
+
+// AuditEntry describes an event to record. The actor defaults to the one authenticated on the request.
+type AuditEntry struct {
+	Action         string
+	Actor          *audit.Actor
+	OrganisationID int64
+	EntityType     string
+	EntityID       int64
+	Changes        map[string]audit.Change
+	Metadata       map[string]any
+}
+
+type AuditEvent struct {
+	ID             int64           `json:"id"`
+	ActorType      string          `json:"actor_type"`
+	ActorID        *int64          `json:"actor_id"`
+	ActorLabel     *string         `json:"actor_label"`
+	OrganisationID *int64          `json:"organisation_id"`
+	Action         string          `json:"action"`
+	EntityType     *string         `json:"entity_type"`
+	EntityID       *string         `json:"entity_id"`
+	IPAddress      *string         `json:"ip_address"`
+	UserAgent      *string         `json:"user_agent"`
+	RequestID      *string         `json:"request_id"`
+	Changes        json.RawMessage `json:"changes"`
+	Metadata       json.RawMessage `json:"metadata"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+func UserActor(user *models.User) audit.Actor {
+	return audit.Actor{Type: audit.ActorUser, ID: user.ID, Label: user.Email}
+}
+
+func toAuditEvent(event *models.AuditEvent) AuditEvent {
+	e := AuditEvent{
+		ID:             event.ID,
+		ActorType:      event.ActorType,
+		ActorID:        event.ActorID.Ptr(),
+		ActorLabel:     event.ActorLabel.Ptr(),
+		OrganisationID: event.OrganisationID.Ptr(),
+		Action:         event.Action,
+		EntityType:     event.EntityType.Ptr(),
+		EntityID:       event.EntityID.Ptr(),
+		UserAgent:      event.UserAgent.Ptr(),
+		RequestID:      event.RequestID.Ptr(),
+		CreatedAt:      event.CreatedAt,
+	}
+
+	if ip, ok := event.IPAddress.Get(); ok {
+		addr := ip.Addr().String()
+		e.IPAddress = &addr
+	}
+
+	if changes, ok := event.Changes.Get(); ok {
+		e.Changes = changes.Val
+	}
+
+	if metadata, ok := event.Metadata.Get(); ok {
+		e.Metadata = metadata.Val
+	}
+
+	return e
+}
+
+func toJSONColumn(v any) (omitnull.Val[types.JSON[json.RawMessage]], error) {
+	raw, err := json.Marshal(v)
+
+	if err != nil {
+		return omitnull.Val[types.JSON[json.RawMessage]]{}, err
+	}
+
+	return omitnull.From(types.NewJSON(json.RawMessage(raw))), nil
+}
+
+// Record stores an audit event together with the client details of the request.
+// Failures are logged and never fail the action being audited.
+func (s *AuditService) Record(ctx context.Context, entry AuditEntry) {
+	actor := audit.Actor{Type: audit.ActorSystem}
+
+	if entry.Actor != nil {
+		actor = *entry.Actor
+	} else if ctxActor, ok := audit.GetCtxActor(ctx); ok {
+		actor = ctxActor
+	}
+
+	data := &models.AuditEventSetter{
+		ActorType: omit.From(actor.Type),
+		Action:    omit.From(entry.Action),
+	}
+
+	if actor.ID != 0 {
+		data.ActorID = omitnull.From(actor.ID)
+	}
+	if actor.Label != "" {
+		data.ActorLabel = omitnull.From(actor.Label)
+	}
+
+	organisationId := entry.OrganisationID
+	if organisationId == 0 {
+		organisationId = actor.OrganisationID
+	}
+	if organisationId != 0 {
+		data.OrganisationID = omitnull.From(organisationId)
+	}
+
+	if entry.EntityType != "" {
+		data.EntityType = omitnull.From(entry.EntityType)
+		data.EntityID = omitnull.From(strconv.FormatInt(entry.EntityID, 10))
+	}
+
+	if clientIp, ok := pkg.GetCtxClientIp(ctx); ok {
+		data.IPAddress = omitnull.From(clientIp)
+	}
+	if userAgent, ok := pkg.GetCtxUserAgent(ctx); ok && userAgent != "" {
+		data.UserAgent = omitnull.From(userAgent)
+	}
+	if requestId, ok := pkg.GetCtxRequestId(ctx); ok {
+		data.RequestID = omitnull.From(requestId)
+	}
+
+	var err error
+
+	if len(entry.Changes) > 0 {
+		if data.Changes, err = toJSONColumn(entry.Changes); err != nil {
+			log.Println("error encoding audit event changes", entry.Action, err)
+		}
+	}
+
+	if len(entry.Metadata) > 0 {
+		if data.Metadata, err = toJSONColumn(entry.Metadata); err != nil {
+			log.Println("error encoding audit event metadata", entry.Action, err)
+		}
+	}
+
+	// The event is still recorded when the client has already gone away
+	if _, err := s.repo.Create(context.WithoutCancel(ctx), data); err != nil {
+		log.Println("error recording audit event", entry.Action, err)
+	}
+}
+
+// List returns a page of events, newest first, and the number of matching events.
+func (s *AuditService) List(ctx context.Context, filter repositories.AuditEventFilter, page int, perPage int) ([]AuditEvent, int64, error) {
+	events, err := s.repo.List(ctx, filter, perPage, (page-1)*perPage)
+
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "error fetching audit events")
+	}
+
+	total, err := s.repo.Count(ctx, filter)
+
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "error counting audit events")
+	}
+
+	auditEvents := make([]AuditEvent, 0, len(events))
+	for _, event := range events {
+		auditEvents = append(auditEvents, toAuditEvent(event))
+	}
+
+	return auditEvents, total, nil
+}
+
+// Values starting with these are evaluated as formulas by spreadsheet applications
+const csvFormulaPrefixes = "=+-@\t\r"
+
+func csvValue[T any](v *T, format func(T) string) string {
+	if v == nil {
+		return ""
+	}
+
+	value := format(*v)
+
+	if value != "" && strings.ContainsRune(csvFormulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+func csvString(v string) string {
+	return v
+}
+
+func csvInt(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+// ExportCSV writes every matching event to w in id order, reading them in batches.
+func (s *AuditService) ExportCSV(ctx context.Context, filter repositories.AuditEventFilter, w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{
+		"id", "created_at", "actor_type", "actor_id", "actor_label", "organisation_id", "action",
+		"entity_type", "entity_id", "ip_address", "user_agent", "request_id", "changes", "metadata",
+	}
+
+	if err := writer.Write(header); err != nil {
+		return errors.Wrap(err, "error writing csv header")
+	}
+
+	var afterId int64
+
+	for {
+		events, err := s.repo.ListAfter(ctx, filter, afterId, auditExportBatchSize)
+
+		if err != nil {
+			return errors.Wrap(err, "error fetching audit events")
+		}
+
+		for _, event := range events {
+			e := toAuditEvent(event)
+			changes, metadata := string(e.Changes), string(e.Metadata)
+
+			record := []string{
+				csvInt(e.ID),
+				e.CreatedAt.UTC().Format(time.RFC3339),
+				csvString(e.ActorType),
+				csvValue(e.ActorID, csvInt),
+				csvValue(e.ActorLabel, csvString),
+				csvValue(e.OrganisationID, csvInt),
+				csvString(e.Action),
+				csvValue(e.EntityType, csvString),
+				csvValue(e.EntityID, csvString),
+				csvValue(e.IPAddress, csvString),
+				csvValue(e.UserAgent, csvString),
+				csvValue(e.RequestID, csvString),
+				csvValue(&changes, csvString),
+				csvValue(&metadata, csvString),
+			}
+
+			if err := writer.Write(record); err != nil {
+				return errors.Wrap(err, "error writing csv record")
+			}
+
+			afterId = e.ID
+		}
+
+		writer.Flush()
+
+		if err := writer.Error(); err != nil {
+			return errors.Wrap(err, "error flushing csv")
+		}
+
+		if len(events) < auditExportBatchSize {
+			return nil
+		}
+	}
+}
+
+func NewAuditService(repo *repositories.AuditEventRepository) *AuditService {
+	return &AuditService{repo: repo}
+}
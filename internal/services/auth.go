@@ -2,9 +2,7 @@ package services
 
 import (
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -17,45 +15,61 @@ import (
 	"github.com/jacoobjake/einvoice-api/internal/database/models"
 	"github.com/jacoobjake/einvoice-api/internal/repositories"
 	"github.com/jacoobjake/einvoice-api/pkg"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	"github.com/jacoobjake/einvoice-api/pkg/denylist"
 	pkgErr "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/keyring"
+	"github.com/jacoobjake/einvoice-api/pkg/mailer"
+	"github.com/jacoobjake/einvoice-api/pkg/password"
 	"github.com/jacoobjake/einvoice-api/pkg/redisclient"
+	"github.com/jacoobjake/einvoice-api/pkg/secretbox"
 	"github.com/pkg/errors"
 )
 
 type AuthService struct {
-	authRepo      *repositories.AuthTokenRepository
-	userRepo      *repositories.UserRepository
-	flRepo        *repositories.FailedLoginRepository
-	config        *config.Config
-	signingMethod jwt.SigningMethod
-	rdb           *redisclient.RedisClient
-	revokedPrefix string
+	authRepo             *repositories.AuthTokenRepository
+	userRepo             *repositories.UserRepository
+	flRepo               *repositories.FailedLoginRepository
+	seRepo               *repositories.SecurityEventRepository
+	mfaRepo              *repositories.MfaRecoveryCodeRepository
+	roleRepo             *repositories.RoleRepository
+	pwHistoryRepo        *repositories.PasswordHistoryRepository
+	audit                *AuditService
+	config               *config.Config
+	keyring              *keyring.Keyring
+	mailer               mailer.Mailer
+	secretBox            *secretbox.SecretBox
+	passwordPolicy       password.Policy
+	hasher               *password.Hasher
+	breached             *password.BreachedChecker
+	rdb                  *redisclient.RedisClient
+	denylist             denylist.TokenDenylist
+	revokedSessionPrefix string
+	verifyResendPrefix   string
+	mfaChallengePrefix   string
+	usedTOTPPrefix       string
 }
 
+// AuthClaims carries the user's permissions at the time of issue,
+// role changes take effect on the next token refresh.
 type AuthClaims struct {
-	UserID    int64
-	Email     string
-	SessionID uuid.UUID
+	UserID      int64
+	Email       string
+	SessionID   uuid.UUID
+	Permissions []string
 	jwt.RegisteredClaims
 }
 
-func (s *AuthService) getRevokedTokenKey(token string) string {
-	return fmt.Sprintf("%s%s", s.revokedPrefix, token)
+func (s *AuthService) getRevokedSessionKey(sessionId uuid.UUID) string {
+	return fmt.Sprintf("%s%s", s.revokedSessionPrefix, sessionId)
 }
 
-func (s *AuthService) hashRefreshToken(token string) (string, error) {
-	encrypted := hmac.New(sha256.New, []byte(s.config.AuthConfig.RefreshTokenSecret))
-	_, err := encrypted.Write([]byte(token))
-
-	if err != nil {
-		return "", errors.Wrap(err, "error encrypting token")
-	}
-
-	return hex.EncodeToString(encrypted.Sum(nil)), nil
+func (s *AuthService) hashToken(token string) (string, error) {
+	return pkg.HashToken(s.config.AuthConfig.RefreshTokenSecret, token)
 }
 
 func (s *AuthService) validateRefreshToken(ctx context.Context, plainToken string) (*models.AuthToken, error) {
-	encrypted, err := s.hashRefreshToken(plainToken)
+	encrypted, err := s.hashToken(plainToken)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "error hashing token")
@@ -75,6 +89,14 @@ func (s *AuthService) validateRefreshToken(ctx context.Context, plainToken strin
 		return nil, errors.New("invalid token type")
 	}
 
+	// A rotated token must never be presented again, treat it as stolen
+	if refreshToken.RotatedAt.IsValue() {
+		return nil, pkgErr.RefreshTokenReuseError{
+			UserID:    refreshToken.UserID,
+			SessionID: refreshToken.SessionID.GetOr(uuid.Nil),
+		}
+	}
+
 	expireAt, isset := refreshToken.ExpireAt.Get()
 
 	if !isset || !expireAt.After(time.Now()) {
@@ -107,7 +129,7 @@ func (s *AuthService) generateRefreshToken(ctx context.Context, user *models.Use
 	}
 
 	// Store encrypted version in DB
-	hashed, err := s.hashRefreshToken(refreshToken)
+	hashed, err := s.hashToken(refreshToken)
 
 	if err != nil {
 		return "", errors.Wrap(err, "error hashing refresh token")
@@ -115,14 +137,25 @@ func (s *AuthService) generateRefreshToken(ctx context.Context, user *models.Use
 
 	duration := time.Duration(authConfig.RefreshExpirationMin) * time.Minute
 
-	// Store refresh token in DB
-	_, err = s.authRepo.Create(ctx, &models.AuthTokenSetter{
+	data := &models.AuthTokenSetter{
 		UserID:    omit.From(user.ID),
 		ExpireAt:  omitnull.From(time.Now().Add(duration)),
 		Type:      omit.From(enums.AuthTokenTypesRefresh),
 		Token:     omit.From(hashed),
 		SessionID: omitnull.From(sessionId),
-	})
+	}
+
+	// Keep track of the client the session was refreshed from
+	if clientIp, ok := pkg.GetCtxClientIp(ctx); ok {
+		data.IPAddress = omitnull.From(clientIp)
+	}
+
+	if userAgent, ok := pkg.GetCtxUserAgent(ctx); ok {
+		data.UserAgent = omitnull.From(userAgent)
+	}
+
+	// Store refresh token in DB
+	_, err = s.authRepo.Create(ctx, data)
 
 	if err != nil {
 		return "", errors.Wrap(err, "error storing refresh token")
@@ -131,17 +164,124 @@ func (s *AuthService) generateRefreshToken(ctx context.Context, user *models.Use
 	return refreshToken, nil
 }
 
-func (s *AuthService) generateToken(ctx context.Context, user *models.User) (token string, refreshToken string, err error) {
-	var t *jwt.Token
+// issueUserToken stores a hashed single-use token of the given type, replacing any
+// unused token of the same type, and returns the plain token.
+func (s *AuthService) issueUserToken(ctx context.Context, userId int64, tokenType enums.AuthTokenTypes, ttl time.Duration) (string, error) {
+	if err := s.authRepo.InvalidateActiveTokensByUserID(ctx, userId, tokenType); err != nil {
+		return "", errors.Wrap(err, "error invalidating previous tokens")
+	}
+
+	plain, err := pkg.GenerateRandomString(48)
+
+	if err != nil {
+		return "", errors.Wrap(err, "error generating raw token")
+	}
+
+	hashed, err := s.hashToken(plain)
+
+	if err != nil {
+		return "", errors.Wrap(err, "error hashing token")
+	}
+
+	_, err = s.authRepo.Create(ctx, &models.AuthTokenSetter{
+		UserID:   omit.From(userId),
+		ExpireAt: omitnull.From(time.Now().Add(ttl)),
+		Type:     omit.From(tokenType),
+		Token:    omit.From(hashed),
+	})
+
+	if err != nil {
+		return "", errors.Wrap(err, "error storing token")
+	}
+
+	return plain, nil
+}
+
+// consumeUserToken validates a single-use token and marks it as used.
+func (s *AuthService) consumeUserToken(ctx context.Context, plain string, tokenType enums.AuthTokenTypes) (*models.AuthToken, error) {
+	hashed, err := s.hashToken(plain)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error hashing token")
+	}
+
+	token, err := s.authRepo.FindByToken(ctx, hashed)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, pkgErr.InvalidTokenError{}
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching token")
+	}
+
+	if token.Type != tokenType {
+		return nil, pkgErr.InvalidTokenError{}
+	}
+
+	consumed, err := s.authRepo.ConsumeToken(ctx, token)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error consuming token")
+	}
+
+	// Expired, or already used by a concurrent request
+	if !consumed {
+		return nil, pkgErr.InvalidTokenError{}
+	}
+
+	return token, nil
+}
+
+// revokeSession revokes every token of the session family and denylists
+// its outstanding access tokens until they would have expired anyway.
+func (s *AuthService) revokeSession(ctx context.Context, sessionId uuid.UUID) error {
+	if err := s.authRepo.RevokeTokensBySessionID(ctx, sessionId); err != nil {
+		return errors.Wrap(err, "error revoking session tokens")
+	}
+
+	// Outstanding access tokens of the session are issued no later than now
+	exp := time.Now().Add(time.Duration(s.config.AuthConfig.TokenExpirationMin) * time.Minute)
+
+	if err := s.denylist.Deny(ctx, s.getRevokedSessionKey(sessionId), exp); err != nil {
+		return errors.Wrap(err, "error denying session")
+	}
+
+	return nil
+}
+
+func (s *AuthService) handleRefreshTokenReuse(ctx context.Context, reuse pkgErr.RefreshTokenReuseError) error {
+	if reuse.SessionID != uuid.Nil {
+		if err := s.revokeSession(ctx, reuse.SessionID); err != nil {
+			return errors.Wrap(err, "error revoking session family")
+		}
+	}
+
+	_, err := s.seRepo.Record(ctx, enums.SecurityEventTypesRefreshTokenReuse, reuse.UserID, reuse.SessionID, nil)
+
+	if err != nil {
+		return errors.Wrap(err, "error recording refresh token reuse")
+	}
+
+	return nil
+}
+
+func (s *AuthService) generateToken(ctx context.Context, user *models.User, sessionId uuid.UUID) (token string, refreshToken string, err error) {
 	authConfig := s.config.AuthConfig
-	key := []byte(authConfig.JWTSecret)
-	sessionId := uuid.Must(uuid.NewV4())
+
+	permissions, err := s.roleRepo.ListPermissionNamesByUserID(ctx, user.ID)
+
+	if err != nil {
+		return "", "", errors.Wrap(err, "error fetching user permissions")
+	}
 
 	claims := AuthClaims{
 		user.ID,
 		user.Email,
 		sessionId,
+		permissions,
 		jwt.RegisteredClaims{
+			ID:        uuid.Must(uuid.NewV4()).String(),
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(authConfig.TokenExpirationMin) * time.Minute)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
@@ -149,9 +289,7 @@ func (s *AuthService) generateToken(ctx context.Context, user *models.User) (tok
 		},
 	}
 
-	t = jwt.NewWithClaims(s.signingMethod, claims)
-
-	signed, err := t.SignedString(key)
+	signed, err := s.signToken(claims)
 
 	if err != nil {
 		return "", "", errors.Wrap(err, "error signing token")
@@ -166,22 +304,74 @@ func (s *AuthService) generateToken(ctx context.Context, user *models.User) (tok
 	return signed, refreshToken, nil
 }
 
+// signToken signs claims with the keyring's current signing key, every JWT the API issues goes through here.
+func (s *AuthService) signToken(claims jwt.Claims) (string, error) {
+	key := s.keyring.SigningKey()
+
+	t := jwt.NewWithClaims(key.Method, claims)
+	t.Header["kid"] = key.ID
+
+	return t.SignedString(key.SignKey())
+}
+
+// parseClaims verifies the token signature and decodes it into claims.
+func (s *AuthService) parseClaims(token string, claims jwt.Claims) error {
+	_, err := jwt.ParseWithClaims(token, claims, s.keyring.Keyfunc, jwt.WithValidMethods(s.keyring.Methods()))
+
+	if err != nil {
+		return errors.Wrap(err, "error parsing jwt claims")
+	}
+
+	return nil
+}
+
 func (s *AuthService) parseToken(_ context.Context, token string) (claims jwt.Claims, err error) {
-	parsed, err := jwt.ParseWithClaims(token, &AuthClaims{}, func(token *jwt.Token) (any, error) {
-		return []byte(s.config.AuthConfig.JWTSecret), nil
-	}, jwt.WithValidMethods([]string{s.signingMethod.Alg()}))
+	authClaims := &AuthClaims{}
+
+	if err := s.parseClaims(token, authClaims); err != nil {
+		return nil, err
+	}
+
+	return authClaims, nil
+}
+
+// tokenID identifies the token on the denylist. Tokens issued before jti was
+// introduced fall back to a hash of the raw token.
+func (s *AuthService) tokenID(claims jwt.RegisteredClaims, token string) (string, error) {
+	if claims.ID != "" {
+		return claims.ID, nil
+	}
+
+	return s.hashToken(token)
+}
+
+// denyToken rejects the token until it would have expired anyway.
+func (s *AuthService) denyToken(ctx context.Context, claims jwt.RegisteredClaims, token string) error {
+	if claims.ExpiresAt == nil {
+		return errors.New("token has no exp")
+	}
+
+	jti, err := s.tokenID(claims, token)
 
 	if err != nil {
-		return nil, errors.Wrap(err, "error parsing jwt claims")
+		return errors.Wrap(err, "error identifying token")
 	}
 
-	claims, ok := parsed.Claims.(*AuthClaims)
+	if err := s.denylist.Deny(ctx, jti, claims.ExpiresAt.Time); err != nil {
+		return errors.Wrap(err, "error denying token")
+	}
 
-	if !ok {
-		return nil, errors.New("invalid claims")
+	return nil
+}
+
+func (s *AuthService) isTokenDenied(ctx context.Context, claims jwt.RegisteredClaims, token string) (bool, error) {
+	jti, err := s.tokenID(claims, token)
+
+	if err != nil {
+		return false, errors.Wrap(err, "error identifying token")
 	}
 
-	return claims, nil
+	return s.denylist.IsDenied(ctx, jti)
 }
 
 func (s *AuthService) verifyJWTToken(ctx context.Context, token string) (*AuthClaims, error) {
@@ -219,48 +409,51 @@ func (s *AuthService) verifyJWTToken(ctx context.Context, token string) (*AuthCl
 		return nil, errors.New("token is not valid yet")
 	}
 
+	// OAuth client tokens share the signing keys but carry no user
+	if authClaims.UserID == 0 {
+		return nil, errors.New("not a user token")
+	}
+
 	// Check if token is revoked
-	key := s.getRevokedTokenKey(token)
-	revoked, err := s.rdb.Exists(ctx, key)
+	revoked, err := s.isTokenDenied(ctx, authClaims.RegisteredClaims, token)
 
 	if err != nil {
-		return nil, errors.Wrapf(err, "error reading key: %s", key)
+		return nil, errors.Wrap(err, "error checking token revocation")
 	}
 
 	if revoked {
 		return nil, errors.New("token revoked")
 	}
 
-	return authClaims, nil
-}
-
-func (s *AuthService) isActiveUser(user *models.User) bool {
-	return user.DeletedAt.IsNull() && user.Status == enums.UserStatusesActive
-}
-
-func (s *AuthService) captureFailedLogin(ctx context.Context, user *models.User) (*models.User, error) {
-	_, err := s.flRepo.CaptureFailedLogin(ctx, user.ID)
+	// Check if the whole session is revoked
+	revoked, err = s.denylist.IsDenied(ctx, s.getRevokedSessionKey(authClaims.SessionID))
 
 	if err != nil {
-		return nil, errors.Wrap(err, "error created failed login record")
+		return nil, errors.Wrap(err, "error checking session revocation")
 	}
 
-	return user, nil
-}
+	if revoked {
+		return nil, errors.New("session revoked")
+	}
 
-func (s *AuthService) reachMaxLoginAttempts(ctx context.Context, user *models.User) error {
-	failedLogins, err := s.flRepo.GetFailedLoginCountByUserId(ctx, user.ID)
-	max := s.config.AuthConfig.MaxFailedLoginAttempts
+	return authClaims, nil
+}
 
-	if err != nil {
-		return errors.Wrap(err, "error fetching user max login attempts")
-	}
+// recordUserEvent audits an action on the user's own account, performed by the user.
+func (s *AuthService) recordUserEvent(ctx context.Context, user *models.User, action string, metadata map[string]any) {
+	actor := UserActor(user)
 
-	if failedLogins >= int64(max) {
-		return pkgErr.MaxLoginAttemptError{MaxAttempts: max}
-	}
+	s.audit.Record(ctx, AuditEntry{
+		Action:     action,
+		Actor:      &actor,
+		EntityType: audit.EntityUser,
+		EntityID:   user.ID,
+		Metadata:   metadata,
+	})
+}
 
-	return nil
+func (s *AuthService) isActiveUser(user *models.User) bool {
+	return user.DeletedAt.IsNull() && user.Status == enums.UserStatusesActive
 }
 
 func (s *AuthService) clearUserFailedLogins(ctx context.Context, user *models.User) error {
@@ -274,23 +467,44 @@ func (s *AuthService) clearUserFailedLogins(ctx context.Context, user *models.Us
 }
 
 func (s *AuthService) Token(ctx context.Context, email string, pw string) (rawToken string, refreshToken string, err error) {
+	// Check the client ip before touching the account so unknown emails are limited too
+	if err := s.reachMaxIPLoginAttempts(ctx); err != nil {
+		return "", "", errors.Wrap(err, "Error validating max ip login attempts")
+	}
 	user, err := s.userRepo.FindByEmailOrFail(ctx, email)
 	if err != nil {
+		s.captureFailedIPLogin(ctx)
 		return "", "", errors.Wrap(err, "error fetching user")
 	}
 	// Check max login attempts
 	if err := s.reachMaxLoginAttempts(ctx, user); err != nil {
 		return "", "", errors.Wrap(err, "Error validating max login attempts")
 	}
-	if err := pkg.ComparePassword([]byte(user.Password), []byte(pw)); err != nil {
+	if err := s.hasher.Compare(user.Password, pw); err != nil {
 		// Capture failed logins
 		s.captureFailedLogin(ctx, user)
-		return "", "", errors.New("password mismatch")
+		return "", "", errors.Wrap(err, "password mismatch")
+	}
+	// Upgrade hashes made with an outdated algorithm or cost while the plain password is at hand
+	s.rehashPassword(ctx, user, pw)
+	// Only reveal the verification state once the password is known to be correct
+	if s.blocksUnverifiedLogin(user) {
+		return "", "", pkgErr.EmailNotVerifiedError{}
+	}
+	// Second factor is completed through CompleteMFALogin
+	if s.isMFAEnabled(user) {
+		challenge, err := s.createMFAChallenge(ctx, user)
+		if err != nil {
+			return "", "", errors.Wrap(err, "error creating mfa challenge")
+		}
+		return "", "", pkgErr.MFARequiredError{ChallengeToken: challenge}
 	}
-	rawToken, refreshToken, err = s.generateToken(ctx, user)
+	sessionId := uuid.Must(uuid.NewV4())
+	rawToken, refreshToken, err = s.generateToken(ctx, user, sessionId)
 	if err != nil {
 		return "", "", errors.Wrap(err, "failed to generate token")
 	}
+	s.recordUserEvent(ctx, user, audit.ActionLogin, map[string]any{"method": "password", "session_id": sessionId})
 	// If successfully logged in, clear failed login
 	if err := s.clearUserFailedLogins(ctx, user); err != nil {
 		return "", "", errors.Wrap(err, "error clearing user failed login on successful token generation")
@@ -299,32 +513,28 @@ func (s *AuthService) Token(ctx context.Context, email string, pw string) (rawTo
 }
 
 func (s *AuthService) RevokeToken(ctx context.Context, token string) error {
-	key := s.getRevokedTokenKey(token)
-
-	exists, err := s.rdb.Exists(ctx, key)
+	claims, err := s.parseToken(ctx, token)
 
 	if err != nil {
-		return errors.Wrapf(err, "failed to read key: %s", key)
+		return errors.Wrap(err, "error parsing token")
 	}
 
-	// Only revoke if not already revoked
-	if exists {
-		return nil
-	}
+	authClaims := claims.(*AuthClaims)
 
-	err = s.rdb.Set(ctx, key, true, time.Duration(15*time.Minute))
+	exists, err := s.isTokenDenied(ctx, authClaims.RegisteredClaims, token)
 
 	if err != nil {
-		return errors.Wrapf(err, "failed to write key: %s", key)
+		return errors.Wrap(err, "error checking token revocation")
 	}
 
-	claims, err := s.parseToken(ctx, token)
-
-	if err != nil {
-		return errors.Wrap(err, "error parsing token")
+	// Only revoke if not already revoked
+	if exists {
+		return nil
 	}
 
-	authClaims := claims.(*AuthClaims)
+	if err := s.denyToken(ctx, authClaims.RegisteredClaims, token); err != nil {
+		return errors.Wrap(err, "error revoking token")
+	}
 
 	// Invalidate refresh token
 	err = s.invalidateActiveRefreshTokens(ctx, authClaims.SessionID)
@@ -333,12 +543,27 @@ func (s *AuthService) RevokeToken(ctx context.Context, token string) error {
 		return errors.Wrap(err, "error invalidating refresh token while revoking")
 	}
 
+	s.audit.Record(ctx, AuditEntry{
+		Action:     audit.ActionLogout,
+		EntityType: audit.EntityUser,
+		EntityID:   authClaims.UserID,
+		Metadata:   map[string]any{"session_id": authClaims.SessionID},
+	})
+
 	return nil
 }
 
 func (s *AuthService) RefreshToken(ctx context.Context, rtstr string) (rawToken string, newRefreshToken string, err error) {
 	rt, err := s.validateRefreshToken(ctx, rtstr)
 
+	var reuse pkgErr.RefreshTokenReuseError
+	if errors.As(err, &reuse) {
+		if err := s.handleRefreshTokenReuse(ctx, reuse); err != nil {
+			return "", "", errors.Wrap(err, "error handling refresh token reuse")
+		}
+		return "", "", errors.Wrap(reuse, "invalid refresh token")
+	}
+
 	if err != nil {
 		return "", "", errors.Wrap(err, "invalid refresh token")
 	}
@@ -347,6 +572,24 @@ func (s *AuthService) RefreshToken(ctx context.Context, rtstr string) (rawToken
 		return "", "", errors.New("refresh token not found")
 	}
 
+	// The new pair stays in the same session family
+	sessionId := rt.SessionID.GetOr(uuid.Must(uuid.NewV4()))
+
+	rotated, err := s.authRepo.MarkRotated(ctx, rt)
+
+	if err != nil {
+		return "", "", errors.Wrap(err, "error rotating refresh token")
+	}
+
+	// Another request rotated this token first
+	if !rotated {
+		reuse = pkgErr.RefreshTokenReuseError{UserID: rt.UserID, SessionID: sessionId}
+		if err := s.handleRefreshTokenReuse(ctx, reuse); err != nil {
+			return "", "", errors.Wrap(err, "error handling refresh token reuse")
+		}
+		return "", "", errors.Wrap(reuse, "invalid refresh token")
+	}
+
 	user, err := s.userRepo.FindByIdOrFail(ctx, rt.UserID)
 
 	if err != nil {
@@ -357,47 +600,86 @@ func (s *AuthService) RefreshToken(ctx context.Context, rtstr string) (rawToken
 		return "", "", errors.New("inactive user")
 	}
 
-	rawToken, newRefreshToken, err = s.generateToken(ctx, user)
+	if s.blocksUnverifiedLogin(user) {
+		return "", "", pkgErr.EmailNotVerifiedError{}
+	}
+
+	rawToken, newRefreshToken, err = s.generateToken(ctx, user, sessionId)
 	if err != nil {
 		return "", "", errors.Wrap(err, "failed to generate new token")
 	}
+	s.recordUserEvent(ctx, user, audit.ActionTokenRefresh, map[string]any{"session_id": sessionId})
 	return rawToken, newRefreshToken, nil
 }
 
-func (s *AuthService) VerifyToken(ctx context.Context, token string) (*models.User, error) {
+func (s *AuthService) VerifyToken(ctx context.Context, token string) (*models.User, *AuthClaims, error) {
 	claims, err := s.verifyJWTToken(ctx, token)
 
 	if err != nil {
-		return nil, errors.Wrap(err, "error verifying jwt token")
+		return nil, nil, errors.Wrap(err, "error verifying jwt token")
 	}
 
 	user, err := s.userRepo.FindByIdOrFail(ctx, claims.UserID)
 
 	if err != nil {
-		return nil, errors.Wrap(err, "error fetching user")
+		return nil, nil, errors.Wrap(err, "error fetching user")
 	}
 
 	if !s.isActiveUser(user) {
-		return nil, errors.New("user account inactive")
+		return nil, nil, errors.New("user account inactive")
+	}
+
+	if s.blocksUnverifiedLogin(user) {
+		return nil, nil, pkgErr.EmailNotVerifiedError{}
 	}
 
-	return user, nil
+	return user, claims, nil
+}
+
+// JWKS returns the public keys that downstream services can use to verify issued tokens.
+func (s *AuthService) JWKS() keyring.JWKSet {
+	return s.keyring.JWKS()
 }
 
 func NewAuthService(
 	authRepo *repositories.AuthTokenRepository,
 	userRepo *repositories.UserRepository,
 	flRepo *repositories.FailedLoginRepository,
+	seRepo *repositories.SecurityEventRepository,
+	mfaRepo *repositories.MfaRecoveryCodeRepository,
+	roleRepo *repositories.RoleRepository,
+	pwHistoryRepo *repositories.PasswordHistoryRepository,
+	auditService *AuditService,
 	config *config.Config,
 	rdb *redisclient.RedisClient,
+	tokenDenylist denylist.TokenDenylist,
+	kr *keyring.Keyring,
+	mail mailer.Mailer,
+	box *secretbox.SecretBox,
+	hasher *password.Hasher,
+	breached *password.BreachedChecker,
 ) *AuthService {
 	return &AuthService{
-		authRepo:      authRepo,
-		userRepo:      userRepo,
-		flRepo:        flRepo,
-		config:        config,
-		signingMethod: jwt.SigningMethodHS256,
-		rdb:           rdb,
-		revokedPrefix: "revoked:",
+		authRepo:             authRepo,
+		userRepo:             userRepo,
+		flRepo:               flRepo,
+		seRepo:               seRepo,
+		mfaRepo:              mfaRepo,
+		roleRepo:             roleRepo,
+		pwHistoryRepo:        pwHistoryRepo,
+		audit:                auditService,
+		config:               config,
+		keyring:              kr,
+		mailer:               mail,
+		secretBox:            box,
+		passwordPolicy:       password.NewPolicy(config.PasswordConfig),
+		hasher:               hasher,
+		breached:             breached,
+		rdb:                  rdb,
+		denylist:             tokenDenylist,
+		revokedSessionPrefix: "revoked_session:",
+		verifyResendPrefix:   "email_verification_resend:",
+		mfaChallengePrefix:   "mfa_challenge:",
+		usedTOTPPrefix:       "mfa_totp_used:",
 	}
 }
@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jacoobjake/einvoice-api/config/auth"
+	"github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	pkgErr "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/mailer"
+	"github.com/pkg/errors"
+)
+
+func (s *AuthService) getVerificationResendKey(email string) string {
+	return fmt.Sprintf("%s%s", s.verifyResendPrefix, strings.ToLower(email))
+}
+
+func (s *AuthService) isEmailVerified(user *models.User) bool {
+	return user.EmailVerifiedAt.IsValue()
+}
+
+// HasRequiredEmailVerification reports whether the user satisfies the email verification
+// policy for restricted actions such as issuing invoices.
+func (s *AuthService) HasRequiredEmailVerification(user *models.User) bool {
+	return s.config.AuthConfig.EmailVerifyPolicy == auth.EmailVerificationOptional || s.isEmailVerified(user)
+}
+
+// blocksUnverifiedLogin reports whether unverified users are denied access altogether.
+func (s *AuthService) blocksUnverifiedLogin(user *models.User) bool {
+	return s.config.AuthConfig.EmailVerifyPolicy == auth.EmailVerificationBlock && !s.isEmailVerified(user)
+}
+
+// SendEmailVerification emails a verification link to a user whose email is not verified yet.
+func (s *AuthService) SendEmailVerification(ctx context.Context, user *models.User) error {
+	if s.isEmailVerified(user) {
+		return nil
+	}
+
+	authConfig := s.config.AuthConfig
+	ttl := time.Duration(authConfig.EmailVerifyExpMin) * time.Minute
+
+	token, err := s.issueUserToken(ctx, user.ID, enums.AuthTokenTypesEmailVerification, ttl)
+
+	if err != nil {
+		return errors.Wrap(err, "error issuing email verification token")
+	}
+
+	err = s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: fmt.Sprintf("Verify your %s email address", s.config.AppName),
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nPlease confirm your email address using the link below. It expires in %d minutes.\n\n%s?token=%s\n",
+			user.FirstName, authConfig.EmailVerifyExpMin, authConfig.EmailVerifyURL, url.QueryEscape(token),
+		),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "error sending email verification mail")
+	}
+
+	return nil
+}
+
+// ResendEmailVerification sends a new verification link, at most once per cooldown per email.
+// The cooldown applies to unknown emails as well so callers cannot tell whether an email is registered.
+func (s *AuthService) ResendEmailVerification(ctx context.Context, email string) error {
+	key := s.getVerificationResendKey(email)
+	cooldown := time.Duration(s.config.AuthConfig.EmailVerifyResendSec) * time.Second
+
+	allowed, err := s.rdb.SetNX(ctx, key, true, cooldown)
+
+	if err != nil {
+		return errors.Wrapf(err, "failed to write key: %s", key)
+	}
+
+	if !allowed {
+		ttl, err := s.rdb.TTL(ctx, key)
+
+		if err != nil {
+			return errors.Wrapf(err, "failed to read key ttl: %s", key)
+		}
+
+		return pkgErr.TooManyRequestsError{RetryAfter: ttl}
+	}
+
+	user, err := s.userRepo.FindByEmail(ctx, email)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "error fetching user")
+	}
+
+	if !s.isActiveUser(user) {
+		return nil
+	}
+
+	// Send in the background so response time does not reveal whether the account exists
+	go func() {
+		if err := s.SendEmailVerification(context.WithoutCancel(ctx), user); err != nil {
+			log.Println("error resending email verification", err)
+		}
+	}()
+
+	return nil
+}
+
+// VerifyEmail marks the owner of the verification token as verified.
+func (s *AuthService) VerifyEmail(ctx context.Context, token string) error {
+	verifyToken, err := s.consumeUserToken(ctx, token, enums.AuthTokenTypesEmailVerification)
+
+	if err != nil {
+		return errors.Wrap(err, "error validating email verification token")
+	}
+
+	user, err := s.userRepo.FindByIdOrFail(ctx, verifyToken.UserID)
+
+	if err != nil {
+		return errors.Wrap(err, "error fetching user")
+	}
+
+	if s.isEmailVerified(user) {
+		return nil
+	}
+
+	_, err = s.userRepo.Update(ctx, user, &models.UserSetter{
+		EmailVerifiedAt: omitnull.From(time.Now()),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "error marking email as verified")
+	}
+
+	s.recordUserEvent(ctx, user, audit.ActionEmailVerify, map[string]any{"email": user.Email})
+
+	return nil
+}
+
+// RequestEmailChange keeps the new address as the user's pending email and sends it a confirmation link.
+// The account keeps its current email, which password resets go to, until the link is used, and the
+// current address is told about the request.
+func (s *AuthService) RequestEmailChange(ctx context.Context, user *models.User, email string) error {
+	_, err := s.userRepo.FindByEmail(ctx, email)
+
+	if err == nil {
+		return errEmailTaken
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrap(err, "error fetching user")
+	}
+
+	if _, err := s.userRepo.Update(ctx, user, &models.UserSetter{PendingEmail: omitnull.From(email)}); err != nil {
+		return errors.Wrap(err, "error storing pending email")
+	}
+
+	authConfig := s.config.AuthConfig
+	ttl := time.Duration(authConfig.EmailVerifyExpMin) * time.Minute
+
+	token, err := s.issueUserToken(ctx, user.ID, enums.AuthTokenTypesEmailChange, ttl)
+
+	if err != nil {
+		return errors.Wrap(err, "error issuing email change token")
+	}
+
+	err = s.mailer.Send(ctx, mailer.Message{
+		To:      email,
+		Subject: fmt.Sprintf("Confirm your new %s email address", s.config.AppName),
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nPlease confirm this is your new email address using the link below. It expires in %d minutes.\n\n%s?token=%s\n",
+			user.FirstName, authConfig.EmailVerifyExpMin, authConfig.EmailChangeURL, url.QueryEscape(token),
+		),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "error sending email change mail")
+	}
+
+	err = s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: fmt.Sprintf("Your %s email address is being changed", s.config.AppName),
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nA change of your email address to %s was requested. It takes effect once confirmed from the new address.\n\nIf this was not you, change your password now.\n",
+			user.FirstName, email,
+		),
+	})
+
+	if err != nil {
+		log.Println("error sending email change notice", err)
+	}
+
+	return nil
+}
+
+// ConfirmEmailChange switches the owner of the token to their pending email, verified by the confirmation.
+func (s *AuthService) ConfirmEmailChange(ctx context.Context, token string) error {
+	changeToken, err := s.consumeUserToken(ctx, token, enums.AuthTokenTypesEmailChange)
+
+	if err != nil {
+		return errors.Wrap(err, "error validating email change token")
+	}
+
+	user, err := s.userRepo.FindByIdOrFail(ctx, changeToken.UserID)
+
+	if err != nil {
+		return errors.Wrap(err, "error fetching user")
+	}
+
+	email, ok := user.PendingEmail.Get()
+
+	if !ok {
+		return pkgErr.InvalidTokenError{}
+	}
+
+	previous := user.Email
+
+	_, err = s.userRepo.Update(ctx, user, &models.UserSetter{
+		Email:           omit.From(email),
+		PendingEmail:    omitnull.FromPtr[string](nil),
+		EmailVerifiedAt: omitnull.From(time.Now()),
+	})
+
+	// Taken by another account since the change was requested
+	if isDuplicateEmail(err) {
+		return errEmailTaken
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "error changing email")
+	}
+
+	actor := UserActor(user)
+	s.audit.Record(ctx, AuditEntry{
+		Action:     audit.ActionEmailChange,
+		Actor:      &actor,
+		EntityType: audit.EntityUser,
+		EntityID:   user.ID,
+		Changes:    map[string]audit.Change{"email": {Old: previous, New: email}},
+	})
+
+	return nil
+}
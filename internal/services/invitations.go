@@ -0,0 +1,457 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/internal/repositories"
+	"github.com/jacoobjake/einvoice-api/pkg"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	pkgErr "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/mailer"
+	"github.com/jacoobjake/einvoice-api/pkg/rbac"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+type InvitationService struct {
+	repo        *repositories.InvitationRepository
+	userRepo    *repositories.UserRepository
+	roleRepo    *repositories.RoleRepository
+	authService *AuthService
+	audit       *AuditService
+}
+
+type Invitation struct {
+	ID             int64      `json:"id"`
+	Email          string     `json:"email"`
+	Role           string     `json:"role"`
+	Status         string     `json:"status"`
+	InvitedBy      *int64     `json:"invited_by"`
+	ExpireAt       time.Time  `json:"expire_at"`
+	AcceptedAt     *time.Time `json:"accepted_at"`
+	AcceptedUserID *int64     `json:"accepted_user_id"`
+	RevokedAt      *time.Time `json:"revoked_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+func invitationStatus(invitation *models.Invitation) string {
+	switch {
+	case invitation.AcceptedAt.IsValue():
+		return repositories.InvitationAccepted
+	case invitation.RevokedAt.IsValue():
+		return repositories.InvitationRevoked
+	case !invitation.ExpireAt.After(time.Now()):
+		return repositories.InvitationExpired
+	default:
+		return repositories.InvitationPending
+	}
+}
+
+func toInvitation(invitation *models.Invitation, role string) Invitation {
+	return Invitation{
+		ID:             invitation.ID,
+		Email:          invitation.Email,
+		Role:           role,
+		Status:         invitationStatus(invitation),
+		InvitedBy:      invitation.InvitedBy.Ptr(),
+		ExpireAt:       invitation.ExpireAt,
+		AcceptedAt:     invitation.AcceptedAt.Ptr(),
+		AcceptedUserID: invitation.AcceptedUserID.Ptr(),
+		RevokedAt:      invitation.RevokedAt.Ptr(),
+		CreatedAt:      invitation.CreatedAt.GetOrZero(),
+	}
+}
+
+func isDuplicateOpenInvitation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Constraint == repositories.InvitationOpenEmailIndex
+}
+
+var errAlreadyInvited = pkgErr.ConflictError{Reason: "email has already been invited"}
+
+func (s *InvitationService) expiry() (time.Time, int) {
+	hours := s.authService.config.AuthConfig.InvitationExpHours
+	return time.Now().Add(time.Duration(hours) * time.Hour), hours
+}
+
+// newToken returns a random invitation token and the hash that is stored in its place.
+func (s *InvitationService) newToken() (string, string, error) {
+	plain, err := pkg.GenerateRandomString(48)
+
+	if err != nil {
+		return "", "", errors.Wrap(err, "error generating raw token")
+	}
+
+	hashed, err := s.authService.hashToken(plain)
+
+	if err != nil {
+		return "", "", errors.Wrap(err, "error hashing token")
+	}
+
+	return plain, hashed, nil
+}
+
+// roleNames maps role ids to names for listing invitations.
+func (s *InvitationService) roleNames(ctx context.Context) (map[int64]string, error) {
+	roles, err := s.roleRepo.List(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching roles")
+	}
+
+	names := make(map[int64]string, len(roles))
+	for _, role := range roles {
+		names[role.ID] = role.Name
+	}
+
+	return names, nil
+}
+
+func (s *InvitationService) view(ctx context.Context, invitation *models.Invitation) (Invitation, error) {
+	names, err := s.roleNames(ctx)
+
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	return toInvitation(invitation, names[invitation.RoleID]), nil
+}
+
+func (s *InvitationService) findInvitation(ctx context.Context, invitationId int64) (*models.Invitation, error) {
+	invitation, err := s.repo.FindById(ctx, invitationId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, pkgErr.NotFoundError{Resource: "invitation"}
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching invitation")
+	}
+
+	return invitation, nil
+}
+
+// checkGrantableRole makes sure an invitation never grants more than the user sending it holds.
+func (s *InvitationService) checkGrantableRole(ctx context.Context, permissions []string, role *models.Role) error {
+	rolePermissions, err := s.roleRepo.ListPermissionNamesByRoleID(ctx, role.ID)
+
+	if err != nil {
+		return errors.Wrap(err, "error fetching role permissions")
+	}
+
+	for _, permission := range rolePermissions {
+		if !rbac.HasPermission(permissions, permission) {
+			return pkgErr.InvalidRoleError{Role: role.Name}
+		}
+	}
+
+	return nil
+}
+
+// recordChange audits the invitation change, actor is nil for the user authenticated on the request.
+func (s *InvitationService) recordChange(ctx context.Context, action string, actor *audit.Actor, before any, after Invitation) {
+	changes, err := audit.Diff(before, after)
+
+	if err != nil {
+		log.Println("error diffing invitation", err)
+	}
+
+	s.audit.Record(ctx, AuditEntry{
+		Action:     action,
+		Actor:      actor,
+		EntityType: audit.EntityInvitation,
+		EntityID:   after.ID,
+		Changes:    changes,
+	})
+}
+
+func (s *InvitationService) sendInvitation(ctx context.Context, invitation *models.Invitation, role string, token string, hours int) error {
+	appName := s.authService.config.AppName
+
+	err := s.authService.mailer.Send(ctx, mailer.Message{
+		To:      invitation.Email,
+		Subject: fmt.Sprintf("You have been invited to %s", appName),
+		Body: fmt.Sprintf(
+			"Hi,\n\nYou have been invited to join %s as %s. Accept the invitation and choose your password using the link below. It expires in %d hours.\n\n%s?token=%s\n",
+			appName, role, hours, s.authService.config.AuthConfig.InvitationURL, url.QueryEscape(token),
+		),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "error sending invitation mail")
+	}
+
+	return nil
+}
+
+// Invite emails a single-use link to create an account with the role.
+// The role is limited to the permissions of the inviter, and an expired invitation to the same email is replaced.
+func (s *InvitationService) Invite(ctx context.Context, inviter *models.User, permissions []string, email string, roleName string) (Invitation, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	role, err := s.roleRepo.FindByName(ctx, roleName)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return Invitation{}, pkgErr.InvalidRoleError{Role: roleName}
+	}
+
+	if err != nil {
+		return Invitation{}, errors.Wrap(err, "error fetching role")
+	}
+
+	if err := s.checkGrantableRole(ctx, permissions, role); err != nil {
+		return Invitation{}, err
+	}
+
+	exists, err := s.userRepo.ExistsByEmail(ctx, email)
+
+	if err != nil {
+		return Invitation{}, errors.Wrap(err, "error checking email")
+	}
+
+	if exists {
+		return Invitation{}, errEmailTaken
+	}
+
+	open, err := s.repo.FindOpenByEmail(ctx, email)
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return Invitation{}, errors.Wrap(err, "error fetching open invitation")
+	}
+
+	if open != nil {
+		if invitationStatus(open) == repositories.InvitationPending {
+			return Invitation{}, errAlreadyInvited
+		}
+
+		// Expired invitations still hold the email, close it to make way for the new one
+		if err := s.repo.Update(ctx, open, &models.InvitationSetter{RevokedAt: omitnull.From(time.Now())}); err != nil {
+			return Invitation{}, errors.Wrap(err, "error revoking expired invitation")
+		}
+	}
+
+	plain, hashed, err := s.newToken()
+
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	expireAt, hours := s.expiry()
+
+	invitation, err := s.repo.Create(ctx, &models.InvitationSetter{
+		Email:     omit.From(email),
+		RoleID:    omit.From(role.ID),
+		InvitedBy: omitnull.From(inviter.ID),
+		Token:     omit.From(hashed),
+		ExpireAt:  omit.From(expireAt),
+	})
+
+	if isDuplicateOpenInvitation(err) {
+		return Invitation{}, errAlreadyInvited
+	}
+
+	if err != nil {
+		return Invitation{}, errors.Wrap(err, "error creating invitation")
+	}
+
+	result := toInvitation(invitation, role.Name)
+	s.recordChange(ctx, audit.ActionInvitationCreate, nil, nil, result)
+
+	if err := s.sendInvitation(ctx, invitation, role.Name, plain, hours); err != nil {
+		return Invitation{}, err
+	}
+
+	return result, nil
+}
+
+// List returns a page of invitations and the number of matching invitations.
+func (s *InvitationService) List(ctx context.Context, filter repositories.InvitationFilter, page int, perPage int) ([]Invitation, int64, error) {
+	filter.Email = strings.ToLower(filter.Email)
+
+	invitations, err := s.repo.List(ctx, filter, perPage, (page-1)*perPage)
+
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "error fetching invitations")
+	}
+
+	total, err := s.repo.Count(ctx, filter)
+
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "error counting invitations")
+	}
+
+	names, err := s.roleNames(ctx)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]Invitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		result = append(result, toInvitation(invitation, names[invitation.RoleID]))
+	}
+
+	return result, total, nil
+}
+
+// Resend emails a new link and restarts the expiry, the previous link stops working.
+// Expired invitations can be resent as long as they have not been accepted or revoked.
+func (s *InvitationService) Resend(ctx context.Context, invitationId int64) (Invitation, error) {
+	invitation, err := s.findInvitation(ctx, invitationId)
+
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	if invitation.AcceptedAt.IsValue() || invitation.RevokedAt.IsValue() {
+		return Invitation{}, pkgErr.ConflictError{Reason: fmt.Sprintf("invitation has been %s", invitationStatus(invitation))}
+	}
+
+	before, err := s.view(ctx, invitation)
+
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	plain, hashed, err := s.newToken()
+
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	expireAt, hours := s.expiry()
+
+	err = s.repo.Update(ctx, invitation, &models.InvitationSetter{
+		Token:    omit.From(hashed),
+		ExpireAt: omit.From(expireAt),
+	})
+
+	if err != nil {
+		return Invitation{}, errors.Wrap(err, "error renewing invitation")
+	}
+
+	result := toInvitation(invitation, before.Role)
+	s.recordChange(ctx, audit.ActionInvitationResend, nil, before, result)
+
+	if err := s.sendInvitation(ctx, invitation, before.Role, plain, hours); err != nil {
+		return Invitation{}, err
+	}
+
+	return result, nil
+}
+
+// Revoke stops the invitation from being accepted. Revoking a revoked invitation is a no-op.
+func (s *InvitationService) Revoke(ctx context.Context, invitationId int64) (Invitation, error) {
+	invitation, err := s.findInvitation(ctx, invitationId)
+
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	if invitation.AcceptedAt.IsValue() {
+		return Invitation{}, pkgErr.ConflictError{Reason: "invitation has been accepted"}
+	}
+
+	before, err := s.view(ctx, invitation)
+
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	if invitation.RevokedAt.IsValue() {
+		return before, nil
+	}
+
+	if err := s.repo.Update(ctx, invitation, &models.InvitationSetter{RevokedAt: omitnull.From(time.Now())}); err != nil {
+		return Invitation{}, errors.Wrap(err, "error revoking invitation")
+	}
+
+	result := toInvitation(invitation, before.Role)
+	s.recordChange(ctx, audit.ActionInvitationRevoke, nil, before, result)
+
+	return result, nil
+}
+
+// Accept creates the invited account with the password chosen by the invitee and assigns the invited role,
+// closing the invitation in the same transaction so it cannot be accepted twice. The email is verified by
+// the invitee having received the link.
+func (s *InvitationService) Accept(ctx context.Context, token string, firstName string, lastName string, password string) (User, error) {
+	hashed, err := s.authService.hashToken(token)
+
+	if err != nil {
+		return User{}, errors.Wrap(err, "error hashing token")
+	}
+
+	invitation, err := s.repo.FindByToken(ctx, hashed)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return User{}, pkgErr.InvalidTokenError{}
+	}
+
+	if err != nil {
+		return User{}, errors.Wrap(err, "error fetching invitation")
+	}
+
+	if invitationStatus(invitation) != repositories.InvitationPending {
+		return User{}, pkgErr.InvalidTokenError{}
+	}
+
+	if err := s.authService.ValidatePassword(ctx, password, invitation.Email, firstName, lastName); err != nil {
+		return User{}, err
+	}
+
+	hashedPw, err := s.authService.hasher.Hash(password)
+
+	if err != nil {
+		return User{}, errors.Wrap(err, "error hashing password")
+	}
+
+	before, err := s.view(ctx, invitation)
+
+	if err != nil {
+		return User{}, err
+	}
+
+	user, err := s.repo.Accept(ctx, invitation, &models.UserSetter{
+		FirstName:       omit.From(firstName),
+		LastName:        omit.From(lastName),
+		Email:           omit.From(invitation.Email),
+		Password:        omit.From(hashedPw),
+		Status:          omit.From(enums.UserStatusesActive),
+		EmailVerifiedAt: omitnull.From(time.Now()),
+	})
+
+	// Accepted by a concurrent request, or revoked or expired since the check above
+	if errors.Is(err, repositories.ErrInvitationClosed) {
+		return User{}, pkgErr.InvalidTokenError{}
+	}
+
+	if isDuplicateEmail(err) {
+		return User{}, errEmailTaken
+	}
+
+	if err != nil {
+		return User{}, errors.Wrap(err, "error accepting invitation")
+	}
+
+	s.authService.recordPasswordHistory(ctx, user.ID, user.Password)
+
+	actor := UserActor(user)
+	s.recordChange(ctx, audit.ActionInvitationAccept, &actor, before, toInvitation(invitation, before.Role))
+
+	return toUser(user), nil
+}
+
+func NewInvitationService(repo *repositories.InvitationRepository, userRepo *repositories.UserRepository, roleRepo *repositories.RoleRepository, authService *AuthService, auditService *AuditService) *InvitationService {
+	return &InvitationService{repo: repo, userRepo: userRepo, roleRepo: roleRepo, authService: authService, audit: auditService}
+}
@@ -0,0 +1,715 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jacoobjake/einvoice-api/internal/database/dberrors"
+	"github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/internal/repositories"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	"github.com/jacoobjake/einvoice-api/pkg/calculation"
+	pkgErr "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/invoice"
+	"github.com/jacoobjake/einvoice-api/pkg/lhdn"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stephenafamo/bob"
+)
+
+type InvoiceService struct {
+	repo         *repositories.InvoiceRepository
+	taxpayerRepo *repositories.TaxpayerProfileRepository
+	audit        *AuditService
+}
+
+var (
+	errInvoiceNumberTaken    = pkgErr.ConflictError{Reason: "invoice number is already used"}
+	errInvoiceNotDraft       = pkgErr.ConflictError{Reason: "only draft invoices can be changed"}
+	errTaxpayerProfileNotSet = pkgErr.ConflictError{Reason: "the organisation needs a taxpayer profile before issuing invoices"}
+	errNoteNotEditable       = pkgErr.ConflictError{Reason: "notes cannot be changed, delete the draft and create it again"}
+	errNoteOfNote            = pkgErr.ConflictError{Reason: "notes can only adjust an invoice"}
+	errInvoiceNotValid       = pkgErr.ConflictError{Reason: "only invoices validated by LHDN can be adjusted"}
+)
+
+func isDuplicateInvoiceNumber(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && dberrors.InvoiceErrors.ErrUniqueInvoicesOrganisationIdInvoiceNumberKey.Is(pqErr)
+}
+
+func nullableString(value string) omitnull.Val[string] {
+	if value == "" {
+		return omitnull.FromPtr[string](nil)
+	}
+
+	return omitnull.From(value)
+}
+
+func nullableDecimal(value decimal.NullDecimal) omitnull.Val[decimal.Decimal] {
+	if !value.Valid {
+		return omitnull.FromPtr[decimal.Decimal](nil)
+	}
+
+	return omitnull.From(value.Decimal)
+}
+
+func toNullDecimal(value null.Val[decimal.Decimal]) decimal.NullDecimal {
+	amount, ok := value.Get()
+
+	return decimal.NullDecimal{Decimal: amount, Valid: ok}
+}
+
+func nullableDate(value string) (omitnull.Val[time.Time], error) {
+	if value == "" {
+		return omitnull.FromPtr[time.Time](nil), nil
+	}
+
+	date, err := time.Parse(invoice.DateLayout, value)
+
+	if err != nil {
+		return omitnull.Val[time.Time]{}, errors.Wrapf(err, "error parsing date %q", value)
+	}
+
+	return omitnull.From(date), nil
+}
+
+func formatDate(value null.Val[time.Time]) string {
+	if date, ok := value.Get(); ok {
+		return date.Format(invoice.DateLayout)
+	}
+
+	return ""
+}
+
+func toInvoiceParty(party *models.InvoiceParty) *invoice.Party {
+	return &invoice.Party{
+		Name:                         party.Name,
+		TIN:                          party.Tin,
+		IDType:                       string(party.IDType),
+		IDValue:                      party.IDValue,
+		SSTRegistrationNumber:        party.SSTRegistrationNumber,
+		TourismTaxRegistrationNumber: party.TourismTaxRegistrationNumber.GetOrZero(),
+		MSICCode:                     party.MsicCode.GetOrZero(),
+		BusinessActivityDescription:  party.BusinessActivityDescription.GetOrZero(),
+		AddressLine1:                 party.AddressLine1,
+		AddressLine2:                 party.AddressLine2.GetOrZero(),
+		AddressLine3:                 party.AddressLine3.GetOrZero(),
+		PostalZone:                   party.PostalZone.GetOrZero(),
+		CityName:                     party.CityName,
+		StateCode:                    party.StateCode,
+		CountryCode:                  party.CountryCode,
+		Phone:                        party.Phone.GetOrZero(),
+		Email:                        party.Email.GetOrZero(),
+	}
+}
+
+func toAllowanceCharges(allowanceCharges models.InvoiceAllowanceChargeSlice) []invoice.AllowanceCharge {
+	result := make([]invoice.AllowanceCharge, 0, len(allowanceCharges))
+	for _, allowanceCharge := range allowanceCharges {
+		result = append(result, invoice.AllowanceCharge{
+			IsCharge: allowanceCharge.IsCharge,
+			Reason:   allowanceCharge.Reason.GetOrZero(),
+			Rate:     toNullDecimal(allowanceCharge.Rate),
+			Amount:   allowanceCharge.Amount,
+		})
+	}
+
+	return result
+}
+
+func toInvoiceLine(line *models.InvoiceLine) invoice.Line {
+	taxes := make([]invoice.TaxBreakdown, 0, len(line.R.InvoiceLineTaxBreakdowns))
+	for _, tax := range line.R.InvoiceLineTaxBreakdowns {
+		taxes = append(taxes, invoice.TaxBreakdown{
+			TaxType:         tax.TaxType,
+			Rate:            toNullDecimal(tax.Rate),
+			PerUnitAmount:   toNullDecimal(tax.PerUnitAmount),
+			ExemptionReason: tax.ExemptionReason.GetOrZero(),
+			TaxableAmount:   tax.TaxableAmount,
+			TaxAmount:       tax.TaxAmount,
+		})
+	}
+
+	return invoice.Line{
+		Number:             int(line.LineNumber),
+		ClassificationCode: line.ClassificationCode,
+		Description:        line.Description,
+		Quantity:           line.Quantity,
+		UnitCode:           line.UnitCode.GetOrZero(),
+		UnitPrice:          line.UnitPrice,
+		ProductTariffCode:  line.ProductTariffCode.GetOrZero(),
+		CountryOfOrigin:    line.CountryOfOrigin.GetOrZero(),
+		Taxes:              taxes,
+		AllowanceCharges:   toAllowanceCharges(line.R.InvoiceAllowanceCharges),
+		Subtotal:           line.Subtotal,
+		TotalExcludingTax:  line.TotalExcludingTax,
+		TaxAmount:          line.TaxAmount,
+	}
+}
+
+// toInvoice maps the invoice and the rows loaded in its R struct.
+func toInvoice(model *models.Invoice) invoice.Invoice {
+	result := invoice.Invoice{
+		ID:                  model.ID,
+		OrganisationID:      model.OrganisationID,
+		Number:              model.InvoiceNumber,
+		TypeCode:            model.TypeCode,
+		Status:              string(model.Status),
+		UUID:                model.UUID.GetOrZero(),
+		IssueDate:           model.IssueDate.Format(invoice.DateLayout),
+		CurrencyCode:        model.CurrencyCode,
+		ExchangeRate:        toNullDecimal(model.ExchangeRate),
+		BillingPeriodStart:  formatDate(model.BillingPeriodStart),
+		BillingPeriodEnd:    formatDate(model.BillingPeriodEnd),
+		BillingFrequency:    model.BillingFrequency.GetOrZero(),
+		PaymentMode:         model.PaymentMode.GetOrZero(),
+		PaymentTerms:        model.PaymentTerms.GetOrZero(),
+		SupplierBankAccount: model.SupplierBankAccount.GetOrZero(),
+		AllowanceCharges:    toAllowanceCharges(model.R.InvoiceAllowanceCharges),
+		Totals: invoice.Totals{
+			LineAmount:      model.TotalLineAmount,
+			AllowanceAmount: model.TotalAllowanceAmount,
+			ChargeAmount:    model.TotalChargeAmount,
+			ExcludingTax:    model.TotalExcludingTax,
+			TaxAmount:       model.TotalTaxAmount,
+			IncludingTax:    model.TotalIncludingTax,
+			RoundingAmount:  model.RoundingAmount,
+			PayableAmount:   model.PayableAmount,
+		},
+		CreatedAt: model.CreatedAt.GetOrZero(),
+		UpdatedAt: model.UpdatedAt.GetOrZero(),
+	}
+
+	if originalId, ok := model.OriginalInvoiceID.Get(); ok {
+		result.BillingReference = &invoice.DocumentReference{
+			InvoiceID: originalId,
+			UUID:      model.OriginalUUID.GetOrZero(),
+			Number:    model.OriginalInvoiceNumber.GetOrZero(),
+		}
+	}
+
+	for _, party := range model.R.InvoiceParties {
+		switch party.Role {
+		case enums.InvoicePartyRolesSupplier:
+			result.Supplier = toInvoiceParty(party)
+		case enums.InvoicePartyRolesBuyer:
+			result.Buyer = toInvoiceParty(party)
+		case enums.InvoicePartyRolesShippingRecipient:
+			result.ShippingRecipient = toInvoiceParty(party)
+		}
+	}
+
+	for _, line := range model.R.InvoiceLines {
+		result.Lines = append(result.Lines, toInvoiceLine(line))
+	}
+
+	if len(result.Lines) > 0 {
+		result.Totals.TaxSubtotals = calculation.TaxSubtotals(result.Lines)
+	}
+
+	return result
+}
+
+// profileParty is the snapshot of the taxpayer profile the organisation issues invoices under.
+func profileParty(profile *models.TaxpayerProfile) invoice.Party {
+	return invoice.Party{
+		Name:                         profile.Name,
+		TIN:                          profile.Tin,
+		IDType:                       string(profile.IDType),
+		IDValue:                      profile.IDValue,
+		SSTRegistrationNumber:        profile.SSTRegistrationNumber,
+		TourismTaxRegistrationNumber: profile.TourismTaxRegistrationNumber,
+		MSICCode:                     profile.MsicCode,
+		BusinessActivityDescription:  profile.BusinessActivityDescription,
+		AddressLine1:                 profile.AddressLine1,
+		AddressLine2:                 profile.AddressLine2.GetOrZero(),
+		AddressLine3:                 profile.AddressLine3.GetOrZero(),
+		PostalZone:                   profile.PostalZone.GetOrZero(),
+		CityName:                     profile.CityName,
+		StateCode:                    profile.StateCode,
+		CountryCode:                  profile.CountryCode,
+		Phone:                        profile.Phone,
+		Email:                        profile.Email.GetOrZero(),
+	}
+}
+
+func partySetter(role enums.InvoicePartyRoles, party invoice.Party) *models.InvoicePartySetter {
+	return &models.InvoicePartySetter{
+		Role:                         omit.From(role),
+		Name:                         omit.From(party.Name),
+		Tin:                          omit.From(party.TIN),
+		IDType:                       omit.From(enums.TaxpayerIDTypes(party.IDType)),
+		IDValue:                      omit.From(party.IDValue),
+		SSTRegistrationNumber:        omit.From(party.SSTRegistrationNumber),
+		TourismTaxRegistrationNumber: nullableString(party.TourismTaxRegistrationNumber),
+		MsicCode:                     nullableString(party.MSICCode),
+		BusinessActivityDescription:  nullableString(party.BusinessActivityDescription),
+		AddressLine1:                 omit.From(party.AddressLine1),
+		AddressLine2:                 nullableString(party.AddressLine2),
+		AddressLine3:                 nullableString(party.AddressLine3),
+		PostalZone:                   nullableString(party.PostalZone),
+		CityName:                     omit.From(party.CityName),
+		StateCode:                    omit.From(party.StateCode),
+		CountryCode:                  omit.From(party.CountryCode),
+		Phone:                        nullableString(party.Phone),
+		Email:                        nullableString(party.Email),
+	}
+}
+
+func allowanceChargeSetters(allowanceCharges []invoice.AllowanceCharge) []*models.InvoiceAllowanceChargeSetter {
+	result := make([]*models.InvoiceAllowanceChargeSetter, 0, len(allowanceCharges))
+	for _, allowanceCharge := range allowanceCharges {
+		result = append(result, &models.InvoiceAllowanceChargeSetter{
+			IsCharge: omit.From(allowanceCharge.IsCharge),
+			Reason:   nullableString(allowanceCharge.Reason),
+			Rate:     nullableDecimal(allowanceCharge.Rate),
+			Amount:   omit.From(allowanceCharge.Amount),
+		})
+	}
+
+	return result
+}
+
+// toDocument maps a draft to the rows to write. Lines are numbered in their order.
+func toDocument(draft invoice.Invoice) (repositories.InvoiceDocument, error) {
+	issueDate, err := time.Parse(invoice.DateLayout, draft.IssueDate)
+
+	if err != nil {
+		return repositories.InvoiceDocument{}, errors.Wrap(err, "error parsing issue date")
+	}
+
+	billingPeriodStart, err := nullableDate(draft.BillingPeriodStart)
+
+	if err != nil {
+		return repositories.InvoiceDocument{}, err
+	}
+
+	billingPeriodEnd, err := nullableDate(draft.BillingPeriodEnd)
+
+	if err != nil {
+		return repositories.InvoiceDocument{}, err
+	}
+
+	document := repositories.InvoiceDocument{
+		Invoice: &models.InvoiceSetter{
+			InvoiceNumber:        omit.From(draft.Number),
+			IssueDate:            omit.From(issueDate),
+			CurrencyCode:         omit.From(strings.ToUpper(draft.CurrencyCode)),
+			ExchangeRate:         nullableDecimal(draft.ExchangeRate),
+			BillingPeriodStart:   billingPeriodStart,
+			BillingPeriodEnd:     billingPeriodEnd,
+			BillingFrequency:     nullableString(draft.BillingFrequency),
+			PaymentMode:          nullableString(draft.PaymentMode),
+			PaymentTerms:         nullableString(draft.PaymentTerms),
+			SupplierBankAccount:  nullableString(draft.SupplierBankAccount),
+			TotalLineAmount:      omit.From(draft.Totals.LineAmount),
+			TotalAllowanceAmount: omit.From(draft.Totals.AllowanceAmount),
+			TotalChargeAmount:    omit.From(draft.Totals.ChargeAmount),
+			TotalExcludingTax:    omit.From(draft.Totals.ExcludingTax),
+			TotalTaxAmount:       omit.From(draft.Totals.TaxAmount),
+			TotalIncludingTax:    omit.From(draft.Totals.IncludingTax),
+			RoundingAmount:       omit.From(draft.Totals.RoundingAmount),
+			PayableAmount:        omit.From(draft.Totals.PayableAmount),
+		},
+		AllowanceCharges: allowanceChargeSetters(draft.AllowanceCharges),
+	}
+
+	if draft.TypeCode != "" {
+		document.Invoice.TypeCode = omit.From(draft.TypeCode)
+	}
+
+	if reference := draft.BillingReference; reference != nil {
+		document.Invoice.OriginalInvoiceID = omitnull.From(reference.InvoiceID)
+		document.Invoice.OriginalUUID = omitnull.From(reference.UUID)
+		document.Invoice.OriginalInvoiceNumber = omitnull.From(reference.Number)
+	}
+
+	if draft.Supplier != nil {
+		document.Parties = append(document.Parties, partySetter(enums.InvoicePartyRolesSupplier, *draft.Supplier))
+	}
+	if draft.Buyer != nil {
+		document.Parties = append(document.Parties, partySetter(enums.InvoicePartyRolesBuyer, *draft.Buyer))
+	}
+	if draft.ShippingRecipient != nil {
+		document.Parties = append(document.Parties, partySetter(enums.InvoicePartyRolesShippingRecipient, *draft.ShippingRecipient))
+	}
+
+	for i, line := range draft.Lines {
+		taxes := make([]*models.InvoiceLineTaxBreakdownSetter, 0, len(line.Taxes))
+		for _, tax := range line.Taxes {
+			taxes = append(taxes, &models.InvoiceLineTaxBreakdownSetter{
+				TaxType:         omit.From(tax.TaxType),
+				Rate:            nullableDecimal(tax.Rate),
+				PerUnitAmount:   nullableDecimal(tax.PerUnitAmount),
+				ExemptionReason: nullableString(tax.ExemptionReason),
+				TaxableAmount:   omit.From(tax.TaxableAmount),
+				TaxAmount:       omit.From(tax.TaxAmount),
+			})
+		}
+
+		document.Lines = append(document.Lines, repositories.InvoiceLineDocument{
+			Line: &models.InvoiceLineSetter{
+				LineNumber:         omit.From(int32(i + 1)),
+				ClassificationCode: omit.From(line.ClassificationCode),
+				Description:        omit.From(line.Description),
+				Quantity:           omit.From(line.Quantity),
+				UnitCode:           nullableString(line.UnitCode),
+				UnitPrice:          omit.From(line.UnitPrice),
+				ProductTariffCode:  nullableString(line.ProductTariffCode),
+				CountryOfOrigin:    nullableString(line.CountryOfOrigin),
+				Subtotal:           omit.From(line.Subtotal),
+				TotalExcludingTax:  omit.From(line.TotalExcludingTax),
+				TaxAmount:          omit.From(line.TaxAmount),
+			},
+			Taxes:            taxes,
+			AllowanceCharges: allowanceChargeSetters(line.AllowanceCharges),
+		})
+	}
+
+	return document, nil
+}
+
+func (s *InvoiceService) findInvoice(ctx context.Context, invoiceId int64) (*models.Invoice, error) {
+	model, err := s.repo.FindById(ctx, invoiceId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, pkgErr.NotFoundError{Resource: "invoice"}
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching invoice")
+	}
+
+	return model, nil
+}
+
+// findDocument returns the invoice with its parties, lines, allowances and charges.
+func (s *InvoiceService) findDocument(ctx context.Context, invoiceId int64) (*models.Invoice, error) {
+	model, err := s.findInvoice(ctx, invoiceId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.LoadDocument(ctx, model); err != nil {
+		return nil, errors.Wrap(err, "error loading invoice")
+	}
+
+	return model, nil
+}
+
+// prepare works the amounts of the draft out and fills its supplier in from the organisation's taxpayer profile,
+// or its buyer when the draft is a self-billed invoice the organisation issues on behalf of the supplier.
+func (s *InvoiceService) prepare(ctx context.Context, organisationId int64, draft invoice.Invoice) (repositories.InvoiceDocument, error) {
+	draft, err := calculation.Calculate(draft)
+
+	if err != nil {
+		return repositories.InvoiceDocument{}, err
+	}
+
+	profile, err := s.taxpayerRepo.FindByOrganisationID(ctx, organisationId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return repositories.InvoiceDocument{}, errTaxpayerProfileNotSet
+	}
+
+	if err != nil {
+		return repositories.InvoiceDocument{}, errors.Wrap(err, "error fetching taxpayer profile")
+	}
+
+	party := profileParty(profile)
+
+	if lhdn.IsSelfBilled(draft.TypeCode) {
+		if draft.Supplier == nil {
+			return repositories.InvoiceDocument{}, pkgErr.InvalidInvoiceError{Field: "Supplier", Reason: "self-billed invoices need a supplier"}
+		}
+
+		draft.Buyer = &party
+	} else {
+		draft.Supplier = &party
+	}
+
+	return toDocument(draft)
+}
+
+func (s *InvoiceService) recordChange(ctx context.Context, action string, organisationId int64, invoiceId int64, before any, after any) {
+	changes, err := audit.Diff(before, after)
+
+	if err != nil {
+		log.Println("error diffing invoice", err)
+	}
+
+	s.audit.Record(ctx, AuditEntry{
+		Action:         action,
+		OrganisationID: organisationId,
+		EntityType:     audit.EntityInvoice,
+		EntityID:       invoiceId,
+		Changes:        changes,
+	})
+}
+
+// Calculate previews the amounts, taxes and totals of a draft without saving it.
+func (s *InvoiceService) Calculate(draft invoice.Invoice) (invoice.Invoice, error) {
+	return calculation.Calculate(draft)
+}
+
+func (s *InvoiceService) Get(ctx context.Context, invoiceId int64) (invoice.Invoice, error) {
+	model, err := s.findDocument(ctx, invoiceId)
+
+	if err != nil {
+		return invoice.Invoice{}, err
+	}
+
+	return toInvoice(model), nil
+}
+
+// List returns a page of invoices without their lines, and the number of matching invoices.
+func (s *InvoiceService) List(ctx context.Context, filter repositories.InvoiceFilter, sort string, page int, perPage int) ([]invoice.Invoice, int64, error) {
+	// A leading "-" sorts descending, e.g. "-issue_date"
+	column, desc := strings.CutPrefix(sort, "-")
+
+	rows, err := s.repo.List(ctx, filter, column, desc, perPage, (page-1)*perPage)
+
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "error fetching invoices")
+	}
+
+	if err := s.repo.LoadParties(ctx, rows); err != nil {
+		return nil, 0, errors.Wrap(err, "error fetching invoice parties")
+	}
+
+	total, err := s.repo.Count(ctx, filter)
+
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "error counting invoices")
+	}
+
+	result := make([]invoice.Invoice, 0, len(rows))
+	for _, model := range rows {
+		result = append(result, toInvoice(model))
+	}
+
+	return result, total, nil
+}
+
+// create saves the document as a draft of the organisation.
+func (s *InvoiceService) create(ctx context.Context, organisationId int64, document repositories.InvoiceDocument) (invoice.Invoice, error) {
+	document.Invoice.OrganisationID = omit.From(organisationId)
+	document.Invoice.Status = omit.From(enums.InvoiceStatusesDraft)
+
+	if actor, ok := audit.GetCtxActor(ctx); ok && actor.Type == audit.ActorUser {
+		document.Invoice.CreatedBy = omitnull.From(actor.ID)
+	}
+
+	model, err := s.repo.Create(ctx, document)
+
+	if isDuplicateInvoiceNumber(err) {
+		return invoice.Invoice{}, errInvoiceNumberTaken
+	}
+
+	// The document check fails inside the transaction
+	var invalid pkgErr.InvalidInvoiceError
+	if errors.As(err, &invalid) {
+		return invoice.Invoice{}, invalid
+	}
+
+	if err != nil {
+		return invoice.Invoice{}, errors.Wrap(err, "error creating invoice")
+	}
+
+	result := toInvoice(model)
+	s.recordChange(ctx, audit.ActionInvoiceCreate, organisationId, model.ID, nil, result)
+
+	return result, nil
+}
+
+// Create calculates and saves a draft invoice of the organisation. The supplier is taken from its taxpayer profile,
+// or the buyer for self-billed invoices.
+func (s *InvoiceService) Create(ctx context.Context, organisationId int64, draft invoice.Invoice) (invoice.Invoice, error) {
+	document, err := s.prepare(ctx, organisationId, draft)
+
+	if err != nil {
+		return invoice.Invoice{}, err
+	}
+
+	return s.create(ctx, organisationId, document)
+}
+
+// availableCredit is what credit and refund notes can still take off the original: its payable amount less
+// its credit and refund notes, drafts included, plus its validated debit notes. Notes LHDN rejected,
+// or that were cancelled, adjust nothing.
+func availableCredit(original *models.Invoice, notes models.InvoiceSlice) decimal.Decimal {
+	available := original.PayableAmount
+	for _, note := range notes {
+		switch {
+		case note.Status == enums.InvoiceStatusesInvalid,
+			note.Status == enums.InvoiceStatusesCancelled,
+			note.Status == enums.InvoiceStatusesRejected:
+			continue
+		case lhdn.IsReduction(note.TypeCode):
+			available = available.Sub(note.PayableAmount)
+		case note.Status == enums.InvoiceStatusesValid:
+			available = available.Add(note.PayableAmount)
+		}
+	}
+
+	return available
+}
+
+// checkCredit keeps the credit and refund notes of the original, with one more of the payable amount,
+// within the original and its validated debit notes. Notes of the same invoice are checked one at a time.
+func (s *InvoiceService) checkCredit(original *models.Invoice, payable decimal.Decimal) func(ctx context.Context, exec bob.Executor) error {
+	return func(ctx context.Context, exec bob.Executor) error {
+		if err := s.repo.Lock(ctx, exec, original.ID); err != nil {
+			return err
+		}
+
+		notes, err := s.repo.FindNotes(ctx, exec, original.ID)
+
+		if err != nil {
+			return err
+		}
+
+		available := availableCredit(original, notes)
+
+		if payable.GreaterThan(available) {
+			return pkgErr.InvalidInvoiceError{
+				Field: "Lines",
+				Reason: fmt.Sprintf("credited amounts cannot exceed the %s %s left on invoice %s",
+					original.CurrencyCode, decimal.Max(available, decimal.Zero).StringFixed(2), original.InvoiceNumber),
+			}
+		}
+
+		return nil
+	}
+}
+
+// CreateNote saves a draft credit, debit or refund note adjusting an invoice validated by LHDN. The note
+// takes the parties, currency and exchange rate of the invoice and references its UUID and number.
+func (s *InvoiceService) CreateNote(ctx context.Context, organisationId int64, originalId int64, note invoice.Invoice) (invoice.Invoice, error) {
+	original, err := s.findDocument(ctx, originalId)
+
+	if err != nil {
+		return invoice.Invoice{}, err
+	}
+
+	if lhdn.IsAdjustment(original.TypeCode) {
+		return invoice.Invoice{}, errNoteOfNote
+	}
+
+	if original.Status != enums.InvoiceStatusesValid || original.UUID.GetOrZero() == "" {
+		return invoice.Invoice{}, errInvoiceNotValid
+	}
+
+	if lhdn.IsSelfBilled(note.TypeCode) != lhdn.IsSelfBilled(original.TypeCode) {
+		return invoice.Invoice{}, pkgErr.InvalidInvoiceError{
+			Field:  "TypeCode",
+			Reason: fmt.Sprintf("a %s cannot adjust a %s", lhdn.DocumentTypes[note.TypeCode], lhdn.DocumentTypes[original.TypeCode]),
+		}
+	}
+
+	source := toInvoice(original)
+	note.CurrencyCode = source.CurrencyCode
+	note.ExchangeRate = source.ExchangeRate
+	note.Supplier = source.Supplier
+	note.Buyer = source.Buyer
+	note.ShippingRecipient = source.ShippingRecipient
+	note.BillingReference = &invoice.DocumentReference{
+		InvoiceID: original.ID,
+		UUID:      source.UUID,
+		Number:    source.Number,
+	}
+
+	note, err = calculation.Calculate(note)
+
+	if err != nil {
+		return invoice.Invoice{}, err
+	}
+
+	document, err := toDocument(note)
+
+	if err != nil {
+		return invoice.Invoice{}, err
+	}
+
+	if lhdn.IsReduction(note.TypeCode) {
+		document.Check = s.checkCredit(original, note.Totals.PayableAmount)
+	}
+
+	return s.create(ctx, organisationId, document)
+}
+
+// Update replaces a draft invoice with the given one, refreshing the supplier, or the buyer of a self-billed
+// invoice, from the taxpayer profile.
+// Draft notes are recreated rather than changed.
+func (s *InvoiceService) Update(ctx context.Context, organisationId int64, invoiceId int64, draft invoice.Invoice) (invoice.Invoice, error) {
+	model, err := s.findDocument(ctx, invoiceId)
+
+	if err != nil {
+		return invoice.Invoice{}, err
+	}
+
+	if model.Status != enums.InvoiceStatusesDraft {
+		return invoice.Invoice{}, errInvoiceNotDraft
+	}
+
+	if model.OriginalInvoiceID.IsValue() {
+		return invoice.Invoice{}, errNoteNotEditable
+	}
+
+	document, err := s.prepare(ctx, organisationId, draft)
+
+	if err != nil {
+		return invoice.Invoice{}, err
+	}
+
+	before := toInvoice(model)
+	err = s.repo.Replace(ctx, model, document)
+
+	if isDuplicateInvoiceNumber(err) {
+		return invoice.Invoice{}, errInvoiceNumberTaken
+	}
+
+	if err != nil {
+		return invoice.Invoice{}, errors.Wrap(err, "error updating invoice")
+	}
+
+	result := toInvoice(model)
+	s.recordChange(ctx, audit.ActionInvoiceUpdate, organisationId, model.ID, before, result)
+
+	return result, nil
+}
+
+// Delete removes a draft invoice, documents sent to LHDN are kept.
+func (s *InvoiceService) Delete(ctx context.Context, organisationId int64, invoiceId int64) error {
+	model, err := s.findDocument(ctx, invoiceId)
+
+	if err != nil {
+		return err
+	}
+
+	if model.Status != enums.InvoiceStatusesDraft {
+		return errInvoiceNotDraft
+	}
+
+	if err := s.repo.Delete(ctx, model); err != nil {
+		return errors.Wrap(err, "error deleting invoice")
+	}
+
+	s.recordChange(ctx, audit.ActionInvoiceDelete, organisationId, model.ID, toInvoice(model), nil)
+
+	return nil
+}
+
+func NewInvoiceService(repo *repositories.InvoiceRepository, taxpayerRepo *repositories.TaxpayerProfileRepository, auditService *AuditService) *InvoiceService {
+	return &InvoiceService{repo: repo, taxpayerRepo: taxpayerRepo, audit: auditService}
+}
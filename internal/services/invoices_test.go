@@ -0,0 +1,99 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/pkg/lhdn"
+	"github.com/shopspring/decimal"
+)
+
+func testNote(typeCode string, status enums.InvoiceStatuses, payable string) *models.Invoice {
+	return &models.Invoice{
+		TypeCode:      typeCode,
+		Status:        status,
+		PayableAmount: decimal.RequireFromString(payable),
+	}
+}
+
+func TestAvailableCredit(t *testing.T) {
+	original := &models.Invoice{
+		TypeCode:      lhdn.DocumentTypeInvoice,
+		Status:        enums.InvoiceStatusesValid,
+		PayableAmount: decimal.RequireFromString("1000"),
+	}
+
+	tests := []struct {
+		name  string
+		notes models.InvoiceSlice
+		want  string
+	}{
+		{"no notes", nil, "1000"},
+		{
+			"draft and valid credit notes",
+			models.InvoiceSlice{
+				testNote(lhdn.DocumentTypeCreditNote, enums.InvoiceStatusesDraft, "100"),
+				testNote(lhdn.DocumentTypeCreditNote, enums.InvoiceStatusesValid, "250.50"),
+			},
+			"649.50",
+		},
+		{
+			"submitted credit note",
+			models.InvoiceSlice{testNote(lhdn.DocumentTypeCreditNote, enums.InvoiceStatusesSubmitted, "300")},
+			"700",
+		},
+		{
+			"refund note",
+			models.InvoiceSlice{testNote(lhdn.DocumentTypeRefundNote, enums.InvoiceStatusesValid, "400")},
+			"600",
+		},
+		{
+			"valid debit note raises the ceiling",
+			models.InvoiceSlice{
+				testNote(lhdn.DocumentTypeDebitNote, enums.InvoiceStatusesValid, "200"),
+				testNote(lhdn.DocumentTypeCreditNote, enums.InvoiceStatusesValid, "1100"),
+			},
+			"100",
+		},
+		{
+			"draft debit note",
+			models.InvoiceSlice{testNote(lhdn.DocumentTypeDebitNote, enums.InvoiceStatusesDraft, "200")},
+			"1000",
+		},
+		{
+			"rejected and cancelled notes",
+			models.InvoiceSlice{
+				testNote(lhdn.DocumentTypeCreditNote, enums.InvoiceStatusesRejected, "100"),
+				testNote(lhdn.DocumentTypeRefundNote, enums.InvoiceStatusesCancelled, "100"),
+				testNote(lhdn.DocumentTypeCreditNote, enums.InvoiceStatusesInvalid, "100"),
+				testNote(lhdn.DocumentTypeDebitNote, enums.InvoiceStatusesCancelled, "100"),
+			},
+			"1000",
+		},
+		{
+			"self-billed notes",
+			models.InvoiceSlice{
+				testNote(lhdn.DocumentTypeSelfBilledCreditNote, enums.InvoiceStatusesValid, "300"),
+				testNote(lhdn.DocumentTypeSelfBilledRefundNote, enums.InvoiceStatusesDraft, "100"),
+				testNote(lhdn.DocumentTypeSelfBilledDebitNote, enums.InvoiceStatusesValid, "50"),
+			},
+			"650",
+		},
+		{
+			"credited in full",
+			models.InvoiceSlice{testNote(lhdn.DocumentTypeCreditNote, enums.InvoiceStatusesValid, "1000")},
+			"0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := availableCredit(original, tt.notes)
+
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Errorf("availableCredit() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
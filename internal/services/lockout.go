@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/pkg"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	pkgErr "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/pkg/errors"
+)
+
+// Beyond this many doublings the back-off is capped anyway.
+const maxLockoutDoublings = 16
+
+func (s *AuthService) loginWindowStart() time.Time {
+	return time.Now().Add(-time.Duration(s.config.AuthConfig.LoginWindowMin) * time.Minute)
+}
+
+// lockoutDuration doubles the base lockout for every failure past the limit, up to the configured maximum.
+func (s *AuthService) lockoutDuration(failures int) time.Duration {
+	authConfig := s.config.AuthConfig
+	base := time.Duration(authConfig.LoginLockoutBaseSec) * time.Second
+	max := time.Duration(authConfig.LoginLockoutMaxMin) * time.Minute
+
+	excess := min(failures-authConfig.MaxFailedLoginAttempts, maxLockoutDoublings)
+	backoff := base << excess
+
+	if backoff > max {
+		return max
+	}
+
+	return backoff
+}
+
+func (s *AuthService) captureFailedLogin(ctx context.Context, user *models.User) {
+	if _, err := s.flRepo.CaptureFailedLogin(ctx, user.ID); err != nil {
+		log.Println("error creating failed login record", err)
+	}
+
+	// Failed login records are cleared on success, the audit log keeps them
+	s.audit.Record(ctx, AuditEntry{
+		Action:     audit.ActionLoginFailed,
+		Actor:      &audit.Actor{Type: audit.ActorAnonymous},
+		EntityType: audit.EntityUser,
+		EntityID:   user.ID,
+	})
+}
+
+func (s *AuthService) captureFailedIPLogin(ctx context.Context) {
+	if _, err := s.flRepo.CaptureFailedLoginByIP(ctx); err != nil {
+		log.Println("error creating failed login record", err)
+	}
+
+	s.audit.Record(ctx, AuditEntry{
+		Action: audit.ActionLoginFailed,
+		Actor:  &audit.Actor{Type: audit.ActorAnonymous},
+	})
+}
+
+// reachMaxLoginAttempts locks the account once it has too many recent failures.
+// The lock is lifted automatically after an exponential back-off from the latest failure.
+func (s *AuthService) reachMaxLoginAttempts(ctx context.Context, user *models.User) error {
+	max := s.config.AuthConfig.MaxFailedLoginAttempts
+	times, err := s.flRepo.ListAttemptTimesByUserID(ctx, user.ID, s.loginWindowStart(), max+maxLockoutDoublings)
+
+	if err != nil {
+		return errors.Wrap(err, "error fetching user failed logins")
+	}
+
+	if len(times) < max {
+		return nil
+	}
+
+	unlockAt := times[0].Add(s.lockoutDuration(len(times)))
+
+	if time.Now().Before(unlockAt) {
+		return pkgErr.MaxLoginAttemptError{MaxAttempts: max, UnlockAt: unlockAt}
+	}
+
+	return nil
+}
+
+// reachMaxIPLoginAttempts blocks a client ip with too many failures across all accounts
+// until enough of them fall outside the sliding window.
+func (s *AuthService) reachMaxIPLoginAttempts(ctx context.Context) error {
+	clientIp, ok := pkg.GetCtxClientIp(ctx)
+
+	if !ok {
+		return nil
+	}
+
+	max := s.config.AuthConfig.MaxFailedLoginsPerIP
+	times, err := s.flRepo.ListAttemptTimesByIP(ctx, clientIp, s.loginWindowStart(), max)
+
+	if err != nil {
+		return errors.Wrap(err, "error fetching ip failed logins")
+	}
+
+	if len(times) < max {
+		return nil
+	}
+
+	unlockAt := times[max-1].Add(time.Duration(s.config.AuthConfig.LoginWindowMin) * time.Minute)
+
+	return pkgErr.MaxLoginAttemptError{MaxAttempts: max, UnlockAt: unlockAt}
+}
+
+// UnlockUser clears the user's failed logins, lifting any lockout immediately.
+func (s *AuthService) UnlockUser(ctx context.Context, userId int64) error {
+	user, err := s.userRepo.FindById(ctx, userId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return pkgErr.NotFoundError{Resource: "user"}
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "error fetching user")
+	}
+
+	if err := s.clearUserFailedLogins(ctx, user); err != nil {
+		return errors.Wrap(err, "error unlocking user")
+	}
+
+	s.audit.Record(ctx, AuditEntry{
+		Action:     audit.ActionUserUnlock,
+		EntityType: audit.EntityUser,
+		EntityID:   user.ID,
+	})
+
+	return nil
+}
@@ -0,0 +1,314 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omitnull"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/pkg"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	pkgErr "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/redisclient"
+	"github.com/pkg/errors"
+	"github.com/pquerna/otp/totp"
+)
+
+const recoveryCodeCount = 10
+
+type TOTPEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+}
+
+func (s *AuthService) getMFAChallengeKey(hashedChallenge string) string {
+	return fmt.Sprintf("%s%s", s.mfaChallengePrefix, hashedChallenge)
+}
+
+func (s *AuthService) getUsedTOTPKey(userId int64, code string) string {
+	return fmt.Sprintf("%s%d:%s", s.usedTOTPPrefix, userId, code)
+}
+
+func (s *AuthService) isMFAEnabled(user *models.User) bool {
+	return user.TotpEnabledAt.IsValue()
+}
+
+// normalizeRecoveryCode ignores case and the separator so codes can be typed loosely.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
+
+func (s *AuthService) generateRecoveryCodes(ctx context.Context, userId int64) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+
+	for i := range codes {
+		raw, err := pkg.GenerateRandomString(10)
+
+		if err != nil {
+			return nil, errors.Wrap(err, "error generating recovery code")
+		}
+
+		raw = strings.ToLower(raw)
+		codes[i] = raw[:5] + "-" + raw[5:]
+
+		hashes[i], err = s.hashToken(raw)
+
+		if err != nil {
+			return nil, errors.Wrap(err, "error hashing recovery code")
+		}
+	}
+
+	if err := s.mfaRepo.ReplaceByUserID(ctx, userId, hashes); err != nil {
+		return nil, errors.Wrap(err, "error storing recovery codes")
+	}
+
+	return codes, nil
+}
+
+func (s *AuthService) validateTOTP(user *models.User, code string) (bool, error) {
+	sealed, isset := user.TotpSecret.Get()
+
+	if !isset {
+		return false, pkgErr.MFANotEnabledError{}
+	}
+
+	secret, err := s.secretBox.Open(sealed)
+
+	if err != nil {
+		return false, errors.Wrap(err, "error decrypting totp secret")
+	}
+
+	return totp.Validate(code, secret), nil
+}
+
+// verifySecondFactor accepts either a TOTP code or an unused recovery code.
+func (s *AuthService) verifySecondFactor(ctx context.Context, user *models.User, code string, recoveryCode string) (bool, error) {
+	if recoveryCode != "" {
+		hashed, err := s.hashToken(normalizeRecoveryCode(recoveryCode))
+
+		if err != nil {
+			return false, errors.Wrap(err, "error hashing recovery code")
+		}
+
+		return s.mfaRepo.Consume(ctx, user.ID, hashed)
+	}
+
+	valid, err := s.validateTOTP(user, code)
+
+	if err != nil || !valid {
+		return false, err
+	}
+
+	// A code stays valid for its whole skew window, only accept it once
+	key := s.getUsedTOTPKey(user.ID, code)
+	fresh, err := s.rdb.SetNX(ctx, key, true, 90*time.Second)
+
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to write key: %s", key)
+	}
+
+	return fresh, nil
+}
+
+func (s *AuthService) createMFAChallenge(ctx context.Context, user *models.User) (string, error) {
+	challenge, err := pkg.GenerateRandomString(48)
+
+	if err != nil {
+		return "", errors.Wrap(err, "error generating mfa challenge")
+	}
+
+	hashed, err := s.hashToken(challenge)
+
+	if err != nil {
+		return "", errors.Wrap(err, "error hashing mfa challenge")
+	}
+
+	key := s.getMFAChallengeKey(hashed)
+	ttl := time.Duration(s.config.AuthConfig.MFAChallengeExpMin) * time.Minute
+
+	if err := s.rdb.Set(ctx, key, user.ID, ttl); err != nil {
+		return "", errors.Wrapf(err, "failed to write key: %s", key)
+	}
+
+	return challenge, nil
+}
+
+// EnrollTOTP generates a new TOTP secret for the user. It only takes effect once confirmed.
+func (s *AuthService) EnrollTOTP(ctx context.Context, user *models.User) (*TOTPEnrollment, error) {
+	if s.isMFAEnabled(user) {
+		return nil, pkgErr.MFAAlreadyEnabledError{}
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      s.config.AppName,
+		AccountName: user.Email,
+	})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error generating totp secret")
+	}
+
+	sealed, err := s.secretBox.Seal(key.Secret())
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error encrypting totp secret")
+	}
+
+	_, err = s.userRepo.Update(ctx, user, &models.UserSetter{
+		TotpSecret: omitnull.From(sealed),
+	})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error storing totp secret")
+	}
+
+	return &TOTPEnrollment{Secret: key.Secret(), URI: key.URL()}, nil
+}
+
+// ConfirmTOTP enables 2FA once the user proves the authenticator works, and returns the recovery codes.
+func (s *AuthService) ConfirmTOTP(ctx context.Context, user *models.User, code string) ([]string, error) {
+	if s.isMFAEnabled(user) {
+		return nil, pkgErr.MFAAlreadyEnabledError{}
+	}
+
+	valid, err := s.validateTOTP(user, code)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error validating totp code")
+	}
+
+	if !valid {
+		return nil, pkgErr.InvalidMFACodeError{}
+	}
+
+	_, err = s.userRepo.Update(ctx, user, &models.UserSetter{
+		TotpEnabledAt: omitnull.From(time.Now()),
+	})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error enabling totp")
+	}
+
+	codes, err := s.generateRecoveryCodes(ctx, user.ID)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error generating recovery codes")
+	}
+
+	s.recordUserEvent(ctx, user, audit.ActionMFAEnable, map[string]any{"method": "totp"})
+
+	return codes, nil
+}
+
+// DisableTOTP turns 2FA off after re-checking the user's password.
+func (s *AuthService) DisableTOTP(ctx context.Context, user *models.User, password string) error {
+	if !s.isMFAEnabled(user) {
+		return pkgErr.MFANotEnabledError{}
+	}
+
+	if err := s.hasher.Compare(user.Password, password); err != nil {
+		return pkgErr.InvalidCredentialsError{}
+	}
+
+	_, err := s.userRepo.Update(ctx, user, &models.UserSetter{
+		TotpSecret:    omitnull.FromPtr[string](nil),
+		TotpEnabledAt: omitnull.FromPtr[time.Time](nil),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "error disabling totp")
+	}
+
+	if err := s.mfaRepo.DeleteByUserID(ctx, user.ID); err != nil {
+		return errors.Wrap(err, "error deleting recovery codes")
+	}
+
+	s.recordUserEvent(ctx, user, audit.ActionMFADisable, map[string]any{"method": "totp"})
+
+	return nil
+}
+
+// CompleteMFALogin finishes a login started by Token using a TOTP code or a recovery code.
+// The challenge is used up by the first attempt, a wrong code means logging in with the password again.
+// Failed attempts count towards the same lockout as wrong passwords.
+func (s *AuthService) CompleteMFALogin(ctx context.Context, challenge string, code string, recoveryCode string) (rawToken string, refreshToken string, err error) {
+	if err := s.reachMaxIPLoginAttempts(ctx); err != nil {
+		return "", "", errors.Wrap(err, "Error validating max ip login attempts")
+	}
+
+	hashed, err := s.hashToken(challenge)
+
+	if err != nil {
+		return "", "", errors.Wrap(err, "error hashing mfa challenge")
+	}
+
+	// Taken and deleted in one step so concurrent requests cannot both use the challenge
+	key := s.getMFAChallengeKey(hashed)
+	val, err := s.rdb.GetDel(ctx, key)
+
+	if err == redisclient.Nil {
+		s.captureFailedIPLogin(ctx)
+		return "", "", pkgErr.InvalidTokenError{}
+	}
+
+	if err != nil {
+		return "", "", errors.Wrapf(err, "failed to read key: %s", key)
+	}
+
+	userId, err := strconv.ParseInt(val, 10, 64)
+
+	if err != nil {
+		return "", "", errors.Wrap(err, "invalid mfa challenge value")
+	}
+
+	user, err := s.userRepo.FindByIdOrFail(ctx, userId)
+
+	if err != nil {
+		return "", "", errors.Wrap(err, "error fetching user")
+	}
+
+	if !s.isActiveUser(user) || !s.isMFAEnabled(user) {
+		return "", "", pkgErr.InvalidTokenError{}
+	}
+
+	if err := s.reachMaxLoginAttempts(ctx, user); err != nil {
+		return "", "", errors.Wrap(err, "Error validating max login attempts")
+	}
+
+	valid, err := s.verifySecondFactor(ctx, user, code, recoveryCode)
+
+	if err != nil {
+		return "", "", errors.Wrap(err, "error verifying second factor")
+	}
+
+	if !valid {
+		s.captureFailedLogin(ctx, user)
+		return "", "", pkgErr.InvalidMFACodeError{}
+	}
+
+	sessionId := uuid.Must(uuid.NewV4())
+	rawToken, refreshToken, err = s.generateToken(ctx, user, sessionId)
+
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to generate token")
+	}
+
+	method := "totp"
+	if recoveryCode != "" {
+		method = "recovery_code"
+	}
+	s.recordUserEvent(ctx, user, audit.ActionLogin, map[string]any{"method": method, "session_id": sessionId})
+
+	if err := s.clearUserFailedLogins(ctx, user); err != nil {
+		return "", "", errors.Wrap(err, "error clearing user failed login on successful token generation")
+	}
+
+	return rawToken, refreshToken, nil
+}
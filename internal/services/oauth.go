@@ -0,0 +1,450 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/gofrs/uuid/v5"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jacoobjake/einvoice-api/config"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/internal/repositories"
+	"github.com/jacoobjake/einvoice-api/pkg"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	pkgErr "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const (
+	oauthClientIDType       = "eic"
+	oauthClientIDLength     = 24
+	oauthClientSecretLength = 48
+	oauthTokenType          = "Bearer"
+)
+
+type OAuthService struct {
+	repo        *repositories.OauthClientRepository
+	orgRepo     *repositories.OrganisationRepository
+	userRepo    *repositories.UserRepository
+	authService *AuthService
+	audit       *AuditService
+	config      *config.Config
+}
+
+// ClientClaims are the claims of an access token issued to an OAuth client.
+// Scope is space separated as in RFC 6749.
+type ClientClaims struct {
+	ClientID       string `json:"client_id"`
+	OrganisationID int64  `json:"organisation_id"`
+	Scope          string `json:"scope"`
+	jwt.RegisteredClaims
+}
+
+func (c *ClientClaims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+type OAuthClient struct {
+	ID             int64      `json:"id"`
+	OrganisationID int64      `json:"organisation_id"`
+	Name           string     `json:"name"`
+	ClientID       string     `json:"client_id"`
+	Scopes         []string   `json:"scopes"`
+	RevokedAt      *time.Time `json:"revoked_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// ClientToken is the RFC 6749 access token response.
+type ClientToken struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope"`
+}
+
+// Introspection is the RFC 7662 introspection response, only Active is set for inactive tokens.
+type Introspection struct {
+	Active         bool   `json:"active"`
+	Scope          string `json:"scope,omitempty"`
+	ClientID       string `json:"client_id,omitempty"`
+	TokenType      string `json:"token_type,omitempty"`
+	Exp            int64  `json:"exp,omitempty"`
+	Iat            int64  `json:"iat,omitempty"`
+	Nbf            int64  `json:"nbf,omitempty"`
+	Sub            string `json:"sub,omitempty"`
+	Iss            string `json:"iss,omitempty"`
+	Jti            string `json:"jti,omitempty"`
+	OrganisationID int64  `json:"organisation_id,omitempty"`
+}
+
+func toOAuthClient(client *models.OauthClient) OAuthClient {
+	return OAuthClient{
+		ID:             client.ID,
+		OrganisationID: client.OrganisationID,
+		Name:           client.Name,
+		ClientID:       client.ClientID,
+		Scopes:         client.Scopes,
+		RevokedAt:      client.RevokedAt.Ptr(),
+		CreatedAt:      client.CreatedAt.GetOrZero(),
+	}
+}
+
+func (s *OAuthService) hashSecret(secret string) (string, error) {
+	return pkg.HashToken(s.config.AuthConfig.RefreshTokenSecret, secret)
+}
+
+// CreateClient registers a client for the organisation and returns it with the plain secret, which is never shown again.
+func (s *OAuthService) CreateClient(ctx context.Context, user *models.User, permissions []string, organisationId int64, name string, scopes []string) (*OAuthClient, string, error) {
+	_, err := s.orgRepo.FindById(ctx, organisationId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "", pkgErr.NotFoundError{Resource: "organisation"}
+	}
+
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error fetching organisation")
+	}
+
+	if err := checkGrantableScopes(permissions, scopes); err != nil {
+		return nil, "", err
+	}
+
+	random, err := pkg.GenerateRandomString(oauthClientIDLength)
+
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error generating client id")
+	}
+
+	secret, err := pkg.GenerateRandomString(oauthClientSecretLength)
+
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error generating client secret")
+	}
+
+	hashed, err := s.hashSecret(secret)
+
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error hashing client secret")
+	}
+
+	created, err := s.repo.Create(ctx, &models.OauthClientSetter{
+		OrganisationID: omit.From(organisationId),
+		UserID:         omit.From(user.ID),
+		Name:           omit.From(name),
+		ClientID:       omit.From(fmt.Sprintf("%s_%s", oauthClientIDType, random)),
+		SecretHash:     omit.From(hashed),
+		Scopes:         omit.From(pq.StringArray(scopes)),
+	})
+
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error storing oauth client")
+	}
+
+	client := toOAuthClient(created)
+	changes, err := audit.Diff(nil, client)
+
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error diffing oauth client")
+	}
+
+	s.audit.Record(ctx, AuditEntry{
+		Action:         audit.ActionOAuthClientCreate,
+		OrganisationID: organisationId,
+		EntityType:     audit.EntityOAuthClient,
+		EntityID:       client.ID,
+		Changes:        changes,
+	})
+
+	return &client, secret, nil
+}
+
+func (s *OAuthService) ListClients(ctx context.Context, organisationId int64) ([]OAuthClient, error) {
+	clients, err := s.repo.ListByOrganisationID(ctx, organisationId)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching oauth clients")
+	}
+
+	oauthClients := make([]OAuthClient, 0, len(clients))
+	for _, client := range clients {
+		oauthClients = append(oauthClients, toOAuthClient(client))
+	}
+
+	return oauthClients, nil
+}
+
+// RevokeClient stops the client from getting new tokens and invalidates the ones it already has.
+func (s *OAuthService) RevokeClient(ctx context.Context, organisationId int64, id int64) error {
+	client, err := s.repo.FindByOrganisationID(ctx, organisationId, id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return pkgErr.NotFoundError{Resource: "oauth client"}
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "error fetching oauth client")
+	}
+
+	if client.RevokedAt.IsValue() {
+		return nil
+	}
+
+	before := toOAuthClient(client)
+
+	if err := s.repo.Revoke(ctx, client); err != nil {
+		return errors.Wrap(err, "error revoking oauth client")
+	}
+
+	changes, err := audit.Diff(before, toOAuthClient(client))
+
+	if err != nil {
+		return errors.Wrap(err, "error diffing oauth client")
+	}
+
+	s.audit.Record(ctx, AuditEntry{
+		Action:         audit.ActionOAuthClientRevoke,
+		OrganisationID: organisationId,
+		EntityType:     audit.EntityOAuthClient,
+		EntityID:       client.ID,
+		Changes:        changes,
+	})
+
+	return nil
+}
+
+// authenticateClient checks the client credentials, the client must still be usable.
+func (s *OAuthService) authenticateClient(ctx context.Context, clientId string, secret string) (*models.OauthClient, error) {
+	client, err := s.repo.FindByClientID(ctx, clientId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, pkgErr.InvalidClientError{}
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching oauth client")
+	}
+
+	hashed, err := s.hashSecret(secret)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error hashing client secret")
+	}
+
+	if !hmac.Equal([]byte(hashed), []byte(client.SecretHash)) || client.RevokedAt.IsValue() {
+		return nil, pkgErr.InvalidClientError{}
+	}
+
+	if _, err := s.activeOwner(ctx, client); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// activeOwner returns the user that registered the client, clients stop working with their creator's account.
+func (s *OAuthService) activeOwner(ctx context.Context, client *models.OauthClient) (*models.User, error) {
+	user, err := s.userRepo.FindByIdOrFail(ctx, client.UserID)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching oauth client owner")
+	}
+
+	if !s.authService.isActiveUser(user) {
+		return nil, pkgErr.InvalidClientError{}
+	}
+
+	return user, nil
+}
+
+// ClientCredentialsToken implements the client_credentials grant. Without a requested
+// scope the token gets every scope of the client.
+func (s *OAuthService) ClientCredentialsToken(ctx context.Context, clientId string, secret string, scope string) (*ClientToken, error) {
+	client, err := s.authenticateClient(ctx, clientId, secret)
+
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := strings.Fields(scope)
+
+	if len(scopes) == 0 {
+		scopes = client.Scopes
+	}
+
+	for _, requested := range scopes {
+		if !slices.Contains(client.Scopes, requested) {
+			return nil, pkgErr.InvalidScopeError{Scope: requested}
+		}
+	}
+
+	now := time.Now()
+	ttl := time.Duration(s.config.AuthConfig.ClientTokenExpMin) * time.Minute
+	claims := ClientClaims{
+		ClientID:       client.ClientID,
+		OrganisationID: client.OrganisationID,
+		Scope:          strings.Join(scopes, " "),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   client.ClientID,
+			ID:        uuid.Must(uuid.NewV4()).String(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    s.config.AppName,
+		},
+	}
+
+	signed, err := s.authService.signToken(claims)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error signing client token")
+	}
+
+	return &ClientToken{
+		AccessToken: signed,
+		TokenType:   oauthTokenType,
+		ExpiresIn:   int64(ttl.Seconds()),
+		Scope:       claims.Scope,
+	}, nil
+}
+
+// IsClientToken tells client tokens apart from user tokens without verifying them,
+// callers still have to verify the token afterwards.
+func (s *OAuthService) IsClientToken(token string) bool {
+	claims := &ClientClaims{}
+
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return false
+	}
+
+	return claims.ClientID != ""
+}
+
+// verifyClientToken checks the signature, lifetime and revocation of a client access token.
+func (s *OAuthService) verifyClientToken(ctx context.Context, token string) (*ClientClaims, error) {
+	claims := &ClientClaims{}
+
+	if err := s.authService.parseClaims(token, claims); err != nil {
+		return nil, errors.Wrap(err, "error parsing client token")
+	}
+
+	// User tokens share the signing keys but carry no client
+	if claims.ClientID == "" {
+		return nil, errors.New("not a client token")
+	}
+
+	revoked, err := s.authService.isTokenDenied(ctx, claims.RegisteredClaims, token)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error checking token revocation")
+	}
+
+	if revoked {
+		return nil, errors.New("token revoked")
+	}
+
+	return claims, nil
+}
+
+// VerifyAccessToken resolves a client access token to its client and the user that registered it.
+func (s *OAuthService) VerifyAccessToken(ctx context.Context, token string) (*models.OauthClient, *models.User, *ClientClaims, error) {
+	claims, err := s.verifyClientToken(ctx, token)
+
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "error verifying client token")
+	}
+
+	client, err := s.repo.FindByClientID(ctx, claims.ClientID)
+
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "error fetching oauth client")
+	}
+
+	if client.RevokedAt.IsValue() {
+		return nil, nil, nil, errors.New("oauth client revoked")
+	}
+
+	user, err := s.activeOwner(ctx, client)
+
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "error fetching oauth client owner")
+	}
+
+	return client, user, claims, nil
+}
+
+// Introspect implements RFC 7662. A client can only introspect tokens of its own organisation,
+// any other token is reported as inactive.
+func (s *OAuthService) Introspect(ctx context.Context, clientId string, secret string, token string) (*Introspection, error) {
+	caller, err := s.authenticateClient(ctx, clientId, secret)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, claims, err := s.VerifyAccessToken(ctx, token)
+
+	if err != nil || claims.OrganisationID != caller.OrganisationID {
+		return &Introspection{Active: false}, nil
+	}
+
+	return &Introspection{
+		Active:         true,
+		Scope:          claims.Scope,
+		ClientID:       claims.ClientID,
+		TokenType:      oauthTokenType,
+		Exp:            claims.ExpiresAt.Unix(),
+		Iat:            claims.IssuedAt.Unix(),
+		Nbf:            claims.NotBefore.Unix(),
+		Sub:            claims.Subject,
+		Iss:            claims.Issuer,
+		Jti:            claims.ID,
+		OrganisationID: claims.OrganisationID,
+	}, nil
+}
+
+// Revoke implements RFC 7009. Invalid tokens and tokens issued to other clients are
+// ignored so the response does not reveal anything about them.
+func (s *OAuthService) Revoke(ctx context.Context, clientId string, secret string, token string) error {
+	caller, err := s.authenticateClient(ctx, clientId, secret)
+
+	if err != nil {
+		return err
+	}
+
+	claims, err := s.verifyClientToken(ctx, token)
+
+	if err != nil || claims.ClientID != caller.ClientID {
+		return nil
+	}
+
+	if err := s.authService.denyToken(ctx, claims.RegisteredClaims, token); err != nil {
+		return errors.Wrap(err, "error revoking client token")
+	}
+
+	return nil
+}
+
+func NewOAuthService(
+	repo *repositories.OauthClientRepository,
+	orgRepo *repositories.OrganisationRepository,
+	userRepo *repositories.UserRepository,
+	authService *AuthService,
+	auditService *AuditService,
+	config *config.Config,
+) *OAuthService {
+	return &OAuthService{
+		repo:        repo,
+		orgRepo:     orgRepo,
+		userRepo:    userRepo,
+		authService: authService,
+		audit:       auditService,
+		config:      config,
+	}
+}
@@ -0,0 +1,388 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jacoobjake/einvoice-api/config"
+	cfg_oidc "github.com/jacoobjake/einvoice-api/config/oidc"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/internal/repositories"
+	"github.com/jacoobjake/einvoice-api/pkg"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	pkgErr "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/redisclient"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+)
+
+// OIDCService signs users in through external OpenID Connect providers using the
+// authorization code flow with PKCE. The provider is responsible for the second factor.
+type OIDCService struct {
+	identityRepo *repositories.UserIdentityRepository
+	userRepo     *repositories.UserRepository
+	authService  *AuthService
+	config       *config.Config
+	rdb          *redisclient.RedisClient
+	statePrefix  string
+	mu           sync.Mutex
+	providers    map[string]*oidcProvider
+}
+
+type oidcProvider struct {
+	config   *cfg_oidc.OIDCProvider
+	oauth2   oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+// oidcState is kept server side for the duration of the redirect to the provider.
+type oidcState struct {
+	Provider string `json:"provider"`
+	Verifier string `json:"verifier"`
+	Nonce    string `json:"nonce"`
+}
+
+type oidcClaims struct {
+	Email         string `json:"email"`
+	EmailVerified *bool  `json:"email_verified"`
+	Name          string `json:"name"`
+	GivenName     string `json:"given_name"`
+	FamilyName    string `json:"family_name"`
+}
+
+func (s *OIDCService) getStateKey(hashedState string) string {
+	return fmt.Sprintf("%s%s", s.statePrefix, hashedState)
+}
+
+// Providers returns the names of the configured identity providers.
+func (s *OIDCService) Providers() []string {
+	names := []string{}
+
+	for name := range s.config.OIDCConfig.Providers {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// provider discovers the identity provider on first use so an unreachable provider does not stop the API from starting.
+func (s *OIDCService) provider(ctx context.Context, name string) (*oidcProvider, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p, ok := s.providers[name]; ok {
+		return p, nil
+	}
+
+	cfg, ok := s.config.OIDCConfig.Providers[name]
+
+	if !ok {
+		return nil, pkgErr.NotFoundError{Resource: "identity provider"}
+	}
+
+	discovered, err := oidc.NewProvider(ctx, cfg.Issuer)
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "error discovering identity provider %s", name)
+	}
+
+	p := &oidcProvider{
+		config: cfg,
+		oauth2: oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Endpoint:     discovered.Endpoint(),
+			Scopes:       cfg.Scopes,
+		},
+		verifier: discovered.Verifier(&oidc.Config{ClientID: cfg.ClientID}),
+	}
+
+	s.providers[name] = p
+
+	return p, nil
+}
+
+// AuthorizationURL starts a sign in, the user has to be sent to the returned URL.
+func (s *OIDCService) AuthorizationURL(ctx context.Context, providerName string) (string, error) {
+	p, err := s.provider(ctx, providerName)
+
+	if err != nil {
+		return "", err
+	}
+
+	state, err := pkg.GenerateRandomString(32)
+
+	if err != nil {
+		return "", errors.Wrap(err, "error generating oidc state")
+	}
+
+	nonce, err := pkg.GenerateRandomString(32)
+
+	if err != nil {
+		return "", errors.Wrap(err, "error generating oidc nonce")
+	}
+
+	verifier := oauth2.GenerateVerifier()
+
+	value, err := json.Marshal(oidcState{Provider: providerName, Verifier: verifier, Nonce: nonce})
+
+	if err != nil {
+		return "", errors.Wrap(err, "error encoding oidc state")
+	}
+
+	hashed, err := s.authService.hashToken(state)
+
+	if err != nil {
+		return "", errors.Wrap(err, "error hashing oidc state")
+	}
+
+	key := s.getStateKey(hashed)
+	ttl := time.Duration(s.config.OIDCConfig.StateExpMin) * time.Minute
+
+	if err := s.rdb.Set(ctx, key, value, ttl); err != nil {
+		return "", errors.Wrapf(err, "failed to write key: %s", key)
+	}
+
+	return p.oauth2.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier)), nil
+}
+
+// consumeState returns the stored state, each state can only complete one sign in.
+func (s *OIDCService) consumeState(ctx context.Context, providerName string, state string) (*oidcState, error) {
+	hashed, err := s.authService.hashToken(state)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error hashing oidc state")
+	}
+
+	key := s.getStateKey(hashed)
+	value, err := s.rdb.GetDel(ctx, key)
+
+	if err == redisclient.Nil {
+		return nil, pkgErr.InvalidTokenError{}
+	}
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read key: %s", key)
+	}
+
+	var stored oidcState
+
+	if err := json.Unmarshal([]byte(value), &stored); err != nil {
+		return nil, errors.Wrap(err, "invalid oidc state value")
+	}
+
+	if stored.Provider != providerName {
+		return nil, pkgErr.InvalidTokenError{}
+	}
+
+	return &stored, nil
+}
+
+// Login completes a sign in with the code the provider redirected back with and issues the usual token pair.
+func (s *OIDCService) Login(ctx context.Context, providerName string, code string, state string) (rawToken string, refreshToken string, err error) {
+	stored, err := s.consumeState(ctx, providerName, state)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	p, err := s.provider(ctx, providerName)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	token, err := p.oauth2.Exchange(ctx, code, oauth2.VerifierOption(stored.Verifier))
+
+	if err != nil {
+		return "", "", errors.Wrap(pkgErr.InvalidTokenError{}, err.Error())
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+
+	if !ok {
+		return "", "", errors.New("token response has no id_token")
+	}
+
+	idToken, err := p.verifier.Verify(ctx, rawIDToken)
+
+	if err != nil {
+		return "", "", errors.Wrap(err, "error verifying id token")
+	}
+
+	if idToken.Nonce != stored.Nonce {
+		return "", "", errors.Wrap(pkgErr.InvalidTokenError{}, "id token nonce mismatch")
+	}
+
+	var claims oidcClaims
+
+	if err := idToken.Claims(&claims); err != nil {
+		return "", "", errors.Wrap(err, "error decoding id token claims")
+	}
+
+	user, err := s.resolveUser(ctx, p.config, idToken.Subject, claims)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	if !s.authService.isActiveUser(user) {
+		return "", "", pkgErr.SSODeniedError{Reason: "account is inactive"}
+	}
+
+	sessionId := uuid.Must(uuid.NewV4())
+	rawToken, refreshToken, err = s.authService.generateToken(ctx, user, sessionId)
+
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to generate token")
+	}
+
+	s.authService.recordUserEvent(ctx, user, audit.ActionLogin, map[string]any{
+		"method":     "oidc",
+		"provider":   providerName,
+		"session_id": sessionId,
+	})
+
+	return rawToken, refreshToken, nil
+}
+
+// resolveUser maps the provider account to a user: an already linked account, then an
+// account with the same email when the provider verified it, and finally a new account
+// when the provider allows it.
+func (s *OIDCService) resolveUser(ctx context.Context, provider *cfg_oidc.OIDCProvider, subject string, claims oidcClaims) (*models.User, error) {
+	identity, err := s.identityRepo.FindByProviderSubject(ctx, provider.Name, subject)
+
+	if err == nil {
+		if err := s.identityRepo.TouchLastLogin(ctx, identity); err != nil {
+			return nil, errors.Wrap(err, "error updating identity last login")
+		}
+
+		return s.userRepo.FindByIdOrFail(ctx, identity.UserID)
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "error fetching user identity")
+	}
+
+	email := claims.Email
+	verified := claims.EmailVerified != nil && *claims.EmailVerified
+
+	if email == "" {
+		return nil, pkgErr.SSODeniedError{Reason: "identity provider did not return an email"}
+	}
+
+	_, domain, _ := strings.Cut(strings.ToLower(email), "@")
+
+	if len(provider.AllowedDomains) > 0 && !slices.Contains(provider.AllowedDomains, domain) {
+		return nil, pkgErr.SSODeniedError{Reason: "email domain is not allowed"}
+	}
+
+	user, err := s.userRepo.FindByEmail(ctx, email)
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "error fetching user")
+	}
+
+	switch {
+	// Linking by email is only safe when the provider vouches for the address, whatever
+	// RequireVerifiedEmail says, otherwise anyone could take over the account
+	case user != nil && !verified:
+		return nil, pkgErr.SSODeniedError{Reason: "identity provider did not return a verified email"}
+	case user == nil && !provider.AutoProvision:
+		return nil, pkgErr.SSODeniedError{Reason: "no account exists for this email"}
+	case user == nil && provider.RequireVerifiedEmail && !verified:
+		return nil, pkgErr.SSODeniedError{Reason: "identity provider did not return a verified email"}
+	case user == nil:
+		if user, err = s.provisionUser(ctx, email, verified, claims); err != nil {
+			return nil, errors.Wrap(err, "error provisioning user")
+		}
+	}
+
+	// The provider verified the address, which also satisfies our own verification
+	if verified && user.EmailVerifiedAt.IsNull() {
+		if user, err = s.userRepo.Update(ctx, user, &models.UserSetter{
+			EmailVerifiedAt: omitnull.From(time.Now()),
+		}); err != nil {
+			return nil, errors.Wrap(err, "error marking email verified")
+		}
+	}
+
+	_, err = s.identityRepo.Create(ctx, &models.UserIdentitySetter{
+		UserID:      omit.From(user.ID),
+		Provider:    omit.From(provider.Name),
+		Subject:     omit.From(subject),
+		Email:       omit.From(email),
+		LastLoginAt: omitnull.From(time.Now()),
+	})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error linking user identity")
+	}
+
+	return user, nil
+}
+
+// provisionUser creates an account for a first time sign in. The random password is never
+// shown, the user can set one through the password reset flow. An email the provider did
+// not verify is left for the user to verify.
+func (s *OIDCService) provisionUser(ctx context.Context, email string, verified bool, claims oidcClaims) (*models.User, error) {
+	_, hashed, err := s.authService.generatePassword(32)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error generating password")
+	}
+
+	firstName, lastName := claims.GivenName, claims.FamilyName
+
+	if firstName == "" {
+		firstName, lastName, _ = strings.Cut(claims.Name, " ")
+	}
+
+	if firstName == "" {
+		firstName, _, _ = strings.Cut(email, "@")
+	}
+
+	user := &models.UserSetter{
+		FirstName: omit.From(firstName),
+		LastName:  omit.From(lastName),
+		Email:     omit.From(email),
+		Password:  omit.From(hashed),
+	}
+
+	if verified {
+		user.EmailVerifiedAt = omitnull.From(time.Now())
+	}
+
+	return s.userRepo.Create(ctx, user)
+}
+
+func NewOIDCService(
+	identityRepo *repositories.UserIdentityRepository,
+	userRepo *repositories.UserRepository,
+	authService *AuthService,
+	config *config.Config,
+	rdb *redisclient.RedisClient,
+) *OIDCService {
+	return &OIDCService{
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+		authService:  authService,
+		config:       config,
+		rdb:          rdb,
+		statePrefix:  "oidc_state:",
+		providers:    map[string]*oidcProvider{},
+	}
+}
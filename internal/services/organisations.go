@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/internal/repositories"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	pkgErr "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/rbac"
+	"github.com/pkg/errors"
+)
+
+type OrganisationService struct {
+	repo     *repositories.OrganisationRepository
+	userRepo *repositories.UserRepository
+	audit    *AuditService
+}
+
+type Organisation struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func toOrganisation(organisation *models.Organisation) Organisation {
+	return Organisation{
+		ID:        organisation.ID,
+		Name:      organisation.Name,
+		CreatedAt: organisation.CreatedAt.GetOrZero(),
+	}
+}
+
+func toOrganisations(organisations models.OrganisationSlice) []Organisation {
+	result := make([]Organisation, 0, len(organisations))
+	for _, organisation := range organisations {
+		result = append(result, toOrganisation(organisation))
+	}
+
+	return result
+}
+
+func (s *OrganisationService) findOrganisation(ctx context.Context, organisationId int64) (*models.Organisation, error) {
+	organisation, err := s.repo.FindById(ctx, organisationId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, pkgErr.NotFoundError{Resource: "organisation"}
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching organisation")
+	}
+
+	return organisation, nil
+}
+
+// ResolveTenant returns the organisation the user acts on. A requested id of 0 picks the user's only
+// organisation. Holders of the wildcard permission may act on any organisation without being a member.
+func (s *OrganisationService) ResolveTenant(ctx context.Context, user *models.User, permissions []string, requested int64) (int64, error) {
+	if requested == 0 {
+		organisations, err := s.repo.ListByUserID(ctx, user.ID)
+
+		if err != nil {
+			return 0, errors.Wrap(err, "error fetching user organisations")
+		}
+
+		if len(organisations) != 1 {
+			return 0, pkgErr.OrganisationRequiredError{}
+		}
+
+		return organisations[0].ID, nil
+	}
+
+	isMember, err := s.repo.IsMember(ctx, requested, user.ID)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "error checking organisation membership")
+	}
+
+	if isMember {
+		return requested, nil
+	}
+
+	if !rbac.HasPermission(permissions, rbac.Wildcard) {
+		return 0, pkgErr.NotOrganisationMemberError{}
+	}
+
+	if _, err := s.findOrganisation(ctx, requested); err != nil {
+		return 0, err
+	}
+
+	return requested, nil
+}
+
+// ListForUser returns the organisations the user can pick as tenant.
+func (s *OrganisationService) ListForUser(ctx context.Context, user *models.User) ([]Organisation, error) {
+	organisations, err := s.repo.ListByUserID(ctx, user.ID)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching user organisations")
+	}
+
+	return toOrganisations(organisations), nil
+}
+
+func (s *OrganisationService) List(ctx context.Context) ([]Organisation, error) {
+	organisations, err := s.repo.List(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching organisations")
+	}
+
+	return toOrganisations(organisations), nil
+}
+
+func (s *OrganisationService) Create(ctx context.Context, name string) (Organisation, error) {
+	organisation, err := s.repo.Create(ctx, &models.OrganisationSetter{
+		Name: omit.From(name),
+	})
+
+	if err != nil {
+		return Organisation{}, errors.Wrap(err, "error creating organisation")
+	}
+
+	result := toOrganisation(organisation)
+	changes, err := audit.Diff(nil, result)
+
+	if err != nil {
+		log.Println("error diffing organisation", err)
+	}
+
+	s.audit.Record(ctx, AuditEntry{
+		Action:         audit.ActionOrganisationCreate,
+		OrganisationID: organisation.ID,
+		EntityType:     audit.EntityOrganisation,
+		EntityID:       organisation.ID,
+		Changes:        changes,
+	})
+
+	return result, nil
+}
+
+func (s *OrganisationService) ListMembers(ctx context.Context, organisationId int64) ([]User, error) {
+	if _, err := s.findOrganisation(ctx, organisationId); err != nil {
+		return nil, err
+	}
+
+	users, err := s.repo.ListMembers(ctx, organisationId)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching organisation members")
+	}
+
+	result := make([]User, 0, len(users))
+	for _, user := range users {
+		result = append(result, toUser(user))
+	}
+
+	return result, nil
+}
+
+func (s *OrganisationService) recordMemberChange(ctx context.Context, action string, organisationId int64, userId int64) {
+	s.audit.Record(ctx, AuditEntry{
+		Action:         action,
+		OrganisationID: organisationId,
+		EntityType:     audit.EntityOrganisation,
+		EntityID:       organisationId,
+		Metadata:       map[string]any{"user_id": userId},
+	})
+}
+
+// AddMember lets the user act on the organisation. Adding a member twice is a no-op.
+func (s *OrganisationService) AddMember(ctx context.Context, organisationId int64, userId int64) error {
+	if _, err := s.findOrganisation(ctx, organisationId); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.FindById(ctx, userId)
+
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && user.DeletedAt.IsValue()) {
+		return pkgErr.NotFoundError{Resource: "user"}
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "error fetching user")
+	}
+
+	added, err := s.repo.AddMember(ctx, organisationId, userId)
+
+	if err != nil {
+		return errors.Wrap(err, "error adding organisation member")
+	}
+
+	if added {
+		s.recordMemberChange(ctx, audit.ActionOrganisationMemberAdd, organisationId, userId)
+	}
+
+	return nil
+}
+
+// RemoveMember stops the user from acting on the organisation. Credentials they issued for it keep working until revoked.
+func (s *OrganisationService) RemoveMember(ctx context.Context, organisationId int64, userId int64) error {
+	removed, err := s.repo.RemoveMember(ctx, organisationId, userId)
+
+	if err != nil {
+		return errors.Wrap(err, "error removing organisation member")
+	}
+
+	if !removed {
+		return pkgErr.NotFoundError{Resource: "organisation member"}
+	}
+
+	s.recordMemberChange(ctx, audit.ActionOrganisationMemberRemove, organisationId, userId)
+
+	return nil
+}
+
+func NewOrganisationService(repo *repositories.OrganisationRepository, userRepo *repositories.UserRepository, auditService *AuditService) *OrganisationService {
+	return &OrganisationService{repo: repo, userRepo: userRepo, audit: auditService}
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/pkg"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	pkgErr "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/pkg/errors"
+)
+
+// ValidatePassword checks a new password against the password policy and the breached password corpus.
+// personal holds the email and names of the account the password is for.
+func (s *AuthService) ValidatePassword(_ context.Context, password string, personal ...string) error {
+	reasons := s.passwordPolicy.Validate(password, personal...)
+
+	breached, err := s.breached.IsBreached(password)
+
+	if err != nil {
+		return errors.Wrap(err, "error checking breached passwords")
+	}
+
+	if breached {
+		reasons = append(reasons, "not appear in a known data breach")
+	}
+
+	if len(reasons) > 0 {
+		return pkgErr.InvalidPasswordError{Reasons: reasons}
+	}
+
+	return nil
+}
+
+// checkPasswordReuse rejects the user's current password and the previous ones kept in the history.
+func (s *AuthService) checkPasswordReuse(ctx context.Context, user *models.User, password string) error {
+	size := s.config.PasswordConfig.HistorySize
+
+	if size <= 0 {
+		return nil
+	}
+
+	hashes := []string{user.Password}
+	histories, err := s.pwHistoryRepo.ListRecentByUserID(ctx, user.ID, size)
+
+	if err != nil {
+		return errors.Wrap(err, "error fetching password history")
+	}
+
+	for _, history := range histories {
+		hashes = append(hashes, history.Password)
+	}
+
+	for _, hash := range hashes {
+		if s.hasher.Compare(hash, password) == nil {
+			return pkgErr.InvalidPasswordError{Reasons: []string{fmt.Sprintf("not match any of your last %d passwords", size)}}
+		}
+	}
+
+	return nil
+}
+
+// recordPasswordHistory keeps the hash so it cannot be reused, pruning anything past the history size.
+// Failures are logged, a missing history entry only weakens the reuse check.
+func (s *AuthService) recordPasswordHistory(ctx context.Context, userId int64, hashedPw string) {
+	size := s.config.PasswordConfig.HistorySize
+
+	if size <= 0 {
+		return
+	}
+
+	if err := s.pwHistoryRepo.Create(ctx, userId, hashedPw); err != nil {
+		log.Println("error recording password history", err)
+		return
+	}
+
+	if err := s.pwHistoryRepo.PruneByUserID(ctx, userId, size); err != nil {
+		log.Println("error pruning password history", err)
+	}
+}
+
+// setPassword enforces the password policy and history, then stores the new password.
+func (s *AuthService) setPassword(ctx context.Context, user *models.User, password string) error {
+	if err := s.ValidatePassword(ctx, password, user.Email, user.FirstName, user.LastName); err != nil {
+		return err
+	}
+
+	if err := s.checkPasswordReuse(ctx, user, password); err != nil {
+		return err
+	}
+
+	hashedPw, err := s.hasher.Hash(password)
+
+	if err != nil {
+		return errors.Wrap(err, "error hashing password")
+	}
+
+	_, err = s.userRepo.Update(ctx, user, &models.UserSetter{
+		Password: omit.From(hashedPw),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "error updating password")
+	}
+
+	s.recordPasswordHistory(ctx, user.ID, hashedPw)
+
+	return nil
+}
+
+// rehashPassword re-hashes a just verified password when the stored hash is outdated.
+// Failures are logged, the old hash keeps working and is retried on the next login.
+func (s *AuthService) rehashPassword(ctx context.Context, user *models.User, password string) {
+	if !s.hasher.NeedsRehash(user.Password) {
+		return
+	}
+
+	hashedPw, err := s.hasher.Hash(password)
+
+	if err != nil {
+		log.Println("error rehashing password", err)
+		return
+	}
+
+	_, err = s.userRepo.Update(ctx, user, &models.UserSetter{
+		Password: omit.From(hashedPw),
+	})
+
+	if err != nil {
+		log.Println("error updating rehashed password", err)
+	}
+}
+
+// generatePassword returns a random password and its hash, for accounts whose password is never shown.
+func (s *AuthService) generatePassword(length int) (string, string, error) {
+	pw, err := pkg.GenerateRandomString(length)
+
+	if err != nil {
+		return "", "", errors.Wrap(err, "error generating random password string")
+	}
+
+	hashedPw, err := s.hasher.Hash(pw)
+
+	if err != nil {
+		return "", "", errors.Wrap(err, "error hashing password")
+	}
+
+	return pw, hashedPw, nil
+}
+
+// ChangePassword sets a new password after re-checking the current one, then logs the user out
+// of every other session and voids outstanding password reset links.
+func (s *AuthService) ChangePassword(ctx context.Context, user *models.User, sessionId uuid.UUID, currentPw string, newPw string) error {
+	if err := s.hasher.Compare(user.Password, currentPw); err != nil {
+		return pkgErr.InvalidCredentialsError{}
+	}
+
+	if err := s.setPassword(ctx, user, newPw); err != nil {
+		return errors.Wrap(err, "error setting password")
+	}
+
+	actor := UserActor(user)
+	s.audit.Record(ctx, AuditEntry{
+		Action:     audit.ActionPasswordChange,
+		Actor:      &actor,
+		EntityType: audit.EntityUser,
+		EntityID:   user.ID,
+		Changes:    map[string]audit.Change{"password": {Old: audit.Redacted, New: audit.Redacted}},
+		Metadata:   map[string]any{"session_id": sessionId},
+	})
+
+	if _, err := s.RevokeOtherSessions(ctx, user.ID, sessionId); err != nil {
+		return errors.Wrap(err, "error revoking other sessions after password change")
+	}
+
+	if err := s.authRepo.InvalidateActiveTokensByUserID(ctx, user.ID, enums.AuthTokenTypesResetPassword); err != nil {
+		return errors.Wrap(err, "error invalidating password reset tokens")
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	pkgErr "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/mailer"
+	"github.com/pkg/errors"
+)
+
+func (s *AuthService) passwordResetLink(token string) string {
+	return fmt.Sprintf("%s?token=%s", s.config.AuthConfig.PasswordResetURL, url.QueryEscape(token))
+}
+
+// sendPasswordReset issues a reset token for the user and mails them the link.
+func (s *AuthService) sendPasswordReset(ctx context.Context, user *models.User) error {
+	ttl := s.config.AuthConfig.PasswordResetExpMin
+	token, err := s.issueUserToken(ctx, user.ID, enums.AuthTokenTypesResetPassword, time.Duration(ttl)*time.Minute)
+
+	if err != nil {
+		return errors.Wrap(err, "error issuing password reset token")
+	}
+
+	err = s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: fmt.Sprintf("%s password reset", s.config.AppName),
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nUse the link below to reset your password. It expires in %d minutes.\n\n%s\n\nIf you did not request a password reset, you can ignore this email.\n",
+			user.FirstName, ttl, s.passwordResetLink(token),
+		),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "error sending password reset mail")
+	}
+
+	return nil
+}
+
+// ForgotPassword emails a password reset link to the user.
+// Unknown or inactive accounts are silently ignored so callers cannot tell whether an email is registered.
+func (s *AuthService) ForgotPassword(ctx context.Context, email string) error {
+	user, err := s.userRepo.FindByEmail(ctx, email)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "error fetching user")
+	}
+
+	if !s.isActiveUser(user) {
+		return nil
+	}
+
+	// Issue and send in the background so the request does the same work, and takes the same time,
+	// whether or not the account exists
+	go func() {
+		if err := s.sendPasswordReset(context.WithoutCancel(ctx), user); err != nil {
+			log.Println("error sending password reset", err)
+		}
+	}()
+
+	return nil
+}
+
+// ResetPassword sets a new password using a reset token and logs the user out everywhere.
+func (s *AuthService) ResetPassword(ctx context.Context, token string, password string) error {
+	resetToken, err := s.consumeUserToken(ctx, token, enums.AuthTokenTypesResetPassword)
+
+	if err != nil {
+		return errors.Wrap(err, "error validating reset token")
+	}
+
+	user, err := s.userRepo.FindByIdOrFail(ctx, resetToken.UserID)
+
+	if err != nil {
+		return errors.Wrap(err, "error fetching user")
+	}
+
+	if !s.isActiveUser(user) {
+		return pkgErr.InvalidTokenError{}
+	}
+
+	if err := s.setPassword(ctx, user, password); err != nil {
+		return errors.Wrap(err, "error setting password")
+	}
+
+	actor := UserActor(user)
+	s.audit.Record(ctx, AuditEntry{
+		Action:     audit.ActionPasswordReset,
+		Actor:      &actor,
+		EntityType: audit.EntityUser,
+		EntityID:   user.ID,
+		Changes:    map[string]audit.Change{"password": {Old: audit.Redacted, New: audit.Redacted}},
+	})
+
+	if _, err := s.RevokeAllSessions(ctx, user.ID); err != nil {
+		return errors.Wrap(err, "error revoking sessions after password reset")
+	}
+
+	// The owner proved access to the mailbox, lift any login lockout
+	if err := s.clearUserFailedLogins(ctx, user); err != nil {
+		return errors.Wrap(err, "error clearing failed logins after password reset")
+	}
+
+	return nil
+}
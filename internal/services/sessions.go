@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jacoobjake/einvoice-api/internal/database/enums"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	pkgErr "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/pkg/errors"
+)
+
+type Session struct {
+	ID              uuid.UUID `json:"id"`
+	CreatedAt       time.Time `json:"created_at"`
+	LastRefreshedAt time.Time `json:"last_refreshed_at"`
+	ExpireAt        time.Time `json:"expire_at"`
+	IPAddress       string    `json:"ip_address"`
+	UserAgent       string    `json:"user_agent"`
+	Current         bool      `json:"current"`
+}
+
+// ListSessions returns the user's active sessions, most recently refreshed first.
+func (s *AuthService) ListSessions(ctx context.Context, userId int64, currentSessionId uuid.UUID) ([]Session, error) {
+	tokens, err := s.authRepo.ListActiveRefreshTokensByUserID(ctx, userId)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching active sessions")
+	}
+
+	sessionIds := make([]uuid.UUID, 0, len(tokens))
+	for _, token := range tokens {
+		sessionIds = append(sessionIds, token.SessionID.MustGet())
+	}
+
+	startTimes, err := s.authRepo.GetSessionStartTimes(ctx, sessionIds)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching session start times")
+	}
+
+	sessions := make([]Session, 0, len(tokens))
+
+	for _, token := range tokens {
+		sessionId := token.SessionID.MustGet()
+		session := Session{
+			ID:              sessionId,
+			CreatedAt:       startTimes[sessionId],
+			LastRefreshedAt: token.CreatedAt.GetOrZero(),
+			ExpireAt:        token.ExpireAt.GetOrZero(),
+			UserAgent:       token.UserAgent.GetOrZero(),
+			Current:         sessionId == currentSessionId,
+		}
+
+		if ip, ok := token.IPAddress.Get(); ok {
+			session.IPAddress = ip.Addr().String()
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession logs out a single session owned by the user.
+func (s *AuthService) RevokeSession(ctx context.Context, userId int64, sessionId uuid.UUID) error {
+	_, err := s.authRepo.FindActiveRefreshTokenBySessionID(ctx, userId, sessionId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return pkgErr.NotFoundError{Resource: "session"}
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "error fetching session")
+	}
+
+	if err := s.revokeSession(ctx, sessionId); err != nil {
+		return errors.Wrap(err, "error revoking session")
+	}
+
+	s.audit.Record(ctx, AuditEntry{
+		Action:     audit.ActionSessionRevoke,
+		EntityType: audit.EntityUser,
+		EntityID:   userId,
+		Metadata:   map[string]any{"session_id": sessionId},
+	})
+
+	return nil
+}
+
+// RevokeAllSessions logs the user out everywhere and returns the number of revoked sessions.
+func (s *AuthService) RevokeAllSessions(ctx context.Context, userId int64) (int, error) {
+	tokens, err := s.authRepo.ListActiveRefreshTokensByUserID(ctx, userId)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "error fetching active sessions")
+	}
+
+	for _, token := range tokens {
+		if err := s.revokeSession(ctx, token.SessionID.MustGet()); err != nil {
+			return 0, errors.Wrap(err, "error revoking session")
+		}
+	}
+
+	// Catch any refresh token that was issued without a session
+	if err := s.authRepo.InvalidateActiveTokensByUserID(ctx, userId, enums.AuthTokenTypesRefresh); err != nil {
+		return 0, errors.Wrap(err, "error invalidating refresh tokens")
+	}
+
+	s.audit.Record(ctx, AuditEntry{
+		Action:     audit.ActionSessionRevoke,
+		EntityType: audit.EntityUser,
+		EntityID:   userId,
+		Metadata:   map[string]any{"revoked_sessions": len(tokens)},
+	})
+
+	return len(tokens), nil
+}
+
+// RevokeOtherSessions logs the user out everywhere except the given session and returns the number of revoked sessions.
+func (s *AuthService) RevokeOtherSessions(ctx context.Context, userId int64, keepSessionId uuid.UUID) (int, error) {
+	tokens, err := s.authRepo.ListActiveRefreshTokensByUserID(ctx, userId)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "error fetching active sessions")
+	}
+
+	revoked := 0
+
+	for _, token := range tokens {
+		sessionId := token.SessionID.MustGet()
+
+		if sessionId == keepSessionId {
+			continue
+		}
+
+		if err := s.revokeSession(ctx, sessionId); err != nil {
+			return 0, errors.Wrap(err, "error revoking session")
+		}
+
+		revoked++
+	}
+
+	s.audit.Record(ctx, AuditEntry{
+		Action:     audit.ActionSessionRevoke,
+		EntityType: audit.EntityUser,
+		EntityID:   userId,
+		Metadata:   map[string]any{"revoked_sessions": revoked, "kept_session_id": keepSessionId},
+	})
+
+	return revoked, nil
+}
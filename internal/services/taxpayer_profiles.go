@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jacoobjake/einvoice-api/internal/database/models"
+	"github.com/jacoobjake/einvoice-api/internal/repositories"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	pkgErr "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/pkg/errors"
+)
+
+type TaxpayerProfileService struct {
+	repo  *repositories.TaxpayerProfileRepository
+	audit *AuditService
+}
+
+// TaxpayerProfile is the supplier identity an organisation issues e-invoices under.
+type TaxpayerProfile struct {
+	ID                           int64     `json:"id"`
+	OrganisationID               int64     `json:"organisation_id"`
+	Name                         string    `json:"name"`
+	TIN                          string    `json:"tin"`
+	IDType                       string    `json:"id_type"`
+	IDValue                      string    `json:"id_value"`
+	SSTRegistrationNumber        string    `json:"sst_registration_number"`
+	TourismTaxRegistrationNumber string    `json:"tourism_tax_registration_number"`
+	MSICCode                     string    `json:"msic_code"`
+	BusinessActivityDescription  string    `json:"business_activity_description"`
+	AddressLine1                 string    `json:"address_line1"`
+	AddressLine2                 *string   `json:"address_line2"`
+	AddressLine3                 *string   `json:"address_line3"`
+	PostalZone                   *string   `json:"postal_zone"`
+	CityName                     string    `json:"city_name"`
+	StateCode                    string    `json:"state_code"`
+	CountryCode                  string    `json:"country_code"`
+	Phone                        string    `json:"phone"`
+	Email                        *string   `json:"email"`
+	CreatedAt                    time.Time `json:"created_at"`
+	UpdatedAt                    time.Time `json:"updated_at"`
+}
+
+func toTaxpayerProfile(profile *models.TaxpayerProfile) TaxpayerProfile {
+	return TaxpayerProfile{
+		ID:                           profile.ID,
+		OrganisationID:               profile.OrganisationID,
+		Name:                         profile.Name,
+		TIN:                          profile.Tin,
+		IDType:                       string(profile.IDType),
+		IDValue:                      profile.IDValue,
+		SSTRegistrationNumber:        profile.SSTRegistrationNumber,
+		TourismTaxRegistrationNumber: profile.TourismTaxRegistrationNumber,
+		MSICCode:                     profile.MsicCode,
+		BusinessActivityDescription:  profile.BusinessActivityDescription,
+		AddressLine1:                 profile.AddressLine1,
+		AddressLine2:                 profile.AddressLine2.Ptr(),
+		AddressLine3:                 profile.AddressLine3.Ptr(),
+		PostalZone:                   profile.PostalZone.Ptr(),
+		CityName:                     profile.CityName,
+		StateCode:                    profile.StateCode,
+		CountryCode:                  profile.CountryCode,
+		Phone:                        profile.Phone,
+		Email:                        profile.Email.Ptr(),
+		CreatedAt:                    profile.CreatedAt.GetOrZero(),
+		UpdatedAt:                    profile.UpdatedAt.GetOrZero(),
+	}
+}
+
+func (s *TaxpayerProfileService) findProfile(ctx context.Context, organisationId int64) (*models.TaxpayerProfile, error) {
+	profile, err := s.repo.FindByOrganisationID(ctx, organisationId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, pkgErr.NotFoundError{Resource: "taxpayer profile"}
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching taxpayer profile")
+	}
+
+	return profile, nil
+}
+
+func (s *TaxpayerProfileService) recordChange(ctx context.Context, action string, organisationId int64, entityId int64, before any, after any) {
+	changes, err := audit.Diff(before, after)
+
+	if err != nil {
+		log.Println("error diffing taxpayer profile", err)
+	}
+
+	s.audit.Record(ctx, AuditEntry{
+		Action:         action,
+		OrganisationID: organisationId,
+		EntityType:     audit.EntityTaxpayerProfile,
+		EntityID:       entityId,
+		Changes:        changes,
+	})
+}
+
+func (s *TaxpayerProfileService) Get(ctx context.Context, organisationId int64) (TaxpayerProfile, error) {
+	profile, err := s.findProfile(ctx, organisationId)
+
+	if err != nil {
+		return TaxpayerProfile{}, err
+	}
+
+	return toTaxpayerProfile(profile), nil
+}
+
+// Save creates the organisation's taxpayer profile or replaces the existing one, and reports whether it was created.
+func (s *TaxpayerProfileService) Save(ctx context.Context, organisationId int64, data models.TaxpayerProfileSetter) (TaxpayerProfile, bool, error) {
+	profile, err := s.findProfile(ctx, organisationId)
+
+	if err != nil {
+		if _, ok := errors.Cause(err).(pkgErr.NotFoundError); !ok {
+			return TaxpayerProfile{}, false, err
+		}
+
+		data.OrganisationID = omit.From(organisationId)
+		profile, err = s.repo.Create(ctx, &data)
+
+		if err != nil {
+			return TaxpayerProfile{}, false, errors.Wrap(err, "error creating taxpayer profile")
+		}
+
+		result := toTaxpayerProfile(profile)
+		s.recordChange(ctx, audit.ActionTaxpayerProfileCreate, organisationId, profile.ID, nil, result)
+
+		return result, true, nil
+	}
+
+	before := toTaxpayerProfile(profile)
+
+	if err := s.repo.Update(ctx, profile, &data); err != nil {
+		return TaxpayerProfile{}, false, errors.Wrap(err, "error updating taxpayer profile")
+	}
+
+	result := toTaxpayerProfile(profile)
+	s.recordChange(ctx, audit.ActionTaxpayerProfileUpdate, organisationId, profile.ID, before, result)
+
+	return result, false, nil
+}
+
+func (s *TaxpayerProfileService) Delete(ctx context.Context, organisationId int64) error {
+	profile, err := s.findProfile(ctx, organisationId)
+
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, profile); err != nil {
+		return errors.Wrap(err, "error deleting taxpayer profile")
+	}
+
+	s.recordChange(ctx, audit.ActionTaxpayerProfileDelete, organisationId, profile.ID, toTaxpayerProfile(profile), nil)
+
+	return nil
+}
+
+func NewTaxpayerProfileService(repo *repositories.TaxpayerProfileRepository, auditService *AuditService) *TaxpayerProfileService {
+	return &TaxpayerProfileService{repo: repo, audit: auditService}
+}
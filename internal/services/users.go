@@ -2,47 +2,456 @@ package services
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jacoobjake/einvoice-api/internal/database/dberrors"
+	"github.com/jacoobjake/einvoice-api/internal/database/enums"
 	"github.com/jacoobjake/einvoice-api/internal/database/models"
 	"github.com/jacoobjake/einvoice-api/internal/repositories"
-	"github.com/jacoobjake/einvoice-api/pkg"
+	"github.com/jacoobjake/einvoice-api/pkg/audit"
+	pkgErr "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/mailer"
+	"github.com/jacoobjake/einvoice-api/pkg/rbac"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
 type UserService struct {
-	repo *repositories.UserRepository
+	repo        *repositories.UserRepository
+	roleRepo    *repositories.RoleRepository
+	authService *AuthService
+	audit       *AuditService
+}
+
+type User struct {
+	ID              int64      `json:"id"`
+	FirstName       string     `json:"first_name"`
+	LastName        string     `json:"last_name"`
+	Email           string     `json:"email"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	// New email waiting to be confirmed from that address
+	PendingEmail *string            `json:"pending_email"`
+	Status       enums.UserStatuses `json:"status"`
+	MFAEnabled   bool               `json:"mfa_enabled"`
+	CreatedAt    time.Time          `json:"created_at"`
+	UpdatedAt    time.Time          `json:"updated_at"`
+	DeletedAt    *time.Time         `json:"deleted_at"`
+}
+
+func toUser(user *models.User) User {
+	return User{
+		ID:              user.ID,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		Email:           user.Email,
+		EmailVerifiedAt: user.EmailVerifiedAt.Ptr(),
+		PendingEmail:    user.PendingEmail.Ptr(),
+		Status:          user.Status,
+		MFAEnabled:      user.TotpEnabledAt.IsValue(),
+		CreatedAt:       user.CreatedAt.GetOrZero(),
+		UpdatedAt:       user.UpdatedAt.GetOrZero(),
+		DeletedAt:       user.DeletedAt.Ptr(),
+	}
+}
+
+// UserUpdate holds the profile fields to change, nil fields are left untouched.
+type UserUpdate struct {
+	FirstName *string
+	LastName  *string
+	Email     *string
+}
+
+func isDuplicateEmail(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && dberrors.UserErrors.ErrUniqueUsersEmailKey.Is(pqErr)
+}
+
+// Soft deleted users keep their email, so it stays taken until the user is purged.
+var errEmailTaken = pkgErr.ConflictError{Reason: "email is already taken"}
+
+// rejectSelf stops admins from locking themselves out.
+func rejectSelf(ctx context.Context, userId int64, action string) error {
+	if actor, ok := audit.GetCtxActor(ctx); ok && actor.Type == audit.ActorUser && actor.ID == userId {
+		return pkgErr.ConflictError{Reason: fmt.Sprintf("you cannot %s your own account", action)}
+	}
+
+	return nil
+}
+
+// checkOutranks stops admins from changing users who hold permissions they do not, such as a super admin
+// having their email changed to take the account over through a password reset.
+func (s *UserService) checkOutranks(ctx context.Context, permissions []string, user *models.User) error {
+	if slices.Contains(permissions, rbac.Wildcard) {
+		return nil
+	}
+
+	userPermissions, err := s.roleRepo.ListPermissionNamesByUserID(ctx, user.ID)
+
+	if err != nil {
+		return errors.Wrap(err, "error fetching user permissions")
+	}
+
+	for _, permission := range userPermissions {
+		if !rbac.HasPermission(permissions, permission) {
+			return pkgErr.ForbiddenError{Reason: "you cannot change a user holding permissions you do not have"}
+		}
+	}
+
+	return nil
+}
+
+func (s *UserService) findUser(ctx context.Context, userId int64) (*models.User, error) {
+	user, err := s.repo.FindById(ctx, userId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, pkgErr.NotFoundError{Resource: "user"}
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching user")
+	}
+
+	return user, nil
+}
+
+func (s *UserService) recordChange(ctx context.Context, action string, before any, user *models.User) {
+	changes, err := audit.Diff(before, toUser(user))
+
+	if err != nil {
+		log.Println("error diffing user", err)
+	}
+
+	s.audit.Record(ctx, AuditEntry{
+		Action:     action,
+		EntityType: audit.EntityUser,
+		EntityID:   user.ID,
+		Changes:    changes,
+	})
 }
 
 // CreateUser creates a user and returns the user and the original (plain) password if generated.
-// If the password was provided, the returned plain password will be empty.
-func (s *UserService) CreateUser(ctx context.Context, user models.UserSetter) (*models.User, string, error) {
+// The returned plain password is empty if the password was provided, or if the generated one was
+// emailed to the user because emailPassword is set.
+func (s *UserService) CreateUser(ctx context.Context, user models.UserSetter, emailPassword bool) (User, string, error) {
 	pw, isset := user.Password.Get()
 	var plainPw string
 
 	if !isset {
 		// Generate random password and hash it
-		randPw, hashedPw, err := pkg.GenerateAndHashPassword(12)
+		randPw, hashedPw, err := s.authService.generatePassword(12)
 		if err != nil {
-			return nil, "", errors.Wrap(err, "error generating random password")
+			return User{}, "", errors.Wrap(err, "error generating random password")
 		}
 		plainPw = randPw
-		user.Password.Set(string(hashedPw))
+		user.Password.Set(hashedPw)
 	} else {
+		// Generated passwords are random, only chosen ones go through the policy
+		err := s.authService.ValidatePassword(ctx, pw, user.Email.GetOrZero(), user.FirstName.GetOrZero(), user.LastName.GetOrZero())
+		if err != nil {
+			return User{}, "", err
+		}
+
 		// Hash the provided password
-		hashedPw, err := pkg.HashPassword(pw)
+		hashedPw, err := s.authService.hasher.Hash(pw)
 		if err != nil {
-			return nil, "", errors.Wrap(err, "error hashing password")
+			return User{}, "", errors.Wrap(err, "error hashing password")
 		}
-		user.Password.Set(string(hashedPw))
+		user.Password.Set(hashedPw)
 	}
 
 	createdUser, err := s.repo.Create(ctx, &user)
+	if isDuplicateEmail(err) {
+		return User{}, "", errEmailTaken
+	}
 	if err != nil {
-		return nil, "", errors.Wrap(err, "error creating user record")
+		return User{}, "", errors.Wrap(err, "error creating user record")
 	}
-	return createdUser, plainPw, nil
+
+	s.authService.recordPasswordHistory(ctx, createdUser.ID, createdUser.Password)
+	s.recordChange(ctx, audit.ActionUserCreate, nil, createdUser)
+
+	if err := s.authService.SendEmailVerification(ctx, createdUser); err != nil {
+		return User{}, "", errors.Wrap(err, "error sending email verification")
+	}
+
+	if plainPw != "" && emailPassword {
+		if err := s.sendGeneratedPassword(ctx, createdUser, plainPw); err != nil {
+			return User{}, "", err
+		}
+		plainPw = ""
+	}
+
+	return toUser(createdUser), plainPw, nil
+}
+
+func (s *UserService) sendGeneratedPassword(ctx context.Context, user *models.User, plainPw string) error {
+	appName := s.authService.config.AppName
+
+	err := s.authService.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: fmt.Sprintf("Your %s account", appName),
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nAn account has been created for you on %s.\n\nEmail: %s\nPassword: %s\n\nPlease change this password after you first sign in.\n",
+			user.FirstName, appName, user.Email, plainPw,
+		),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "error sending generated password mail")
+	}
+
+	return nil
+}
+
+// Profile returns the view of an already loaded user, such as the authenticated one.
+func (s *UserService) Profile(user *models.User) User {
+	return toUser(user)
+}
+
+// GetUser returns the user, soft deleted users included.
+func (s *UserService) GetUser(ctx context.Context, userId int64) (User, error) {
+	user, err := s.findUser(ctx, userId)
+
+	if err != nil {
+		return User{}, err
+	}
+
+	return toUser(user), nil
+}
+
+// ListUsers returns a page of users and the number of matching users.
+func (s *UserService) ListUsers(ctx context.Context, filter repositories.UserFilter, sort string, page int, perPage int) ([]User, int64, error) {
+	// A leading "-" sorts descending, e.g. "-created_at"
+	column, desc := strings.CutPrefix(sort, "-")
+
+	users, err := s.repo.List(ctx, filter, column, desc, perPage, (page-1)*perPage)
+
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "error fetching users")
+	}
+
+	total, err := s.repo.Count(ctx, filter)
+
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "error counting users")
+	}
+
+	result := make([]User, 0, len(users))
+	for _, user := range users {
+		result = append(result, toUser(user))
+	}
+
+	return result, total, nil
+}
+
+// UpdateUser changes the user's profile on behalf of an admin holding the given permissions.
+func (s *UserService) UpdateUser(ctx context.Context, permissions []string, userId int64, update UserUpdate) (User, error) {
+	user, err := s.findUser(ctx, userId)
+
+	if err != nil {
+		return User{}, err
+	}
+
+	if user.DeletedAt.IsValue() {
+		return User{}, pkgErr.NotFoundError{Resource: "user"}
+	}
+
+	if err := s.checkOutranks(ctx, permissions, user); err != nil {
+		return User{}, err
+	}
+
+	return s.updateProfile(ctx, user, update)
+}
+
+// UpdateProfile changes the user's own profile. Changing the email needs the current password and only
+// takes effect once the new address is confirmed, see AuthService.ConfirmEmailChange, so a stolen
+// session cannot move the account to another address.
+func (s *UserService) UpdateProfile(ctx context.Context, user *models.User, currentPw string, update UserUpdate) (User, error) {
+	email := update.Email
+	update.Email = nil
+
+	if email != nil && strings.EqualFold(*email, user.Email) {
+		email = nil
+	}
+
+	if email != nil {
+		if err := s.authService.hasher.Compare(user.Password, currentPw); err != nil {
+			return User{}, pkgErr.InvalidCredentialsError{}
+		}
+	}
+
+	result, err := s.updateProfile(ctx, user, update)
+
+	if err != nil || email == nil {
+		return result, err
+	}
+
+	if err := s.authService.RequestEmailChange(ctx, user, *email); err != nil {
+		return User{}, err
+	}
+
+	return toUser(user), nil
+}
+
+// updateProfile changes the user's profile. A new email address has to be verified again.
+func (s *UserService) updateProfile(ctx context.Context, user *models.User, update UserUpdate) (User, error) {
+	before := toUser(user)
+	data := &models.UserSetter{}
+
+	if update.FirstName != nil {
+		data.FirstName = omit.From(*update.FirstName)
+	}
+
+	if update.LastName != nil {
+		data.LastName = omit.From(*update.LastName)
+	}
+
+	emailChanged := update.Email != nil && !strings.EqualFold(*update.Email, user.Email)
+
+	if emailChanged {
+		data.Email = omit.From(*update.Email)
+		data.EmailVerifiedAt = omitnull.FromPtr[time.Time](nil)
+	}
+
+	if !data.FirstName.IsValue() && !data.LastName.IsValue() && !emailChanged {
+		return before, nil
+	}
+
+	_, err := s.repo.Update(ctx, user, data)
+
+	if isDuplicateEmail(err) {
+		return User{}, errEmailTaken
+	}
+
+	if err != nil {
+		return User{}, errors.Wrap(err, "error updating user")
+	}
+
+	s.recordChange(ctx, audit.ActionUserUpdate, before, user)
+
+	if emailChanged {
+		if err := s.authService.SendEmailVerification(ctx, user); err != nil {
+			return User{}, errors.Wrap(err, "error sending email verification")
+		}
+	}
+
+	return toUser(user), nil
+}
+
+// UpdateStatus activates, deactivates or suspends the user on behalf of an admin holding the given permissions.
+// Leaving the active status ends all sessions.
+func (s *UserService) UpdateStatus(ctx context.Context, permissions []string, userId int64, status enums.UserStatuses) (User, error) {
+	user, err := s.findUser(ctx, userId)
+
+	if err != nil {
+		return User{}, err
+	}
+
+	if user.DeletedAt.IsValue() {
+		return User{}, pkgErr.NotFoundError{Resource: "user"}
+	}
+
+	if user.Status == status {
+		return toUser(user), nil
+	}
+
+	if status != enums.UserStatusesActive {
+		if err := rejectSelf(ctx, userId, "deactivate"); err != nil {
+			return User{}, err
+		}
+	}
+
+	if err := s.checkOutranks(ctx, permissions, user); err != nil {
+		return User{}, err
+	}
+
+	before := toUser(user)
+
+	if _, err := s.repo.Update(ctx, user, &models.UserSetter{Status: omit.From(status)}); err != nil {
+		return User{}, errors.Wrap(err, "error updating user status")
+	}
+
+	s.recordChange(ctx, audit.ActionUserStatusChange, before, user)
+
+	if status != enums.UserStatusesActive {
+		if _, err := s.authService.RevokeAllSessions(ctx, user.ID); err != nil {
+			return User{}, errors.Wrap(err, "error revoking sessions of inactive user")
+		}
+	}
+
+	return toUser(user), nil
+}
+
+// DeleteUser soft deletes the user on behalf of an admin holding the given permissions and ends all of their sessions.
+func (s *UserService) DeleteUser(ctx context.Context, permissions []string, userId int64) error {
+	user, err := s.findUser(ctx, userId)
+
+	if err != nil {
+		return err
+	}
+
+	if user.DeletedAt.IsValue() {
+		return nil
+	}
+
+	if err := rejectSelf(ctx, userId, "delete"); err != nil {
+		return err
+	}
+
+	if err := s.checkOutranks(ctx, permissions, user); err != nil {
+		return err
+	}
+
+	before := toUser(user)
+
+	if err := s.repo.Delete(ctx, user); err != nil {
+		return errors.Wrap(err, "error deleting user")
+	}
+
+	s.recordChange(ctx, audit.ActionUserDelete, before, user)
+
+	if _, err := s.authService.RevokeAllSessions(ctx, user.ID); err != nil {
+		return errors.Wrap(err, "error revoking sessions of deleted user")
+	}
+
+	return nil
+}
+
+// RestoreUser undoes a soft delete on behalf of an admin holding the given permissions, the user keeps
+// the status they had before.
+func (s *UserService) RestoreUser(ctx context.Context, permissions []string, userId int64) (User, error) {
+	user, err := s.findUser(ctx, userId)
+
+	if err != nil {
+		return User{}, err
+	}
+
+	if user.DeletedAt.IsNull() {
+		return User{}, pkgErr.ConflictError{Reason: "user is not deleted"}
+	}
+
+	if err := s.checkOutranks(ctx, permissions, user); err != nil {
+		return User{}, err
+	}
+
+	before := toUser(user)
+
+	if err := s.repo.Restore(ctx, user); err != nil {
+		return User{}, errors.Wrap(err, "error restoring user")
+	}
+
+	s.recordChange(ctx, audit.ActionUserRestore, before, user)
+
+	return toUser(user), nil
 }
 
-func NewUserService(repo *repositories.UserRepository) *UserService {
-	return &UserService{repo: repo}
+func NewUserService(repo *repositories.UserRepository, roleRepo *repositories.RoleRepository, authService *AuthService, auditService *AuditService) *UserService {
+	return &UserService{repo: repo, roleRepo: roleRepo, authService: authService, audit: auditService}
 }
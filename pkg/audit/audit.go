@@ -0,0 +1,184 @@
+// Package audit defines the vocabulary of the audit log: who acted, what they did and what changed.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Actor types
+const (
+	ActorUser        = "user"
+	ActorAPIKey      = "api_key"
+	ActorOAuthClient = "oauth_client"
+	ActorSystem      = "system"
+	// Unauthenticated clients, such as failed logins
+	ActorAnonymous = "anonymous"
+)
+
+// Actions follow the "entity.verb" format.
+const (
+	ActionLogin          = "auth.login"
+	ActionLoginFailed    = "auth.login_failed"
+	ActionLogout         = "auth.logout"
+	ActionTokenRefresh   = "auth.token_refresh"
+	ActionSessionRevoke  = "auth.session_revoke"
+	ActionPasswordReset  = "auth.password_reset"
+	ActionPasswordChange = "auth.password_change"
+	ActionEmailVerify    = "auth.email_verify"
+	ActionEmailChange    = "auth.email_change"
+	ActionMFAEnable      = "auth.mfa_enable"
+	ActionMFADisable     = "auth.mfa_disable"
+
+	ActionUserCreate       = "user.create"
+	ActionUserUpdate       = "user.update"
+	ActionUserStatusChange = "user.status_change"
+	ActionUserDelete       = "user.delete"
+	ActionUserRestore      = "user.restore"
+	ActionUserUnlock       = "user.unlock"
+
+	ActionAPIKeyCreate      = "api_key.create"
+	ActionAPIKeyRevoke      = "api_key.revoke"
+	ActionOAuthClientCreate = "oauth_client.create"
+	ActionOAuthClientRevoke = "oauth_client.revoke"
+
+	ActionInvitationCreate = "invitation.create"
+	ActionInvitationResend = "invitation.resend"
+	ActionInvitationRevoke = "invitation.revoke"
+	ActionInvitationAccept = "invitation.accept"
+
+	ActionOrganisationCreate       = "organisation.create"
+	ActionOrganisationMemberAdd    = "organisation.member_add"
+	ActionOrganisationMemberRemove = "organisation.member_remove"
+
+	ActionTaxpayerProfileCreate = "taxpayer_profile.create"
+	ActionTaxpayerProfileUpdate = "taxpayer_profile.update"
+	ActionTaxpayerProfileDelete = "taxpayer_profile.delete"
+
+	ActionInvoiceCreate = "invoice.create"
+	ActionInvoiceUpdate = "invoice.update"
+	ActionInvoiceDelete = "invoice.delete"
+)
+
+// Entity types
+const (
+	EntityUser            = "user"
+	EntitySession         = "session"
+	EntityAPIKey          = "api_key"
+	EntityOAuthClient     = "oauth_client"
+	EntityInvitation      = "invitation"
+	EntityOrganisation    = "organisation"
+	EntityTaxpayerProfile = "taxpayer_profile"
+	EntityInvoice         = "invoice"
+)
+
+// Actor is whoever performed the action. OrganisationID is 0 when the actor is not bound to an organisation.
+type Actor struct {
+	Type           string
+	ID             int64
+	Label          string
+	OrganisationID int64
+}
+
+type actorKeyType struct{}
+
+var actorKey = actorKeyType{}
+
+func SetCtxActor(c context.Context, actor Actor) context.Context {
+	return context.WithValue(c, actorKey, actor)
+}
+
+func GetCtxActor(c context.Context) (Actor, bool) {
+	val := c.Value(actorKey)
+
+	actor, ok := val.(Actor)
+
+	return actor, ok
+}
+
+// Change is the before and after value of a single field.
+type Change struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// Redacted replaces the values of sensitive fields
+const Redacted = "[redacted]"
+
+// Fields containing any of these are recorded as changed without their values
+var sensitiveFields = []string{"password", "secret", "hash", "token"}
+
+func isSensitive(field string) bool {
+	field = strings.ToLower(field)
+
+	for _, s := range sensitiveFields {
+		if strings.Contains(field, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toMap(v any) (map[string]any, error) {
+	fields := map[string]any{}
+
+	if v == nil {
+		return fields, nil
+	}
+
+	raw, err := json.Marshal(v)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error encoding audit value")
+	}
+
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, errors.Wrap(err, "error decoding audit value")
+	}
+
+	return fields, nil
+}
+
+// Diff compares the JSON form of two values field by field. Either side may be nil for
+// creations and deletions. Sensitive values are redacted.
+func Diff(before any, after any) (map[string]Change, error) {
+	old, err := toMap(before)
+
+	if err != nil {
+		return nil, err
+	}
+
+	new, err := toMap(after)
+
+	if err != nil {
+		return nil, err
+	}
+
+	changes := map[string]Change{}
+
+	// A missing field counts as null
+	for field, value := range new {
+		if prev := old[field]; !reflect.DeepEqual(prev, value) {
+			changes[field] = Change{Old: prev, New: value}
+		}
+	}
+
+	for field, prev := range old {
+		if _, ok := new[field]; !ok && prev != nil {
+			changes[field] = Change{Old: prev, New: nil}
+		}
+	}
+
+	for field := range changes {
+		if isSensitive(field) {
+			changes[field] = Change{Old: Redacted, New: Redacted}
+		}
+	}
+
+	return changes, nil
+}
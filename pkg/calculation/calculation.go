@@ -0,0 +1,420 @@
+// Package calculation computes the amounts of an invoice the way LHDN validates them: every amount is
+// rounded half away from zero to 2 decimals where it is computed, and totals are sums of rounded amounts,
+// so the same input always gives the same document.
+package calculation
+
+import (
+	"fmt"
+	"sort"
+
+	pkgError "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/invoice"
+	"github.com/jacoobjake/einvoice-api/pkg/lhdn"
+	"github.com/shopspring/decimal"
+)
+
+// AmountPlaces is the number of decimals of the amounts in a document.
+const AmountPlaces = 2
+
+// Digits and decimals of the columns the document is stored in, NUMERIC(18, 2) amounts, NUMERIC(18, 6)
+// quantities and unit prices and NUMERIC(7, 4) rates. Inputs with more decimals are rejected rather
+// than rounded so the saved document is the one that was calculated.
+const (
+	amountDigits   = 18
+	quantityPlaces = 6
+	rateDigits     = 7
+	ratePlaces     = 4
+)
+
+const paymentModeCash = "01"
+
+var (
+	hundred = decimal.NewFromInt(100)
+	// Cash payments in MYR are rounded to the nearest 5 sen
+	cashRoundingSteps = decimal.NewFromInt(20)
+)
+
+func round(amount decimal.Decimal) decimal.Decimal {
+	return amount.Round(AmountPlaces)
+}
+
+// percentage returns rate percent of amount.
+func percentage(amount decimal.Decimal, rate decimal.Decimal) decimal.Decimal {
+	return round(amount.Mul(rate).Div(hundred))
+}
+
+func invalid(field string, format string, args ...any) error {
+	return pkgError.InvalidInvoiceError{Field: field, Reason: fmt.Sprintf(format, args...)}
+}
+
+// checkNumeric keeps value within a NUMERIC(digits, places) column. Digits are counted rather than
+// the value rescaled, which is slow for exponents such as 1e-999999999.
+func checkNumeric(field string, name string, value decimal.Decimal, digits int32, places int32) error {
+	if value.IsZero() {
+		return nil
+	}
+
+	if int64(value.NumDigits())+int64(value.Exponent()) > int64(digits-places) {
+		return invalid(field, "%s must be less than %s", name, decimal.New(1, digits-places))
+	}
+
+	// Decimals past places are only allowed as trailing zeros
+	if extra := -int64(value.Exponent()) - int64(places); extra > 0 && (extra >= int64(value.NumDigits()) || !value.Equal(value.Truncate(places))) {
+		return invalid(field, "%s can have at most %d decimals", name, places)
+	}
+
+	return nil
+}
+
+func checkAmount(field string, name string, value decimal.Decimal) error {
+	return checkNumeric(field, name, value, amountDigits, AmountPlaces)
+}
+
+// applyAllowanceCharges works out the amount of each allowance and charge on base and returns them with
+// the sums of the allowances and of the charges.
+func applyAllowanceCharges(field string, base decimal.Decimal, allowanceCharges []invoice.AllowanceCharge) ([]invoice.AllowanceCharge, decimal.Decimal, decimal.Decimal, error) {
+	result := make([]invoice.AllowanceCharge, 0, len(allowanceCharges))
+	allowances, charges := decimal.Zero, decimal.Zero
+
+	for i, allowanceCharge := range allowanceCharges {
+		if allowanceCharge.Rate.Valid {
+			if allowanceCharge.Rate.Decimal.IsNegative() {
+				return nil, decimal.Zero, decimal.Zero, invalid(fmt.Sprintf("%s[%d].Rate", field, i), "rate must not be negative")
+			}
+
+			if err := checkNumeric(fmt.Sprintf("%s[%d].Rate", field, i), "rate", allowanceCharge.Rate.Decimal, rateDigits, ratePlaces); err != nil {
+				return nil, decimal.Zero, decimal.Zero, err
+			}
+
+			allowanceCharge.Amount = percentage(base, allowanceCharge.Rate.Decimal)
+		} else {
+			allowanceCharge.Amount = round(allowanceCharge.Amount)
+		}
+
+		if allowanceCharge.Amount.IsNegative() {
+			return nil, decimal.Zero, decimal.Zero, invalid(fmt.Sprintf("%s[%d].Amount", field, i), "amount must not be negative")
+		}
+
+		if err := checkAmount(fmt.Sprintf("%s[%d].Amount", field, i), "amount", allowanceCharge.Amount); err != nil {
+			return nil, decimal.Zero, decimal.Zero, err
+		}
+
+		if allowanceCharge.IsCharge {
+			charges = charges.Add(allowanceCharge.Amount)
+		} else {
+			allowances = allowances.Add(allowanceCharge.Amount)
+		}
+
+		result = append(result, allowanceCharge)
+	}
+
+	return result, allowances, charges, nil
+}
+
+// calculateTax charges one tax type on a line worth amount. Percentage taxes apply to the amount,
+// fixed rate taxes such as the tourism tax to every unit.
+func calculateTax(field string, amount decimal.Decimal, quantity decimal.Decimal, tax invoice.TaxBreakdown) (invoice.TaxBreakdown, error) {
+	tax.TaxableAmount = amount
+
+	switch tax.TaxType {
+	case lhdn.TaxTypeNotApplicable, lhdn.TaxTypeExempted:
+		if (tax.Rate.Valid && !tax.Rate.Decimal.IsZero()) || (tax.PerUnitAmount.Valid && !tax.PerUnitAmount.Decimal.IsZero()) {
+			return tax, invalid(field, "%s is not charged, leave the rate and amount per unit out", lhdn.TaxTypes[tax.TaxType])
+		}
+
+		if tax.TaxType == lhdn.TaxTypeExempted && tax.ExemptionReason == "" {
+			return tax, invalid(field+".ExemptionReason", "exempted taxes need an exemption reason")
+		}
+
+		tax.TaxAmount = decimal.Zero
+	case lhdn.TaxTypeSales, lhdn.TaxTypeService, lhdn.TaxTypeTourism, lhdn.TaxTypeHighValueGoods, lhdn.TaxTypeSalesLowValueGoods:
+		switch {
+		case tax.Rate.Valid == tax.PerUnitAmount.Valid:
+			return tax, invalid(field, "%s needs either a rate or an amount per unit", lhdn.TaxTypes[tax.TaxType])
+		case tax.Rate.Valid:
+			if tax.Rate.Decimal.IsNegative() {
+				return tax, invalid(field+".Rate", "rate must not be negative")
+			}
+
+			if err := checkNumeric(field+".Rate", "rate", tax.Rate.Decimal, rateDigits, ratePlaces); err != nil {
+				return tax, err
+			}
+
+			tax.TaxAmount = percentage(amount, tax.Rate.Decimal)
+		default:
+			if tax.PerUnitAmount.Decimal.IsNegative() {
+				return tax, invalid(field+".PerUnitAmount", "amount per unit must not be negative")
+			}
+
+			if err := checkAmount(field+".PerUnitAmount", "amount per unit", tax.PerUnitAmount.Decimal); err != nil {
+				return tax, err
+			}
+
+			tax.TaxAmount = round(quantity.Mul(tax.PerUnitAmount.Decimal))
+		}
+	default:
+		return tax, invalid(field+".TaxType", "unknown tax type %q", tax.TaxType)
+	}
+
+	if err := checkAmount(field, "tax amount", tax.TaxAmount); err != nil {
+		return tax, err
+	}
+
+	return tax, nil
+}
+
+func calculateLine(field string, line invoice.Line) (invoice.Line, error) {
+	if !line.Quantity.IsPositive() {
+		return line, invalid(field+".Quantity", "quantity must be greater than 0")
+	}
+
+	if line.UnitPrice.IsNegative() {
+		return line, invalid(field+".UnitPrice", "unit price must not be negative")
+	}
+
+	if err := checkNumeric(field+".Quantity", "quantity", line.Quantity, amountDigits, quantityPlaces); err != nil {
+		return line, err
+	}
+
+	if err := checkNumeric(field+".UnitPrice", "unit price", line.UnitPrice, amountDigits, quantityPlaces); err != nil {
+		return line, err
+	}
+
+	if len(line.Taxes) == 0 {
+		return line, invalid(field+".Taxes", "every line needs a tax type, use %s when no tax applies", lhdn.TaxTypeNotApplicable)
+	}
+
+	line.Subtotal = round(line.Quantity.Mul(line.UnitPrice))
+
+	if err := checkAmount(field, "line amount", line.Subtotal); err != nil {
+		return line, err
+	}
+
+	allowanceCharges, allowances, charges, err := applyAllowanceCharges(field+".AllowanceCharges", line.Subtotal, line.AllowanceCharges)
+
+	if err != nil {
+		return line, err
+	}
+
+	line.AllowanceCharges = allowanceCharges
+	line.TotalExcludingTax = line.Subtotal.Sub(allowances).Add(charges)
+
+	if line.TotalExcludingTax.IsNegative() {
+		return line, invalid(field+".AllowanceCharges", "allowances exceed the line amount")
+	}
+
+	if err := checkAmount(field+".AllowanceCharges", "line amount", line.TotalExcludingTax); err != nil {
+		return line, err
+	}
+
+	taxes := make([]invoice.TaxBreakdown, 0, len(line.Taxes))
+	seen := map[string]bool{}
+	line.TaxAmount = decimal.Zero
+
+	for i, tax := range line.Taxes {
+		taxField := fmt.Sprintf("%s.Taxes[%d]", field, i)
+
+		if seen[tax.TaxType] {
+			return line, invalid(taxField+".TaxType", "tax type %q is listed twice", tax.TaxType)
+		}
+
+		seen[tax.TaxType] = true
+
+		tax, err := calculateTax(taxField, line.TotalExcludingTax, line.Quantity, tax)
+
+		if err != nil {
+			return line, err
+		}
+
+		line.TaxAmount = line.TaxAmount.Add(tax.TaxAmount)
+		taxes = append(taxes, tax)
+	}
+
+	if err := checkAmount(field+".Taxes", "tax amount", line.TaxAmount); err != nil {
+		return line, err
+	}
+
+	if seen[lhdn.TaxTypeNotApplicable] && len(taxes) > 1 {
+		return line, invalid(field+".Taxes", "%s cannot be combined with other tax types", lhdn.TaxTypes[lhdn.TaxTypeNotApplicable])
+	}
+
+	line.Taxes = taxes
+
+	return line, nil
+}
+
+// spread splits a document level adjustment over the lines in proportion to their amounts. The rounding
+// difference goes to the largest line so the shares add up to the adjustment.
+func spread(lines []invoice.Line, adjustment decimal.Decimal) []decimal.Decimal {
+	shares := make([]decimal.Decimal, len(lines))
+
+	if adjustment.IsZero() || len(lines) == 0 {
+		return shares
+	}
+
+	total := decimal.Zero
+	largest := 0
+
+	for i, line := range lines {
+		total = total.Add(line.TotalExcludingTax)
+
+		if line.TotalExcludingTax.GreaterThan(lines[largest].TotalExcludingTax) {
+			largest = i
+		}
+	}
+
+	remaining := adjustment
+
+	if !total.IsZero() {
+		for i, line := range lines {
+			if i == largest {
+				continue
+			}
+
+			shares[i] = round(adjustment.Mul(line.TotalExcludingTax).Div(total))
+			remaining = remaining.Sub(shares[i])
+		}
+	}
+
+	shares[largest] = remaining
+
+	return shares
+}
+
+// spreadAdjustment spreads the adjustment, the document charges less the document allowances, over the
+// lines so it raises or lowers the taxable amount of their taxes. Percentage taxes are charged again on
+// what the buyer actually pays, fixed rate taxes are charged per unit whatever the amount.
+func spreadAdjustment(lines []invoice.Line, adjustment decimal.Decimal) {
+	shares := spread(lines, adjustment)
+
+	for i, line := range lines {
+		if shares[i].IsZero() {
+			continue
+		}
+
+		taxes := make([]invoice.TaxBreakdown, 0, len(line.Taxes))
+		line.TaxAmount = decimal.Zero
+
+		for _, tax := range line.Taxes {
+			tax.TaxableAmount = tax.TaxableAmount.Add(shares[i])
+
+			if tax.Rate.Valid {
+				tax.TaxAmount = percentage(tax.TaxableAmount, tax.Rate.Decimal)
+			}
+
+			line.TaxAmount = line.TaxAmount.Add(tax.TaxAmount)
+			taxes = append(taxes, tax)
+		}
+
+		line.Taxes = taxes
+		lines[i] = line
+	}
+}
+
+// TaxSubtotals sums the taxes of the lines per tax type, in tax type order.
+func TaxSubtotals(lines []invoice.Line) []invoice.TaxSubtotal {
+	subtotals := map[string]*invoice.TaxSubtotal{}
+	taxTypes := []string{}
+
+	for _, line := range lines {
+		for _, tax := range line.Taxes {
+			subtotal, ok := subtotals[tax.TaxType]
+
+			if !ok {
+				subtotal = &invoice.TaxSubtotal{TaxType: tax.TaxType}
+				subtotals[tax.TaxType] = subtotal
+				taxTypes = append(taxTypes, tax.TaxType)
+			}
+
+			subtotal.TaxableAmount = subtotal.TaxableAmount.Add(tax.TaxableAmount)
+			subtotal.TaxAmount = subtotal.TaxAmount.Add(tax.TaxAmount)
+		}
+	}
+
+	sort.Strings(taxTypes)
+
+	result := make([]invoice.TaxSubtotal, 0, len(taxTypes))
+	for _, taxType := range taxTypes {
+		result = append(result, *subtotals[taxType])
+	}
+
+	return result
+}
+
+// Calculate returns a copy of the document with its line amounts, taxes and totals worked out.
+// Line numbers follow the order of the lines. Document allowances and charges apply to the sum of
+// the lines and are taxed as part of the lines they are spread over, so the line taxes add up to the
+// document tax. The payable amount of cash payments in MYR is rounded to the nearest 5 sen.
+func Calculate(document invoice.Invoice) (invoice.Invoice, error) {
+	if len(document.Lines) == 0 {
+		return document, invalid("Lines", "an invoice needs at least one line")
+	}
+
+	lines := make([]invoice.Line, 0, len(document.Lines))
+	totals := invoice.Totals{}
+
+	for i, line := range document.Lines {
+		line.Number = i + 1
+		line, err := calculateLine(fmt.Sprintf("Lines[%d]", i), line)
+
+		if err != nil {
+			return document, err
+		}
+
+		totals.LineAmount = totals.LineAmount.Add(line.TotalExcludingTax)
+		lines = append(lines, line)
+	}
+
+	allowanceCharges, allowances, charges, err := applyAllowanceCharges("AllowanceCharges", totals.LineAmount, document.AllowanceCharges)
+
+	if err != nil {
+		return document, err
+	}
+
+	totals.AllowanceAmount = allowances
+	totals.ChargeAmount = charges
+	totals.ExcludingTax = totals.LineAmount.Sub(allowances).Add(charges)
+
+	if totals.ExcludingTax.IsNegative() {
+		return document, invalid("AllowanceCharges", "allowances exceed the invoice amount")
+	}
+
+	spreadAdjustment(lines, charges.Sub(allowances))
+	totals.TaxSubtotals = TaxSubtotals(lines)
+
+	for _, subtotal := range totals.TaxSubtotals {
+		totals.TaxAmount = totals.TaxAmount.Add(subtotal.TaxAmount)
+	}
+
+	totals.IncludingTax = totals.ExcludingTax.Add(totals.TaxAmount)
+	totals.PayableAmount = totals.IncludingTax
+
+	if document.PaymentMode == paymentModeCash && document.CurrencyCode == lhdn.DefaultCurrencyCode {
+		totals.PayableAmount = round(totals.IncludingTax.Mul(cashRoundingSteps).Round(0).Div(cashRoundingSteps))
+	}
+
+	totals.RoundingAmount = totals.PayableAmount.Sub(totals.IncludingTax)
+
+	amounts := []decimal.Decimal{
+		totals.LineAmount, totals.AllowanceAmount, totals.ChargeAmount, totals.ExcludingTax,
+		totals.TaxAmount, totals.IncludingTax, totals.PayableAmount,
+	}
+	for _, subtotal := range totals.TaxSubtotals {
+		amounts = append(amounts, subtotal.TaxableAmount, subtotal.TaxAmount)
+	}
+	for _, line := range lines {
+		amounts = append(amounts, line.TaxAmount)
+	}
+
+	for _, total := range amounts {
+		if err := checkAmount("Lines", "invoice amount", total); err != nil {
+			return document, err
+		}
+	}
+
+	document.Lines = lines
+	document.AllowanceCharges = allowanceCharges
+	document.Totals = totals
+
+	return document, nil
+}
@@ -0,0 +1,587 @@
+package calculation
+
+import (
+	"testing"
+
+	pkgError "github.com/jacoobjake/einvoice-api/pkg/error"
+	"github.com/jacoobjake/einvoice-api/pkg/invoice"
+	"github.com/jacoobjake/einvoice-api/pkg/lhdn"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+func amount(value string) decimal.Decimal {
+	return decimal.RequireFromString(value)
+}
+
+func rate(value string) decimal.NullDecimal {
+	return decimal.NullDecimal{Decimal: amount(value), Valid: true}
+}
+
+func line(quantity string, unitPrice string, taxes ...invoice.TaxBreakdown) invoice.Line {
+	return invoice.Line{
+		ClassificationCode: lhdn.ClassificationOthers,
+		Description:        "Item",
+		Quantity:           amount(quantity),
+		UnitPrice:          amount(unitPrice),
+		Taxes:              taxes,
+	}
+}
+
+func percentTax(taxType string, value string) invoice.TaxBreakdown {
+	return invoice.TaxBreakdown{TaxType: taxType, Rate: rate(value)}
+}
+
+func perUnitTax(taxType string, value string) invoice.TaxBreakdown {
+	return invoice.TaxBreakdown{TaxType: taxType, PerUnitAmount: rate(value)}
+}
+
+func exempted(reason string) invoice.TaxBreakdown {
+	return invoice.TaxBreakdown{TaxType: lhdn.TaxTypeExempted, ExemptionReason: reason}
+}
+
+func notApplicable() invoice.TaxBreakdown {
+	return invoice.TaxBreakdown{TaxType: lhdn.TaxTypeNotApplicable}
+}
+
+func withAllowanceCharges(l invoice.Line, allowanceCharges ...invoice.AllowanceCharge) invoice.Line {
+	l.AllowanceCharges = allowanceCharges
+	return l
+}
+
+func document(currencyCode string, paymentMode string, lines ...invoice.Line) invoice.Invoice {
+	return invoice.Invoice{CurrencyCode: currencyCode, PaymentMode: paymentMode, Lines: lines}
+}
+
+func withDocumentAllowanceCharges(doc invoice.Invoice, allowanceCharges ...invoice.AllowanceCharge) invoice.Invoice {
+	doc.AllowanceCharges = allowanceCharges
+	return doc
+}
+
+type expectedLine struct {
+	subtotal          string
+	totalExcludingTax string
+	taxAmount         string
+}
+
+type expectedTotals struct {
+	lineAmount      string
+	allowanceAmount string
+	chargeAmount    string
+	excludingTax    string
+	taxAmount       string
+	includingTax    string
+	roundingAmount  string
+	payableAmount   string
+}
+
+func assertAmount(t *testing.T, name string, got decimal.Decimal, want string) {
+	t.Helper()
+
+	if !got.Equal(amount(want)) {
+		t.Errorf("%s = %s, want %s", name, got, want)
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	tests := []struct {
+		name     string
+		document invoice.Invoice
+		lines    []expectedLine
+		totals   expectedTotals
+		// Tax type to taxable amount and tax amount
+		subtotals map[string][2]string
+	}{
+		{
+			// Shape of the LHDN sample invoice: one exempted line paid by cash
+			name:      "exempted single line",
+			document:  document("MYR", "01", line("1", "1436.50", exempted("Exempt New Means of Transport"))),
+			lines:     []expectedLine{{"1436.50", "1436.50", "0"}},
+			totals:    expectedTotals{"1436.50", "0", "0", "1436.50", "0", "1436.50", "0", "1436.50"},
+			subtotals: map[string][2]string{lhdn.TaxTypeExempted: {"1436.50", "0"}},
+		},
+		{
+			name: "sales and service tax on separate lines",
+			document: document("MYR", "03",
+				line("2", "50", percentTax(lhdn.TaxTypeSales, "10")),
+				line("3", "33.333", percentTax(lhdn.TaxTypeService, "8")),
+			),
+			lines:  []expectedLine{{"100", "100", "10"}, {"100", "100", "8"}},
+			totals: expectedTotals{"200", "0", "0", "200", "18", "218", "0", "218"},
+			subtotals: map[string][2]string{
+				lhdn.TaxTypeSales:   {"100", "10"},
+				lhdn.TaxTypeService: {"100", "8"},
+			},
+		},
+		{
+			name: "tourism tax per room night with service tax",
+			document: document("MYR", "03",
+				line("3", "250", perUnitTax(lhdn.TaxTypeTourism, "10"), percentTax(lhdn.TaxTypeService, "8")),
+			),
+			lines:  []expectedLine{{"750", "750", "90"}},
+			totals: expectedTotals{"750", "0", "0", "750", "90", "840", "0", "840"},
+			subtotals: map[string][2]string{
+				lhdn.TaxTypeService: {"750", "60"},
+				lhdn.TaxTypeTourism: {"750", "30"},
+			},
+		},
+		{
+			name: "high value goods and low value goods",
+			document: document("MYR", "03",
+				line("1", "12000", percentTax(lhdn.TaxTypeHighValueGoods, "5")),
+				line("4", "99.99", percentTax(lhdn.TaxTypeSalesLowValueGoods, "10")),
+			),
+			lines:  []expectedLine{{"12000", "12000", "600"}, {"399.96", "399.96", "40"}},
+			totals: expectedTotals{"12399.96", "0", "0", "12399.96", "640", "13039.96", "0", "13039.96"},
+			subtotals: map[string][2]string{
+				lhdn.TaxTypeHighValueGoods:     {"12000", "600"},
+				lhdn.TaxTypeSalesLowValueGoods: {"399.96", "40"},
+			},
+		},
+		{
+			name: "line discount and fee",
+			document: document("MYR", "03",
+				withAllowanceCharges(line("4", "25", percentTax(lhdn.TaxTypeSales, "5")),
+					invoice.AllowanceCharge{Reason: "Promotion", Rate: rate("10")},
+					invoice.AllowanceCharge{IsCharge: true, Reason: "Handling", Amount: amount("5")},
+				),
+			),
+			lines:     []expectedLine{{"100", "95", "4.75"}},
+			totals:    expectedTotals{"95", "0", "0", "95", "4.75", "99.75", "0", "99.75"},
+			subtotals: map[string][2]string{lhdn.TaxTypeSales: {"95", "4.75"}},
+		},
+		{
+			name: "document discount and charge",
+			document: withDocumentAllowanceCharges(
+				document("MYR", "03", line("2", "100", percentTax(lhdn.TaxTypeService, "6"))),
+				invoice.AllowanceCharge{Reason: "Loyalty", Amount: amount("20")},
+				invoice.AllowanceCharge{IsCharge: true, Reason: "Delivery", Rate: rate("2.5")},
+			),
+			lines:     []expectedLine{{"200", "200", "11.10"}},
+			totals:    expectedTotals{"200", "20", "5", "185", "11.10", "196.10", "0", "196.10"},
+			subtotals: map[string][2]string{lhdn.TaxTypeService: {"185", "11.10"}},
+		},
+		{
+			name: "document discount spread over tax types",
+			document: withDocumentAllowanceCharges(
+				document("MYR", "03",
+					line("1", "100", percentTax(lhdn.TaxTypeSales, "10")),
+					line("1", "300", percentTax(lhdn.TaxTypeService, "8")),
+					line("1", "50", exempted("Exempt New Means of Transport")),
+				),
+				invoice.AllowanceCharge{Reason: "Loyalty", Rate: rate("10")},
+			),
+			lines:  []expectedLine{{"100", "100", "9"}, {"300", "300", "21.60"}, {"50", "50", "0"}},
+			totals: expectedTotals{"450", "45", "0", "405", "30.60", "435.60", "0", "435.60"},
+			subtotals: map[string][2]string{
+				lhdn.TaxTypeSales:    {"90", "9"},
+				lhdn.TaxTypeService:  {"270", "21.60"},
+				lhdn.TaxTypeExempted: {"45", "0"},
+			},
+		},
+		{
+			name: "document discount rounding goes to the largest line",
+			document: withDocumentAllowanceCharges(
+				document("MYR", "03",
+					line("1", "10", percentTax(lhdn.TaxTypeService, "8")),
+					line("1", "10", percentTax(lhdn.TaxTypeService, "8")),
+					line("1", "10", percentTax(lhdn.TaxTypeService, "8")),
+				),
+				invoice.AllowanceCharge{Reason: "Voucher", Amount: amount("10")},
+			),
+			// Shares of 3.34, 3.33 and 3.33 are each taxed 0.53
+			lines:     []expectedLine{{"10", "10", "0.53"}, {"10", "10", "0.53"}, {"10", "10", "0.53"}},
+			totals:    expectedTotals{"30", "10", "0", "20", "1.59", "21.59", "0", "21.59"},
+			subtotals: map[string][2]string{lhdn.TaxTypeService: {"20", "1.59"}},
+		},
+		{
+			name: "document charge on tourism tax",
+			document: withDocumentAllowanceCharges(
+				document("MYR", "03", line("2", "100", perUnitTax(lhdn.TaxTypeTourism, "10"))),
+				invoice.AllowanceCharge{IsCharge: true, Reason: "Late checkout", Amount: amount("50")},
+			),
+			lines:     []expectedLine{{"200", "200", "20"}},
+			totals:    expectedTotals{"200", "0", "50", "250", "20", "270", "0", "270"},
+			subtotals: map[string][2]string{lhdn.TaxTypeTourism: {"250", "20"}},
+		},
+		{
+			name:      "amounts round half away from zero",
+			document:  document("MYR", "03", line("3", "0.125", percentTax(lhdn.TaxTypeService, "6"))),
+			lines:     []expectedLine{{"0.38", "0.38", "0.02"}},
+			totals:    expectedTotals{"0.38", "0", "0", "0.38", "0.02", "0.40", "0", "0.40"},
+			subtotals: map[string][2]string{lhdn.TaxTypeService: {"0.38", "0.02"}},
+		},
+		{
+			name:      "cash payment rounds up to 5 sen",
+			document:  document("MYR", "01", line("1", "10.03", percentTax(lhdn.TaxTypeService, "8"))),
+			lines:     []expectedLine{{"10.03", "10.03", "0.80"}},
+			totals:    expectedTotals{"10.03", "0", "0", "10.03", "0.80", "10.83", "0.02", "10.85"},
+			subtotals: map[string][2]string{lhdn.TaxTypeService: {"10.03", "0.80"}},
+		},
+		{
+			name:      "cash payment rounds down to 5 sen",
+			document:  document("MYR", "01", line("1", "10.01", percentTax(lhdn.TaxTypeService, "8"))),
+			lines:     []expectedLine{{"10.01", "10.01", "0.80"}},
+			totals:    expectedTotals{"10.01", "0", "0", "10.01", "0.80", "10.81", "-0.01", "10.80"},
+			subtotals: map[string][2]string{lhdn.TaxTypeService: {"10.01", "0.80"}},
+		},
+		{
+			name:      "foreign currency cash payment is not rounded",
+			document:  document("USD", "01", line("1", "10.03", percentTax(lhdn.TaxTypeService, "8"))),
+			lines:     []expectedLine{{"10.03", "10.03", "0.80"}},
+			totals:    expectedTotals{"10.03", "0", "0", "10.03", "0.80", "10.83", "0", "10.83"},
+			subtotals: map[string][2]string{lhdn.TaxTypeService: {"10.03", "0.80"}},
+		},
+		{
+			name:      "tax not applicable",
+			document:  document("MYR", "", line("2.5", "40", notApplicable())),
+			lines:     []expectedLine{{"100", "100", "0"}},
+			totals:    expectedTotals{"100", "0", "0", "100", "0", "100", "0", "100"},
+			subtotals: map[string][2]string{lhdn.TaxTypeNotApplicable: {"100", "0"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Calculate(tt.document)
+
+			if err != nil {
+				t.Fatalf("Calculate() error = %v", err)
+			}
+
+			if len(result.Lines) != len(tt.lines) {
+				t.Fatalf("got %d lines, want %d", len(result.Lines), len(tt.lines))
+			}
+
+			for i, want := range tt.lines {
+				got := result.Lines[i]
+
+				if got.Number != i+1 {
+					t.Errorf("Lines[%d].Number = %d, want %d", i, got.Number, i+1)
+				}
+
+				assertAmount(t, "Subtotal", got.Subtotal, want.subtotal)
+				assertAmount(t, "TotalExcludingTax", got.TotalExcludingTax, want.totalExcludingTax)
+				assertAmount(t, "TaxAmount", got.TaxAmount, want.taxAmount)
+			}
+
+			totals := result.Totals
+			assertAmount(t, "LineAmount", totals.LineAmount, tt.totals.lineAmount)
+			assertAmount(t, "AllowanceAmount", totals.AllowanceAmount, tt.totals.allowanceAmount)
+			assertAmount(t, "ChargeAmount", totals.ChargeAmount, tt.totals.chargeAmount)
+			assertAmount(t, "ExcludingTax", totals.ExcludingTax, tt.totals.excludingTax)
+			assertAmount(t, "TaxAmount", totals.TaxAmount, tt.totals.taxAmount)
+			assertAmount(t, "IncludingTax", totals.IncludingTax, tt.totals.includingTax)
+			assertAmount(t, "RoundingAmount", totals.RoundingAmount, tt.totals.roundingAmount)
+			assertAmount(t, "PayableAmount", totals.PayableAmount, tt.totals.payableAmount)
+
+			if len(totals.TaxSubtotals) != len(tt.subtotals) {
+				t.Fatalf("got %d tax subtotals, want %d", len(totals.TaxSubtotals), len(tt.subtotals))
+			}
+
+			for i, subtotal := range totals.TaxSubtotals {
+				if i > 0 && totals.TaxSubtotals[i-1].TaxType >= subtotal.TaxType {
+					t.Errorf("tax subtotals are not in tax type order")
+				}
+
+				want, ok := tt.subtotals[subtotal.TaxType]
+
+				if !ok {
+					t.Errorf("unexpected tax subtotal for %q", subtotal.TaxType)
+					continue
+				}
+
+				assertAmount(t, subtotal.TaxType+" TaxableAmount", subtotal.TaxableAmount, want[0])
+				assertAmount(t, subtotal.TaxType+" TaxAmount", subtotal.TaxAmount, want[1])
+			}
+		})
+	}
+}
+
+func TestCalculateTaxesAddUp(t *testing.T) {
+	tests := []struct {
+		name     string
+		document invoice.Invoice
+	}{
+		{
+			name: "document discount",
+			document: withDocumentAllowanceCharges(
+				document("MYR", "03",
+					line("3", "33.33", percentTax(lhdn.TaxTypeSales, "10")),
+					line("7", "14.29", percentTax(lhdn.TaxTypeService, "6")),
+					line("1", "0.99", notApplicable()),
+				),
+				invoice.AllowanceCharge{Reason: "Loyalty", Rate: rate("7.5")},
+			),
+		},
+		{
+			name: "document discount and charge on discounted lines",
+			document: withDocumentAllowanceCharges(
+				document("MYR", "01",
+					withAllowanceCharges(line("2", "49.95", percentTax(lhdn.TaxTypeSales, "5")),
+						invoice.AllowanceCharge{Reason: "Promotion", Rate: rate("15")},
+					),
+					line("1", "19.90", exempted("Exempt New Means of Transport")),
+					line("4", "2.35", percentTax(lhdn.TaxTypeService, "8")),
+				),
+				invoice.AllowanceCharge{Reason: "Voucher", Amount: amount("12.34")},
+				invoice.AllowanceCharge{IsCharge: true, Reason: "Delivery", Rate: rate("3")},
+			),
+		},
+		{
+			name: "whole invoice discounted",
+			document: withDocumentAllowanceCharges(
+				document("MYR", "03",
+					line("1", "0.01", percentTax(lhdn.TaxTypeService, "8")),
+					line("1", "0.01", percentTax(lhdn.TaxTypeSales, "10")),
+					line("1", "0.01", percentTax(lhdn.TaxTypeSales, "5")),
+				),
+				invoice.AllowanceCharge{Reason: "Free", Rate: rate("100")},
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Calculate(tt.document)
+
+			if err != nil {
+				t.Fatalf("Calculate() error = %v", err)
+			}
+
+			taxableAmount, taxAmount := decimal.Zero, decimal.Zero
+			for _, subtotal := range result.Totals.TaxSubtotals {
+				taxableAmount = taxableAmount.Add(subtotal.TaxableAmount)
+				taxAmount = taxAmount.Add(subtotal.TaxAmount)
+			}
+
+			assertAmount(t, "sum of TaxableAmount", taxableAmount, result.Totals.ExcludingTax.String())
+			assertAmount(t, "sum of TaxAmount", taxAmount, result.Totals.TaxAmount.String())
+
+			// The line taxes are what the document tax is made of
+			lineTaxableAmount, lineTaxAmount := decimal.Zero, decimal.Zero
+			for _, line := range result.Lines {
+				breakdownAmount := decimal.Zero
+				for _, tax := range line.Taxes {
+					lineTaxableAmount = lineTaxableAmount.Add(tax.TaxableAmount)
+					breakdownAmount = breakdownAmount.Add(tax.TaxAmount)
+				}
+
+				assertAmount(t, "line TaxAmount", line.TaxAmount, breakdownAmount.String())
+				lineTaxAmount = lineTaxAmount.Add(line.TaxAmount)
+			}
+
+			assertAmount(t, "sum of line TaxableAmount", lineTaxableAmount, taxableAmount.String())
+			assertAmount(t, "sum of line TaxAmount", lineTaxAmount, result.Totals.TaxAmount.String())
+		})
+	}
+}
+
+func TestCalculateDoesNotChangeInput(t *testing.T) {
+	doc := document("MYR", "01", line("1", "10", percentTax(lhdn.TaxTypeSales, "10")))
+
+	if _, err := Calculate(doc); err != nil {
+		t.Fatalf("Calculate() error = %v", err)
+	}
+
+	if !doc.Lines[0].Subtotal.IsZero() || !doc.Lines[0].Taxes[0].TaxAmount.IsZero() || doc.Lines[0].Number != 0 {
+		t.Errorf("Calculate() changed the lines of its input")
+	}
+}
+
+func TestCalculateErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		document invoice.Invoice
+		field    string
+	}{
+		{
+			name:     "no lines",
+			document: document("MYR", ""),
+			field:    "Lines",
+		},
+		{
+			name:     "zero quantity",
+			document: document("MYR", "", line("0", "10", notApplicable())),
+			field:    "Lines[0].Quantity",
+		},
+		{
+			name:     "negative unit price",
+			document: document("MYR", "", line("1", "-10", notApplicable())),
+			field:    "Lines[0].UnitPrice",
+		},
+		{
+			name:     "line without taxes",
+			document: document("MYR", "", line("1", "10")),
+			field:    "Lines[0].Taxes",
+		},
+		{
+			name:     "tax without rate",
+			document: document("MYR", "", line("1", "10", invoice.TaxBreakdown{TaxType: lhdn.TaxTypeSales})),
+			field:    "Lines[0].Taxes[0]",
+		},
+		{
+			name: "tax with rate and amount per unit",
+			document: document("MYR", "", line("1", "10", invoice.TaxBreakdown{
+				TaxType:       lhdn.TaxTypeService,
+				Rate:          rate("8"),
+				PerUnitAmount: rate("1"),
+			})),
+			field: "Lines[0].Taxes[0]",
+		},
+		{
+			name:     "exemption without reason",
+			document: document("MYR", "", line("1", "10", exempted(""))),
+			field:    "Lines[0].Taxes[0].ExemptionReason",
+		},
+		{
+			name:     "rate on tax not applicable",
+			document: document("MYR", "", line("1", "10", percentTax(lhdn.TaxTypeNotApplicable, "6"))),
+			field:    "Lines[0].Taxes[0]",
+		},
+		{
+			name: "tax type listed twice",
+			document: document("MYR", "", line("1", "10",
+				percentTax(lhdn.TaxTypeSales, "10"),
+				percentTax(lhdn.TaxTypeSales, "5"),
+			)),
+			field: "Lines[0].Taxes[1].TaxType",
+		},
+		{
+			name:     "tax not applicable with another tax",
+			document: document("MYR", "", line("1", "10", percentTax(lhdn.TaxTypeSales, "10"), notApplicable())),
+			field:    "Lines[0].Taxes",
+		},
+		{
+			name:     "unknown tax type",
+			document: document("MYR", "", line("1", "10", percentTax("99", "10"))),
+			field:    "Lines[0].Taxes[0].TaxType",
+		},
+		{
+			name: "line discount above line amount",
+			document: document("MYR", "",
+				line("1", "10", notApplicable()),
+				withAllowanceCharges(line("1", "10", notApplicable()), invoice.AllowanceCharge{Amount: amount("10.01")}),
+			),
+			field: "Lines[1].AllowanceCharges",
+		},
+		{
+			name: "document discount above invoice amount",
+			document: withDocumentAllowanceCharges(
+				document("MYR", "", line("1", "10", notApplicable())),
+				invoice.AllowanceCharge{Rate: rate("60")},
+				invoice.AllowanceCharge{Rate: rate("50")},
+			),
+			field: "AllowanceCharges",
+		},
+		{
+			name: "negative document charge",
+			document: withDocumentAllowanceCharges(
+				document("MYR", "", line("1", "10", notApplicable())),
+				invoice.AllowanceCharge{IsCharge: true, Amount: amount("-1")},
+			),
+			field: "AllowanceCharges[0].Amount",
+		},
+		{
+			name:     "quantity with 7 decimals",
+			document: document("MYR", "", line("1.0000001", "10", notApplicable())),
+			field:    "Lines[0].Quantity",
+		},
+		{
+			name:     "quantity too large",
+			document: document("MYR", "", line("1000000000000", "1", notApplicable())),
+			field:    "Lines[0].Quantity",
+		},
+		{
+			name:     "unit price with 7 decimals",
+			document: document("MYR", "", line("1", "0.1234567", notApplicable())),
+			field:    "Lines[0].UnitPrice",
+		},
+		{
+			name:     "unit price too large",
+			document: document("MYR", "", line("1", "1e20", notApplicable())),
+			field:    "Lines[0].UnitPrice",
+		},
+		{
+			name:     "tax rate with 5 decimals",
+			document: document("MYR", "", line("1", "10", percentTax(lhdn.TaxTypeSales, "10.00001"))),
+			field:    "Lines[0].Taxes[0].Rate",
+		},
+		{
+			name:     "amount per unit with 3 decimals",
+			document: document("MYR", "", line("1", "10", perUnitTax(lhdn.TaxTypeTourism, "10.001"))),
+			field:    "Lines[0].Taxes[0].PerUnitAmount",
+		},
+		{
+			name: "discount rate with 5 decimals",
+			document: document("MYR", "",
+				withAllowanceCharges(line("1", "10", notApplicable()), invoice.AllowanceCharge{Rate: rate("5.00001")}),
+			),
+			field: "Lines[0].AllowanceCharges[0].Rate",
+		},
+		{
+			name:     "line amount too large",
+			document: document("MYR", "", line("999999999999", "999999999999", notApplicable())),
+			field:    "Lines[0]",
+		},
+		{
+			name:     "tax amount too large",
+			document: document("MYR", "", line("999999999999", "1", perUnitTax(lhdn.TaxTypeTourism, "999999999999"))),
+			field:    "Lines[0].Taxes[0]",
+		},
+		{
+			name: "invoice amount too large",
+			document: document("MYR", "",
+				line("999999999999", "9000", notApplicable()),
+				line("999999999999", "9000", notApplicable()),
+			),
+			field: "Lines",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Calculate(tt.document)
+
+			invalidInvoice, ok := errors.Cause(err).(pkgError.InvalidInvoiceError)
+
+			if !ok {
+				t.Fatalf("Calculate() error = %v, want an InvalidInvoiceError", err)
+			}
+
+			if invalidInvoice.Field != tt.field {
+				t.Errorf("Field = %q, want %q (%s)", invalidInvoice.Field, tt.field, invalidInvoice.Reason)
+			}
+		})
+	}
+}
+
+func TestCheckNumeric(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{"0", true},
+		{"0.000000", true},
+		{"999999999999.999999", true},
+		{"1000000000000", false},
+		{"-1000000000000", false},
+		{"1e12", false},
+		{"1e999999999", false},
+		{"0.000001", true},
+		{"0.0000001", false},
+		{"1.50000000", true},
+		{"1.50000001", false},
+		{"1e-999999999", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			err := checkNumeric("Quantity", "quantity", amount(tt.value), amountDigits, quantityPlaces)
+
+			if (err == nil) != tt.valid {
+				t.Errorf("checkNumeric(%s) error = %v, want valid %v", tt.value, err, tt.valid)
+			}
+		})
+	}
+}
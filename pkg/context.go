@@ -2,6 +2,8 @@ package pkg
 
 import (
 	"context"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/stephenafamo/bob/types/pgtypes"
 )
@@ -10,6 +12,20 @@ type clientIpKeyType struct{}
 
 var clientIpKey = clientIpKeyType{}
 
+type userAgentKeyType struct{}
+
+var userAgentKey = userAgentKeyType{}
+
+type requestIdKeyType struct{}
+
+var requestIdKey = requestIdKeyType{}
+
+type organisationIdKeyType struct{}
+
+var organisationIdKey = organisationIdKeyType{}
+
+const maxUserAgentLength = 512
+
 func SetCtxClientIp(c context.Context, ip string) context.Context {
 	inet := pgtypes.Inet{}
 	inet.Scan(ip)
@@ -26,3 +42,53 @@ func GetCtxClientIp(c context.Context) (pgtypes.Inet, bool) {
 
 	return ip, ok
 }
+
+// SetCtxUserAgent keeps the user agent storable: invalid UTF-8 and NUL bytes, which Postgres rejects,
+// are dropped and it is cut to maxUserAgentLength bytes without splitting a character.
+func SetCtxUserAgent(c context.Context, userAgent string) context.Context {
+	userAgent = strings.ReplaceAll(strings.ToValidUTF8(userAgent, ""), "\x00", "")
+
+	if len(userAgent) > maxUserAgentLength {
+		end := maxUserAgentLength
+		for end > 0 && !utf8.RuneStart(userAgent[end]) {
+			end--
+		}
+
+		userAgent = userAgent[:end]
+	}
+
+	return context.WithValue(c, userAgentKey, userAgent)
+}
+
+func GetCtxUserAgent(c context.Context) (string, bool) {
+	val := c.Value(userAgentKey)
+
+	userAgent, ok := val.(string)
+
+	return userAgent, ok
+}
+
+func SetCtxRequestId(c context.Context, requestId string) context.Context {
+	return context.WithValue(c, requestIdKey, requestId)
+}
+
+func GetCtxRequestId(c context.Context) (string, bool) {
+	val := c.Value(requestIdKey)
+
+	requestId, ok := val.(string)
+
+	return requestId, ok
+}
+
+// SetCtxOrganisationId sets the tenant of the request, repository queries are scoped to it.
+func SetCtxOrganisationId(c context.Context, organisationId int64) context.Context {
+	return context.WithValue(c, organisationIdKey, organisationId)
+}
+
+func GetCtxOrganisationId(c context.Context) (int64, bool) {
+	val := c.Value(organisationIdKey)
+
+	organisationId, ok := val.(int64)
+
+	return organisationId, ok
+}
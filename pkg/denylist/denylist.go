@@ -0,0 +1,102 @@
+package denylist
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jacoobjake/einvoice-api/pkg/redisclient"
+	"github.com/pkg/errors"
+)
+
+// TokenDenylist rejects revoked tokens by their jti until they expire.
+// Implementations must be safe for concurrent use.
+type TokenDenylist interface {
+	// Deny rejects the jti until expiresAt, denying an already expired token is a no-op.
+	Deny(ctx context.Context, jti string, expiresAt time.Time) error
+	IsDenied(ctx context.Context, jti string) (bool, error)
+}
+
+// MemoryDenylist keeps revocations in process, for local development and tests only.
+// Revocations are lost on restart and are not shared between instances.
+type MemoryDenylist struct {
+	mu      sync.Mutex
+	entries map[string]time.Time
+}
+
+func (d *MemoryDenylist) Deny(_ context.Context, jti string, expiresAt time.Time) error {
+	now := time.Now()
+
+	if !expiresAt.After(now) {
+		return nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// Revocations are rare, sweeping on write keeps the map bounded
+	for k, exp := range d.entries {
+		if !exp.After(now) {
+			delete(d.entries, k)
+		}
+	}
+
+	d.entries[jti] = expiresAt
+
+	return nil
+}
+
+func (d *MemoryDenylist) IsDenied(_ context.Context, jti string) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	exp, ok := d.entries[jti]
+
+	return ok && exp.After(time.Now()), nil
+}
+
+func NewMemoryDenylist() *MemoryDenylist {
+	return &MemoryDenylist{entries: map[string]time.Time{}}
+}
+
+// RedisDenylist stores each revocation as a key that expires with the token.
+type RedisDenylist struct {
+	rdb    *redisclient.RedisClient
+	prefix string
+}
+
+func (d *RedisDenylist) key(jti string) string {
+	return fmt.Sprintf("%s%s", d.prefix, jti)
+}
+
+func (d *RedisDenylist) Deny(ctx context.Context, jti string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+
+	if ttl <= 0 {
+		return nil
+	}
+
+	key := d.key(jti)
+
+	if err := d.rdb.Set(ctx, key, true, ttl); err != nil {
+		return errors.Wrapf(err, "failed to write key: %s", key)
+	}
+
+	return nil
+}
+
+func (d *RedisDenylist) IsDenied(ctx context.Context, jti string) (bool, error) {
+	key := d.key(jti)
+	denied, err := d.rdb.Exists(ctx, key)
+
+	if err != nil {
+		return false, errors.Wrapf(err, "error reading key: %s", key)
+	}
+
+	return denied, nil
+}
+
+func NewRedisDenylist(rdb *redisclient.RedisClient) *RedisDenylist {
+	return &RedisDenylist{rdb: rdb, prefix: "denied_jti:"}
+}
@@ -3,17 +3,185 @@ package error
 import (
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid/v5"
 )
 
 type MaxLoginAttemptError struct {
-	MaxAttempts int `json:"max_attempts"`
+	MaxAttempts int       `json:"max_attempts"`
+	UnlockAt    time.Time `json:"unlock_at"`
 }
 
 func (e MaxLoginAttemptError) Error() string {
-	return fmt.Sprintf("exceeded maximum login attempts of %d times", e.MaxAttempts)
+	return fmt.Sprintf("exceeded maximum login attempts of %d times, try again after %s", e.MaxAttempts, e.UnlockAt.UTC().Format(time.RFC3339))
+}
+
+type NotFoundError struct {
+	Resource string `json:"resource"`
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("%s not found", e.Resource)
+}
+
+// InvalidTokenError is returned when a single-use token is unknown, expired or already used.
+type InvalidTokenError struct{}
+
+func (e InvalidTokenError) Error() string {
+	return "invalid or expired token"
+}
+
+// InvalidPasswordError lists the password policy rules a new password breaks.
+type InvalidPasswordError struct {
+	Reasons []string `json:"reasons"`
+}
+
+func (e InvalidPasswordError) Error() string {
+	if len(e.Reasons) == 0 {
+		return "password does not meet the password policy"
+	}
+
+	return "password must " + strings.Join(e.Reasons, ", ")
+}
+
+type EmailNotVerifiedError struct{}
+
+func (e EmailNotVerifiedError) Error() string {
+	return "email address has not been verified"
+}
+
+type TooManyRequestsError struct {
+	RetryAfter time.Duration `json:"-"`
+}
+
+func (e TooManyRequestsError) Error() string {
+	return fmt.Sprintf("too many requests, retry in %d seconds", int(math.Ceil(e.RetryAfter.Seconds())))
+}
+
+type InvalidCredentialsError struct{}
+
+func (e InvalidCredentialsError) Error() string {
+	return "invalid credentials"
+}
+
+// MFARequiredError is returned by a login with valid credentials that must still complete
+// the second factor using the challenge token.
+type MFARequiredError struct {
+	ChallengeToken string `json:"-"`
+}
+
+func (e MFARequiredError) Error() string {
+	return "multi-factor authentication required"
+}
+
+type InvalidMFACodeError struct{}
+
+func (e InvalidMFACodeError) Error() string {
+	return "invalid authentication code"
+}
+
+type MFAAlreadyEnabledError struct{}
+
+func (e MFAAlreadyEnabledError) Error() string {
+	return "two-factor authentication is already enabled"
+}
+
+type MFANotEnabledError struct{}
+
+func (e MFANotEnabledError) Error() string {
+	return "two-factor authentication is not enabled"
+}
+
+// InvalidScopeError is returned when a credential requests a scope that is unknown or not granted to its creator.
+type InvalidScopeError struct {
+	Scope string `json:"scope"`
+}
+
+func (e InvalidScopeError) Error() string {
+	return fmt.Sprintf("scope %q is unknown or not granted", e.Scope)
+}
+
+// InvalidClientError is returned when OAuth client authentication fails.
+type InvalidClientError struct{}
+
+func (e InvalidClientError) Error() string {
+	return "client authentication failed"
+}
+
+// SSODeniedError is returned when a verified identity provider account may not sign in.
+type SSODeniedError struct {
+	Reason string `json:"reason"`
+}
+
+func (e SSODeniedError) Error() string {
+	return e.Reason
+}
+
+// InvalidRoleError is returned when a role is unknown or grants more than the user assigning it holds.
+type InvalidRoleError struct {
+	Role string `json:"role"`
+}
+
+func (e InvalidRoleError) Error() string {
+	return fmt.Sprintf("role %q is unknown or not grantable", e.Role)
+}
+
+// OrganisationRequiredError is returned when a user belonging to several organisations does not pick one.
+type OrganisationRequiredError struct{}
+
+func (e OrganisationRequiredError) Error() string {
+	return "organisation is required, set the X-Organisation-ID header"
+}
+
+// NotOrganisationMemberError is returned when a user acts on an organisation they do not belong to.
+type NotOrganisationMemberError struct{}
+
+func (e NotOrganisationMemberError) Error() string {
+	return "you are not a member of this organisation"
+}
+
+// ForbiddenError is returned when the user is not allowed to act on a resource despite the route permission.
+type ForbiddenError struct {
+	Reason string `json:"reason"`
+}
+
+func (e ForbiddenError) Error() string {
+	return e.Reason
+}
+
+// ConflictError is returned when a request conflicts with the current state of a resource.
+type ConflictError struct {
+	Reason string `json:"reason"`
+}
+
+func (e ConflictError) Error() string {
+	return e.Reason
+}
+
+// InvalidInvoiceError is returned when the amounts or taxes of an invoice cannot be calculated.
+type InvalidInvoiceError struct {
+	// Path of the offending field, e.g. Lines[0].Taxes[1].Rate
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+func (e InvalidInvoiceError) Error() string {
+	return e.Reason
+}
+
+// RefreshTokenReuseError is returned when an already rotated refresh token is presented again.
+type RefreshTokenReuseError struct {
+	UserID    int64     `json:"-"`
+	SessionID uuid.UUID `json:"-"`
+}
+
+func (e RefreshTokenReuseError) Error() string {
+	return "refresh token reuse detected"
 }
 
 type ValidationError struct {
@@ -30,8 +198,10 @@ func (e ValidationError) Error() string {
 
 func customValidationMessages(fe validator.FieldError) string {
 	switch fe.Tag() {
-	case "required":
+	case "required", "required_if", "required_unless", "required_with":
 		return "This field is required"
+	case "excluded_if", "excluded_unless":
+		return "This field is not allowed"
 	case "email":
 		return "Invalid email format"
 	case "min":
@@ -56,8 +226,12 @@ func customValidationMessages(fe validator.FieldError) string {
 		default:
 			return fe.Error() // Default error message
 		}
+	case "gt":
+		return "Value must be greater than " + fe.Param()
 	case "gte":
 		return "Value must be greater than or equal to " + fe.Param()
+	case "lt":
+		return "Value must be less than " + fe.Param()
 	case "lte":
 		return "Value must be less than or equal to " + fe.Param()
 	case "eqfield":
@@ -70,6 +244,34 @@ func customValidationMessages(fe validator.FieldError) string {
 		return "Invalid URL format"
 	case "uuid4":
 		return "Invalid UUIDv4 format"
+	case "datetime":
+		return "Invalid date, expected the " + fe.Param() + " format"
+	case "alphanum":
+		return "Value must only contain letters and digits"
+	case "iso4217":
+		return "Invalid ISO 4217 currency code"
+	case "lhdn_tin":
+		return "Invalid LHDN tax identification number"
+	case "lhdn_id":
+		return "Invalid registration number for the selected ID type"
+	case "lhdn_sst":
+		return "Invalid SST registration number, use NA when not registered"
+	case "lhdn_ttx":
+		return "Invalid tourism tax registration number, use NA when not registered"
+	case "lhdn_msic":
+		return "Invalid MSIC code, it must be 5 digits"
+	case "lhdn_state":
+		return "Invalid LHDN state code"
+	case "lhdn_country":
+		return "Invalid ISO 3166-1 alpha-3 country code"
+	case "lhdn_phone":
+		return "Invalid phone number"
+	case "lhdn_classification":
+		return "Invalid LHDN classification code, it must be between 001 and 045"
+	case "lhdn_tax_type":
+		return "Invalid LHDN tax type"
+	case "lhdn_payment_mode":
+		return "Invalid LHDN payment mode"
 	default:
 		return fe.Error() // Default error message
 	}
@@ -0,0 +1,150 @@
+// Package invoice is the document model shared by the API, the tax calculation and the UBL mapping.
+// Amounts are exact decimals in the document currency, optional text fields are empty when absent.
+package invoice
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Statuses follow a document from a local draft to the outcome of the LHDN validation.
+const (
+	StatusDraft     = "draft"
+	StatusValidated = "validated"
+	StatusSubmitted = "submitted"
+	StatusValid     = "valid"
+	StatusInvalid   = "invalid"
+	StatusCancelled = "cancelled"
+	StatusRejected  = "rejected"
+)
+
+// DateLayout is the format of issue dates and billing periods.
+const DateLayout = "2006-01-02"
+
+// TimeLayout is the format of issue times, always in UTC.
+const TimeLayout = "15:04:05Z"
+
+type Party struct {
+	Name                         string `json:"name"`
+	TIN                          string `json:"tin"`
+	IDType                       string `json:"id_type"`
+	IDValue                      string `json:"id_value"`
+	SSTRegistrationNumber        string `json:"sst_registration_number"`
+	TourismTaxRegistrationNumber string `json:"tourism_tax_registration_number,omitempty"`
+	MSICCode                     string `json:"msic_code,omitempty"`
+	BusinessActivityDescription  string `json:"business_activity_description,omitempty"`
+	AddressLine1                 string `json:"address_line1"`
+	AddressLine2                 string `json:"address_line2,omitempty"`
+	AddressLine3                 string `json:"address_line3,omitempty"`
+	PostalZone                   string `json:"postal_zone,omitempty"`
+	CityName                     string `json:"city_name"`
+	StateCode                    string `json:"state_code"`
+	CountryCode                  string `json:"country_code"`
+	Phone                        string `json:"phone,omitempty"`
+	Email                        string `json:"email,omitempty"`
+}
+
+// TaxBreakdown is one tax type applied to a line, charged at a percentage Rate or a PerUnitAmount.
+type TaxBreakdown struct {
+	TaxType         string              `json:"tax_type"`
+	Rate            decimal.NullDecimal `json:"rate"`
+	PerUnitAmount   decimal.NullDecimal `json:"per_unit_amount"`
+	ExemptionReason string              `json:"exemption_reason,omitempty"`
+	TaxableAmount   decimal.Decimal     `json:"taxable_amount"`
+	TaxAmount       decimal.Decimal     `json:"tax_amount"`
+}
+
+// AllowanceCharge is a discount, or a fee when IsCharge, of a fixed Amount or a Rate percentage of its base.
+type AllowanceCharge struct {
+	IsCharge bool                `json:"is_charge"`
+	Reason   string              `json:"reason,omitempty"`
+	Rate     decimal.NullDecimal `json:"rate"`
+	Amount   decimal.Decimal     `json:"amount"`
+}
+
+type Line struct {
+	Number             int               `json:"line_number"`
+	ClassificationCode string            `json:"classification_code"`
+	Description        string            `json:"description"`
+	Quantity           decimal.Decimal   `json:"quantity"`
+	UnitCode           string            `json:"unit_code,omitempty"`
+	UnitPrice          decimal.Decimal   `json:"unit_price"`
+	ProductTariffCode  string            `json:"product_tariff_code,omitempty"`
+	CountryOfOrigin    string            `json:"country_of_origin,omitempty"`
+	Taxes              []TaxBreakdown    `json:"taxes"`
+	AllowanceCharges   []AllowanceCharge `json:"allowance_charges"`
+	// Quantity * UnitPrice
+	Subtotal decimal.Decimal `json:"subtotal"`
+	// Subtotal less allowances plus charges
+	TotalExcludingTax decimal.Decimal `json:"total_excluding_tax"`
+	TaxAmount         decimal.Decimal `json:"tax_amount"`
+}
+
+// TaxSubtotal sums the line taxes of one tax type.
+type TaxSubtotal struct {
+	TaxType       string          `json:"tax_type"`
+	TaxableAmount decimal.Decimal `json:"taxable_amount"`
+	TaxAmount     decimal.Decimal `json:"tax_amount"`
+}
+
+type Totals struct {
+	LineAmount      decimal.Decimal `json:"line_amount"`
+	AllowanceAmount decimal.Decimal `json:"allowance_amount"`
+	ChargeAmount    decimal.Decimal `json:"charge_amount"`
+	ExcludingTax    decimal.Decimal `json:"excluding_tax"`
+	TaxAmount       decimal.Decimal `json:"tax_amount"`
+	IncludingTax    decimal.Decimal `json:"including_tax"`
+	RoundingAmount  decimal.Decimal `json:"rounding_amount"`
+	PayableAmount   decimal.Decimal `json:"payable_amount"`
+	// Derived from the lines, left out of invoice lists
+	TaxSubtotals []TaxSubtotal `json:"tax_subtotals,omitempty"`
+}
+
+// DocumentReference identifies the document a credit, debit or refund note adjusts, by its LHDN UUID
+// and the supplier's internal ID.
+type DocumentReference struct {
+	// Local ID of the adjusted invoice, 0 when not known
+	InvoiceID int64  `json:"invoice_id,omitempty"`
+	UUID      string `json:"uuid"`
+	Number    string `json:"invoice_number"`
+}
+
+type Invoice struct {
+	ID             int64  `json:"id"`
+	OrganisationID int64  `json:"organisation_id"`
+	Number         string `json:"invoice_number"`
+	// One of the lhdn.DocumentTypes
+	TypeCode string `json:"type_code"`
+	Status   string `json:"status"`
+	// Assigned by LHDN once the document is accepted
+	UUID string `json:"uuid,omitempty"`
+	// Set on credit, debit and refund notes
+	BillingReference *DocumentReference `json:"billing_reference,omitempty"`
+	// DateLayout
+	IssueDate string `json:"issue_date"`
+	// TimeLayout, stamped when the document is issued to LHDN
+	IssueTime    string              `json:"issue_time,omitempty"`
+	CurrencyCode string              `json:"currency_code"`
+	ExchangeRate decimal.NullDecimal `json:"exchange_rate"`
+	// DateLayout, empty without a billing period
+	BillingPeriodStart  string `json:"billing_period_start,omitempty"`
+	BillingPeriodEnd    string `json:"billing_period_end,omitempty"`
+	BillingFrequency    string `json:"billing_frequency,omitempty"`
+	PaymentMode         string `json:"payment_mode,omitempty"`
+	PaymentTerms        string `json:"payment_terms,omitempty"`
+	SupplierBankAccount string `json:"supplier_bank_account,omitempty"`
+
+	Supplier          *Party `json:"supplier"`
+	Buyer             *Party `json:"buyer"`
+	ShippingRecipient *Party `json:"shipping_recipient,omitempty"`
+
+	// Left out of invoice lists
+	Lines []Line `json:"lines,omitempty"`
+	// Allowances and charges on the whole document
+	AllowanceCharges []AllowanceCharge `json:"allowance_charges,omitempty"`
+	Totals           Totals            `json:"totals"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
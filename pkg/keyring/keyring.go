@@ -0,0 +1,277 @@
+package keyring
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+// Key is a single JWT key identified by its kid.
+// Private is nil for verification-only (retired) keys.
+type Key struct {
+	ID      string
+	Method  jwt.SigningMethod
+	Private crypto.Signer
+	Public  crypto.PublicKey
+	secret  []byte
+}
+
+// Keyring holds one signing key and every key that is still accepted for verification.
+type Keyring struct {
+	signing *Key
+	keys    map[string]*Key
+}
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// NewHMACKeyring creates a keyring with a single shared secret key.
+// Shared secrets are never published in the JWKS.
+func NewHMACKeyring(kid string, secret string) *Keyring {
+	key := &Key{
+		ID:     kid,
+		Method: jwt.SigningMethodHS256,
+		secret: []byte(secret),
+	}
+
+	return &Keyring{
+		signing: key,
+		keys:    map[string]*Key{kid: key},
+	}
+}
+
+// LoadKeyring loads every *.pem file in dir. The kid of a key is its file name
+// without the extension (and without a trailing ".pub" for public keys).
+// Private keys can sign and verify, public keys can only verify.
+// signingKid selects the signing key; it may be empty when the directory
+// contains exactly one private key.
+func LoadKeyring(dir string, signingKid string) (*Keyring, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing key files")
+	}
+
+	ring := &Keyring{keys: map[string]*Key{}}
+
+	for _, file := range files {
+		kid := strings.TrimSuffix(strings.TrimSuffix(filepath.Base(file), ".pem"), ".pub")
+
+		if _, exists := ring.keys[kid]; exists {
+			return nil, errors.Errorf("duplicate key id: %s", kid)
+		}
+
+		key, err := loadKeyFile(file)
+
+		if err != nil {
+			return nil, errors.Wrapf(err, "error loading key file %s", file)
+		}
+
+		key.ID = kid
+		ring.keys[kid] = key
+	}
+
+	if len(ring.keys) == 0 {
+		return nil, errors.Errorf("no keys found in %s", dir)
+	}
+
+	if signingKid == "" {
+		for _, key := range ring.keys {
+			if key.Private == nil {
+				continue
+			}
+			if ring.signing != nil {
+				return nil, errors.New("multiple private keys found, signing key id must be set")
+			}
+			ring.signing = key
+		}
+	} else {
+		ring.signing = ring.keys[signingKid]
+	}
+
+	if ring.signing == nil || ring.signing.Private == nil {
+		return nil, errors.Errorf("private signing key not found: %s", signingKid)
+	}
+
+	return ring, nil
+}
+
+func loadKeyFile(file string) (*Key, error) {
+	data, err := os.ReadFile(file)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading file")
+	}
+
+	block, _ := pem.Decode(data)
+
+	if block == nil {
+		return nil, errors.New("invalid pem data")
+	}
+
+	var parsed any
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, errors.Errorf("unsupported pem block type: %s", block.Type)
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing key")
+	}
+
+	return newKey(parsed)
+}
+
+func newKey(parsed any) (*Key, error) {
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		return &Key{Method: jwt.SigningMethodRS256, Private: k, Public: &k.PublicKey}, nil
+	case *rsa.PublicKey:
+		return &Key{Method: jwt.SigningMethodRS256, Public: k}, nil
+	case ed25519.PrivateKey:
+		return &Key{Method: jwt.SigningMethodEdDSA, Private: k, Public: k.Public()}, nil
+	case ed25519.PublicKey:
+		return &Key{Method: jwt.SigningMethodEdDSA, Public: k}, nil
+	default:
+		return nil, errors.Errorf("unsupported key type: %T", parsed)
+	}
+}
+
+// SigningKey returns the key new tokens are signed with.
+func (r *Keyring) SigningKey() *Key {
+	return r.signing
+}
+
+// SignKey returns the value expected by jwt.Token.SignedString.
+func (k *Key) SignKey() any {
+	if k.secret != nil {
+		return k.secret
+	}
+	return k.Private
+}
+
+// VerifyKey returns the value expected by a jwt.Keyfunc.
+func (k *Key) VerifyKey() any {
+	if k.secret != nil {
+		return k.secret
+	}
+	return k.Public
+}
+
+// Find returns the key with the given kid.
+func (r *Keyring) Find(kid string) (*Key, bool) {
+	key, ok := r.keys[kid]
+	return key, ok
+}
+
+// Methods returns the distinct signing algorithms accepted by the keyring.
+func (r *Keyring) Methods() []string {
+	seen := map[string]bool{}
+	methods := []string{}
+
+	for _, key := range r.keys {
+		alg := key.Method.Alg()
+		if !seen[alg] {
+			seen[alg] = true
+			methods = append(methods, alg)
+		}
+	}
+
+	sort.Strings(methods)
+
+	return methods
+}
+
+// Keyfunc resolves the verification key from the token's kid header.
+func (r *Keyring) Keyfunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok := r.Find(kid)
+
+	if !ok {
+		return nil, errors.Errorf("unknown key id: %s", kid)
+	}
+
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, errors.Errorf("unexpected signing method %s for key %s", token.Method.Alg(), kid)
+	}
+
+	return key.VerifyKey(), nil
+}
+
+// JWKS returns the public verification keys as a JSON Web Key Set.
+func (r *Keyring) JWKS() JWKSet {
+	set := JWKSet{Keys: []JWK{}}
+
+	for _, key := range r.keys {
+		jwk, ok := key.jwk()
+		if ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+
+	sort.Slice(set.Keys, func(i, j int) bool {
+		return set.Keys[i].Kid < set.Keys[j].Kid
+	})
+
+	return set
+}
+
+func (k *Key) jwk() (JWK, bool) {
+	enc := base64.RawURLEncoding
+
+	switch pub := k.Public.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			Kty: "RSA",
+			Kid: k.ID,
+			Use: "sig",
+			Alg: k.Method.Alg(),
+			N:   enc.EncodeToString(pub.N.Bytes()),
+			E:   enc.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}, true
+	case ed25519.PublicKey:
+		return JWK{
+			Kty: "OKP",
+			Kid: k.ID,
+			Use: "sig",
+			Alg: k.Method.Alg(),
+			Crv: "Ed25519",
+			X:   enc.EncodeToString(pub),
+		}, true
+	default:
+		return JWK{}, false
+	}
+}
@@ -0,0 +1,256 @@
+// Package lhdn holds the code tables and field formats of the LHDN (Inland Revenue Board of
+// Malaysia) e-Invoice specification.
+package lhdn
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Registration schemes accepted next to the TIN.
+const (
+	IDTypeNRIC     = "NRIC"
+	IDTypeBRN      = "BRN"
+	IDTypePassport = "PASSPORT"
+	IDTypeArmy     = "ARMY"
+)
+
+// NotApplicable is submitted for registration numbers the taxpayer does not hold.
+const NotApplicable = "NA"
+
+// MSICNotApplicable is the MSIC code of taxpayers without a business activity classification.
+const MSICNotApplicable = "00000"
+
+// General TINs stand in for parties that have no Malaysian TIN or do not give one.
+const (
+	TINGeneralPublic   = "EI00000000010"
+	TINForeignBuyer    = "EI00000000020"
+	TINForeignSupplier = "EI00000000030"
+	// Government, statutory bodies and others exempt from giving a TIN
+	TINGovernment = "EI00000000040"
+)
+
+// GeneralTINs maps the general TINs to the parties they stand in for.
+var GeneralTINs = map[string]string{
+	TINGeneralPublic:   "General public",
+	TINForeignBuyer:    "Foreign buyer",
+	TINForeignSupplier: "Foreign supplier",
+	TINGovernment:      "Government and exempt bodies",
+}
+
+// DefaultCountryCode is Malaysia in ISO 3166-1 alpha-3.
+const DefaultCountryCode = "MYS"
+
+// DefaultCurrencyCode is the Malaysian ringgit in ISO 4217.
+const DefaultCurrencyCode = "MYR"
+
+// StateCodes maps the LHDN state codes to their names.
+var StateCodes = map[string]string{
+	"01": "Johor",
+	"02": "Kedah",
+	"03": "Kelantan",
+	"04": "Melaka",
+	"05": "Negeri Sembilan",
+	"06": "Pahang",
+	"07": "Pulau Pinang",
+	"08": "Perak",
+	"09": "Perlis",
+	"10": "Selangor",
+	"11": "Terengganu",
+	"12": "Sabah",
+	"13": "Sarawak",
+	"14": "Wilayah Persekutuan Kuala Lumpur",
+	"15": "Wilayah Persekutuan Labuan",
+	"16": "Wilayah Persekutuan Putrajaya",
+	"17": "Not Applicable",
+}
+
+// Tax types of the LHDN tax type code table.
+const (
+	TaxTypeSales              = "01"
+	TaxTypeService            = "02"
+	TaxTypeTourism            = "03"
+	TaxTypeHighValueGoods     = "04"
+	TaxTypeSalesLowValueGoods = "05"
+	TaxTypeNotApplicable      = "06"
+	TaxTypeExempted           = "E"
+)
+
+// TaxTypes maps the LHDN tax type codes to their names.
+var TaxTypes = map[string]string{
+	TaxTypeSales:              "Sales Tax",
+	TaxTypeService:            "Service Tax",
+	TaxTypeTourism:            "Tourism Tax",
+	TaxTypeHighValueGoods:     "High-Value Goods Tax",
+	TaxTypeSalesLowValueGoods: "Sales Tax on Low Value Goods",
+	TaxTypeNotApplicable:      "Not Applicable",
+	TaxTypeExempted:           "Tax exemption",
+}
+
+// PaymentModes maps the LHDN payment mode codes to their names.
+var PaymentModes = map[string]string{
+	"01": "Cash",
+	"02": "Cheque",
+	"03": "Bank Transfer",
+	"04": "Credit Card",
+	"05": "Debit Card",
+	"06": "e-Wallet / Digital Wallet",
+	"07": "Digital Bank",
+	"08": "Others",
+}
+
+// Document types of the LHDN e-Invoice type code table. Self-billed documents are issued by the buyer.
+const (
+	DocumentTypeInvoice              = "01"
+	DocumentTypeCreditNote           = "02"
+	DocumentTypeDebitNote            = "03"
+	DocumentTypeRefundNote           = "04"
+	DocumentTypeSelfBilledInvoice    = "11"
+	DocumentTypeSelfBilledCreditNote = "12"
+	DocumentTypeSelfBilledDebitNote  = "13"
+	DocumentTypeSelfBilledRefundNote = "14"
+)
+
+// DocumentTypes maps the LHDN e-Invoice type codes to their names.
+var DocumentTypes = map[string]string{
+	DocumentTypeInvoice:              "Invoice",
+	DocumentTypeCreditNote:           "Credit Note",
+	DocumentTypeDebitNote:            "Debit Note",
+	DocumentTypeRefundNote:           "Refund Note",
+	DocumentTypeSelfBilledInvoice:    "Self-billed Invoice",
+	DocumentTypeSelfBilledCreditNote: "Self-billed Credit Note",
+	DocumentTypeSelfBilledDebitNote:  "Self-billed Debit Note",
+	DocumentTypeSelfBilledRefundNote: "Self-billed Refund Note",
+}
+
+// ClassificationOthers is the classification of goods and services no other code applies to.
+const ClassificationOthers = "022"
+
+var (
+	// IG for individuals, the other prefixes identify the kind of non-individual taxpayer
+	tinPattern = regexp.MustCompile(`^(IG|C|CS|D|E|F|FA|PT|TA|TC|TN|TR|TP|J|LE)[0-9]{8,12}$`)
+
+	idPatterns = map[string]*regexp.Regexp{
+		// 12 digits without dashes
+		IDTypeNRIC: regexp.MustCompile(`^[0-9]{12}$`),
+		// SSM registration number in the 12 digit format
+		IDTypeBRN:      regexp.MustCompile(`^[0-9]{12}$`),
+		IDTypePassport: regexp.MustCompile(`^[A-Z0-9]{6,12}$`),
+		IDTypeArmy:     regexp.MustCompile(`^[A-Z0-9]{6,12}$`),
+	}
+
+	sstPattern        = regexp.MustCompile(`^[A-Z][0-9]{2}-[0-9]{4}-[0-9]{8}$`)
+	tourismTaxPattern = regexp.MustCompile(`^[0-9]{3}-[0-9]{4}-[0-9]{8}$`)
+	msicPattern       = regexp.MustCompile(`^[0-9]{5}$`)
+	countryPattern    = regexp.MustCompile(`^[A-Z]{3}$`)
+	phonePattern      = regexp.MustCompile(`^\+?[0-9]{7,19}$`)
+	// 001 to 045
+	classificationPattern = regexp.MustCompile(`^0(0[1-9]|[1-3][0-9]|4[0-5])$`)
+)
+
+// IsValidTIN reports whether tin is a taxpayer identification number, e.g. C2584563200 or IG21136626090,
+// or one of the general TINs.
+func IsValidTIN(tin string) bool {
+	if _, ok := GeneralTINs[tin]; ok {
+		return true
+	}
+
+	return len(tin) <= 14 && tinPattern.MatchString(tin)
+}
+
+// IsValidID reports whether value is a registration number of the idType scheme.
+func IsValidID(idType string, value string) bool {
+	pattern, ok := idPatterns[idType]
+
+	return ok && pattern.MatchString(value)
+}
+
+// IsValidSSTNumber accepts NA or up to two SST registration numbers separated by a semicolon, e.g. W10-1808-32000004.
+func IsValidSSTNumber(value string) bool {
+	if value == NotApplicable {
+		return true
+	}
+
+	numbers := strings.Split(value, ";")
+
+	if len(numbers) > 2 {
+		return false
+	}
+
+	for _, number := range numbers {
+		if !sstPattern.MatchString(number) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsValidTourismTaxNumber accepts NA or a tourism tax registration number, e.g. 123-4567-89012345.
+func IsValidTourismTaxNumber(value string) bool {
+	return value == NotApplicable || tourismTaxPattern.MatchString(value)
+}
+
+// IsValidMSICCode reports whether code has the 5 digit MSIC 2008 format.
+func IsValidMSICCode(code string) bool {
+	return msicPattern.MatchString(code)
+}
+
+func IsValidStateCode(code string) bool {
+	_, ok := StateCodes[code]
+
+	return ok
+}
+
+// IsValidCountryCode reports whether code has the ISO 3166-1 alpha-3 format.
+func IsValidCountryCode(code string) bool {
+	return countryPattern.MatchString(code)
+}
+
+// IsValidPhone accepts digits with an optional leading +, e.g. +60123456789.
+func IsValidPhone(phone string) bool {
+	return phonePattern.MatchString(phone)
+}
+
+// IsValidClassificationCode reports whether code is one of the LHDN classification codes 001 to 045.
+func IsValidClassificationCode(code string) bool {
+	return classificationPattern.MatchString(code)
+}
+
+func IsValidTaxType(code string) bool {
+	_, ok := TaxTypes[code]
+
+	return ok
+}
+
+func IsValidPaymentMode(code string) bool {
+	_, ok := PaymentModes[code]
+
+	return ok
+}
+
+func IsValidDocumentType(code string) bool {
+	_, ok := DocumentTypes[code]
+
+	return ok
+}
+
+// IsSelfBilled reports whether code is one of the self-billed document types 11 to 14.
+func IsSelfBilled(code string) bool {
+	return IsValidDocumentType(code) && strings.HasPrefix(code, "1")
+}
+
+// IsAdjustment reports whether code is a credit, debit or refund note, which reference the document they adjust.
+func IsAdjustment(code string) bool {
+	return IsValidDocumentType(code) && code != DocumentTypeInvoice && code != DocumentTypeSelfBilledInvoice
+}
+
+// IsReduction reports whether code is a credit or refund note, which take amounts off the document they adjust.
+func IsReduction(code string) bool {
+	switch code {
+	case DocumentTypeCreditNote, DocumentTypeRefundNote, DocumentTypeSelfBilledCreditNote, DocumentTypeSelfBilledRefundNote:
+		return true
+	}
+
+	return false
+}
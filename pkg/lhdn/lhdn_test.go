@@ -0,0 +1,35 @@
+package lhdn
+
+import "testing"
+
+func TestIsValidTIN(t *testing.T) {
+	tests := []struct {
+		tin  string
+		want bool
+	}{
+		{"C2584563200", true},
+		{"IG21136626090", true},
+		{"CS12345678", true},
+		{"LE123456789012", true},
+		{TINGeneralPublic, true},
+		{TINForeignBuyer, true},
+		{TINForeignSupplier, true},
+		{TINGovernment, true},
+		{"EI00000000050", false},
+		{"EI12345678", false},
+		{"", false},
+		{"C1234567", false},
+		{"C1234567890123", false},
+		{"c2584563200", false},
+		{"X2584563200", false},
+		{"IG2113662609A", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tin, func(t *testing.T) {
+			if got := IsValidTIN(tt.tin); got != tt.want {
+				t.Errorf("IsValidTIN(%q) = %v, want %v", tt.tin, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package lhdn
+
+import (
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Validation tags for request bindings. lhdn_id takes the name of the sibling field holding the ID type,
+// e.g. `binding:"lhdn_id=IDType"`.
+var validations = map[string]func(string) bool{
+	"lhdn_tin":     IsValidTIN,
+	"lhdn_sst":     IsValidSSTNumber,
+	"lhdn_ttx":     IsValidTourismTaxNumber,
+	"lhdn_msic":    IsValidMSICCode,
+	"lhdn_state":   IsValidStateCode,
+	"lhdn_country": IsValidCountryCode,
+	"lhdn_phone":   IsValidPhone,
+
+	"lhdn_classification": IsValidClassificationCode,
+	"lhdn_tax_type":       IsValidTaxType,
+	"lhdn_payment_mode":   IsValidPaymentMode,
+}
+
+// decimalValue lets the numeric tags (gt, gte, lte...) compare decimal amounts. A null decimal is a
+// nil pointer rather than nil, so that the required_* tags still run on it.
+func decimalValue(field reflect.Value) any {
+	switch value := field.Interface().(type) {
+	case decimal.Decimal:
+		return value.InexactFloat64()
+	case decimal.NullDecimal:
+		if value.Valid {
+			return value.Decimal.InexactFloat64()
+		}
+	}
+
+	return (*float64)(nil)
+}
+
+func validateID(fl validator.FieldLevel) bool {
+	idType := reflect.Indirect(fl.Parent()).FieldByName(fl.Param())
+
+	if !idType.IsValid() || idType.Kind() != reflect.String {
+		return false
+	}
+
+	return IsValidID(idType.String(), fl.Field().String())
+}
+
+// RegisterValidations adds the lhdn_* validation tags to v and the decimal amount support.
+func RegisterValidations(v *validator.Validate) error {
+	for tag, isValid := range validations {
+		err := v.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+			return isValid(fl.Field().String())
+		})
+
+		if err != nil {
+			return errors.Wrapf(err, "error registering %s validation", tag)
+		}
+	}
+
+	v.RegisterCustomTypeFunc(decimalValue, decimal.Decimal{}, decimal.NullDecimal{})
+
+	if err := v.RegisterValidation("lhdn_id", validateID); err != nil {
+		return errors.Wrap(err, "error registering lhdn_id validation")
+	}
+
+	return nil
+}
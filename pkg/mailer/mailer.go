@@ -0,0 +1,79 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	cfg_mail "github.com/jacoobjake/einvoice-api/config/mail"
+	"github.com/pkg/errors"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers outgoing emails. Implementations must be safe for concurrent use.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewMailer creates the mailer selected by MAIL_DRIVER.
+func NewMailer(mailCfg *cfg_mail.MailConfig) (Mailer, error) {
+	switch mailCfg.Driver {
+	case "log":
+		return &LogMailer{from: mailCfg.From}, nil
+	case "file":
+		if err := os.MkdirAll(mailCfg.FileDir, 0o700); err != nil {
+			return nil, errors.Wrap(err, "error creating mail directory")
+		}
+		return &FileMailer{from: mailCfg.From, dir: mailCfg.FileDir}, nil
+	default:
+		return nil, errors.Errorf("unsupported mail driver: %s", mailCfg.Driver)
+	}
+}
+
+func format(from string, msg Message) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(msg.Body)
+
+	return b.String()
+}
+
+// LogMailer writes emails to the application log, for local development only.
+type LogMailer struct {
+	from string
+}
+
+func (m *LogMailer) Send(_ context.Context, msg Message) error {
+	log.Printf("mail:\n%s", format(m.from, msg))
+	return nil
+}
+
+// FileMailer writes each email to its own .eml file in dir.
+type FileMailer struct {
+	from string
+	dir  string
+}
+
+func (m *FileMailer) Send(_ context.Context, msg Message) error {
+	name := fmt.Sprintf("%d.eml", time.Now().UnixNano())
+
+	if err := os.WriteFile(filepath.Join(m.dir, name), []byte(format(m.from, msg)), 0o600); err != nil {
+		return errors.Wrap(err, "error writing mail file")
+	}
+
+	return nil
+}
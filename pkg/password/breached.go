@@ -0,0 +1,87 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Length of the SHA-1 hash prefix each range file is named after.
+const RangePrefixLength = 5
+
+// BreachedChecker looks passwords up in a local copy of a breached password corpus,
+// laid out like the Pwned Passwords k-anonymity range API: one file per SHA-1 prefix
+// named <PREFIX>.txt, each line holding the remaining hash suffix and a count, SUFFIX:COUNT.
+// Only the file for the password's prefix is read, the corpus is never loaded into memory.
+type BreachedChecker struct {
+	dir string
+}
+
+// SHA1Hex returns the uppercase hex SHA-1 of the password, as used by the range files.
+func SHA1Hex(pw string) string {
+	sum := sha1.Sum([]byte(pw))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+// IsBreached reports whether the password appears in the corpus.
+// A missing range file means no breached password shares the prefix.
+func (c *BreachedChecker) IsBreached(pw string) (bool, error) {
+	if c == nil {
+		return false, nil
+	}
+
+	hash := SHA1Hex(pw)
+	prefix, suffix := hash[:RangePrefixLength], hash[RangePrefixLength:]
+
+	f, err := os.Open(filepath.Join(c.dir, prefix+".txt"))
+
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, errors.Wrap(err, "error opening breached password range file")
+	}
+
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		candidate, _, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+
+		if strings.EqualFold(candidate, suffix) {
+			return true, nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return false, errors.Wrap(err, "error reading breached password range file")
+	}
+
+	return false, nil
+}
+
+// NewBreachedChecker returns nil when dir is empty, a nil checker reports nothing as breached.
+func NewBreachedChecker(dir string) (*BreachedChecker, error) {
+	if dir == "" {
+		return nil, nil
+	}
+
+	info, err := os.Stat(dir)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading breached password directory")
+	}
+
+	if !info.IsDir() {
+		return nil, errors.Errorf("breached password path is not a directory: %s", dir)
+	}
+
+	return &BreachedChecker{dir: dir}, nil
+}
@@ -0,0 +1,167 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	cfg_password "github.com/jacoobjake/einvoice-api/config/password"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Supported hashing algorithms, see PASSWORD_HASH_ALGORITHM.
+const (
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+)
+
+const (
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+)
+
+// ErrMismatch is returned by Compare when the password does not match the hash.
+var ErrMismatch = errors.New("password does not match")
+
+type Argon2Params struct {
+	// Memory in KiB
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+}
+
+// Hasher hashes new passwords with the configured algorithm and verifies hashes of every
+// supported algorithm, the algorithm and its parameters are read back from the encoded hash.
+// Argon2id hashes use the PHC string format: $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>.
+type Hasher struct {
+	algorithm  string
+	bcryptCost int
+	argon2     Argon2Params
+}
+
+func (h *Hasher) Hash(pw string) (string, error) {
+	switch h.algorithm {
+	case AlgorithmBcrypt:
+		hashed, err := bcrypt.GenerateFromPassword([]byte(pw), h.bcryptCost)
+		if err != nil {
+			return "", errors.Wrap(err, "error hashing password with bcrypt")
+		}
+		return string(hashed), nil
+	default:
+		salt := make([]byte, argon2SaltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return "", errors.Wrap(err, "error generating salt")
+		}
+		return encodeArgon2id(h.argon2, salt, argon2id(pw, salt, h.argon2, argon2KeyLength)), nil
+	}
+}
+
+// Compare returns ErrMismatch when the password does not match, other errors mean the hash is unusable.
+func (h *Hasher) Compare(hash string, pw string) error {
+	if strings.HasPrefix(hash, "$"+AlgorithmArgon2id+"$") {
+		params, salt, key, err := decodeArgon2id(hash)
+		if err != nil {
+			return err
+		}
+
+		if subtle.ConstantTimeCompare(key, argon2id(pw, salt, params, uint32(len(key)))) != 1 {
+			return ErrMismatch
+		}
+
+		return nil
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(pw))
+
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return ErrMismatch
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "error comparing bcrypt hash")
+	}
+
+	return nil
+}
+
+// NeedsRehash reports whether the hash was made with another algorithm or weaker parameters than configured.
+func (h *Hasher) NeedsRehash(hash string) bool {
+	switch h.algorithm {
+	case AlgorithmBcrypt:
+		cost, err := bcrypt.Cost([]byte(hash))
+		return err != nil || cost != h.bcryptCost
+	default:
+		params, _, key, err := decodeArgon2id(hash)
+		return err != nil || params != h.argon2 || len(key) != argon2KeyLength
+	}
+}
+
+func argon2id(pw string, salt []byte, params Argon2Params, keyLength uint32) []byte {
+	return argon2.IDKey([]byte(pw), salt, params.Iterations, params.Memory, params.Parallelism, keyLength)
+}
+
+func encodeArgon2id(params Argon2Params, salt []byte, key []byte) string {
+	return fmt.Sprintf(
+		"$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		AlgorithmArgon2id, argon2.Version, params.Memory, params.Iterations, params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key),
+	)
+}
+
+func decodeArgon2id(hash string) (params Argon2Params, salt []byte, key []byte, err error) {
+	parts := strings.Split(hash, "$")
+
+	if len(parts) != 6 || parts[1] != AlgorithmArgon2id {
+		return params, nil, nil, errors.New("invalid argon2id hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, errors.New("unsupported argon2id version")
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, errors.Wrap(err, "invalid argon2id parameters")
+	}
+
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return params, nil, nil, errors.Wrap(err, "invalid argon2id salt")
+	}
+
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return params, nil, nil, errors.Wrap(err, "invalid argon2id key")
+	}
+
+	return params, salt, key, nil
+}
+
+func NewHasher(passwordCfg *cfg_password.PasswordConfig) (*Hasher, error) {
+	h := &Hasher{
+		algorithm:  passwordCfg.HashAlgorithm,
+		bcryptCost: passwordCfg.BcryptCost,
+		argon2: Argon2Params{
+			Memory:      uint32(passwordCfg.Argon2MemoryKiB),
+			Iterations:  uint32(passwordCfg.Argon2Iterations),
+			Parallelism: uint8(passwordCfg.Argon2Parallelism),
+		},
+	}
+
+	switch h.algorithm {
+	case AlgorithmBcrypt:
+		if h.bcryptCost < bcrypt.MinCost || h.bcryptCost > bcrypt.MaxCost {
+			return nil, errors.Errorf("bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	case AlgorithmArgon2id:
+		if h.argon2.Memory < 8*uint32(h.argon2.Parallelism) || h.argon2.Iterations < 1 || h.argon2.Parallelism < 1 {
+			return nil, errors.New("invalid argon2id parameters")
+		}
+	default:
+		return nil, errors.Errorf("unsupported password hash algorithm: %s", h.algorithm)
+	}
+
+	return h, nil
+}
@@ -0,0 +1,112 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	cfg_password "github.com/jacoobjake/einvoice-api/config/password"
+)
+
+// Personal info fragments shorter than this are too common to reject on.
+const minPersonalTokenLength = 3
+
+type Policy struct {
+	MinLength            int
+	MaxLength            int
+	RequireLower         bool
+	RequireUpper         bool
+	RequireDigit         bool
+	RequireSymbol        bool
+	DisallowPersonalInfo bool
+}
+
+func NewPolicy(passwordCfg *cfg_password.PasswordConfig) Policy {
+	return Policy{
+		MinLength:            passwordCfg.MinLength,
+		MaxLength:            passwordCfg.MaxLength,
+		RequireLower:         passwordCfg.RequireLower,
+		RequireUpper:         passwordCfg.RequireUpper,
+		RequireDigit:         passwordCfg.RequireDigit,
+		RequireSymbol:        passwordCfg.RequireSymbol,
+		DisallowPersonalInfo: passwordCfg.DisallowPersonalInfo,
+	}
+}
+
+// Validate returns the rules the password breaks, phrased to follow "password must".
+// personal holds the user's email and names, emails are matched on their local part.
+func (p Policy) Validate(pw string, personal ...string) []string {
+	var violations []string
+	length := utf8.RuneCountInString(pw)
+
+	if length < p.MinLength {
+		violations = append(violations, fmt.Sprintf("be at least %d characters long", p.MinLength))
+	}
+
+	if p.MaxLength > 0 && length > p.MaxLength {
+		violations = append(violations, fmt.Sprintf("be at most %d characters long", p.MaxLength))
+	}
+
+	var hasLower, hasUpper, hasDigit, hasSymbol bool
+
+	for _, r := range pw {
+		switch {
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		default:
+			hasSymbol = true
+		}
+	}
+
+	if p.RequireLower && !hasLower {
+		violations = append(violations, "contain a lowercase letter")
+	}
+
+	if p.RequireUpper && !hasUpper {
+		violations = append(violations, "contain an uppercase letter")
+	}
+
+	if p.RequireDigit && !hasDigit {
+		violations = append(violations, "contain a digit")
+	}
+
+	if p.RequireSymbol && !hasSymbol {
+		violations = append(violations, "contain a special character")
+	}
+
+	if p.DisallowPersonalInfo && containsPersonalInfo(pw, personal) {
+		violations = append(violations, "not contain your email address or name")
+	}
+
+	return violations
+}
+
+func containsPersonalInfo(pw string, personal []string) bool {
+	lower := strings.ToLower(pw)
+
+	for _, value := range personal {
+		value = strings.ToLower(value)
+
+		if local, _, ok := strings.Cut(value, "@"); ok {
+			value = local
+		}
+
+		// Check the whole value as well as its parts, e.g. "john.doe" and "john", "doe"
+		tokens := strings.FieldsFunc(value, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+
+		for _, token := range append(tokens, value) {
+			if utf8.RuneCountInString(token) >= minPersonalTokenLength && strings.Contains(lower, token) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,105 @@
+// Package ratelimit implements a token bucket limiter stored in redis.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jacoobjake/einvoice-api/pkg/redisclient"
+	"github.com/pkg/errors"
+)
+
+// The bucket refills continuously and is updated atomically, using the redis clock
+// so every app instance agrees on the time.
+//
+// KEYS[1] bucket key
+// ARGV[1] capacity
+// ARGV[2] refill period in milliseconds for a full bucket
+//
+// Returns {allowed, remaining, retry_after_ms, reset_ms}
+var tokenBucket = redisclient.NewScript(`
+local capacity = tonumber(ARGV[1])
+local period = tonumber(ARGV[2])
+local rate = capacity / period
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1]) or capacity
+local ts = tonumber(bucket[2]) or now
+
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * rate)
+
+local allowed = 0
+local retry_after = 0
+
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  retry_after = math.ceil((1 - tokens) / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tokens, 'ts', now)
+redis.call('PEXPIRE', KEYS[1], period)
+
+return {allowed, math.floor(tokens), retry_after, math.ceil((capacity - tokens) / rate)}
+`)
+
+// Limit allows Requests per Period, with bursts of up to Requests.
+type Limit struct {
+	Requests int
+	Period   time.Duration
+}
+
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration
+	// ResetAfter is the time until the bucket is full again
+	ResetAfter time.Duration
+}
+
+type Limiter struct {
+	rdb    *redisclient.RedisClient
+	prefix string
+}
+
+// Allow takes one token from the bucket identified by key.
+func (l *Limiter) Allow(ctx context.Context, key string, limit Limit) (*Result, error) {
+	bucketKey := fmt.Sprintf("%s%s", l.prefix, key)
+
+	raw, err := l.rdb.RunScript(ctx, tokenBucket, []string{bucketKey}, limit.Requests, limit.Period.Milliseconds())
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "error running rate limit script for key: %s", bucketKey)
+	}
+
+	values, ok := raw.([]any)
+
+	if !ok || len(values) != 4 {
+		return nil, errors.Errorf("unexpected rate limit script result: %v", raw)
+	}
+
+	ints := make([]int64, len(values))
+	for i, v := range values {
+		if ints[i], ok = v.(int64); !ok {
+			return nil, errors.Errorf("unexpected rate limit script result: %v", raw)
+		}
+	}
+
+	return &Result{
+		Allowed:    ints[0] == 1,
+		Limit:      limit.Requests,
+		Remaining:  int(ints[1]),
+		RetryAfter: time.Duration(ints[2]) * time.Millisecond,
+		ResetAfter: time.Duration(ints[3]) * time.Millisecond,
+	}, nil
+}
+
+func NewLimiter(rdb *redisclient.RedisClient) *Limiter {
+	return &Limiter{rdb: rdb, prefix: "rate_limit:"}
+}
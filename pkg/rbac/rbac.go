@@ -0,0 +1,96 @@
+// Package rbac defines the built-in roles and the permissions routes can require.
+package rbac
+
+import "strings"
+
+// Permissions follow the "resource:action" format.
+const (
+	// Wildcard grants every permission
+	Wildcard = "*"
+
+	UserRead   = "user:read"
+	UserWrite  = "user:write"
+	UserUnlock = "user:unlock"
+	UserInvite = "user:invite"
+
+	RoleManage = "role:manage"
+
+	OrganisationManage = "organisation:manage"
+
+	AuditRead = "audit:read"
+
+	TaxpayerRead  = "taxpayer:read"
+	TaxpayerWrite = "taxpayer:write"
+
+	APIKeyManage      = "api_key:manage"
+	OAuthClientManage = "oauth_client:manage"
+
+	InvoiceRead   = "invoice:read"
+	InvoiceCreate = "invoice:create"
+	InvoiceSubmit = "invoice:submit"
+)
+
+const (
+	RoleSuperAdmin = "super_admin"
+	RoleAdmin      = "admin"
+	RoleAccountant = "accountant"
+	RoleViewer     = "viewer"
+)
+
+// Permissions lists every known permission with its description.
+var Permissions = map[string]string{
+	Wildcard:           "Every permission, including ones added later",
+	UserRead:           "View users",
+	UserWrite:          "Create and update users",
+	UserUnlock:         "Lift login lockouts",
+	UserInvite:         "Invite users and manage invitations",
+	RoleManage:         "Manage roles and role assignments",
+	OrganisationManage: "Create organisations and manage their members",
+	AuditRead:          "View and export the audit log",
+	TaxpayerRead:       "View the organisation's taxpayer profile",
+	TaxpayerWrite:      "Maintain the organisation's taxpayer profile",
+	APIKeyManage:       "Issue and revoke organisation API keys",
+	OAuthClientManage:  "Register and revoke organisation OAuth clients",
+	InvoiceRead:        "View invoices",
+	InvoiceCreate:      "Create and edit draft invoices",
+	InvoiceSubmit:      "Submit invoices to LHDN",
+}
+
+type RoleDefinition struct {
+	Description string
+	Permissions []string
+}
+
+// Roles are the built-in roles seeded into the database.
+var Roles = map[string]RoleDefinition{
+	RoleSuperAdmin: {
+		Description: "Unrestricted access",
+		Permissions: []string{Wildcard},
+	},
+	RoleAdmin: {
+		Description: "Manages users and roles",
+		Permissions: []string{UserRead, UserWrite, UserUnlock, UserInvite, RoleManage, OrganisationManage, AuditRead, APIKeyManage, OAuthClientManage, TaxpayerRead, TaxpayerWrite, InvoiceRead},
+	},
+	RoleAccountant: {
+		Description: "Prepares and submits invoices",
+		Permissions: []string{TaxpayerRead, InvoiceRead, InvoiceCreate, InvoiceSubmit},
+	},
+	RoleViewer: {
+		Description: "Read-only access to invoices",
+		Permissions: []string{InvoiceRead},
+	},
+}
+
+// HasPermission reports whether granted satisfies the required permission.
+// A "resource:*" grant covers every action on that resource.
+func HasPermission(granted []string, required string) bool {
+	resource, _, _ := strings.Cut(required, ":")
+
+	for _, p := range granted {
+		if p == Wildcard || p == required || p == resource+":*" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// Nil is returned by Get when the key does not exist.
+const Nil = redis.Nil
+
 type RedisClient struct {
 	rdb *redis.Client
 }
@@ -34,6 +37,11 @@ func (c *RedisClient) Get(ctx context.Context, key string) (string, error) {
 	return c.rdb.Get(ctx, key).Result()
 }
 
+// GetDel retrieves a value and removes the key atomically, for single-use values.
+func (c *RedisClient) GetDel(ctx context.Context, key string) (string, error) {
+	return c.rdb.GetDel(ctx, key).Result()
+}
+
 // Exists checks if a key exists.
 func (c *RedisClient) Exists(ctx context.Context, key string) (bool, error) {
 	n, err := c.rdb.Exists(ctx, key).Result()
@@ -49,3 +57,27 @@ func (c *RedisClient) Delete(ctx context.Context, key string) error {
 func (c *RedisClient) Expire(ctx context.Context, key string, expiration time.Duration) error {
 	return c.rdb.Expire(ctx, key, expiration).Err()
 }
+
+// SetNX stores a value only if the key does not exist yet. Returns false when the key already existed.
+func (c *RedisClient) SetNX(ctx context.Context, key string, value any, expiration time.Duration) (bool, error) {
+	return c.rdb.SetNX(ctx, key, value, expiration).Result()
+}
+
+// TTL returns the remaining time to live of a key.
+func (c *RedisClient) TTL(ctx context.Context, key string) (time.Duration, error) {
+	return c.rdb.TTL(ctx, key).Result()
+}
+
+// Script is a Lua script that runs atomically on the server.
+type Script struct {
+	script *redis.Script
+}
+
+func NewScript(src string) *Script {
+	return &Script{script: redis.NewScript(src)}
+}
+
+// RunScript executes the script by its SHA, loading it first if the server does not know it yet.
+func (c *RedisClient) RunScript(ctx context.Context, s *Script, keys []string, args ...any) (any, error) {
+	return s.script.Run(ctx, c.rdb, keys, args...).Result()
+}
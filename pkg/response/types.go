@@ -9,3 +9,10 @@ type JSONApiResponse struct {
 	Data             any                        `json:"data,omitempty"`
 	ValidationErrors []pkgError.ValidationError `json:"validation_errors,omitempty"`
 }
+
+// Pagination describes the page returned by a list endpoint.
+type Pagination struct {
+	Page    int   `json:"page"`
+	PerPage int   `json:"per_page"`
+	Total   int64 `json:"total"`
+}
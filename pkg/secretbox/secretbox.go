@@ -0,0 +1,71 @@
+// Package secretbox encrypts small secrets, such as TOTP seeds, before they are stored.
+package secretbox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/pkg/errors"
+)
+
+type SecretBox struct {
+	aead cipher.AEAD
+}
+
+// NewSecretBox derives an AES-256-GCM key from the given passphrase.
+func NewSecretBox(passphrase string) (*SecretBox, error) {
+	key := sha256.Sum256([]byte(passphrase))
+
+	block, err := aes.NewCipher(key[:])
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating cipher")
+	}
+
+	aead, err := cipher.NewGCM(block)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating gcm")
+	}
+
+	return &SecretBox{aead: aead}, nil
+}
+
+// Seal encrypts plaintext and returns base64(nonce || ciphertext).
+func (b *SecretBox) Seal(plaintext string) (string, error) {
+	nonce := make([]byte, b.aead.NonceSize())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.Wrap(err, "error generating nonce")
+	}
+
+	sealed := b.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a value produced by Seal.
+func (b *SecretBox) Open(sealed string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(sealed)
+
+	if err != nil {
+		return "", errors.Wrap(err, "error decoding sealed value")
+	}
+
+	size := b.aead.NonceSize()
+
+	if len(raw) < size {
+		return "", errors.New("sealed value too short")
+	}
+
+	plaintext, err := b.aead.Open(nil, raw[:size], raw[size:], nil)
+
+	if err != nil {
+		return "", errors.Wrap(err, "error decrypting sealed value")
+	}
+
+	return string(plaintext), nil
+}
@@ -0,0 +1,435 @@
+package ubl
+
+import (
+	"strconv"
+
+	"github.com/jacoobjake/einvoice-api/pkg/calculation"
+	"github.com/jacoobjake/einvoice-api/pkg/invoice"
+	"github.com/jacoobjake/einvoice-api/pkg/lhdn"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Schemes of the party identifications besides the ID type of the party.
+const (
+	schemeTIN        = "TIN"
+	schemeSST        = "SST"
+	schemeTourismTax = "TTX"
+)
+
+// List IDs of the commodity classifications of an item.
+const (
+	listClassification = "CLASS"
+	listTariff         = "PTC"
+)
+
+func amount(name string, value decimal.Decimal, currencyCode string) *element {
+	return numeric(name, value.StringFixed(calculation.AmountPlaces), attr{"currencyID", currencyCode})
+}
+
+func taxScheme() *element {
+	return aggregate("TaxScheme", basic("ID", "OTH", attr{"schemeID", "UN/ECE 5153"}, attr{"schemeAgencyID", "6"}))
+}
+
+func partyElement(name string, party *invoice.Party) *element {
+	if party == nil {
+		return nil
+	}
+
+	children := []*element{
+		basic("IndustryClassificationCode", party.MSICCode, attr{"name", party.BusinessActivityDescription}),
+	}
+
+	identifications := []attr{
+		{schemeTIN, party.TIN},
+		{party.IDType, party.IDValue},
+		{schemeSST, party.SSTRegistrationNumber},
+		{schemeTourismTax, party.TourismTaxRegistrationNumber},
+	}
+
+	for _, identification := range identifications {
+		children = append(children, aggregate("PartyIdentification", basic("ID", identification.Value, attr{"schemeID", identification.Name})))
+	}
+
+	address := []*element{
+		basic("CityName", party.CityName),
+		basic("PostalZone", party.PostalZone),
+		basic("CountrySubentityCode", party.StateCode),
+	}
+
+	for _, line := range []string{party.AddressLine1, party.AddressLine2, party.AddressLine3} {
+		address = append(address, aggregate("AddressLine", basic("Line", line)))
+	}
+
+	address = append(address, aggregate("Country", basic("IdentificationCode", party.CountryCode, attr{"listID", "ISO3166-1"}, attr{"listAgencyID", "6"})))
+
+	children = append(children,
+		aggregate("PostalAddress", address...),
+		aggregate("PartyLegalEntity", basic("RegistrationName", party.Name)),
+		aggregate("Contact", basic("Telephone", party.Phone), basic("ElectronicMail", party.Email)),
+	)
+
+	return aggregate(name, children...)
+}
+
+// allowanceChargeElements writes rates as fractions, 10 percent is a MultiplierFactorNumeric of 0.1.
+func allowanceChargeElements(allowanceCharges []invoice.AllowanceCharge, currencyCode string) []*element {
+	result := make([]*element, 0, len(allowanceCharges))
+	for _, allowanceCharge := range allowanceCharges {
+		var multiplier *element
+		if allowanceCharge.Rate.Valid {
+			multiplier = numeric("MultiplierFactorNumeric", allowanceCharge.Rate.Decimal.Shift(-2).String())
+		}
+
+		result = append(result, aggregate("AllowanceCharge",
+			&element{Name: "ChargeIndicator", Value: strconv.FormatBool(allowanceCharge.IsCharge), Kind: kindIndicator},
+			basic("AllowanceChargeReason", allowanceCharge.Reason),
+			multiplier,
+			amount("Amount", allowanceCharge.Amount, currencyCode),
+		))
+	}
+
+	return result
+}
+
+func lineElement(line invoice.Line, currencyCode string) *element {
+	taxTotal := []*element{amount("TaxAmount", line.TaxAmount, currencyCode)}
+
+	for _, tax := range line.Taxes {
+		var percent, baseUnitMeasure, perUnitAmount *element
+
+		if tax.Rate.Valid {
+			percent = numeric("Percent", tax.Rate.Decimal.String())
+		}
+
+		if tax.PerUnitAmount.Valid {
+			baseUnitMeasure = numeric("BaseUnitMeasure", line.Quantity.String(), attr{"unitCode", line.UnitCode})
+			perUnitAmount = numeric("PerUnitAmount", tax.PerUnitAmount.Decimal.String(), attr{"currencyID", currencyCode})
+		}
+
+		taxTotal = append(taxTotal, aggregate("TaxSubtotal",
+			amount("TaxableAmount", tax.TaxableAmount, currencyCode),
+			amount("TaxAmount", tax.TaxAmount, currencyCode),
+			percent,
+			baseUnitMeasure,
+			perUnitAmount,
+			aggregate("TaxCategory",
+				basic("ID", tax.TaxType),
+				basic("TaxExemptionReason", tax.ExemptionReason),
+				taxScheme(),
+			),
+		))
+	}
+
+	children := []*element{
+		basic("ID", strconv.Itoa(line.Number)),
+		numeric("InvoicedQuantity", line.Quantity.String(), attr{"unitCode", line.UnitCode}),
+		amount("LineExtensionAmount", line.TotalExcludingTax, currencyCode),
+	}
+
+	children = append(children, allowanceChargeElements(line.AllowanceCharges, currencyCode)...)
+	children = append(children,
+		aggregate("TaxTotal", taxTotal...),
+		aggregate("Item",
+			basic("Description", line.Description),
+			aggregate("OriginCountry", basic("IdentificationCode", line.CountryOfOrigin)),
+			aggregate("CommodityClassification", basic("ItemClassificationCode", line.ProductTariffCode, attr{"listID", listTariff})),
+			aggregate("CommodityClassification", basic("ItemClassificationCode", line.ClassificationCode, attr{"listID", listClassification})),
+		),
+		aggregate("Price", numeric("PriceAmount", line.UnitPrice.String(), attr{"currencyID", currencyCode})),
+		aggregate("ItemPriceExtension", amount("Amount", line.Subtotal, currencyCode)),
+	)
+
+	return aggregate("InvoiceLine", children...)
+}
+
+func billingReference(reference *invoice.DocumentReference) *element {
+	if reference == nil {
+		return nil
+	}
+
+	return aggregate("BillingReference", aggregate("InvoiceDocumentReference",
+		basic("ID", reference.Number),
+		basic("UUID", reference.UUID),
+	))
+}
+
+// build maps the document to its UBL tree, in the element order of the UBL 2.1 schema.
+func build(doc invoice.Invoice) *element {
+	currencyCode := doc.CurrencyCode
+	totals := doc.Totals
+
+	children := []*element{
+		basic("ID", doc.Number),
+		basic("IssueDate", doc.IssueDate),
+		basic("IssueTime", doc.IssueTime),
+		basic("InvoiceTypeCode", doc.TypeCode, attr{"listVersionID", VersionID}),
+		basic("DocumentCurrencyCode", currencyCode),
+		basic("TaxCurrencyCode", lhdn.DefaultCurrencyCode),
+		aggregate("InvoicePeriod",
+			basic("StartDate", doc.BillingPeriodStart),
+			basic("EndDate", doc.BillingPeriodEnd),
+			basic("Description", doc.BillingFrequency),
+		),
+		billingReference(doc.BillingReference),
+		aggregate("AccountingSupplierParty", partyElement("Party", doc.Supplier)),
+		aggregate("AccountingCustomerParty", partyElement("Party", doc.Buyer)),
+		aggregate("Delivery", partyElement("DeliveryParty", doc.ShippingRecipient)),
+		aggregate("PaymentMeans",
+			basic("PaymentMeansCode", doc.PaymentMode),
+			aggregate("PayeeFinancialAccount", basic("ID", doc.SupplierBankAccount)),
+		),
+		aggregate("PaymentTerms", basic("Note", doc.PaymentTerms)),
+	}
+
+	children = append(children, allowanceChargeElements(doc.AllowanceCharges, currencyCode)...)
+
+	if doc.ExchangeRate.Valid {
+		children = append(children, aggregate("TaxExchangeRate",
+			basic("SourceCurrencyCode", currencyCode),
+			basic("TargetCurrencyCode", lhdn.DefaultCurrencyCode),
+			numeric("CalculationRate", doc.ExchangeRate.Decimal.String()),
+		))
+	}
+
+	taxTotal := []*element{amount("TaxAmount", totals.TaxAmount, currencyCode)}
+	for _, subtotal := range totals.TaxSubtotals {
+		taxTotal = append(taxTotal, aggregate("TaxSubtotal",
+			amount("TaxableAmount", subtotal.TaxableAmount, currencyCode),
+			amount("TaxAmount", subtotal.TaxAmount, currencyCode),
+			aggregate("TaxCategory", basic("ID", subtotal.TaxType), taxScheme()),
+		))
+	}
+
+	children = append(children,
+		aggregate("TaxTotal", taxTotal...),
+		aggregate("LegalMonetaryTotal",
+			amount("LineExtensionAmount", totals.LineAmount, currencyCode),
+			amount("TaxExclusiveAmount", totals.ExcludingTax, currencyCode),
+			amount("TaxInclusiveAmount", totals.IncludingTax, currencyCode),
+			amount("AllowanceTotalAmount", totals.AllowanceAmount, currencyCode),
+			amount("ChargeTotalAmount", totals.ChargeAmount, currencyCode),
+			amount("PayableRoundingAmount", totals.RoundingAmount, currencyCode),
+			amount("PayableAmount", totals.PayableAmount, currencyCode),
+		),
+	)
+
+	for _, line := range doc.Lines {
+		children = append(children, lineElement(line, currencyCode))
+	}
+
+	return aggregate(rootName, children...)
+}
+
+// reader keeps the first number that fails to parse so the mapping reads straight through.
+type reader struct {
+	err error
+}
+
+func (r *reader) fail(err error, field string) {
+	if r.err == nil {
+		r.err = errors.Wrapf(err, "ubl: invalid %s", field)
+	}
+}
+
+// decimal is zero when the value is missing.
+func (r *reader) decimal(e *element, path ...string) decimal.Decimal {
+	value := e.text(path...)
+
+	if value == "" {
+		return decimal.Zero
+	}
+
+	result, err := decimal.NewFromString(value)
+
+	if err != nil {
+		r.fail(err, path[len(path)-1])
+	}
+
+	return result
+}
+
+func (r *reader) nullDecimal(e *element, path ...string) decimal.NullDecimal {
+	if e.text(path...) == "" {
+		return decimal.NullDecimal{}
+	}
+
+	return decimal.NullDecimal{Decimal: r.decimal(e, path...), Valid: true}
+}
+
+func (r *reader) party(e *element) *invoice.Party {
+	if e == nil {
+		return nil
+	}
+
+	party := &invoice.Party{
+		Name:                        e.text("PartyLegalEntity", "RegistrationName"),
+		MSICCode:                    e.text("IndustryClassificationCode"),
+		BusinessActivityDescription: e.child("IndustryClassificationCode").attr("name"),
+		PostalZone:                  e.text("PostalAddress", "PostalZone"),
+		CityName:                    e.text("PostalAddress", "CityName"),
+		StateCode:                   e.text("PostalAddress", "CountrySubentityCode"),
+		CountryCode:                 e.text("PostalAddress", "Country", "IdentificationCode"),
+		Phone:                       e.text("Contact", "Telephone"),
+		Email:                       e.text("Contact", "ElectronicMail"),
+	}
+
+	for _, identification := range e.children("PartyIdentification") {
+		id := identification.child("ID")
+
+		switch scheme := id.attr("schemeID"); scheme {
+		case schemeTIN:
+			party.TIN = id.Value
+		case schemeSST:
+			party.SSTRegistrationNumber = id.Value
+		case schemeTourismTax:
+			party.TourismTaxRegistrationNumber = id.Value
+		default:
+			party.IDType = scheme
+			party.IDValue = id.Value
+		}
+	}
+
+	addressLines := []*string{&party.AddressLine1, &party.AddressLine2, &party.AddressLine3}
+	for i, line := range e.child("PostalAddress").children("AddressLine") {
+		if i < len(addressLines) {
+			*addressLines[i] = line.text("Line")
+		}
+	}
+
+	return party
+}
+
+func (r *reader) allowanceCharges(e *element) []invoice.AllowanceCharge {
+	elements := e.children("AllowanceCharge")
+	result := make([]invoice.AllowanceCharge, 0, len(elements))
+
+	for _, allowanceCharge := range elements {
+		rate := r.nullDecimal(allowanceCharge, "MultiplierFactorNumeric")
+		rate.Decimal = rate.Decimal.Shift(2)
+
+		result = append(result, invoice.AllowanceCharge{
+			IsCharge: allowanceCharge.text("ChargeIndicator") == "true",
+			Reason:   allowanceCharge.text("AllowanceChargeReason"),
+			Rate:     rate,
+			Amount:   r.decimal(allowanceCharge, "Amount"),
+		})
+	}
+
+	return result
+}
+
+func (r *reader) line(e *element) invoice.Line {
+	number, err := strconv.Atoi(e.text("ID"))
+
+	if err != nil {
+		r.fail(err, "InvoiceLine ID")
+	}
+
+	line := invoice.Line{
+		Number:            number,
+		Description:       e.text("Item", "Description"),
+		Quantity:          r.decimal(e, "InvoicedQuantity"),
+		UnitCode:          e.child("InvoicedQuantity").attr("unitCode"),
+		UnitPrice:         r.decimal(e, "Price", "PriceAmount"),
+		CountryOfOrigin:   e.text("Item", "OriginCountry", "IdentificationCode"),
+		AllowanceCharges:  r.allowanceCharges(e),
+		Subtotal:          r.decimal(e, "ItemPriceExtension", "Amount"),
+		TotalExcludingTax: r.decimal(e, "LineExtensionAmount"),
+		TaxAmount:         r.decimal(e, "TaxTotal", "TaxAmount"),
+	}
+
+	for _, classification := range e.child("Item").children("CommodityClassification") {
+		code := classification.child("ItemClassificationCode")
+
+		switch code.attr("listID") {
+		case listClassification:
+			line.ClassificationCode = code.Value
+		case listTariff:
+			line.ProductTariffCode = code.Value
+		}
+	}
+
+	subtotals := e.child("TaxTotal").children("TaxSubtotal")
+	line.Taxes = make([]invoice.TaxBreakdown, 0, len(subtotals))
+
+	for _, subtotal := range subtotals {
+		line.Taxes = append(line.Taxes, invoice.TaxBreakdown{
+			TaxType:         subtotal.text("TaxCategory", "ID"),
+			Rate:            r.nullDecimal(subtotal, "Percent"),
+			PerUnitAmount:   r.nullDecimal(subtotal, "PerUnitAmount"),
+			ExemptionReason: subtotal.text("TaxCategory", "TaxExemptionReason"),
+			TaxableAmount:   r.decimal(subtotal, "TaxableAmount"),
+			TaxAmount:       r.decimal(subtotal, "TaxAmount"),
+		})
+	}
+
+	return line
+}
+
+// parse maps a UBL tree back to a document. Fields UBL has no place for, such as the status, are left empty.
+func parse(root *element) (invoice.Invoice, error) {
+	if root == nil || root.Name != rootName {
+		return invoice.Invoice{}, errNotInvoice
+	}
+
+	r := &reader{}
+	totals := root.child("LegalMonetaryTotal")
+
+	doc := invoice.Invoice{
+		Number:              root.text("ID"),
+		TypeCode:            root.text("InvoiceTypeCode"),
+		IssueDate:           root.text("IssueDate"),
+		IssueTime:           root.text("IssueTime"),
+		CurrencyCode:        root.text("DocumentCurrencyCode"),
+		ExchangeRate:        r.nullDecimal(root, "TaxExchangeRate", "CalculationRate"),
+		BillingPeriodStart:  root.text("InvoicePeriod", "StartDate"),
+		BillingPeriodEnd:    root.text("InvoicePeriod", "EndDate"),
+		BillingFrequency:    root.text("InvoicePeriod", "Description"),
+		PaymentMode:         root.text("PaymentMeans", "PaymentMeansCode"),
+		PaymentTerms:        root.text("PaymentTerms", "Note"),
+		SupplierBankAccount: root.text("PaymentMeans", "PayeeFinancialAccount", "ID"),
+		Supplier:            r.party(root.child("AccountingSupplierParty", "Party")),
+		Buyer:               r.party(root.child("AccountingCustomerParty", "Party")),
+		ShippingRecipient:   r.party(root.child("Delivery", "DeliveryParty")),
+		Totals: invoice.Totals{
+			LineAmount:      r.decimal(totals, "LineExtensionAmount"),
+			AllowanceAmount: r.decimal(totals, "AllowanceTotalAmount"),
+			ChargeAmount:    r.decimal(totals, "ChargeTotalAmount"),
+			ExcludingTax:    r.decimal(totals, "TaxExclusiveAmount"),
+			TaxAmount:       r.decimal(root, "TaxTotal", "TaxAmount"),
+			IncludingTax:    r.decimal(totals, "TaxInclusiveAmount"),
+			RoundingAmount:  r.decimal(totals, "PayableRoundingAmount"),
+			PayableAmount:   r.decimal(totals, "PayableAmount"),
+		},
+	}
+
+	if reference := root.child("BillingReference", "InvoiceDocumentReference"); reference != nil {
+		doc.BillingReference = &invoice.DocumentReference{
+			UUID:   reference.text("UUID"),
+			Number: reference.text("ID"),
+		}
+	}
+
+	if allowanceCharges := r.allowanceCharges(root); len(allowanceCharges) > 0 {
+		doc.AllowanceCharges = allowanceCharges
+	}
+
+	for _, subtotal := range root.child("TaxTotal").children("TaxSubtotal") {
+		doc.Totals.TaxSubtotals = append(doc.Totals.TaxSubtotals, invoice.TaxSubtotal{
+			TaxType:       subtotal.text("TaxCategory", "ID"),
+			TaxableAmount: r.decimal(subtotal, "TaxableAmount"),
+			TaxAmount:     r.decimal(subtotal, "TaxAmount"),
+		})
+	}
+
+	for _, line := range root.children("InvoiceLine") {
+		doc.Lines = append(doc.Lines, r.line(line))
+	}
+
+	if r.err != nil {
+		return invoice.Invoice{}, r.err
+	}
+
+	return doc, nil
+}
@@ -0,0 +1,236 @@
+package ubl
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/jacoobjake/einvoice-api/pkg/invoice"
+	"github.com/pkg/errors"
+)
+
+// In the JSON form every element is an array of objects. Basic elements keep their value under "_"
+// next to their attributes, aggregate elements keep their attributes as strings next to their children,
+// and the namespaces are declared under "_D", "_A" and "_B".
+const jsonValueKey = "_"
+
+func writeJSONString(buf *bytes.Buffer, value string) {
+	// Marshalling a string cannot fail
+	encoded, _ := json.Marshal(value)
+	buf.Write(encoded)
+}
+
+func writeJSONElement(buf *bytes.Buffer, e *element) {
+	buf.WriteByte('{')
+
+	if !e.isAggregate() {
+		writeJSONString(buf, jsonValueKey)
+		buf.WriteByte(':')
+
+		switch e.Kind {
+		case kindNumeric, kindIndicator:
+			buf.WriteString(e.Value)
+		default:
+			writeJSONString(buf, e.Value)
+		}
+
+		for _, a := range e.Attrs {
+			if a.Value != "" {
+				buf.WriteByte(',')
+				writeJSONString(buf, a.Name)
+				buf.WriteByte(':')
+				writeJSONString(buf, a.Value)
+			}
+		}
+
+		buf.WriteByte('}')
+		return
+	}
+
+	for _, a := range e.Attrs {
+		if a.Value != "" {
+			writeJSONString(buf, a.Name)
+			buf.WriteByte(':')
+			writeJSONString(buf, a.Value)
+			buf.WriteByte(',')
+		}
+	}
+
+	// Repeated elements are listed together, in the order they first appear
+	names := []string{}
+	groups := map[string][]*element{}
+	for _, child := range e.Children {
+		if _, ok := groups[child.Name]; !ok {
+			names = append(names, child.Name)
+		}
+
+		groups[child.Name] = append(groups[child.Name], child)
+	}
+
+	for i, name := range names {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		writeJSONString(buf, name)
+		buf.WriteString(":[")
+
+		for j, child := range groups[name] {
+			if j > 0 {
+				buf.WriteByte(',')
+			}
+
+			writeJSONElement(buf, child)
+		}
+
+		buf.WriteByte(']')
+	}
+
+	buf.WriteByte('}')
+}
+
+// MarshalJSON writes the document as a UBL 2.1 JSON Invoice.
+func MarshalJSON(doc invoice.Invoice) ([]byte, error) {
+	var compact bytes.Buffer
+
+	compact.WriteByte('{')
+	for _, namespace := range [][2]string{{"_D", NamespaceInvoice}, {"_A", NamespaceAggregate}, {"_B", NamespaceBasic}} {
+		writeJSONString(&compact, namespace[0])
+		compact.WriteByte(':')
+		writeJSONString(&compact, namespace[1])
+		compact.WriteByte(',')
+	}
+
+	writeJSONString(&compact, rootName)
+	compact.WriteString(":[")
+	writeJSONElement(&compact, build(doc))
+	compact.WriteString("]}")
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, compact.Bytes(), "", "  "); err != nil {
+		return nil, errors.Wrap(err, "error encoding UBL JSON")
+	}
+
+	buf.WriteByte('\n')
+
+	return buf.Bytes(), nil
+}
+
+func jsonScalar(value any) (string, kind, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", kindText, nil
+	case string:
+		return v, kindText, nil
+	case json.Number:
+		return v.String(), kindNumeric, nil
+	case bool:
+		return strconv.FormatBool(v), kindIndicator, nil
+	default:
+		return "", kindText, errors.Errorf("ubl: unexpected value %v", value)
+	}
+}
+
+func decodeJSONElement(name string, value any) (*element, error) {
+	object, ok := value.(map[string]any)
+
+	if !ok {
+		return nil, errors.Errorf("ubl: %s must be an object", name)
+	}
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	e := &element{Name: name}
+
+	if raw, ok := object[jsonValueKey]; ok {
+		var err error
+
+		if e.Value, e.Kind, err = jsonScalar(raw); err != nil {
+			return nil, err
+		}
+
+		for _, key := range keys {
+			if key == jsonValueKey {
+				continue
+			}
+
+			attrValue, _, err := jsonScalar(object[key])
+
+			if err != nil {
+				return nil, err
+			}
+
+			e.Attrs = append(e.Attrs, attr{key, attrValue})
+		}
+
+		return e, nil
+	}
+
+	for _, key := range keys {
+		if attrValue, ok := object[key].(string); ok {
+			e.Attrs = append(e.Attrs, attr{key, attrValue})
+			continue
+		}
+
+		items, ok := object[key].([]any)
+
+		if !ok {
+			return nil, errors.Errorf("ubl: %s must be an array", key)
+		}
+
+		for _, item := range items {
+			child, err := decodeJSONElement(key, item)
+
+			if err != nil {
+				return nil, err
+			}
+
+			e.Children = append(e.Children, child)
+		}
+	}
+
+	return e, nil
+}
+
+// decodeJSON reads the element tree of a JSON Invoice.
+func decodeJSON(data []byte) (*element, error) {
+	var document map[string]any
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	if err := dec.Decode(&document); err != nil {
+		return nil, errors.Wrap(err, "error decoding UBL JSON")
+	}
+
+	roots, ok := document[rootName].([]any)
+
+	if !ok || len(roots) != 1 {
+		return nil, errNotInvoice
+	}
+
+	root, err := decodeJSONElement(rootName, roots[0])
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error decoding UBL JSON")
+	}
+
+	return root, nil
+}
+
+// UnmarshalJSON reads a UBL 2.1 JSON Invoice.
+func UnmarshalJSON(data []byte) (invoice.Invoice, error) {
+	root, err := decodeJSON(data)
+
+	if err != nil {
+		return invoice.Invoice{}, err
+	}
+
+	return parse(root)
+}
@@ -0,0 +1,143 @@
+// Package ubl maps invoices to the UBL 2.1 documents accepted by MyInvois, in XML or JSON, and back.
+//
+// Documents are built as a tree of elements first, the XML and JSON forms only differ in how the tree
+// is written. Aggregate elements are in the cac namespace and basic elements in the cbc namespace.
+package ubl
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	NamespaceInvoice   = "urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"
+	NamespaceAggregate = "urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	NamespaceBasic     = "urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"
+)
+
+// VersionID is the version of the LHDN document type, version 1.0 documents are not signed.
+const VersionID = "1.0"
+
+const rootName = "Invoice"
+
+var errNotInvoice = errors.New("ubl: document is not an Invoice")
+
+// kind is how the value of a basic element is written in JSON, XML values are all text.
+type kind int
+
+const (
+	kindText kind = iota
+	kindNumeric
+	kindIndicator
+)
+
+type attr struct {
+	Name  string
+	Value string
+}
+
+type element struct {
+	Name     string
+	Value    string
+	Kind     kind
+	Attrs    []attr
+	Children []*element
+}
+
+func (e *element) isAggregate() bool {
+	return len(e.Children) > 0
+}
+
+// basic is a cbc element, left out when it has no value.
+func basic(name string, value string, attrs ...attr) *element {
+	if value == "" {
+		return nil
+	}
+
+	return &element{Name: name, Value: value, Attrs: attrs}
+}
+
+func numeric(name string, value string, attrs ...attr) *element {
+	e := basic(name, value, attrs...)
+
+	if e != nil {
+		e.Kind = kindNumeric
+	}
+
+	return e
+}
+
+// aggregate is a cac element, left out when none of its children are set.
+func aggregate(name string, children ...*element) *element {
+	e := &element{Name: name}
+
+	for _, child := range children {
+		if child != nil {
+			e.Children = append(e.Children, child)
+		}
+	}
+
+	if len(e.Children) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// child follows the path of element names, taking the first match at every step.
+func (e *element) child(path ...string) *element {
+	for _, name := range path {
+		if e == nil {
+			return nil
+		}
+
+		var next *element
+		for _, child := range e.Children {
+			if child.Name == name {
+				next = child
+				break
+			}
+		}
+
+		e = next
+	}
+
+	return e
+}
+
+func (e *element) children(name string) []*element {
+	if e == nil {
+		return nil
+	}
+
+	result := []*element{}
+	for _, child := range e.Children {
+		if child.Name == name {
+			result = append(result, child)
+		}
+	}
+
+	return result
+}
+
+// text is the value at the end of the path, empty when any element is missing.
+func (e *element) text(path ...string) string {
+	if e = e.child(path...); e == nil {
+		return ""
+	}
+
+	return e.Value
+}
+
+func (e *element) attr(name string) string {
+	if e == nil {
+		return ""
+	}
+
+	for _, a := range e.Attrs {
+		if a.Name == name {
+			return a.Value
+		}
+	}
+
+	return ""
+}
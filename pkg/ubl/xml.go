@@ -0,0 +1,157 @@
+package ubl
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/jacoobjake/einvoice-api/pkg/invoice"
+	"github.com/pkg/errors"
+)
+
+func xmlName(e *element) xml.Name {
+	if e.isAggregate() {
+		return xml.Name{Local: "cac:" + e.Name}
+	}
+
+	return xml.Name{Local: "cbc:" + e.Name}
+}
+
+func encodeXMLElement(enc *xml.Encoder, e *element) error {
+	start := xml.StartElement{Name: xmlName(e)}
+
+	for _, a := range e.Attrs {
+		if a.Value != "" {
+			start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: a.Name}, Value: a.Value})
+		}
+	}
+
+	if err := enc.EncodeToken(start); err != nil {
+		return err
+	}
+
+	if e.isAggregate() {
+		for _, child := range e.Children {
+			if err := encodeXMLElement(enc, child); err != nil {
+				return err
+			}
+		}
+	} else if err := enc.EncodeToken(xml.CharData(e.Value)); err != nil {
+		return err
+	}
+
+	return enc.EncodeToken(start.End())
+}
+
+// MarshalXML writes the document as a UBL 2.1 XML Invoice with the cac and cbc namespace prefixes.
+func MarshalXML(doc invoice.Invoice) ([]byte, error) {
+	root := build(doc)
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+
+	start := xml.StartElement{
+		Name: xml.Name{Local: root.Name},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "xmlns"}, Value: NamespaceInvoice},
+			{Name: xml.Name{Local: "xmlns:cac"}, Value: NamespaceAggregate},
+			{Name: xml.Name{Local: "xmlns:cbc"}, Value: NamespaceBasic},
+		},
+	}
+
+	if err := enc.EncodeToken(start); err != nil {
+		return nil, errors.Wrap(err, "error encoding UBL XML")
+	}
+
+	for _, child := range root.Children {
+		if err := encodeXMLElement(enc, child); err != nil {
+			return nil, errors.Wrap(err, "error encoding UBL XML")
+		}
+	}
+
+	if err := enc.EncodeToken(start.End()); err != nil {
+		return nil, errors.Wrap(err, "error encoding UBL XML")
+	}
+
+	if err := enc.Flush(); err != nil {
+		return nil, errors.Wrap(err, "error encoding UBL XML")
+	}
+
+	buf.WriteByte('\n')
+
+	return buf.Bytes(), nil
+}
+
+// decodeXML reads the element tree of an XML Invoice. Elements are named without their prefix, elements
+// the mapping has no use for, such as the signature, are kept as they are.
+func decodeXML(data []byte) (*element, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+
+	var root *element
+	stack := []*element{}
+
+	for {
+		token, err := dec.Token()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, errors.Wrap(err, "error decoding UBL XML")
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if root == nil && (t.Name.Space != NamespaceInvoice || t.Name.Local != rootName) {
+				return nil, errNotInvoice
+			}
+
+			e := &element{Name: t.Name.Local}
+			for _, a := range t.Attr {
+				if a.Name.Space != "xmlns" && a.Name.Local != "xmlns" {
+					e.Attrs = append(e.Attrs, attr{a.Name.Local, a.Value})
+				}
+			}
+
+			if len(stack) == 0 {
+				root = e
+			} else {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, e)
+			}
+
+			stack = append(stack, e)
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].Value += string(t)
+			}
+		case xml.EndElement:
+			e := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if e.isAggregate() {
+				e.Value = ""
+			} else {
+				e.Value = strings.TrimSpace(e.Value)
+			}
+		}
+	}
+
+	return root, nil
+}
+
+// UnmarshalXML reads a UBL 2.1 XML Invoice, whatever prefixes it binds the namespaces to.
+func UnmarshalXML(data []byte) (invoice.Invoice, error) {
+	root, err := decodeXML(data)
+
+	if err != nil {
+		return invoice.Invoice{}, err
+	}
+
+	return parse(root)
+}